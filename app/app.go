@@ -160,6 +160,7 @@ import (
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v26/x/pool-incentives/client"
 	poolmanagerclient "github.com/osmosis-labs/osmosis/v26/x/poolmanager/client"
 	superfluidclient "github.com/osmosis-labs/osmosis/v26/x/superfluid/client"
+	twapclient "github.com/osmosis-labs/osmosis/v26/x/twap/client"
 	txfeesclient "github.com/osmosis-labs/osmosis/v26/x/txfees/client"
 )
 
@@ -515,16 +516,22 @@ func NewOsmosisApp(
 					superfluidclient.SetSuperfluidAssetsProposalHandler,
 					superfluidclient.RemoveSuperfluidAssetsProposalHandler,
 					superfluidclient.UpdateUnpoolWhitelistProposalHandler,
+					superfluidclient.MigrateBalancerPoolToConcentratedProposalHandler,
 					gammclient.ReplaceMigrationRecordsProposalHandler,
 					gammclient.UpdateMigrationRecordsProposalHandler,
 					gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 					gammclient.SetScalingFactorControllerProposalHandler,
 					clclient.CreateConcentratedLiquidityPoolProposalHandler,
 					clclient.TickSpacingDecreaseProposalHandler,
+					clclient.DynamicSpreadFactorProposalHandler,
+					clclient.PoolHooksProposalHandler,
 					cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 					cwpoolclient.MigratePoolContractsProposalHandler,
 					txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 					poolmanagerclient.DenomPairTakerFeeProposalHandler,
+					poolmanagerclient.TakerFeeVolumeTiersProposalHandler,
+					twapclient.SetOracleRoutesProposalHandler,
+					twapclient.SetRecordRetentionOverridesProposalHandler,
 					incentivesclient.HandleCreateGroupsProposal,
 				},
 			),
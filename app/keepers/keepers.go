@@ -457,6 +457,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+	appKeepers.PoolManagerKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
@@ -603,6 +605,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.WasmKeeper = &wasmKeeper
 	appKeepers.CosmwasmPoolKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
 	appKeepers.PoolManagerKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+	appKeepers.GAMMKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+	appKeepers.SuperfluidKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
 
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
@@ -637,6 +641,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
 		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
 		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper)).
+		AddRoute(twaptypes.RouterKey, twap.NewTwapProposalHandler(*appKeepers.TwapKeeper)).
 		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper))
 
 	govConfig := govtypes.DefaultConfig()
@@ -891,6 +896,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.PoolManagerKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
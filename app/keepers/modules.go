@@ -60,6 +60,7 @@ import (
 	superfluid "github.com/osmosis-labs/osmosis/v26/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v26/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v26/x/tokenfactory"
+	twapclient "github.com/osmosis-labs/osmosis/v26/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v26/x/txfees"
 	txfeesclient "github.com/osmosis-labs/osmosis/v26/x/txfees/client"
@@ -86,16 +87,22 @@ var AppModuleBasics = module.NewBasicManager(
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
 			superfluidclient.UpdateUnpoolWhitelistProposalHandler,
+			superfluidclient.MigrateBalancerPoolToConcentratedProposalHandler,
 			gammclient.ReplaceMigrationRecordsProposalHandler,
 			gammclient.UpdateMigrationRecordsProposalHandler,
 			gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.DynamicSpreadFactorProposalHandler,
+			clclient.PoolHooksProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
+			poolmanagerclient.TakerFeeVolumeTiersProposalHandler,
+			twapclient.SetOracleRoutesProposalHandler,
+			twapclient.SetRecordRetentionOverridesProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
 		},
 	),
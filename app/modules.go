@@ -139,6 +139,7 @@ var moduleAccountPermissions = map[string][]string{
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:               {authtypes.Staking},
 	poolmanagertypes.ModuleName:              nil,
+	concentratedliquiditytypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	cosmwasmpooltypes.ModuleName:             nil,
 	auctiontypes.ModuleName:                  nil,
 	smartaccounttypes.ModuleName:             nil,
@@ -234,6 +235,7 @@ func orderBeginBlockers(allModuleNames []string) []string {
 	// TODO: Come back and delete this line after testing the base change.
 	ord.Sequence(stakingtypes.ModuleName, ibchost.ModuleName, superfluidtypes.ModuleName)
 	// We leave downtime-detector un-constrained.
+	// gamm pulls stableswap scaling factors from rate provider contracts, and is left un-constrained as well.
 	// every remaining module's begin block is a no-op.
 	return ord.TotalOrdering()
 }
@@ -246,7 +248,7 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.FirstElements(govtypes.ModuleName)
 	ord.LastElements(stakingtypes.ModuleName)
 
-	// only Osmosis modules with endblock code are: twap, crisis, govtypes, staking
+	// only Osmosis modules with endblock code are: twap, concentrated-liquidity, crisis, govtypes, staking
 	// we don't care about the relative ordering between them.
 	return ord.TotalOrdering()
 }
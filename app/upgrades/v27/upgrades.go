@@ -10,6 +10,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v26/app/keepers"
 	"github.com/osmosis-labs/osmosis/v26/app/upgrades"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
 )
 
 func CreateUpgradeHandler(
@@ -43,6 +45,22 @@ func CreateUpgradeHandler(
 			sdkCtx.Logger().Error("Error initializing Constitution Collection:", "message", err.Error())
 		}
 
+		// Initialize the newly created concentrated liquidity auto-compound params.
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyAutoCompoundEpochIdentifier, cltypes.DefaultAutoCompoundEpochIdentifier)
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyAutoCompoundGasBudget, cltypes.DefaultAutoCompoundGasBudget)
+
+		// Initialize the newly created concentrated liquidity minimum spread rewards position age param.
+		keepers.ConcentratedLiquidityKeeper.SetParam(sdkCtx, cltypes.KeyMinSpreadRewardsPositionAge, cltypes.DefaultMinSpreadRewardsPositionAge)
+
+		// Initialize the newly created superfluid balancer pool migration spot price deviation param.
+		keepers.SuperfluidKeeper.SetParam(sdkCtx, superfluidtypes.KeyMaxBalancerPoolMigrationSpotPriceDeviation, superfluidtypes.DefaultMaxBalancerPoolMigrationSpotPriceDeviation)
+
+		// Set the amplification of the existing stableswap pools, reproducing their current curve.
+		err = keepers.GAMMKeeper.InitializeStableswapAmplification(sdkCtx)
+		if err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
@@ -11,10 +11,12 @@ import (
 	"cosmossdk.io/x/upgrade"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	v27 "github.com/osmosis-labs/osmosis/v26/app/upgrades/v27"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
 )
 
 const (
@@ -36,6 +38,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 	s.PrepareGovModuleConstitutionTest()
 	s.PrepareSupplyOffsetTest()
+	stableswapPoolId := s.PrepareStableswapAmplificationTest()
 
 	// Run the upgrade
 	dummyUpgrade(s)
@@ -46,6 +49,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 	s.ExecuteGovModuleConstitutionTest()
 	s.ExecuteSupplyOffsetTest()
+	s.ExecuteStableswapAmplificationTest(stableswapPoolId)
 }
 
 func dummyUpgrade(s *UpgradeTestSuite) {
@@ -90,3 +94,26 @@ func (s *UpgradeTestSuite) ExecuteSupplyOffsetTest() {
 	s.Require().Equal("500", offset.String())
 	s.Require().Equal("0", oldOffset.String())
 }
+
+// PrepareStableswapAmplificationTest writes a stableswap pool without an amplification,
+// as created before the amplification was introduced.
+func (s *UpgradeTestSuite) PrepareStableswapAmplificationTest() uint64 {
+	poolId := s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx)
+	liquidity := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000), sdk.NewInt64Coin("uusdc", 1_000_000))
+	pool, err := stableswap.NewStableswapPool(poolId, stableswap.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}, liquidity, nil, "", "")
+	s.Require().NoError(err)
+
+	pool.PoolParams.Amplification = 0
+	err = s.App.GAMMKeeper.OverwritePoolV15MigrationUnsafe(s.Ctx, &pool)
+	s.Require().NoError(err)
+
+	return poolId
+}
+
+// ExecuteStableswapAmplificationTest checks that the amplification of the pool was set to 1,
+// which reproduces its curve before the upgrade.
+func (s *UpgradeTestSuite) ExecuteStableswapAmplificationTest(poolId uint64) {
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), pool.(*stableswap.Pool).PoolParams.Amplification)
+}
@@ -379,6 +379,18 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) SetLastLiquidityUpdate(newT
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastLiquidityUpdate", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetLastLiquidityUpdate), newTime)
 }
 
+// SetSpreadFactor mocks base method.
+func (m *MockConcentratedPoolExtension) SetSpreadFactor(newSpreadFactor osmomath.Dec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSpreadFactor", newSpreadFactor)
+}
+
+// SetSpreadFactor indicates an expected call of SetSpreadFactor.
+func (mr *MockConcentratedPoolExtensionMockRecorder) SetSpreadFactor(newSpreadFactor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpreadFactor", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).SetSpreadFactor), newSpreadFactor)
+}
+
 // SetTickSpacing mocks base method.
 func (m *MockConcentratedPoolExtension) SetTickSpacing(newTickSpacing uint64) {
 	m.ctrl.T.Helper()
@@ -174,6 +174,11 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwap", &twapquerytypes.VolumeWeightedTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolumeWeightedTwapToNow", &twapquerytypes.VolumeWeightedTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MedianTwap", &twapquerytypes.MedianTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MedianTwapToNow", &twapquerytypes.MedianTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/OraclePrice", &twapquerytypes.OraclePriceResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -0,0 +1,11 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock settles the range orders crossed by swaps and updates the dynamic spread factors at the end of every block.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	k.settleQueuedRangeOrders(ctx)
+	k.updateDynamicSpreadFactors(ctx)
+}
@@ -0,0 +1,260 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkprefix "cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// SetPositionAutoCompound opts the position with the given id in or out of auto-compounding.
+// Auto-compounding positions have their spread rewards claimed and added back to the position
+// at the end of every auto-compound epoch. Superfluid staked positions can not be opted in,
+// since adding liquidity to them must go through the superfluid module. Adding to the position, including by
+// compounding it, carries the opt-in over to the new position.
+// Opting out a position that is not auto-compounding is a no-op.
+// Returns error if the position does not exist or is not owned by the given owner.
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, enabled bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if position.Address != owner.String() {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		positionHasActiveUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if positionHasActiveUnderlyingLock {
+			return types.PositionSuperfluidStakedError{PositionId: positionId}
+		}
+		store.Set(types.KeyAutoCompoundPosition(positionId), []byte{})
+	} else {
+		store.Delete(types.KeyAutoCompoundPosition(positionId))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	})
+
+	return nil
+}
+
+// IsPositionAutoCompounding returns true if the position with the given id is opted in to auto-compounding.
+func (k Keeper) IsPositionAutoCompounding(ctx sdk.Context, positionId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyAutoCompoundPosition(positionId))
+}
+
+// compoundPositions compounds the spread rewards of the auto-compounding positions, in order of position id.
+// Compounding resumes after the last position compounded at the previous epoch, and stops once the gas consumed
+// by this call reaches the auto-compound gas budget. At least one position is compounded per call so that
+// compounding always progresses. The positions that were not reached are compounded first at the next epoch.
+// A failure to compound a position reverts the compounding of that position only, and its spread rewards
+// remain claimable.
+func (k Keeper) compoundPositions(ctx sdk.Context) {
+	gasBudget := k.GetParams(ctx).AutoCompoundGasBudget
+	if gasBudget == 0 {
+		return
+	}
+
+	gasStart := ctx.GasMeter().GasConsumed()
+	lastPositionId := k.getAutoCompoundCursor(ctx)
+	for {
+		positionId, found := k.getNextAutoCompoundPositionId(ctx, lastPositionId)
+		if !found {
+			// All positions have been compounded, start from the first position at the next epoch.
+			lastPositionId = 0
+			break
+		}
+
+		_ = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(ctx sdk.Context) error {
+			return k.compoundPosition(ctx, positionId)
+		})
+		lastPositionId = positionId
+
+		if ctx.GasMeter().GasConsumed()-gasStart >= gasBudget {
+			break
+		}
+	}
+
+	k.setAutoCompoundCursor(ctx, lastPositionId)
+}
+
+// compoundPosition claims the spread rewards of the position with the given id and adds them back to the position.
+// The claimed amounts are first brought to the token ratio of the position range by swapping the surplus side
+// through the pool. Any amount that could not be added to the position is left to the owner.
+// Like MsgAddToPosition, adding to the position replaces it with a new position with a fresh join time, which
+// remains opted in to auto-compounding.
+// If the position no longer exists, it is removed from the auto-compounding positions. Positions younger than the
+// minimum spread rewards position age are skipped, their spread rewards remain claimable.
+func (k Keeper) compoundPosition(ctx sdk.Context, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		if _, ok := err.(types.PositionIdNotFoundError); ok {
+			ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundPosition(positionId))
+			return nil
+		}
+		return err
+	}
+
+	// The position might have been superfluid staked after opting in.
+	positionHasActiveUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	// Claiming the spread rewards of a position younger than the minimum spread rewards position age would forfeit
+	// them, so the position is skipped until it is old enough.
+	if ctx.BlockTime().Sub(k.getPositionSpreadRewardEligibilityTime(ctx, position)) < k.GetParams(ctx).MinSpreadRewardsPositionAge {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	spreadRewardsClaimed, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return err
+	}
+	if spreadRewardsClaimed.IsZero() {
+		return nil
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return err
+	}
+
+	tokensProvided := spreadRewardsClaimed
+	tokenIn, tokenOutDenom := getRebalanceSwap(pool, sqrtPriceLowerTick, sqrtPriceUpperTick, tokensProvided.AmountOf(pool.GetToken0()), tokensProvided.AmountOf(pool.GetToken1()))
+	if tokenIn.IsPositive() {
+		// The surplus might be too small to be swapped, in which case it is left to the owner.
+		err := osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(ctx sdk.Context) error {
+			tokenOutAmount, _, err := k.poolmanagerKeeper.SwapExactAmountIn(ctx, owner, position.PoolId, tokenIn, tokenOutDenom, osmomath.OneInt())
+			if err != nil {
+				return err
+			}
+			tokensProvided = tokensProvided.Sub(tokenIn).Add(sdk.NewCoin(tokenOutDenom, tokenOutAmount))
+			return nil
+		})
+		if err == nil {
+			// The swap moves the current sqrt price of the pool.
+			pool, err = k.getPoolById(ctx, position.PoolId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, tokensProvided.AmountOf(pool.GetToken0()), tokensProvided.AmountOf(pool.GetToken1()))
+	if !liquidityDelta.IsPositive() {
+		// Revert the claim so that the spread rewards keep accruing until they can be compounded.
+		return types.ErrZeroLiquidity
+	}
+
+	// The tokens are added through addToPosition so that the added liquidity gets a fresh join time and the pool hooks
+	// of the pool are called, as for any other position the owner adds to.
+	newPositionId, _, _, err := k.addToPosition(ctx, owner, positionId, tokensProvided.AmountOf(pool.GetToken0()), tokensProvided.AmountOf(pool.GetToken1()), osmomath.ZeroInt(), osmomath.ZeroInt())
+	if err != nil {
+		return err
+	}
+	newPosition, err := k.GetPosition(ctx, newPositionId)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCompoundPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeySpreadRewardsClaimed, spreadRewardsClaimed.String()),
+			sdk.NewAttribute(types.AttributeLiquidity, newPosition.Liquidity.Sub(position.Liquidity).String()),
+		),
+	})
+
+	return nil
+}
+
+// getNextAutoCompoundPositionId returns the smallest auto-compounding position id greater than the given position id.
+// Returns false if there is no such position.
+func (k Keeper) getNextAutoCompoundPositionId(ctx sdk.Context, positionId uint64) (uint64, bool) {
+	prefixStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundPositionPrefix)
+	iter := prefixStore.Iterator(sdk.Uint64ToBigEndian(positionId+1), nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iter.Key()), true
+}
+
+// getAllAutoCompoundPositionIds returns the ids of all auto-compounding positions in ascending order.
+func (k Keeper) getAllAutoCompoundPositionIds(ctx sdk.Context) []uint64 {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AutoCompoundPositionPrefix)
+	defer iter.Close()
+
+	positionIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iter.Key()[len(types.AutoCompoundPositionPrefix):]))
+	}
+	return positionIds
+}
+
+// initAutoCompoundPositions opts the positions with the given ids in to auto-compounding.
+// Returns error if any of the positions does not exist.
+func (k Keeper) initAutoCompoundPositions(ctx sdk.Context, positionIds []uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, positionId := range positionIds {
+		if _, err := k.GetPosition(ctx, positionId); err != nil {
+			return fmt.Errorf("auto-compounding position (%d) does not exist: %w", positionId, err)
+		}
+		store.Set(types.KeyAutoCompoundPosition(positionId), []byte{})
+	}
+	return nil
+}
+
+// getAutoCompoundCursor returns the id of the last position compounded at the previous auto-compound epoch,
+// or zero if the previous epoch compounded all positions.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAutoCompoundCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setAutoCompoundCursor sets the id of the last compounded position.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundCursor, sdk.Uint64ToBigEndian(positionId))
+}
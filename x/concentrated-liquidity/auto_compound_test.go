@@ -0,0 +1,255 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// accrueSpreadRewards swaps both ways through the given pool so that its positions accrue spread rewards in both tokens.
+func (s *KeeperTestSuite) accrueSpreadRewards(poolId uint64) {
+	swapper := s.TestAccs[2]
+	swaps := []struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+	}{
+		{tokenIn: sdk.NewCoin(USDC, osmomath.NewInt(10_000_000)), tokenOutDenom: ETH},
+		{tokenIn: sdk.NewCoin(ETH, osmomath.NewInt(2_000)), tokenOutDenom: USDC},
+	}
+	for _, swap := range swaps {
+		s.FundAcc(swapper, sdk.NewCoins(swap.tokenIn))
+		_, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, swapper, poolId, swap.tokenIn, swap.tokenOutDenom, osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+}
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	tests := map[string]struct {
+		sender        int
+		positionId    uint64
+		enabled       bool
+		expectedError error
+	}{
+		"enable": {
+			positionId: 1,
+			enabled:    true,
+		},
+		"disable": {
+			positionId: 1,
+			enabled:    false,
+		},
+		"error: sender is not the owner": {
+			sender:        1,
+			positionId:    1,
+			enabled:       true,
+			expectedError: types.NotPositionOwnerError{PositionId: 1, Address: s.TestAccs[1].String()},
+		},
+		"error: position does not exist": {
+			positionId:    2,
+			enabled:       true,
+			expectedError: types.PositionIdNotFoundError{PositionId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+			s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, !tc.enabled))
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			err := s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[tc.sender], tc.positionId, tc.enabled)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(!tc.enabled, s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.enabled, s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSetAutoCompound, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+
+	s.accrueSpreadRewards(pool.GetId())
+	s.AddBlockTime(time.Hour)
+
+	positionBefore, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test
+	err = s.App.ConcentratedLiquidityKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.DefaultAutoCompoundEpochIdentifier, 1)
+	s.Require().NoError(err)
+
+	// The spread rewards were added to the position the way MsgAddToPosition does, replacing it with a new position
+	// with a fresh join time that remains opted in to auto-compounding.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtAddToPosition, 1)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+	s.Require().False(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+	newPositionId := s.App.ConcentratedLiquidityKeeper.GetNextPositionId(s.Ctx) - 1
+	positionAfter, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(owner.String(), positionAfter.Address)
+	s.Require().True(positionAfter.Liquidity.GT(positionBefore.Liquidity))
+	s.Require().Equal(s.Ctx.BlockTime(), positionAfter.JoinTime)
+	s.Require().True(positionAfter.JoinTime.After(positionBefore.JoinTime))
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, newPositionId))
+
+	// No spread rewards are left to claim and the owner received at most the leftover of the compounding.
+	spreadRewards, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(spreadRewards.IsZero())
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, owner).IsAllGTE(ownerBalanceBefore))
+
+	// All positions were compounded so the next epoch starts from the first position.
+	s.Require().Equal(uint64(0), s.App.ConcentratedLiquidityKeeper.GetAutoCompoundCursor(s.Ctx))
+
+	// Other epochs do not compound.
+	s.accrueSpreadRewards(pool.GetId())
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = s.App.ConcentratedLiquidityKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 0)
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_GasBudget() {
+	pool := s.PrepareConcentratedPool()
+	owner := s.TestAccs[0]
+	positionIds := make([]uint64, 3)
+	for i := range positionIds {
+		positionIds[i] = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+		s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionIds[i], true))
+	}
+
+	// A zero budget disables auto-compounding.
+	s.App.ConcentratedLiquidityKeeper.SetParam(s.Ctx, types.KeyAutoCompoundGasBudget, uint64(0))
+	s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+	s.Require().Equal(uint64(0), s.App.ConcentratedLiquidityKeeper.GetAutoCompoundCursor(s.Ctx))
+
+	// The smallest budget is exhausted by a single position, so each epoch compounds the next position.
+	s.App.ConcentratedLiquidityKeeper.SetParam(s.Ctx, types.KeyAutoCompoundGasBudget, uint64(1))
+	for _, positionId := range positionIds {
+		s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+		s.Require().Equal(positionId, s.App.ConcentratedLiquidityKeeper.GetAutoCompoundCursor(s.Ctx))
+	}
+
+	// Once the last position is reached, the next epoch starts over.
+	s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+	s.Require().Equal(uint64(0), s.App.ConcentratedLiquidityKeeper.GetAutoCompoundCursor(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_DeletedPosition() {
+	pool := s.PrepareConcentratedPool()
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	s.Require().False(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+	s.Require().Empty(s.App.ConcentratedLiquidityKeeper.GetAllAutoCompoundPositionIds(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_YoungPosition() {
+	const minPositionAge = time.Hour
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.MinSpreadRewardsPositionAge = minPositionAge
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+	s.accrueSpreadRewards(pool.GetId())
+
+	positionBefore, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.AddBlockTime(minPositionAge - time.Second)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test
+	s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+
+	// The position is too young to be compounded, and its spread rewards are not forfeited.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 0)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCollectSpreadRewards, 0)
+	positionAfter, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.Liquidity, positionAfter.Liquidity)
+
+	// Once old enough, the position is compounded with all of its spread rewards.
+	s.AddBlockTime(time.Second)
+	spreadRewards, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+
+	s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 1)
+	positionAfter, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, s.App.ConcentratedLiquidityKeeper.GetNextPositionId(s.Ctx)-1)
+	s.Require().NoError(err)
+	s.Require().True(positionAfter.Liquidity.GT(positionBefore.Liquidity))
+}
+
+func (s *KeeperTestSuite) TestCompoundPositions_PoolHooks() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+	s.accrueSpreadRewards(pool.GetId())
+
+	// Creating positions fails as long as the hook is set, since the hook is not a contract.
+	createHook := types.PoolHook{ActionPrefix: types.BeforeActionPrefix(types.CreatePositionPrefix), ContractAddress: s.TestAccs[0].String()}
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPoolHook(s.Ctx, pool.GetId(), createHook))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test
+	s.App.ConcentratedLiquidityKeeper.CompoundPositions(s.Ctx)
+
+	// The hooks of adding to the position are called, so the compounding fails and the position is left unchanged.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundPosition, 0)
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+	spreadRewards, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+}
+
+func (s *KeeperTestSuite) TestAddToPosition_AutoCompound() {
+	pool := s.PrepareConcentratedPool()
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+	s.FundAcc(owner, DefaultCoins)
+
+	// System under test
+	newPositionId, _, _, err := s.App.ConcentratedLiquidityKeeper.AddToPosition(s.Ctx, owner, positionId, DefaultAmt0, DefaultAmt1, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	// The opt-in carries over to the new position.
+	s.Require().False(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompounding(s.Ctx, newPositionId))
+}
@@ -0,0 +1,212 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// batchTransfersKey is the context key of the batchTransfers of the batch of position operations being executed.
+type batchTransfersKey struct{}
+
+// batchTransfers records the token transfers between the sender of a batch of position operations
+// and the addresses of the pools, which are settled at once after all the operations are executed.
+type batchTransfers struct {
+	sender sdk.AccAddress
+	// counterparties are the addresses the sender transfers tokens with, in order of their first transfer.
+	counterparties []sdk.AccAddress
+	// sentTo and receivedFrom are the tokens sent by the sender to and received by the sender
+	// from each counterparty, keyed by the address of the counterparty.
+	sentTo       map[string]sdk.Coins
+	receivedFrom map[string]sdk.Coins
+}
+
+func newBatchTransfers(sender sdk.AccAddress) *batchTransfers {
+	return &batchTransfers{
+		sender:       sender,
+		sentTo:       map[string]sdk.Coins{},
+		receivedFrom: map[string]sdk.Coins{},
+	}
+}
+
+// record records the transfer of the given coins between the given addresses if one of them is the sender.
+// Returns true if the transfer was recorded.
+func (b *batchTransfers) record(from, to sdk.AccAddress, coins sdk.Coins) bool {
+	var counterparty sdk.AccAddress
+	switch {
+	case from.Equals(b.sender) && !to.Equals(b.sender):
+		counterparty = to
+		b.sentTo[counterparty.String()] = b.sentTo[counterparty.String()].Add(coins...)
+	case to.Equals(b.sender) && !from.Equals(b.sender):
+		counterparty = from
+		b.receivedFrom[counterparty.String()] = b.receivedFrom[counterparty.String()].Add(coins...)
+	default:
+		return false
+	}
+
+	for _, address := range b.counterparties {
+		if address.Equals(counterparty) {
+			return true
+		}
+	}
+	b.counterparties = append(b.counterparties, counterparty)
+	return true
+}
+
+// sendCoins sends the given coins from one address to another. While a batch of position operations
+// is being executed, the transfers to and from its sender are recorded to be settled after all the
+// operations are executed instead.
+func (k Keeper) sendCoins(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if transfers, ok := ctx.Value(batchTransfersKey{}).(*batchTransfers); ok && transfers.record(from, to, coins) {
+		return nil
+	}
+	return k.bankKeeper.SendCoins(ctx, from, to, coins)
+}
+
+// batchPositionOps executes the given position operations of the sender in order, returning their results.
+// The token transfers between the sender and the pools are netted per denom and settled once all the
+// operations are executed, so that the sender sends and receives tokens at most once each. This allows,
+// for example, funding a position with the tokens withdrawn from another position in the same batch.
+// Note that the bank balances of the pools are only updated when settling.
+// Returns the tokens sent and received by the sender.
+// Returns error if any of the operations fails or if the sender can not pay for the tokens sent.
+func (k Keeper) batchPositionOps(ctx sdk.Context, sender sdk.AccAddress, ops []types.PositionOp) ([]types.PositionOpResult, sdk.Coins, sdk.Coins, error) {
+	transfers := newBatchTransfers(sender)
+	batchCtx := ctx.WithValue(batchTransfersKey{}, transfers)
+
+	results := make([]types.PositionOpResult, 0, len(ops))
+	for i, op := range ops {
+		result, err := k.executePositionOp(batchCtx, sender, op)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("position op %d failed: %w", i, err)
+		}
+		results = append(results, result)
+	}
+
+	tokensIn, tokensOut, err := k.settleBatchTransfers(ctx, transfers)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtBatchPositionOps,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, tokensIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+		),
+	})
+
+	return results, tokensIn, tokensOut, nil
+}
+
+// executePositionOp executes the given position operation of the sender like its equivalent message.
+func (k Keeper) executePositionOp(ctx sdk.Context, sender sdk.AccAddress, op types.PositionOp) (types.PositionOpResult, error) {
+	switch {
+	case op.CreatePosition != nil:
+		create := op.CreatePosition
+		positionData, err := k.CreatePosition(ctx, create.PoolId, sender, create.TokensProvided, create.TokenMinAmount0, create.TokenMinAmount1, create.LowerTick, create.UpperTick)
+		if err != nil {
+			return types.PositionOpResult{}, err
+		}
+		return types.PositionOpResult{CreatePosition: &types.MsgCreatePositionResponse{
+			PositionId:       positionData.ID,
+			Amount0:          positionData.Amount0,
+			Amount1:          positionData.Amount1,
+			LiquidityCreated: positionData.Liquidity,
+			LowerTick:        positionData.LowerTick,
+			UpperTick:        positionData.UpperTick,
+		}}, nil
+	case op.WithdrawPosition != nil:
+		amount0, amount1, err := k.WithdrawPosition(ctx, sender, op.WithdrawPosition.PositionId, op.WithdrawPosition.LiquidityAmount)
+		if err != nil {
+			return types.PositionOpResult{}, err
+		}
+		return types.PositionOpResult{WithdrawPosition: &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}}, nil
+	case op.AddToPosition != nil:
+		add := op.AddToPosition
+		tokenMinAmount0, tokenMinAmount1 := add.TokenMinAmount0, add.TokenMinAmount1
+		if tokenMinAmount0.IsNil() {
+			tokenMinAmount0 = osmomath.ZeroInt()
+		}
+		if tokenMinAmount1.IsNil() {
+			tokenMinAmount1 = osmomath.ZeroInt()
+		}
+		positionId, amount0, amount1, err := k.addToPosition(ctx, sender, add.PositionId, add.Amount0, add.Amount1, tokenMinAmount0, tokenMinAmount1)
+		if err != nil {
+			return types.PositionOpResult{}, err
+		}
+		return types.PositionOpResult{AddToPosition: &types.MsgAddToPositionResponse{PositionId: positionId, Amount0: amount0, Amount1: amount1}}, nil
+	case op.CollectRewards != nil:
+		spreadRewards, err := k.collectSpreadRewards(ctx, sender, op.CollectRewards.PositionId)
+		if err != nil {
+			return types.PositionOpResult{}, err
+		}
+		incentives, forfeitedIncentives, _, err := k.collectIncentives(ctx, sender, op.CollectRewards.PositionId)
+		if err != nil {
+			return types.PositionOpResult{}, err
+		}
+		return types.PositionOpResult{
+			CollectSpreadRewards: &types.MsgCollectSpreadRewardsResponse{CollectedSpreadRewards: spreadRewards},
+			CollectIncentives:    &types.MsgCollectIncentivesResponse{CollectedIncentives: incentives, ForfeitedIncentives: forfeitedIncentives},
+		}, nil
+	default:
+		return types.PositionOpResult{}, fmt.Errorf("position op has no operation set")
+	}
+}
+
+// settleBatchTransfers settles the given recorded transfers through the batch escrow address. Each counterparty
+// first sends the tokens it owes on net, then the sender sends the tokens it owes on net, then each counterparty
+// receives the tokens it is owed on net, and finally the sender receives the tokens it is owed on net.
+// Returns the tokens sent and received by the sender.
+func (k Keeper) settleBatchTransfers(ctx sdk.Context, transfers *batchTransfers) (sdk.Coins, sdk.Coins, error) {
+	escrowAddress := types.BatchPositionOpsEscrowAddress
+
+	totalSent, totalReceived := sdk.Coins{}, sdk.Coins{}
+	owedToCounterparties := make([]sdk.Coins, len(transfers.counterparties))
+	for i, counterparty := range transfers.counterparties {
+		sent, received := transfers.sentTo[counterparty.String()], transfers.receivedFrom[counterparty.String()]
+		totalSent, totalReceived = totalSent.Add(sent...), totalReceived.Add(received...)
+
+		owedToCounterparty, owedByCounterparty := netCoins(sent, received)
+		owedToCounterparties[i] = owedToCounterparty
+		if !owedByCounterparty.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, counterparty, escrowAddress, owedByCounterparty); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	tokensIn, tokensOut := netCoins(totalSent, totalReceived)
+	if !tokensIn.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, transfers.sender, escrowAddress, tokensIn); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for i, counterparty := range transfers.counterparties {
+		if !owedToCounterparties[i].IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, escrowAddress, counterparty, owedToCounterparties[i]); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if !tokensOut.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, transfers.sender, tokensOut); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return tokensIn, tokensOut, nil
+}
+
+// netCoins returns the amounts by which a exceeds b and by which b exceeds a, per denom.
+func netCoins(a, b sdk.Coins) (sdk.Coins, sdk.Coins) {
+	common := a.Min(b)
+	return a.Sub(common...), b.Sub(common...)
+}
@@ -0,0 +1,175 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// countTransfers returns the number of bank transfer events with the given address as the given attribute.
+func (s *KeeperTestSuite) countTransfers(ctx sdk.Context, attributeKey string, address sdk.AccAddress) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		if attribute, ok := event.GetAttribute(attributeKey); ok && attribute.Value == address.String() {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *KeeperTestSuite) TestBatchPositionOps() {
+	s.SetupTest()
+	owner, otherLP := s.TestAccs[0], s.TestAccs[1]
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	msgServer := cl.NewMsgServerImpl(clKeeper)
+
+	poolOne := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	poolTwo := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	s.SetupFullRangePositionAcc(poolOne.GetId(), otherLP)
+	s.SetupFullRangePositionAcc(poolTwo.GetId(), otherLP)
+	withdrawnPositionId := s.SetupDefaultPositionAcc(poolOne.GetId(), owner)
+	addedToPositionId := s.SetupDefaultPositionAcc(poolTwo.GetId(), owner)
+	s.accrueSpreadRewards(poolOne.GetId())
+
+	withdrawnLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, withdrawnPositionId)
+	s.Require().NoError(err)
+	claimableSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, withdrawnPositionId)
+	s.Require().NoError(err)
+	s.Require().False(claimableSpreadRewards.IsZero())
+
+	// The owner funds the batch with tokens withdrawn from a position, so it only needs to
+	// hold the tokens provided beyond the withdrawn ones.
+	extraCoins := sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000_000)))
+	s.FundAcc(owner, extraCoins)
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	poolOneBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, poolOne.GetAddress())
+	poolTwoBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, poolTwo.GetAddress())
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	msg := &types.MsgBatchPositionOps{
+		Sender: owner.String(),
+		Ops: []types.PositionOp{
+			{CollectRewards: &types.CollectRewardsOp{PositionId: withdrawnPositionId}},
+			{WithdrawPosition: &types.WithdrawPositionOp{PositionId: withdrawnPositionId, LiquidityAmount: withdrawnLiquidity}},
+			{AddToPosition: &types.AddToPositionOp{PositionId: addedToPositionId, Amount0: DefaultAmt0, Amount1: DefaultAmt1, TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()}},
+			{CreatePosition: &types.CreatePositionOp{
+				PoolId:          poolTwo.GetId(),
+				LowerTick:       DefaultLowerTick,
+				UpperTick:       DefaultUpperTick,
+				TokensProvided:  extraCoins,
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			}},
+		},
+	}
+	s.Require().NoError(msg.ValidateBasic())
+
+	// System under test
+	response, err := msgServer.BatchPositionOps(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	// Each operation returns its result in order.
+	s.Require().Len(response.Results, len(msg.Ops))
+	s.Require().Equal(claimableSpreadRewards, response.Results[0].CollectSpreadRewards.CollectedSpreadRewards)
+	s.Require().NotNil(response.Results[0].CollectIncentives)
+	withdrawn := response.Results[1].WithdrawPosition
+	s.Require().NotNil(withdrawn)
+	addedTo := response.Results[2].AddToPosition
+	s.Require().NotNil(addedTo)
+	created := response.Results[3].CreatePosition
+	s.Require().NotNil(created)
+	_, err = clKeeper.GetPosition(s.Ctx, withdrawnPositionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: withdrawnPositionId})
+	_, err = clKeeper.GetPosition(s.Ctx, addedTo.PositionId)
+	s.Require().NoError(err)
+	_, err = clKeeper.GetPosition(s.Ctx, created.PositionId)
+	s.Require().NoError(err)
+
+	// The pools hold the tokens of their positions once settled. Adding to a position withdraws it
+	// and creates it anew with the total amounts, so only the added tokens are netted into the pool.
+	poolOneBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, poolOne.GetAddress())
+	withdrawnCoins := sdk.NewCoins(sdk.NewCoin(ETH, withdrawn.Amount0), sdk.NewCoin(USDC, withdrawn.Amount1))
+	s.Require().Equal(poolOneBalanceBefore.Sub(withdrawnCoins...), poolOneBalanceAfter)
+	poolTwoBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, poolTwo.GetAddress())
+	sent := poolTwoBalanceAfter.Sub(poolTwoBalanceBefore...)
+	s.Require().True(sent.IsAllGTE(sdk.NewCoins(sdk.NewCoin(ETH, created.Amount0), sdk.NewCoin(USDC, created.Amount1))))
+
+	// The tokens sent and received by the owner are netted per denom.
+	received := claimableSpreadRewards.Add(withdrawnCoins...)
+	s.Require().Equal(sent.Sub(sent.Min(received)...), response.TokensIn)
+	s.Require().Equal(received.Sub(sent.Min(received)...), response.TokensOut)
+	s.Require().False(response.TokensIn.IsZero())
+
+	// The owner sends and receives tokens at most once, through the escrow whose balance is zero once settled.
+	ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Require().Equal(ownerBalanceBefore.Add(response.TokensOut...).Sub(response.TokensIn...), ownerBalanceAfter)
+	s.Require().Equal(1, s.countTransfers(s.Ctx, banktypes.AttributeKeySender, owner))
+	s.Require().LessOrEqual(s.countTransfers(s.Ctx, banktypes.AttributeKeyRecipient, owner), 1)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.BatchPositionOpsEscrowAddress).IsZero())
+
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBatchPositionOps, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtWithdrawPosition, 2)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCreatePosition, 2)
+}
+
+func (s *KeeperTestSuite) TestBatchPositionOps_Errors() {
+	tests := map[string]struct {
+		ops                   func(positionId uint64) []types.PositionOp
+		expectedErrorContains string
+	}{
+		"error: op fails": {
+			ops: func(positionId uint64) []types.PositionOp {
+				return []types.PositionOp{
+					{CollectRewards: &types.CollectRewardsOp{PositionId: positionId}},
+					{WithdrawPosition: &types.WithdrawPositionOp{PositionId: positionId + 1, LiquidityAmount: osmomath.OneDec()}},
+				}
+			},
+			expectedErrorContains: "position op 1 failed",
+		},
+		"error: sender can not pay the net tokens sent": {
+			ops: func(positionId uint64) []types.PositionOp {
+				return []types.PositionOp{
+					{CreatePosition: &types.CreatePositionOp{
+						PoolId:          1,
+						LowerTick:       DefaultLowerTick,
+						UpperTick:       DefaultUpperTick,
+						TokensProvided:  DefaultCoins,
+						TokenMinAmount0: osmomath.ZeroInt(),
+						TokenMinAmount1: osmomath.ZeroInt(),
+					}},
+				}
+			},
+			expectedErrorContains: "insufficient funds",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			owner := s.TestAccs[0]
+			pool := s.PrepareConcentratedPool()
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			poolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+
+			// Executes the batch in a cache context, as a transaction would.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			// System under test
+			response, err := msgServer.BatchPositionOps(cacheCtx, &types.MsgBatchPositionOps{Sender: owner.String(), Ops: tc.ops(positionId)})
+
+			s.Require().ErrorContains(err, tc.expectedErrorContains)
+			s.Require().Nil(response)
+			s.Require().Equal(poolBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -8,6 +8,13 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagRangeOrderStatus           = "status"
+	FlagDynamicSpreadFactorRecords = "dynamic-spread-factor-records"
+	FlagDisabledPoolIds            = "disabled-pool-ids"
+	FlagPricePoolIds               = "price-pool-ids"
+	FlagPoolHooks                  = "pool-hooks"
+	FlagRemovedActionPrefixes      = "removed-action-prefixes"
+	FlagHookFailurePolicy          = "hook-failure-policy"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +22,15 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetRangeOrderStatus() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagRangeOrderStatus, "0", "The status of the range orders to return: 1 for open, 2 for filled, 0 for all")
+	return fs
+}
+
+func FlagSetPricePoolIds() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPricePoolIds, "", "The comma-separated ids of the pools, in addition to the queried pool, whose twap prices the reward denoms in the quote denom")
+	return fs
+}
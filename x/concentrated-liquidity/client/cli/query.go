@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
@@ -23,6 +25,12 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRangeOrdersByOwner)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRangeOrdersByPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquiditySnapshots)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetDynamicSpreadFactor)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRangeApr)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolHooks)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +154,93 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetRangeOrdersByOwner() (*osmocli.QueryDescriptor, *queryproto.RangeOrdersByOwnerRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "range-orders-by-owner",
+			Short: "Query the range orders of an owner, optionally filtered by pool and status",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} range-orders-by-owner osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj --pool-id 1 --status 1`,
+			Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId(), FlagSetRangeOrderStatus()}},
+			CustomFlagOverrides: map[string]string{
+				"poolid": FlagPoolId,
+				"status": FlagRangeOrderStatus,
+			},
+		},
+		&queryproto.RangeOrdersByOwnerRequest{}
+}
+
+func GetRangeOrdersByPool() (*osmocli.QueryDescriptor, *queryproto.RangeOrdersByPoolRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "range-orders-by-pool",
+			Short: "Query the range orders of a pool, optionally filtered by status",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} range-orders-by-pool 1 --status 2`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetRangeOrderStatus()}},
+			CustomFlagOverrides: map[string]string{"status": FlagRangeOrderStatus},
+		},
+		&queryproto.RangeOrdersByPoolRequest{}
+}
+
+func GetLiquiditySnapshots() (*osmocli.QueryDescriptor, *queryproto.LiquiditySnapshotsRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "liquidity-snapshots",
+			Short: "Query the liquidity snapshots of a pool taken between a start and an end unix time",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-snapshots 1 1700000000 1702592000`,
+		},
+		&queryproto.LiquiditySnapshotsRequest{}
+}
+
+func GetDynamicSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.DynamicSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "dynamic-spread-factor",
+			Short: "Query the dynamic spread factor of a pool and its current effective spread factor",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} dynamic-spread-factor 1`,
+		},
+		&queryproto.DynamicSpreadFactorRequest{}
+}
+
+func GetRangeApr() (*osmocli.QueryDescriptor, *queryproto.RangeAprRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "range-apr",
+			Short: "Query the TVL of a pool and the estimated APR of a tick range over a lookback, priced in a quote denom",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} range-apr 1 [-1000] 1000 168h uosmo --price-pool-ids 2,3`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPricePoolIds()}},
+			CustomFlagOverrides: map[string]string{"pricepoolids": FlagPricePoolIds},
+			CustomFieldParsers:  map[string]osmocli.CustomFieldParserFn{"pricepoolids": osmocli.FlagOnlyParser(parsePricePoolIds)},
+		},
+		&queryproto.RangeAprRequest{}
+}
+
+func GetPoolHooks() (*osmocli.QueryDescriptor, *queryproto.PoolHooksRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "pool-hooks",
+			Short: "Query the hook failure policy and the configured hooks of a pool, with the last ignored error of each hook",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-hooks 1`,
+		},
+		&queryproto.PoolHooksRequest{}
+}
+
+// parsePricePoolIds parses the comma-separated price pool ids from the price pool ids flag.
+func parsePricePoolIds(fs *flag.FlagSet) ([]uint64, error) {
+	pricePoolIdsStr, err := fs.GetString(FlagPricePoolIds)
+	if err != nil {
+		return nil, err
+	}
+	poolIds := []uint64{}
+	if pricePoolIdsStr == "" {
+		return poolIds, nil
+	}
+	for _, poolIdStr := range strings.Split(pricePoolIdsStr, ",") {
+		poolId, err := osmocli.ParseUint(poolIdStr, FlagPricePoolIds)
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, nil
+}
@@ -31,6 +31,13 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewRebalancePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
+	osmocli.AddTxCmd(txCmd, NewMintPositionTokenCmd)
+	osmocli.AddTxCmd(txCmd, NewRedeemPositionTokenCmd)
 	return txCmd
 }
 
@@ -103,6 +110,65 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewPlaceRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-range-order",
+		Short:   "place a range order selling the given token over the single tick spacing range starting at the given lower tick",
+		Long:    "token0 orders must be placed above the current tick and token1 orders below it. The order is withdrawn automatically once the price crosses it, and its proceeds can then be claimed.",
+		Example: "osmosisd tx concentratedliquidity place-range-order 1 \"[-69100]\" 10000uion --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceRangeOrder{}
+}
+
+func NewClaimRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-range-order",
+		Short:   "claim the proceeds of a filled range order",
+		Example: "osmosisd tx concentratedliquidity claim-range-order 53 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimRangeOrder{}
+}
+
+func NewCancelRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-range-order",
+		Short:   "cancel an open range order, withdrawing its position",
+		Example: "osmosisd tx concentratedliquidity cancel-range-order 53 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelRangeOrder{}
+}
+
+func NewRebalancePositionCmd() (*osmocli.TxCliDesc, *types.MsgRebalancePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "rebalance-position",
+		Short:   "move an existing concentrated liquidity position to a new tick range, carrying over its unclaimed rewards",
+		Long:    "If swap-imbalance is true, the withdrawn tokens that do not fit the new range at the current price are swapped through the same pool before recreating the position.",
+		Example: "osmosisd tx concentratedliquidity rebalance-position 10 \"[-69082]\" 69082 true 0 0 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRebalancePosition{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound",
+		Short:   "opt a concentrated liquidity position in or out of auto-compounding its spread rewards",
+		Long:    "Auto-compounding positions have their spread rewards claimed and added back to the position at the end of every auto-compound epoch.",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 10 true --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func NewMintPositionTokenCmd() (*osmocli.TxCliDesc, *types.MsgMintPositionToken) {
+	return &osmocli.TxCliDesc{
+		Use:     "mint-position-token",
+		Short:   "tokenize a concentrated liquidity position, minting a cl/position/{position-id} token that confers its ownership",
+		Example: "osmosisd tx concentratedliquidity mint-position-token 10 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgMintPositionToken{}
+}
+
+func NewRedeemPositionTokenCmd() (*osmocli.TxCliDesc, *types.MsgRedeemPositionToken) {
+	return &osmocli.TxCliDesc{
+		Use:     "redeem-position-token",
+		Short:   "burn the token of a tokenized concentrated liquidity position and take ownership of the position",
+		Example: "osmosisd tx concentratedliquidity redeem-position-token 10 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRedeemPositionToken{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,6 +256,107 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a dynamic spread factor proposal",
+		Long: strings.TrimSpace(`Submit a dynamic spread factor proposal.
+
+Passing in FlagDynamicSpreadFactorRecords separated by commas would be parsed automatically to records of
+pool id, min spread factor, max spread factor and volatility multiplier. Each record enables or updates the
+dynamic spread factor of its pool.
+Ex) --dynamic-spread-factor-records=1,0.001,0.01,2,5,0.0005,0.003,1 ->
+[(poolId 1, minSpreadFactor 0.1%, maxSpreadFactor 1%, volatilityMultiplier 2), (poolId 5, minSpreadFactor 0.05%, maxSpreadFactor 0.3%, volatilityMultiplier 1)]
+Passing in FlagDisabledPoolIds separated by commas disables the dynamic spread factor of the given pools.
+Ex) --disabled-pool-ids=2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDynamicSpreadFactorArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagDynamicSpreadFactorRecords, "", "The dynamic spread factor records array")
+	cmd.Flags().String(FlagDisabledPoolIds, "", "The ids of the pools to disable the dynamic spread factor of")
+
+	return cmd
+}
+
+func NewPoolHooksProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-hooks-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a pool hooks proposal",
+		Long: strings.TrimSpace(`Submit a pool hooks proposal.
+
+Passing in FlagPoolHooks separated by commas would be parsed automatically to hooks of
+action prefix, contract address and gas limit. Each hook is set on the pool, replacing the hook of the same action.
+A gas limit of zero defaults to the hook gas limit param.
+Ex) --pool-hooks=beforeSwapExactAmountIn,osmo1...,500000,afterCollectIncentives,osmo1...,0
+Passing in FlagRemovedActionPrefixes separated by commas removes the hooks of the given actions from the pool.
+Ex) --removed-action-prefixes=beforeCreatePosition,afterCreatePosition
+FlagHookFailurePolicy is either "revert", failing the action that triggered a failed hook, or "ignore",
+letting the action succeed and recording the error of the hook.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parsePoolHooksArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Uint64(FlagPoolId, 0, "The id of the pool to configure the hooks of")
+	cmd.Flags().String(FlagPoolHooks, "", "The pool hooks array")
+	cmd.Flags().String(FlagRemovedActionPrefixes, "", "The actions to remove the hooks of")
+	cmd.Flags().String(FlagHookFailurePolicy, "revert", "The hook failure policy of the pool: revert or ignore")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -317,3 +484,175 @@ func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 
 	return finalPoolRecords, nil
 }
+
+func parseDynamicSpreadFactorArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := parseDynamicSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledPoolIdsStr, err := cmd.Flags().GetString(FlagDisabledPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	disabledPoolIds := []uint64{}
+	if disabledPoolIdsStr != "" {
+		for _, poolIdStr := range strings.Split(disabledPoolIdsStr, ",") {
+			poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			disabledPoolIds = append(disabledPoolIds, poolId)
+		}
+	}
+
+	content := &types.DynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Records:         records,
+		DisabledPoolIds: disabledPoolIds,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorRecords(cmd *cobra.Command) ([]types.DynamicSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []types.DynamicSpreadFactorRecord{}
+	if recordsStr == "" {
+		return records, nil
+	}
+
+	fields := strings.Split(recordsStr, ",")
+	if len(fields)%4 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorRecords must be a list of poolId, minSpreadFactor, maxSpreadFactor, and volatilityMultiplier")
+	}
+
+	for i := 0; i < len(fields); i += 4 {
+		poolId, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		minSpreadFactor, err := osmomath.NewDecFromStr(fields[i+1])
+		if err != nil {
+			return nil, err
+		}
+		maxSpreadFactor, err := osmomath.NewDecFromStr(fields[i+2])
+		if err != nil {
+			return nil, err
+		}
+		volatilityMultiplier, err := osmomath.NewDecFromStr(fields[i+3])
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, types.DynamicSpreadFactorRecord{
+			PoolId:               poolId,
+			MinSpreadFactor:      minSpreadFactor,
+			MaxSpreadFactor:      maxSpreadFactor,
+			VolatilityMultiplier: volatilityMultiplier,
+		})
+	}
+
+	return records, nil
+}
+
+func parsePoolHooksArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := parsePoolHooks(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	removedActionPrefixesStr, err := cmd.Flags().GetString(FlagRemovedActionPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	removedActionPrefixes := []string{}
+	if removedActionPrefixesStr != "" {
+		removedActionPrefixes = strings.Split(removedActionPrefixesStr, ",")
+	}
+
+	failurePolicyStr, err := cmd.Flags().GetString(FlagHookFailurePolicy)
+	if err != nil {
+		return nil, err
+	}
+	var failurePolicy types.PoolHookFailurePolicy
+	switch failurePolicyStr {
+	case "revert":
+		failurePolicy = types.PoolHookFailurePolicyRevert
+	case "ignore":
+		failurePolicy = types.PoolHookFailurePolicyIgnore
+	default:
+		return nil, fmt.Errorf("hook failure policy must be revert or ignore, got (%s)", failurePolicyStr)
+	}
+
+	content := &types.PoolHooksProposal{
+		Title:                 title,
+		Description:           description,
+		PoolId:                poolId,
+		Hooks:                 hooks,
+		RemovedActionPrefixes: removedActionPrefixes,
+		FailurePolicy:         failurePolicy,
+	}
+	return content, nil
+}
+
+func parsePoolHooks(cmd *cobra.Command) ([]types.PoolHook, error) {
+	hooksStr, err := cmd.Flags().GetString(FlagPoolHooks)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := []types.PoolHook{}
+	if hooksStr == "" {
+		return hooks, nil
+	}
+
+	fields := strings.Split(hooksStr, ",")
+	if len(fields)%3 != 0 {
+		return nil, fmt.Errorf("poolHooks must be a list of actionPrefix, contractAddress, and gasLimit")
+	}
+
+	for i := 0; i < len(fields); i += 3 {
+		gasLimit, err := strconv.ParseUint(fields[i+2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		hooks = append(hooks, types.PoolHook{
+			ActionPrefix:    fields[i],
+			ContractAddress: fields[i+1],
+			GasLimit:        gasLimit,
+		})
+	}
+
+	return hooks, nil
+}
@@ -51,6 +51,36 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) RangeOrdersByPool(grpcCtx context.Context,
+	req *queryproto.RangeOrdersByPoolRequest,
+) (*queryproto.RangeOrdersByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RangeOrdersByPool(ctx, *req)
+}
+
+func (q Querier) RangeOrdersByOwner(grpcCtx context.Context,
+	req *queryproto.RangeOrdersByOwnerRequest,
+) (*queryproto.RangeOrdersByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RangeOrdersByOwner(ctx, *req)
+}
+
+func (q Querier) RangeApr(grpcCtx context.Context,
+	req *queryproto.RangeAprRequest,
+) (*queryproto.RangeAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RangeApr(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
@@ -71,6 +101,16 @@ func (q Querier) Pools(grpcCtx context.Context,
 	return q.Q.Pools(ctx, *req)
 }
 
+func (q Querier) PoolHooks(grpcCtx context.Context,
+	req *queryproto.PoolHooksRequest,
+) (*queryproto.PoolHooksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolHooks(ctx, *req)
+}
+
 func (q Querier) PoolAccumulatorRewards(grpcCtx context.Context,
 	req *queryproto.PoolAccumulatorRewardsRequest,
 ) (*queryproto.PoolAccumulatorRewardsResponse, error) {
@@ -111,6 +151,16 @@ func (q Querier) NumNextInitializedTicks(grpcCtx context.Context,
 	return q.Q.NumNextInitializedTicks(ctx, *req)
 }
 
+func (q Querier) LiquiditySnapshots(grpcCtx context.Context,
+	req *queryproto.LiquiditySnapshotsRequest,
+) (*queryproto.LiquiditySnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.LiquiditySnapshots(ctx, *req)
+}
+
 func (q Querier) LiquidityPerTickRange(grpcCtx context.Context,
 	req *queryproto.LiquidityPerTickRangeRequest,
 ) (*queryproto.LiquidityPerTickRangeResponse, error) {
@@ -151,6 +201,16 @@ func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	return q.Q.GetTotalLiquidity(ctx, *req)
 }
 
+func (q Querier) DynamicSpreadFactor(grpcCtx context.Context,
+	req *queryproto.DynamicSpreadFactorRequest,
+) (*queryproto.DynamicSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DynamicSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
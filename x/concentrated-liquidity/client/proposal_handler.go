@@ -9,4 +9,6 @@ import (
 var (
 	TickSpacingDecreaseProposalHandler             = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal)
 	CreateConcentratedLiquidityPoolProposalHandler = govclient.NewProposalHandler(cli.NewCmdCreateConcentratedLiquidityPoolsProposal)
+	DynamicSpreadFactorProposalHandler             = govclient.NewProposalHandler(cli.NewDynamicSpreadFactorProposal)
+	PoolHooksProposalHandler                       = govclient.NewProposalHandler(cli.NewPoolHooksProposal)
 )
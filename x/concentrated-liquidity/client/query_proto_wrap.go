@@ -335,3 +335,109 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// RangeOrdersByOwner returns the range orders of the given owner, optionally filtered by pool and status.
+func (q Querier) RangeOrdersByOwner(ctx sdk.Context, req clquery.RangeOrdersByOwnerRequest) (*clquery.RangeOrdersByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeOrders, pageRes, err := q.Keeper.GetRangeOrdersByOwner(ctx, owner, req.PoolId, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.RangeOrdersByOwnerResponse{
+		RangeOrders: rangeOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// RangeOrdersByPool returns the range orders of the given pool, optionally filtered by status.
+func (q Querier) RangeOrdersByPool(ctx sdk.Context, req clquery.RangeOrdersByPoolRequest) (*clquery.RangeOrdersByPoolResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	rangeOrders, pageRes, err := q.Keeper.GetRangeOrdersByPool(ctx, req.PoolId, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.RangeOrdersByPoolResponse{
+		RangeOrders: rangeOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// LiquiditySnapshots returns the retained liquidity snapshots of the given pool taken within the given time range.
+func (q Querier) LiquiditySnapshots(ctx sdk.Context, req clquery.LiquiditySnapshotsRequest) (*clquery.LiquiditySnapshotsResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	snapshots, err := q.Keeper.GetLiquiditySnapshots(ctx, req.PoolId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clquery.LiquiditySnapshotsResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+// DynamicSpreadFactor returns the dynamic spread factor of the given pool and its current effective spread factor.
+func (q Querier) DynamicSpreadFactor(ctx sdk.Context, req clquery.DynamicSpreadFactorRequest) (*clquery.DynamicSpreadFactorResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	dynamicSpreadFactor, err := q.Keeper.GetDynamicSpreadFactor(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.DynamicSpreadFactorResponse{
+		DynamicSpreadFactor: dynamicSpreadFactor,
+		SpreadFactor:        pool.GetSpreadFactor(ctx),
+	}, nil
+}
+
+// RangeApr returns the TVL of the given pool and the estimated yields of a position in the given tick range.
+func (q Querier) RangeApr(ctx sdk.Context, req clquery.RangeAprRequest) (*clquery.RangeAprResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+	if req.QuoteDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "quote denom is empty")
+	}
+
+	response, err := q.Keeper.GetRangeApr(ctx, req.PoolId, req.LowerTick, req.UpperTick, req.Lookback, req.QuoteDenom, req.PricePoolIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return response, nil
+}
+
+// PoolHooks returns the hook failure policy and the configured hooks of the given pool.
+func (q Querier) PoolHooks(ctx sdk.Context, req clquery.PoolHooksRequest) (*clquery.PoolHooksResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	if _, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &clquery.PoolHooksResponse{
+		FailurePolicy: q.Keeper.GetPoolHookFailurePolicy(ctx, req.PoolId),
+		Hooks:         q.Keeper.GetPoolHooks(ctx, req.PoolId),
+	}, nil
+}
@@ -15,6 +15,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
 	model "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
@@ -22,15 +23,19 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1717,1772 +1722,1888 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
-	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
-	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
-	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
-	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
-	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
-	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
-	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
-	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
-	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
-	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
-	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
-	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
-	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
-	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
-	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
-	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
-	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
-	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
-	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
-	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
-	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
-	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
-	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
-	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
-	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
-	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+// =============================== RangeOrdersByOwner
+type RangeOrdersByOwnerRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// pool_id restricts the result to the given pool if non-zero.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// status restricts the result to the given status if specified.
+	Status     types1.RangeOrderStatus `protobuf:"varint,3,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.RangeOrderStatus" json:"status,omitempty" yaml:"status"`
+	Pagination *query.PageRequest      `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
+func (m *RangeOrdersByOwnerRequest) Reset()         { *m = RangeOrdersByOwnerRequest{} }
+func (m *RangeOrdersByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeOrdersByOwnerRequest) ProtoMessage()    {}
+func (*RangeOrdersByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
 }
-
-var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0xbf, 0xf3, 0xe2, 0x38, 0x49, 0xd9, 0xb1, 0x9d, 0x49, 0x32, 0x93, 0x2d, 0x08,
-	0x6b, 0x91, 0x64, 0x86, 0xfc, 0x6d, 0xc8, 0xdf, 0x26, 0x1e, 0x7b, 0x1d, 0x8d, 0xd6, 0xf1, 0x3a,
-	0x9d, 0x18, 0x10, 0x42, 0xf4, 0xf6, 0x74, 0x97, 0xc7, 0xad, 0xe9, 0xe9, 0x1a, 0x77, 0x57, 0x27,
-	0x31, 0x4b, 0xa4, 0xd5, 0xee, 0x11, 0x09, 0x16, 0x71, 0x45, 0x48, 0x88, 0x0b, 0x5a, 0x71, 0xe4,
-	0x02, 0x17, 0x04, 0x07, 0x14, 0x71, 0x58, 0xad, 0x84, 0x90, 0xd0, 0x1e, 0x66, 0x21, 0xe1, 0x80,
-	0xb4, 0x80, 0x84, 0xb9, 0x70, 0x44, 0x5d, 0x5d, 0xfd, 0x33, 0x33, 0x3d, 0x76, 0xcf, 0x38, 0x9c,
-	0xf6, 0xe4, 0xa9, 0x7e, 0xf5, 0xde, 0xfb, 0xde, 0x4f, 0xbd, 0xae, 0xf7, 0xda, 0x70, 0x81, 0xb9,
-	0x4d, 0xe6, 0x9a, 0x6e, 0x59, 0x67, 0xb6, 0x4e, 0x6d, 0xee, 0x68, 0x9c, 0x1a, 0x96, 0xb9, 0xee,
-	0x99, 0x86, 0xc9, 0x37, 0xca, 0x8f, 0x2e, 0xd4, 0x28, 0xd7, 0x2e, 0x94, 0xd7, 0x3d, 0xea, 0x6c,
-	0x94, 0x5a, 0x0e, 0xe3, 0x0c, 0x9f, 0x91, 0x2c, 0xa5, 0x54, 0x96, 0x92, 0x64, 0xc9, 0x4f, 0xd4,
-	0x59, 0x9d, 0x09, 0x8e, 0xb2, 0xff, 0x2b, 0x60, 0xce, 0x7f, 0x79, 0x6b, 0x7d, 0x2d, 0xcd, 0xd1,
-	0x9a, 0xae, 0xdc, 0x7b, 0x25, 0x1b, 0x36, 0x6e, 0xea, 0x0d, 0xd5, 0xb4, 0x57, 0x43, 0x15, 0x05,
-	0x5d, 0xf0, 0x95, 0x6b, 0x9a, 0x4b, 0xa3, 0x4d, 0x3a, 0x33, 0xed, 0x10, 0x42, 0x92, 0x2e, 0x0c,
-	0x8b, 0x76, 0xb5, 0xb4, 0xba, 0x69, 0x6b, 0xdc, 0x64, 0xe1, 0xde, 0x93, 0x75, 0xc6, 0xea, 0x16,
-	0x2d, 0x6b, 0x2d, 0xb3, 0xac, 0xd9, 0x36, 0xe3, 0x82, 0x18, 0x02, 0x3c, 0x2e, 0xa9, 0x62, 0x55,
-	0xf3, 0x56, 0xcb, 0x9a, 0xbd, 0x11, 0x92, 0x02, 0x25, 0x6a, 0xe0, 0x80, 0x60, 0x21, 0x49, 0x97,
-	0xb3, 0x99, 0xd5, 0x62, 0xae, 0x99, 0x40, 0x72, 0x33, 0x1b, 0x97, 0x29, 0x88, 0xe6, 0x23, 0xaa,
-	0x3a, 0x54, 0x67, 0x8e, 0x11, 0x70, 0x93, 0x5f, 0x21, 0x98, 0x58, 0x71, 0xa9, 0xb3, 0x2c, 0x85,
-	0xba, 0x0a, 0x5d, 0xf7, 0xa8, 0xcb, 0xf1, 0x39, 0xd8, 0xaf, 0x19, 0x86, 0x43, 0x5d, 0x77, 0x1a,
-	0x9d, 0x46, 0x33, 0xb9, 0x0a, 0xde, 0x6c, 0x17, 0xc7, 0x36, 0xb4, 0xa6, 0x75, 0x9d, 0x48, 0x02,
-	0x51, 0xc2, 0x2d, 0xf8, 0x2c, 0xec, 0x6f, 0x31, 0x66, 0xa9, 0xa6, 0x31, 0x3d, 0x72, 0x1a, 0xcd,
-	0xec, 0x49, 0xee, 0x96, 0x04, 0xa2, 0xec, 0xf3, 0x7f, 0x55, 0x0d, 0xbc, 0x00, 0x10, 0xfb, 0x73,
-	0x7a, 0xf7, 0x69, 0x34, 0x73, 0xf0, 0xe2, 0x97, 0x4a, 0xd2, 0x15, 0xbe, 0xf3, 0x4b, 0x41, 0x56,
-	0x49, 0xe8, 0xa5, 0x65, 0xad, 0x4e, 0x25, 0x2c, 0x25, 0xc1, 0x49, 0x7e, 0x87, 0xe0, 0x58, 0x17,
-	0x76, 0xb7, 0xc5, 0x6c, 0x97, 0xe2, 0xb7, 0x21, 0x17, 0x7a, 0xc9, 0x87, 0xbf, 0x7b, 0xe6, 0xe0,
-	0xc5, 0x9b, 0xa5, 0x4c, 0xd9, 0x59, 0x5a, 0xf0, 0x2c, 0x2b, 0x14, 0x58, 0x71, 0xa8, 0xd6, 0x30,
-	0xd8, 0x63, 0xbb, 0xb2, 0xe7, 0x59, 0xbb, 0xb8, 0x4b, 0x89, 0x85, 0xe2, 0xbb, 0x1d, 0x36, 0x8c,
-	0x08, 0x1b, 0x5e, 0xdd, 0xd6, 0x86, 0x00, 0x5e, 0x87, 0x11, 0x4b, 0x30, 0x1e, 0xa9, 0xdb, 0xa8,
-	0x1a, 0xa1, 0xfb, 0xaf, 0xc2, 0xc1, 0x50, 0x99, 0xef, 0x54, 0x24, 0x9c, 0x3a, 0xb9, 0xd9, 0x2e,
-	0xe2, 0xd0, 0xa9, 0x11, 0x91, 0x28, 0x10, 0xae, 0xaa, 0x06, 0x79, 0x04, 0x13, 0x9d, 0xf2, 0xa4,
-	0x4b, 0xbe, 0x0d, 0x07, 0xc2, 0x5d, 0x42, 0xda, 0xcb, 0xf1, 0x48, 0x24, 0x93, 0x2c, 0xc0, 0xd4,
-	0x92, 0xd7, 0x5c, 0x66, 0xcc, 0xea, 0x49, 0xa5, 0x44, 0x72, 0xa0, 0xed, 0x92, 0x83, 0x7c, 0x0b,
-	0xa6, 0x7b, 0xe5, 0x48, 0x1b, 0xee, 0xc0, 0x58, 0x64, 0xb7, 0xce, 0x3c, 0x9b, 0x4b, 0x79, 0xc7,
-	0x37, 0xdb, 0xc5, 0x63, 0x5d, 0x7e, 0x11, 0x74, 0xa2, 0x1c, 0x0a, 0x1f, 0xcc, 0x89, 0xf5, 0xd7,
-	0x60, 0xd4, 0x17, 0x1d, 0x41, 0x5b, 0x48, 0x09, 0xe3, 0x30, 0xa9, 0xf8, 0x03, 0x04, 0x87, 0xa4,
-	0x60, 0x89, 0xf5, 0x0a, 0xec, 0xf5, 0x2d, 0x0a, 0xd3, 0x6f, 0xa2, 0x14, 0x94, 0x84, 0x52, 0x58,
-	0x12, 0x4a, 0xb3, 0xf6, 0x46, 0x25, 0xf7, 0x87, 0x5f, 0x9e, 0xdf, 0xeb, 0xf3, 0x55, 0x95, 0x60,
-	0xf7, 0xcb, 0xcb, 0xab, 0xc3, 0x70, 0x68, 0x59, 0xd4, 0x4c, 0x09, 0x97, 0xac, 0xc0, 0x58, 0xf8,
-	0x40, 0x42, 0x9c, 0x83, 0x7d, 0x41, 0x59, 0x95, 0x09, 0x71, 0x66, 0x9b, 0x84, 0x08, 0xd8, 0x65,
-	0xe4, 0x25, 0x2b, 0xf9, 0x10, 0xc1, 0x91, 0x87, 0xa6, 0xde, 0x58, 0x0c, 0xb7, 0x2d, 0x51, 0x8e,
-	0xdf, 0x86, 0x43, 0x11, 0x9b, 0x6a, 0x53, 0x2e, 0x4b, 0xc8, 0x0d, 0x9f, 0xf3, 0x93, 0x76, 0xf1,
-	0x44, 0x60, 0x8f, 0x6b, 0x34, 0x4a, 0x26, 0x2b, 0x37, 0x35, 0xbe, 0x56, 0x5a, 0xa4, 0x75, 0x4d,
-	0xdf, 0x98, 0xa7, 0xfa, 0x66, 0xbb, 0x38, 0x11, 0x84, 0xb2, 0x43, 0x02, 0x51, 0x46, 0xad, 0xa4,
-	0x86, 0xcb, 0x00, 0xb2, 0xbc, 0x1b, 0xf4, 0x89, 0xf0, 0xd3, 0xee, 0xca, 0xb1, 0xcd, 0x76, 0xf1,
-	0x68, 0xc0, 0x1b, 0xd3, 0x88, 0x92, 0xf3, 0x17, 0x55, 0xf1, 0xfb, 0x9f, 0x08, 0xa6, 0x22, 0xa0,
-	0xf3, 0xb4, 0xc5, 0xd7, 0xbe, 0x6e, 0xf2, 0x35, 0x45, 0xb3, 0xeb, 0x14, 0xaf, 0xc2, 0x91, 0x58,
-	0xa3, 0xd6, 0x8c, 0xd2, 0x6b, 0x87, 0xb0, 0x0f, 0x47, 0xeb, 0x59, 0x21, 0xd3, 0x47, 0x6e, 0xb1,
-	0xc7, 0xd4, 0x51, 0x7d, 0x58, 0xbd, 0xc8, 0x63, 0x1a, 0x51, 0x72, 0x62, 0xe1, 0x7b, 0xd7, 0xe7,
-	0xf2, 0x5a, 0xad, 0x90, 0x6b, 0x77, 0x37, 0x57, 0x4c, 0x23, 0x4a, 0x4e, 0x2c, 0x7c, 0x2e, 0xf2,
-	0xe9, 0x08, 0x14, 0x92, 0x81, 0xa9, 0xda, 0xf3, 0xa6, 0x43, 0x75, 0x3f, 0x41, 0x86, 0x39, 0x9c,
-	0xb8, 0x04, 0x07, 0x38, 0x6b, 0x50, 0x5b, 0x35, 0x83, 0xdc, 0xcc, 0x55, 0xc6, 0x37, 0xdb, 0xc5,
-	0xc3, 0xd2, 0xe7, 0x92, 0x42, 0x94, 0xfd, 0xe2, 0x67, 0xd5, 0xf6, 0x51, 0xbb, 0x5c, 0x73, 0x78,
-	0x1f, 0xd4, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x10, 0xb6, 0x5e, 0x83, 0x51, 0xcf, 0xa5, 0xaa, 0xee,
-	0x49, 0x6b, 0xf7, 0x9c, 0x46, 0x33, 0x07, 0x2a, 0x53, 0x9b, 0xed, 0xe2, 0xb8, 0xb4, 0x36, 0x41,
-	0x25, 0x0a, 0x78, 0x2e, 0x9d, 0xf3, 0x22, 0x37, 0xd5, 0x98, 0x67, 0x1b, 0x01, 0xe3, 0xde, 0x6e,
-	0x85, 0x31, 0x8d, 0x28, 0x39, 0xb1, 0x48, 0x2a, 0xb4, 0x99, 0x2a, 0x9e, 0x4d, 0xef, 0x4b, 0x53,
-	0x18, 0x52, 0x03, 0x85, 0x4b, 0xac, 0x22, 0x16, 0x3f, 0xdd, 0x0d, 0xc5, 0xbe, 0x1e, 0x96, 0xe7,
-	0x6c, 0x2d, 0x99, 0x59, 0x86, 0x9f, 0x75, 0x61, 0x55, 0xb8, 0x9a, 0xb1, 0x04, 0x77, 0x1f, 0x30,
-	0x79, 0x06, 0xe3, 0xdc, 0x12, 0xb9, 0xec, 0xe2, 0x57, 0x60, 0x54, 0xf7, 0x1c, 0x87, 0xda, 0x3c,
-	0x91, 0x5d, 0xca, 0x41, 0xf9, 0x4c, 0xd8, 0x6a, 0xc1, 0xd1, 0x70, 0x4b, 0xc4, 0x2d, 0x22, 0x93,
-	0xab, 0xdc, 0xce, 0x96, 0xe7, 0xd3, 0x81, 0x4f, 0x7a, 0xa4, 0x10, 0xe5, 0x88, 0x7c, 0x16, 0x41,
-	0xc5, 0xef, 0x21, 0xc0, 0xe1, 0x46, 0x77, 0xdd, 0xe1, 0x6a, 0xcb, 0x31, 0x75, 0x2a, 0x22, 0x9a,
-	0xab, 0x3c, 0x94, 0xfa, 0xca, 0x75, 0x93, 0xaf, 0x79, 0xb5, 0x92, 0xce, 0x9a, 0x65, 0xe9, 0x8f,
-	0xf3, 0x96, 0x56, 0x73, 0xc3, 0x85, 0xf8, 0x2b, 0x60, 0x54, 0xcc, 0x7a, 0x80, 0xe1, 0x78, 0x27,
-	0x86, 0x58, 0x74, 0x0c, 0xe2, 0xc1, 0xba, 0xc3, 0x97, 0xc5, 0xa3, 0x37, 0xe1, 0x64, 0x84, 0x68,
-	0x39, 0x38, 0x19, 0xe2, 0xc8, 0x0f, 0xf5, 0x7e, 0xfa, 0x0d, 0x82, 0x53, 0x7d, 0xa4, 0xc9, 0x70,
-	0xd7, 0x20, 0x17, 0x7b, 0x36, 0x88, 0xf3, 0xeb, 0x19, 0xe3, 0xdc, 0xa7, 0x36, 0x85, 0xd7, 0x8f,
-	0x88, 0x01, 0x5f, 0x87, 0xd1, 0x9a, 0xa7, 0x37, 0x28, 0xef, 0x28, 0x80, 0x89, 0x8c, 0x4d, 0x52,
-	0x89, 0x72, 0x30, 0x58, 0x06, 0x45, 0xf0, 0x1b, 0x70, 0x6a, 0xce, 0xd2, 0xcc, 0xa6, 0x56, 0xb3,
-	0xe8, 0x83, 0x96, 0x43, 0x35, 0x43, 0xa1, 0x8f, 0x35, 0xc7, 0x70, 0x77, 0x7c, 0xf7, 0xf8, 0x09,
-	0x82, 0x42, 0x3f, 0xd1, 0xd2, 0x39, 0xdf, 0x85, 0x69, 0x3d, 0xdc, 0xa1, 0xba, 0x62, 0x8b, 0xea,
-	0x04, 0x7b, 0xa4, 0xaf, 0x8e, 0x77, 0xbc, 0xed, 0x42, 0xcf, 0xcc, 0x31, 0xd3, 0xae, 0xbc, 0xea,
-	0xbb, 0x61, 0xb3, 0x5d, 0x2c, 0xca, 0xe8, 0xf7, 0x11, 0x44, 0x94, 0x49, 0x3d, 0x15, 0x05, 0x59,
-	0x81, 0x7c, 0x84, 0xaf, 0x1a, 0x5e, 0x88, 0x77, 0x6e, 0xf7, 0xfb, 0x23, 0x70, 0x22, 0x55, 0xae,
-	0x34, 0x7a, 0x1d, 0x26, 0x62, 0xac, 0xd1, 0x45, 0x3c, 0x83, 0xc1, 0x5f, 0x90, 0x06, 0x9f, 0xe8,
-	0x36, 0x38, 0x16, 0x42, 0x94, 0x71, 0xbd, 0x57, 0xb5, 0xaf, 0x72, 0x95, 0x39, 0xab, 0xd4, 0xe4,
-	0xd4, 0x48, 0xaa, 0x1c, 0x19, 0x50, 0x65, 0x9a, 0x10, 0xa2, 0x8c, 0x47, 0x8f, 0x63, 0x95, 0x64,
-	0x11, 0x4e, 0xf9, 0x57, 0x99, 0x59, 0x5d, 0xf7, 0x9a, 0x9e, 0xa5, 0x71, 0xe6, 0x74, 0xe5, 0xd5,
-	0x40, 0xe7, 0xec, 0xb7, 0x23, 0x50, 0xe8, 0x27, 0x4e, 0xba, 0xf5, 0x03, 0x04, 0x27, 0x3a, 0x22,
-	0xaf, 0xd6, 0x1d, 0xf6, 0x98, 0xaf, 0xa9, 0x75, 0x8b, 0xd5, 0x34, 0x4b, 0xba, 0xf7, 0x64, 0xaa,
-	0xad, 0xf3, 0x54, 0x17, 0xe6, 0x5e, 0xf2, 0xcd, 0xfd, 0xf0, 0xd3, 0xe2, 0xd9, 0x44, 0x0d, 0x92,
-	0x6d, 0x60, 0xf0, 0xe7, 0xbc, 0x6b, 0x34, 0xca, 0x7c, 0xa3, 0x45, 0xdd, 0x90, 0xc7, 0x55, 0xa6,
-	0xdd, 0x44, 0x56, 0xdd, 0x15, 0x3a, 0xef, 0x0a, 0x95, 0xf8, 0x7b, 0x08, 0x26, 0xbc, 0x16, 0x37,
-	0x9b, 0xb4, 0x0b, 0x4b, 0xe0, 0xf7, 0xcb, 0x19, 0xeb, 0xc0, 0x8a, 0x10, 0xf1, 0xd0, 0xd1, 0xf4,
-	0x06, 0x75, 0xba, 0x43, 0x92, 0x26, 0x9f, 0x28, 0x38, 0x78, 0x9c, 0x44, 0x43, 0xde, 0x47, 0x50,
-	0xf0, 0xeb, 0x53, 0xc2, 0x87, 0x52, 0xe6, 0x50, 0x31, 0x19, 0xf2, 0xd2, 0xf5, 0xd9, 0x08, 0x14,
-	0xfb, 0xa2, 0x90, 0xa1, 0x7c, 0x86, 0xe0, 0x5a, 0x6a, 0x28, 0x59, 0x4b, 0x9c, 0x33, 0xaa, 0x1a,
-	0xe1, 0x6b, 0x55, 0x65, 0xab, 0xaa, 0xa5, 0xb9, 0x5c, 0xe5, 0x8e, 0xf6, 0x88, 0x3a, 0xee, 0xff,
-	0x33, 0xd0, 0x17, 0x7b, 0x03, 0xfd, 0x96, 0x04, 0x14, 0xbd, 0xe6, 0xdf, 0x5a, 0x5d, 0xd4, 0x5c,
-	0xfe, 0x30, 0x04, 0x83, 0x9f, 0xc2, 0x61, 0x19, 0x21, 0x2e, 0xad, 0xdc, 0x51, 0xf0, 0x0b, 0x32,
-	0xf8, 0x93, 0x1d, 0xc1, 0x0f, 0x45, 0x13, 0x65, 0xcc, 0x4b, 0x6e, 0x77, 0xc9, 0xf7, 0x11, 0x4c,
-	0x45, 0x87, 0x52, 0x11, 0xad, 0xfe, 0x70, 0xc1, 0x7e, 0x59, 0xad, 0xd1, 0x47, 0x08, 0xa6, 0x7b,
-	0x01, 0xc9, 0xb8, 0x9b, 0x70, 0xb4, 0x7b, 0x30, 0x11, 0x96, 0xc5, 0xd7, 0x32, 0xba, 0xab, 0x4b,
-	0xb6, 0x7c, 0x57, 0x1e, 0x31, 0xbb, 0x54, 0xbe, 0xbc, 0xce, 0xea, 0x5d, 0x04, 0x67, 0xe7, 0x16,
-	0xee, 0xdd, 0x13, 0x7d, 0x9b, 0xb1, 0x68, 0xda, 0x8d, 0x05, 0x87, 0x35, 0xe7, 0x12, 0x20, 0x03,
-	0x4a, 0xe8, 0xf5, 0xfb, 0x30, 0x91, 0xb4, 0x40, 0xed, 0x0c, 0x41, 0x31, 0x51, 0xde, 0x53, 0x76,
-	0x11, 0x05, 0xeb, 0x3d, 0x92, 0x89, 0x09, 0xe7, 0xb2, 0x21, 0x90, 0x6e, 0xbe, 0x06, 0xa3, 0xfa,
-	0x6a, 0xb3, 0xd9, 0xa5, 0x3a, 0x71, 0x5d, 0x48, 0x52, 0x89, 0x02, 0xfe, 0x52, 0xaa, 0xba, 0x07,
-	0xa7, 0x56, 0x5c, 0xea, 0xac, 0xd8, 0x35, 0x66, 0x1b, 0xa6, 0x5d, 0xdf, 0xd9, 0xa0, 0x88, 0xfc,
-	0x0c, 0x41, 0xa1, 0x9f, 0x3c, 0x09, 0xf6, 0x5d, 0x04, 0xf9, 0x68, 0xd0, 0xa2, 0x3e, 0x36, 0xf9,
-	0x9a, 0xda, 0xa2, 0x8e, 0xc9, 0x0c, 0xd5, 0x62, 0x7a, 0x43, 0x66, 0xc7, 0xad, 0x8c, 0xd9, 0x11,
-	0x8a, 0xf7, 0xef, 0x52, 0xcb, 0x42, 0xca, 0x22, 0xd3, 0x1b, 0x32, 0x49, 0xa6, 0x22, 0x35, 0x9d,
-	0x64, 0x92, 0x87, 0xe9, 0xbb, 0x94, 0x3f, 0x64, 0x5c, 0xb3, 0xa2, 0x2b, 0x59, 0xd8, 0x47, 0xff,
-	0x10, 0xc1, 0xf1, 0x14, 0xa2, 0x04, 0xcf, 0xe1, 0x30, 0xf7, 0x29, 0x6a, 0xf7, 0x15, 0x70, 0x8b,
-	0x57, 0xee, 0x57, 0x64, 0x69, 0x9a, 0xc9, 0x50, 0x9a, 0x82, 0xba, 0x34, 0xc6, 0x3b, 0xb4, 0x93,
-	0x4d, 0x04, 0x85, 0x25, 0xaf, 0xb9, 0x44, 0x9f, 0xf0, 0xaa, 0x6d, 0x72, 0x53, 0xb3, 0xcc, 0xef,
-	0x50, 0xd1, 0xdb, 0x0c, 0x77, 0xf6, 0x6f, 0xc3, 0x58, 0xd8, 0xcd, 0xa9, 0x06, 0xb5, 0x59, 0x53,
-	0x76, 0x7b, 0x89, 0x41, 0x4b, 0x27, 0x9d, 0x28, 0xa3, 0xb2, 0xe7, 0x9b, 0xf7, 0x97, 0xb8, 0x06,
-	0x79, 0xdb, 0x6b, 0xaa, 0x36, 0x7d, 0xe2, 0xdf, 0x41, 0x23, 0x44, 0xa2, 0x2b, 0x71, 0x45, 0xbb,
-	0xb1, 0xa7, 0x72, 0x66, 0xb3, 0x5d, 0x7c, 0x25, 0x10, 0xd6, 0x7f, 0x2f, 0x51, 0xa6, 0xec, 0x74,
-	0xc3, 0xc8, 0x8f, 0x47, 0xa0, 0xd8, 0xd7, 0xe8, 0xcf, 0x7d, 0xeb, 0x75, 0xf1, 0xdf, 0x79, 0xd8,
-	0x7b, 0xdf, 0xaf, 0x68, 0xf8, 0xe7, 0x08, 0xc4, 0x90, 0xc9, 0xc5, 0x97, 0x32, 0x9f, 0x9a, 0x78,
-	0x46, 0x96, 0xbf, 0x3c, 0x18, 0x53, 0xe0, 0x79, 0x72, 0xf9, 0xbd, 0x3f, 0xfe, 0xed, 0x47, 0x23,
-	0x25, 0x7c, 0xae, 0x9c, 0x75, 0xaa, 0xed, 0x03, 0xfc, 0x05, 0x82, 0x7d, 0xc1, 0x98, 0x09, 0x67,
-	0x56, 0x9b, 0x9c, 0x72, 0xe5, 0xaf, 0x0c, 0xc8, 0x25, 0xd1, 0x5e, 0x11, 0x68, 0xcb, 0xf8, 0x7c,
-	0x56, 0xb4, 0x01, 0xc6, 0x8f, 0x10, 0x1c, 0xea, 0x98, 0x40, 0xe3, 0x1b, 0x59, 0x5f, 0xf2, 0x29,
-	0x33, 0xf7, 0xfc, 0xcd, 0xe1, 0x98, 0xa5, 0x0d, 0x15, 0x61, 0xc3, 0x4d, 0x7c, 0xbd, 0x3c, 0xd8,
-	0x77, 0x04, 0xb7, 0xfc, 0x8e, 0xac, 0xce, 0x4f, 0xf1, 0x67, 0x08, 0x8e, 0xa5, 0x76, 0xb7, 0x78,
-	0x6e, 0xd0, 0x16, 0x36, 0xa5, 0xd3, 0xce, 0xcf, 0xef, 0x4c, 0x88, 0x34, 0xf4, 0xae, 0x30, 0x74,
-	0x16, 0xdf, 0xce, 0x68, 0x68, 0x5c, 0x01, 0xc2, 0x21, 0x99, 0xea, 0x08, 0x9b, 0xfe, 0x93, 0x1c,
-	0x07, 0x76, 0x0e, 0x6f, 0xf0, 0x1b, 0x83, 0x42, 0x4d, 0x1d, 0xaf, 0xe5, 0x17, 0x76, 0x2a, 0x46,
-	0xda, 0x5c, 0x15, 0x36, 0xcf, 0xe1, 0xd9, 0x81, 0x6d, 0xb6, 0xc5, 0x18, 0x20, 0xbe, 0x3f, 0xe3,
-	0x7f, 0x21, 0x98, 0x4c, 0xef, 0xd2, 0x71, 0xd6, 0xf8, 0x6c, 0x39, 0x3f, 0xc8, 0xbf, 0xb1, 0x43,
-	0x29, 0x43, 0x86, 0xb9, 0xdf, 0x38, 0x00, 0xff, 0x15, 0xc1, 0x78, 0x4a, 0x7b, 0x8e, 0x67, 0x07,
-	0xc5, 0xd9, 0x33, 0x32, 0xc8, 0x57, 0x76, 0x22, 0x42, 0xda, 0x39, 0x27, 0xec, 0xbc, 0x85, 0x6f,
-	0x0c, 0x6c, 0x67, 0xdc, 0x92, 0xe3, 0xdf, 0x23, 0x18, 0x4d, 0x7e, 0xf7, 0xc1, 0xd7, 0x07, 0xbc,
-	0x20, 0x25, 0x3e, 0x3e, 0xe5, 0x6f, 0x0c, 0xc5, 0x2b, 0xcd, 0xb9, 0x25, 0xcc, 0xb9, 0x8a, 0xaf,
-	0x0c, 0x58, 0x86, 0xd4, 0xda, 0x86, 0x6a, 0x1a, 0xf8, 0xef, 0x08, 0x26, 0xd3, 0xfb, 0xfe, 0xcc,
-	0xd9, 0xb9, 0xe5, 0x14, 0x22, 0x73, 0x76, 0x6e, 0x3d, 0x7c, 0x20, 0xb3, 0xc2, 0xcc, 0x1b, 0xf8,
-	0xda, 0x00, 0xef, 0x37, 0x55, 0xf3, 0xe5, 0x45, 0x79, 0xf9, 0x27, 0x04, 0x47, 0xba, 0x3b, 0x23,
-	0xfc, 0xfa, 0x70, 0x6d, 0x4f, 0x64, 0xde, 0xed, 0xa1, 0xf9, 0xa5, 0x61, 0x77, 0x84, 0x61, 0xd7,
-	0xf1, 0x57, 0xcb, 0xc3, 0x7d, 0x58, 0x76, 0xf1, 0x3f, 0x10, 0x4c, 0xf5, 0x69, 0xf8, 0x33, 0x97,
-	0xd5, 0xad, 0xc7, 0x16, 0x99, 0xcb, 0xea, 0x36, 0x73, 0x87, 0x81, 0xdf, 0x99, 0xe2, 0xe5, 0x11,
-	0x44, 0x31, 0x6c, 0xc1, 0xf1, 0xaf, 0x47, 0xe0, 0x8b, 0x59, 0xba, 0x31, 0xac, 0x64, 0x2d, 0x16,
-	0xd9, 0x9b, 0xcb, 0xfc, 0x83, 0x97, 0x2a, 0x53, 0x7a, 0xc5, 0x14, 0x5e, 0xd1, 0xb1, 0x96, 0xb5,
-	0x22, 0x25, 0xba, 0x47, 0xd5, 0x32, 0xed, 0x86, 0xba, 0xea, 0xb0, 0xa6, 0x9a, 0x64, 0x2a, 0xbf,
-	0x93, 0xd6, 0xdd, 0x3e, 0xc5, 0xff, 0x45, 0x30, 0x99, 0xde, 0x0f, 0x66, 0x3e, 0xee, 0x5b, 0xb6,
-	0xa7, 0x99, 0x8f, 0xfb, 0xd6, 0x4d, 0x29, 0xb9, 0x2f, 0x5c, 0xf2, 0x26, 0xae, 0x66, 0x74, 0x89,
-	0xe7, 0x52, 0x47, 0xf5, 0x42, 0x79, 0x6a, 0xda, 0x5d, 0xeb, 0x13, 0x04, 0x47, 0x7b, 0x1a, 0x49,
-	0x9c, 0xf5, 0xfc, 0xf6, 0xeb, 0x4f, 0xf3, 0x77, 0x86, 0x17, 0x30, 0xe4, 0xa1, 0xa8, 0x53, 0xae,
-	0x76, 0x35, 0xbd, 0xe2, 0x6a, 0xd5, 0xa7, 0x39, 0xcb, 0x5c, 0x03, 0xb6, 0xee, 0x68, 0x33, 0xd7,
-	0x80, 0x6d, 0x7a, 0xc4, 0x81, 0xaf, 0x56, 0xfd, 0x9b, 0xd5, 0xca, 0xda, 0xb3, 0xe7, 0x05, 0xf4,
-	0xf1, 0xf3, 0x02, 0xfa, 0xcb, 0xf3, 0x02, 0xfa, 0xe0, 0x45, 0x61, 0xd7, 0xc7, 0x2f, 0x0a, 0xbb,
-	0xfe, 0xfc, 0xa2, 0xb0, 0xeb, 0x9b, 0x4b, 0xdb, 0x7d, 0xe3, 0x7a, 0x74, 0xf1, 0xb5, 0xf2, 0x93,
-	0x0e, 0xcd, 0xe7, 0x63, 0xd5, 0xba, 0x65, 0x52, 0x9b, 0x07, 0xff, 0x93, 0x14, 0xfc, 0x03, 0xc1,
-	0x3e, 0xf1, 0xe7, 0xd2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0x8a, 0x7f, 0x31, 0xa7, 0x25,
-	0x00, 0x00,
+func (m *RangeOrdersByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// UserPositions returns all concentrated positions of some address.
-	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+func (m *RangeOrdersByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrdersByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *RangeOrdersByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrdersByOwnerRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *RangeOrdersByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrdersByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrdersByOwnerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
-	out := new(PoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RangeOrdersByOwnerRequest proto.InternalMessageInfo
+
+func (m *RangeOrdersByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByOwnerRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
-	out := new(UserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByOwnerRequest) GetStatus() types1.RangeOrderStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return types1.RangeOrderStatusUnspecified
 }
 
-func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
-	out := new(LiquidityPerTickRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
-	out := new(LiquidityNetInDirectionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RangeOrdersByOwnerResponse struct {
+	RangeOrders []types1.RangeOrder `protobuf:"bytes,1,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
-	out := new(ClaimableSpreadRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByOwnerResponse) Reset()         { *m = RangeOrdersByOwnerResponse{} }
+func (m *RangeOrdersByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeOrdersByOwnerResponse) ProtoMessage()    {}
+func (*RangeOrdersByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *RangeOrdersByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrdersByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrdersByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RangeOrdersByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrdersByOwnerResponse.Merge(m, src)
+}
+func (m *RangeOrdersByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrdersByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrdersByOwnerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
-	out := new(ClaimableIncentivesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RangeOrdersByOwnerResponse proto.InternalMessageInfo
+
+func (m *RangeOrdersByOwnerResponse) GetRangeOrders() []types1.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
-	out := new(PositionByIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
-	out := new(PoolAccumulatorRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== RangeOrdersByPool
+type RangeOrdersByPoolRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// status restricts the result to the given status if specified.
+	Status     types1.RangeOrderStatus `protobuf:"varint,2,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.RangeOrderStatus" json:"status,omitempty" yaml:"status"`
+	Pagination *query.PageRequest      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
-	out := new(IncentiveRecordsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByPoolRequest) Reset()         { *m = RangeOrdersByPoolRequest{} }
+func (m *RangeOrdersByPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeOrdersByPoolRequest) ProtoMessage()    {}
+func (*RangeOrdersByPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *RangeOrdersByPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrdersByPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrdersByPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RangeOrdersByPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrdersByPoolRequest.Merge(m, src)
+}
+func (m *RangeOrdersByPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrdersByPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrdersByPoolRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
-	out := new(TickAccumulatorTrackersResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RangeOrdersByPoolRequest proto.InternalMessageInfo
+
+func (m *RangeOrdersByPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByPoolRequest) GetStatus() types1.RangeOrderStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return types1.RangeOrderStatusUnspecified
 }
 
-func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
-	out := new(UserUnbondingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByPoolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
-	out := new(GetTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RangeOrdersByPoolResponse struct {
+	RangeOrders []types1.RangeOrder `protobuf:"bytes,1,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
-	out := new(NumNextInitializedTicksResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RangeOrdersByPoolResponse) Reset()         { *m = RangeOrdersByPoolResponse{} }
+func (m *RangeOrdersByPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeOrdersByPoolResponse) ProtoMessage()    {}
+func (*RangeOrdersByPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *RangeOrdersByPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrdersByPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrdersByPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RangeOrdersByPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrdersByPoolResponse.Merge(m, src)
+}
+func (m *RangeOrdersByPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrdersByPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrdersByPoolResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// UserPositions returns all concentrated positions of some address.
-	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+var xxx_messageInfo_RangeOrdersByPoolResponse proto.InternalMessageInfo
+
+func (m *RangeOrdersByPoolResponse) GetRangeOrders() []types1.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *RangeOrdersByPoolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+// =============================== LiquiditySnapshots
+type LiquiditySnapshotsRequest struct {
+	PoolId    uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func (m *LiquiditySnapshotsRequest) Reset()         { *m = LiquiditySnapshotsRequest{} }
+func (m *LiquiditySnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*LiquiditySnapshotsRequest) ProtoMessage()    {}
+func (*LiquiditySnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *LiquiditySnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+func (m *LiquiditySnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquiditySnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+func (m *LiquiditySnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquiditySnapshotsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+func (m *LiquiditySnapshotsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+func (m *LiquiditySnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquiditySnapshotsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+
+var xxx_messageInfo_LiquiditySnapshotsRequest proto.InternalMessageInfo
+
+func (m *LiquiditySnapshotsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+
+func (m *LiquiditySnapshotsRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
-func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+
+func (m *LiquiditySnapshotsRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
-func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+
+type LiquiditySnapshotsResponse struct {
+	// snapshots are ordered by epoch number.
+	Snapshots []types1.LiquiditySnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
 }
-func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+
+func (m *LiquiditySnapshotsResponse) Reset()         { *m = LiquiditySnapshotsResponse{} }
+func (m *LiquiditySnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*LiquiditySnapshotsResponse) ProtoMessage()    {}
+func (*LiquiditySnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
 }
-func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+func (m *LiquiditySnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+func (m *LiquiditySnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquiditySnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+func (m *LiquiditySnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquiditySnapshotsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *LiquiditySnapshotsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *LiquiditySnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquiditySnapshotsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+var xxx_messageInfo_LiquiditySnapshotsResponse proto.InternalMessageInfo
+
+func (m *LiquiditySnapshotsResponse) GetSnapshots() []types1.LiquiditySnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== DynamicSpreadFactor
+type DynamicSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityPerTickRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+func (m *DynamicSpreadFactorRequest) Reset()         { *m = DynamicSpreadFactorRequest{} }
+func (m *DynamicSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorRequest) ProtoMessage()    {}
+func (*DynamicSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{40}
+}
+func (m *DynamicSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DynamicSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorRequest.Merge(m, src)
+}
+func (m *DynamicSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorRequest.DiscardUnknown(m)
 }
 
-func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityNetInDirectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+var xxx_messageInfo_DynamicSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableSpreadRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DynamicSpreadFactorResponse struct {
+	DynamicSpreadFactor types1.DynamicSpreadFactor `protobuf:"bytes,1,opt,name=dynamic_spread_factor,json=dynamicSpreadFactor,proto3" json:"dynamic_spread_factor" yaml:"dynamic_spread_factor"`
+	// spread_factor is the effective spread factor currently charged by the
+	// pool.
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
 }
 
-func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableIncentivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableIncentives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+func (m *DynamicSpreadFactorResponse) Reset()         { *m = DynamicSpreadFactorResponse{} }
+func (m *DynamicSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorResponse) ProtoMessage()    {}
+func (*DynamicSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{41}
+}
+func (m *DynamicSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DynamicSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorResponse.Merge(m, src)
+}
+func (m *DynamicSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorResponse.DiscardUnknown(m)
 }
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+var xxx_messageInfo_DynamicSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorResponse) GetDynamicSpreadFactor() types1.DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactor
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.DynamicSpreadFactor{}
 }
 
-func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolAccumulatorRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+// =============================== RangeApr
+type RangeAprRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// lookback is the duration over which the accumulator growth is measured.
+	// It is bounded by the retained liquidity snapshots of the pool.
+	Lookback time.Duration `protobuf:"bytes,4,opt,name=lookback,proto3,stdduration" json:"lookback" yaml:"lookback"`
+	// quote_denom is the denom the TVL and the yields are priced in.
+	QuoteDenom string `protobuf:"bytes,5,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// price_pool_ids are the pools, in addition to the given pool, whose twap
+	// prices each denom in the quote denom. At most 5 price pools are allowed.
+	PricePoolIds []uint64 `protobuf:"varint,6,rep,packed,name=price_pool_ids,json=pricePoolIds,proto3" json:"price_pool_ids,omitempty" yaml:"price_pool_ids"`
+}
+
+func (m *RangeAprRequest) Reset()         { *m = RangeAprRequest{} }
+func (m *RangeAprRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeAprRequest) ProtoMessage()    {}
+func (*RangeAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{42}
+}
+func (m *RangeAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RangeAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeAprRequest.Merge(m, src)
+}
+func (m *RangeAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeAprRequest.DiscardUnknown(m)
 }
 
-func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncentiveRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentiveRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+var xxx_messageInfo_RangeAprRequest proto.InternalMessageInfo
+
+func (m *RangeAprRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TickAccumulatorTrackersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+func (m *RangeAprRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+func (m *RangeAprRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserUnbondingPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
+func (m *RangeAprRequest) GetLookback() time.Duration {
+	if m != nil {
+		return m.Lookback
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
+	return 0
+}
+
+func (m *RangeAprRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+	return ""
+}
+
+func (m *RangeAprRequest) GetPricePoolIds() []uint64 {
+	if m != nil {
+		return m.PricePoolIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type RangeAprResponse struct {
+	// tvl is the value of the pool liquidity in the quote denom.
+	Tvl             cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=tvl,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tvl" yaml:"tvl"`
+	SpreadRewardApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spread_reward_apr,json=spreadRewardApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_reward_apr" yaml:"spread_reward_apr"`
+	// incentive_apr assumes the position qualifies for every authorized uptime.
+	IncentiveApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=incentive_apr,json=incentiveApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"incentive_apr" yaml:"incentive_apr"`
+	// in_range_fraction is the fraction of the snapshots over the lookback,
+	// including the current state, at which the tick range was active.
+	InRangeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=in_range_fraction,json=inRangeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"in_range_fraction" yaml:"in_range_fraction"`
+	// lookback_start_time is the time of the snapshot the growth is measured
+	// from.
+	LookbackStartTime time.Time `protobuf:"bytes,5,opt,name=lookback_start_time,json=lookbackStartTime,proto3,stdtime" json:"lookback_start_time" yaml:"lookback_start_time"`
+	// unpriced_denoms are the reward denoms that could not be priced in the
+	// quote denom and are therefore excluded from the yields.
+	UnpricedDenoms []string `protobuf:"bytes,6,rep,name=unpriced_denoms,json=unpricedDenoms,proto3" json:"unpriced_denoms,omitempty" yaml:"unpriced_denoms"`
+}
+
+func (m *RangeAprResponse) Reset()         { *m = RangeAprResponse{} }
+func (m *RangeAprResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeAprResponse) ProtoMessage()    {}
+func (*RangeAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{43}
+}
+func (m *RangeAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
+}
+func (m *RangeAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeAprResponse.Merge(m, src)
+}
+func (m *RangeAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeAprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeAprResponse proto.InternalMessageInfo
+
+func (m *RangeAprResponse) GetLookbackStartTime() time.Time {
+	if m != nil {
+		return m.LookbackStartTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
+	return time.Time{}
+}
+
+func (m *RangeAprResponse) GetUnpricedDenoms() []string {
+	if m != nil {
+		return m.UnpricedDenoms
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
+	return nil
+}
+
+// =============================== PoolHooks
+type PoolHooksRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *PoolHooksRequest) Reset()         { *m = PoolHooksRequest{} }
+func (m *PoolHooksRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolHooksRequest) ProtoMessage()    {}
+func (*PoolHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{44}
+}
+func (m *PoolHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PoolHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHooksRequest.Merge(m, src)
+}
+func (m *PoolHooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHooksRequest.DiscardUnknown(m)
 }
 
-func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumNextInitializedTicksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_PoolHooksRequest proto.InternalMessageInfo
+
+func (m *PoolHooksRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
+	return 0
+}
+
+type PoolHookStatus struct {
+	Hook types1.PoolHook `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook" yaml:"hook"`
+	// last_error is the last error of the hook ignored by the failure policy of
+	// the pool. It is unset if the hook never failed under that policy.
+	LastError *types1.PoolHookError `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" yaml:"last_error"`
+}
+
+func (m *PoolHookStatus) Reset()         { *m = PoolHookStatus{} }
+func (m *PoolHookStatus) String() string { return proto.CompactTextString(m) }
+func (*PoolHookStatus) ProtoMessage()    {}
+func (*PoolHookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{45}
+}
+func (m *PoolHookStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHookStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
+}
+func (m *PoolHookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHookStatus.Merge(m, src)
+}
+func (m *PoolHookStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHookStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHookStatus proto.InternalMessageInfo
+
+func (m *PoolHookStatus) GetHook() types1.PoolHook {
+	if m != nil {
+		return m.Hook
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
+	return types1.PoolHook{}
+}
+
+func (m *PoolHookStatus) GetLastError() *types1.PoolHookError {
+	if m != nil {
+		return m.LastError
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "LiquidityPerTickRange",
-			Handler:    _Query_LiquidityPerTickRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableSpreadRewards",
-			Handler:    _Query_ClaimableSpreadRewards_Handler,
-		},
-		{
-			MethodName: "ClaimableIncentives",
-			Handler:    _Query_ClaimableIncentives_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
-		{
-			MethodName: "PoolAccumulatorRewards",
-			Handler:    _Query_PoolAccumulatorRewards_Handler,
-		},
-		{
-			MethodName: "IncentiveRecords",
-			Handler:    _Query_IncentiveRecords_Handler,
-		},
-		{
-			MethodName: "TickAccumulatorTrackers",
-			Handler:    _Query_TickAccumulatorTrackers_Handler,
-		},
-		{
-			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
-			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
-		},
-		{
-			MethodName: "UserUnbondingPositions",
-			Handler:    _Query_UserUnbondingPositions_Handler,
-		},
-		{
-			MethodName: "GetTotalLiquidity",
-			Handler:    _Query_GetTotalLiquidity_Handler,
-		},
-		{
-			MethodName: "NumNextInitializedTicks",
-			Handler:    _Query_NumNextInitializedTicks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+type PoolHooksResponse struct {
+	FailurePolicy types1.PoolHookFailurePolicy `protobuf:"varint,1,opt,name=failure_policy,json=failurePolicy,proto3,enum=osmosis.concentratedliquidity.v1beta1.PoolHookFailurePolicy" json:"failure_policy,omitempty" yaml:"failure_policy"`
+	// hooks are ordered by action prefix.
+	Hooks []PoolHookStatus `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks"`
 }
 
-func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolHooksResponse) Reset()         { *m = PoolHooksResponse{} }
+func (m *PoolHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolHooksResponse) ProtoMessage()    {}
+func (*PoolHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{46}
 }
-
-func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PoolHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHooksResponse.Merge(m, src)
 }
-
-func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHooksResponse.DiscardUnknown(m)
 }
 
-func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_PoolHooksResponse proto.InternalMessageInfo
+
+func (m *PoolHooksResponse) GetFailurePolicy() types1.PoolHookFailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
 	}
-	return len(dAtA) - i, nil
+	return types1.PoolHookFailurePolicyRevert
 }
 
-func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolHooksResponse) GetHooks() []PoolHookStatus {
+	if m != nil {
+		return m.Hooks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
+	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
+	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
+	proto.RegisterType((*NumPoolPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsRequest")
+	proto.RegisterType((*NumPoolPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumPoolPositionsResponse")
+	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
+	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
+	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
+	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
+	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
+	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
+	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
+	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
+	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
+	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
+	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
+	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
+	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
+	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
+	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
+	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
+	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
+	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
+	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*RangeOrdersByOwnerRequest)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrdersByOwnerRequest")
+	proto.RegisterType((*RangeOrdersByOwnerResponse)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrdersByOwnerResponse")
+	proto.RegisterType((*RangeOrdersByPoolRequest)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrdersByPoolRequest")
+	proto.RegisterType((*RangeOrdersByPoolResponse)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrdersByPoolResponse")
+	proto.RegisterType((*LiquiditySnapshotsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquiditySnapshotsRequest")
+	proto.RegisterType((*LiquiditySnapshotsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquiditySnapshotsResponse")
+	proto.RegisterType((*DynamicSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorRequest")
+	proto.RegisterType((*DynamicSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorResponse")
+	proto.RegisterType((*RangeAprRequest)(nil), "osmosis.concentratedliquidity.v1beta1.RangeAprRequest")
+	proto.RegisterType((*RangeAprResponse)(nil), "osmosis.concentratedliquidity.v1beta1.RangeAprResponse")
+	proto.RegisterType((*PoolHooksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHooksRequest")
+	proto.RegisterType((*PoolHookStatus)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHookStatus")
+	proto.RegisterType((*PoolHooksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHooksResponse")
 }
 
-func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
 }
 
-func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_5da291368ba4d8e3 = []byte{
+	// 3290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
+	0x19, 0xce, 0xd8, 0x71, 0x92, 0xfd, 0xe3, 0xf8, 0x72, 0xec, 0x24, 0xf6, 0x24, 0xf1, 0xa6, 0x87,
+	0xa6, 0x8d, 0x68, 0xb3, 0x4b, 0xd2, 0x5c, 0x9a, 0x4b, 0x9b, 0x78, 0xed, 0x38, 0x35, 0x4d, 0x1d,
+	0x67, 0x92, 0x40, 0x55, 0x55, 0x4c, 0x67, 0x67, 0x8e, 0xd7, 0xc3, 0xee, 0xce, 0x6c, 0xe6, 0x92,
+	0xc4, 0x0d, 0x41, 0x55, 0xfb, 0x88, 0x04, 0x45, 0x20, 0xc4, 0x03, 0xaa, 0x84, 0x78, 0x41, 0x15,
+	0x8f, 0xbc, 0xc0, 0x4b, 0x29, 0x48, 0xa8, 0x42, 0xa8, 0xaa, 0x84, 0x40, 0xa8, 0x0f, 0x2e, 0xa4,
+	0x15, 0x20, 0xb5, 0xf0, 0xe0, 0x4a, 0xa8, 0x8f, 0xe8, 0xdc, 0x66, 0x67, 0x77, 0x67, 0x93, 0xd9,
+	0xd9, 0x50, 0x09, 0xf1, 0x64, 0xcf, 0x9c, 0xf3, 0x7f, 0xff, 0xf5, 0xfc, 0xe7, 0x9c, 0xff, 0x9f,
+	0x85, 0xc3, 0xae, 0x5f, 0x77, 0x7d, 0xdb, 0x2f, 0x9a, 0xae, 0x63, 0x12, 0x27, 0xf0, 0x8c, 0x80,
+	0x58, 0x35, 0xfb, 0x7a, 0x68, 0x5b, 0x76, 0xb0, 0x56, 0xbc, 0x71, 0xb8, 0x4c, 0x02, 0xe3, 0x70,
+	0xf1, 0x7a, 0x48, 0xbc, 0xb5, 0x42, 0xc3, 0x73, 0x03, 0x17, 0x1d, 0x10, 0x24, 0x85, 0x44, 0x92,
+	0x82, 0x20, 0x51, 0x27, 0x2b, 0x6e, 0xc5, 0x65, 0x14, 0x45, 0xfa, 0x1f, 0x27, 0x56, 0xbf, 0x78,
+	0x6f, 0x7e, 0x0d, 0xc3, 0x33, 0xea, 0xbe, 0x98, 0x7b, 0x2c, 0x9d, 0x6c, 0x81, 0x6d, 0x56, 0x75,
+	0xdb, 0x59, 0x91, 0x2c, 0x66, 0x4c, 0x46, 0x57, 0x2c, 0x1b, 0x3e, 0x89, 0x26, 0x99, 0xae, 0xed,
+	0x48, 0x11, 0xe2, 0xe3, 0x4c, 0xb1, 0x68, 0x56, 0xc3, 0xa8, 0xd8, 0x8e, 0x11, 0xd8, 0xae, 0x9c,
+	0xbb, 0xb7, 0xe2, 0xba, 0x95, 0x1a, 0x29, 0x1a, 0x0d, 0xbb, 0x68, 0x38, 0x8e, 0x1b, 0xb0, 0x41,
+	0x29, 0xe0, 0xb4, 0x18, 0x65, 0x4f, 0xe5, 0x70, 0xa5, 0x68, 0x38, 0xc2, 0x48, 0x6a, 0xbe, 0x7d,
+	0x28, 0xb0, 0xeb, 0xc4, 0x0f, 0x8c, 0x7a, 0x43, 0x4a, 0xd9, 0x3e, 0xc1, 0x0a, 0xbd, 0x38, 0xe7,
+	0x69, 0x2e, 0xa5, 0xce, 0x2d, 0xc8, 0x1f, 0xc4, 0xd0, 0xd1, 0x74, 0x76, 0x69, 0xb8, 0xbe, 0x1d,
+	0x03, 0x3c, 0x93, 0x8e, 0xca, 0x66, 0x83, 0xf6, 0x0d, 0xa2, 0x7b, 0xc4, 0x74, 0x3d, 0x4b, 0x50,
+	0x9f, 0x48, 0x47, 0xed, 0x19, 0x4e, 0x85, 0xe8, 0xae, 0x67, 0x11, 0x4f, 0x10, 0x3e, 0x9d, 0x8e,
+	0x30, 0x7a, 0xa3, 0xfb, 0x8e, 0xd1, 0xf0, 0x57, 0xdd, 0x40, 0xd0, 0xcf, 0xa6, 0xa3, 0xb7, 0xd6,
+	0x1c, 0xa3, 0x6e, 0x9b, 0xba, 0xdf, 0xf0, 0x88, 0x61, 0xe9, 0x2b, 0x86, 0x19, 0xb8, 0x52, 0x84,
+	0xe3, 0x69, 0xed, 0xe5, 0xd6, 0xf4, 0x55, 0xd7, 0xad, 0x0a, 0x3b, 0xe3, 0x5f, 0x28, 0x30, 0x79,
+	0xcd, 0x27, 0xde, 0xb2, 0x30, 0xa4, 0xaf, 0x91, 0xeb, 0x21, 0xf1, 0x03, 0xf4, 0x38, 0x6c, 0x35,
+	0x2c, 0xcb, 0x23, 0xbe, 0x3f, 0xa5, 0xec, 0x57, 0x0e, 0xe6, 0x4a, 0x68, 0x63, 0x3d, 0x3f, 0xb2,
+	0x66, 0xd4, 0x6b, 0xa7, 0xb0, 0x18, 0xc0, 0x9a, 0x9c, 0x82, 0x1e, 0x83, 0xad, 0x0c, 0xda, 0xb6,
+	0xa6, 0x06, 0xf6, 0x2b, 0x07, 0x37, 0xc7, 0x67, 0x8b, 0x01, 0xac, 0x6d, 0xa1, 0xff, 0x2d, 0x5a,
+	0x68, 0x01, 0xa0, 0x19, 0x84, 0x53, 0x83, 0xfb, 0x95, 0x83, 0xdb, 0x8f, 0x3c, 0x52, 0x10, 0xee,
+	0xa7, 0x11, 0x5b, 0xe0, 0x4b, 0x51, 0x08, 0x5d, 0x58, 0x36, 0x2a, 0x44, 0x88, 0xa5, 0xc5, 0x28,
+	0xf1, 0xaf, 0x15, 0xd8, 0xd9, 0x26, 0xbb, 0xdf, 0x70, 0x1d, 0x9f, 0xa0, 0x97, 0x20, 0x27, 0x23,
+	0x83, 0x8a, 0x3f, 0x78, 0x70, 0xfb, 0x91, 0x33, 0x85, 0x54, 0x4b, 0xba, 0xb0, 0x10, 0xd6, 0x6a,
+	0x12, 0xb0, 0xe4, 0x11, 0xa3, 0x6a, 0xb9, 0x37, 0x9d, 0xd2, 0xe6, 0x77, 0xd6, 0xf3, 0x9b, 0xb4,
+	0x26, 0x28, 0xba, 0xd0, 0xa2, 0xc3, 0x00, 0xd3, 0xe1, 0xd1, 0xfb, 0xea, 0xc0, 0xc5, 0x6b, 0x51,
+	0x62, 0x09, 0x26, 0x22, 0x76, 0x6b, 0x8b, 0x96, 0x34, 0xff, 0x09, 0xd8, 0x2e, 0x99, 0x51, 0xa3,
+	0x2a, 0xcc, 0xa8, 0xbb, 0x36, 0xd6, 0xf3, 0x48, 0x1a, 0x35, 0x1a, 0xc4, 0x1a, 0xc8, 0xa7, 0x45,
+	0x0b, 0xdf, 0x80, 0xc9, 0x56, 0x3c, 0x61, 0x92, 0xaf, 0xc1, 0x36, 0x39, 0x8b, 0xa1, 0x3d, 0x18,
+	0x8b, 0x44, 0x98, 0x78, 0x01, 0x76, 0x2f, 0x85, 0xf5, 0x65, 0xd7, 0xad, 0x75, 0x84, 0x52, 0x2c,
+	0x38, 0x94, 0xfb, 0x05, 0x07, 0x7e, 0x11, 0xa6, 0x3a, 0x71, 0x84, 0x0e, 0xe7, 0x60, 0x24, 0xd2,
+	0xdb, 0x74, 0x43, 0x27, 0x10, 0x78, 0xd3, 0x1b, 0xeb, 0xf9, 0x9d, 0x6d, 0x76, 0x61, 0xe3, 0x58,
+	0xdb, 0x21, 0x5f, 0xcc, 0xb1, 0xe7, 0xaf, 0xc0, 0x30, 0x85, 0x8e, 0x44, 0x5b, 0x48, 0x70, 0x63,
+	0x96, 0x50, 0xfc, 0x8e, 0x02, 0x3b, 0x04, 0xb0, 0x90, 0xf5, 0x18, 0x0c, 0x51, 0x8d, 0x64, 0xf8,
+	0x4d, 0x16, 0x78, 0x2e, 0x2c, 0xc8, 0x5c, 0x58, 0x98, 0x75, 0xd6, 0x4a, 0xb9, 0xdf, 0xfd, 0xfc,
+	0xd0, 0x10, 0xa5, 0x5b, 0xd4, 0xf8, 0xec, 0x07, 0x17, 0x57, 0xa3, 0xb0, 0x63, 0x99, 0x6d, 0x34,
+	0x42, 0x5c, 0x7c, 0x0d, 0x46, 0xe4, 0x0b, 0x21, 0xe2, 0x1c, 0x6c, 0xe1, 0x7b, 0x91, 0x08, 0x88,
+	0x03, 0xf7, 0x09, 0x08, 0x4e, 0x2e, 0x3c, 0x2f, 0x48, 0xf1, 0x9b, 0x0a, 0x8c, 0x5d, 0xb5, 0xcd,
+	0xea, 0x45, 0x39, 0x6d, 0x89, 0x04, 0xe8, 0x25, 0xd8, 0xd1, 0x4c, 0x76, 0x0e, 0x09, 0x44, 0x0a,
+	0x39, 0x4d, 0x29, 0xdf, 0x5f, 0xcf, 0xef, 0xe1, 0xfa, 0xf8, 0x56, 0xb5, 0x60, 0xbb, 0xc5, 0xba,
+	0x11, 0xac, 0x16, 0x2e, 0x92, 0x8a, 0x61, 0xae, 0xcd, 0x13, 0x73, 0x63, 0x3d, 0x3f, 0xc9, 0x5d,
+	0xd9, 0x82, 0x80, 0xb5, 0xe1, 0x5a, 0x9c, 0xc3, 0x51, 0x00, 0xb1, 0x27, 0x5a, 0xe4, 0x16, 0xb3,
+	0xd3, 0x60, 0x69, 0xe7, 0xc6, 0x7a, 0x7e, 0x9c, 0xd3, 0x36, 0xc7, 0xb0, 0x96, 0xa3, 0x0f, 0x8b,
+	0xec, 0xff, 0x7f, 0x2a, 0xb0, 0x3b, 0x12, 0x74, 0x9e, 0x34, 0x82, 0xd5, 0xaf, 0xda, 0xc1, 0xaa,
+	0x46, 0x13, 0x3a, 0x5a, 0x81, 0xb1, 0x26, 0x47, 0xa3, 0x1e, 0x85, 0x57, 0x9f, 0x62, 0x8f, 0x46,
+	0xcf, 0xb3, 0x0c, 0x93, 0x4a, 0x5e, 0x73, 0x6f, 0x12, 0x4f, 0xa7, 0x62, 0x75, 0x4a, 0xde, 0x1c,
+	0xc3, 0x5a, 0x8e, 0x3d, 0x50, 0xeb, 0x52, 0xaa, 0xb0, 0xd1, 0x90, 0x54, 0x83, 0xed, 0x54, 0xcd,
+	0x31, 0xac, 0xe5, 0xd8, 0x03, 0xa5, 0xc2, 0x1f, 0x0c, 0xc0, 0x4c, 0xdc, 0x31, 0x8b, 0xce, 0xbc,
+	0xed, 0x11, 0x93, 0x06, 0x48, 0x96, 0xc5, 0x89, 0x0a, 0xb0, 0x2d, 0x70, 0xab, 0xc4, 0xd1, 0x6d,
+	0x1e, 0x9b, 0xb9, 0xd2, 0xc4, 0xc6, 0x7a, 0x7e, 0x54, 0xd8, 0x5c, 0x8c, 0x60, 0x6d, 0x2b, 0xfb,
+	0x77, 0xd1, 0xa1, 0x52, 0xfb, 0x81, 0xe1, 0x05, 0x5d, 0xa4, 0x6e, 0x8e, 0x61, 0x2d, 0xc7, 0x1e,
+	0x98, 0xae, 0x27, 0x61, 0x38, 0xf4, 0x89, 0x6e, 0x86, 0x42, 0xdb, 0xcd, 0xfb, 0x95, 0x83, 0xdb,
+	0x4a, 0xbb, 0x37, 0xd6, 0xf3, 0x13, 0x42, 0xdb, 0xd8, 0x28, 0xd6, 0x20, 0xf4, 0xc9, 0x5c, 0x18,
+	0x99, 0xa9, 0xec, 0x86, 0x8e, 0xc5, 0x09, 0x87, 0xda, 0x19, 0x36, 0xc7, 0xb0, 0x96, 0x63, 0x0f,
+	0x71, 0x86, 0x8e, 0xab, 0xb3, 0x77, 0x53, 0x5b, 0x92, 0x18, 0xca, 0x51, 0xce, 0x70, 0xc9, 0x2d,
+	0xb1, 0x87, 0x1f, 0x0f, 0x42, 0xbe, 0xab, 0x85, 0xc5, 0x3a, 0x5b, 0x8d, 0x47, 0x96, 0x45, 0xa3,
+	0x4e, 0x66, 0x85, 0x13, 0x29, 0x53, 0x70, 0xfb, 0x02, 0x13, 0x6b, 0x70, 0xb4, 0xd6, 0x12, 0xcb,
+	0x3e, 0x7a, 0x08, 0x86, 0xcd, 0xd0, 0xf3, 0x88, 0x13, 0xc4, 0xa2, 0x4b, 0xdb, 0x2e, 0xde, 0x31,
+	0x5d, 0x6b, 0x30, 0x2e, 0xa7, 0x44, 0xd4, 0xcc, 0x33, 0xb9, 0xd2, 0xd9, 0x74, 0x71, 0x3e, 0xc5,
+	0x6d, 0xd2, 0x81, 0x82, 0xb5, 0x31, 0xf1, 0x2e, 0x12, 0x15, 0xbd, 0xaa, 0x00, 0x92, 0x13, 0xfd,
+	0xeb, 0x5e, 0xa0, 0x37, 0x3c, 0xdb, 0x24, 0xcc, 0xa3, 0xb9, 0xd2, 0x55, 0xc1, 0xaf, 0x58, 0xb1,
+	0x83, 0xd5, 0xb0, 0x5c, 0x30, 0xdd, 0x7a, 0x51, 0xd8, 0xe3, 0x50, 0xcd, 0x28, 0xfb, 0xf2, 0x81,
+	0xfd, 0x65, 0x62, 0x94, 0xec, 0x0a, 0x97, 0x61, 0xba, 0x55, 0x86, 0x26, 0x74, 0x53, 0x88, 0x2b,
+	0xd7, 0xbd, 0x60, 0x99, 0xbd, 0x7a, 0x16, 0xf6, 0x46, 0x12, 0x2d, 0xf3, 0x95, 0xc1, 0x96, 0x7c,
+	0xa6, 0xfd, 0xe9, 0x2d, 0x05, 0xf6, 0x75, 0x41, 0x13, 0xee, 0x2e, 0x43, 0xae, 0x69, 0x59, 0xee,
+	0xe7, 0xa7, 0x53, 0xfa, 0xb9, 0x4b, 0x6e, 0x92, 0xc7, 0x8f, 0x88, 0x00, 0x9d, 0x82, 0xe1, 0x72,
+	0x68, 0x56, 0x49, 0xd0, 0x92, 0x00, 0x63, 0x11, 0x1b, 0x1f, 0xc5, 0xda, 0x76, 0xfe, 0xc8, 0x93,
+	0xe0, 0xf3, 0xb0, 0x6f, 0xae, 0x66, 0xd8, 0x75, 0xa3, 0x5c, 0x23, 0x57, 0xd8, 0x51, 0x52, 0x23,
+	0x37, 0x0d, 0xcf, 0xf2, 0xfb, 0x3e, 0x7b, 0xbc, 0xa1, 0xc0, 0x4c, 0x37, 0x68, 0x61, 0x9c, 0x6f,
+	0xc0, 0x94, 0x29, 0x67, 0xc8, 0x83, 0xac, 0xc7, 0xe7, 0x08, 0x5b, 0x4d, 0xb7, 0xec, 0x76, 0xd2,
+	0x32, 0x73, 0xae, 0xed, 0x94, 0x1e, 0xa5, 0x66, 0xd8, 0x58, 0xcf, 0xe7, 0x85, 0xf7, 0xbb, 0x00,
+	0x61, 0x6d, 0x97, 0x99, 0x28, 0x05, 0xbe, 0x06, 0x6a, 0x24, 0xdf, 0xa2, 0xbc, 0x04, 0xf4, 0xaf,
+	0xf7, 0x6b, 0x03, 0xb0, 0x27, 0x11, 0x57, 0x28, 0x7d, 0x1d, 0x26, 0x9b, 0xb2, 0x46, 0x97, 0x8f,
+	0x14, 0x0a, 0x7f, 0x41, 0x28, 0xbc, 0xa7, 0x5d, 0xe1, 0x26, 0x08, 0xd6, 0x26, 0xcc, 0x4e, 0xd6,
+	0x94, 0xe5, 0x8a, 0xeb, 0xad, 0x10, 0x3b, 0x20, 0x56, 0x9c, 0xe5, 0x40, 0x8f, 0x2c, 0x93, 0x40,
+	0xb0, 0x36, 0x11, 0xbd, 0x6e, 0xb2, 0xc4, 0x17, 0x61, 0x1f, 0x3d, 0xca, 0xcc, 0x9a, 0x66, 0x58,
+	0x0f, 0x6b, 0x46, 0xe0, 0x7a, 0x6d, 0x71, 0xd5, 0xd3, 0x3a, 0x7b, 0x7b, 0x00, 0x66, 0xba, 0xc1,
+	0x09, 0xb3, 0xbe, 0xae, 0xc0, 0x9e, 0x16, 0xcf, 0xeb, 0x15, 0xcf, 0xbd, 0x19, 0xac, 0xea, 0x95,
+	0x9a, 0x5b, 0x36, 0x6a, 0xc2, 0xbc, 0x7b, 0x13, 0x75, 0x9d, 0x27, 0x26, 0x53, 0xf7, 0x09, 0xaa,
+	0xee, 0x9b, 0x1f, 0xe4, 0x1f, 0x8b, 0xe5, 0x20, 0x3e, 0x5f, 0xfc, 0x39, 0xe4, 0x5b, 0xd5, 0x62,
+	0xb0, 0xd6, 0x20, 0xbe, 0xa4, 0xf1, 0xb5, 0x29, 0x3f, 0x16, 0x55, 0x17, 0x18, 0xcf, 0x0b, 0x8c,
+	0x25, 0xfa, 0x96, 0x02, 0x93, 0x61, 0x83, 0xde, 0x83, 0xdb, 0x64, 0xe1, 0x76, 0x3f, 0x9a, 0x32,
+	0x0f, 0x5c, 0x63, 0x10, 0x57, 0x3d, 0xc3, 0xac, 0x12, 0xaf, 0xdd, 0x25, 0x49, 0xf8, 0x58, 0x43,
+	0xfc, 0x75, 0x5c, 0x1a, 0xfc, 0x9a, 0x02, 0x33, 0x34, 0x3f, 0xc5, 0x6c, 0x28, 0x30, 0x33, 0xf9,
+	0x24, 0xe3, 0xa1, 0xeb, 0xe3, 0x01, 0xc8, 0x77, 0x95, 0x42, 0xb8, 0xf2, 0x1d, 0x05, 0x4e, 0x26,
+	0xba, 0xd2, 0x6d, 0xb0, 0x75, 0x46, 0x74, 0x4b, 0x6e, 0xab, 0xba, 0xbb, 0xa2, 0xd7, 0x0c, 0x3f,
+	0xd0, 0x03, 0xcf, 0xb8, 0x41, 0x3c, 0xff, 0xbf, 0xe9, 0xe8, 0x23, 0x9d, 0x8e, 0xbe, 0x24, 0x04,
+	0x8a, 0xb6, 0xf9, 0x4b, 0x2b, 0x17, 0x0d, 0x3f, 0xb8, 0x2a, 0x85, 0x41, 0x77, 0x60, 0x54, 0x78,
+	0x28, 0x10, 0x5a, 0xf6, 0xe5, 0xfc, 0x19, 0xe1, 0xfc, 0x5d, 0x2d, 0xce, 0x97, 0xd0, 0x58, 0x1b,
+	0x09, 0xe3, 0xd3, 0x7d, 0xfc, 0x6d, 0x05, 0x76, 0x47, 0x8b, 0x52, 0x63, 0xe5, 0x8d, 0x6c, 0xce,
+	0x7e, 0x50, 0x57, 0xa3, 0x77, 0x15, 0x98, 0xea, 0x14, 0x48, 0xf8, 0xdd, 0x86, 0xf1, 0xf6, 0x62,
+	0x8c, 0x4c, 0x8b, 0xc7, 0x53, 0x9a, 0xab, 0x0d, 0x5b, 0xec, 0x95, 0x63, 0x76, 0x1b, 0xcb, 0x07,
+	0x77, 0xb3, 0x7a, 0x45, 0x81, 0xc7, 0xe6, 0x16, 0x9e, 0x7b, 0x8e, 0xdd, 0xdb, 0xac, 0x8b, 0xb6,
+	0x53, 0x5d, 0xf0, 0xdc, 0xfa, 0x5c, 0x4c, 0x48, 0x3e, 0x22, 0xad, 0x7e, 0x19, 0x26, 0xe3, 0x1a,
+	0xe8, 0xad, 0x2e, 0xc8, 0xc7, 0xd2, 0x7b, 0xc2, 0x2c, 0xac, 0x21, 0xb3, 0x03, 0x19, 0xdb, 0xf0,
+	0x78, 0x3a, 0x09, 0x84, 0x99, 0x4f, 0xc2, 0xb0, 0xb9, 0x52, 0xaf, 0xb7, 0xb1, 0x8e, 0x1d, 0x17,
+	0xe2, 0xa3, 0x58, 0x03, 0xfa, 0x28, 0x58, 0x3d, 0x07, 0xfb, 0x68, 0x8d, 0xe5, 0x9a, 0x53, 0x76,
+	0x1d, 0xcb, 0x76, 0x2a, 0xfd, 0x15, 0x8a, 0xf0, 0x4f, 0x14, 0x98, 0xe9, 0x86, 0x27, 0x84, 0x7d,
+	0x45, 0x01, 0x35, 0x2a, 0xb4, 0xe8, 0x37, 0xed, 0x60, 0x55, 0x6f, 0x10, 0xcf, 0x76, 0x2d, 0xbd,
+	0xe6, 0x9a, 0x55, 0x11, 0x1d, 0x4f, 0xa5, 0x8c, 0x0e, 0x09, 0x4f, 0xcf, 0x52, 0xcb, 0x0c, 0xe5,
+	0xa2, 0x6b, 0x56, 0x45, 0x90, 0xec, 0x8e, 0xd8, 0xb4, 0x0e, 0x63, 0x15, 0xa6, 0x2e, 0x90, 0xe0,
+	0xaa, 0x1b, 0x18, 0xb5, 0xe8, 0x48, 0x26, 0xef, 0xd1, 0xdf, 0x55, 0x60, 0x3a, 0x61, 0x50, 0x08,
+	0x1f, 0xc0, 0x68, 0x40, 0x47, 0xf4, 0xf6, 0x23, 0xe0, 0x3d, 0xb6, 0xdc, 0x2f, 0x89, 0xd4, 0x74,
+	0x30, 0x45, 0x6a, 0xe2, 0x79, 0x69, 0x24, 0x68, 0xe1, 0x8e, 0x37, 0x14, 0x98, 0x59, 0x0a, 0xeb,
+	0x4b, 0xe4, 0x56, 0xb0, 0xe8, 0xd8, 0x81, 0x6d, 0xd4, 0xec, 0x97, 0x09, 0xbb, 0xdb, 0x64, 0x5b,
+	0xfb, 0x67, 0x61, 0x44, 0xde, 0xe6, 0x74, 0x8b, 0x38, 0x6e, 0x5d, 0xdc, 0xf6, 0x62, 0x85, 0x96,
+	0xd6, 0x71, 0xac, 0x0d, 0x8b, 0x3b, 0xdf, 0x3c, 0x7d, 0x44, 0x65, 0x50, 0x9d, 0xb0, 0xae, 0x3b,
+	0xe4, 0x16, 0x3d, 0x83, 0x46, 0x12, 0xb1, 0x5b, 0x89, 0xcf, 0xae, 0x1b, 0x9b, 0x4b, 0x07, 0x36,
+	0xd6, 0xf3, 0x0f, 0x71, 0xb0, 0xee, 0x73, 0xb1, 0xb6, 0xdb, 0x49, 0x56, 0x0c, 0xff, 0x68, 0x00,
+	0xf2, 0x5d, 0x95, 0xfe, 0xbf, 0xbf, 0x7a, 0x51, 0xf3, 0x4c, 0xb3, 0xdb, 0xc3, 0x25, 0x5a, 0xa9,
+	0xf6, 0x4b, 0x6b, 0x97, 0x6e, 0x3a, 0xc4, 0x93, 0xe1, 0xf0, 0x08, 0x0c, 0xb9, 0xf4, 0x59, 0xac,
+	0xd9, 0xb1, 0x8d, 0xf5, 0xfc, 0x30, 0x07, 0x67, 0xaf, 0xb1, 0xc6, 0x87, 0x7b, 0x2b, 0xec, 0x96,
+	0x61, 0x8b, 0x1f, 0x18, 0x41, 0xc8, 0x3d, 0x3c, 0x92, 0xda, 0xc6, 0x4d, 0x31, 0xaf, 0x30, 0xf2,
+	0xd2, 0xf8, 0xc6, 0x7a, 0x7e, 0x47, 0x54, 0x23, 0x08, 0x42, 0x1f, 0x6b, 0x02, 0xb9, 0x6d, 0x5b,
+	0xda, 0x9c, 0x79, 0x5b, 0x7a, 0x5b, 0x01, 0x35, 0xc9, 0x3c, 0x22, 0x70, 0x5e, 0x80, 0xe1, 0x58,
+	0x9d, 0x5f, 0x06, 0xcd, 0xe1, 0x9e, 0x15, 0x12, 0xe1, 0xb2, 0xdd, 0x6b, 0xb2, 0x7a, 0x70, 0x3b,
+	0xd1, 0xa7, 0x0a, 0x4c, 0xb5, 0xe8, 0x40, 0x73, 0x76, 0xa6, 0x05, 0xdf, 0xf4, 0xdc, 0xc0, 0xe7,
+	0xe4, 0xb9, 0xec, 0x65, 0xff, 0x5f, 0x29, 0x30, 0x9d, 0xa0, 0xf5, 0xff, 0x92, 0xe3, 0xfe, 0xad,
+	0xc0, 0x74, 0xb4, 0x52, 0xaf, 0x88, 0x66, 0x50, 0xb6, 0x54, 0xfd, 0x7c, 0xb3, 0xc4, 0x56, 0x27,
+	0x42, 0x26, 0xb5, 0xa3, 0xd8, 0x7c, 0x55, 0x76, 0xe6, 0x4a, 0xfb, 0xc4, 0x79, 0xb2, 0xad, 0x04,
+	0x57, 0x27, 0xf8, 0xf5, 0x0f, 0xf2, 0x4a, 0x54, 0x86, 0xab, 0x13, 0xa4, 0xc1, 0x36, 0xc2, 0xaa,
+	0x65, 0x75, 0x32, 0x35, 0x78, 0x5f, 0xdc, 0x3d, 0x02, 0x57, 0x14, 0x03, 0x25, 0x25, 0x47, 0xdd,
+	0x4a, 0x68, 0xa5, 0xad, 0x4e, 0xf0, 0xcb, 0xa0, 0x26, 0xe9, 0x2d, 0x7c, 0xf7, 0x22, 0xe4, 0x64,
+	0x67, 0x4c, 0x3a, 0xee, 0xc9, 0x5e, 0x2b, 0x27, 0x12, 0x55, 0xd6, 0x4c, 0x22, 0x40, 0xbc, 0x08,
+	0xea, 0x3c, 0xef, 0xa0, 0xf1, 0xa2, 0xc0, 0x02, 0xeb, 0x9f, 0x65, 0xba, 0x9c, 0xfe, 0x60, 0x00,
+	0xf6, 0x24, 0x62, 0x09, 0x45, 0xbe, 0xaf, 0xc0, 0xce, 0xc4, 0x6e, 0x9d, 0xa8, 0xb4, 0x9f, 0x4a,
+	0xa9, 0x55, 0x02, 0x8f, 0xd2, 0xc3, 0xc2, 0xd0, 0x7b, 0xb9, 0x6c, 0x89, 0x6c, 0xb0, 0x36, 0x61,
+	0x75, 0x92, 0xd2, 0xb2, 0x7c, 0xab, 0x34, 0x03, 0x19, 0xea, 0xdb, 0x6d, 0x8c, 0x86, 0xfd, 0x18,
+	0x07, 0xfc, 0xd9, 0x00, 0x8c, 0xb2, 0x35, 0x34, 0xdb, 0xf0, 0xb2, 0x5e, 0x31, 0x3f, 0xaf, 0xea,
+	0x38, 0x0d, 0xf0, 0x9a, 0xeb, 0x56, 0xcb, 0x86, 0xa8, 0x31, 0xd3, 0x43, 0x5a, 0x7b, 0x80, 0xcf,
+	0x8b, 0x8e, 0x75, 0x7b, 0x7c, 0x4b, 0x42, 0xfc, 0x43, 0x1a, 0xdf, 0x11, 0x0e, 0xad, 0x21, 0x5d,
+	0x0f, 0x5d, 0x7a, 0x5f, 0x65, 0xc7, 0xa6, 0x21, 0x66, 0xe0, 0x58, 0x0d, 0x29, 0x36, 0x88, 0x35,
+	0x60, 0x4f, 0xfc, 0xc4, 0x74, 0x16, 0x46, 0x58, 0x01, 0x53, 0x9e, 0xc2, 0xfd, 0xa9, 0x2d, 0xfb,
+	0x07, 0xdb, 0x7a, 0x5b, 0x2d, 0xe3, 0x58, 0x1b, 0x66, 0x2f, 0xf8, 0x39, 0xdd, 0xc7, 0xbf, 0xdf,
+	0x0c, 0x63, 0x4d, 0xd3, 0x47, 0x2d, 0x9e, 0xc1, 0xe0, 0x46, 0x4d, 0x6c, 0xf2, 0x87, 0xd3, 0xf9,
+	0x19, 0x38, 0xb7, 0xe0, 0x46, 0x0d, 0x6b, 0x94, 0x1a, 0x55, 0x61, 0xbc, 0xf5, 0x6e, 0x6e, 0x34,
+	0x64, 0xe8, 0xf4, 0x76, 0x6e, 0xe9, 0x40, 0xc1, 0xda, 0x68, 0xfc, 0x86, 0x3d, 0xdb, 0x60, 0x31,
+	0xda, 0xbc, 0x11, 0x52, 0x46, 0x83, 0x19, 0x62, 0xb4, 0x05, 0x01, 0x6b, 0xc3, 0xd1, 0x33, 0xe5,
+	0x50, 0xa5, 0x77, 0x4e, 0x9d, 0x6f, 0x12, 0x2b, 0x9e, 0x61, 0x46, 0x07, 0x89, 0x5e, 0xd5, 0xe9,
+	0x40, 0xc1, 0xda, 0xa8, 0xed, 0x30, 0x1f, 0x2c, 0x88, 0x37, 0xc8, 0x83, 0x09, 0x19, 0x1b, 0x7a,
+	0x2c, 0x4f, 0x0f, 0xdd, 0x37, 0x9f, 0x3e, 0x22, 0xe2, 0x4d, 0x6d, 0x8d, 0x37, 0xbd, 0x3d, 0x61,
+	0x8f, 0xcb, 0x91, 0x2b, 0x51, 0xe2, 0x9e, 0x83, 0xd1, 0xd0, 0x61, 0xb1, 0x61, 0xf1, 0x48, 0xe3,
+	0xb1, 0x94, 0x2b, 0xa9, 0xb1, 0x3a, 0x42, 0xeb, 0x04, 0x5a, 0x47, 0x10, 0x6f, 0xe6, 0xf9, 0x8b,
+	0xb3, 0x30, 0x46, 0x23, 0xeb, 0x19, 0xd7, 0xcd, 0x76, 0x87, 0xc0, 0x7f, 0x52, 0x60, 0x44, 0x22,
+	0xf0, 0xc3, 0x01, 0x7a, 0x1e, 0x36, 0xd3, 0x6f, 0x0f, 0x44, 0x12, 0x2c, 0xa6, 0xbe, 0xc2, 0x71,
+	0x90, 0xd2, 0x84, 0x30, 0xc9, 0x76, 0xce, 0x91, 0x42, 0x61, 0x8d, 0x21, 0xa2, 0xaf, 0x03, 0xb0,
+	0x9a, 0x10, 0xf1, 0x3c, 0x91, 0xd6, 0xd2, 0xd7, 0x5b, 0x24, 0xfe, 0x79, 0x4a, 0xdb, 0x92, 0x6c,
+	0x22, 0x44, 0x9a, 0x6c, 0x0c, 0x3f, 0x60, 0x33, 0xf0, 0xdf, 0x14, 0x18, 0x8f, 0x99, 0x46, 0xac,
+	0xb4, 0x6f, 0xc2, 0xc8, 0x8a, 0x61, 0xd7, 0x42, 0x8f, 0xae, 0xd0, 0x9a, 0x6d, 0xae, 0x31, 0x2d,
+	0x47, 0x52, 0x77, 0xd9, 0x25, 0xe2, 0x02, 0x07, 0x59, 0x66, 0x18, 0xf1, 0xd5, 0xdf, 0x8a, 0x8e,
+	0xb5, 0x1d, 0x2b, 0xf1, 0x99, 0xe8, 0x32, 0x0c, 0x51, 0x4b, 0xc8, 0x62, 0xd3, 0xb1, 0x1e, 0xd9,
+	0x8a, 0xe3, 0x1b, 0xdf, 0x34, 0x39, 0xd2, 0x91, 0x37, 0x0e, 0xc0, 0xd0, 0x65, 0x7a, 0xa0, 0x41,
+	0x3f, 0x55, 0x80, 0xb5, 0xa9, 0x7d, 0xf4, 0x44, 0x0f, 0xb8, 0x32, 0x6e, 0xd4, 0xa3, 0xbd, 0x11,
+	0x71, 0x8b, 0xe2, 0xa3, 0xaf, 0xfe, 0xe1, 0xa3, 0xef, 0x0d, 0x14, 0xd0, 0xe3, 0xc5, 0xf4, 0xdf,
+	0xb6, 0xf8, 0xe8, 0x67, 0x0a, 0x6c, 0xe1, 0x8d, 0x6a, 0x94, 0x9a, 0x6d, 0xbc, 0x4f, 0xae, 0x1e,
+	0xeb, 0x91, 0x4a, 0x48, 0x7b, 0x8c, 0x49, 0x5b, 0x44, 0x87, 0xd2, 0x4a, 0xcb, 0x65, 0x7c, 0x57,
+	0x81, 0x1d, 0x2d, 0xdf, 0xb0, 0xa0, 0xd3, 0x69, 0xcb, 0x84, 0x09, 0x5f, 0xed, 0xa8, 0x67, 0xb2,
+	0x11, 0x0b, 0x1d, 0x4a, 0x4c, 0x87, 0x33, 0xe8, 0x54, 0xb1, 0xb7, 0xaf, 0xaf, 0xfc, 0xe2, 0x6d,
+	0x51, 0xdf, 0xb9, 0x83, 0x3e, 0x56, 0x60, 0x67, 0x62, 0x7f, 0x0c, 0xcd, 0xf5, 0x7a, 0x94, 0x4b,
+	0xe8, 0xd5, 0xa9, 0xf3, 0xfd, 0x81, 0x08, 0x45, 0x2f, 0x30, 0x45, 0x67, 0xd1, 0xd9, 0x62, 0xaf,
+	0x5f, 0x6e, 0xc9, 0x83, 0x04, 0xcf, 0xfc, 0xe8, 0xd3, 0xf8, 0x07, 0x05, 0xad, 0xed, 0x5f, 0x74,
+	0xbe, 0x57, 0x51, 0x13, 0x1b, 0xf4, 0xea, 0x42, 0xbf, 0x30, 0x42, 0xe7, 0x45, 0xa6, 0xf3, 0x1c,
+	0x9a, 0xed, 0x59, 0x67, 0x87, 0x35, 0x12, 0x9b, 0x15, 0x78, 0xf4, 0x2f, 0x05, 0x76, 0x25, 0xf7,
+	0xf9, 0x50, 0x5a, 0xff, 0xdc, 0xb3, 0x03, 0xa9, 0x9e, 0xef, 0x13, 0x25, 0xa3, 0x9b, 0xbb, 0x35,
+	0x14, 0xd1, 0x5f, 0x15, 0x98, 0x48, 0x68, 0xf0, 0xa1, 0xd9, 0x5e, 0xe5, 0xec, 0x68, 0x3a, 0xaa,
+	0xa5, 0x7e, 0x20, 0x84, 0x9e, 0x73, 0x4c, 0xcf, 0xa7, 0xd0, 0xe9, 0x9e, 0xf5, 0x6c, 0x36, 0xf5,
+	0xd0, 0x6f, 0x15, 0xfa, 0x6d, 0x54, 0xf3, 0xcb, 0x31, 0x74, 0xaa, 0xc7, 0x12, 0x6b, 0xec, 0xf3,
+	0x35, 0xf5, 0x74, 0x26, 0x5a, 0xa1, 0xce, 0x53, 0x4c, 0x9d, 0x13, 0xe8, 0x58, 0x8f, 0x69, 0x48,
+	0x2f, 0xaf, 0xe9, 0xb6, 0x85, 0xfe, 0xa1, 0xc0, 0xae, 0xe4, 0xce, 0x61, 0xea, 0xe8, 0xbc, 0x67,
+	0x1f, 0x53, 0x3d, 0xdf, 0x27, 0x8a, 0x50, 0x73, 0x96, 0xa9, 0x79, 0x1a, 0x9d, 0xec, 0x61, 0x7f,
+	0xd3, 0x0d, 0x8a, 0x17, 0xc5, 0xe5, 0x1f, 0x15, 0x18, 0x6b, 0xef, 0xad, 0xa0, 0xa7, 0xb3, 0x35,
+	0x4e, 0x22, 0xf5, 0xce, 0x66, 0xa6, 0x17, 0x8a, 0x9d, 0x63, 0x8a, 0x9d, 0x42, 0x4f, 0x16, 0xb3,
+	0x7d, 0x8e, 0xeb, 0xa3, 0x4f, 0x14, 0xd8, 0xdd, 0xa5, 0x65, 0x98, 0x3a, 0xad, 0xde, 0xbb, 0xf1,
+	0xa9, 0x2e, 0xf4, 0x0b, 0x93, 0x71, 0xcf, 0x64, 0x9b, 0x07, 0xf7, 0xa2, 0x6c, 0xe2, 0xa1, 0x5f,
+	0x0e, 0xc0, 0xc3, 0x69, 0xfa, 0x39, 0x48, 0x4b, 0x9b, 0x2c, 0xd2, 0xb7, 0xa7, 0xd4, 0x2b, 0x0f,
+	0x14, 0x53, 0x58, 0xc5, 0x66, 0x56, 0x31, 0x91, 0x91, 0x36, 0x23, 0xc5, 0xfa, 0x4f, 0x7a, 0xcd,
+	0x76, 0xaa, 0xfa, 0x8a, 0xe7, 0xd6, 0xf5, 0x38, 0x51, 0xf1, 0x76, 0x52, 0x7f, 0xec, 0x0e, 0xfa,
+	0x4c, 0x81, 0x5d, 0xc9, 0x1d, 0xa5, 0xd4, 0xcb, 0xfd, 0x9e, 0x0d, 0x2e, 0xf5, 0x7c, 0x9f, 0x28,
+	0xc2, 0x24, 0x97, 0x99, 0x49, 0x9e, 0x45, 0x8b, 0x29, 0x4d, 0x12, 0xfa, 0xc4, 0xd3, 0x43, 0x89,
+	0xa7, 0x27, 0x9d, 0xb5, 0xde, 0x57, 0x60, 0xbc, 0xa3, 0x15, 0x85, 0xd2, 0xae, 0xdf, 0x6e, 0x1d,
+	0x2e, 0xf5, 0x5c, 0x76, 0x80, 0x8c, 0x8b, 0xa2, 0x42, 0x02, 0xbd, 0xad, 0x6d, 0xc6, 0x8e, 0x56,
+	0x5d, 0xda, 0x3b, 0xa9, 0x73, 0xc0, 0xbd, 0x7b, 0x62, 0xea, 0x42, 0xbf, 0x30, 0x19, 0x8f, 0x56,
+	0xdd, 0xdb, 0x5d, 0xe8, 0x23, 0x05, 0x50, 0x67, 0x5b, 0x02, 0x9d, 0xeb, 0xb9, 0x7e, 0xdd, 0xd6,
+	0xf0, 0x51, 0x67, 0xfb, 0x40, 0xc8, 0xa8, 0x66, 0xbc, 0x0e, 0x5f, 0x64, 0xed, 0xa4, 0xe2, 0x6d,
+	0xf6, 0xe7, 0x0e, 0xba, 0xab, 0xc0, 0x78, 0x47, 0x0d, 0x3f, 0x75, 0xe4, 0x76, 0xeb, 0x79, 0xa8,
+	0xe7, 0xb2, 0x03, 0x08, 0x1d, 0xbf, 0xcc, 0x74, 0x9c, 0x47, 0xa5, 0x2c, 0x3a, 0xd2, 0x9c, 0x54,
+	0xbc, 0x1d, 0x65, 0xa6, 0xbf, 0x2b, 0x80, 0x3a, 0xab, 0xdd, 0xa9, 0x7d, 0xd9, 0xb5, 0x41, 0xa0,
+	0xce, 0xf6, 0x81, 0x20, 0xf4, 0xbc, 0xc8, 0xf4, 0x5c, 0x40, 0xf3, 0xc5, 0xac, 0xbf, 0x5d, 0xf1,
+	0x63, 0x9a, 0x7e, 0xa2, 0xc0, 0x44, 0x42, 0xad, 0x3a, 0xf5, 0xf9, 0xb8, 0x7b, 0x5d, 0x5e, 0x2d,
+	0xf5, 0x03, 0x21, 0x94, 0x5d, 0x62, 0xca, 0x3e, 0x83, 0x16, 0x8a, 0x7d, 0xfc, 0xd0, 0x26, 0xa6,
+	0xee, 0x5b, 0x0a, 0x6c, 0x93, 0xa5, 0x56, 0x74, 0xbc, 0x97, 0x98, 0x6b, 0x96, 0xc5, 0xd5, 0x13,
+	0x3d, 0xd3, 0x65, 0x4c, 0xae, 0x3c, 0x44, 0x8d, 0x46, 0x5c, 0x83, 0xdf, 0x28, 0x90, 0x8b, 0x6a,
+	0x58, 0xe8, 0x44, 0x8f, 0xc5, 0xa2, 0x28, 0x10, 0x9f, 0xec, 0x9d, 0x30, 0xe3, 0x95, 0xa5, 0xf9,
+	0xc3, 0xa5, 0xa6, 0x16, 0xa5, 0xd5, 0x77, 0xee, 0xce, 0x28, 0xef, 0xdd, 0x9d, 0x51, 0xfe, 0x72,
+	0x77, 0x46, 0x79, 0xfd, 0xc3, 0x99, 0x4d, 0xef, 0x7d, 0x38, 0xb3, 0xe9, 0xcf, 0x1f, 0xce, 0x6c,
+	0x7a, 0x61, 0xe9, 0x7e, 0x9f, 0x14, 0xdf, 0x38, 0x72, 0xbc, 0x78, 0xab, 0x85, 0xe7, 0xa1, 0x26,
+	0x53, 0xb3, 0x66, 0x13, 0x27, 0xe0, 0xbf, 0x9b, 0xe3, 0xa5, 0xd9, 0x2d, 0xec, 0xcf, 0x13, 0xff,
+	0x19, 0x00, 0x63, 0x6e, 0x7b, 0x0a, 0x4b, 0x38, 0x00, 0x00,
 }
 
-func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *NumPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// UserPositions returns all concentrated positions of some address.
+	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// RangeOrdersByOwner returns the range orders of the given owner, optionally
+	// filtered by pool and status.
+	RangeOrdersByOwner(ctx context.Context, in *RangeOrdersByOwnerRequest, opts ...grpc.CallOption) (*RangeOrdersByOwnerResponse, error)
+	// RangeOrdersByPool returns the range orders of the given pool, optionally
+	// filtered by status.
+	RangeOrdersByPool(ctx context.Context, in *RangeOrdersByPoolRequest, opts ...grpc.CallOption) (*RangeOrdersByPoolResponse, error)
+	// LiquiditySnapshots returns the retained liquidity snapshots of the given
+	// pool taken between the given start and end times, inclusive.
+	LiquiditySnapshots(ctx context.Context, in *LiquiditySnapshotsRequest, opts ...grpc.CallOption) (*LiquiditySnapshotsResponse, error)
+	// DynamicSpreadFactor returns the dynamic spread factor of the given pool,
+	// along with its current effective spread factor.
+	DynamicSpreadFactor(ctx context.Context, in *DynamicSpreadFactorRequest, opts ...grpc.CallOption) (*DynamicSpreadFactorResponse, error)
+	// RangeApr returns the TVL of the given pool and the estimated annualized
+	// spread reward and incentive yields of a position in the given tick range,
+	// derived from the growth of the pool accumulators over the given lookback.
+	RangeApr(ctx context.Context, in *RangeAprRequest, opts ...grpc.CallOption) (*RangeAprResponse, error)
+	// PoolHooks returns the failure policy and the configured hooks of the
+	// given pool, along with the last error of each hook ignored by the policy.
+	PoolHooks(ctx context.Context, in *PoolHooksRequest, opts ...grpc.CallOption) (*PoolHooksResponse, error)
 }
 
-func (m *NumPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *NumPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *NumPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
+	out := new(PoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NumPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *NumPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionCount))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
+	out := new(UserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
+	out := new(LiquidityPerTickRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
+	out := new(LiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
+	out := new(ClaimableSpreadRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
+	out := new(ClaimableIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
+	out := new(PositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
+	out := new(PoolAccumulatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
+	out := new(IncentiveRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
+	out := new(TickAccumulatorTrackersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
+	out := new(UserUnbondingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
+	out := new(GetTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
+	out := new(NumNextInitializedTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RangeOrdersByOwner(ctx context.Context, in *RangeOrdersByOwnerRequest, opts ...grpc.CallOption) (*RangeOrdersByOwnerResponse, error) {
+	out := new(RangeOrdersByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/RangeOrdersByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpperTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LowerTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RangeOrdersByPool(ctx context.Context, in *RangeOrdersByPoolRequest, opts ...grpc.CallOption) (*RangeOrdersByPoolResponse, error) {
+	out := new(RangeOrdersByPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/RangeOrdersByPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseNoBound {
-		i--
-		if m.UseNoBound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.BoundTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.UseCurTick {
-		i--
-		if m.UseCurTick {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquiditySnapshots(ctx context.Context, in *LiquiditySnapshotsRequest, opts ...grpc.CallOption) (*LiquiditySnapshotsResponse, error) {
+	out := new(LiquiditySnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquiditySnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentSqrtPrice.Size()
-		i -= size
-		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DynamicSpreadFactor(ctx context.Context, in *DynamicSpreadFactorRequest, opts ...grpc.CallOption) (*DynamicSpreadFactorResponse, error) {
+	out := new(DynamicSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/DynamicSpreadFactor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) RangeApr(ctx context.Context, in *RangeAprRequest, opts ...grpc.CallOption) (*RangeAprResponse, error) {
+	out := new(RangeAprResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/RangeApr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolHooks(ctx context.Context, in *PoolHooksRequest, opts ...grpc.CallOption) (*PoolHooksResponse, error) {
+	out := new(PoolHooksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolHooks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BucketIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// UserPositions returns all concentrated positions of some address.
+	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// RangeOrdersByOwner returns the range orders of the given owner, optionally
+	// filtered by pool and status.
+	RangeOrdersByOwner(context.Context, *RangeOrdersByOwnerRequest) (*RangeOrdersByOwnerResponse, error)
+	// RangeOrdersByPool returns the range orders of the given pool, optionally
+	// filtered by status.
+	RangeOrdersByPool(context.Context, *RangeOrdersByPoolRequest) (*RangeOrdersByPoolResponse, error)
+	// LiquiditySnapshots returns the retained liquidity snapshots of the given
+	// pool taken between the given start and end times, inclusive.
+	LiquiditySnapshots(context.Context, *LiquiditySnapshotsRequest) (*LiquiditySnapshotsResponse, error)
+	// DynamicSpreadFactor returns the dynamic spread factor of the given pool,
+	// along with its current effective spread factor.
+	DynamicSpreadFactor(context.Context, *DynamicSpreadFactorRequest) (*DynamicSpreadFactorResponse, error)
+	// RangeApr returns the TVL of the given pool and the estimated annualized
+	// spread reward and incentive yields of a position in the given tick range,
+	// derived from the growth of the pool accumulators over the given lookback.
+	RangeApr(context.Context, *RangeAprRequest) (*RangeAprResponse, error)
+	// PoolHooks returns the failure policy and the configured hooks of the
+	// given pool, along with the last error of each hook ignored by the policy.
+	PoolHooks(context.Context, *PoolHooksRequest) (*PoolHooksResponse, error)
 }
 
-func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
-
-func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
 }
-
-func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+}
+func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+}
+func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+}
+func (*UnimplementedQueryServer) RangeOrdersByOwner(ctx context.Context, req *RangeOrdersByOwnerRequest) (*RangeOrdersByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeOrdersByOwner not implemented")
+}
+func (*UnimplementedQueryServer) RangeOrdersByPool(ctx context.Context, req *RangeOrdersByPoolRequest) (*RangeOrdersByPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeOrdersByPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquiditySnapshots(ctx context.Context, req *LiquiditySnapshotsRequest) (*LiquiditySnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquiditySnapshots not implemented")
+}
+func (*UnimplementedQueryServer) DynamicSpreadFactor(ctx context.Context, req *DynamicSpreadFactorRequest) (*DynamicSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicSpreadFactor not implemented")
+}
+func (*UnimplementedQueryServer) RangeApr(ctx context.Context, req *RangeAprRequest) (*RangeAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeApr not implemented")
+}
+func (*UnimplementedQueryServer) PoolHooks(ctx context.Context, req *PoolHooksRequest) (*PoolHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolHooks not implemented")
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPositionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityPerTickRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForfeitedIncentives) > 0 {
-		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClaimableIncentives) > 0 {
-		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableSpreadRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableIncentivesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccumulatorRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
+func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickAccumulatorTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUnbondingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UptimeTrackers) > 0 {
-		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumNextInitializedTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RangeOrdersByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeOrdersByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RangeOrdersByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/RangeOrdersByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RangeOrdersByOwner(ctx, req.(*RangeOrdersByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RangeOrdersByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeOrdersByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RangeOrdersByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/RangeOrdersByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RangeOrdersByPool(ctx, req.(*RangeOrdersByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquiditySnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquiditySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquiditySnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquiditySnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquiditySnapshots(ctx, req.(*LiquiditySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamicSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/DynamicSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicSpreadFactor(ctx, req.(*DynamicSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RangeApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RangeApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/RangeApr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RangeApr(ctx, req.(*RangeAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolHooks(ctx, req.(*PoolHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRange",
+			Handler:    _Query_LiquidityPerTickRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableSpreadRewards",
+			Handler:    _Query_ClaimableSpreadRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "PoolAccumulatorRewards",
+			Handler:    _Query_PoolAccumulatorRewards_Handler,
+		},
+		{
+			MethodName: "IncentiveRecords",
+			Handler:    _Query_IncentiveRecords_Handler,
+		},
+		{
+			MethodName: "TickAccumulatorTrackers",
+			Handler:    _Query_TickAccumulatorTrackers_Handler,
+		},
+		{
+			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
+			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
+		},
+		{
+			MethodName: "UserUnbondingPositions",
+			Handler:    _Query_UserUnbondingPositions_Handler,
+		},
+		{
+			MethodName: "GetTotalLiquidity",
+			Handler:    _Query_GetTotalLiquidity_Handler,
+		},
+		{
+			MethodName: "NumNextInitializedTicks",
+			Handler:    _Query_NumNextInitializedTicks_Handler,
+		},
+		{
+			MethodName: "RangeOrdersByOwner",
+			Handler:    _Query_RangeOrdersByOwner_Handler,
+		},
+		{
+			MethodName: "RangeOrdersByPool",
+			Handler:    _Query_RangeOrdersByPool_Handler,
+		},
+		{
+			MethodName: "LiquiditySnapshots",
+			Handler:    _Query_LiquiditySnapshots_Handler,
+		},
+		{
+			MethodName: "DynamicSpreadFactor",
+			Handler:    _Query_DynamicSpreadFactor_Handler,
+		},
+		{
+			MethodName: "RangeApr",
+			Handler:    _Query_RangeApr_Handler,
+		},
+		{
+			MethodName: "PoolHooks",
+			Handler:    _Query_PoolHooks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+}
+
+func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3492,12 +3613,12 @@ func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3512,17 +3633,24 @@ func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3532,12 +3660,12 @@ func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3554,10 +3682,10 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.IncentiveRecords) > 0 {
-		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3571,7 +3699,7 @@ func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3581,25 +3709,25 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3609,25 +3737,30 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolPositionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3637,27 +3770,25 @@ func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *NumPoolPositionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3667,34 +3798,25 @@ func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumPoolPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.PositionCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionCount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3704,20 +3826,32 @@ func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3727,20 +3861,32 @@ func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3754,7 +3900,7 @@ func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3764,37 +3910,20 @@ func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumNextInitializedTicks != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3804,594 +3933,4007 @@ func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.UseNoBound {
+		i--
+		if m.UseNoBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BoundTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.UseCurTick {
+		i--
+		if m.UseCurTick {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
-	}
-	if m.UseNoBound {
-		n += 2
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
-	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSqrtPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityPerTickRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.BucketIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.BucketIndex != 0 {
-		n += 1 + sovQuery(uint64(m.BucketIndex))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.ClaimableSpreadRewards) > 0 {
-		for _, e := range m.ClaimableSpreadRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableIncentives) > 0 {
-		for _, e := range m.ClaimableIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ForfeitedIncentives) > 0 {
-		for _, e := range m.ForfeitedIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for _, e := range m.SpreadRewardGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for _, e := range m.UptimeGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TickAccumulatorTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.UptimeTrackers) > 0 {
-		for _, e := range m.UptimeTrackers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentiveRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IncentiveRecords) > 0 {
-		for _, e := range m.IncentiveRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
-	}
-	return n
+func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserUnbondingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbondingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for _, e := range m.PositionsWithPeriodLock {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for _, e := range m.TotalLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *NumNextInitializedTicksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NumNextInitializedTicks != 0 {
-		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	return dAtA[:n], nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumNextInitializedTicks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeOrdersByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrdersByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrdersByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeOrdersByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrdersByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrdersByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeOrdersByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrdersByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrdersByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeOrdersByPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrdersByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrdersByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquiditySnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquiditySnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquiditySnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquiditySnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquiditySnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquiditySnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DynamicSpreadFactor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PricePoolIds) > 0 {
+		dAtA17 := make([]byte, len(m.PricePoolIds)*10)
+		var j16 int
+		for _, num := range m.PricePoolIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Lookback, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnpricedDenoms) > 0 {
+		for iNdEx := len(m.UnpricedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnpricedDenoms[iNdEx])
+			copy(dAtA[i:], m.UnpricedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.UnpricedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LookbackStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LookbackStartTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InRangeFraction.Size()
+		i -= size
+		if _, err := m.InRangeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IncentiveApr.Size()
+		i -= size
+		if _, err := m.IncentiveApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SpreadRewardApr.Size()
+		i -= size
+		if _, err := m.SpreadRewardApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHookStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.FailurePolicy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *NumPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityPerTickRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BucketIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BucketIndex))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for _, e := range m.ClaimableSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for _, e := range m.PositionsWithPeriodLock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for _, e := range m.TotalLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumNextInitializedTicks != 0 {
+		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RangeOrdersByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeOrdersByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeOrdersByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeOrdersByPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquiditySnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquiditySnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RangeAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lookback)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PricePoolIds) > 0 {
+		l = 0
+		for _, e := range m.PricePoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RangeAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpreadRewardApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.IncentiveApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InRangeFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LookbackStartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnpricedDenoms) > 0 {
+		for _, s := range m.UnpricedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolHookStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Hook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailurePolicy != 0 {
+		n += 1 + sovQuery(uint64(m.FailurePolicy))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			}
+			m.PositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+			}
+			m.BucketIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
-	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4414,17 +7956,17 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var stringLen uint64
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4434,46 +7976,64 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4500,10 +8060,8 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4528,7 +8086,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4551,15 +8109,84 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4586,14 +8213,14 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4620,10 +8247,8 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4648,7 +8273,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4671,17 +8296,17 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4691,7 +8316,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4717,7 +8342,7 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4740,15 +8365,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4775,7 +8400,42 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4800,7 +8460,7 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4823,10 +8483,10 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4848,6 +8508,25 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4869,7 +8548,7 @@ func (m *NumPoolPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,17 +8571,17 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
 			}
-			m.PositionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4912,11 +8591,60 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4938,7 +8666,7 @@ func (m *NumPoolPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,12 +8689,31 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5024,7 +8771,7 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5047,15 +8794,15 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5082,8 +8829,8 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5122,7 +8869,145 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+			}
+			m.ConcentratedPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			}
+			m.CfmmPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5144,7 +9029,7 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5167,12 +9052,44 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5194,7 +9111,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,15 +9134,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,7 +9169,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5277,7 +9195,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5300,65 +9218,12 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5380,7 +9245,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +9268,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5423,64 +9288,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
-			}
-			m.LowerTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
-			}
-			m.UpperTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5502,7 +9329,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5525,10 +9352,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5552,7 +9379,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5564,87 +9391,29 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
-			}
-			m.StartTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
-			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
 			}
-			var v int
+			m.NumNextInitializedTicks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5654,12 +9423,11 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseNoBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5681,7 +9449,7 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,10 +9472,10 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5797,40 +9565,6 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5852,7 +9586,7 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeOrdersByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5875,17 +9609,17 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeOrdersByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeOrdersByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5895,64 +9629,65 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.RangeOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5979,30 +9714,13 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
-			}
-			m.BucketIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BucketIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6024,7 +9742,7 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeOrdersByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6047,17 +9765,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeOrdersByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeOrdersByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
 			}
-			m.PositionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6067,64 +9785,29 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RangeOrders = append(m.RangeOrders, types1.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6151,8 +9834,10 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6177,7 +9862,7 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeOrdersByPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6200,17 +9885,17 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeOrdersByPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeOrdersByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6220,11 +9905,66 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.RangeOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6246,7 +9986,7 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *RangeOrdersByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6269,15 +10009,15 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeOrdersByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeOrdersByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6304,14 +10044,14 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RangeOrders = append(m.RangeOrders, types1.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6338,8 +10078,10 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6364,7 +10106,7 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquiditySnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,10 +10129,10 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquiditySnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquiditySnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6412,6 +10154,72 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6433,7 +10241,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *LiquiditySnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6456,49 +10264,15 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquiditySnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquiditySnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6525,8 +10299,8 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, types1.LiquiditySnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6551,7 +10325,7 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *DynamicSpreadFactorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,10 +10348,10 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicSpreadFactorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6599,25 +10373,6 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6639,7 +10394,7 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *DynamicSpreadFactorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,15 +10417,15 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicSpreadFactorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6697,16 +10452,15 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DynamicSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6716,23 +10470,23 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6757,7 +10511,7 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeAprRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6780,17 +10534,55 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeAprRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
 			}
-			m.PoolId = 0
+			m.UpperTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6800,14 +10592,14 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.UpperTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6834,13 +10626,118 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Lookback, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PricePoolIds = append(m.PricePoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PricePoolIds) == 0 {
+					m.PricePoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PricePoolIds = append(m.PricePoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6862,7 +10759,7 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *RangeAprResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6885,17 +10782,17 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeAprResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6905,31 +10802,31 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardApr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6939,83 +10836,31 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpreadRewardApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveApr", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7025,66 +10870,31 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.IncentiveApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRangeFraction", wireType)
 			}
-			m.CfmmPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7094,66 +10904,31 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InRangeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7163,79 +10938,30 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LookbackStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpricedDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7245,25 +10971,23 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnpricedDenoms = append(m.UnpricedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7286,7 +11010,7 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolHooksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7309,12 +11033,31 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolHooksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7336,7 +11079,7 @@ func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolHookStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,15 +11102,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolHookStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolHookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7394,85 +11137,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7482,43 +11155,28 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
+			if m.LastError == nil {
+				m.LastError = &types1.PoolHookError{}
 			}
-			m.NumNextInitializedTicks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7540,7 +11198,7 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7563,51 +11221,17 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
 			}
-			m.CurrentTick = 0
+			m.FailurePolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7617,16 +11241,16 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
+				m.FailurePolicy |= types1.PoolHookFailurePolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7636,23 +11260,23 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hooks = append(m.Hooks, PoolHookStatus{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,190 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// HandleDynamicSpreadFactorProposal handles a dynamic spread factor proposal to the corresponding keeper methods.
+func (k Keeper) HandleDynamicSpreadFactorProposal(ctx sdk.Context, p *types.DynamicSpreadFactorProposal) error {
+	for _, record := range p.Records {
+		if err := k.SetDynamicSpreadFactor(ctx, record); err != nil {
+			return err
+		}
+	}
+	for _, poolId := range p.DisabledPoolIds {
+		if err := k.DisableDynamicSpreadFactor(ctx, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetDynamicSpreadFactor enables the dynamic spread factor of the pool of the given record, or updates its
+// bounds and volatility multiplier if it is already enabled. Once enabled, the spread factor of the pool is
+// recomputed from its volatility at the end of every block, starting from a zero volatility. The spread factor
+// of the pool prior to enabling is kept so that it can be restored.
+// Returns error if the record is invalid or the pool does not exist.
+func (k Keeper) SetDynamicSpreadFactor(ctx sdk.Context, record types.DynamicSpreadFactorRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, record.PoolId)
+	if err != nil {
+		return err
+	}
+
+	dynamicSpreadFactor, err := k.GetDynamicSpreadFactor(ctx, record.PoolId)
+	if err != nil {
+		if _, ok := err.(types.DynamicSpreadFactorNotFoundError); !ok {
+			return err
+		}
+		dynamicSpreadFactor = types.DynamicSpreadFactor{
+			StaticSpreadFactor: pool.GetSpreadFactor(ctx),
+			Volatility:         osmomath.ZeroDec(),
+			LastSqrtPrice:      pool.GetCurrentSqrtPrice(),
+		}
+	}
+	dynamicSpreadFactor.Record = record
+
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+	return k.setPoolSpreadFactor(ctx, pool, dynamicSpreadFactor.EffectiveSpreadFactor(), dynamicSpreadFactor.Volatility)
+}
+
+// DisableDynamicSpreadFactor disables the dynamic spread factor of the given pool and restores the spread
+// factor the pool had before it was enabled.
+// Returns error if the pool does not have a dynamic spread factor.
+func (k Keeper) DisableDynamicSpreadFactor(ctx sdk.Context, poolId uint64) error {
+	dynamicSpreadFactor, err := k.GetDynamicSpreadFactor(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactor(poolId))
+	return k.setPoolSpreadFactor(ctx, pool, dynamicSpreadFactor.StaticSpreadFactor, osmomath.ZeroDec())
+}
+
+// GetDynamicSpreadFactor returns the dynamic spread factor of the given pool.
+// Returns error if the pool does not have a dynamic spread factor.
+func (k Keeper) GetDynamicSpreadFactor(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactor, error) {
+	dynamicSpreadFactor := types.DynamicSpreadFactor{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(poolId), &dynamicSpreadFactor)
+	if err != nil {
+		return types.DynamicSpreadFactor{}, err
+	}
+	if !found {
+		return types.DynamicSpreadFactor{}, types.DynamicSpreadFactorNotFoundError{PoolId: poolId}
+	}
+	return dynamicSpreadFactor, nil
+}
+
+// updateDynamicSpreadFactors updates the volatility and the spread factor of every pool with a dynamic spread factor.
+// A failure to update a pool does not prevent the other pools from being updated.
+func (k Keeper) updateDynamicSpreadFactors(ctx sdk.Context) {
+	dynamicSpreadFactors, err := k.getAllDynamicSpreadFactors(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to get dynamic spread factors", "error", err)
+		return
+	}
+
+	for _, dynamicSpreadFactor := range dynamicSpreadFactors {
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.updateDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+		})
+	}
+}
+
+// updateDynamicSpreadFactor folds the relative change of the spot price of the pool since the end of the previous
+// block into the volatility of the pool, and sets the spread factor of the pool to the resulting effective spread factor.
+// The volatility is an exponential moving average of the absolute per-block price changes, with the previous
+// volatility weighted by DynamicSpreadFactorVolatilityDecay. Price changes are capped at MaxDynamicSpreadFactorPriceChange.
+func (k Keeper) updateDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) error {
+	pool, err := k.getPoolById(ctx, dynamicSpreadFactor.Record.PoolId)
+	if err != nil {
+		return err
+	}
+
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	priceChange := osmomath.ZeroDec()
+	// The price is not defined until the first position of the pool is created.
+	if dynamicSpreadFactor.LastSqrtPrice.IsPositive() && currentSqrtPrice.IsPositive() {
+		sqrtPriceRatio := currentSqrtPrice.Quo(dynamicSpreadFactor.LastSqrtPrice)
+		priceChangeBigDec := sqrtPriceRatio.Mul(sqrtPriceRatio).Sub(osmomath.OneBigDec()).Abs()
+		// The price change is clamped before its conversion, which would panic for extreme price moves and keep
+		// the volatility and the last price of the pool from ever being updated again.
+		if priceChangeBigDec.GT(osmomath.BigDecFromDec(types.MaxDynamicSpreadFactorPriceChange)) {
+			priceChange = types.MaxDynamicSpreadFactorPriceChange
+		} else {
+			priceChange = priceChangeBigDec.Dec()
+		}
+	}
+
+	decay := types.DynamicSpreadFactorVolatilityDecay
+	dynamicSpreadFactor.Volatility = dynamicSpreadFactor.Volatility.Mul(decay).Add(priceChange.Mul(osmomath.OneDec().Sub(decay)))
+	dynamicSpreadFactor.LastSqrtPrice = currentSqrtPrice
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+
+	return k.setPoolSpreadFactor(ctx, pool, dynamicSpreadFactor.EffectiveSpreadFactor(), dynamicSpreadFactor.Volatility)
+}
+
+// setPoolSpreadFactor sets the spread factor of the given pool, emitting an event if it changed.
+func (k Keeper) setPoolSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor, volatility osmomath.Dec) error {
+	if pool.GetSpreadFactor(ctx).Equal(spreadFactor) {
+		return nil
+	}
+
+	pool.SetSpreadFactor(spreadFactor)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtUpdateSpreadFactor,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeySpreadFactor, spreadFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyVolatility, volatility.String()),
+		),
+	})
+	return nil
+}
+
+// setDynamicSpreadFactor stores the given dynamic spread factor.
+func (k Keeper) setDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(dynamicSpreadFactor.Record.PoolId), &dynamicSpreadFactor)
+}
+
+// getAllDynamicSpreadFactors returns the dynamic spread factors of all pools, in order of pool id.
+func (k Keeper) getAllDynamicSpreadFactors(ctx sdk.Context) ([]types.DynamicSpreadFactor, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorPrefix, parseDynamicSpreadFactor)
+}
+
+// initDynamicSpreadFactors stores the given dynamic spread factors.
+// Returns error if any of them belongs to a pool that does not exist.
+func (k Keeper) initDynamicSpreadFactors(ctx sdk.Context, dynamicSpreadFactors []types.DynamicSpreadFactor) error {
+	for _, dynamicSpreadFactor := range dynamicSpreadFactors {
+		if _, err := k.getPoolById(ctx, dynamicSpreadFactor.Record.PoolId); err != nil {
+			return err
+		}
+		k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+	}
+	return nil
+}
+
+func parseDynamicSpreadFactor(value []byte) (types.DynamicSpreadFactor, error) {
+	dynamicSpreadFactor := types.DynamicSpreadFactor{}
+	err := dynamicSpreadFactor.Unmarshal(value)
+	return dynamicSpreadFactor, err
+}
@@ -0,0 +1,197 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+var (
+	staticSpreadFactor         = osmomath.MustNewDecFromStr("0.003")
+	defaultDynamicSpreadRecord = types.DynamicSpreadFactorRecord{
+		PoolId:               1,
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: osmomath.NewDec(10),
+	}
+)
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactor() {
+	tests := map[string]struct {
+		record        types.DynamicSpreadFactorRecord
+		expectedError error
+	}{
+		"enable": {
+			record: defaultDynamicSpreadRecord,
+		},
+		"error: invalid bounds": {
+			record: types.DynamicSpreadFactorRecord{
+				PoolId:               1,
+				MinSpreadFactor:      defaultDynamicSpreadRecord.MaxSpreadFactor,
+				MaxSpreadFactor:      defaultDynamicSpreadRecord.MinSpreadFactor,
+				VolatilityMultiplier: osmomath.OneDec(),
+			},
+			expectedError: types.InvalidDynamicSpreadFactorBoundsError{MinSpreadFactor: defaultDynamicSpreadRecord.MaxSpreadFactor, MaxSpreadFactor: defaultDynamicSpreadRecord.MinSpreadFactor},
+		},
+		"error: pool does not exist": {
+			record: types.DynamicSpreadFactorRecord{
+				PoolId:               2,
+				MinSpreadFactor:      defaultDynamicSpreadRecord.MinSpreadFactor,
+				MaxSpreadFactor:      defaultDynamicSpreadRecord.MaxSpreadFactor,
+				VolatilityMultiplier: osmomath.OneDec(),
+			},
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, staticSpreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			err = clKeeper.SetDynamicSpreadFactor(s.Ctx, tc.record)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				_, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+				s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: pool.GetId()})
+				return
+			}
+			s.Require().NoError(err)
+
+			// The volatility starts at zero so the pool charges the minimum spread factor.
+			dynamicSpreadFactor, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.record, dynamicSpreadFactor.Record)
+			s.Require().Equal(staticSpreadFactor, dynamicSpreadFactor.StaticSpreadFactor)
+			s.Require().True(dynamicSpreadFactor.Volatility.IsZero())
+			s.Require().Equal(pool.GetCurrentSqrtPrice(), dynamicSpreadFactor.LastSqrtPrice)
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.record.MinSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdateSpreadFactor, 1)
+
+			// Updating the record keeps the static spread factor.
+			updatedRecord := tc.record
+			updatedRecord.MinSpreadFactor = osmomath.MustNewDecFromStr("0.002")
+			s.Require().NoError(clKeeper.SetDynamicSpreadFactor(s.Ctx, updatedRecord))
+			dynamicSpreadFactor, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(updatedRecord, dynamicSpreadFactor.Record)
+			s.Require().Equal(staticSpreadFactor, dynamicSpreadFactor.StaticSpreadFactor)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactors() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, staticSpreadFactor)
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	staticPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, staticSpreadFactor)
+	s.SetupFullRangePositionAcc(staticPool.GetId(), s.TestAccs[0])
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactor(s.Ctx, defaultDynamicSpreadRecord))
+
+	pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	sqrtPriceBefore := pool.GetCurrentSqrtPrice()
+
+	// Move the price of both pools.
+	for _, poolId := range []uint64{pool.GetId(), staticPool.GetId()} {
+		tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(10_000_000))
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], poolId, tokenIn, ETH, osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	sqrtPriceRatio := pool.GetCurrentSqrtPrice().Quo(sqrtPriceBefore)
+	priceChange := sqrtPriceRatio.Mul(sqrtPriceRatio).Sub(osmomath.OneBigDec()).Abs().Dec()
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test
+	clKeeper.EndBlock(s.Ctx)
+
+	// The volatility is the price change weighted by one minus the decay.
+	expectedVolatility := priceChange.Mul(osmomath.OneDec().Sub(types.DynamicSpreadFactorVolatilityDecay))
+	expectedSpreadFactor := defaultDynamicSpreadRecord.MinSpreadFactor.Add(defaultDynamicSpreadRecord.VolatilityMultiplier.Mul(expectedVolatility))
+	s.Require().True(expectedSpreadFactor.LT(defaultDynamicSpreadRecord.MaxSpreadFactor))
+	dynamicSpreadFactor, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedVolatility, dynamicSpreadFactor.Volatility)
+	s.Require().Equal(pool.GetCurrentSqrtPrice(), dynamicSpreadFactor.LastSqrtPrice)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdateSpreadFactor, 1)
+
+	// Pools without a dynamic spread factor keep their spread factor.
+	staticPool, err = clKeeper.GetConcentratedPoolById(s.Ctx, staticPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(staticSpreadFactor, staticPool.GetSpreadFactor(s.Ctx))
+
+	// Without price changes, the volatility decays.
+	clKeeper.EndBlock(s.Ctx)
+	dynamicSpreadFactor, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(expectedVolatility.Mul(types.DynamicSpreadFactorVolatilityDecay), dynamicSpreadFactor.Volatility)
+
+	// Extreme price moves, which would overflow, are clamped and the last price is still updated.
+	dynamicSpreadFactor.LastSqrtPrice = osmomath.NewBigDecWithPrec(1, 36)
+	clKeeper.SetDynamicSpreadFactorState(s.Ctx, dynamicSpreadFactor)
+	s.Require().NotPanics(func() {
+		clKeeper.EndBlock(s.Ctx)
+	})
+	extremeMoveDynamicSpreadFactor, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	expectedVolatility = dynamicSpreadFactor.Volatility.Mul(types.DynamicSpreadFactorVolatilityDecay).Add(types.MaxDynamicSpreadFactorPriceChange.Mul(osmomath.OneDec().Sub(types.DynamicSpreadFactorVolatilityDecay)))
+	s.Require().Equal(expectedVolatility, extremeMoveDynamicSpreadFactor.Volatility)
+	s.Require().Equal(pool.GetCurrentSqrtPrice(), extremeMoveDynamicSpreadFactor.LastSqrtPrice)
+
+	// The spread factor is capped at the maximum.
+	highMultiplierRecord := defaultDynamicSpreadRecord
+	highMultiplierRecord.VolatilityMultiplier = osmomath.NewDec(1_000_000)
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactor(s.Ctx, highMultiplierRecord))
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(highMultiplierRecord.MaxSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+
+	// Disabling restores the static spread factor.
+	s.Require().NoError(clKeeper.DisableDynamicSpreadFactor(s.Ctx, pool.GetId()))
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(staticSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+	_, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: pool.GetId()})
+	err = clKeeper.DisableDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: pool.GetId()})
+}
+
+func (s *KeeperTestSuite) TestHandleDynamicSpreadFactorProposal() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, staticSpreadFactor)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	enableProposal := types.NewDynamicSpreadFactorProposal("title", "description", []types.DynamicSpreadFactorRecord{defaultDynamicSpreadRecord}, nil)
+	s.Require().NoError(enableProposal.ValidateBasic())
+	s.Require().NoError(clKeeper.HandleDynamicSpreadFactorProposal(s.Ctx, enableProposal.(*types.DynamicSpreadFactorProposal)))
+	pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(defaultDynamicSpreadRecord.MinSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+
+	disableProposal := types.NewDynamicSpreadFactorProposal("title", "description", nil, []uint64{pool.GetId()})
+	s.Require().NoError(disableProposal.ValidateBasic())
+	s.Require().NoError(clKeeper.HandleDynamicSpreadFactorProposal(s.Ctx, disableProposal.(*types.DynamicSpreadFactorProposal)))
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(staticSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+
+	// Disabling a pool without a dynamic spread factor fails the proposal.
+	err = clKeeper.HandleDynamicSpreadFactorProposal(s.Ctx, disableProposal.(*types.DynamicSpreadFactorProposal))
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: pool.GetId()})
+}
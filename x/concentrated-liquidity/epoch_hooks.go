@@ -0,0 +1,42 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = &epochHooks{}
+
+type epochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks that compound the spread rewards of auto-compounding positions
+// and snapshot the liquidity of pools.
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (*epochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// AfterEpochEnd compounds the spread rewards of auto-compounding positions at the end of the auto-compound epoch,
+// and snapshots the liquidity of pools at the end of the liquidity snapshot epoch.
+// Positions are compounded first so that the snapshots include the compounded liquidity.
+func (hook *epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == hook.k.GetParams(ctx).AutoCompoundEpochIdentifier {
+		hook.k.compoundPositions(ctx)
+	}
+	if epochIdentifier == types.LiquiditySnapshotEpochIdentifier && epochNumber > 0 {
+		return hook.k.takeLiquiditySnapshots(ctx, uint64(epochNumber), types.LiquiditySnapshotGasBudget)
+	}
+	return nil
+}
+
+func (hook *epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
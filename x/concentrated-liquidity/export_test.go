@@ -116,7 +116,7 @@ func (k Keeper) CollectSpreadRewards(ctx sdk.Context, owner sdk.AccAddress, posi
 	return k.collectSpreadRewards(ctx, owner, positionId)
 }
 
-func (k Keeper) PrepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
+func (k Keeper) PrepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, error) {
 	return k.prepareClaimableSpreadRewards(ctx, positionId)
 }
 
@@ -201,6 +201,34 @@ func (k Keeper) AddToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	return k.addToPosition(ctx, owner, positionId, amount0Added, amount1Added, amount0Min, amount1Min)
 }
 
+func (k Keeper) RebalancePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, newLowerTick, newUpperTick int64, swapImbalance bool, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	return k.rebalancePosition(ctx, owner, positionId, newLowerTick, newUpperTick, swapImbalance, amount0Min, amount1Min)
+}
+
+func (k Keeper) CompoundPositions(ctx sdk.Context) {
+	k.compoundPositions(ctx)
+}
+
+func (k Keeper) CompoundPosition(ctx sdk.Context, positionId uint64) error {
+	return k.compoundPosition(ctx, positionId)
+}
+
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) uint64 {
+	return k.getAutoCompoundCursor(ctx)
+}
+
+func (k Keeper) TakeLiquiditySnapshots(ctx sdk.Context, epochNumber uint64, gasBudget uint64) error {
+	return k.takeLiquiditySnapshots(ctx, epochNumber, gasBudget)
+}
+
+func (k Keeper) GetLiquiditySnapshotCursor(ctx sdk.Context) uint64 {
+	return k.getLiquiditySnapshotCursor(ctx)
+}
+
+func (k Keeper) GetAllAutoCompoundPositionIds(ctx sdk.Context) []uint64 {
+	return k.getAllAutoCompoundPositionIds(ctx)
+}
+
 func (ss *SwapState) UpdateSpreadRewardGrowthGlobal(spreadRewardChargeTotal, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
 	return ss.updateSpreadRewardGrowthGlobal(spreadRewardChargeTotal, spreadFactor)
 }
@@ -323,7 +351,7 @@ func (k Keeper) SetupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExten
 }
 
 func MoveRewardsToNewPositionAndDeleteOldAcc(ctx sdk.Context, accum *accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
-	return moveRewardsToNewPositionAndDeleteOldAcc(accum, oldPositionName, newPositionName, growthOutside)
+	return moveRewardsToNewPositionAndDeleteOldAcc(accum, oldPositionName, newPositionName, growthOutside, growthOutside)
 }
 
 func (k Keeper) TransferPositions(ctx sdk.Context, positionIds []uint64, sender sdk.AccAddress, recipient sdk.AccAddress) error {
@@ -334,6 +362,10 @@ func (k Keeper) SetPoolHookContract(ctx sdk.Context, poolID uint64, actionPrefix
 	return k.setPoolHookContract(ctx, poolID, actionPrefix, cosmwasmAddress)
 }
 
+func (k Keeper) SetPoolHook(ctx sdk.Context, poolID uint64, hook types.PoolHook) error {
+	return k.setPoolHook(ctx, poolID, hook)
+}
+
 func (k Keeper) GetIncentiveScalingFactorForPool(ctx sdk.Context, poolID uint64) (osmomath.Dec, error) {
 	return k.getIncentiveScalingFactorForPool(ctx, poolID)
 }
@@ -365,3 +397,15 @@ func ScaleDownSpreadRewardAmount(incentiveAmount osmomath.Int, scalingFactor osm
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) GetLiquidityDepths(ctx sdk.Context, pool types.ConcentratedPoolExtension, priceChanges []osmomath.Dec) ([]types.LiquidityDepth, error) {
+	return k.getLiquidityDepths(ctx, pool, priceChanges)
+}
+
+func (k Keeper) HasRangeOrderSettlement(ctx sdk.Context, poolId uint64, orderId uint64) bool {
+	return k.hasRangeOrderSettlement(ctx, poolId, orderId)
+}
+
+func (k Keeper) SetDynamicSpreadFactorState(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) {
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+}
@@ -3,6 +3,7 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -68,6 +69,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// set pool hooks
+		err = k.initPoolHooks(ctx, poolId, poolData.Hooks, poolData.HookFailurePolicy)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// set positions for pool
@@ -99,6 +106,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		for uptimeIndex, uptimeRecord := range positionWrapper.UptimeAccumRecords {
 			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
 		}
+
+		if positionWrapper.SpreadRewardEligibilityTime != nil {
+			k.setPositionSpreadRewardEligibilityTime(ctx, positionWrapper.Position.PositionId, *positionWrapper.SpreadRewardEligibilityTime)
+		}
+	}
+
+	// set range orders
+	if err := k.initRangeOrders(ctx, genState.RangeOrders); err != nil {
+		panic(err)
+	}
+
+	// set auto-compounding positions
+	if err := k.initAutoCompoundPositions(ctx, genState.AutoCompoundPositionIds); err != nil {
+		panic(err)
+	}
+	k.setAutoCompoundCursor(ctx, genState.AutoCompoundCursor)
+
+	// set liquidity snapshots
+	if err := k.initLiquiditySnapshots(ctx, genState.LiquiditySnapshots); err != nil {
+		panic(err)
+	}
+	k.setLiquiditySnapshotCursor(ctx, genState.LiquiditySnapshotCursor)
+
+	// set dynamic spread factors
+	if err := k.initDynamicSpreadFactors(ctx, genState.DynamicSpreadFactors); err != nil {
+		panic(err)
 	}
 
 	// set total liquidity
@@ -175,6 +208,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			SpreadRewardAccumulator: spreadRewardAccumObject,
 			IncentivesAccumulators:  incentivesAccumObject,
 			IncentiveRecords:        incentiveRecordsForPool,
+			Hooks:                   k.getPoolHooksData(ctx, poolId),
+			HookFailurePolicy:       k.GetPoolHookFailurePolicy(ctx, poolId),
 		})
 	}
 
@@ -225,11 +260,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			uptimeAccumObject[uptimeIndex] = accumRecord
 		}
 
+		// The spread reward eligibility time is only exported if it differs from the join time.
+		var spreadRewardEligibilityTime *time.Time
+		if eligibilityTime := k.getPositionSpreadRewardEligibilityTime(ctx, position); !eligibilityTime.Equal(position.JoinTime) {
+			spreadRewardEligibilityTime = &eligibilityTime
+		}
+
 		positionData = append(positionData, genesis.PositionData{
-			LockId:                  lockId,
-			Position:                &position,
-			SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
-			UptimeAccumRecords:      uptimeAccumObject,
+			LockId:                      lockId,
+			Position:                    &position,
+			SpreadRewardAccumRecord:     spreadRewardAccumPositionRecord,
+			UptimeAccumRecords:          uptimeAccumObject,
+			SpreadRewardEligibilityTime: spreadRewardEligibilityTime,
 		})
 	}
 
@@ -245,6 +287,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		panic(err)
 	}
 
+	rangeOrders, err := k.getAllRangeOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	liquiditySnapshots, err := k.getAllLiquiditySnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactors, err := k.getAllDynamicSpreadFactors(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:                k.GetParams(ctx),
 		PoolData:              poolData,
@@ -253,6 +310,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
 		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+		RangeOrders:                                   rangeOrders,
+		AutoCompoundPositionIds:                       k.getAllAutoCompoundPositionIds(ctx),
+		AutoCompoundCursor:                            k.getAutoCompoundCursor(ctx),
+		LiquiditySnapshots:                            liquiditySnapshots,
+		DynamicSpreadFactors:                          dynamicSpreadFactors,
+		LiquiditySnapshotCursor:                       k.getLiquiditySnapshotCursor(ctx),
 	}
 }
 
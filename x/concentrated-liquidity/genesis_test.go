@@ -31,6 +31,8 @@ type singlePoolGenesisEntry struct {
 	spreadFactorAccumValues genesis.AccumObject
 	incentiveAccumulators   []genesis.AccumObject
 	incentiveRecords        []types.IncentiveRecord
+	hooks                   []genesis.PoolHookData
+	hookFailurePolicy       types.PoolHookFailurePolicy
 }
 
 var (
@@ -40,6 +42,9 @@ var (
 			AuthorizedSpreadFactors:      []osmomath.Dec{osmomath.MustNewDecFromStr("0.0001"), osmomath.MustNewDecFromStr("0.0003"), osmomath.MustNewDecFromStr("0.0005")},
 			BalancerSharesRewardDiscount: types.DefaultBalancerSharesDiscount,
 			AuthorizedUptimes:            types.DefaultAuthorizedUptimes,
+			AutoCompoundEpochIdentifier:  types.DefaultAutoCompoundEpochIdentifier,
+			AutoCompoundGasBudget:        types.DefaultAutoCompoundGasBudget,
+			MinSpreadRewardsPositionAge:  types.DefaultMinSpreadRewardsPositionAge,
 		},
 		PoolData:              []genesis.PoolData{},
 		NextIncentiveRecordId: 2,
@@ -173,7 +178,7 @@ func incentiveAccumsWithPoolId(poolId uint64) []genesis.AccumObject {
 // The function iterates over the poolGenesisEntries, and for each entry, it creates a new Any type using
 // the pool's data, then appends a new PoolData structure containing the pool and its corresponding
 // ticks to the baseGenesis.PoolData. It also appends the corresponding positions to the
-// baseGenesis.Positions, along with the incentive records and accumulator values for spread rewards and incentives.
+// baseGenesis.Positions, along with the incentive records, accumulator values for spread rewards and incentives and pool hooks.
 func setupGenesis(baseGenesis genesis.GenesisState, poolGenesisEntries []singlePoolGenesisEntry) genesis.GenesisState {
 	for _, poolGenesisEntry := range poolGenesisEntries {
 		poolCopy := poolGenesisEntry.pool
@@ -187,6 +192,8 @@ func setupGenesis(baseGenesis genesis.GenesisState, poolGenesisEntries []singleP
 			SpreadRewardAccumulator: poolGenesisEntry.spreadFactorAccumValues,
 			IncentivesAccumulators:  poolGenesisEntry.incentiveAccumulators,
 			IncentiveRecords:        poolGenesisEntry.incentiveRecords,
+			Hooks:                   poolGenesisEntry.hooks,
+			HookFailurePolicy:       poolGenesisEntry.hookFailurePolicy,
 		})
 		baseGenesis.PositionData = append(baseGenesis.PositionData, poolGenesisEntry.positionData...)
 		baseGenesis.NextPositionId = uint64(len(poolGenesisEntry.positionData))
@@ -679,6 +686,27 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 							IncentiveId: 2,
 						},
 					},
+					hooks: []genesis.PoolHookData{
+						{
+							Hook: types.PoolHook{
+								ActionPrefix:    types.AfterActionPrefix(types.SwapExactAmountInPrefix),
+								ContractAddress: s.TestAccs[0].String(),
+								GasLimit:        100_000,
+							},
+							LastError: &types.PoolHookError{
+								Error:       "hook failed",
+								BlockHeight: 10,
+								Time:        defaultTime1.UTC(),
+							},
+						},
+						{
+							Hook: types.PoolHook{
+								ActionPrefix:    types.BeforeActionPrefix(types.SwapExactAmountInPrefix),
+								ContractAddress: s.TestAccs[1].String(),
+							},
+						},
+					},
+					hookFailurePolicy: types.PoolHookFailurePolicyIgnore,
 				},
 			}),
 		},
@@ -813,6 +841,10 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 					s.Require().Equal(incentiveRecord.IncentiveRecordBody.RemainingCoin.String(), expectedPoolData.IncentiveRecords[i].IncentiveRecordBody.RemainingCoin.String())
 					s.Require().True(incentiveRecord.IncentiveRecordBody.StartTime.Equal(expectedPoolData.IncentiveRecords[i].IncentiveRecordBody.StartTime))
 				}
+
+				// Validate pool hooks
+				s.Require().ElementsMatch(expectedPoolData.Hooks, actualPoolData.Hooks)
+				s.Require().Equal(expectedPoolData.HookFailurePolicy, actualPoolData.HookFailurePolicy)
 			}
 
 			// Validate uptime accumulators
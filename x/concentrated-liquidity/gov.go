@@ -37,6 +37,10 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.DynamicSpreadFactorProposal:
+			return k.HandleDynamicSpreadFactorProposal(ctx, c)
+		case *types.PoolHooksProposal:
+			return k.HandlePoolHooksProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
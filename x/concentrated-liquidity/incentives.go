@@ -718,12 +718,11 @@ func updateAccumAndClaimRewards(accum *accum.AccumulatorObject, positionKey stri
 // moveRewardsToNewPositionAndDeleteOldAcc claims the rewards from the old position and moves them to the new position.
 // Deletes the position tracker associated with the old position name.
 // The positions must be associated with the given accumulator.
-// The given growth outside the positions range is used for claim rewards accounting.
+// The given growth outside the old and the new positions ranges are used for claim rewards accounting.
+// They are equal when both positions share the same range.
 // The rewards are moved as "unclaimed rewards" to the new position.
 // Returns nil on success. Error otherwise.
-// NOTE: It is only used by fungifyChargedPosition which we disabled for launch.
-// nolint: unused
-func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
+func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, oldPositionName, newPositionName string, oldGrowthOutside, newGrowthOutside sdk.DecCoins) error {
 	if oldPositionName == newPositionName {
 		return types.ModifySamePositionAccumulatorError{PositionAccName: oldPositionName}
 	}
@@ -733,7 +732,7 @@ func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, old
 		return fmt.Errorf("position %s does not exist", oldPositionName)
 	}
 
-	if err := updatePositionToInitValuePlusGrowthOutside(accum, oldPositionName, growthOutside); err != nil {
+	if err := updatePositionToInitValuePlusGrowthOutside(accum, oldPositionName, oldGrowthOutside); err != nil {
 		return err
 	}
 
@@ -748,7 +747,7 @@ func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, old
 	}
 
 	// Ensure that the new position's accumulator value is the growth inside.
-	currentGrowthInsideForPosition := accum.GetValue().Sub(growthOutside)
+	currentGrowthInsideForPosition := accum.GetValue().Sub(newGrowthOutside)
 	err = accum.SetPositionIntervalAccumulation(newPositionName, currentGrowthInsideForPosition)
 	if err != nil {
 		return err
@@ -880,7 +879,7 @@ func (k Keeper) redepositForfeitedIncentives(ctx sdk.Context, poolId uint64, sen
 
 	// If no active liquidity, give the forfeited incentives to the sender.
 	if activeLiquidity.LT(osmomath.OneDec()) {
-		err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), sender, totalForefeitedIncentives)
+		err := k.sendCoins(ctx, pool.GetIncentivesAddress(), sender, totalForefeitedIncentives)
 		if err != nil {
 			return err
 		}
@@ -950,6 +949,13 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		}
 	}
 
+	// Trigger before hook for CollectIncentives prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeCollectIncentives(ctx, position.PoolId, sender, positionId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, nil, err
+	}
+
 	// Claim all incentives for the position.
 	collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, err := k.prepareClaimAllIncentivesForPosition(ctx, position.PositionId)
 	if err != nil {
@@ -958,6 +964,10 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 
 	// If no incentives were collected, return an empty coin set.
 	if collectedIncentivesForPosition.IsZero() && totalForfeitedIncentivesForPosition.IsZero() {
+		err = k.AfterCollectIncentives(ctx, position.PoolId, sender, positionId, collectedIncentivesForPosition, totalForfeitedIncentivesForPosition)
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, nil, err
+		}
 		return collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, nil
 	}
 
@@ -969,7 +979,7 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 
 	// Send the collected incentives to the position's owner from the pool's address.
 	if !collectedIncentivesForPosition.IsZero() {
-		if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), sender, collectedIncentivesForPosition); err != nil {
+		if err := k.sendCoins(ctx, pool.GetIncentivesAddress(), sender, collectedIncentivesForPosition); err != nil {
 			return sdk.Coins{}, sdk.Coins{}, nil, err
 		}
 	}
@@ -986,6 +996,13 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		),
 	})
 
+	// Trigger after hook for CollectIncentives.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterCollectIncentives(ctx, position.PoolId, sender, positionId, collectedIncentivesForPosition, totalForfeitedIncentivesForPosition)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, nil, err
+	}
+
 	return collectedIncentivesForPosition, totalForfeitedIncentivesForPosition, scaledAmountForfeitedByUptime, nil
 }
 
@@ -1046,6 +1063,13 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		return types.IncentiveRecord{}, types.IncentiveInsufficientBalanceError{PoolId: poolId, IncentiveDenom: incentiveCoin.Denom, IncentiveAmount: incentiveCoin.Amount}
 	}
 
+	// Trigger before hook for CreateIncentive prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeCreateIncentive(ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
 	// Sync global uptime accumulators to current blocktime to ensure consistency in reward emissions
 	err = k.UpdatePoolUptimeAccumulatorsToNow(ctx, poolId)
 	if err != nil {
@@ -1090,6 +1114,13 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		return types.IncentiveRecord{}, err
 	}
 
+	// Trigger after hook for CreateIncentive.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterCreateIncentive(ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime, incentiveRecordId)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+
 	return incentiveRecord, nil
 }
 
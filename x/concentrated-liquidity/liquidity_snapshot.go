@@ -0,0 +1,202 @@
+package concentrated_liquidity
+
+import (
+	"time"
+
+	sdkprefix "cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// takeLiquiditySnapshots snapshots the pools at the end of the given liquidity snapshot epoch, in order of pool id,
+// and prunes the snapshots of each pool that are older than the last MaxLiquiditySnapshotsRetained epochs.
+// Each call picks up from the pool following the liquidity snapshot cursor and snapshots pools until gasBudget is spent.
+// A failure to snapshot a pool does not prevent the other pools from being snapshotted.
+func (k Keeper) takeLiquiditySnapshots(ctx sdk.Context, epochNumber uint64, gasBudget uint64) error {
+	gasStart := ctx.GasMeter().GasConsumed()
+	lastPoolId := k.getLiquiditySnapshotCursor(ctx)
+	for {
+		poolId, found := k.getNextConcentratedPoolId(ctx, lastPoolId)
+		if !found {
+			// All pools have been snapshotted, start from the first pool at the next epoch.
+			lastPoolId = 0
+			break
+		}
+
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.takeLiquiditySnapshot(ctx, pool, epochNumber)
+		})
+		lastPoolId = poolId
+
+		if ctx.GasMeter().GasConsumed()-gasStart >= gasBudget {
+			break
+		}
+	}
+
+	k.setLiquiditySnapshotCursor(ctx, lastPoolId)
+	return nil
+}
+
+// getNextConcentratedPoolId returns the smallest id of a concentrated pool greater than the given pool id, and false
+// if there is none. Pool ids are shared by all pool types, so the ids in between are checked without loading any pool.
+func (k Keeper) getNextConcentratedPoolId(ctx sdk.Context, poolId uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	nextPoolId := k.poolmanagerKeeper.GetNextPoolId(ctx)
+	for id := poolId + 1; id < nextPoolId; id++ {
+		if store.Has(types.KeyPool(id)) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// takeLiquiditySnapshot snapshots the liquidity of the given pool at the given epoch and prunes its expired snapshots.
+// Pools that never had a position, and therefore have no spot price, are not snapshotted.
+func (k Keeper) takeLiquiditySnapshot(ctx sdk.Context, pool types.ConcentratedPoolExtension, epochNumber uint64) error {
+	if epochNumber >= types.MaxLiquiditySnapshotsRetained {
+		k.pruneLiquiditySnapshots(ctx, pool.GetId(), epochNumber-types.MaxLiquiditySnapshotsRetained+1)
+	}
+
+	if pool.GetCurrentSqrtPrice().IsZero() {
+		return nil
+	}
+
+	depths, err := k.getLiquidityDepths(ctx, pool, types.LiquidityDepthPriceChanges)
+	if err != nil {
+		return err
+	}
+
+	spreadRewardGrowthGlobal, uptimeGrowthGlobal, err := k.getGrowthGlobalToNow(ctx, pool.GetId())
+	if err != nil {
+		return err
+	}
+
+	snapshot := types.LiquiditySnapshot{
+		PoolId:                   pool.GetId(),
+		EpochNumber:              epochNumber,
+		Time:                     ctx.BlockTime(),
+		CurrentTick:              pool.GetCurrentTick(),
+		CurrentSqrtPrice:         pool.GetCurrentSqrtPrice(),
+		ActiveLiquidity:          pool.GetLiquidity(),
+		Depths:                   depths,
+		SpreadRewardGrowthGlobal: spreadRewardGrowthGlobal,
+		UptimeGrowthGlobal:       uptimeGrowthGlobal,
+	}
+	k.setLiquiditySnapshot(ctx, snapshot)
+	return nil
+}
+
+// getGrowthGlobalToNow returns the value of the spread reward accumulator of the given pool and the sum of the values
+// of its uptime accumulators. The uptime accumulators are updated to the current block time in a cache context, so that
+// the incentives emitted since the last update of the pool are included without writing them to state.
+func (k Keeper) getGrowthGlobalToNow(ctx sdk.Context, poolId uint64) (sdk.DecCoins, sdk.DecCoins, error) {
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.UpdatePoolUptimeAccumulatorsToNow(cacheCtx, poolId); err != nil {
+		return nil, nil, err
+	}
+	uptimeAccumulatorValues, err := k.GetUptimeAccumulatorValues(cacheCtx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uptimeGrowthGlobal := sdk.DecCoins{}
+	for _, uptimeAccumulatorValue := range uptimeAccumulatorValues {
+		uptimeGrowthGlobal = uptimeGrowthGlobal.Add(uptimeAccumulatorValue...)
+	}
+	return spreadRewardAccumulator.GetValue(), uptimeGrowthGlobal, nil
+}
+
+// pruneLiquiditySnapshots deletes the snapshots of the given pool taken before the given epoch.
+func (k Keeper) pruneLiquiditySnapshots(ctx sdk.Context, poolId uint64, oldestRetainedEpoch uint64) {
+	store := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.KeyLiquiditySnapshotPoolPrefix(poolId))
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(oldestRetainedEpoch))
+	defer iter.Close()
+
+	var keysToDelete [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// getLiquiditySnapshotCursor returns the id of the last pool snapshotted at the previous liquidity snapshot epoch,
+// or zero if the previous epoch snapshotted all pools.
+func (k Keeper) getLiquiditySnapshotCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLiquiditySnapshotCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLiquiditySnapshotCursor sets the id of the last snapshotted pool.
+func (k Keeper) setLiquiditySnapshotCursor(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLiquiditySnapshotCursor, sdk.Uint64ToBigEndian(poolId))
+}
+
+// setLiquiditySnapshot stores the given liquidity snapshot.
+func (k Keeper) setLiquiditySnapshot(ctx sdk.Context, snapshot types.LiquiditySnapshot) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyLiquiditySnapshot(snapshot.PoolId, snapshot.EpochNumber), &snapshot)
+}
+
+// GetLiquiditySnapshots returns the retained liquidity snapshots of the given pool taken between the given
+// start and end times, inclusive, in order of epoch number.
+// Returns error if the pool does not exist or if the end time is before the start time.
+func (k Keeper) GetLiquiditySnapshots(ctx sdk.Context, poolId uint64, startTime, endTime time.Time) ([]types.LiquiditySnapshot, error) {
+	if endTime.Before(startTime) {
+		return nil, types.InvalidLiquiditySnapshotTimeRangeError{StartTime: startTime, EndTime: endTime}
+	}
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyLiquiditySnapshotPoolPrefix(poolId), parseLiquiditySnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsInRange := []types.LiquiditySnapshot{}
+	for _, snapshot := range snapshots {
+		if !snapshot.Time.Before(startTime) && !snapshot.Time.After(endTime) {
+			snapshotsInRange = append(snapshotsInRange, snapshot)
+		}
+	}
+	return snapshotsInRange, nil
+}
+
+// getAllLiquiditySnapshots returns the retained liquidity snapshots of all pools.
+func (k Keeper) getAllLiquiditySnapshots(ctx sdk.Context) ([]types.LiquiditySnapshot, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LiquiditySnapshotPrefix, parseLiquiditySnapshot)
+}
+
+// initLiquiditySnapshots stores the given liquidity snapshots.
+// Returns error if any of the snapshots belongs to a pool that does not exist.
+func (k Keeper) initLiquiditySnapshots(ctx sdk.Context, snapshots []types.LiquiditySnapshot) error {
+	for _, snapshot := range snapshots {
+		if _, err := k.getPoolById(ctx, snapshot.PoolId); err != nil {
+			return err
+		}
+		k.setLiquiditySnapshot(ctx, snapshot)
+	}
+	return nil
+}
+
+func parseLiquiditySnapshot(value []byte) (types.LiquiditySnapshot, error) {
+	snapshot := types.LiquiditySnapshot{}
+	err := snapshot.Unmarshal(value)
+	return snapshot, err
+}
@@ -0,0 +1,145 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestGetLiquidityDepths() {
+	pool := s.PrepareConcentratedPool()
+	fullRangePositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	fullRangeLiquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, fullRangePositionId)
+	s.Require().NoError(err)
+
+	// A narrow position within 1% of the spot price on both sides.
+	narrowCoins := sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000_000)))
+	s.FundAcc(s.TestAccs[1], narrowCoins)
+	narrowPosition, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], narrowCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultCurrTick-300, DefaultCurrTick+300)
+	s.Require().NoError(err)
+
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+
+	// System under test
+	depths, err := s.App.ConcentratedLiquidityKeeper.GetLiquidityDepths(s.Ctx, pool, types.LiquidityDepthPriceChanges)
+	s.Require().NoError(err)
+	s.Require().Len(depths, len(types.LiquidityDepthPriceChanges))
+
+	tolerance := osmomath.NewInt(10)
+	for i, depth := range depths {
+		s.Require().Equal(types.LiquidityDepthPriceChanges[i], depth.PriceChange)
+
+		// The full range position holds liquidity over the whole price change, the narrow position is entirely within it.
+		upperSqrtPrice := sqrtPrice.MulDec(osmomath.MustMonotonicSqrt(osmomath.OneDec().Add(depth.PriceChange)))
+		lowerSqrtPrice := sqrtPrice.MulDec(osmomath.MustMonotonicSqrt(osmomath.OneDec().Sub(depth.PriceChange)))
+		expectedAmount0 := math.CalcAmount0Delta(fullRangeLiquidity, sqrtPrice, upperSqrtPrice, false).Dec().TruncateInt().Add(narrowPosition.Amount0)
+		expectedAmount1 := math.CalcAmount1Delta(fullRangeLiquidity, lowerSqrtPrice, sqrtPrice, false).Dec().TruncateInt().Add(narrowPosition.Amount1)
+
+		s.Require().True(depth.Amount0.Sub(expectedAmount0).Abs().LTE(tolerance), "price change %s: expected amount0 %s, got %s", depth.PriceChange, expectedAmount0, depth.Amount0)
+		s.Require().True(depth.Amount1.Sub(expectedAmount1).Abs().LTE(tolerance), "price change %s: expected amount1 %s, got %s", depth.PriceChange, expectedAmount1, depth.Amount1)
+	}
+}
+
+func (s *KeeperTestSuite) TestTakeLiquiditySnapshots() {
+	pool := s.PrepareConcentratedPool()
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	emptyPool := s.PrepareConcentratedPool()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	startTime := s.Ctx.BlockTime()
+	for epochNumber := int64(1); epochNumber <= 2; epochNumber++ {
+		s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.LiquiditySnapshotEpochIdentifier, epochNumber))
+		s.AddBlockTime(24 * time.Hour)
+	}
+	endTime := s.Ctx.BlockTime()
+
+	// Other epochs do not take snapshots.
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 3))
+
+	snapshots, err := clKeeper.GetLiquiditySnapshots(s.Ctx, pool.GetId(), startTime, endTime)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	for i, snapshot := range snapshots {
+		s.Require().Equal(uint64(i+1), snapshot.EpochNumber)
+		s.Require().Equal(startTime.Add(time.Duration(i)*24*time.Hour), snapshot.Time)
+		s.Require().Equal(pool.GetCurrentTick(), snapshot.CurrentTick)
+		s.Require().Equal(pool.GetLiquidity(), snapshot.ActiveLiquidity)
+		s.Require().Len(snapshot.Depths, len(types.LiquidityDepthPriceChanges))
+	}
+
+	// The time range is inclusive.
+	snapshots, err = clKeeper.GetLiquiditySnapshots(s.Ctx, pool.GetId(), startTime, startTime)
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 1)
+	s.Require().Equal(uint64(1), snapshots[0].EpochNumber)
+
+	// Pools without positions are not snapshotted.
+	snapshots, err = clKeeper.GetLiquiditySnapshots(s.Ctx, emptyPool.GetId(), startTime, endTime)
+	s.Require().NoError(err)
+	s.Require().Empty(snapshots)
+
+	// Snapshots older than the retained epochs are pruned.
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.LiquiditySnapshotEpochIdentifier, int64(types.MaxLiquiditySnapshotsRetained+1)))
+	snapshots, err = clKeeper.GetLiquiditySnapshots(s.Ctx, pool.GetId(), startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Len(snapshots, 2)
+	s.Require().Equal(uint64(2), snapshots[0].EpochNumber)
+	s.Require().Equal(types.MaxLiquiditySnapshotsRetained+1, snapshots[1].EpochNumber)
+
+	// Invalid queries.
+	_, err = clKeeper.GetLiquiditySnapshots(s.Ctx, pool.GetId(), endTime, startTime)
+	s.Require().ErrorIs(err, types.InvalidLiquiditySnapshotTimeRangeError{StartTime: endTime, EndTime: startTime})
+	_, err = clKeeper.GetLiquiditySnapshots(s.Ctx, 100, startTime, endTime)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: 100})
+}
+
+func (s *KeeperTestSuite) TestTakeLiquiditySnapshots_GasBudget() {
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	poolIds := make([]uint64, 3)
+	for i := range poolIds {
+		// Pools of other types in between concentrated pools are skipped.
+		s.PrepareBalancerPool()
+		pool := s.PrepareConcentratedPool()
+		s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+		poolIds[i] = pool.GetId()
+	}
+
+	// The smallest budget is exhausted by a single pool, so each epoch snapshots the next pool.
+	for i, poolId := range poolIds {
+		epochNumber := uint64(i + 1)
+		s.Require().NoError(clKeeper.TakeLiquiditySnapshots(s.Ctx, epochNumber, 1))
+		s.Require().Equal(poolId, clKeeper.GetLiquiditySnapshotCursor(s.Ctx))
+
+		for j, otherPoolId := range poolIds {
+			snapshots, err := clKeeper.GetLiquiditySnapshots(s.Ctx, otherPoolId, s.Ctx.BlockTime(), s.Ctx.BlockTime())
+			s.Require().NoError(err)
+			if j <= i {
+				s.Require().Len(snapshots, 1)
+				s.Require().Equal(uint64(j+1), snapshots[0].EpochNumber)
+			} else {
+				s.Require().Empty(snapshots)
+			}
+		}
+	}
+
+	// Once the last pool is reached, the next epoch starts over.
+	s.Require().NoError(clKeeper.TakeLiquiditySnapshots(s.Ctx, uint64(len(poolIds)+1), 1))
+	s.Require().Equal(uint64(0), clKeeper.GetLiquiditySnapshotCursor(s.Ctx))
+
+	// A budget that is not exhausted snapshots every pool and resets the cursor.
+	s.Require().NoError(clKeeper.TakeLiquiditySnapshots(s.Ctx, uint64(len(poolIds)+2), types.LiquiditySnapshotGasBudget))
+	s.Require().Equal(uint64(0), clKeeper.GetLiquiditySnapshotCursor(s.Ctx))
+	for _, poolId := range poolIds {
+		snapshots, err := clKeeper.GetLiquiditySnapshots(s.Ctx, poolId, s.Ctx.BlockTime(), s.Ctx.BlockTime())
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(len(poolIds)+2), snapshots[len(snapshots)-1].EpochNumber)
+	}
+}
@@ -273,6 +273,17 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	// On a partial withdrawal, collect the spread rewards prior to updating the position, as for incentives.
+	// Otherwise, the spread rewards would carry over to the remaining liquidity and a position younger than the
+	// minimum spread rewards position age could claim them without forfeiting once it is old enough.
+	// On a full withdrawal, they are collected below, prior to deleting the position.
+	isFullWithdrawal := requestedLiquidityAmountToWithdraw.Equal(position.Liquidity)
+	if !isFullWithdrawal {
+		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
 	// Calculate the change in liquidity for the pool based on the requested amount to withdraw.
 	// This amount is negative because that liquidity is being withdrawn from the pool.
 	liquidityDelta := requestedLiquidityAmountToWithdraw.Neg()
@@ -299,7 +310,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	// Ensure we collect any outstanding spread factors prior to deleting the position from state. Outstanding incentives
 	// should already be fully claimed by this point. This claiming process also clears position records from spread factor
 	// and incentive accumulators.
-	if requestedLiquidityAmountToWithdraw.Equal(position.Liquidity) {
+	if isFullWithdrawal {
 		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
@@ -382,6 +393,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 // Note that these field indicates the min amount corresponding to the total liquidity of the position,
 // not only for the liquidity amount that is being added.
 // Uses amounts withdrawn from the original position if provided min amount is zero.
+// If the position is opted in to auto-compounding, so is the new position.
 // Returns error if
 // - Withdrawing full position fails
 // - Creating new position with added liquidity fails
@@ -417,6 +429,9 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// The new position remains opted in to auto-compounding, which withdrawing the position opts out of.
+	autoCompounding := k.IsPositionAutoCompounding(ctx, positionId)
+
 	// Withdraw full position.
 	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
@@ -451,6 +466,9 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	if err != nil {
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
+	if autoCompounding {
+		ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundPosition(newPositionData.ID), []byte{})
+	}
 
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -469,6 +487,262 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	return newPositionData.ID, newPositionData.Amount0, newPositionData.Amount1, nil
 }
 
+// rebalancePosition moves the position with the given id to the new tick range within the same pool.
+// It withdraws the full position, optionally swaps the part of the withdrawn tokens that does not fit
+// the new range at the current price through the same pool, and creates a new position at the new ticks
+// with the resulting tokens. Tokens that cannot be added to the new position are left with the owner.
+// Contrary to withdrawing and creating the positions separately, the spread rewards and incentives of the
+// old position are not claimed. They are moved to the new position as unclaimed rewards, and the new position
+// keeps the join time of the old one so that its incentives keep accruing towards the same uptimes.
+// The age of the new position towards the minimum spread rewards position age starts over, so if the old
+// position reached that age, its spread rewards are claimed instead of being moved.
+// Returns error if
+// - the owner does not own the position
+// - the new range is the range of the position
+// - the position is superfluid staked
+// - the position is the last position in the pool
+// - the swap fails
+// - creating the new position fails, including if it holds less than the given minimum amounts
+func (k Keeper) rebalancePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, newLowerTick, newUpperTick int64, swapImbalance bool, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Check if the provided owner owns the position being rebalanced.
+	if owner.String() != position.Address {
+		return CreatePositionData{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if position.LowerTick == newLowerTick && position.UpperTick == newUpperTick {
+		return CreatePositionData{}, types.RebalanceToSameRangeError{PositionId: positionId, LowerTick: newLowerTick, UpperTick: newUpperTick}
+	}
+
+	// If the position is superfluid staked, return error.
+	// Its new position would not be tied to the underlying lock.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if positionHasUnderlyingLock {
+		return CreatePositionData{}, types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Trigger before hook for WithdrawPosition prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeWithdrawPosition(ctx, position.PoolId, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The age of the new position towards the minimum spread rewards position age starts over. The spread rewards
+	// the old position earned once it reached the minimum age are claimed first, so that they are not forfeited.
+	minSpreadRewardsPositionAge := k.GetParams(ctx).MinSpreadRewardsPositionAge
+	if minSpreadRewardsPositionAge > 0 && ctx.BlockTime().Sub(k.getPositionSpreadRewardEligibilityTime(ctx, position)) >= minSpreadRewardsPositionAge {
+		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	// Withdraw the full position without claiming its rewards. Since the position update moves the rewards
+	// accrued so far to the unclaimed rewards of the position's accumulator records, these records are kept
+	// until the rewards are moved to the new position below.
+	liquidityDelta := position.Liquidity.Neg()
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0.Abs(), updateData.Amount1.Abs(), pool.GetAddress(), owner)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The growth outside the old range is captured before its ticks are possibly removed.
+	oldSpreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	oldUptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if !anyPositionsRemainingInPool {
+		return CreatePositionData{}, types.RebalanceLastPositionInPoolError{PoolId: position.PoolId, PositionId: positionId}
+	}
+
+	// If lowertick/uppertick has no liquidity in it, delete it from state.
+	if updateData.LowerTickIsEmpty {
+		k.RemoveTickInfo(ctx, position.PoolId, position.LowerTick)
+	}
+	if updateData.UpperTickIsEmpty {
+		k.RemoveTickInfo(ctx, position.PoolId, position.UpperTick)
+	}
+
+	amount0Withdrawn, amount1Withdrawn := updateData.Amount0.Neg(), updateData.Amount1.Neg()
+	tokensWithdrawn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0Withdrawn), sdk.NewCoin(pool.GetToken1(), amount1Withdrawn))
+	k.RecordTotalLiquidityDecrease(ctx, tokensWithdrawn)
+
+	event := &liquidityChangeEvent{
+		eventType:      types.TypeEvtWithdrawPosition,
+		positionId:     positionId,
+		sender:         owner,
+		poolId:         position.PoolId,
+		lowerTick:      position.LowerTick,
+		upperTick:      position.UpperTick,
+		joinTime:       position.JoinTime,
+		liquidityDelta: liquidityDelta,
+		actualAmount0:  updateData.Amount0,
+		actualAmount1:  updateData.Amount1,
+	}
+	event.emit(ctx)
+
+	// Trigger after hook for WithdrawPosition.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterWithdrawPosition(ctx, position.PoolId, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	tokensProvided := tokensWithdrawn
+	if swapImbalance {
+		sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(newLowerTick, newUpperTick)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+
+		// Refetch pool since the withdrawal may have updated its liquidity.
+		pool, err = k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+
+		tokenIn, tokenOutDenom := getRebalanceSwap(pool, sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Withdrawn, amount1Withdrawn)
+		if tokenIn.IsPositive() {
+			// The swap is routed through the pool manager so that it is charged the taker fee as any other swap.
+			// The minimum amounts of the new position protect against slippage.
+			tokenOutAmount, _, err := k.poolmanagerKeeper.SwapExactAmountIn(ctx, owner, position.PoolId, tokenIn, tokenOutDenom, osmomath.OneInt())
+			if err != nil {
+				return CreatePositionData{}, err
+			}
+			tokensProvided = tokensProvided.Sub(tokenIn).Add(sdk.NewCoin(tokenOutDenom, tokenOutAmount))
+		}
+	}
+
+	newPositionData, err := k.CreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, newLowerTick, newUpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Carry over the join time of the old position, so that its incentives keep accruing towards the same uptimes.
+	// The age of the new position towards the minimum spread rewards position age starts now instead, otherwise
+	// an old position could be moved around the current price just in time for a swap to capture its spread rewards.
+	err = k.SetPosition(ctx, position.PoolId, owner, newPositionData.LowerTick, newPositionData.UpperTick, position.JoinTime, newPositionData.Liquidity, newPositionData.ID, noUnderlyingLockId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	k.setPositionSpreadRewardEligibilityTime(ctx, newPositionData.ID, ctx.BlockTime())
+
+	// Move the unclaimed spread rewards and incentives of the old position to the new position.
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	newSpreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, position.PoolId, newPositionData.LowerTick, newPositionData.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	oldPositionName := types.KeySpreadRewardPositionAccumulator(positionId)
+	newPositionName := types.KeySpreadRewardPositionAccumulator(newPositionData.ID)
+	if err := moveRewardsToNewPositionAndDeleteOldAcc(spreadRewardAccumulator, oldPositionName, newPositionName, oldSpreadRewardGrowthOutside, newSpreadRewardGrowthOutside); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	newUptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, position.PoolId, newPositionData.LowerTick, newPositionData.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	oldPositionName = string(types.KeyPositionId(positionId))
+	newPositionName = string(types.KeyPositionId(newPositionData.ID))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		if err := moveRewardsToNewPositionAndDeleteOldAcc(uptimeAccum, oldPositionName, newPositionName, oldUptimeGrowthOutside[uptimeIndex], newUptimeGrowthOutside[uptimeIndex]); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	// Emit an event indicating that a position was rebalanced.
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRebalancePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionData.ID, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(newPositionData.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(newPositionData.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, newPositionData.Amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, newPositionData.Amount1.String()),
+		),
+	})
+
+	return newPositionData, nil
+}
+
+// getRebalanceSwap returns the token to swap in, and the denom to swap it for, so that the given amounts match
+// the ratio of the pool tokens held by a position in the given range at the current sqrt price of the pool.
+// The spread factor, the taker fee and the price impact of the swap are not accounted for, so the amounts after
+// the swap only approximately match the range. Returns a zero token in if no swap is needed.
+func getRebalanceSwap(pool types.ConcentratedPoolExtension, sqrtPriceLower, sqrtPriceUpper osmomath.BigDec, amount0, amount1 osmomath.Int) (tokenIn sdk.Coin, tokenOutDenom string) {
+	token0, token1 := pool.GetToken0(), pool.GetToken1()
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+
+	// A range above the current price only holds token0, a range below it only token1.
+	if sqrtPrice.LTE(sqrtPriceLower) {
+		return sdk.NewCoin(token1, amount1), token0
+	}
+	if sqrtPrice.GTE(sqrtPriceUpper) {
+		return sdk.NewCoin(token0, amount0), token1
+	}
+
+	// Per unit of liquidity, the range holds (1 / sqrtPrice - 1 / sqrtPriceUpper) token0 and (sqrtPrice - sqrtPriceLower) token1.
+	// Splitting the total value of the amounts, in token1, in that ratio yields the target amount of token0.
+	price := sqrtPrice.Mul(sqrtPrice)
+	token0PerLiquidity := sqrtPriceUpper.Sub(sqrtPrice).Quo(sqrtPrice.Mul(sqrtPriceUpper))
+	token1PerLiquidity := sqrtPrice.Sub(sqrtPriceLower)
+	value := osmomath.BigDecFromSDKInt(amount0).Mul(price).Add(osmomath.BigDecFromSDKInt(amount1))
+	targetAmount0 := value.Mul(token0PerLiquidity).Quo(price.Mul(token0PerLiquidity).Add(token1PerLiquidity))
+
+	targetAmount1 := value.Sub(targetAmount0.Mul(price))
+
+	// Round the targets up so that rounding never swaps more than the excess.
+	if excessAmount0 := amount0.Sub(targetAmount0.Dec().Ceil().TruncateInt()); excessAmount0.IsPositive() {
+		return sdk.NewCoin(token0, excessAmount0), token1
+	}
+	if excessAmount1 := amount1.Sub(targetAmount1.Dec().Ceil().TruncateInt()); excessAmount1.IsPositive() {
+		return sdk.NewCoin(token1, excessAmount1), token0
+	}
+	return sdk.NewCoin(token0, osmomath.ZeroInt()), token1
+}
+
 // UpdatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
 // Negative liquidityDelta implies withdrawing liquidity.
 // Positive liquidityDelta implies adding liquidity.
@@ -543,7 +817,7 @@ func (k Keeper) sendCoinsBetweenPoolAndUser(ctx sdk.Context, denom0, denom1 stri
 	}
 
 	finalCoinsToSend := sdk.NewCoins(sdk.NewCoin(denom1, amount1), sdk.NewCoin(denom0, amount0))
-	err := k.bankKeeper.SendCoins(ctx, sender, receiver, finalCoinsToSend)
+	err := k.sendCoins(ctx, sender, receiver, finalCoinsToSend)
 	if err != nil {
 		return err
 	}
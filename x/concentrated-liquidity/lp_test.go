@@ -593,11 +593,10 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			expectedIncentivesClaimed := sdk.NewCoins()
 
 			// Set the expected spread rewards claimed to the amount of liquidity created since the global spread reward growth is 1.
+			// Spread rewards are collected on both partial and full withdrawals.
 			// Fund the pool account with the expected spread rewards claimed.
-			if expectedRemainingLiquidity.IsZero() {
-				expectedSpreadRewardsClaimed = expectedSpreadRewardsClaimed.Add(sdk.NewCoin(ETH, liquidityCreated.TruncateInt()))
-				s.FundAcc(pool.GetSpreadRewardsAddress(), expectedSpreadRewardsClaimed)
-			}
+			expectedSpreadRewardsClaimed = expectedSpreadRewardsClaimed.Add(sdk.NewCoin(ETH, liquidityCreated.TruncateInt()))
+			s.FundAcc(pool.GetSpreadRewardsAddress(), expectedSpreadRewardsClaimed)
 
 			// Set expected incentives and fund pool with appropriate amount
 			expectedIncentivesClaimed = expectedIncentivesFromUptimeGrowth(defaultUptimeGrowth, liquidityCreated, tc.timeElapsed, defaultMultiplier)
@@ -2212,3 +2211,259 @@ func (s *KeeperTestSuite) TestValidatePositionUpdateById() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRebalancePosition() {
+	const (
+		narrowLowerTick = int64(30900000)
+		narrowUpperTick = int64(31100000)
+		aboveLowerTick  = int64(31100000)
+		aboveUpperTick  = int64(31200000)
+	)
+
+	tests := map[string]struct {
+		newLowerTick       int64
+		newUpperTick       int64
+		swapImbalance      bool
+		amount0Min         osmomath.Int
+		lastPositionInPool bool
+		senderNotOwner     bool
+		expectedError      error
+	}{
+		"rebalance to a narrower range around the current tick": {
+			newLowerTick: narrowLowerTick,
+			newUpperTick: narrowUpperTick,
+		},
+		"rebalance to a narrower range around the current tick, swapping the imbalance": {
+			newLowerTick:  narrowLowerTick,
+			newUpperTick:  narrowUpperTick,
+			swapImbalance: true,
+		},
+		"rebalance to a range above the current tick, swapping the imbalance": {
+			newLowerTick:  aboveLowerTick,
+			newUpperTick:  aboveUpperTick,
+			swapImbalance: true,
+		},
+		"error: rebalance to the same range": {
+			newLowerTick:  DefaultLowerTick,
+			newUpperTick:  DefaultUpperTick,
+			expectedError: types.RebalanceToSameRangeError{PositionId: 2, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick},
+		},
+		"error: sender is not the owner": {
+			newLowerTick:   narrowLowerTick,
+			newUpperTick:   narrowUpperTick,
+			senderNotOwner: true,
+			expectedError:  types.NotPositionOwnerError{PositionId: 2, Address: s.TestAccs[1].String()},
+		},
+		"error: last position in the pool": {
+			newLowerTick:       narrowLowerTick,
+			newUpperTick:       narrowUpperTick,
+			lastPositionInPool: true,
+			expectedError:      types.RebalanceLastPositionInPoolError{PoolId: 1, PositionId: 1},
+		},
+		"error: new position below the minimum amount": {
+			newLowerTick:  aboveLowerTick,
+			newUpperTick:  aboveUpperTick,
+			amount0Min:    DefaultAmt0.MulRaw(10),
+			expectedError: types.InsufficientLiquidityCreatedError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			owner := s.TestAccs[0]
+
+			// Provide enough liquidity for the imbalance swaps to have a small price impact.
+			if !tc.lastPositionInPool {
+				s.SetupPosition(pool.GetId(), s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0.MulRaw(1000)), sdk.NewCoin(USDC, DefaultAmt1.MulRaw(1000))), DefaultMinTick, DefaultMaxTick, false)
+			}
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			oldPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+
+			sender := owner
+			if tc.senderNotOwner {
+				sender = s.TestAccs[1]
+			}
+			amount0Min := osmomath.ZeroInt()
+			if !tc.amount0Min.IsNil() {
+				amount0Min = tc.amount0Min
+			}
+
+			s.AddBlockTime(time.Hour)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test
+			newPositionData, err := s.App.ConcentratedLiquidityKeeper.RebalancePosition(s.Ctx, sender, positionId, tc.newLowerTick, tc.newUpperTick, tc.swapImbalance, amount0Min, osmomath.ZeroInt())
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtRebalancePosition, 1)
+
+			// The old position is deleted.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+
+			// The new position is at the new range and keeps the join time of the old position.
+			newPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, newPositionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			s.Require().Equal(tc.newLowerTick, newPosition.LowerTick)
+			s.Require().Equal(tc.newUpperTick, newPosition.UpperTick)
+			s.Require().Equal(newPositionData.Liquidity, newPosition.Liquidity)
+			s.Require().Equal(oldPosition.JoinTime, newPosition.JoinTime)
+
+			// Whatever could not be added to the new position is left with the owner.
+			balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			leftover := balanceAfter.Sub(balanceBefore...)
+			if !tc.swapImbalance {
+				s.Require().True(leftover.AmountOf(ETH).Add(newPositionData.Amount0).LTE(DefaultAmt0))
+				s.Require().True(leftover.AmountOf(USDC).Add(newPositionData.Amount1).LTE(DefaultAmt1))
+				return
+			}
+
+			// Swapping the imbalance leaves at most a small fraction of the withdrawn tokens unused.
+			s.Require().True(leftover.AmountOf(ETH).LT(DefaultAmt0.QuoRaw(100)), "leftover %s", leftover)
+			s.Require().True(leftover.AmountOf(USDC).LT(DefaultAmt1.QuoRaw(100)), "leftover %s", leftover)
+			if tc.newLowerTick == aboveLowerTick {
+				s.Require().True(newPositionData.Amount1.IsZero())
+				s.Require().True(leftover.AmountOf(USDC).IsZero())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalancePosition_CarriesOverRewards() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(incentiveCoin))
+	_, err := s.App.ConcentratedLiquidityKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[2], incentiveCoin, osmomath.NewDec(1000), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+
+	// Accrue spread rewards in both tokens and incentives.
+	swapper := s.TestAccs[2]
+	swaps := []struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+	}{
+		{tokenIn: sdk.NewCoin(USDC, osmomath.NewInt(10_000_000)), tokenOutDenom: ETH},
+		{tokenIn: sdk.NewCoin(ETH, osmomath.NewInt(2_000)), tokenOutDenom: USDC},
+	}
+	for _, swap := range swaps {
+		s.FundAcc(swapper, sdk.NewCoins(swap.tokenIn))
+		_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, swapper, pool.GetId(), swap.tokenIn, swap.tokenOutDenom, osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+	s.AddBlockTime(time.Hour)
+
+	// Compute the rewards the position would claim if it was withdrawn instead.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	expectedSpreadRewards, err := s.App.ConcentratedLiquidityKeeper.CollectSpreadRewards(cacheCtx, owner, positionId)
+	s.Require().NoError(err)
+	expectedIncentives, _, _, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(cacheCtx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedSpreadRewards.IsZero())
+	s.Require().False(expectedIncentives.IsZero())
+
+	// System under test
+	newPositionData, err := s.App.ConcentratedLiquidityKeeper.RebalancePosition(s.Ctx, owner, positionId, 30900000, 31100000, false, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	// The old position accumulator records are deleted.
+	spreadRewardAccumulator, err := s.App.ConcentratedLiquidityKeeper.GetSpreadRewardAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(spreadRewardAccumulator.HasPosition(types.KeySpreadRewardPositionAccumulator(positionId)))
+	uptimeAccumulators, err := s.App.ConcentratedLiquidityKeeper.GetUptimeAccumulators(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	for _, uptimeAccumulator := range uptimeAccumulators {
+		s.Require().False(uptimeAccumulator.HasPosition(string(types.KeyPositionId(positionId))))
+	}
+
+	// The new position claims the rewards of the old position.
+	spreadRewards, err := s.App.ConcentratedLiquidityKeeper.CollectSpreadRewards(s.Ctx, owner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedSpreadRewards, spreadRewards)
+	incentives, _, _, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, owner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedIncentives, incentives)
+}
+
+// TestRebalancePosition_MinSpreadRewardsPositionAge tests that a rebalanced position keeps the join time of the old
+// position for its incentives, while its age towards the minimum spread rewards position age starts over.
+func (s *KeeperTestSuite) TestRebalancePosition_MinSpreadRewardsPositionAge() {
+	const minPositionAge = time.Hour
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.MinSpreadRewardsPositionAge = minPositionAge
+	params.AuthorizedUptimes = []time.Duration{time.Nanosecond, minPositionAge}
+	clKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	owner := s.TestAccs[0]
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	// The incentives require the position to be as old as the minimum spread rewards position age.
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(incentiveCoin))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[2], incentiveCoin, osmomath.NewDec(1000), s.Ctx.BlockTime(), minPositionAge)
+	s.Require().NoError(err)
+
+	s.AddBlockTime(minPositionAge)
+	s.accrueSpreadRewards(pool.GetId())
+	s.AddBlockTime(time.Minute)
+
+	// Compute the rewards the old position would claim if it was withdrawn instead.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	expectedSpreadRewards, err := clKeeper.CollectSpreadRewards(cacheCtx, owner, positionId)
+	s.Require().NoError(err)
+	expectedIncentives, _, _, err := clKeeper.CollectIncentives(cacheCtx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedSpreadRewards.IsZero())
+	s.Require().False(expectedIncentives.IsZero())
+
+	// Compute the tokens the owner would receive from rebalancing if the old spread rewards were moved instead.
+	cacheCtx, _ = s.Ctx.CacheContext()
+	clKeeper.SetParams(cacheCtx, types.DefaultParams())
+	balancesBefore := s.App.BankKeeper.GetAllBalances(cacheCtx, owner)
+	_, err = clKeeper.RebalancePosition(cacheCtx, owner, positionId, 30900000, 31100000, false, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	expectedReceivedWithoutSpreadRewards := s.App.BankKeeper.GetAllBalances(cacheCtx, owner).Sub(balancesBefore...)
+
+	// System under test
+	balancesBefore = s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	newPositionData, err := clKeeper.RebalancePosition(s.Ctx, owner, positionId, 30900000, 31100000, false, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	// The spread rewards of the old position are claimed on rebalancing rather than forfeited.
+	received := s.App.BankKeeper.GetAllBalances(s.Ctx, owner).Sub(balancesBefore...)
+	s.Require().Equal(expectedReceivedWithoutSpreadRewards.Add(expectedSpreadRewards...), received)
+
+	// The new position keeps the join time of the old position, so its incentives are not forfeited.
+	incentives, forfeitedIncentives, _, err := clKeeper.CollectIncentives(s.Ctx, owner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedIncentives, incentives)
+	s.Require().True(forfeitedIncentives.IsZero())
+
+	// The spread rewards the new position earns are forfeited until the new position reaches the minimum age.
+	s.accrueSpreadRewards(pool.GetId())
+	spreadRewards, err := clKeeper.CollectSpreadRewards(s.Ctx, owner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(spreadRewards.IsZero())
+
+	s.AddBlockTime(minPositionAge)
+	s.accrueSpreadRewards(pool.GetId())
+	spreadRewards, err = clKeeper.CollectSpreadRewards(s.Ctx, owner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+}
@@ -86,6 +86,108 @@ func (server msgServer) AddToPosition(goCtx context.Context, msg *types.MsgAddTo
 	return &types.MsgAddToPositionResponse{PositionId: positionId, Amount0: actualAmount0, Amount1: actualAmount1}, nil
 }
 
+func (server msgServer) RebalancePosition(goCtx context.Context, msg *types.MsgRebalancePosition) (*types.MsgRebalancePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = osmomath.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = osmomath.ZeroInt()
+	}
+
+	newPositionData, err := server.keeper.rebalancePosition(ctx, sender, msg.PositionId, msg.NewLowerTick, msg.NewUpperTick, msg.SwapImbalance, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: rebalance position event is emitted in keeper.rebalancePosition(...)
+
+	return &types.MsgRebalancePositionResponse{
+		NewPositionId:    newPositionData.ID,
+		Amount0:          newPositionData.Amount0,
+		Amount1:          newPositionData.Amount1,
+		LiquidityCreated: newPositionData.Liquidity,
+	}, nil
+}
+
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetPositionAutoCompound(ctx, sender, msg.PositionId, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	// Note: set position auto compound event is emitted in keeper.SetPositionAutoCompound(...)
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
+
+func (server msgServer) MintPositionToken(goCtx context.Context, msg *types.MsgMintPositionToken) (*types.MsgMintPositionTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := server.keeper.MintPositionToken(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: mint position token event is emitted in keeper.MintPositionToken(...)
+
+	return &types.MsgMintPositionTokenResponse{Token: token}, nil
+}
+
+func (server msgServer) RedeemPositionToken(goCtx context.Context, msg *types.MsgRedeemPositionToken) (*types.MsgRedeemPositionTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.RedeemPositionToken(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	// Note: redeem position token event is emitted in keeper.RedeemPositionToken(...)
+
+	return &types.MsgRedeemPositionTokenResponse{}, nil
+}
+
+// BatchPositionOps executes the provided position operations in order and atomically, netting their token transfers
+// so that the sender sends and receives each denom at most once.
+func (server msgServer) BatchPositionOps(goCtx context.Context, msg *types.MsgBatchPositionOps) (*types.MsgBatchPositionOpsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	results, tokensIn, tokensOut, err := server.keeper.batchPositionOps(ctx, sender, msg.Ops)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: batch position ops event is emitted in keeper.batchPositionOps(...)
+
+	return &types.MsgBatchPositionOpsResponse{Results: results, TokensIn: tokensIn, TokensOut: tokensOut}, nil
+}
+
 // TODO: tests, including events
 func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -198,3 +300,57 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+func (server msgServer) PlaceRangeOrder(goCtx context.Context, msg *types.MsgPlaceRangeOrder) (*types.MsgPlaceRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeOrder, liquidityCreated, err := server.keeper.PlaceRangeOrder(ctx, msg.PoolId, sender, msg.LowerTick, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: place range order event is emitted in keeper.PlaceRangeOrder(...)
+
+	return &types.MsgPlaceRangeOrderResponse{OrderId: rangeOrder.OrderId, LiquidityCreated: liquidityCreated}, nil
+}
+
+func (server msgServer) ClaimRangeOrder(goCtx context.Context, msg *types.MsgClaimRangeOrder) (*types.MsgClaimRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.keeper.ClaimRangeOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: claim range order event is emitted in keeper.ClaimRangeOrder(...)
+
+	return &types.MsgClaimRangeOrderResponse{Claimed: claimed}, nil
+}
+
+func (server msgServer) CancelRangeOrder(goCtx context.Context, msg *types.MsgCancelRangeOrder) (*types.MsgCancelRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawn, err := server.keeper.CancelRangeOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: cancel range order event is emitted in keeper.CancelRangeOrder(...)
+
+	return &types.MsgCancelRangeOrderResponse{Withdrawn: withdrawn}, nil
+}
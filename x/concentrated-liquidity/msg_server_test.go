@@ -304,7 +304,7 @@ func (s *KeeperTestSuite) TestCollectSpreadRewards_Events() {
 			expectedTotalSpreadRewards := sdk.Coins(nil)
 			cacheCtx, _ := s.Ctx.CacheContext()
 			for _, positionId := range tc.positionIds {
-				spreadRewardsClaimed, _ := s.App.ConcentratedLiquidityKeeper.PrepareClaimableSpreadRewards(cacheCtx, positionId)
+				spreadRewardsClaimed, _, _ := s.App.ConcentratedLiquidityKeeper.PrepareClaimableSpreadRewards(cacheCtx, positionId)
 				expectedTotalSpreadRewards = expectedTotalSpreadRewards.Add(spreadRewardsClaimed...)
 			}
 
@@ -2,6 +2,9 @@ package concentrated_liquidity
 
 import (
 	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
 
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/prefix"
@@ -10,7 +13,9 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/client/queryproto"
 	types "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
 )
 
 // --- Pool Hooks ---
@@ -89,110 +94,325 @@ func (k Keeper) AfterSwapExactAmountOut(ctx sdk.Context, poolId uint64, sender s
 	return k.callPoolActionListener(ctx, msgBuilderFn, poolId, types.AfterActionPrefix(types.SwapExactAmountOutPrefix))
 }
 
+// BeforeCollectSpreadRewards is a hook that is called before the spread rewards of a position are collected.
+func (k Keeper) BeforeCollectSpreadRewards(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.BeforeCollectSpreadRewardsMsg{PoolId: poolId, Owner: owner, PositionId: positionId}
+		return json.Marshal(types.BeforeCollectSpreadRewardsSudoMsg{BeforeCollectSpreadRewards: msg})
+	}
+	return k.callPoolActionListener(ctx, msgBuilderFn, poolId, types.BeforeActionPrefix(types.CollectSpreadRewardsPrefix))
+}
+
+// AfterCollectSpreadRewards is a hook that is called after the spread rewards of a position are collected.
+func (k Keeper) AfterCollectSpreadRewards(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64, collectedSpreadRewards sdk.Coins) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.AfterCollectSpreadRewardsMsg{PoolId: poolId, Owner: owner, PositionId: positionId, CollectedSpreadRewards: osmoutils.CWCoinsFromSDKCoins(collectedSpreadRewards)}
+		return json.Marshal(types.AfterCollectSpreadRewardsSudoMsg{AfterCollectSpreadRewards: msg})
+	}
+	return k.callPoolActionListener(ctx, msgBuilderFn, poolId, types.AfterActionPrefix(types.CollectSpreadRewardsPrefix))
+}
+
+// BeforeCollectIncentives is a hook that is called before the incentives of a position are collected.
+func (k Keeper) BeforeCollectIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.BeforeCollectIncentivesMsg{PoolId: poolId, Owner: owner, PositionId: positionId}
+		return json.Marshal(types.BeforeCollectIncentivesSudoMsg{BeforeCollectIncentives: msg})
+	}
+	return k.callPoolActionListener(ctx, msgBuilderFn, poolId, types.BeforeActionPrefix(types.CollectIncentivesPrefix))
+}
+
+// AfterCollectIncentives is a hook that is called after the incentives of a position are collected.
+func (k Keeper) AfterCollectIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, positionId uint64, collectedIncentives sdk.Coins, forfeitedIncentives sdk.Coins) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.AfterCollectIncentivesMsg{PoolId: poolId, Owner: owner, PositionId: positionId, CollectedIncentives: osmoutils.CWCoinsFromSDKCoins(collectedIncentives), ForfeitedIncentives: osmoutils.CWCoinsFromSDKCoins(forfeitedIncentives)}
+		return json.Marshal(types.AfterCollectIncentivesSudoMsg{AfterCollectIncentives: msg})
+	}
+	return k.callPoolActionListener(ctx, msgBuilderFn, poolId, types.AfterActionPrefix(types.CollectIncentivesPrefix))
+}
+
+// BeforeCreateIncentive is a hook that is called before an incentive is created.
+func (k Keeper) BeforeCreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.BeforeCreateIncentiveMsg{PoolId: poolId, Sender: sender, IncentiveCoin: osmoutils.CWCoinFromSDKCoin(incentiveCoin), EmissionRate: emissionRate, StartTime: startTime, MinUptime: minUptime}
+		return json.Marshal(types.BeforeCreateIncentiveSudoMsg{BeforeCreateIncentive: msg})
+	}
+	return k.callPoolActionListenerWithFailurePolicy(ctx, msgBuilderFn, poolId, types.BeforeActionPrefix(types.CreateIncentivePrefix), k.getCreateIncentiveHookFailurePolicy(ctx, poolId, sender))
+}
+
+// AfterCreateIncentive is a hook that is called after an incentive is created.
+func (k Keeper) AfterCreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration, incentiveId uint64) error {
+	msgBuilderFn := func(poolId uint64) ([]byte, error) {
+		msg := types.AfterCreateIncentiveMsg{PoolId: poolId, Sender: sender, IncentiveCoin: osmoutils.CWCoinFromSDKCoin(incentiveCoin), EmissionRate: emissionRate, StartTime: startTime, MinUptime: minUptime, IncentiveId: incentiveId}
+		return json.Marshal(types.AfterCreateIncentiveSudoMsg{AfterCreateIncentive: msg})
+	}
+	return k.callPoolActionListenerWithFailurePolicy(ctx, msgBuilderFn, poolId, types.AfterActionPrefix(types.CreateIncentivePrefix), k.getCreateIncentiveHookFailurePolicy(ctx, poolId, sender))
+}
+
 // callPoolActionListener processes and dispatches the passed in message to the contract corresponding to the hook
 // defined by the given pool ID and action prefix (e.g. pool Id: 1, action prefix: "beforeSwap").
 //
-// This function returns an error if the contract address in state is invalid (should be impossible) or if the contract execution fails.
+// This function returns an error if the contract address in state is invalid (should be impossible) or if the contract execution fails,
+// unless the failure policy of the pool is to ignore hook failures. In that case, the state changes of the contract are discarded,
+// the error is recorded as the last error of the hook and emitted in an event, and the action proceeds.
 //
-// Since it is possible for this function to be triggered in begin block code, we need to directly meter its execution and set a limit.
 // If no contract is linked to the hook, this function is a no-op.
-func (k Keeper) callPoolActionListener(ctx sdk.Context, msgBuilderFn msgBuilderFn, poolId uint64, actionPrefix string) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = types.ContractHookOutOfGasError{GasLimit: k.GetParams(ctx).HookGasLimit}
-		}
-	}()
+func (k Keeper) callPoolActionListener(ctx sdk.Context, msgBuilderFn msgBuilderFn, poolId uint64, actionPrefix string) error {
+	return k.callPoolActionListenerWithFailurePolicy(ctx, msgBuilderFn, poolId, actionPrefix, k.GetPoolHookFailurePolicy(ctx, poolId))
+}
 
-	cosmwasmAddress := k.getPoolHookContract(ctx, poolId, actionPrefix)
-	if cosmwasmAddress == "" {
+// callPoolActionListenerWithFailurePolicy is callPoolActionListener with the given failure policy instead of the one of the pool.
+func (k Keeper) callPoolActionListenerWithFailurePolicy(ctx sdk.Context, msgBuilderFn msgBuilderFn, poolId uint64, actionPrefix string, failurePolicy types.PoolHookFailurePolicy) error {
+	hook, found := k.getPoolHook(ctx, poolId, actionPrefix)
+	if !found {
 		return nil
 	}
 
+	err := k.executePoolHook(ctx, msgBuilderFn, poolId, hook)
+	if err == nil || failurePolicy == types.PoolHookFailurePolicyRevert {
+		return err
+	}
+
+	k.setPoolHookLastError(ctx, poolId, actionPrefix, types.PoolHookError{
+		Error:       err.Error(),
+		BlockHeight: ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+	})
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolHookFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionPrefix, actionPrefix),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, hook.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	})
+	return nil
+}
+
+// executePoolHook calls the contract of the given hook with the message built for the given pool. The state changes
+// of the contract are only written if the call succeeds.
+//
+// Since it is possible for this function to be triggered in begin block code, we need to directly meter its execution and set a limit.
+// The limit is the gas limit of the hook, defaulting to and capped at the hook gas limit param. The cap also applies to the hooks
+// set before the param was lowered.
+func (k Keeper) executePoolHook(ctx sdk.Context, msgBuilderFn msgBuilderFn, poolId uint64, hook types.PoolHook) (err error) {
+	gasLimit := k.GetParams(ctx).HookGasLimit
+	if hook.GasLimit != 0 && hook.GasLimit < gasLimit {
+		gasLimit = hook.GasLimit
+	}
+
 	msgBz, err := msgBuilderFn(poolId)
 	if err != nil {
 		return err
 	}
 
-	cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	cwAddr, err := sdk.AccAddressFromBech32(hook.ContractAddress)
 	if err != nil {
 		return err
 	}
 
-	em := sdk.NewEventManager()
+	// We ensure the limit only applies to this call by creating a child context with a gas
+	// limit and then metering the gas used in parent context once the operation is completed,
+	// whether it succeeded or not. See comments on `ContractHookGasLimit` for details on how
+	// the default limit was chosen.
+	cacheCtx, write := ctx.CacheContext()
+	childCtx := cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager())
+	defer func() {
+		if r := recover(); r != nil {
+			err = types.ContractHookOutOfGasError{GasLimit: gasLimit}
+		}
+
+		// Consume gas used for calling contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "Track CL action contract call gas")
+	}()
 
-	// Since it is possible for this hook to be triggered in begin block code, we need to
-	// directly meter its execution and set a limit. See comments on `ContractHookGasLimit`
-	// for details on how the specific limit was chosen.
-	//
-	// We ensure this limit only applies to this call by creating a child context with a gas
-	// limit and then metering the gas used in parent context once the operation is completed.
-	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(k.GetParams(ctx).HookGasLimit))
-	_, err = k.contractKeeper.Sudo(childCtx.WithEventManager(em), cwAddr, msgBz)
+	_, err = k.contractKeeper.Sudo(childCtx, cwAddr, msgBz)
 	if err != nil {
 		return err
 	}
 
-	// Consume gas used for calling contract to the parent ctx
-	ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumed(), "Track CL action contract call gas")
+	write()
+	return nil
+}
+
+// HandlePoolHooksProposal handles a pool hooks proposal to the corresponding keeper methods.
+// Returns error if the gas limit of any of the hooks exceeds the hook gas limit param.
+func (k Keeper) HandlePoolHooksProposal(ctx sdk.Context, p *types.PoolHooksProposal) error {
+	if _, err := k.getPoolById(ctx, p.PoolId); err != nil {
+		return err
+	}
+
+	hookGasLimit := k.GetParams(ctx).HookGasLimit
+	for _, hook := range p.Hooks {
+		if hook.GasLimit > hookGasLimit {
+			return types.PoolHookGasLimitTooHighError{ActionPrefix: hook.ActionPrefix, GasLimit: hook.GasLimit, MaxGasLimit: hookGasLimit}
+		}
+		if err := k.setPoolHook(ctx, p.PoolId, hook); err != nil {
+			return err
+		}
+	}
+	for _, actionPrefix := range p.RemovedActionPrefixes {
+		if err := k.setPoolHookContract(ctx, p.PoolId, actionPrefix, ""); err != nil {
+			return err
+		}
+	}
+	k.setPoolHookFailurePolicy(ctx, p.PoolId, p.FailurePolicy)
+	return nil
+}
 
+// GetPoolHooks returns the hooks configured on the given pool, ordered by action prefix, along with the last
+// error of each hook ignored by the failure policy of the pool.
+func (k Keeper) GetPoolHooks(ctx sdk.Context, poolId uint64) []queryproto.PoolHookStatus {
+	actionPrefixes := types.GetAllActionPrefixes()
+	sort.Strings(actionPrefixes)
+
+	hooks := []queryproto.PoolHookStatus{}
+	for _, actionPrefix := range actionPrefixes {
+		hook, found := k.getPoolHook(ctx, poolId, actionPrefix)
+		if !found {
+			continue
+		}
+		status := queryproto.PoolHookStatus{Hook: hook}
+		if lastError, found := k.getPoolHookLastError(ctx, poolId, actionPrefix); found {
+			status.LastError = &lastError
+		}
+		hooks = append(hooks, status)
+	}
+	return hooks
+}
+
+// initPoolHooks sets the given hooks of the given pool, along with their last errors, and the hook failure policy
+// of the pool.
+// Returns error if any of the hooks is invalid.
+func (k Keeper) initPoolHooks(ctx sdk.Context, poolId uint64, hooks []genesis.PoolHookData, failurePolicy types.PoolHookFailurePolicy) error {
+	for _, hookData := range hooks {
+		if err := k.setPoolHook(ctx, poolId, hookData.Hook); err != nil {
+			return err
+		}
+		if hookData.LastError != nil {
+			k.setPoolHookLastError(ctx, poolId, hookData.Hook.ActionPrefix, *hookData.LastError)
+		}
+	}
+	if failurePolicy != types.PoolHookFailurePolicyRevert {
+		k.setPoolHookFailurePolicy(ctx, poolId, failurePolicy)
+	}
 	return nil
 }
 
+// getPoolHooksData returns the hooks of the given pool along with their last errors, ordered by action prefix.
+func (k Keeper) getPoolHooksData(ctx sdk.Context, poolId uint64) []genesis.PoolHookData {
+	hooks := []genesis.PoolHookData{}
+	for _, status := range k.GetPoolHooks(ctx, poolId) {
+		hooks = append(hooks, genesis.PoolHookData{Hook: status.Hook, LastError: status.LastError})
+	}
+	return hooks
+}
+
+// GetPoolHookFailurePolicy returns the hook failure policy of the given pool, which defaults to reverting.
+func (k Keeper) GetPoolHookFailurePolicy(ctx sdk.Context, poolId uint64) types.PoolHookFailurePolicy {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPoolHookFailurePolicy(poolId))
+	if bz == nil {
+		return types.PoolHookFailurePolicyRevert
+	}
+	return types.PoolHookFailurePolicy(sdk.BigEndianToUint64(bz))
+}
+
+// getCreateIncentiveHookFailurePolicy returns the failure policy of the create incentive hooks of the given pool
+// for an incentive created by the given sender.
+// Incentives created by module accounts, such as the ones distributed by the incentives module at the end of every
+// epoch for all of the gauges at once, ignore hook failures regardless of the policy of the pool. Otherwise, the
+// hook of a single pool could fail the distribution of every gauge.
+func (k Keeper) getCreateIncentiveHookFailurePolicy(ctx sdk.Context, poolId uint64, sender sdk.AccAddress) types.PoolHookFailurePolicy {
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, sender).(sdk.ModuleAccountI); isModuleAccount {
+		return types.PoolHookFailurePolicyIgnore
+	}
+	return k.GetPoolHookFailurePolicy(ctx, poolId)
+}
+
 // --- Store helpers ---
 
-// nolint: unused
 // getPoolHookPrefixStore returns the substore for a specific pool ID where hook-related data is stored.
 func (k Keeper) getPoolHookPrefixStore(ctx sdk.Context, poolID uint64) store.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.GetPoolPrefixStoreKey(poolID))
 }
 
-// nolint: unused
 // getPoolHookContract returns the contract address linked to the passed in action for a specific pool ID.
 // For instance, if poolId is `1` and actionPrefix is "beforeSwap", this will return the contract address
 // corresponding to the beforeSwap hook on pool 1.
 func (k Keeper) getPoolHookContract(ctx sdk.Context, poolId uint64, actionPrefix string) string {
+	hook, _ := k.getPoolHook(ctx, poolId, actionPrefix)
+	return hook.ContractAddress
+}
+
+// getPoolHook returns the hook linked to the passed in action for a specific pool ID.
+// Returns false if no hook is linked to the action.
+func (k Keeper) getPoolHook(ctx sdk.Context, poolId uint64, actionPrefix string) (types.PoolHook, bool) {
 	store := k.getPoolHookPrefixStore(ctx, poolId)
 
-	bz := store.Get([]byte(actionPrefix))
-	if bz == nil {
-		return ""
+	hook := types.PoolHook{}
+	found, err := osmoutils.Get(store, []byte(actionPrefix), &hook)
+	if err != nil {
+		panic(err)
 	}
-
-	return string(bz)
+	return hook, found
 }
 
-// nolint: unused
-// setPoolHookContract sets the contract address linked to the passed in hook for a specific pool ID.
+// setPoolHookContract sets the contract address linked to the passed in hook for a specific pool ID, with the default gas limit.
 // Passing in an empty string for `cosmwasmAddress` will be interpreted as a deletion for the contract associated
 // with the given poolId and actionPrefix.
 // Attempting to delete a non-existent contract in state will simply be a no-op.
 func (k Keeper) setPoolHookContract(ctx sdk.Context, poolID uint64, actionPrefix string, cosmwasmAddress string) error {
-	store := k.getPoolHookPrefixStore(ctx, poolID)
-
-	validActionPrefixes := types.GetAllActionPrefixes()
-	if !osmoutils.Contains(validActionPrefixes, actionPrefix) {
-		return types.InvalidActionPrefixError{ActionPrefix: actionPrefix, ValidActions: validActionPrefixes}
-	}
-
-	// If cosmwasm address is nil, treat this as a delete operation for the stored address.
+	// If cosmwasm address is nil, treat this as a delete operation for the stored hook.
 	if cosmwasmAddress == "" {
-		deletePoolHookContract(store, actionPrefix)
+		validActionPrefixes := types.GetAllActionPrefixes()
+		if !osmoutils.Contains(validActionPrefixes, actionPrefix) {
+			return types.InvalidActionPrefixError{ActionPrefix: actionPrefix, ValidActions: validActionPrefixes}
+		}
+
+		deletePoolHookContract(k.getPoolHookPrefixStore(ctx, poolID), actionPrefix)
+		ctx.KVStore(k.storeKey).Delete(types.KeyPoolHookLastError(poolID, actionPrefix))
 		return nil
 	}
 
-	// Verify that the cosmwasm address is valid bech32 that can be converted to AccAddress.
-	_, err := sdk.AccAddressFromBech32(cosmwasmAddress)
-	if err != nil {
+	return k.setPoolHook(ctx, poolID, types.PoolHook{ActionPrefix: actionPrefix, ContractAddress: cosmwasmAddress})
+}
+
+// setPoolHook links the given hook to its action for a specific pool ID, replacing the hook previously linked to the action
+// and clearing its last error.
+// Returns error if the action prefix is invalid or the contract address is not valid bech32.
+func (k Keeper) setPoolHook(ctx sdk.Context, poolID uint64, hook types.PoolHook) error {
+	if err := hook.Validate(); err != nil {
 		return err
 	}
 
-	store.Set([]byte(actionPrefix), []byte(cosmwasmAddress))
-
+	osmoutils.MustSet(k.getPoolHookPrefixStore(ctx, poolID), []byte(hook.ActionPrefix), &hook)
+	ctx.KVStore(k.storeKey).Delete(types.KeyPoolHookLastError(poolID, hook.ActionPrefix))
 	return nil
 }
 
-// nolint: unused
+// setPoolHookFailurePolicy sets the hook failure policy of the given pool.
+func (k Keeper) setPoolHookFailurePolicy(ctx sdk.Context, poolId uint64, failurePolicy types.PoolHookFailurePolicy) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPoolHookFailurePolicy(poolId), sdk.Uint64ToBigEndian(uint64(failurePolicy)))
+}
+
+// getPoolHookLastError returns the last error of the hook of the given pool and action ignored by the failure policy of the pool.
+// Returns false if there is none.
+func (k Keeper) getPoolHookLastError(ctx sdk.Context, poolId uint64, actionPrefix string) (types.PoolHookError, bool) {
+	hookError := types.PoolHookError{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPoolHookLastError(poolId, actionPrefix), &hookError)
+	if err != nil {
+		panic(err)
+	}
+	return hookError, found
+}
+
+// setPoolHookLastError records the given error as the last error of the hook of the given pool and action.
+func (k Keeper) setPoolHookLastError(ctx sdk.Context, poolId uint64, actionPrefix string, hookError types.PoolHookError) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPoolHookLastError(poolId, actionPrefix), &hookError)
+}
+
 // deletePoolHookContract deletes the pool hook contract corresponding to the given action prefix from the passed in store.
 // It takes in a store directly instead of ctx and pool ID to avoid doing another read (to fetch pool hook prefix store) for
 // an abstraction that was primarily added for code readability reasons.
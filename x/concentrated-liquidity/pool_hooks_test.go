@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v26/x/incentives/types"
 )
 
 var (
@@ -112,6 +113,7 @@ func (s *KeeperTestSuite) TestCallPoolActionListener() {
 		wasmFile      string
 		msg           CountSudoMsg
 		noContractSet bool
+		gasLimit      uint64
 
 		expectedError error
 	}{
@@ -151,6 +153,31 @@ func (s *KeeperTestSuite) TestCallPoolActionListener() {
 				},
 			},
 
+			expectedError: types.ContractHookOutOfGasError{GasLimit: types.DefaultContractHookGasLimit},
+		},
+		"error: contract that consumes more than the gas limit of its hook": {
+			wasmFile: counterContractPath,
+			msg: CountSudoMsg{
+				Count: CountMsg{
+					// Consumes roughly 100k gas, which is over the limit of the hook.
+					Amount: 10,
+				},
+			},
+			gasLimit: 50_000,
+
+			expectedError: types.ContractHookOutOfGasError{GasLimit: 50_000},
+		},
+		"error: gas limit of the hook above the param is capped at the param": {
+			wasmFile: counterContractPath,
+			msg: CountSudoMsg{
+				Count: CountMsg{
+					// Each loop in the contract consumes on the order of 1k-10k gas,
+					// so this should push consumed gas over the param limit.
+					Amount: int64(types.DefaultContractHookGasLimit) / 1000,
+				},
+			},
+			gasLimit: 10 * types.DefaultContractHookGasLimit,
+
 			expectedError: types.ContractHookOutOfGasError{GasLimit: types.DefaultContractHookGasLimit},
 		},
 	}
@@ -164,7 +191,7 @@ func (s *KeeperTestSuite) TestCallPoolActionListener() {
 			_, cosmwasmAddressBech32 := s.uploadAndInstantiateContract(tc.wasmFile)
 
 			// Set pool hook contract to the newly instantiated contract
-			err := s.Clk.SetPoolHookContract(s.Ctx, validPoolId, validActionPrefix, cosmwasmAddressBech32)
+			err := s.Clk.SetPoolHook(s.Ctx, validPoolId, types.PoolHook{ActionPrefix: validActionPrefix, ContractAddress: cosmwasmAddressBech32, GasLimit: tc.gasLimit})
 			s.Require().NoError(err)
 
 			// Marshal test case msg to pass into contract
@@ -302,6 +329,149 @@ func (s *KeeperTestSuite) TestPoolHooks() {
 	}
 }
 
+// TestPoolHookFailurePolicy tests that the failure of a hook fails the action that triggered it under the revert
+// policy, and that it is recorded and emitted while the action succeeds under the ignore policy.
+//
+// The hooks test contract does not implement the collect and incentive creation hooks, so every call to
+// it on these actions fails. This is used to check that each of these actions triggers its hooks.
+func (s *KeeperTestSuite) TestPoolHookFailurePolicy() {
+	hookContractFilePath := "./testcontracts/compiled-wasm/hooks.wasm"
+	failingHooks := []string{
+		after(types.CollectIncentivesPrefix),
+		after(types.CollectSpreadRewardsPrefix),
+		after(types.CreateIncentivePrefix),
+		before(types.CollectIncentivesPrefix),
+		before(types.CollectSpreadRewardsPrefix),
+		before(types.CreateIncentivePrefix),
+	}
+
+	for _, failurePolicy := range []types.PoolHookFailurePolicy{types.PoolHookFailurePolicyRevert, types.PoolHookFailurePolicyIgnore} {
+		s.Run(failurePolicy.String(), func() {
+			s.SetupTest()
+			clPool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(clPool.GetId(), s.TestAccs[0])
+			_, cosmwasmAddressBech32 := s.uploadAndInstantiateContract(hookContractFilePath)
+
+			// The before create position hook succeeds, so only the failing hooks have errors.
+			hooks := []types.PoolHook{{ActionPrefix: before(types.CreatePositionPrefix), ContractAddress: cosmwasmAddressBech32}}
+			for _, actionPrefix := range failingHooks {
+				hooks = append(hooks, types.PoolHook{ActionPrefix: actionPrefix, ContractAddress: cosmwasmAddressBech32, GasLimit: 1_000_000})
+			}
+			err := s.Clk.HandlePoolHooksProposal(s.Ctx, &types.PoolHooksProposal{PoolId: clPool.GetId(), Hooks: hooks, FailurePolicy: failurePolicy})
+			s.Require().NoError(err)
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))))
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// --- Execute the actions that trigger the failing hooks ---
+
+			_, errCollectSpreadRewards := s.Clk.CollectSpreadRewards(s.Ctx, s.TestAccs[0], positionId)
+			_, _, _, errCollectIncentives := s.Clk.CollectIncentives(s.Ctx, s.TestAccs[0], positionId)
+			_, errCreateIncentive := s.Clk.CreateIncentive(s.Ctx, clPool.GetId(), s.TestAccs[1], sdk.NewCoin(USDC, osmomath.NewInt(1_000_000)), osmomath.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+
+			s.Require().Equal(failurePolicy, s.Clk.GetPoolHookFailurePolicy(s.Ctx, clPool.GetId()))
+			poolHooks := s.Clk.GetPoolHooks(s.Ctx, clPool.GetId())
+			s.Require().Len(poolHooks, len(hooks))
+
+			if failurePolicy == types.PoolHookFailurePolicyRevert {
+				s.Require().Error(errCollectSpreadRewards)
+				s.Require().Error(errCollectIncentives)
+				s.Require().Error(errCreateIncentive)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolHookFailed, 0)
+
+				// Errors are not recorded as the actions that triggered them revert.
+				for _, status := range poolHooks {
+					s.Require().Nil(status.LastError)
+				}
+				return
+			}
+
+			s.Require().NoError(errCollectSpreadRewards)
+			s.Require().NoError(errCollectIncentives)
+			s.Require().NoError(errCreateIncentive)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolHookFailed, len(failingHooks))
+
+			// Hooks are ordered by action prefix, and only the failing hooks have errors.
+			for i, status := range poolHooks {
+				if status.Hook.ActionPrefix == before(types.CreatePositionPrefix) {
+					s.Require().Nil(status.LastError)
+					continue
+				}
+				s.Require().Equal(failingHooks[i], status.Hook.ActionPrefix)
+				s.Require().Equal(uint64(1_000_000), status.Hook.GasLimit)
+				s.Require().NotNil(status.LastError)
+				s.Require().Equal(s.Ctx.BlockHeight(), status.LastError.BlockHeight)
+				s.Require().Equal(s.Ctx.BlockTime(), status.LastError.Time)
+				s.Require().NotEmpty(status.LastError.Error)
+			}
+
+			// Replacing a hook clears its last error, and removing a hook removes it from the pool.
+			err = s.Clk.HandlePoolHooksProposal(s.Ctx, &types.PoolHooksProposal{
+				PoolId:                clPool.GetId(),
+				Hooks:                 []types.PoolHook{{ActionPrefix: failingHooks[0], ContractAddress: cosmwasmAddressBech32}},
+				RemovedActionPrefixes: failingHooks[1:],
+				FailurePolicy:         failurePolicy,
+			})
+			s.Require().NoError(err)
+			poolHooks = s.Clk.GetPoolHooks(s.Ctx, clPool.GetId())
+			s.Require().Len(poolHooks, 2)
+			for _, status := range poolHooks {
+				s.Require().Nil(status.LastError)
+			}
+		})
+	}
+}
+
+// TestPoolHookFailurePolicy_ModuleAccountCreateIncentive tests that the failure of a create incentive hook does not
+// fail the creation of an incentive by a module account, such as the distribution of a gauge by the incentives module,
+// even under the revert policy.
+func (s *KeeperTestSuite) TestPoolHookFailurePolicy_ModuleAccountCreateIncentive() {
+	s.SetupTest()
+	clPool := s.PrepareConcentratedPool()
+	_, cosmwasmAddressBech32 := s.uploadAndInstantiateContract("./testcontracts/compiled-wasm/hooks.wasm")
+	err := s.Clk.HandlePoolHooksProposal(s.Ctx, &types.PoolHooksProposal{
+		PoolId: clPool.GetId(),
+		Hooks: []types.PoolHook{
+			{ActionPrefix: before(types.CreateIncentivePrefix), ContractAddress: cosmwasmAddressBech32},
+			{ActionPrefix: after(types.CreateIncentivePrefix), ContractAddress: cosmwasmAddressBech32},
+		},
+		FailurePolicy: types.PoolHookFailurePolicyRevert,
+	})
+	s.Require().NoError(err)
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))
+
+	// An account fails to create an incentive.
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err = s.Clk.CreateIncentive(s.Ctx, clPool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().Error(err)
+
+	// The incentives module creates the incentive, and the hook failures are recorded.
+	s.FundModuleAcc(incentivestypes.ModuleName, sdk.NewCoins(incentiveCoin))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.Clk.CreateIncentive(s.Ctx, clPool.GetId(), s.App.AccountKeeper.GetModuleAddress(incentivestypes.ModuleName), incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolHookFailed, 2)
+	for _, status := range s.Clk.GetPoolHooks(s.Ctx, clPool.GetId()) {
+		s.Require().NotNil(status.LastError)
+	}
+}
+
+// TestHandlePoolHooksProposal_GasLimitTooHigh tests that a hook cannot be set with a gas limit above the hook gas limit param.
+func (s *KeeperTestSuite) TestHandlePoolHooksProposal_GasLimitTooHigh() {
+	s.SetupTest()
+	clPool := s.PrepareConcentratedPool()
+	hookGasLimit := s.Clk.GetParams(s.Ctx).HookGasLimit
+
+	hook := types.PoolHook{ActionPrefix: validActionPrefix, ContractAddress: validCosmwasmAddress, GasLimit: hookGasLimit + 1}
+	err := s.Clk.HandlePoolHooksProposal(s.Ctx, &types.PoolHooksProposal{PoolId: clPool.GetId(), Hooks: []types.PoolHook{hook}})
+	s.Require().ErrorIs(err, types.PoolHookGasLimitTooHighError{ActionPrefix: validActionPrefix, GasLimit: hookGasLimit + 1, MaxGasLimit: hookGasLimit})
+	s.Require().Empty(s.Clk.GetPoolHooks(s.Ctx, clPool.GetId()))
+
+	hook.GasLimit = hookGasLimit
+	err = s.Clk.HandlePoolHooksProposal(s.Ctx, &types.PoolHooksProposal{PoolId: clPool.GetId(), Hooks: []types.PoolHook{hook}})
+	s.Require().NoError(err)
+	s.Require().Len(s.Clk.GetPoolHooks(s.Ctx, clPool.GetId()), 1)
+}
+
 // Adds "before" prefix to action (helper for test readability)
 func before(action string) string {
 	return types.BeforeActionPrefix(action)
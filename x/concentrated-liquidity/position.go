@@ -391,6 +391,12 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the position from the auto-compounding positions (if it is one).
+	store.Delete(types.KeyAutoCompoundPosition(positionId))
+
+	// Remove the spread reward eligibility time of the position (if it has one).
+	store.Delete(types.KeyPositionSpreadRewardEligibilityTime(positionId))
+
 	return nil
 }
 
@@ -699,6 +705,11 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 			return types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: sender.String()}
 		}
 
+		// Tokenized positions are transferred by transferring their token, and must be redeemed to leave the custody of the module.
+		if k.IsPositionTokenized(position) || recipient.Equals(types.PositionTokenCustodyAddress) {
+			return types.PositionTokenizedError{PositionId: positionId}
+		}
+
 		// If the position has an active underlying lock, we cannot transfer it.
 		positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
 		if err != nil {
@@ -711,6 +722,9 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 		// Since the caller can be either the owner or the governance module (verified above), we can safely utilize the address directly from the position.
 		positionOwnerAddr := sdk.MustAccAddressFromBech32(position.Address)
 
+		// The spread reward eligibility time is deleted along with the position, so it is restored below.
+		spreadRewardEligibilityTime := k.getPositionSpreadRewardEligibilityTime(ctx, position)
+
 		// Delete the KVStore entries for the position.
 		err = k.deletePosition(ctx, positionId, positionOwnerAddr, position.PoolId)
 		if err != nil {
@@ -731,6 +745,9 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 		if err != nil {
 			return err
 		}
+		if !spreadRewardEligibilityTime.Equal(position.JoinTime) {
+			k.setPositionSpreadRewardEligibilityTime(ctx, positionId, spreadRewardEligibilityTime)
+		}
 	}
 
 	return nil
@@ -0,0 +1,138 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// MintPositionToken tokenizes the position with the given id. The position is moved into the custody of the
+// module and a token of denom cl/position/{positionId} with a supply of one is minted to the owner. Holding
+// the token confers the ownership of the position, which can be taken back by redeeming it.
+// The spread rewards and incentives accrued so far are collected to the owner, the position stops
+// auto-compounding, and the rewards accrued while it is tokenized go to whoever redeems the token.
+// Returns error if the position does not exist, is not owned by the given owner, or is superfluid staked.
+func (k Keeper) MintPositionToken(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coin, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if position.Address != owner.String() {
+		return sdk.Coin{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	// Positions with an underlying lock are owned through their lock and can not be tokenized.
+	positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if positionHasActiveUnderlyingLock {
+		return sdk.Coin{}, types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+		return sdk.Coin{}, err
+	}
+	if _, _, _, err := k.collectIncentives(ctx, owner, positionId); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundPosition(positionId))
+	if err := k.setPositionOwner(ctx, position, types.PositionTokenCustodyAddress); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	token := types.NewPositionToken(positionId)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(token)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMintPositionToken,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToken, token.String()),
+		),
+	})
+
+	return token, nil
+}
+
+// RedeemPositionToken burns the token of the tokenized position with the given id held by the given sender
+// and moves the position out of the custody of the module to the sender. The spread rewards and incentives
+// accrued while the position was tokenized remain claimable by the sender.
+// Returns error if the position does not exist, is not tokenized, or its token is not held by the sender.
+func (k Keeper) RedeemPositionToken(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if !k.IsPositionTokenized(position) {
+		return types.PositionNotTokenizedError{PositionId: positionId}
+	}
+
+	token := types.NewPositionToken(positionId)
+	if !k.bankKeeper.HasBalance(ctx, sender, token) {
+		return types.PositionTokenNotHeldError{PositionId: positionId, Address: sender.String()}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	if err := k.setPositionOwner(ctx, position, sender); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRedeemPositionToken,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToken, token.String()),
+		),
+	})
+
+	return nil
+}
+
+// IsPositionTokenized returns true if the given position is in the custody of the module, i.e. its
+// ownership is represented by its position token.
+func (k Keeper) IsPositionTokenized(position model.Position) bool {
+	return position.Address == types.PositionTokenCustodyAddress.String()
+}
+
+// setPositionOwner changes the owner of the given position to the given address, leaving the rest of the
+// position and its accumulators untouched.
+func (k Keeper) setPositionOwner(ctx sdk.Context, position model.Position, newOwner sdk.AccAddress) error {
+	oldOwner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	oldOwnerKey := types.KeyAddressPoolIdPositionId(oldOwner, position.PoolId, position.PositionId)
+	if !store.Has(oldOwnerKey) {
+		return types.AddressPoolPositionIdNotFoundError{Owner: position.Address, PoolId: position.PoolId, PositionId: position.PositionId}
+	}
+	store.Delete(oldOwnerKey)
+	store.Set(types.KeyAddressPoolIdPositionId(newOwner, position.PoolId, position.PositionId), []byte{1})
+
+	position.Address = newOwner.String()
+	osmoutils.MustSet(store, types.KeyPositionId(position.PositionId), &position)
+	return nil
+}
@@ -0,0 +1,160 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestMintPositionToken() {
+	tests := map[string]struct {
+		sender        int
+		positionId    uint64
+		locked        bool
+		expectedError error
+	}{
+		"mint": {
+			positionId: 2,
+		},
+		"error: sender is not the owner": {
+			sender:        1,
+			positionId:    2,
+			expectedError: types.NotPositionOwnerError{PositionId: 2, Address: s.TestAccs[1].String()},
+		},
+		"error: position does not exist": {
+			positionId:    3,
+			expectedError: types.PositionIdNotFoundError{PositionId: 3},
+		},
+		"error: position is locked": {
+			positionId:    2,
+			locked:        true,
+			expectedError: types.LockNotMatureError{PositionId: 2, LockId: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+			owner := s.TestAccs[0]
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[2])
+			var positionId uint64
+			if tc.locked {
+				s.FundAcc(owner, DefaultCoins)
+				positionData, _, err := clKeeper.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, time.Hour)
+				s.Require().NoError(err)
+				positionId = positionData.ID
+			} else {
+				positionId = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+				s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+			}
+			s.accrueSpreadRewards(pool.GetId())
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			token, err := clKeeper.MintPositionToken(s.Ctx, s.TestAccs[tc.sender], tc.positionId)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				position, err := clKeeper.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(owner.String(), position.Address)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(types.NewPositionToken(positionId), token)
+
+			// The owner holds the only token of the position, which is in the custody of the module.
+			s.Require().Equal(token, s.App.BankKeeper.GetBalance(s.Ctx, owner, token.Denom))
+			s.Require().Equal(token, s.App.BankKeeper.GetSupply(s.Ctx, token.Denom))
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().True(clKeeper.IsPositionTokenized(position))
+			userPositions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Empty(userPositions)
+			custodyPositions, err := clKeeper.GetUserPositions(s.Ctx, types.PositionTokenCustodyAddress, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(custodyPositions, 1)
+			s.Require().Equal(positionId, custodyPositions[0].PositionId)
+
+			// The rewards accrued before tokenizing were collected to the owner and the position stopped auto-compounding.
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().True(spreadRewards.IsZero())
+			s.Require().False(clKeeper.IsPositionAutoCompounding(s.Ctx, positionId))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtMintPositionToken, 1)
+
+			// The owner can no longer operate the position directly.
+			_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+			s.Require().Error(err)
+			_, err = clKeeper.MintPositionToken(s.Ctx, owner, positionId)
+			s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemPositionToken() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	minter, holder := s.TestAccs[0], s.TestAccs[1]
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	untokenizedPositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[2])
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), minter)
+
+	token, err := clKeeper.MintPositionToken(s.Ctx, minter, positionId)
+	s.Require().NoError(err)
+
+	// The token is transferred like any other token.
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, minter, holder, sdk.NewCoins(token)))
+
+	// Tokenized positions can only leave the custody of the module by redeeming their token.
+	err = clKeeper.TransferPositions(s.Ctx, []uint64{positionId}, s.App.AccountKeeper.GetModuleAddress("gov"), holder)
+	s.Require().ErrorIs(err, types.PositionTokenizedError{PositionId: positionId})
+	err = clKeeper.TransferPositions(s.Ctx, []uint64{untokenizedPositionId}, s.TestAccs[2], types.PositionTokenCustodyAddress)
+	s.Require().ErrorIs(err, types.PositionTokenizedError{PositionId: untokenizedPositionId})
+
+	// The position keeps accruing rewards while tokenized.
+	s.accrueSpreadRewards(pool.GetId())
+
+	// Only the holder of the token can redeem it.
+	err = clKeeper.RedeemPositionToken(s.Ctx, minter, positionId)
+	s.Require().ErrorIs(err, types.PositionTokenNotHeldError{PositionId: positionId, Address: minter.String()})
+	err = clKeeper.RedeemPositionToken(s.Ctx, s.TestAccs[2], untokenizedPositionId)
+	s.Require().ErrorIs(err, types.PositionNotTokenizedError{PositionId: untokenizedPositionId})
+	err = clKeeper.RedeemPositionToken(s.Ctx, holder, positionId+1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId + 1})
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// System under test
+	err = clKeeper.RedeemPositionToken(s.Ctx, holder, positionId)
+	s.Require().NoError(err)
+
+	// The token was burned and the holder owns the position.
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, token.Denom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, holder, token.Denom).IsZero())
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(holder.String(), position.Address)
+	s.Require().False(clKeeper.IsPositionTokenized(position))
+	userPositions, err := clKeeper.GetUserPositions(s.Ctx, holder, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(userPositions, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRedeemPositionToken, 1)
+
+	// The rewards accrued while tokenized go to the holder.
+	spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(spreadRewards.IsZero())
+	collected, err := clKeeper.CollectSpreadRewards(s.Ctx, holder, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(spreadRewards, collected)
+
+	// The holder can withdraw the position.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, holder, positionId, position.Liquidity)
+	s.Require().NoError(err)
+}
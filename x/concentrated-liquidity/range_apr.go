@@ -0,0 +1,186 @@
+package concentrated_liquidity
+
+import (
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+var (
+	secondsPerYear = osmomath.NewDec(int64((365 * 24 * time.Hour) / time.Second))
+	// rangeAprLiquidity is the nominal liquidity the yields of a tick range are computed for. The ratio of
+	// its rewards to its capital does not depend on it, and a large value limits the truncation of both.
+	rangeAprLiquidity = osmomath.NewDec(1e18)
+)
+
+// GetRangeApr returns the TVL of the given pool and the estimated annualized spread reward and incentive yields
+// of a position in the given tick range, priced in the given quote denom.
+//
+// The yields are derived from the growth of the pool accumulators since the oldest liquidity snapshot taken within
+// the lookback. The global growth per unit of liquidity is only earned by the range while it is active, so it is
+// weighted by the fraction of the snapshots, including the current state, at which the current tick was in the range.
+// The incentive yield sums the growth of every uptime accumulator, as earned by a position that qualifies for all of them.
+//
+// Every denom is priced by the arithmetic twap, over the lookback capped at MaxRangeAprTwapDuration, of the first of
+// the given pool and the price pools that succeeds. Reward denoms that can not be priced are excluded from the yields
+// and returned as unpriced.
+//
+// Returns error if:
+// - the pool does not exist or the tick range is invalid
+// - the lookback is not positive or the pool has no liquidity snapshot within it
+// - more than MaxRangeAprPricePools price pools are given
+// - a token of the pool can not be priced in the quote denom
+func (k Keeper) GetRangeApr(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, lookback time.Duration, quoteDenom string, pricePoolIds []uint64) (*queryproto.RangeAprResponse, error) {
+	if len(pricePoolIds) > types.MaxRangeAprPricePools {
+		return nil, types.TooManyRangeAprPricePoolsError{NumPricePools: len(pricePoolIds), Max: types.MaxRangeAprPricePools}
+	}
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return nil, err
+	}
+	if lookback <= 0 {
+		return nil, types.NonPositiveRangeAprLookbackError{Lookback: lookback}
+	}
+
+	now := ctx.BlockTime()
+	snapshots, err := k.GetLiquiditySnapshots(ctx, poolId, now.Add(-lookback), now)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) == 0 || !snapshots[0].Time.Before(now) {
+		return nil, types.NoLiquiditySnapshotInLookbackError{PoolId: poolId, Lookback: lookback}
+	}
+	startSnapshot := snapshots[0]
+	elapsedSeconds := osmomath.NewDec(int64(now.Sub(startSnapshot.Time) / time.Second))
+	if elapsedSeconds.IsZero() {
+		return nil, types.NoLiquiditySnapshotInLookbackError{PoolId: poolId, Lookback: lookback}
+	}
+
+	// The fraction of the snapshots, including the current state, at which the range was active.
+	numInRange := 0
+	for _, snapshot := range snapshots {
+		if snapshot.CurrentTick >= lowerTick && snapshot.CurrentTick < upperTick {
+			numInRange++
+		}
+	}
+	if pool.IsCurrentTickInRange(lowerTick, upperTick) {
+		numInRange++
+	}
+	inRangeFraction := osmomath.NewDec(int64(numInRange)).QuoInt64(int64(len(snapshots) + 1))
+
+	// The growth of the nominal liquidity since the start snapshot, scaled down to token amounts.
+	spreadRewardGrowthGlobal, uptimeGrowthGlobal, err := k.getGrowthGlobalToNow(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	spreadFactorScalingFactor, err := k.getSpreadFactorScalingFactorForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	incentiveScalingFactor, err := k.getIncentiveScalingFactorForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	spreadRewards := spreadRewardGrowthGlobal.Sub(startSnapshot.SpreadRewardGrowthGlobal).MulDec(rangeAprLiquidity).QuoDecTruncate(spreadFactorScalingFactor)
+	incentives := uptimeGrowthGlobal.Sub(startSnapshot.UptimeGrowthGlobal).MulDec(rangeAprLiquidity).QuoDecTruncate(incentiveScalingFactor)
+
+	twapStartTime := startSnapshot.Time
+	if maxTwapStartTime := now.Add(-types.MaxRangeAprTwapDuration); twapStartTime.Before(maxTwapStartTime) {
+		twapStartTime = maxTwapStartTime
+	}
+	pricer := rangeAprPricer{k: k, ctx: ctx, quoteDenom: quoteDenom, poolIds: append([]uint64{poolId}, pricePoolIds...), startTime: twapStartTime, prices: map[string]osmomath.Dec{}}
+
+	price0, ok := pricer.price(pool.GetToken0())
+	if !ok {
+		return nil, types.DenomNotPricedError{Denom: pool.GetToken0(), QuoteDenom: quoteDenom}
+	}
+	price1, ok := pricer.price(pool.GetToken1())
+	if !ok {
+		return nil, types.DenomNotPricedError{Denom: pool.GetToken1(), QuoteDenom: quoteDenom}
+	}
+
+	token0Balance := k.bankKeeper.GetBalance(ctx, pool.GetAddress(), pool.GetToken0())
+	token1Balance := k.bankKeeper.GetBalance(ctx, pool.GetAddress(), pool.GetToken1())
+	tvl := price0.MulInt(token0Balance.Amount).Add(price1.MulInt(token1Balance.Amount))
+
+	// The capital of the nominal liquidity in the range at the current price.
+	amount0, amount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, rangeAprLiquidity)
+	if err != nil {
+		return nil, err
+	}
+	capital := amount0.Mul(price0).Add(amount1.Mul(price1))
+
+	// The yield of the rewards of the nominal liquidity while in range, annualized.
+	annualizedYield := func(rewards sdk.DecCoins) osmomath.Dec {
+		value := pricer.value(rewards)
+		if capital.IsZero() {
+			return osmomath.ZeroDec()
+		}
+		return value.Mul(inRangeFraction).Quo(capital).Mul(secondsPerYear).Quo(elapsedSeconds)
+	}
+
+	return &queryproto.RangeAprResponse{
+		Tvl:               tvl,
+		SpreadRewardApr:   annualizedYield(spreadRewards),
+		IncentiveApr:      annualizedYield(incentives),
+		InRangeFraction:   inRangeFraction,
+		LookbackStartTime: startSnapshot.Time,
+		UnpricedDenoms:    pricer.unpricedDenoms,
+	}, nil
+}
+
+// rangeAprPricer prices denoms in a quote denom by the twaps of the given pools, caching the prices.
+type rangeAprPricer struct {
+	k              Keeper
+	ctx            sdk.Context
+	quoteDenom     string
+	poolIds        []uint64
+	startTime      time.Time
+	prices         map[string]osmomath.Dec
+	unpricedDenoms []string
+}
+
+// price returns the price of the given denom in the quote denom by the twap of the first of the pools that succeeds.
+// Returns false if none of the pools prices the denom.
+func (p *rangeAprPricer) price(denom string) (osmomath.Dec, bool) {
+	if denom == p.quoteDenom {
+		return osmomath.OneDec(), true
+	}
+	if price, ok := p.prices[denom]; ok {
+		return price, true
+	}
+
+	for _, poolId := range p.poolIds {
+		price, err := p.k.twapKeeper.GetArithmeticTwapToNow(p.ctx, poolId, denom, p.quoteDenom, p.startTime)
+		if err == nil {
+			p.prices[denom] = price
+			return price, true
+		}
+	}
+	return osmomath.Dec{}, false
+}
+
+// value returns the value of the given amounts in the quote denom. The denoms that can not be priced are
+// excluded from the value and recorded as unpriced.
+func (p *rangeAprPricer) value(amounts sdk.DecCoins) osmomath.Dec {
+	value := osmomath.ZeroDec()
+	for _, amount := range amounts {
+		price, ok := p.price(amount.Denom)
+		if !ok {
+			if !slices.Contains(p.unpricedDenoms, amount.Denom) {
+				p.unpricedDenoms = append(p.unpricedDenoms, amount.Denom)
+			}
+			continue
+		}
+		value = value.Add(amount.Amount.Mul(price))
+	}
+	return value
+}
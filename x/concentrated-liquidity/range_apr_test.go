@@ -0,0 +1,103 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestGetRangeApr() {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.002"))
+	positionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	// The twap of the pool errors over the time it had no liquidity, until its records are updated with the position.
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.AddBlockTime(time.Hour)
+
+	// Incentives in the quote denom and in a denom without a pool to price it.
+	unpricedDenom := "uion"
+	incentiveCoins := sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(unpricedDenom, osmomath.NewInt(1_000_000_000)))
+	s.FundAcc(s.TestAccs[1], incentiveCoins)
+	for _, incentiveCoin := range incentiveCoins {
+		_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.NewDec(1000), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.LiquiditySnapshotEpochIdentifier, 1))
+	snapshotTime := s.Ctx.BlockTime()
+
+	// Accrue spread rewards in the quote denom only, so that their value is not subject to truncation.
+	tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(10_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	_, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool.GetId(), tokenIn, ETH, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.AddBlockTime(12 * time.Hour)
+
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	elapsedSeconds := osmomath.NewDec(int64(s.Ctx.BlockTime().Sub(snapshotTime) / time.Second))
+	annualization := osmomath.NewDec(365 * 24 * 60 * 60).Quo(elapsedSeconds)
+
+	// As the only position, the full range position earns all the rewards of the pool while always in range.
+	ethPrice, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, pool.GetId(), ETH, USDC, snapshotTime)
+	s.Require().NoError(err)
+	positionLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, positionId)
+	s.Require().NoError(err)
+	amount0, amount1, err := pool.CalcActualAmounts(s.Ctx, DefaultMinTick, DefaultMaxTick, positionLiquidity)
+	s.Require().NoError(err)
+	capital := amount0.Mul(ethPrice).Add(amount1)
+	spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	incentives, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	expectedSpreadRewardApr := spreadRewards.AmountOf(USDC).ToLegacyDec().Quo(capital).Mul(annualization)
+	expectedIncentiveApr := incentives.AmountOf(USDC).ToLegacyDec().Quo(capital).Mul(annualization)
+	s.Require().True(expectedSpreadRewardApr.IsPositive())
+	s.Require().True(expectedIncentiveApr.IsPositive())
+
+	poolBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	expectedTvl := ethPrice.MulInt(poolBalances.AmountOf(ETH)).Add(poolBalances.AmountOf(USDC).ToLegacyDec())
+
+	// System under test
+	response, err := clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, 24*time.Hour, USDC, nil)
+	s.Require().NoError(err)
+
+	tolerance := osmomath.MustNewDecFromStr("0.0001")
+	s.Require().True(response.SpreadRewardApr.Sub(expectedSpreadRewardApr).Abs().Quo(expectedSpreadRewardApr).LT(tolerance), "expected %s, got %s", expectedSpreadRewardApr, response.SpreadRewardApr)
+	s.Require().True(response.IncentiveApr.Sub(expectedIncentiveApr).Abs().Quo(expectedIncentiveApr).LT(tolerance), "expected %s, got %s", expectedIncentiveApr, response.IncentiveApr)
+	s.Require().Equal(expectedTvl, response.Tvl)
+	s.Require().Equal(osmomath.OneDec(), response.InRangeFraction)
+	s.Require().Equal(snapshotTime, response.LookbackStartTime)
+	s.Require().Equal([]string{unpricedDenom}, response.UnpricedDenoms)
+
+	// A range that was never active earns nothing.
+	response, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMaxTick-1000, DefaultMaxTick-500, 24*time.Hour, USDC, nil)
+	s.Require().NoError(err)
+	s.Require().True(response.InRangeFraction.IsZero())
+	s.Require().True(response.SpreadRewardApr.IsZero())
+	s.Require().True(response.IncentiveApr.IsZero())
+	s.Require().Equal(expectedTvl, response.Tvl)
+
+	// Pricing in token0 inverts the TVL.
+	response, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, 24*time.Hour, ETH, nil)
+	s.Require().NoError(err)
+	s.Require().True(response.Tvl.IsPositive())
+	s.Require().Equal([]string{unpricedDenom}, response.UnpricedDenoms)
+
+	// Errors
+	_, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, 0, USDC, nil)
+	s.Require().ErrorIs(err, types.NonPositiveRangeAprLookbackError{Lookback: 0})
+	tooManyPricePoolIds := make([]uint64, types.MaxRangeAprPricePools+1)
+	_, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, 24*time.Hour, USDC, tooManyPricePoolIds)
+	s.Require().ErrorIs(err, types.TooManyRangeAprPricePoolsError{NumPricePools: types.MaxRangeAprPricePools + 1, Max: types.MaxRangeAprPricePools})
+	_, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, time.Hour, USDC, nil)
+	s.Require().ErrorIs(err, types.NoLiquiditySnapshotInLookbackError{PoolId: pool.GetId(), Lookback: time.Hour})
+	_, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMinTick, DefaultMaxTick, 24*time.Hour, unpricedDenom, nil)
+	s.Require().ErrorIs(err, types.DenomNotPricedError{Denom: ETH, QuoteDenom: unpricedDenom})
+	_, err = clKeeper.GetRangeApr(s.Ctx, pool.GetId(), DefaultMaxTick, DefaultMinTick, 24*time.Hour, USDC, nil)
+	s.Require().Error(err)
+}
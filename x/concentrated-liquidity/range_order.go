@@ -0,0 +1,467 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkprefix "cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+// PlaceRangeOrder creates a range order selling tokenIn over the single tick spacing range starting at lowerTick.
+// The tokens are deposited into a position owned by the range order escrow of the pool, so that the position
+// can only be withdrawn through the range order. The range must lie entirely on the side of the current tick
+// that holds tokenIn only: above it for token0 and below it for token1.
+// Any dust of tokenIn that could not be converted into liquidity is returned to the owner.
+// Returns the placed range order and the liquidity created.
+func (k Keeper) PlaceRangeOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick int64, tokenIn sdk.Coin) (types.RangeOrder, osmomath.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.RangeOrder{}, osmomath.Dec{}, err
+	}
+
+	if tokenIn.Denom != pool.GetToken0() && tokenIn.Denom != pool.GetToken1() {
+		return types.RangeOrder{}, osmomath.Dec{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+	sellsToken0 := tokenIn.Denom == pool.GetToken0()
+
+	tickSpacing := int64(pool.GetTickSpacing())
+	if lowerTick%tickSpacing != 0 {
+		return types.RangeOrder{}, osmomath.Dec{}, types.RangeOrderTickNotAlignedError{LowerTick: lowerTick, TickSpacing: pool.GetTickSpacing()}
+	}
+	upperTick := lowerTick + tickSpacing
+
+	currentTick := pool.GetCurrentTick()
+	if (sellsToken0 && lowerTick <= currentTick) || (!sellsToken0 && upperTick > currentTick) {
+		return types.RangeOrder{}, osmomath.Dec{}, types.RangeOrderInvalidRangeError{TokenInDenom: tokenIn.Denom, LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick}
+	}
+
+	escrowAddress := types.RangeOrderEscrowAddress(poolId)
+	if err := k.bankKeeper.SendCoins(ctx, owner, escrowAddress, sdk.NewCoins(tokenIn)); err != nil {
+		return types.RangeOrder{}, osmomath.Dec{}, err
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, escrowAddress, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return types.RangeOrder{}, osmomath.Dec{}, err
+	}
+
+	amountDeposited := positionData.Amount1
+	if sellsToken0 {
+		amountDeposited = positionData.Amount0
+	}
+	if refund := tokenIn.Amount.Sub(amountDeposited); refund.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, refund))); err != nil {
+			return types.RangeOrder{}, osmomath.Dec{}, err
+		}
+	}
+
+	rangeOrder := types.RangeOrder{
+		OrderId:    positionData.ID,
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		LowerTick:  positionData.LowerTick,
+		UpperTick:  positionData.UpperTick,
+		TokenIn:    sdk.NewCoin(tokenIn.Denom, amountDeposited),
+		Status:     types.RangeOrderStatusOpen,
+		PlacedTime: ctx.BlockTime(),
+	}
+	k.setRangeOrder(ctx, rangeOrder)
+	k.setOpenRangeOrderTick(ctx, rangeOrder, sellsToken0)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPlaceRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(rangeOrder.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, rangeOrder.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(rangeOrder.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(rangeOrder.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, rangeOrder.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeLiquidity, positionData.Liquidity.String()),
+		),
+	})
+
+	return rangeOrder, positionData.Liquidity, nil
+}
+
+// ClaimRangeOrder sends the proceeds of the given filled range order to its owner and removes the order from state.
+// An open order that has been crossed by a swap, or by the current tick, but not settled yet is filled first.
+// Returns error if the sender is not the owner of the order or if the order has not been crossed.
+func (k Keeper) ClaimRangeOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	rangeOrder, err := k.getOwnedRangeOrder(ctx, sender, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if rangeOrder.Status == types.RangeOrderStatusOpen {
+		pool, err := k.getPoolById(ctx, rangeOrder.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		sellsToken0 := rangeOrder.TokenIn.Denom == pool.GetToken0()
+		if !k.hasRangeOrderSettlement(ctx, rangeOrder.PoolId, orderId) && !rangeOrder.IsFillable(sellsToken0, pool.GetCurrentTick()) {
+			return nil, types.RangeOrderNotFilledError{OrderId: orderId}
+		}
+		if rangeOrder, err = k.fillRangeOrder(ctx, rangeOrder, sellsToken0); err != nil {
+			return nil, err
+		}
+		k.deleteRangeOrderSettlement(ctx, rangeOrder.PoolId, orderId)
+	}
+
+	if !rangeOrder.Claimable.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RangeOrderEscrowAddress(rangeOrder.PoolId), sender, rangeOrder.Claimable); err != nil {
+			return nil, err
+		}
+	}
+	k.deleteRangeOrder(ctx, rangeOrder)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, rangeOrder.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(rangeOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, rangeOrder.Claimable.String()),
+		),
+	})
+
+	return rangeOrder.Claimable, nil
+}
+
+// CancelRangeOrder withdraws the position of the given open range order, sends the withdrawn tokens to its owner
+// and removes the order from state. Returns error if the sender is not the owner of the order or if the order
+// is already filled.
+func (k Keeper) CancelRangeOrder(ctx sdk.Context, sender sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	rangeOrder, err := k.getOwnedRangeOrder(ctx, sender, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if rangeOrder.Status != types.RangeOrderStatusOpen {
+		return nil, types.RangeOrderNotOpenError{OrderId: orderId}
+	}
+
+	pool, err := k.getPoolById(ctx, rangeOrder.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawn, err := k.withdrawRangeOrderPosition(ctx, rangeOrder)
+	if err != nil {
+		return nil, err
+	}
+	if !withdrawn.Empty() {
+		if err := k.bankKeeper.SendCoins(ctx, types.RangeOrderEscrowAddress(rangeOrder.PoolId), sender, withdrawn); err != nil {
+			return nil, err
+		}
+	}
+	k.deleteOpenRangeOrderTick(ctx, rangeOrder, rangeOrder.TokenIn.Denom == pool.GetToken0())
+	k.deleteRangeOrderSettlement(ctx, rangeOrder.PoolId, orderId)
+	k.deleteRangeOrder(ctx, rangeOrder)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, rangeOrder.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(rangeOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, withdrawn.String()),
+		),
+	})
+
+	return withdrawn, nil
+}
+
+// crossedRangeOrder is an open range order whose range has been crossed, recorded for settlement.
+type crossedRangeOrder struct {
+	poolId      uint64
+	orderId     uint64
+	sellsToken0 bool
+}
+
+// recordCrossedRangeOrders records the open range orders of the given pool that fill at the given tick for
+// settlement at the end of the block. It is called by the swap strategy whenever it crosses an initialized tick,
+// so that an order crossed by a swap is filled even if a later swap of the same block crosses its range back.
+// Token0 orders are crossed when the price rises across their upper tick, token1 orders when it falls across
+// their lower tick. The swapper only pays for the records, not for the settlement of the orders.
+func (k Keeper) recordCrossedRangeOrders(ctx sdk.Context, poolId uint64, tick int64, zeroForOne bool) {
+	sellsToken0 := !zeroForOne
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(types.KeyOpenRangeOrderTickPrefix(poolId, sellsToken0), types.TickIndexToBytes(tick)...)
+
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	orderIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		orderIds = append(orderIds, sdk.BigEndianToUint64(iter.Value()))
+	}
+	iter.Close()
+
+	for _, orderId := range orderIds {
+		k.setRangeOrderSettlement(ctx, crossedRangeOrder{poolId: poolId, orderId: orderId, sellsToken0: sellsToken0})
+	}
+}
+
+// settleQueuedRangeOrders fills the range orders recorded as crossed by the swaps, in order of pool id and order id,
+// up to MaxRangeOrdersSettledPerBlock of them. It is called at the end of every block. The orders are filled
+// regardless of the current tick of their pool, since they were crossed when recorded. Orders left over are settled
+// first in the next blocks, or when claimed by their owner.
+// An order that fails to be filled is left open and is no longer settled at the end of the block, so that it cannot
+// hold up the settlement of the other orders. A fill_range_order_failed event is emitted for it. Its owner can
+// still claim it while it is crossed, or cancel it.
+func (k Keeper) settleQueuedRangeOrders(ctx sdk.Context) {
+	for _, crossed := range k.getRangeOrderSettlements(ctx, types.MaxRangeOrdersSettledPerBlock) {
+		k.deleteRangeOrderSettlement(ctx, crossed.poolId, crossed.orderId)
+
+		rangeOrder, err := k.GetRangeOrder(ctx, crossed.orderId)
+		if err != nil {
+			ctx.Logger().Error("failed to get crossed range order", "order_id", crossed.orderId, "error", err)
+			continue
+		}
+		if rangeOrder.Status != types.RangeOrderStatusOpen {
+			continue
+		}
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, err := k.fillRangeOrder(cacheCtx, rangeOrder, crossed.sellsToken0)
+			return err
+		})
+		if err != nil {
+			k.deleteOpenRangeOrderTick(ctx, rangeOrder, crossed.sellsToken0)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtFillRangeOrderFailed,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(rangeOrder.OrderId, 10)),
+					sdk.NewAttribute(types.AttributeKeyOwner, rangeOrder.Owner),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(crossed.poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			})
+		}
+	}
+}
+
+// getRangeOrderSettlements returns up to limit range orders recorded as crossed, in order of pool id and order id.
+func (k Keeper) getRangeOrderSettlements(ctx sdk.Context, limit int) []crossedRangeOrder {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RangeOrderSettlementQueuePrefix)
+	defer iter.Close()
+
+	crossedRangeOrders := []crossedRangeOrder{}
+	for ; iter.Valid() && len(crossedRangeOrders) < limit; iter.Next() {
+		key := iter.Key()[len(types.RangeOrderSettlementQueuePrefix):]
+		crossedRangeOrders = append(crossedRangeOrders, crossedRangeOrder{
+			poolId:      sdk.BigEndianToUint64(key[:8]),
+			orderId:     sdk.BigEndianToUint64(key[8:]),
+			sellsToken0: iter.Value()[0] == 1,
+		})
+	}
+	return crossedRangeOrders
+}
+
+// setRangeOrderSettlement records the given crossed range order for settlement at the end of the block.
+func (k Keeper) setRangeOrderSettlement(ctx sdk.Context, crossed crossedRangeOrder) {
+	value := []byte{0}
+	if crossed.sellsToken0 {
+		value = []byte{1}
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyRangeOrderSettlement(crossed.poolId, crossed.orderId), value)
+}
+
+// hasRangeOrderSettlement returns true if the given range order is recorded as crossed and not settled yet.
+func (k Keeper) hasRangeOrderSettlement(ctx sdk.Context, poolId uint64, orderId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyRangeOrderSettlement(poolId, orderId))
+}
+
+// deleteRangeOrderSettlement removes the settlement record of the given range order, if any.
+func (k Keeper) deleteRangeOrderSettlement(ctx sdk.Context, poolId uint64, orderId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyRangeOrderSettlement(poolId, orderId))
+}
+
+// fillRangeOrder withdraws the position of the given open range order into the range order escrow of its pool
+// and marks the order as filled with the withdrawn tokens as claimable.
+func (k Keeper) fillRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder, sellsToken0 bool) (types.RangeOrder, error) {
+	withdrawn, err := k.withdrawRangeOrderPosition(ctx, rangeOrder)
+	if err != nil {
+		return types.RangeOrder{}, err
+	}
+
+	k.deleteOpenRangeOrderTick(ctx, rangeOrder, sellsToken0)
+	rangeOrder.Status = types.RangeOrderStatusFilled
+	rangeOrder.Claimable = withdrawn
+	k.setRangeOrder(ctx, rangeOrder)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFillRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(rangeOrder.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, rangeOrder.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(rangeOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensClaimable, withdrawn.String()),
+		),
+	})
+
+	return rangeOrder, nil
+}
+
+// withdrawRangeOrderPosition fully withdraws the position of the given range order into the range order escrow
+// of its pool. Returns the tokens withdrawn, including the spread rewards and incentives collected.
+func (k Keeper) withdrawRangeOrderPosition(ctx sdk.Context, rangeOrder types.RangeOrder) (sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, rangeOrder.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAddress := types.RangeOrderEscrowAddress(rangeOrder.PoolId)
+	balanceBefore := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+	if _, _, err := k.WithdrawPosition(ctx, escrowAddress, rangeOrder.OrderId, position.Liquidity); err != nil {
+		return nil, err
+	}
+	return k.bankKeeper.GetAllBalances(ctx, escrowAddress).Sub(balanceBefore...), nil
+}
+
+// GetRangeOrder returns the range order with the given id.
+func (k Keeper) GetRangeOrder(ctx sdk.Context, orderId uint64) (types.RangeOrder, error) {
+	rangeOrder := types.RangeOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRangeOrder(orderId), &rangeOrder)
+	if err != nil {
+		return types.RangeOrder{}, err
+	}
+	if !found {
+		return types.RangeOrder{}, types.RangeOrderNotFoundError{OrderId: orderId}
+	}
+	return rangeOrder, nil
+}
+
+// getOwnedRangeOrder returns the range order with the given id, erroring if it is not owned by the given address.
+func (k Keeper) getOwnedRangeOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (types.RangeOrder, error) {
+	rangeOrder, err := k.GetRangeOrder(ctx, orderId)
+	if err != nil {
+		return types.RangeOrder{}, err
+	}
+	if rangeOrder.Owner != owner.String() {
+		return types.RangeOrder{}, types.NotRangeOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+	return rangeOrder, nil
+}
+
+// GetRangeOrdersByOwner returns the range orders of the given owner, restricted to the given pool if non-zero and
+// to the given status if specified.
+func (k Keeper) GetRangeOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, status types.RangeOrderStatus, pagination *query.PageRequest) ([]types.RangeOrder, *query.PageResponse, error) {
+	prefix := types.KeyRangeOrderOwnerPrefix(owner)
+	if poolId != 0 {
+		prefix = types.KeyRangeOrderOwnerPoolPrefix(owner, poolId)
+	}
+	return k.paginateRangeOrders(ctx, prefix, status, pagination)
+}
+
+// GetRangeOrdersByPool returns the range orders of the given pool, restricted to the given status if specified.
+func (k Keeper) GetRangeOrdersByPool(ctx sdk.Context, poolId uint64, status types.RangeOrderStatus, pagination *query.PageRequest) ([]types.RangeOrder, *query.PageResponse, error) {
+	return k.paginateRangeOrders(ctx, types.KeyRangeOrderPoolPrefix(poolId), status, pagination)
+}
+
+// paginateRangeOrders paginates over the range order ids indexed under the given prefix, returning the
+// range orders with the given status, or all of them if the status is unspecified.
+func (k Keeper) paginateRangeOrders(ctx sdk.Context, prefix []byte, status types.RangeOrderStatus, pagination *query.PageRequest) ([]types.RangeOrder, *query.PageResponse, error) {
+	indexStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
+
+	rangeOrders := []types.RangeOrder{}
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		rangeOrder, err := k.GetRangeOrder(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return false, err
+		}
+		if status != types.RangeOrderStatusUnspecified && rangeOrder.Status != status {
+			return false, nil
+		}
+		if accumulate {
+			rangeOrders = append(rangeOrders, rangeOrder)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rangeOrders, pageRes, nil
+}
+
+// getAllRangeOrders returns all range orders in state, ordered by id.
+func (k Keeper) getAllRangeOrders(ctx sdk.Context) ([]types.RangeOrder, error) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RangeOrderPrefix)
+	defer iter.Close()
+
+	rangeOrders := []types.RangeOrder{}
+	for ; iter.Valid(); iter.Next() {
+		rangeOrder := types.RangeOrder{}
+		if err := rangeOrder.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		rangeOrders = append(rangeOrders, rangeOrder)
+	}
+	return rangeOrders, nil
+}
+
+// setRangeOrder stores the given range order and indexes it by owner and by pool.
+func (k Keeper) setRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(rangeOrder.Owner)
+	orderIdBz := sdk.Uint64ToBigEndian(rangeOrder.OrderId)
+
+	osmoutils.MustSet(store, types.KeyRangeOrder(rangeOrder.OrderId), &rangeOrder)
+	store.Set(types.KeyRangeOrderOwner(owner, rangeOrder.PoolId, rangeOrder.OrderId), orderIdBz)
+	store.Set(types.KeyRangeOrderPool(rangeOrder.PoolId, rangeOrder.OrderId), orderIdBz)
+}
+
+// deleteRangeOrder removes the given range order and its owner and pool indexes from state.
+func (k Keeper) deleteRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(rangeOrder.Owner)
+
+	store.Delete(types.KeyRangeOrder(rangeOrder.OrderId))
+	store.Delete(types.KeyRangeOrderOwner(owner, rangeOrder.PoolId, rangeOrder.OrderId))
+	store.Delete(types.KeyRangeOrderPool(rangeOrder.PoolId, rangeOrder.OrderId))
+}
+
+// setOpenRangeOrderTick indexes the given open range order by the tick the current tick must cross for it to fill.
+func (k Keeper) setOpenRangeOrderTick(ctx sdk.Context, rangeOrder types.RangeOrder, sellsToken0 bool) {
+	key := types.KeyOpenRangeOrderTick(rangeOrder.PoolId, sellsToken0, rangeOrder.FillTick(sellsToken0), rangeOrder.OrderId)
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(rangeOrder.OrderId))
+}
+
+// deleteOpenRangeOrderTick removes the fill tick index of the given range order.
+func (k Keeper) deleteOpenRangeOrderTick(ctx sdk.Context, rangeOrder types.RangeOrder, sellsToken0 bool) {
+	key := types.KeyOpenRangeOrderTick(rangeOrder.PoolId, sellsToken0, rangeOrder.FillTick(sellsToken0), rangeOrder.OrderId)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// initRangeOrders sets the given range orders from genesis. The pools and positions of the orders must already be set.
+// Open orders whose range the current tick of their pool has crossed are recorded for settlement.
+func (k Keeper) initRangeOrders(ctx sdk.Context, rangeOrders []types.RangeOrder) error {
+	for _, rangeOrder := range rangeOrders {
+		pool, err := k.getPoolById(ctx, rangeOrder.PoolId)
+		if err != nil {
+			return err
+		}
+		k.setRangeOrder(ctx, rangeOrder)
+		if rangeOrder.Status != types.RangeOrderStatusOpen {
+			continue
+		}
+		if _, err := k.GetPosition(ctx, rangeOrder.OrderId); err != nil {
+			return fmt.Errorf("open range order (%d) has no position: %w", rangeOrder.OrderId, err)
+		}
+		sellsToken0 := rangeOrder.TokenIn.Denom == pool.GetToken0()
+		k.setOpenRangeOrderTick(ctx, rangeOrder, sellsToken0)
+		if rangeOrder.IsFillable(sellsToken0, pool.GetCurrentTick()) {
+			k.setRangeOrderSettlement(ctx, crossedRangeOrder{poolId: rangeOrder.PoolId, orderId: rangeOrder.OrderId, sellsToken0: sellsToken0})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+var (
+	// Token0 range orders must be placed above the current tick, token1 range orders below it.
+	defaultToken0RangeOrderLowerTick = DefaultCurrTick + int64(DefaultTickSpacing)
+	defaultToken1RangeOrderLowerTick = DefaultCurrTick - 2*int64(DefaultTickSpacing)
+	defaultRangeOrderToken0          = sdk.NewCoin(ETH, osmomath.NewInt(1000))
+	defaultRangeOrderToken1          = sdk.NewCoin(USDC, osmomath.NewInt(5000000))
+)
+
+// placeRangeOrder funds the owner with the given token and places a range order with it.
+func (s *KeeperTestSuite) placeRangeOrder(poolId uint64, owner sdk.AccAddress, lowerTick int64, tokenIn sdk.Coin) types.RangeOrder {
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	rangeOrder, _, err := s.App.ConcentratedLiquidityKeeper.PlaceRangeOrder(s.Ctx, poolId, owner, lowerTick, tokenIn)
+	s.Require().NoError(err)
+	return rangeOrder
+}
+
+// swapInDefaultPool swaps the given token into the given pool on behalf of a fresh account.
+func (s *KeeperTestSuite) swapInDefaultPool(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	swapper := s.TestAccs[2]
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tokenIn, tokenOutDenom, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPlaceRangeOrder() {
+	tests := map[string]struct {
+		lowerTick     int64
+		tokenIn       sdk.Coin
+		expectedError error
+	}{
+		"token0 order above the current tick": {
+			lowerTick: defaultToken0RangeOrderLowerTick,
+			tokenIn:   defaultRangeOrderToken0,
+		},
+		"token1 order below the current tick": {
+			lowerTick: defaultToken1RangeOrderLowerTick,
+			tokenIn:   defaultRangeOrderToken1,
+		},
+		"error: token0 order containing the current tick": {
+			lowerTick:     DefaultCurrTick,
+			tokenIn:       defaultRangeOrderToken0,
+			expectedError: types.RangeOrderInvalidRangeError{TokenInDenom: ETH, LowerTick: DefaultCurrTick, UpperTick: DefaultCurrTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick},
+		},
+		"error: token1 order above the current tick": {
+			lowerTick:     defaultToken0RangeOrderLowerTick,
+			tokenIn:       defaultRangeOrderToken1,
+			expectedError: types.RangeOrderInvalidRangeError{TokenInDenom: USDC, LowerTick: defaultToken0RangeOrderLowerTick, UpperTick: defaultToken0RangeOrderLowerTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick},
+		},
+		"error: lower tick not aligned to the tick spacing": {
+			lowerTick:     defaultToken0RangeOrderLowerTick + 1,
+			tokenIn:       defaultRangeOrderToken0,
+			expectedError: types.RangeOrderTickNotAlignedError{LowerTick: defaultToken0RangeOrderLowerTick + 1, TickSpacing: DefaultTickSpacing},
+		},
+		"error: token not in pool": {
+			lowerTick:     defaultToken0RangeOrderLowerTick,
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: FOO},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			// System under test.
+			rangeOrder, liquidity, err := clKeeper.PlaceRangeOrder(s.Ctx, pool.GetId(), owner, tc.lowerTick, tc.tokenIn)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(liquidity.IsPositive())
+
+			s.Require().Equal(types.RangeOrderStatusOpen, rangeOrder.Status)
+			s.Require().Equal(owner.String(), rangeOrder.Owner)
+			s.Require().Equal(tc.lowerTick, rangeOrder.LowerTick)
+			s.Require().Equal(tc.lowerTick+int64(DefaultTickSpacing), rangeOrder.UpperTick)
+			s.Require().Equal(tc.tokenIn.Denom, rangeOrder.TokenIn.Denom)
+			storedRangeOrder, err := clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(rangeOrder, storedRangeOrder)
+
+			// The position is held by the range order escrow, any dust is refunded to the owner.
+			position, err := clKeeper.GetPosition(s.Ctx, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RangeOrderEscrowAddress(pool.GetId()).String(), position.Address)
+			s.Require().Equal(liquidity, position.Liquidity)
+			s.Require().Equal(tc.tokenIn.Amount.Sub(rangeOrder.TokenIn.Amount).String(), s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom).Amount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRangeOrderSettlement() {
+	tests := map[string]struct {
+		lowerTick   int64
+		tokenIn     sdk.Coin
+		boughtDenom string
+		// amount of the bought token swapped in to move the price across the order.
+		swapAmount osmomath.Int
+	}{
+		"token0 order fills when the price rises across its upper tick": {
+			lowerTick:   defaultToken0RangeOrderLowerTick,
+			tokenIn:     defaultRangeOrderToken0,
+			boughtDenom: USDC,
+			swapAmount:  osmomath.NewInt(100000000),
+		},
+		"token1 order fills when the price falls below its lower tick": {
+			lowerTick:   defaultToken1RangeOrderLowerTick,
+			tokenIn:     defaultRangeOrderToken1,
+			boughtDenom: ETH,
+			swapAmount:  osmomath.NewInt(100000),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			owner := s.TestAccs[1]
+			rangeOrder := s.placeRangeOrder(pool.GetId(), owner, tc.lowerTick, tc.tokenIn)
+
+			// A swap moving the price away from the order leaves it open.
+			s.swapInDefaultPool(pool.GetId(), tc.tokenIn, tc.boughtDenom)
+			rangeOrder, err := clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RangeOrderStatusOpen, rangeOrder.Status)
+
+			// The swap crossing the order only records it for settlement at the end of the block.
+			s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(tc.boughtDenom, tc.swapAmount), tc.tokenIn.Denom)
+			rangeOrder, err = clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RangeOrderStatusOpen, rangeOrder.Status)
+
+			// System under test.
+			clKeeper.EndBlock(s.Ctx)
+
+			// The position is withdrawn into the escrow and the proceeds are claimable in the bought token only.
+			rangeOrder, err = clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RangeOrderStatusFilled, rangeOrder.Status)
+			s.Require().True(rangeOrder.Claimable.AmountOf(tc.boughtDenom).IsPositive())
+			s.Require().True(rangeOrder.Claimable.AmountOf(tc.tokenIn.Denom).IsZero())
+			_, err = clKeeper.GetPosition(s.Ctx, rangeOrder.OrderId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: rangeOrder.OrderId})
+			s.Require().Equal(rangeOrder.Claimable, s.App.BankKeeper.GetAllBalances(s.Ctx, types.RangeOrderEscrowAddress(pool.GetId())))
+			s.Require().Equal([]types.RangeOrder{rangeOrder}, clKeeper.ExportGenesis(s.Ctx).RangeOrders)
+
+			// Cancelling a filled order fails.
+			_, err = clKeeper.CancelRangeOrder(s.Ctx, owner, rangeOrder.OrderId)
+			s.Require().ErrorIs(err, types.RangeOrderNotOpenError{OrderId: rangeOrder.OrderId})
+
+			// Only the owner may claim.
+			_, err = clKeeper.ClaimRangeOrder(s.Ctx, s.TestAccs[0], rangeOrder.OrderId)
+			s.Require().ErrorIs(err, types.NotRangeOrderOwnerError{OrderId: rangeOrder.OrderId, Address: s.TestAccs[0].String()})
+
+			claimed, err := clKeeper.ClaimRangeOrder(s.Ctx, owner, rangeOrder.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(rangeOrder.Claimable, claimed)
+			s.Require().Equal(claimed.AmountOf(tc.boughtDenom), s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.boughtDenom).Amount)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.RangeOrderEscrowAddress(pool.GetId())).IsZero())
+
+			_, err = clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+			s.Require().ErrorIs(err, types.RangeOrderNotFoundError{OrderId: rangeOrder.OrderId})
+			s.Require().Empty(clKeeper.ExportGenesis(s.Ctx).RangeOrders)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRangeOrderSettlement_MaxPerBlock() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+
+	numRangeOrders := 2*types.MaxRangeOrdersSettledPerBlock + 1
+	rangeOrders := make([]types.RangeOrder, numRangeOrders)
+	for i := range rangeOrders {
+		rangeOrders[i] = s.placeRangeOrder(pool.GetId(), owner, defaultToken0RangeOrderLowerTick, defaultRangeOrderToken0)
+	}
+
+	s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(1000000000)), ETH)
+
+	clKeeper.EndBlock(s.Ctx)
+	filled, _, err := clKeeper.GetRangeOrdersByPool(s.Ctx, pool.GetId(), types.RangeOrderStatusFilled, nil)
+	s.Require().NoError(err)
+	s.Require().Len(filled, types.MaxRangeOrdersSettledPerBlock)
+
+	// The orders left over are settled in the next block.
+	clKeeper.EndBlock(s.Ctx)
+	filled, _, err = clKeeper.GetRangeOrdersByPool(s.Ctx, pool.GetId(), types.RangeOrderStatusFilled, nil)
+	s.Require().NoError(err)
+	s.Require().Len(filled, 2*types.MaxRangeOrdersSettledPerBlock)
+	open, _, err := clKeeper.GetRangeOrdersByOwner(s.Ctx, owner, pool.GetId(), types.RangeOrderStatusOpen, nil)
+	s.Require().NoError(err)
+	s.Require().Len(open, 1)
+	s.Require().Equal(rangeOrders[numRangeOrders-1].OrderId, open[0].OrderId)
+
+	// A crossed order left open is filled when claimed, which removes its settlement record.
+	s.Require().True(clKeeper.HasRangeOrderSettlement(s.Ctx, pool.GetId(), open[0].OrderId))
+	claimed, err := clKeeper.ClaimRangeOrder(s.Ctx, owner, open[0].OrderId)
+	s.Require().NoError(err)
+	s.Require().True(claimed.AmountOf(USDC).IsPositive())
+	s.Require().False(clKeeper.HasRangeOrderSettlement(s.Ctx, pool.GetId(), open[0].OrderId))
+	all, _, err := clKeeper.GetRangeOrdersByOwner(s.Ctx, owner, 0, types.RangeOrderStatusUnspecified, nil)
+	s.Require().NoError(err)
+	s.Require().Len(all, 2*types.MaxRangeOrdersSettledPerBlock)
+}
+
+func (s *KeeperTestSuite) TestRangeOrderSettlement_CrossedBackWithinBlock() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	rangeOrder := s.placeRangeOrder(pool.GetId(), owner, defaultToken0RangeOrderLowerTick, defaultRangeOrderToken0)
+
+	// A swap crosses the order and a later swap of the same block moves the price back below it.
+	s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(100000000)), ETH)
+	s.Require().True(clKeeper.HasRangeOrderSettlement(s.Ctx, pool.GetId(), rangeOrder.OrderId))
+	s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(ETH, osmomath.NewInt(100000)), USDC)
+	pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(rangeOrder.IsFillable(true, pool.GetCurrentTick()))
+
+	// System under test.
+	clKeeper.EndBlock(s.Ctx)
+
+	// The order is filled regardless of the current tick, since a swap crossed it.
+	rangeOrder, err = clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.RangeOrderStatusFilled, rangeOrder.Status)
+	s.Require().False(rangeOrder.Claimable.Empty())
+	_, err = clKeeper.GetPosition(s.Ctx, rangeOrder.OrderId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: rangeOrder.OrderId})
+	s.Require().False(clKeeper.HasRangeOrderSettlement(s.Ctx, pool.GetId(), rangeOrder.OrderId))
+}
+
+func (s *KeeperTestSuite) TestRangeOrderSettlement_Failure() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	rangeOrder := s.placeRangeOrder(pool.GetId(), owner, defaultToken0RangeOrderLowerTick, defaultRangeOrderToken0)
+
+	// Withdrawing the position of the order fails as long as the hook is set, since the hook is not a contract.
+	withdrawHook := types.PoolHook{ActionPrefix: types.BeforeActionPrefix(types.WithdrawPositionPrefix), ContractAddress: s.TestAccs[0].String()}
+	s.Require().NoError(clKeeper.SetPoolHook(s.Ctx, pool.GetId(), withdrawHook))
+
+	// The swap crossing the order succeeds regardless.
+	s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(100000000)), ETH)
+
+	// System under test.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	clKeeper.EndBlock(s.Ctx)
+
+	// The order is left open, the failure is surfaced and the order is no longer settled at the end of the block.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtFillRangeOrderFailed, 1)
+	rangeOrder, err := clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.RangeOrderStatusOpen, rangeOrder.Status)
+	s.Require().False(clKeeper.HasRangeOrderSettlement(s.Ctx, pool.GetId(), rangeOrder.OrderId))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	clKeeper.EndBlock(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtFillRangeOrderFailed, 0)
+
+	// Its owner can still claim it once withdrawing succeeds again.
+	s.Require().NoError(clKeeper.SetPoolHookContract(s.Ctx, pool.GetId(), withdrawHook.ActionPrefix, ""))
+	claimed, err := clKeeper.ClaimRangeOrder(s.Ctx, owner, rangeOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().True(claimed.AmountOf(USDC).IsPositive())
+}
+
+func (s *KeeperTestSuite) TestClaimAndCancelRangeOrder_Open() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	rangeOrder := s.placeRangeOrder(pool.GetId(), owner, defaultToken0RangeOrderLowerTick, defaultRangeOrderToken0)
+
+	// An open order that has not been crossed cannot be claimed.
+	_, err := clKeeper.ClaimRangeOrder(s.Ctx, owner, rangeOrder.OrderId)
+	s.Require().ErrorIs(err, types.RangeOrderNotFilledError{OrderId: rangeOrder.OrderId})
+
+	// Only the owner may cancel.
+	_, err = clKeeper.CancelRangeOrder(s.Ctx, s.TestAccs[0], rangeOrder.OrderId)
+	s.Require().ErrorIs(err, types.NotRangeOrderOwnerError{OrderId: rangeOrder.OrderId, Address: s.TestAccs[0].String()})
+
+	// Cancelling returns the deposited token, less rounding in favor of the pool.
+	withdrawn, err := clKeeper.CancelRangeOrder(s.Ctx, owner, rangeOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().True(withdrawn.AmountOf(USDC).IsZero())
+	s.Require().True(rangeOrder.TokenIn.Amount.Sub(withdrawn.AmountOf(ETH)).LTE(osmomath.OneInt()))
+	s.Require().Equal(defaultRangeOrderToken0.Amount.Sub(rangeOrder.TokenIn.Amount).Add(withdrawn.AmountOf(ETH)), s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount)
+
+	_, err = clKeeper.GetRangeOrder(s.Ctx, rangeOrder.OrderId)
+	s.Require().ErrorIs(err, types.RangeOrderNotFoundError{OrderId: rangeOrder.OrderId})
+	open, _, err := clKeeper.GetRangeOrdersByPool(s.Ctx, pool.GetId(), types.RangeOrderStatusOpen, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(open)
+
+	// The cancelled order is no longer settled once crossed.
+	s.swapInDefaultPool(pool.GetId(), sdk.NewCoin(USDC, osmomath.NewInt(100000000)), ETH)
+	clKeeper.EndBlock(s.Ctx)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.RangeOrderEscrowAddress(pool.GetId())).IsZero())
+}
@@ -3,11 +3,13 @@ package concentrated_liquidity
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 )
 
@@ -168,15 +170,26 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		}
 	}
 
+	// Trigger before hook for CollectSpreadRewards prior to mutating state.
+	// If no contract is set, this will be a no-op.
+	err = k.BeforeCollectSpreadRewards(ctx, position.PoolId, sender, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
 	// Get the amount of spread rewards that the position is eligible to claim.
 	// This also mutates the internal state of the spread reward accumulator.
-	spreadRewardsClaimed, err := k.prepareClaimableSpreadRewards(ctx, positionId)
+	spreadRewardsClaimed, spreadRewardsForfeited, err := k.prepareClaimableSpreadRewards(ctx, positionId)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
 
 	// Early return, emit no events if there is no spread rewards to claim.
-	if spreadRewardsClaimed.IsZero() {
+	if spreadRewardsClaimed.IsZero() && spreadRewardsForfeited.IsZero() {
+		err = k.AfterCollectSpreadRewards(ctx, position.PoolId, sender, positionId, sdk.Coins{})
+		if err != nil {
+			return sdk.Coins{}, err
+		}
 		return sdk.Coins{}, nil
 	}
 
@@ -185,8 +198,10 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 	if err != nil {
 		return sdk.Coins{}, err
 	}
-	if err := k.bankKeeper.SendCoins(ctx, pool.GetSpreadRewardsAddress(), sender, spreadRewardsClaimed); err != nil {
-		return sdk.Coins{}, err
+	if !spreadRewardsClaimed.IsZero() {
+		if err := k.sendCoins(ctx, pool.GetSpreadRewardsAddress(), sender, spreadRewardsClaimed); err != nil {
+			return sdk.Coins{}, err
+		}
 	}
 
 	// Emit an event for the spread rewards collected.
@@ -197,9 +212,17 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
 			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
 			sdk.NewAttribute(types.AttributeKeyTokensOut, spreadRewardsClaimed.String()),
+			sdk.NewAttribute(types.AttributeKeyForfeitedTokens, spreadRewardsForfeited.String()),
 		),
 	})
 
+	// Trigger after hook for CollectSpreadRewards.
+	// If no contract is set, this will be a no-op.
+	err = k.AfterCollectSpreadRewards(ctx, position.PoolId, sender, positionId, spreadRewardsClaimed)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
 	return spreadRewardsClaimed, nil
 }
 
@@ -212,31 +235,38 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 func (k Keeper) GetClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
 	// Since this is a query, we don't want to modify the state and therefore use a cache context.
 	cacheCtx, _ := ctx.CacheContext()
-	return k.prepareClaimableSpreadRewards(cacheCtx, positionId)
+	// We omit the forfeited spread rewards as they are not claimable.
+	spreadRewardsClaimed, _, err := k.prepareClaimableSpreadRewards(cacheCtx, positionId)
+	return spreadRewardsClaimed, err
 }
 
-// prepareClaimableSpreadRewards returns the amount of spread rewards that a position is eligible to claim.
+// prepareClaimableSpreadRewards returns the amount of spread rewards that a position is eligible to claim
+// and the amount of spread rewards that it forfeits.
 // Note that it mutates the internal state of the spread reward accumulator by setting the position's
 // unclaimed rewards to zero and update the position's accumulator value to reflect the
 // current pool spread reward accumulator value. If there is any dust left over, it is added back to the
 // global accumulator as long as there are shares remaining in the accumulator. If not, the dust
 // is ignored.
 //
+// If the position is younger than the minimum spread rewards position age, its spread rewards are
+// forfeited and added back to the global accumulator for the other in-range positions of the pool.
+// If there is no other active liquidity to receive them, the position keeps its spread rewards.
+//
 // Returns error if:
 // - pool with the given id does not exist
 // - position given by pool id, owner, lower tick and upper tick does not exist
 // - other internal database or math errors.
-func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
+func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, error) {
 	// Get the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Get the spread reward accumulator for the position's pool.
 	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Get the key for the position's accumulator in the spread reward accumulator.
@@ -245,24 +275,24 @@ func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64
 	// Check if the position exists in the spread reward accumulator.
 	hasPosition := spreadRewardAccumulator.HasPosition(positionKey)
 	if !hasPosition {
-		return nil, types.SpreadRewardPositionNotFoundError{PositionId: positionId}
+		return nil, nil, types.SpreadRewardPositionNotFoundError{PositionId: positionId}
 	}
 
 	// Compute the spread reward growth outside of the range between the position's lower and upper ticks.
 	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, position.PoolId, position.LowerTick, position.UpperTick)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Claim rewards, set the unclaimed rewards to zero, and update the position's accumulator value to reflect the current accumulator value.
 	spreadRewardsClaimedScaled, forfeitedDustScaled, err := updateAccumAndClaimRewards(spreadRewardAccumulator, positionKey, spreadRewardGrowthOutside)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	spreadFactorScalingFactor, err := k.getSpreadFactorScalingFactorForPool(ctx, position.PoolId)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// We scale the spread factor per-unit of liquidity accumulator up to avoid truncation to zero.
@@ -290,7 +320,7 @@ func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64
 		// Refetch the spread reward accumulator as the number of shares has changed after claiming.
 		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		totalSharesRemaining := spreadRewardAccumulator.GetTotalShares()
@@ -304,7 +334,71 @@ func (k Keeper) prepareClaimableSpreadRewards(ctx sdk.Context, positionId uint64
 		}
 	}
 
-	return spreadRewardsClaimed, nil
+	// Positions younger than the minimum age forfeit their spread rewards to the other in-range positions,
+	// so that liquidity added just in time for a swap does not capture its spread rewards.
+	spreadRewardsForfeited := sdk.Coins{}
+	positionAge := ctx.BlockTime().Sub(k.getPositionSpreadRewardEligibilityTime(ctx, position))
+	if !spreadRewardsClaimed.IsZero() && positionAge < k.GetParams(ctx).MinSpreadRewardsPositionAge {
+		pool, err := k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Refetch the spread reward accumulator as its state has changed after claiming.
+		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The position is still part of the active liquidity if it was not withdrawn and is in range.
+		// It must not receive back any of the spread rewards it forfeits.
+		otherActiveLiquidity := pool.GetLiquidity()
+		isPositionActive := spreadRewardAccumulator.HasPosition(positionKey) && pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick)
+		if isPositionActive {
+			otherActiveLiquidity = otherActiveLiquidity.Sub(position.Liquidity)
+		}
+
+		if otherActiveLiquidity.GTE(oneDec) {
+			// The claimed spread rewards are redeposited in scaled form, like the ones distributed by swaps.
+			forfeitedPerLiquidityScaled := sdk.NewDecCoinsFromCoins(spreadRewardsClaimedScaled...).QuoDecTruncate(otherActiveLiquidity)
+			spreadRewardAccumulator.AddToAccumulator(forfeitedPerLiquidityScaled)
+
+			// Exclude the position from the redeposit by moving its accumulator value to the current growth inside.
+			// Since the position is in range, its growth outside is unaffected by the redeposit.
+			if isPositionActive {
+				currentGrowthInsideForPosition, _ := spreadRewardAccumulator.GetValue().SafeSub(spreadRewardGrowthOutside)
+				if err := spreadRewardAccumulator.SetPositionIntervalAccumulation(positionKey, currentGrowthInsideForPosition); err != nil {
+					return nil, nil, err
+				}
+			}
+
+			spreadRewardsForfeited = spreadRewardsClaimed
+			spreadRewardsClaimed = sdk.Coins{}
+		}
+	}
+
+	return spreadRewardsClaimed, spreadRewardsForfeited, nil
+}
+
+// getPositionSpreadRewardEligibilityTime returns the time from which the age of the given position is counted
+// towards the minimum spread rewards position age. This is the join time of the position, unless the position
+// was moved to a new range while keeping its join time, in which case it is the time of the move.
+func (k Keeper) getPositionSpreadRewardEligibilityTime(ctx sdk.Context, position model.Position) time.Time {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPositionSpreadRewardEligibilityTime(position.PositionId))
+	if bz == nil {
+		return position.JoinTime
+	}
+	eligibilityTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return eligibilityTime
+}
+
+// setPositionSpreadRewardEligibilityTime sets the time from which the age of the position with the given id is
+// counted towards the minimum spread rewards position age, in place of its join time.
+func (k Keeper) setPositionSpreadRewardEligibilityTime(ctx sdk.Context, positionId uint64, eligibilityTime time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPositionSpreadRewardEligibilityTime(positionId), sdk.FormatTimeBytes(eligibilityTime))
 }
 
 // calculateSpreadRewardGrowth above or below the given tick.
@@ -1115,7 +1115,7 @@ func (s *KeeperTestSuite) TestPrepareClaimableSpreadRewards() {
 			originalAccumValue := originalAccum.GetValue()
 
 			// System under test
-			actualSpreadRewardsClaimed, err := clKeeper.PrepareClaimableSpreadRewards(ctx, tc.positionIdToPrepare)
+			actualSpreadRewardsClaimed, _, err := clKeeper.PrepareClaimableSpreadRewards(ctx, tc.positionIdToPrepare)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
@@ -1437,13 +1437,14 @@ func (s *KeeperTestSuite) TestFunctional_SpreadRewards_LP() {
 	// Swap once.
 	ticksActivatedAfterEachSwap, totalSpreadRewardsExpected, _, _ := s.swapAndTrackXTimesInARow(pool.GetId(), DefaultCoin1, ETH, types.MaxSpotPriceBigDec, 1)
 
-	// Withdraw half.
+	// Withdraw half, which collects the spread rewards.
 	halfLiquidity := positionDataOne.Liquidity.Mul(osmomath.NewDecWithPrec(5, 1))
-	_, _, err = concentratedLiquidityKeeper.WithdrawPosition(ctx, owner, positionDataOne.ID, halfLiquidity)
+	balanceBeforeWithdraw := s.App.BankKeeper.GetBalance(ctx, owner, USDC)
+	_, amtDenom1, err := concentratedLiquidityKeeper.WithdrawPosition(ctx, owner, positionDataOne.ID, halfLiquidity)
 	s.Require().NoError(err)
-
-	// Collect spread rewards.
-	spreadRewardsCollected := s.collectSpreadRewardsAndCheckInvariance(ctx, 0, DefaultMinTick, DefaultMaxTick, positionDataOne.ID, sdk.NewCoins(), []string{USDC}, [][]int64{ticksActivatedAfterEachSwap})
+	balanceAfterWithdraw := s.App.BankKeeper.GetBalance(ctx, owner, USDC)
+	spreadRewardsCollected := sdk.NewCoins(balanceAfterWithdraw.Sub(balanceBeforeWithdraw).SubAmount(amtDenom1))
+	s.tickStatusInvariance([][]int64{ticksActivatedAfterEachSwap}, DefaultMinTick, DefaultMaxTick, spreadRewardsCollected, []string{USDC})
 	expectedSpreadRewardsTruncated := totalSpreadRewardsExpected
 	for i, spreadRewardToken := range totalSpreadRewardsExpected {
 		// We run expected spread rewards through a cycle of division and multiplication by liquidity to capture appropriate rounding behavior
@@ -1463,10 +1464,10 @@ func (s *KeeperTestSuite) TestFunctional_SpreadRewards_LP() {
 	ticksActivatedAfterEachSwap, totalSpreadRewardsExpected, _, _ = s.swapAndTrackXTimesInARow(pool.GetId(), DefaultCoin0, USDC, types.MinSpotPriceBigDec, 1)
 
 	// This should claim under the hood for position 2 since full liquidity is removed.
-	balanceBeforeWithdraw := s.App.BankKeeper.GetBalance(ctx, owner, ETH)
+	balanceBeforeWithdraw = s.App.BankKeeper.GetBalance(ctx, owner, ETH)
 	amtDenom0, _, err := concentratedLiquidityKeeper.WithdrawPosition(ctx, owner, positionDataTwo.ID, positionDataTwo.Liquidity)
 	s.Require().NoError(err)
-	balanceAfterWithdraw := s.App.BankKeeper.GetBalance(ctx, owner, ETH)
+	balanceAfterWithdraw = s.App.BankKeeper.GetBalance(ctx, owner, ETH)
 
 	// Validate that the correct amount of ETH was collected in withdraw for position two.
 	// total spread rewards * full liquidity / (full liquidity + half liquidity)
@@ -1622,3 +1623,236 @@ func (s *KeeperTestSuite) TestScaleDownSpreadRewardAmount() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCollectSpreadRewards_MinPositionAge() {
+	const minPositionAge = time.Hour
+	tolerance := osmomath.NewInt(2)
+
+	tests := map[string]struct {
+		minSpreadRewardsPositionAge time.Duration
+		jitPositionAge              time.Duration
+		hasOtherPosition            bool
+		collectOnly                 bool
+		expectForfeit               bool
+	}{
+		"young position forfeits its spread rewards to the other positions": {
+			minSpreadRewardsPositionAge: minPositionAge,
+			hasOtherPosition:            true,
+			expectForfeit:               true,
+		},
+		"young position that is not withdrawn forfeits its spread rewards to the other positions": {
+			minSpreadRewardsPositionAge: minPositionAge,
+			hasOtherPosition:            true,
+			collectOnly:                 true,
+			expectForfeit:               true,
+		},
+		"position slightly younger than the min age forfeits its spread rewards": {
+			minSpreadRewardsPositionAge: minPositionAge,
+			jitPositionAge:              minPositionAge - time.Nanosecond,
+			hasOtherPosition:            true,
+			expectForfeit:               true,
+		},
+		"position as old as the min age keeps its spread rewards": {
+			minSpreadRewardsPositionAge: minPositionAge,
+			jitPositionAge:              minPositionAge,
+			hasOtherPosition:            true,
+		},
+		"min age disabled": {
+			hasOtherPosition: true,
+		},
+		"young position keeps its spread rewards if there is no other active liquidity": {
+			minSpreadRewardsPositionAge: minPositionAge,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			params := clKeeper.GetParams(s.Ctx)
+			params.MinSpreadRewardsPositionAge = tc.minSpreadRewardsPositionAge
+			clKeeper.SetParams(s.Ctx, params)
+
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+			var otherPositionId uint64
+			if tc.hasOtherPosition {
+				otherPositionId = s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+				s.AddBlockTime(minPositionAge)
+			}
+
+			// The just-in-time position has the same liquidity as the other position and earns half of the spread rewards.
+			jitOwner := s.TestAccs[1]
+			jitPositionId := s.SetupFullRangePositionAcc(pool.GetId(), jitOwner)
+			s.accrueSpreadRewards(pool.GetId())
+			s.AddBlockTime(tc.jitPositionAge)
+
+			otherSpreadRewardsBefore := sdk.Coins{}
+			if tc.hasOtherPosition {
+				var err error
+				otherSpreadRewardsBefore, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+				s.Require().NoError(err)
+				s.Require().False(otherSpreadRewardsBefore.IsZero())
+			}
+			jitSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, jitPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectForfeit, jitSpreadRewards.IsZero())
+
+			jitPosition, err := clKeeper.GetPosition(s.Ctx, jitPositionId)
+			s.Require().NoError(err)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			amount0, amount1 := osmomath.ZeroInt(), osmomath.ZeroInt()
+			if tc.collectOnly {
+				_, err = clKeeper.CollectSpreadRewards(s.Ctx, jitOwner, jitPositionId)
+			} else {
+				amount0, amount1, err = clKeeper.WithdrawPosition(s.Ctx, jitOwner, jitPositionId, jitPosition.Liquidity)
+			}
+			s.Require().NoError(err)
+
+			// The owner of the just-in-time position receives its spread rewards on top of its liquidity, unless forfeited.
+			received := s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner).Sub(balancesBefore...)
+			spreadRewardsReceived := received.Sub(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+			s.Require().Equal(jitSpreadRewards, spreadRewardsReceived)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtCollectSpreadRewards, 1)
+
+			// A position that is not withdrawn does not get back any of the spread rewards it forfeited.
+			if tc.collectOnly {
+				params.MinSpreadRewardsPositionAge = 0
+				clKeeper.SetParams(s.Ctx, params)
+				jitSpreadRewards, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, jitPositionId)
+				s.Require().NoError(err)
+				s.Require().True(jitSpreadRewards.IsZero())
+			}
+
+			if !tc.hasOtherPosition {
+				s.Require().False(spreadRewardsReceived.IsZero())
+				return
+			}
+
+			// Forfeited spread rewards go to the other position, which otherwise keeps its share.
+			otherSpreadRewardsAfter, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+			s.Require().NoError(err)
+			expectedOtherSpreadRewards := otherSpreadRewardsBefore
+			if tc.expectForfeit {
+				expectedOtherSpreadRewards = otherSpreadRewardsBefore.Add(otherSpreadRewardsBefore...)
+			}
+			for _, coin := range expectedOtherSpreadRewards {
+				actual := otherSpreadRewardsAfter.AmountOf(coin.Denom)
+				s.Require().True(coin.Amount.Sub(actual).Abs().LTE(tolerance), "expected %s, got %s", coin, actual)
+			}
+		})
+	}
+}
+
+// TestWithdrawPosition_PartialWithdrawalForfeitsYoungSpreadRewards tests that a position younger than the minimum
+// spread rewards position age cannot keep its spread rewards by withdrawing all of its liquidity but dust and
+// claiming them once it is old enough.
+func (s *KeeperTestSuite) TestWithdrawPosition_PartialWithdrawalForfeitsYoungSpreadRewards() {
+	const minPositionAge = time.Hour
+	tolerance := osmomath.NewInt(2)
+
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.MinSpreadRewardsPositionAge = minPositionAge
+	clKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	otherPositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	s.AddBlockTime(minPositionAge)
+
+	jitOwner := s.TestAccs[1]
+	jitPositionId := s.SetupFullRangePositionAcc(pool.GetId(), jitOwner)
+	s.accrueSpreadRewards(pool.GetId())
+
+	otherSpreadRewardsBefore, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+	s.Require().False(otherSpreadRewardsBefore.IsZero())
+
+	// Withdraw all of the just-in-time position's liquidity but dust.
+	jitPosition, err := clKeeper.GetPosition(s.Ctx, jitPositionId)
+	s.Require().NoError(err)
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	amount0, amount1, err := clKeeper.WithdrawPosition(s.Ctx, jitOwner, jitPositionId, jitPosition.Liquidity.Sub(osmomath.OneDec()))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCollectSpreadRewards, 1)
+
+	// The spread rewards are collected and forfeited on the partial withdrawal.
+	received := s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner).Sub(balancesBefore...)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)), received)
+
+	// Once the remaining position is old enough, there is nothing left to claim.
+	s.AddBlockTime(minPositionAge)
+	balancesBefore = s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner)
+	spreadRewardsClaimed, err := clKeeper.CollectSpreadRewards(s.Ctx, jitOwner, jitPositionId)
+	s.Require().NoError(err)
+	s.Require().True(spreadRewardsClaimed.IsZero())
+	s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner))
+
+	// The forfeited spread rewards went to the other position.
+	otherSpreadRewardsAfter, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+	for _, coin := range otherSpreadRewardsBefore.Add(otherSpreadRewardsBefore...) {
+		actual := otherSpreadRewardsAfter.AmountOf(coin.Denom)
+		s.Require().True(coin.Amount.Sub(actual).Abs().LTE(tolerance), "expected %s, got %s", coin, actual)
+	}
+}
+
+// TestRebalancePosition_ForfeitsYoungSpreadRewards tests that a position old enough to keep its spread rewards
+// cannot be moved to a narrow range around the current price just in time for a swap to capture its spread rewards.
+func (s *KeeperTestSuite) TestRebalancePosition_ForfeitsYoungSpreadRewards() {
+	const minPositionAge = time.Hour
+	tolerance := osmomath.NewInt(2)
+
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.MinSpreadRewardsPositionAge = minPositionAge
+	clKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.005"))
+	otherPositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	jitOwner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), jitOwner)
+	s.AddBlockTime(minPositionAge)
+
+	// System under test
+	newPositionData, err := clKeeper.RebalancePosition(s.Ctx, jitOwner, positionId, 30900000, 31100000, false, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	s.accrueSpreadRewards(pool.GetId())
+
+	// The age of the rebalanced position is exported with the position.
+	exportedPositions := clKeeper.ExportGenesis(s.Ctx).PositionData
+	s.Require().Len(exportedPositions, 2)
+	s.Require().Equal(newPositionData.ID, exportedPositions[1].Position.PositionId)
+	s.Require().Equal(s.Ctx.BlockTime(), *exportedPositions[1].SpreadRewardEligibilityTime)
+
+	// Compute the spread rewards the rebalanced position earned.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	params.MinSpreadRewardsPositionAge = 0
+	clKeeper.SetParams(cacheCtx, params)
+	jitSpreadRewards, err := clKeeper.CollectSpreadRewards(cacheCtx, jitOwner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().False(jitSpreadRewards.IsZero())
+
+	otherSpreadRewardsBefore, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+
+	// The rebalanced position forfeits its spread rewards, even though it kept the join time of the old position.
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner)
+	spreadRewardsClaimed, err := clKeeper.CollectSpreadRewards(s.Ctx, jitOwner, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(spreadRewardsClaimed.IsZero())
+	s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, jitOwner))
+
+	// The forfeited spread rewards went to the other position.
+	otherSpreadRewardsAfter, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+	for _, coin := range otherSpreadRewardsBefore.Add(jitSpreadRewards...) {
+		actual := otherSpreadRewardsAfter.AmountOf(coin.Denom)
+		s.Require().True(coin.Amount.Sub(actual).Abs().LTE(tolerance), "expected %s, got %s", coin, actual)
+	}
+}
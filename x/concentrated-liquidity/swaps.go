@@ -358,6 +358,62 @@ func (k Keeper) CalcInAmtGivenOut(
 	return sdk.NewCoin(tokenInDenom, swapResult.AmountIn), nil
 }
 
+// SimulateSwapExactAmountIn swaps tokenIn for tokenOutDenom against the given pool and stores the
+// pool's new liquidity, tick and sqrt price, without moving any funds or updating any accumulators.
+// Callers must pass a cache context that is discarded.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenOut sdk.Coin, err error) {
+	swapResult, poolUpdates, err := k.computeOutAmtGivenIn(ctx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, unboundedPriceLimit, false)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.applySimulatedSwap(ctx, poolI.GetId(), poolUpdates); err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenOutDenom, swapResult.AmountOut), nil
+}
+
+// SimulateSwapExactAmountOut swaps tokenInDenom for tokenOut against the given pool and stores the
+// pool's new liquidity, tick and sqrt price, without moving any funds or updating any accumulators.
+// Callers must pass a cache context that is discarded.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenIn sdk.Coin, err error) {
+	swapResult, poolUpdates, err := k.computeInAmtGivenOut(ctx, tokenOut, tokenInDenom, spreadFactor, unboundedPriceLimit, poolI.GetId(), false)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.applySimulatedSwap(ctx, poolI.GetId(), poolUpdates); err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenInDenom, swapResult.AmountIn), nil
+}
+
+// applySimulatedSwap applies the given pool updates to the pool and stores it.
+func (k Keeper) applySimulatedSwap(ctx sdk.Context, poolId uint64, poolUpdates PoolUpdates) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := pool.ApplySwap(poolUpdates.NewLiquidity, poolUpdates.NewCurrentTick, poolUpdates.NewSqrtPrice); err != nil {
+		return fmt.Errorf("error applying swap: %w", err)
+	}
+
+	return k.setPool(ctx, pool)
+}
+
 func (k Keeper) swapSetup(ctx sdk.Context,
 	poolId uint64,
 	tokenInDenom string,
@@ -740,6 +796,9 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 		if err != nil {
 			return swapState, err
 		}
+
+		// Record the range orders filled by crossing the tick, to be settled at the end of the block.
+		k.recordCrossedRangeOrders(ctx, p.GetId(), nextInitializedTick, strategy.ZeroForOne())
 	}
 	liquidityNet := nextInitializedTickInfo.LiquidityNet
 
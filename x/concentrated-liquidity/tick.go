@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
 )
@@ -184,6 +185,95 @@ func (k Keeper) GetAllInitializedTicksForPool(ctx sdk.Context, poolId uint64) ([
 	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId), ParseFullTickFromBytes)
 }
 
+// getLiquidityDepths returns the amounts of tokens held by the given pool between its spot price and the prices
+// that are the given fractions away from it. The price changes must be increasing and smaller than one.
+// Amount0 is accumulated over the initialized ticks above the current tick, and amount1 over the ones below it.
+func (k Keeper) getLiquidityDepths(ctx sdk.Context, pool types.ConcentratedPoolExtension, priceChanges []osmomath.Dec) ([]types.LiquidityDepth, error) {
+	amounts0, err := k.getLiquidityDepthInDirection(ctx, pool, priceChanges, false)
+	if err != nil {
+		return nil, err
+	}
+	amounts1, err := k.getLiquidityDepthInDirection(ctx, pool, priceChanges, true)
+	if err != nil {
+		return nil, err
+	}
+
+	depths := make([]types.LiquidityDepth, len(priceChanges))
+	for i, priceChange := range priceChanges {
+		depths[i] = types.LiquidityDepth{
+			PriceChange: priceChange,
+			Amount0:     amounts0[i],
+			Amount1:     amounts1[i],
+		}
+	}
+	return depths, nil
+}
+
+// getLiquidityDepthInDirection walks the initialized ticks of the given pool away from the current tick, in the
+// direction of a swap of token0 for token1 if zeroForOne is true and of token1 for token0 otherwise. It returns,
+// for each of the given increasing price changes, the cumulative amount of the token out of such a swap held
+// between the spot price and the price changed by that fraction in the walking direction.
+func (k Keeper) getLiquidityDepthInDirection(ctx sdk.Context, pool types.ConcentratedPoolExtension, priceChanges []osmomath.Dec, zeroForOne bool) ([]osmomath.Int, error) {
+	swapStrategy := swapstrategy.New(zeroForOne, osmomath.ZeroBigDec(), k.storeKey, osmomath.ZeroDec())
+	nextTickIter := swapStrategy.InitializeNextTickIterator(ctx, pool.GetId(), pool.GetCurrentTick())
+	defer nextTickIter.Close()
+
+	// amountBetween returns the amount of the token out held by the given liquidity between the sqrt prices.
+	amountBetween := func(liquidity osmomath.Dec, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
+		if !liquidity.IsPositive() {
+			return osmomath.ZeroBigDec()
+		}
+		if zeroForOne {
+			return math.CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB, false)
+		}
+		return math.CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB, false)
+	}
+
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	liquidity := pool.GetLiquidity()
+	amount := osmomath.ZeroBigDec()
+	amounts := make([]osmomath.Int, 0, len(priceChanges))
+	for _, priceChange := range priceChanges {
+		priceFactor := osmomath.OneDec().Add(priceChange)
+		if zeroForOne {
+			priceFactor = osmomath.OneDec().Sub(priceChange)
+		}
+		sqrtPriceFactor, err := osmomath.MonotonicSqrt(priceFactor)
+		if err != nil {
+			return nil, err
+		}
+		boundSqrtPrice := pool.GetCurrentSqrtPrice().MulDec(sqrtPriceFactor)
+
+		// Cross the initialized ticks up to the bound, accumulating the amount held between each of them.
+		for ; nextTickIter.Valid(); nextTickIter.Next() {
+			tickIndex, err := types.TickIndexFromBytes(nextTickIter.Key())
+			if err != nil {
+				return nil, err
+			}
+			tickSqrtPrice, err := math.TickToSqrtPrice(tickIndex)
+			if err != nil {
+				return nil, err
+			}
+			if (zeroForOne && tickSqrtPrice.LT(boundSqrtPrice)) || (!zeroForOne && tickSqrtPrice.GT(boundSqrtPrice)) {
+				break
+			}
+
+			tick, err := ParseTickFromBz(nextTickIter.Value())
+			if err != nil {
+				return nil, err
+			}
+			amount = amount.Add(amountBetween(liquidity, sqrtPrice, tickSqrtPrice))
+			liquidity = liquidity.Add(swapStrategy.SetLiquidityDeltaSign(tick.LiquidityNet))
+			sqrtPrice = tickSqrtPrice
+		}
+
+		amount = amount.Add(amountBetween(liquidity, sqrtPrice, boundSqrtPrice))
+		sqrtPrice = boundSqrtPrice
+		amounts = append(amounts, amount.Dec().TruncateInt())
+	}
+	return amounts, nil
+}
+
 // validateTickInRangeIsValid validates that given ticks are valid. That is:
 // - both lower and upper ticks are divisible by the tick spacing
 // - both lower and upper ticks are within MinTick and MaxTick range
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const (
+	// MaxPositionOpsPerBatch is the maximum number of operations of a MsgBatchPositionOps.
+	MaxPositionOpsPerBatch = 100
+
+	batchPositionOpsEscrowAddressPrefix = "batchPositionOps"
+)
+
+// BatchPositionOpsEscrowAddress is the address through which the token transfers of batches of position operations are settled.
+// Its balance is always zero outside of the settlement of a batch.
+var BatchPositionOpsEscrowAddress = osmoutils.NewModuleAddressWithPrefix(ModuleName, batchPositionOpsEscrowAddressPrefix, nil)
+
+// ValidateBasic performs stateless validation of a position operation of the given sender by validating
+// the message equivalent to the operation. Exactly one operation must be set.
+func (op PositionOp) ValidateBasic(sender string) error {
+	numOps := 0
+	var err error
+	if op.CreatePosition != nil {
+		numOps++
+		err = MsgCreatePosition{
+			PoolId:          op.CreatePosition.PoolId,
+			Sender:          sender,
+			LowerTick:       op.CreatePosition.LowerTick,
+			UpperTick:       op.CreatePosition.UpperTick,
+			TokensProvided:  op.CreatePosition.TokensProvided,
+			TokenMinAmount0: op.CreatePosition.TokenMinAmount0,
+			TokenMinAmount1: op.CreatePosition.TokenMinAmount1,
+		}.ValidateBasic()
+	}
+	if op.WithdrawPosition != nil {
+		numOps++
+		err = MsgWithdrawPosition{
+			PositionId:      op.WithdrawPosition.PositionId,
+			Sender:          sender,
+			LiquidityAmount: op.WithdrawPosition.LiquidityAmount,
+		}.ValidateBasic()
+	}
+	if op.AddToPosition != nil {
+		numOps++
+		err = MsgAddToPosition{
+			PositionId:      op.AddToPosition.PositionId,
+			Sender:          sender,
+			Amount0:         op.AddToPosition.Amount0,
+			Amount1:         op.AddToPosition.Amount1,
+			TokenMinAmount0: op.AddToPosition.TokenMinAmount0,
+			TokenMinAmount1: op.AddToPosition.TokenMinAmount1,
+		}.ValidateBasic()
+	}
+	if op.CollectRewards != nil {
+		numOps++
+		if op.CollectRewards.PositionId == 0 {
+			err = fmt.Errorf("Invalid position id (%d)", op.CollectRewards.PositionId)
+		}
+	}
+
+	if numOps != 1 {
+		return fmt.Errorf("Exactly one operation must be set, got %d", numOps)
+	}
+	return err
+}
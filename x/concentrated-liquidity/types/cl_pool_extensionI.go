@@ -27,6 +27,7 @@ type ConcentratedPoolExtension interface {
 	SetCurrentSqrtPrice(newSqrtPrice osmomath.BigDec)
 	SetCurrentTick(newTick int64)
 	SetTickSpacing(newTickSpacing uint64)
+	SetSpreadFactor(newSpreadFactor osmomath.Dec)
 	SetLastLiquidityUpdate(newTime time.Time)
 
 	UpdateLiquidity(newLiquidity osmomath.Dec)
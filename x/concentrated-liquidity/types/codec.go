@@ -18,10 +18,20 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceRangeOrder{}, "osmosis/cl-place-range-order", nil)
+	cdc.RegisterConcrete(&MsgClaimRangeOrder{}, "osmosis/cl-claim-range-order", nil)
+	cdc.RegisterConcrete(&MsgCancelRangeOrder{}, "osmosis/cl-cancel-range-order", nil)
+	cdc.RegisterConcrete(&MsgRebalancePosition{}, "osmosis/cl-rebalance-position", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-position-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgMintPositionToken{}, "osmosis/cl-mint-position-token", nil)
+	cdc.RegisterConcrete(&MsgRedeemPositionToken{}, "osmosis/cl-redeem-position-token", nil)
+	cdc.RegisterConcrete(&MsgBatchPositionOps{}, "osmosis/cl-batch-position-ops", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&DynamicSpreadFactorProposal{}, "osmosis/cl-dynamic-spread-factor-prop", nil)
+	cdc.RegisterConcrete(&PoolHooksProposal{}, "osmosis/cl-pool-hooks-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,12 +48,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgPlaceRangeOrder{},
+		&MsgClaimRangeOrder{},
+		&MsgCancelRangeOrder{},
+		&MsgRebalancePosition{},
+		&MsgSetPositionAutoCompound{},
+		&MsgMintPositionToken{},
+		&MsgRedeemPositionToken{},
+		&MsgBatchPositionOps{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&DynamicSpreadFactorProposal{},
+		&PoolHooksProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
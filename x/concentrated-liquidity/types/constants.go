@@ -69,4 +69,11 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+
+	DefaultAutoCompoundEpochIdentifier = "day"
+	// Compounding a position costs in the order of a few hundred thousand gas,
+	// so this budget compounds about a hundred positions per epoch.
+	DefaultAutoCompoundGasBudget = uint64(30_000_000)
+	// Spread rewards can be earned regardless of the age of the position by default.
+	DefaultMinSpreadRewardsPositionAge = time.Duration(0)
 )
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DynamicSpreadFactorVolatilityDecay is the weight of the previous volatility in the exponential moving
+// average of the per-block price changes of a pool. The weight of the latest block is one minus the decay.
+var DynamicSpreadFactorVolatilityDecay = osmomath.MustNewDecFromStr("0.9")
+
+// MaxDynamicSpreadFactorPriceChange is the maximum per-block relative price change of a pool folded into its
+// volatility. Larger price changes are clamped to it, which also keeps them from overflowing.
+var MaxDynamicSpreadFactorPriceChange = osmomath.OneDec()
+
+// Validate performs basic validation of a dynamic spread factor record.
+func (r DynamicSpreadFactorRecord) Validate() error {
+	if r.PoolId == 0 {
+		return fmt.Errorf("pool id cannot be zero")
+	}
+	if r.MinSpreadFactor.IsNil() || r.MaxSpreadFactor.IsNil() ||
+		r.MinSpreadFactor.IsNegative() || r.MinSpreadFactor.GT(r.MaxSpreadFactor) || r.MaxSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidDynamicSpreadFactorBoundsError{MinSpreadFactor: r.MinSpreadFactor, MaxSpreadFactor: r.MaxSpreadFactor}
+	}
+	if r.VolatilityMultiplier.IsNil() || r.VolatilityMultiplier.IsNegative() {
+		return fmt.Errorf("volatility multiplier of pool (%d) must be non-negative, got (%s)", r.PoolId, r.VolatilityMultiplier)
+	}
+	return nil
+}
+
+// Validate performs basic validation of a dynamic spread factor.
+func (d DynamicSpreadFactor) Validate() error {
+	if err := d.Record.Validate(); err != nil {
+		return err
+	}
+	if d.StaticSpreadFactor.IsNil() || d.StaticSpreadFactor.IsNegative() || d.StaticSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidSpreadFactorError{ActualSpreadFactor: d.StaticSpreadFactor}
+	}
+	if d.Volatility.IsNil() || d.Volatility.IsNegative() {
+		return fmt.Errorf("volatility of pool (%d) must be non-negative, got (%s)", d.Record.PoolId, d.Volatility)
+	}
+	if d.LastSqrtPrice.IsNil() || d.LastSqrtPrice.IsNegative() {
+		return fmt.Errorf("last sqrt price of pool (%d) must be non-negative, got (%s)", d.Record.PoolId, d.LastSqrtPrice)
+	}
+	return nil
+}
+
+// EffectiveSpreadFactor returns the spread factor charged given the current volatility:
+// the minimum spread factor plus the volatility multiplier times the volatility, capped at the maximum spread factor.
+func (d DynamicSpreadFactor) EffectiveSpreadFactor() osmomath.Dec {
+	spreadFactor := d.Record.MinSpreadFactor.Add(d.Record.VolatilityMultiplier.Mul(d.Volatility))
+	if spreadFactor.GT(d.Record.MaxSpreadFactor) {
+		return d.Record.MaxSpreadFactor
+	}
+	return spreadFactor
+}
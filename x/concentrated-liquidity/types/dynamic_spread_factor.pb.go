@@ -0,0 +1,823 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSpreadFactorRecord is the governance set configuration of the
+// dynamic spread factor of a pool. The spread factor of the pool is
+// min_spread_factor plus volatility_multiplier times its volatility, capped
+// at max_spread_factor.
+type DynamicSpreadFactorRecord struct {
+	PoolId               uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	MinSpreadFactor      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	VolatilityMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+}
+
+func (m *DynamicSpreadFactorRecord) Reset()         { *m = DynamicSpreadFactorRecord{} }
+func (m *DynamicSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorRecord) ProtoMessage()    {}
+func (*DynamicSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{0}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorRecord.Merge(m, src)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// DynamicSpreadFactor is the state of the dynamic spread factor of a pool.
+type DynamicSpreadFactor struct {
+	Record DynamicSpreadFactorRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record" yaml:"record"`
+	// static_spread_factor is the spread factor of the pool before its dynamic
+	// spread factor was enabled, restored once it is disabled.
+	StaticSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=static_spread_factor,json=staticSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_spread_factor" yaml:"static_spread_factor"`
+	// volatility is the exponential moving average of the absolute relative
+	// change of the spot price of the pool over a block.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+	// last_sqrt_price is the current sqrt price of the pool at the end of the
+	// previous block.
+	LastSqrtPrice github_com_osmosis_labs_osmosis_osmomath.BigDec `protobuf:"bytes,4,opt,name=last_sqrt_price,json=lastSqrtPrice,proto3,customtype=github.com/osmosis-labs/osmosis/osmomath.BigDec" json:"last_sqrt_price" yaml:"last_sqrt_price"`
+}
+
+func (m *DynamicSpreadFactor) Reset()         { *m = DynamicSpreadFactor{} }
+func (m *DynamicSpreadFactor) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactor) ProtoMessage()    {}
+func (*DynamicSpreadFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{1}
+}
+func (m *DynamicSpreadFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactor.Merge(m, src)
+}
+func (m *DynamicSpreadFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactor proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactor) GetRecord() DynamicSpreadFactorRecord {
+	if m != nil {
+		return m.Record
+	}
+	return DynamicSpreadFactorRecord{}
+}
+
+func init() {
+	proto.RegisterType((*DynamicSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorRecord")
+	proto.RegisterType((*DynamicSpreadFactor)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactor")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto", fileDescriptor_81bebf9355d0ef5b)
+}
+
+var fileDescriptor_81bebf9355d0ef5b = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x6b, 0xd3, 0x40,
+	0x1c, 0xc7, 0x1b, 0x5b, 0x2a, 0x9e, 0xcc, 0xb1, 0xd8, 0x49, 0x75, 0x92, 0x8c, 0x03, 0x61, 0x20,
+	0x4b, 0xd8, 0x04, 0x91, 0xbd, 0xa8, 0x71, 0x08, 0x82, 0x82, 0x66, 0x2f, 0x22, 0x42, 0xb8, 0x5e,
+	0xce, 0xec, 0x58, 0x92, 0x4b, 0xef, 0xae, 0x25, 0xf9, 0x2f, 0xfc, 0x13, 0xc4, 0xbf, 0x66, 0x8f,
+	0xf3, 0x4d, 0x7c, 0x08, 0xd2, 0xbe, 0xf8, 0xdc, 0xbf, 0x40, 0x72, 0x17, 0xed, 0xda, 0x46, 0x2c,
+	0x7b, 0xea, 0xdd, 0xaf, 0xdc, 0xe7, 0xf3, 0xbb, 0xdf, 0xb7, 0x3d, 0xf0, 0x9c, 0x89, 0x84, 0x09,
+	0x2a, 0x5c, 0xcc, 0x52, 0x4c, 0x52, 0xc9, 0x91, 0x24, 0x61, 0x4c, 0x87, 0x23, 0x1a, 0x52, 0x59,
+	0xb8, 0xe3, 0x83, 0x01, 0x91, 0xe8, 0xc0, 0x0d, 0x8b, 0x14, 0x25, 0x14, 0x07, 0x22, 0xe3, 0x04,
+	0x85, 0xc1, 0x27, 0x84, 0x25, 0xe3, 0x4e, 0xc6, 0x99, 0x64, 0xe6, 0x83, 0x1a, 0xe1, 0x34, 0x22,
+	0x9c, 0x1a, 0x71, 0xaf, 0x17, 0xb1, 0x88, 0xa9, 0x13, 0x6e, 0xb5, 0xd2, 0x87, 0xe1, 0xd7, 0x36,
+	0xb8, 0x7b, 0xac, 0xe1, 0x27, 0x8a, 0xfd, 0x52, 0xa1, 0x7d, 0x82, 0x19, 0x0f, 0xcd, 0x87, 0xe0,
+	0x7a, 0xc6, 0x58, 0x1c, 0xd0, 0xb0, 0x6f, 0xec, 0x1a, 0x7b, 0x1d, 0xcf, 0x9c, 0x95, 0xf6, 0xad,
+	0x02, 0x25, 0xf1, 0x11, 0xac, 0xbf, 0x80, 0x7e, 0xb7, 0x5a, 0xbd, 0x0a, 0xcd, 0x33, 0xb0, 0x95,
+	0xd0, 0x74, 0xb1, 0xc5, 0xfe, 0xb5, 0x5d, 0x63, 0xef, 0x86, 0xf7, 0xf4, 0xbc, 0xb4, 0x5b, 0x3f,
+	0x4a, 0x7b, 0x07, 0xab, 0x5e, 0x45, 0x78, 0xe6, 0x50, 0xe6, 0x26, 0x48, 0x9e, 0x3a, 0xaf, 0x49,
+	0x84, 0x70, 0x71, 0x4c, 0xf0, 0xac, 0xb4, 0xfb, 0x9a, 0xbc, 0x42, 0x81, 0xfe, 0x66, 0x42, 0xd3,
+	0xcb, 0xfd, 0x29, 0x19, 0xca, 0x97, 0x64, 0xed, 0xab, 0xc8, 0x50, 0xbe, 0x2a, 0x43, 0xf9, 0x82,
+	0x2c, 0x07, 0xdb, 0x63, 0x16, 0x23, 0x49, 0x63, 0x2a, 0x8b, 0x20, 0x19, 0xc5, 0x92, 0x66, 0x31,
+	0x25, 0xbc, 0xdf, 0x51, 0xc2, 0x17, 0xeb, 0x09, 0xef, 0x6b, 0x61, 0x23, 0x09, 0xfa, 0xbd, 0x79,
+	0xfd, 0xcd, 0xdf, 0xf2, 0x51, 0xe7, 0xd7, 0x17, 0xdb, 0x80, 0xdf, 0xda, 0xe0, 0x76, 0x43, 0x48,
+	0x26, 0x03, 0x5d, 0xae, 0x82, 0x52, 0xe9, 0xdc, 0x3c, 0x7c, 0xe6, 0xac, 0xf5, 0x53, 0x70, 0xfe,
+	0x19, 0xb8, 0xb7, 0x5d, 0x5d, 0x65, 0x56, 0xda, 0x1b, 0xba, 0x57, 0x4d, 0x87, 0x7e, 0xad, 0x31,
+	0x25, 0xe8, 0x09, 0x89, 0x24, 0xc5, 0x8d, 0x29, 0x7b, 0xeb, 0xcd, 0x61, 0x47, 0xb3, 0x9b, 0x40,
+	0xd0, 0x37, 0x75, 0x79, 0xe1, 0x9a, 0xef, 0x01, 0x98, 0x0f, 0xa7, 0x0e, 0xf9, 0xc9, 0x7a, 0xae,
+	0xad, 0xe5, 0x99, 0x43, 0xff, 0x12, 0xcb, 0x2c, 0xc0, 0x66, 0x8c, 0x84, 0x0c, 0xc4, 0x90, 0xcb,
+	0x20, 0xe3, 0x14, 0x93, 0x3a, 0xd2, 0x77, 0x35, 0xde, 0x8d, 0xa8, 0x3c, 0x1d, 0x0d, 0x1c, 0xcc,
+	0x12, 0xb7, 0x9e, 0xed, 0x7e, 0x8c, 0x06, 0xe2, 0xcf, 0x46, 0x7d, 0x2a, 0xab, 0x47, 0x23, 0xad,
+	0xbc, 0xa3, 0x95, 0x4b, 0x5c, 0xe8, 0x6f, 0x54, 0x95, 0x93, 0x21, 0x97, 0x6f, 0xab, 0xbd, 0xf7,
+	0xf1, 0x7c, 0x62, 0x19, 0x17, 0x13, 0xcb, 0xf8, 0x39, 0xb1, 0x8c, 0xcf, 0x53, 0xab, 0x75, 0x31,
+	0xb5, 0x5a, 0xdf, 0xa7, 0x56, 0xeb, 0x83, 0xf7, 0x3f, 0xe7, 0xf8, 0xf0, 0xb1, 0x9b, 0x2f, 0x3c,
+	0x18, 0xfb, 0xf3, 0x17, 0x43, 0x16, 0x19, 0x11, 0x83, 0xae, 0xfa, 0x77, 0x3f, 0xfa, 0x3d, 0x00,
+	0xa6, 0x07, 0xde, 0x8d, 0x5f, 0x04, 0x00, 0x00,
+}
+
+func (this *DynamicSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.MinSpreadFactor.Equal(that1.MinSpreadFactor) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if !this.VolatilityMultiplier.Equal(that1.VolatilityMultiplier) {
+		return false
+	}
+	return true
+}
+func (m *DynamicSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastSqrtPrice.Size()
+		i -= size
+		if _, err := m.LastSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StaticSpreadFactor.Size()
+		i -= size
+		if _, err := m.StaticSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSpreadFactor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSpreadFactor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.PoolId))
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func (m *DynamicSpreadFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.StaticSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.LastSqrtPrice.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func sovDynamicSpreadFactor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSpreadFactor(x uint64) (n int) {
+	return sovDynamicSpreadFactor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSpreadFactorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSpreadFactor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSpreadFactor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSpreadFactor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSpreadFactor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSpreadFactor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSpreadFactor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSpreadFactor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -666,6 +666,25 @@ func (e AddToLastPositionInPoolError) Error() string {
 	return fmt.Sprintf("Cannot add to a position if it is the last position in the pool. Pool id (%d), position ID (%d).", e.PoolId, e.PositionId)
 }
 
+type RebalanceLastPositionInPoolError struct {
+	PoolId     uint64
+	PositionId uint64
+}
+
+func (e RebalanceLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("Cannot rebalance a position if it is the last position in the pool. Pool id (%d), position ID (%d).", e.PoolId, e.PositionId)
+}
+
+type RebalanceToSameRangeError struct {
+	PositionId uint64
+	LowerTick  int64
+	UpperTick  int64
+}
+
+func (e RebalanceToSameRangeError) Error() string {
+	return fmt.Sprintf("position (%d) is already in range [%d, %d)", e.PositionId, e.LowerTick, e.UpperTick)
+}
+
 type NegativeAmountAddedError struct {
 	PositionId   uint64
 	Asset0Amount osmomath.Int
@@ -956,3 +975,152 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type RangeOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e RangeOrderNotFoundError) Error() string {
+	return fmt.Sprintf("range order not found (%d)", e.OrderId)
+}
+
+type RangeOrderTickNotAlignedError struct {
+	LowerTick   int64
+	TickSpacing uint64
+}
+
+func (e RangeOrderTickNotAlignedError) Error() string {
+	return fmt.Sprintf("range order lower tick (%d) must be divisible by the pool's tick spacing (%d)", e.LowerTick, e.TickSpacing)
+}
+
+type RangeOrderInvalidRangeError struct {
+	TokenInDenom string
+	LowerTick    int64
+	UpperTick    int64
+	CurrentTick  int64
+}
+
+func (e RangeOrderInvalidRangeError) Error() string {
+	return fmt.Sprintf("range order selling %s must not contain the current tick (%d) nor be fillable at it, got range [%d, %d): token0 orders must be placed above the current tick, token1 orders below", e.TokenInDenom, e.CurrentTick, e.LowerTick, e.UpperTick)
+}
+
+type NotRangeOrderOwnerError struct {
+	OrderId uint64
+	Address string
+}
+
+func (e NotRangeOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of range order (%d)", e.Address, e.OrderId)
+}
+
+type RangeOrderNotFilledError struct {
+	OrderId uint64
+}
+
+func (e RangeOrderNotFilledError) Error() string {
+	return fmt.Sprintf("range order (%d) is not filled yet", e.OrderId)
+}
+
+type RangeOrderNotOpenError struct {
+	OrderId uint64
+}
+
+func (e RangeOrderNotOpenError) Error() string {
+	return fmt.Sprintf("range order (%d) is not open", e.OrderId)
+}
+
+type InvalidLiquiditySnapshotTimeRangeError struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e InvalidLiquiditySnapshotTimeRangeError) Error() string {
+	return fmt.Sprintf("liquidity snapshot end time (%s) is before start time (%s)", e.EndTime, e.StartTime)
+}
+
+type PositionNotTokenizedError struct {
+	PositionId uint64
+}
+
+func (e PositionNotTokenizedError) Error() string {
+	return fmt.Sprintf("position (%d) is not tokenized", e.PositionId)
+}
+
+type PositionTokenizedError struct {
+	PositionId uint64
+}
+
+func (e PositionTokenizedError) Error() string {
+	return fmt.Sprintf("position (%d) is tokenized, its token must be redeemed first", e.PositionId)
+}
+
+type PositionTokenNotHeldError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e PositionTokenNotHeldError) Error() string {
+	return fmt.Sprintf("address (%s) does not hold the token of position (%d)", e.Address, e.PositionId)
+}
+
+type InvalidDynamicSpreadFactorBoundsError struct {
+	MinSpreadFactor osmomath.Dec
+	MaxSpreadFactor osmomath.Dec
+}
+
+func (e InvalidDynamicSpreadFactorBoundsError) Error() string {
+	return fmt.Sprintf("invalid dynamic spread factor bounds [%s, %s], must satisfy 0 <= min <= max < 1", e.MinSpreadFactor, e.MaxSpreadFactor)
+}
+
+type DynamicSpreadFactorNotFoundError struct {
+	PoolId uint64
+}
+
+func (e DynamicSpreadFactorNotFoundError) Error() string {
+	return fmt.Sprintf("pool (%d) does not have a dynamic spread factor", e.PoolId)
+}
+
+type NonPositiveRangeAprLookbackError struct {
+	Lookback time.Duration
+}
+
+func (e NonPositiveRangeAprLookbackError) Error() string {
+	return fmt.Sprintf("range apr lookback (%s) must be positive", e.Lookback)
+}
+
+type NoLiquiditySnapshotInLookbackError struct {
+	PoolId   uint64
+	Lookback time.Duration
+}
+
+func (e NoLiquiditySnapshotInLookbackError) Error() string {
+	return fmt.Sprintf("pool (%d) has no liquidity snapshot taken within the lookback (%s) before the current block", e.PoolId, e.Lookback)
+}
+
+type TooManyRangeAprPricePoolsError struct {
+	NumPricePools int
+	Max           int
+}
+
+func (e TooManyRangeAprPricePoolsError) Error() string {
+	return fmt.Sprintf("number of range apr price pools (%d) must not exceed (%d)", e.NumPricePools, e.Max)
+}
+
+type DenomNotPricedError struct {
+	Denom      string
+	QuoteDenom string
+}
+
+func (e DenomNotPricedError) Error() string {
+	return fmt.Sprintf("denom (%s) can not be priced in quote denom (%s) by the twap of any of the given pools", e.Denom, e.QuoteDenom)
+}
+
+type PoolHookGasLimitTooHighError struct {
+	ActionPrefix string
+	GasLimit     uint64
+	MaxGasLimit  uint64
+}
+
+func (e PoolHookGasLimitTooHighError) Error() string {
+	return fmt.Sprintf("gas limit (%d) of the %s hook exceeds the hook gas limit param (%d)", e.GasLimit, e.ActionPrefix, e.MaxGasLimit)
+}
@@ -4,6 +4,9 @@ const (
 	TypeEvtCreatePosition            = "create_position"
 	TypeEvtWithdrawPosition          = "withdraw_position"
 	TypeEvtAddToPosition             = "add_to_position"
+	TypeEvtRebalancePosition         = "rebalance_position"
+	TypeEvtSetAutoCompound           = "set_position_auto_compound"
+	TypeEvtCompoundPosition          = "compound_position"
 	TypeEvtTotalCollectSpreadRewards = "total_collect_spread_rewards"
 	TypeEvtCollectSpreadRewards      = "collect_spread_rewards"
 	TypeEvtTotalCollectIncentives    = "total_collect_incentives"
@@ -15,6 +18,16 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtPlaceRangeOrder           = "place_range_order"
+	TypeEvtFillRangeOrder            = "fill_range_order"
+	TypeEvtClaimRangeOrder           = "claim_range_order"
+	TypeEvtCancelRangeOrder          = "cancel_range_order"
+	TypeEvtFillRangeOrderFailed      = "fill_range_order_failed"
+	TypeEvtMintPositionToken         = "mint_position_token"
+	TypeEvtRedeemPositionToken       = "redeem_position_token"
+	TypeEvtUpdateSpreadFactor        = "update_spread_factor"
+	TypeEvtBatchPositionOps          = "batch_position_ops"
+	TypeEvtPoolHookFailed            = "pool_hook_failed"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -50,4 +63,14 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyOrderId                                            = "order_id"
+	AttributeKeyOwner                                              = "owner"
+	AttributeKeyTokensClaimable                                    = "tokens_claimable"
+	AttributeKeyEnabled                                            = "enabled"
+	AttributeKeySpreadRewardsClaimed                               = "spread_rewards_claimed"
+	AttributeKeyToken                                              = "token"
+	AttributeKeyVolatility                                         = "volatility"
+	AttributeKeyActionPrefix                                       = "action_prefix"
+	AttributeKeyContractAddress                                    = "contract_address"
+	AttributeKeyError                                              = "error"
 )
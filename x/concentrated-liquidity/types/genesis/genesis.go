@@ -1,6 +1,8 @@
 package genesis
 
 import (
+	"fmt"
+
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 )
 
@@ -28,5 +30,67 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, poolData := range gs.PoolData {
+		if err := poolData.validatePoolHooks(); err != nil {
+			return err
+		}
+	}
+	seenOrderIds := map[uint64]struct{}{}
+	for _, rangeOrder := range gs.RangeOrders {
+		if err := rangeOrder.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenOrderIds[rangeOrder.OrderId]; ok {
+			return fmt.Errorf("duplicate range order id (%d)", rangeOrder.OrderId)
+		}
+		seenOrderIds[rangeOrder.OrderId] = struct{}{}
+	}
+	seenAutoCompoundPositionIds := map[uint64]struct{}{}
+	for _, positionId := range gs.AutoCompoundPositionIds {
+		if _, ok := seenAutoCompoundPositionIds[positionId]; ok {
+			return fmt.Errorf("duplicate auto-compounding position id (%d)", positionId)
+		}
+		seenAutoCompoundPositionIds[positionId] = struct{}{}
+	}
+	seenSnapshots := map[[2]uint64]struct{}{}
+	for _, snapshot := range gs.LiquiditySnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+		snapshotKey := [2]uint64{snapshot.PoolId, snapshot.EpochNumber}
+		if _, ok := seenSnapshots[snapshotKey]; ok {
+			return fmt.Errorf("duplicate liquidity snapshot of pool (%d) at epoch (%d)", snapshot.PoolId, snapshot.EpochNumber)
+		}
+		seenSnapshots[snapshotKey] = struct{}{}
+	}
+	seenDynamicSpreadFactorPoolIds := map[uint64]struct{}{}
+	for _, dynamicSpreadFactor := range gs.DynamicSpreadFactors {
+		if err := dynamicSpreadFactor.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenDynamicSpreadFactorPoolIds[dynamicSpreadFactor.Record.PoolId]; ok {
+			return fmt.Errorf("duplicate dynamic spread factor of pool (%d)", dynamicSpreadFactor.Record.PoolId)
+		}
+		seenDynamicSpreadFactorPoolIds[dynamicSpreadFactor.Record.PoolId] = struct{}{}
+	}
+	return nil
+}
+
+// validatePoolHooks returns an error if any of the hooks of the pool is invalid or set more than once for the same
+// action, or if the hook failure policy of the pool is invalid.
+func (pd PoolData) validatePoolHooks() error {
+	if _, ok := types.PoolHookFailurePolicy_name[int32(pd.HookFailurePolicy)]; !ok {
+		return fmt.Errorf("invalid pool hook failure policy (%d)", pd.HookFailurePolicy)
+	}
+	seenActionPrefixes := map[string]struct{}{}
+	for _, hookData := range pd.Hooks {
+		if err := hookData.Hook.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenActionPrefixes[hookData.Hook.ActionPrefix]; ok {
+			return fmt.Errorf("duplicate pool hook for action (%s)", hookData.Hook.ActionPrefix)
+		}
+		seenActionPrefixes[hookData.Hook.ActionPrefix] = struct{}{}
+	}
 	return nil
 }
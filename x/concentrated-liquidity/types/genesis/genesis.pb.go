@@ -9,18 +9,22 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	accum "github.com/osmosis-labs/osmosis/osmoutils/accum"
 	model "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -104,6 +108,9 @@ type PoolData struct {
 	IncentivesAccumulators  []AccumObject `protobuf:"bytes,4,rep,name=incentives_accumulators,json=incentivesAccumulators,proto3" json:"incentives_accumulators" yaml:"incentives_accumulator"`
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// hooks of the pool, along with their last errors
+	Hooks             []PoolHookData               `protobuf:"bytes,6,rep,name=hooks,proto3" json:"hooks" yaml:"hooks"`
+	HookFailurePolicy types1.PoolHookFailurePolicy `protobuf:"varint,7,opt,name=hook_failure_policy,json=hookFailurePolicy,proto3,enum=osmosis.concentratedliquidity.v1beta1.PoolHookFailurePolicy" json:"hook_failure_policy,omitempty" yaml:"hook_failure_policy"`
 }
 
 func (m *PoolData) Reset()         { *m = PoolData{} }
@@ -174,18 +181,91 @@ func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
 	return nil
 }
 
+func (m *PoolData) GetHooks() []PoolHookData {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+func (m *PoolData) GetHookFailurePolicy() types1.PoolHookFailurePolicy {
+	if m != nil {
+		return m.HookFailurePolicy
+	}
+	return types1.PoolHookFailurePolicyRevert
+}
+
+// PoolHookData represents a hook of a pool along with its last error ignored
+// by the failure policy of the pool, for genesis state.
+type PoolHookData struct {
+	Hook types1.PoolHook `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook"`
+	// last_error is nil if the hook has no error recorded.
+	LastError *types1.PoolHookError `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" yaml:"last_error"`
+}
+
+func (m *PoolHookData) Reset()         { *m = PoolHookData{} }
+func (m *PoolHookData) String() string { return proto.CompactTextString(m) }
+func (*PoolHookData) ProtoMessage()    {}
+func (*PoolHookData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+}
+func (m *PoolHookData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHookData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHookData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHookData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHookData.Merge(m, src)
+}
+func (m *PoolHookData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHookData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHookData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHookData proto.InternalMessageInfo
+
+func (m *PoolHookData) GetHook() types1.PoolHook {
+	if m != nil {
+		return m.Hook
+	}
+	return types1.PoolHook{}
+}
+
+func (m *PoolHookData) GetLastError() *types1.PoolHookError {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
 type PositionData struct {
 	Position                *model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
 	SpreadRewardAccumRecord accum.Record    `protobuf:"bytes,3,opt,name=spread_reward_accum_record,json=spreadRewardAccumRecord,proto3" json:"spread_reward_accum_record"`
 	UptimeAccumRecords      []accum.Record  `protobuf:"bytes,4,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records"`
+	// spread_reward_eligibility_time is the time from which the age of the
+	// position is counted towards the minimum spread rewards position age, if it
+	// differs from its join time. It is nil otherwise.
+	SpreadRewardEligibilityTime *time.Time `protobuf:"bytes,5,opt,name=spread_reward_eligibility_time,json=spreadRewardEligibilityTime,proto3,stdtime" json:"spread_reward_eligibility_time,omitempty" yaml:"spread_reward_eligibility_time"`
 }
 
 func (m *PositionData) Reset()         { *m = PositionData{} }
 func (m *PositionData) String() string { return proto.CompactTextString(m) }
 func (*PositionData) ProtoMessage()    {}
 func (*PositionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
 }
 func (m *PositionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,6 +322,13 @@ func (m *PositionData) GetUptimeAccumRecords() []accum.Record {
 	return nil
 }
 
+func (m *PositionData) GetSpreadRewardEligibilityTime() *time.Time {
+	if m != nil {
+		return m.SpreadRewardEligibilityTime
+	}
+	return nil
+}
+
 // GenesisState defines the concentrated liquidity module's genesis state.
 type GenesisState struct {
 	// params are all the parameters of the module
@@ -253,13 +340,29 @@ type GenesisState struct {
 	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
 	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
 	SpreadFactorPoolIdMigrationThreshold          uint64         `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	// range orders that are open or filled but not yet claimed.
+	RangeOrders []types1.RangeOrder `protobuf:"bytes,8,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders" yaml:"range_orders"`
+	// ids of the positions that opted into auto-compounding.
+	AutoCompoundPositionIds []uint64 `protobuf:"varint,9,rep,packed,name=auto_compound_position_ids,json=autoCompoundPositionIds,proto3" json:"auto_compound_position_ids,omitempty" yaml:"auto_compound_position_ids"`
+	// id of the last position compounded at the end of the previous
+	// auto-compound epoch, if its gas budget was consumed before all positions
+	// were compounded.
+	AutoCompoundCursor uint64 `protobuf:"varint,10,opt,name=auto_compound_cursor,json=autoCompoundCursor,proto3" json:"auto_compound_cursor,omitempty" yaml:"auto_compound_cursor"`
+	// retained liquidity snapshots of all pools.
+	LiquiditySnapshots []types1.LiquiditySnapshot `protobuf:"bytes,11,rep,name=liquidity_snapshots,json=liquiditySnapshots,proto3" json:"liquidity_snapshots" yaml:"liquidity_snapshots"`
+	// dynamic spread factors of the pools that have one enabled.
+	DynamicSpreadFactors []types1.DynamicSpreadFactor `protobuf:"bytes,12,rep,name=dynamic_spread_factors,json=dynamicSpreadFactors,proto3" json:"dynamic_spread_factors" yaml:"dynamic_spread_factors"`
+	// id of the last pool snapshotted at the end of the previous liquidity
+	// snapshot epoch, if its gas budget was consumed before all pools were
+	// snapshotted.
+	LiquiditySnapshotCursor uint64 `protobuf:"varint,13,opt,name=liquidity_snapshot_cursor,json=liquiditySnapshotCursor,proto3" json:"liquidity_snapshot_cursor,omitempty" yaml:"liquidity_snapshot_cursor"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,6 +440,48 @@ func (m *GenesisState) GetSpreadFactorPoolIdMigrationThreshold() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRangeOrders() []types1.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundPositionIds() []uint64 {
+	if m != nil {
+		return m.AutoCompoundPositionIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundCursor() uint64 {
+	if m != nil {
+		return m.AutoCompoundCursor
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLiquiditySnapshots() []types1.LiquiditySnapshot {
+	if m != nil {
+		return m.LiquiditySnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicSpreadFactors() []types1.DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquiditySnapshotCursor() uint64 {
+	if m != nil {
+		return m.LiquiditySnapshotCursor
+	}
+	return 0
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -347,7 +492,7 @@ func (m *AccumObject) Reset()         { *m = AccumObject{} }
 func (m *AccumObject) String() string { return proto.CompactTextString(m) }
 func (*AccumObject) ProtoMessage()    {}
 func (*AccumObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{5}
 }
 func (m *AccumObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +538,7 @@ func (m *AccumObject) GetAccumContent() *accum.AccumulatorContent {
 func init() {
 	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
 	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
+	proto.RegisterType((*PoolHookData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHookData")
 	proto.RegisterType((*PositionData)(nil), "osmosis.concentratedliquidity.v1beta1.PositionData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.concentratedliquidity.v1beta1.GenesisState")
 	proto.RegisterType((*AccumObject)(nil), "osmosis.concentratedliquidity.v1beta1.AccumObject")
@@ -403,67 +549,94 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0xa5, 0x4e, 0xa8, 0x04, 0x82, 0x43, 0x5c, 0x28, 0x32, 0x08, 0x88, 0x86, 0x70,
-	0xe1, 0xcb, 0x8c, 0x77, 0xc7, 0xce, 0xd0, 0xf5, 0x8e, 0xd9, 0x19, 0x87, 0xf8, 0xca, 0x1d, 0x09,
-	0x71, 0xe2, 0x27, 0xf0, 0x03, 0x90, 0x38, 0x73, 0xab, 0x10, 0x87, 0x1c, 0x39, 0xad, 0x50, 0x72,
-	0xe0, 0xee, 0x5f, 0x80, 0x76, 0x3e, 0xfc, 0x11, 0x9c, 0xc4, 0xe9, 0x6d, 0xc6, 0xef, 0xfb, 0x3c,
-	0xef, 0x33, 0xef, 0x97, 0x17, 0xec, 0x70, 0xd1, 0xe5, 0x82, 0x09, 0x3f, 0xe0, 0x71, 0x40, 0x63,
-	0x99, 0x10, 0x49, 0xc3, 0x88, 0x7d, 0xd7, 0x67, 0x21, 0x93, 0x03, 0xff, 0xe8, 0x61, 0x8b, 0x4a,
-	0xf2, 0xd0, 0xef, 0xd0, 0x98, 0x0a, 0x26, 0xaa, 0xbd, 0x84, 0x4b, 0x0e, 0x37, 0x0d, 0xa8, 0x3a,
-	0x13, 0x54, 0x35, 0xa0, 0x8d, 0xb5, 0x0e, 0xef, 0x70, 0x85, 0xf0, 0xb3, 0x93, 0x06, 0x6f, 0xdc,
-	0x0d, 0x14, 0xba, 0xa9, 0x0d, 0xfa, 0x62, 0x4d, 0x1d, 0xce, 0x3b, 0x11, 0xf5, 0xd5, 0xad, 0xd5,
-	0x6f, 0xfb, 0x24, 0x1e, 0x18, 0xd3, 0xcb, 0x56, 0x27, 0x09, 0x82, 0x7e, 0x77, 0xa4, 0x4b, 0xdd,
-	0x8c, 0xcb, 0xab, 0x97, 0x3f, 0xa5, 0x47, 0x12, 0xd2, 0xb5, 0x91, 0x76, 0xe7, 0x7b, 0x76, 0x8f,
-	0x0b, 0x26, 0x19, 0x8f, 0x0d, 0xea, 0x8d, 0xf9, 0x50, 0x92, 0x05, 0x4f, 0x9b, 0x2c, 0x6e, 0xdb,
-	0x17, 0xbf, 0x3d, 0x1f, 0x8c, 0x29, 0x23, 0x3b, 0xa2, 0xcd, 0x84, 0x06, 0x3c, 0x09, 0x35, 0x1a,
-	0xfd, 0xe5, 0x80, 0xe5, 0x27, 0xfd, 0x28, 0x3a, 0x60, 0xc1, 0x53, 0xf8, 0x1a, 0xb8, 0xd1, 0xe3,
-	0x3c, 0x6a, 0xb2, 0xd0, 0x75, 0x2a, 0xce, 0x56, 0xae, 0x0e, 0x87, 0xa9, 0x77, 0x6b, 0x40, 0xba,
-	0xd1, 0x5b, 0xc8, 0x18, 0x10, 0x2e, 0x64, 0xa7, 0x46, 0x08, 0x77, 0x01, 0x30, 0x52, 0x42, 0x7a,
-	0xec, 0x2e, 0x56, 0x9c, 0xad, 0xa5, 0xfa, 0x9d, 0x61, 0xea, 0xdd, 0xd6, 0xfe, 0x63, 0x1b, 0xc2,
-	0x2b, 0xd9, 0xa5, 0x91, 0x9d, 0xe1, 0x57, 0x20, 0x97, 0x69, 0x77, 0x97, 0x2a, 0xce, 0x56, 0xb1,
-	0xe6, 0x57, 0xe7, 0xaa, 0x75, 0xf5, 0x40, 0xe1, 0xdb, 0xbc, 0xee, 0x3e, 0x4b, 0xbd, 0x85, 0x61,
-	0xea, 0xad, 0x4e, 0x05, 0x69, 0x73, 0x84, 0x15, 0x2d, 0xfa, 0x3d, 0x07, 0x96, 0xf7, 0x39, 0x8f,
-	0xde, 0x25, 0x92, 0xc0, 0x1d, 0x90, 0xcb, 0xb4, 0xaa, 0xb7, 0x14, 0x6b, 0x6b, 0x55, 0x5d, 0xff,
-	0xaa, 0xad, 0x7f, 0x75, 0x2f, 0x1e, 0xd4, 0x57, 0xfe, 0xfc, 0x6d, 0x3b, 0x9f, 0x21, 0x1a, 0x58,
-	0x39, 0xc3, 0x2f, 0x40, 0x3e, 0x63, 0x15, 0xee, 0x62, 0x65, 0xe9, 0x1a, 0x0a, 0x6d, 0x0e, 0xeb,
-	0x6b, 0x46, 0x61, 0x69, 0xac, 0x50, 0x20, 0xac, 0x39, 0xe1, 0x2f, 0x0e, 0xb8, 0x2b, 0x7a, 0x09,
-	0x25, 0x61, 0x33, 0xa1, 0xdf, 0x93, 0x24, 0x6c, 0xaa, 0x16, 0xeb, 0x47, 0x44, 0xf2, 0xc4, 0xe4,
-	0xa4, 0x36, 0x67, 0xc4, 0xbd, 0x0c, 0xf9, 0x49, 0xeb, 0x5b, 0x1a, 0xc8, 0xfa, 0x96, 0x09, 0x5a,
-	0xd1, 0x41, 0x2f, 0x0c, 0x81, 0xf0, 0xba, 0xb6, 0x61, 0x65, 0xda, 0x1b, 0x5b, 0xe0, 0xcf, 0x0e,
-	0x58, 0x1f, 0xf5, 0x88, 0x98, 0x04, 0x09, 0x37, 0xa7, 0x52, 0xf1, 0x3c, 0xc2, 0x36, 0x8d, 0xb0,
-	0x7b, 0x5a, 0xd8, 0xec, 0x00, 0x08, 0xbf, 0x38, 0x36, 0x4c, 0x68, 0x12, 0x90, 0x81, 0xdb, 0xe7,
-	0xfb, 0x56, 0xb8, 0x79, 0xa5, 0xe6, 0xd1, 0x9c, 0x6a, 0x1a, 0x16, 0x8f, 0x15, 0xbc, 0x9e, 0xcb,
-	0x14, 0xe1, 0x55, 0x36, 0xfd, 0xb3, 0x40, 0x7f, 0x2c, 0x82, 0xd2, 0xbe, 0x19, 0x48, 0xd5, 0x3d,
-	0x1f, 0x82, 0x65, 0x3b, 0xa0, 0xa6, 0x83, 0xe6, 0xed, 0x05, 0x4b, 0x83, 0x47, 0x04, 0xd9, 0x64,
-	0x45, 0x3c, 0xeb, 0xd5, 0x50, 0x4d, 0xca, 0xd4, 0x64, 0x19, 0x03, 0xc2, 0x85, 0xec, 0xd4, 0x08,
-	0xe1, 0x37, 0x60, 0x63, 0x46, 0x05, 0xcd, 0xfb, 0x4d, 0x97, 0xdc, 0x1b, 0x69, 0xd1, 0x4b, 0xca,
-	0xc6, 0x9e, 0x7a, 0xe5, 0xff, 0x8b, 0xad, 0xcd, 0xf0, 0x33, 0xb0, 0xd6, 0xef, 0x49, 0xd6, 0xa5,
-	0x53, 0xd4, 0xb6, 0xd0, 0x73, 0x71, 0x43, 0x4d, 0x30, 0xc1, 0x2a, 0xd0, 0xbf, 0x79, 0x50, 0x7a,
-	0x5f, 0xef, 0xf2, 0x4f, 0x25, 0x91, 0x14, 0x3e, 0x06, 0x05, 0xbd, 0x18, 0x4d, 0x06, 0x37, 0xaf,
-	0xc8, 0xe0, 0xbe, 0x72, 0x36, 0x11, 0x0c, 0x14, 0x62, 0xb0, 0xa2, 0x96, 0x4f, 0x48, 0x24, 0xb9,
-	0xe6, 0x54, 0xda, 0x55, 0x60, 0x18, 0x97, 0x7b, 0x76, 0x35, 0x7c, 0x0d, 0x6e, 0xda, 0xda, 0x68,
-	0xde, 0x25, 0xc5, 0xbb, 0x73, 0xcd, 0x0a, 0x4f, 0x70, 0x97, 0x7a, 0x93, 0xcd, 0xf3, 0x1e, 0x58,
-	0x8d, 0xe9, 0xb1, 0x6c, 0x8e, 0x82, 0xb0, 0xd0, 0xcd, 0xa9, 0xc2, 0xbf, 0x34, 0x4c, 0xbd, 0x75,
-	0x5d, 0xf8, 0xf3, 0x1e, 0x08, 0xdf, 0xca, 0x7e, 0xb2, 0xe4, 0x8d, 0x10, 0x7e, 0x09, 0x5c, 0xe5,
-	0x74, 0x7e, 0x08, 0x32, 0xba, 0xbc, 0xa2, 0xbb, 0x3f, 0x4c, 0x3d, 0x6f, 0x82, 0x6e, 0x86, 0x27,
-	0xc2, 0x77, 0x32, 0xd3, 0xb9, 0x41, 0x68, 0x84, 0xf0, 0x57, 0x07, 0xd4, 0x66, 0x4f, 0x64, 0xd3,
-	0x6c, 0xfb, 0x66, 0x97, 0x75, 0x12, 0xa2, 0xe4, 0xc9, 0xc3, 0x84, 0x8a, 0x43, 0x1e, 0x85, 0x6e,
-	0x41, 0x05, 0x7e, 0x67, 0x98, 0x7a, 0x6f, 0x5e, 0x36, 0xd5, 0x97, 0x71, 0x20, 0xbc, 0x3d, 0x73,
-	0xe2, 0xd5, 0x22, 0x0e, 0x3f, 0xb2, 0x80, 0x03, 0xeb, 0x0f, 0x7f, 0x74, 0xc0, 0x03, 0x33, 0x13,
-	0x6d, 0x12, 0x5c, 0xa5, 0xf0, 0x86, 0x52, 0xb8, 0x3b, 0x4c, 0xbd, 0xd7, 0xa7, 0x16, 0xe2, 0xd5,
-	0x50, 0x84, 0x5f, 0xd1, 0xbe, 0x4f, 0x94, 0xeb, 0x45, 0x7a, 0xd0, 0x0f, 0x0e, 0x28, 0x4e, 0xec,
-	0x39, 0x78, 0x1f, 0xe4, 0x62, 0xd2, 0xa5, 0xaa, 0xcd, 0x57, 0xea, 0x2f, 0x0c, 0x53, 0xaf, 0x68,
-	0x8a, 0x42, 0xba, 0x14, 0x61, 0x65, 0x84, 0x1f, 0x83, 0x9b, 0x7a, 0xdc, 0x02, 0x1e, 0x4b, 0x1a,
-	0x4b, 0xb5, 0x0a, 0x8a, 0xb5, 0x07, 0x17, 0x8c, 0xdb, 0x44, 0x5e, 0x1e, 0x6b, 0x00, 0x2e, 0x29,
-	0x0f, 0x73, 0xab, 0x87, 0xcf, 0x4e, 0xcb, 0xce, 0xc9, 0x69, 0xd9, 0xf9, 0xe7, 0xb4, 0xec, 0xfc,
-	0x74, 0x56, 0x5e, 0x38, 0x39, 0x2b, 0x2f, 0xfc, 0x7d, 0x56, 0x5e, 0xf8, 0xfc, 0x83, 0x0e, 0x93,
-	0x87, 0xfd, 0x56, 0x35, 0xe0, 0x5d, 0xdf, 0x90, 0x6f, 0x47, 0xa4, 0x25, 0xec, 0xc5, 0x3f, 0xaa,
-	0x3d, 0xf2, 0x8f, 0xa7, 0xbe, 0x18, 0xb6, 0xc7, 0x9f, 0x0c, 0x72, 0xd0, 0xa3, 0xc2, 0x7e, 0x94,
-	0xb5, 0x0a, 0xea, 0xff, 0x72, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x06, 0x85, 0x4a,
-	0xcc, 0x09, 0x00, 0x00,
+	// 1379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5d, 0x73, 0xd3, 0x46,
+	0x17, 0x8e, 0xb0, 0x63, 0x92, 0xb5, 0xe1, 0x85, 0x25, 0x10, 0x91, 0x0c, 0x96, 0x59, 0xc8, 0x4c,
+	0x78, 0x3b, 0xb1, 0x8b, 0x93, 0xd2, 0x96, 0xa1, 0x9d, 0x89, 0x02, 0xb4, 0xee, 0x17, 0x74, 0x49,
+	0x6f, 0xfa, 0x25, 0xd6, 0x92, 0xe2, 0x88, 0xc8, 0x5a, 0xa3, 0x95, 0x29, 0xbe, 0xed, 0x75, 0xdb,
+	0x61, 0x7a, 0xd3, 0xde, 0xf4, 0xba, 0xfd, 0x01, 0xfd, 0x0b, 0x9d, 0x61, 0x3a, 0xbd, 0x60, 0x7a,
+	0xd5, 0x2b, 0xb5, 0x03, 0xff, 0xc0, 0xbf, 0xa0, 0xb3, 0x1f, 0xb2, 0xe5, 0x8f, 0x04, 0xb9, 0x77,
+	0x92, 0xce, 0x79, 0x9e, 0xf3, 0xec, 0xee, 0xd9, 0x73, 0x8e, 0xc0, 0x26, 0x65, 0x6d, 0xca, 0x3c,
+	0x56, 0xb3, 0x69, 0x60, 0xbb, 0x41, 0x14, 0x92, 0xc8, 0x75, 0x7c, 0xef, 0x61, 0xd7, 0x73, 0xbc,
+	0xa8, 0x57, 0x7b, 0x74, 0xb5, 0xe9, 0x46, 0xe4, 0x6a, 0xad, 0xe5, 0x06, 0x2e, 0xf3, 0x58, 0xb5,
+	0x13, 0xd2, 0x88, 0xc2, 0x35, 0x05, 0xaa, 0x4e, 0x05, 0x55, 0x15, 0x68, 0x65, 0xa9, 0x45, 0x5b,
+	0x54, 0x20, 0x6a, 0xfc, 0x49, 0x82, 0x57, 0xce, 0xdb, 0x02, 0x6d, 0x49, 0x83, 0x7c, 0x49, 0x4c,
+	0x2d, 0x4a, 0x5b, 0xbe, 0x5b, 0x13, 0x6f, 0xcd, 0xee, 0x5e, 0x8d, 0x04, 0x3d, 0x65, 0x32, 0xc6,
+	0x4d, 0x91, 0xd7, 0x76, 0x59, 0x44, 0xda, 0x1d, 0xe5, 0x70, 0x31, 0x59, 0x08, 0xb1, 0xed, 0x6e,
+	0x7b, 0x20, 0x5c, 0xbc, 0x29, 0x97, 0xff, 0x1f, 0xbd, 0xd6, 0x0e, 0x09, 0x49, 0x3b, 0x91, 0xb2,
+	0x95, 0x6d, 0x5f, 0x3a, 0x94, 0x79, 0x91, 0x47, 0x03, 0x85, 0x7a, 0x2d, 0x1b, 0x2a, 0xf2, 0xec,
+	0x03, 0xcb, 0x0b, 0xf6, 0x92, 0x2d, 0xb9, 0x91, 0x0d, 0xe6, 0x09, 0xa3, 0xf7, 0xc8, 0xb5, 0x42,
+	0xd7, 0xa6, 0xa1, 0xa3, 0xd0, 0xaf, 0x67, 0x43, 0x87, 0x24, 0x68, 0xb9, 0x16, 0x0d, 0x1d, 0x37,
+	0x54, 0xc0, 0xb7, 0xb3, 0x01, 0x07, 0x5f, 0x2c, 0x16, 0x90, 0x0e, 0xdb, 0xa7, 0x91, 0xc2, 0x6f,
+	0x67, 0xc3, 0x3b, 0xbd, 0x80, 0xb4, 0x3d, 0xdb, 0x62, 0x9d, 0xd0, 0x25, 0x8e, 0xb5, 0x47, 0xec,
+	0x88, 0x26, 0x12, 0xae, 0x65, 0xdd, 0x66, 0xea, 0x5b, 0xfb, 0x94, 0x1e, 0xa8, 0xe3, 0x41, 0x7f,
+	0x68, 0x60, 0xe1, 0x76, 0xd7, 0xf7, 0x77, 0x3d, 0xfb, 0x00, 0xbe, 0x02, 0x8e, 0x0b, 0x07, 0xcf,
+	0xd1, 0xb5, 0x8a, 0xb6, 0x9e, 0x37, 0x61, 0x3f, 0x36, 0x4e, 0xf6, 0x48, 0xdb, 0xbf, 0x8e, 0x94,
+	0x01, 0xe1, 0x02, 0x7f, 0x6a, 0x38, 0x70, 0x0b, 0x00, 0xb5, 0xfd, 0x8e, 0xfb, 0x58, 0x3f, 0x56,
+	0xd1, 0xd6, 0x73, 0xe6, 0xd9, 0x7e, 0x6c, 0x9c, 0x96, 0xfe, 0x43, 0x1b, 0xc2, 0x8b, 0xfc, 0xa5,
+	0xc1, 0x9f, 0xe1, 0x17, 0x20, 0xcf, 0xcf, 0x4b, 0xcf, 0x55, 0xb4, 0xf5, 0x62, 0xbd, 0x56, 0xcd,
+	0x74, 0x01, 0xaa, 0xbb, 0x02, 0xbf, 0x47, 0x4d, 0xfd, 0x69, 0x6c, 0xcc, 0xf5, 0x63, 0xe3, 0xd4,
+	0x48, 0x90, 0x3d, 0x8a, 0xb0, 0xa0, 0x45, 0x3f, 0x17, 0xc0, 0xc2, 0x5d, 0x4a, 0xfd, 0x9b, 0x24,
+	0x22, 0x70, 0x13, 0xe4, 0xb9, 0x56, 0xb1, 0x96, 0x62, 0x7d, 0xa9, 0x2a, 0x33, 0xbf, 0x9a, 0x64,
+	0x7e, 0x75, 0x3b, 0xe8, 0x99, 0x8b, 0xbf, 0xff, 0xba, 0x31, 0xcf, 0x11, 0x0d, 0x2c, 0x9c, 0xe1,
+	0x67, 0x60, 0x9e, 0xb3, 0x32, 0xfd, 0x58, 0x25, 0x37, 0x83, 0xc2, 0x64, 0x0f, 0xcd, 0x25, 0xa5,
+	0xb0, 0x34, 0x54, 0xc8, 0x10, 0x96, 0x9c, 0xf0, 0x47, 0x0d, 0x9c, 0x57, 0xa7, 0x17, 0xba, 0x5f,
+	0x91, 0xd0, 0xb1, 0xc4, 0xb5, 0xea, 0xfa, 0x24, 0xa2, 0xa1, 0xda, 0x93, 0x7a, 0xc6, 0x88, 0xdb,
+	0x1c, 0x79, 0xa7, 0xf9, 0xc0, 0xb5, 0x23, 0x73, 0x5d, 0x05, 0xad, 0xc8, 0xa0, 0x87, 0x86, 0x40,
+	0x78, 0x59, 0xda, 0xb0, 0x30, 0x6d, 0x0f, 0x2d, 0xf0, 0x7b, 0x0d, 0x2c, 0x0f, 0xee, 0x05, 0x4b,
+	0x83, 0x98, 0x9e, 0xaf, 0xe4, 0xfe, 0xa3, 0xb0, 0x35, 0x25, 0xec, 0x82, 0x14, 0x36, 0x3d, 0x00,
+	0xc2, 0xe7, 0x86, 0x86, 0x94, 0x26, 0x06, 0x3d, 0x70, 0x7a, 0xfc, 0xae, 0x32, 0x7d, 0x5e, 0xa8,
+	0xb9, 0x96, 0x51, 0x4d, 0x23, 0xc1, 0x63, 0x01, 0x37, 0xf3, 0x5c, 0x11, 0x3e, 0xe5, 0x8d, 0x7e,
+	0x66, 0xd0, 0x02, 0xf3, 0xe2, 0x5e, 0xe8, 0x05, 0x41, 0xbf, 0x99, 0x91, 0x9e, 0xa7, 0xce, 0xbb,
+	0x94, 0x1e, 0xf0, 0x84, 0x1b, 0x3f, 0x7b, 0xc1, 0x87, 0xb0, 0xe4, 0x85, 0xdf, 0x68, 0xe0, 0x0c,
+	0x7f, 0xb2, 0xf6, 0x88, 0xe7, 0x77, 0x43, 0xd7, 0xea, 0x50, 0xdf, 0xb3, 0x7b, 0xfa, 0xf1, 0x8a,
+	0xb6, 0x7e, 0xb2, 0x7e, 0x63, 0xc6, 0x78, 0xb7, 0x25, 0xc9, 0x5d, 0xc1, 0x61, 0x96, 0xfb, 0xb1,
+	0xb1, 0x32, 0x0c, 0x3a, 0x16, 0x02, 0xe1, 0xd3, 0xfb, 0xe3, 0x10, 0xf4, 0x9b, 0x06, 0x4a, 0x69,
+	0xf1, 0xb0, 0x01, 0xf2, 0xdc, 0x4b, 0xdd, 0x96, 0xda, 0x8c, 0x7a, 0xd4, 0xbe, 0x0a, 0x0a, 0xf8,
+	0x00, 0x00, 0x9f, 0xb0, 0xc8, 0x72, 0xc3, 0x90, 0x86, 0xa2, 0x34, 0x14, 0xeb, 0x5b, 0x33, 0x12,
+	0xde, 0xe2, 0xd8, 0x74, 0x41, 0x19, 0x32, 0x22, 0xbc, 0xc8, 0x5f, 0x84, 0x07, 0xfa, 0x33, 0xc7,
+	0xd7, 0x21, 0x9b, 0x87, 0x58, 0xc7, 0xfb, 0x60, 0x21, 0x69, 0x26, 0x33, 0xaf, 0x45, 0xc2, 0xf0,
+	0x80, 0x80, 0x57, 0x44, 0x9f, 0xf2, 0x1a, 0xe3, 0xe8, 0xc7, 0xc6, 0x2b, 0xa2, 0x32, 0x20, 0x5c,
+	0xe0, 0x4f, 0x0d, 0x07, 0xde, 0x07, 0x2b, 0x53, 0x6e, 0x9e, 0xca, 0x5b, 0x75, 0xbb, 0x2f, 0x0c,
+	0xb4, 0x08, 0xe3, 0x20, 0xf6, 0x48, 0x76, 0x4e, 0x5e, 0x52, 0x69, 0x86, 0x9f, 0x80, 0xa5, 0x6e,
+	0x87, 0x37, 0xec, 0x11, 0xea, 0xe4, 0x82, 0x66, 0xe2, 0x86, 0x92, 0x20, 0xc5, 0xca, 0xe0, 0x77,
+	0x1a, 0x28, 0x8f, 0x2a, 0x77, 0x7d, 0xaf, 0xe5, 0x35, 0x3d, 0x9f, 0x37, 0x2b, 0xee, 0xae, 0xcf,
+	0x0b, 0xf5, 0x2b, 0x13, 0x35, 0x74, 0x37, 0x99, 0x1e, 0xcc, 0x8d, 0x7e, 0x6c, 0xac, 0x4d, 0xab,
+	0x3f, 0xe3, 0x5c, 0xe8, 0xc9, 0xdf, 0x86, 0x86, 0x57, 0xd3, 0x6b, 0xbc, 0x35, 0x74, 0xe1, 0x84,
+	0xe8, 0xa7, 0x22, 0x28, 0xbd, 0x23, 0x27, 0xa5, 0x7b, 0x11, 0x89, 0x5c, 0xb8, 0x03, 0x0a, 0x72,
+	0xaa, 0x50, 0x47, 0xba, 0xf6, 0x92, 0x23, 0xbd, 0x2b, 0x9c, 0xd5, 0x92, 0x15, 0x14, 0x62, 0xb0,
+	0x28, 0xba, 0x98, 0x43, 0x22, 0x32, 0x63, 0x79, 0x4f, 0x7a, 0x8a, 0x62, 0x5c, 0xe8, 0x24, 0x3d,
+	0xe6, 0x4b, 0x70, 0x22, 0x49, 0x16, 0xc9, 0x9b, 0x9b, 0xb1, 0x7c, 0x0c, 0x33, 0x57, 0x71, 0x97,
+	0x3a, 0xe9, 0x6c, 0xbe, 0x05, 0x4e, 0x05, 0xee, 0xe3, 0xc8, 0x1a, 0x04, 0xf1, 0x1c, 0x3d, 0x2f,
+	0x32, 0x71, 0xb5, 0x1f, 0x1b, 0xcb, 0x72, 0xbf, 0xc7, 0x3d, 0x10, 0x3e, 0xc9, 0x3f, 0x25, 0xe4,
+	0x0d, 0x07, 0x7e, 0x0e, 0x74, 0xe1, 0x34, 0x5e, 0x4d, 0x39, 0xdd, 0xbc, 0xa0, 0xbb, 0xd4, 0x8f,
+	0x0d, 0x23, 0x45, 0x37, 0xc5, 0x13, 0xe1, 0xb3, 0xdc, 0x34, 0x56, 0x51, 0x1b, 0x0e, 0xfc, 0x45,
+	0x03, 0xf5, 0xe9, 0xa5, 0xdd, 0x52, 0x63, 0x83, 0xd5, 0xf6, 0x5a, 0x21, 0x11, 0xf2, 0xa2, 0xfd,
+	0xd0, 0x65, 0xfb, 0xd4, 0x77, 0xf4, 0x82, 0x08, 0xfc, 0x56, 0x3f, 0x36, 0xde, 0x3c, 0xaa, 0x3d,
+	0x1c, 0xc5, 0x81, 0xf0, 0xc6, 0xd4, 0xd6, 0x21, 0x3a, 0xba, 0xf3, 0x61, 0x02, 0xd8, 0x4d, 0xfc,
+	0xe1, 0xb7, 0x1a, 0xb8, 0x32, 0x32, 0x3f, 0x1d, 0xa9, 0xf0, 0xb8, 0x50, 0xb8, 0xd5, 0x8f, 0x8d,
+	0x57, 0x47, 0x32, 0xfb, 0xe5, 0x50, 0x84, 0x2f, 0x4b, 0xdf, 0xdb, 0xc4, 0x3e, 0x4a, 0xcf, 0x43,
+	0x50, 0x4a, 0xcd, 0x93, 0x4c, 0x5f, 0x10, 0xe9, 0x73, 0x35, 0x63, 0xfa, 0x60, 0x0e, 0xbd, 0xc3,
+	0x91, 0xe6, 0xaa, 0xea, 0x3d, 0x67, 0xa4, 0xd0, 0x34, 0x29, 0xc2, 0xc5, 0x70, 0xe0, 0xc8, 0x60,
+	0x13, 0xac, 0x90, 0x6e, 0x44, 0x2d, 0x9b, 0xb6, 0x3b, 0xb4, 0x1b, 0x38, 0xe9, 0xcc, 0x61, 0xfa,
+	0x62, 0x25, 0xb7, 0x9e, 0x37, 0xd7, 0xfa, 0xb1, 0x71, 0x51, 0x32, 0x1d, 0xee, 0x8b, 0xf0, 0x32,
+	0x37, 0xee, 0x28, 0xdb, 0x30, 0xdd, 0x18, 0xfc, 0x18, 0x2c, 0x8d, 0xe2, 0xec, 0x6e, 0xc8, 0x68,
+	0xa8, 0x03, 0xb1, 0xa1, 0x46, 0x3f, 0x36, 0x56, 0xa7, 0xb1, 0x4b, 0x2f, 0x84, 0x61, 0x9a, 0x77,
+	0x47, 0x7c, 0xe4, 0x27, 0x77, 0x66, 0x72, 0x82, 0x66, 0x7a, 0x51, 0xec, 0xd8, 0x1b, 0x19, 0x77,
+	0xec, 0x83, 0xe4, 0xcb, 0x3d, 0x45, 0x60, 0x22, 0xb5, 0x71, 0xaa, 0x7f, 0x4e, 0x09, 0x81, 0x30,
+	0xf4, 0xc7, 0x61, 0x0c, 0xfe, 0xa0, 0x81, 0x73, 0x53, 0x27, 0x72, 0xa6, 0x97, 0x84, 0xa4, 0xeb,
+	0x19, 0x25, 0xdd, 0x94, 0x24, 0xf7, 0x52, 0xe9, 0x32, 0x3e, 0x37, 0x4d, 0x8f, 0x83, 0xf0, 0x92,
+	0x33, 0x89, 0x65, 0xf0, 0x3e, 0x38, 0x3f, 0xb9, 0x8a, 0xe4, 0x04, 0x4e, 0x88, 0x13, 0xb8, 0x3c,
+	0x1c, 0x16, 0x0f, 0x75, 0x45, 0x78, 0x79, 0x62, 0xd9, 0xf2, 0x2c, 0xd0, 0xd7, 0x1a, 0x28, 0xa6,
+	0xc6, 0x3c, 0x78, 0x09, 0xe4, 0x03, 0xd2, 0x76, 0x45, 0x71, 0x5e, 0x34, 0xff, 0xd7, 0x8f, 0x8d,
+	0xa2, 0x2a, 0x25, 0xa4, 0xed, 0x22, 0x2c, 0x8c, 0xf0, 0x23, 0x70, 0x42, 0x76, 0x2d, 0x9b, 0x06,
+	0x91, 0x1b, 0x44, 0x6a, 0x30, 0xb8, 0x72, 0x48, 0xd7, 0x4a, 0xdd, 0xe6, 0x1d, 0x09, 0xc0, 0x25,
+	0xe1, 0xa1, 0xde, 0x4c, 0xe7, 0xe9, 0xf3, 0xb2, 0xf6, 0xec, 0x79, 0x59, 0xfb, 0xe7, 0x79, 0x59,
+	0x7b, 0xf2, 0xa2, 0x3c, 0xf7, 0xec, 0x45, 0x79, 0xee, 0xaf, 0x17, 0xe5, 0xb9, 0x4f, 0xdf, 0x6b,
+	0x79, 0xd1, 0x7e, 0xb7, 0x59, 0xb5, 0x69, 0xbb, 0xa6, 0xc8, 0x37, 0x7c, 0xd2, 0x64, 0xc9, 0x4b,
+	0xed, 0x51, 0xfd, 0x5a, 0xed, 0xf1, 0xc8, 0xaf, 0xd2, 0xc6, 0xf0, 0x5f, 0x29, 0xea, 0x75, 0x5c,
+	0x96, 0xfc, 0xa8, 0x37, 0x0b, 0xa2, 0xd5, 0x6d, 0xfe, 0x3b, 0x00, 0x2f, 0x09, 0x84, 0x4f, 0xe0,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -529,6 +702,25 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HookFailurePolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HookFailurePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IncentiveRecords) > 0 {
 		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -596,6 +788,51 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolHookData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHookData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHookData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PositionData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -616,6 +853,16 @@ func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpreadRewardEligibilityTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SpreadRewardEligibilityTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpreadRewardEligibilityTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGenesis(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.UptimeAccumRecords) > 0 {
 		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -680,6 +927,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquiditySnapshotCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquiditySnapshotCursor))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DynamicSpreadFactors) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LiquiditySnapshots) > 0 {
+		for iNdEx := len(m.LiquiditySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquiditySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AutoCompoundCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AutoCompoundCursor))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AutoCompoundPositionIds) > 0 {
+		dAtA10 := make([]byte, len(m.AutoCompoundPositionIds)*10)
+		var j9 int
+		for _, num := range m.AutoCompoundPositionIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintGenesis(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpreadFactorPoolIdMigrationThreshold))
 		i--
@@ -841,6 +1158,30 @@ func (m *PoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HookFailurePolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.HookFailurePolicy))
+	}
+	return n
+}
+
+func (m *PoolHookData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Hook.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -865,6 +1206,10 @@ func (m *PositionData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SpreadRewardEligibilityTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpreadRewardEligibilityTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -900,6 +1245,37 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.SpreadFactorPoolIdMigrationThreshold))
 	}
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundPositionIds) > 0 {
+		l = 0
+		for _, e := range m.AutoCompoundPositionIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.AutoCompoundCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.AutoCompoundCursor))
+	}
+	if len(m.LiquiditySnapshots) > 0 {
+		for _, e := range m.LiquiditySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicSpreadFactors) > 0 {
+		for _, e := range m.DynamicSpreadFactors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LiquiditySnapshotCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.LiquiditySnapshotCursor))
+	}
 	return n
 }
 
@@ -1247,6 +1623,59 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, PoolHookData{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookFailurePolicy", wireType)
+			}
+			m.HookFailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookFailurePolicy |= types1.PoolHookFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1268,7 +1697,7 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionData) Unmarshal(dAtA []byte) error {
+func (m *PoolHookData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,15 +1720,134 @@ func (m *PositionData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionData: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolHookData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolHookData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &types1.PoolHookError{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1419,6 +1967,42 @@ func (m *PositionData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardEligibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpreadRewardEligibilityTime == nil {
+				m.SpreadRewardEligibilityTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SpreadRewardEligibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1646,6 +2230,222 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeOrders = append(m.RangeOrders, types1.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AutoCompoundPositionIds = append(m.AutoCompoundPositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AutoCompoundPositionIds) == 0 {
+					m.AutoCompoundPositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AutoCompoundPositionIds = append(m.AutoCompoundPositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundPositionIds", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCursor", wireType)
+			}
+			m.AutoCompoundCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquiditySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquiditySnapshots = append(m.LiquiditySnapshots, types1.LiquiditySnapshot{})
+			if err := m.LiquiditySnapshots[len(m.LiquiditySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactors = append(m.DynamicSpreadFactors, types1.DynamicSpreadFactor{})
+			if err := m.DynamicSpreadFactors[len(m.DynamicSpreadFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquiditySnapshotCursor", wireType)
+			}
+			m.LiquiditySnapshotCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquiditySnapshotCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,13 +3,22 @@ package genesis_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types/genesis"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	validPoolHook := genesis.PoolHookData{
+		Hook: types.PoolHook{
+			ActionPrefix:    types.BeforeActionPrefix(types.SwapExactAmountInPrefix),
+			ContractAddress: sdk.AccAddress([]byte("addr1---------------")).String(),
+		},
+	}
+
 	tests := []struct {
 		name           string
 		genesis        genesis.GenesisState
@@ -50,6 +59,108 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			exepectedError: true,
 		},
+		{
+			name: "auto-compounding positions",
+			genesis: *&genesis.GenesisState{
+				Params:                  genesis.DefaultGenesis().GetParams(),
+				PoolData:                genesis.DefaultGenesis().PoolData,
+				NextPositionId:          genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId:   genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				AutoCompoundPositionIds: []uint64{1, 2},
+				AutoCompoundCursor:      1,
+				LiquiditySnapshotCursor: 1,
+			},
+			exepectedError: false,
+		},
+		{
+			name: "duplicate auto-compounding position id",
+			genesis: *&genesis.GenesisState{
+				Params:                  genesis.DefaultGenesis().GetParams(),
+				PoolData:                genesis.DefaultGenesis().PoolData,
+				NextPositionId:          genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId:   genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				AutoCompoundPositionIds: []uint64{1, 1},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "duplicate liquidity snapshot",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              genesis.DefaultGenesis().PoolData,
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				LiquiditySnapshots: []types.LiquiditySnapshot{
+					{PoolId: 1, EpochNumber: 1, ActiveLiquidity: osmomath.OneDec()},
+					{PoolId: 1, EpochNumber: 1, ActiveLiquidity: osmomath.OneDec()},
+				},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "duplicate dynamic spread factor",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              genesis.DefaultGenesis().PoolData,
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				DynamicSpreadFactors: []types.DynamicSpreadFactor{
+					{
+						Record:             types.DynamicSpreadFactorRecord{PoolId: 1, MinSpreadFactor: osmomath.ZeroDec(), MaxSpreadFactor: osmomath.ZeroDec(), VolatilityMultiplier: osmomath.ZeroDec()},
+						StaticSpreadFactor: osmomath.ZeroDec(),
+						Volatility:         osmomath.ZeroDec(),
+						LastSqrtPrice:      osmomath.OneBigDec(),
+					},
+					{
+						Record:             types.DynamicSpreadFactorRecord{PoolId: 1, MinSpreadFactor: osmomath.ZeroDec(), MaxSpreadFactor: osmomath.ZeroDec(), VolatilityMultiplier: osmomath.ZeroDec()},
+						StaticSpreadFactor: osmomath.ZeroDec(),
+						Volatility:         osmomath.ZeroDec(),
+						LastSqrtPrice:      osmomath.OneBigDec(),
+					},
+				},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "pool hooks",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{Hooks: []genesis.PoolHookData{validPoolHook}, HookFailurePolicy: types.PoolHookFailurePolicyIgnore}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: false,
+		},
+		{
+			name: "invalid pool hook",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{Hooks: []genesis.PoolHookData{{Hook: types.PoolHook{ActionPrefix: "invalid", ContractAddress: validPoolHook.Hook.ContractAddress}}}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
+		{
+			name: "duplicate pool hook",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{Hooks: []genesis.PoolHookData{validPoolHook, validPoolHook}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
+		{
+			name: "invalid pool hook failure policy",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{HookFailurePolicy: types.PoolHookFailurePolicy(100)}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
 	}
 
 	for _, test := range tests {
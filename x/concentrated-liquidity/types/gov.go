@@ -9,21 +9,28 @@ import (
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeDynamicSpreadFactor             = "DynamicSpreadFactor"
+	ProposalTypePoolHooks                       = "PoolHooks"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeDynamicSpreadFactor)
+	govtypesv1.RegisterProposalType(ProposalTypePoolHooks)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &DynamicSpreadFactorProposal{}
+	_ govtypesv1.Content = &PoolHooksProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +162,155 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewDynamicSpreadFactorProposal(title, description string, records []DynamicSpreadFactorRecord, disabledPoolIds []uint64) govtypesv1.Content {
+	return &DynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Records:         records,
+		DisabledPoolIds: disabledPoolIds,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *DynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *DynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *DynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *DynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Records) == 0 && len(p.DisabledPoolIds) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := map[uint64]struct{}{}
+	for _, record := range p.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenPoolIds[record.PoolId]; ok {
+			return fmt.Errorf("duplicate pool id (%d)", record.PoolId)
+		}
+		seenPoolIds[record.PoolId] = struct{}{}
+	}
+	for _, poolId := range p.DisabledPoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id cannot be zero")
+		}
+		if _, ok := seenPoolIds[poolId]; ok {
+			return fmt.Errorf("duplicate pool id (%d)", poolId)
+		}
+		seenPoolIds[poolId] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the dynamic spread factor proposal.
+func (p DynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.Records {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityMultiplier: %s) ", record.PoolId, record.MinSpreadFactor, record.MaxSpreadFactor, record.VolatilityMultiplier)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Dynamic Spread Factor Proposal:
+Title:             %s
+Description:       %s
+Records:           %s
+Disabled Pool IDs: %v
+`, p.Title, p.Description, recordsStr, p.DisabledPoolIds))
+	return b.String()
+}
+
+func NewPoolHooksProposal(title, description string, poolId uint64, hooks []PoolHook, removedActionPrefixes []string, failurePolicy PoolHookFailurePolicy) govtypesv1.Content {
+	return &PoolHooksProposal{
+		Title:                 title,
+		Description:           description,
+		PoolId:                poolId,
+		Hooks:                 hooks,
+		RemovedActionPrefixes: removedActionPrefixes,
+		FailurePolicy:         failurePolicy,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *PoolHooksProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *PoolHooksProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *PoolHooksProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *PoolHooksProposal) ProposalType() string {
+	return ProposalTypePoolHooks
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *PoolHooksProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id cannot be zero")
+	}
+	if _, ok := PoolHookFailurePolicy_name[int32(p.FailurePolicy)]; !ok {
+		return fmt.Errorf("invalid pool hook failure policy (%d)", p.FailurePolicy)
+	}
+
+	validActionPrefixes := GetAllActionPrefixes()
+	seenActionPrefixes := map[string]struct{}{}
+	for _, hook := range p.Hooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenActionPrefixes[hook.ActionPrefix]; ok {
+			return fmt.Errorf("duplicate action prefix (%s)", hook.ActionPrefix)
+		}
+		seenActionPrefixes[hook.ActionPrefix] = struct{}{}
+	}
+	for _, actionPrefix := range p.RemovedActionPrefixes {
+		if !osmoutils.Contains(validActionPrefixes, actionPrefix) {
+			return InvalidActionPrefixError{ActionPrefix: actionPrefix, ValidActions: validActionPrefixes}
+		}
+		if _, ok := seenActionPrefixes[actionPrefix]; ok {
+			return fmt.Errorf("duplicate action prefix (%s)", actionPrefix)
+		}
+		seenActionPrefixes[actionPrefix] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the pool hooks proposal.
+func (p PoolHooksProposal) String() string {
+	hooksStr := ""
+	for _, hook := range p.Hooks {
+		hooksStr = hooksStr + fmt.Sprintf("(ActionPrefix: %s, ContractAddress: %s, GasLimit: %d) ", hook.ActionPrefix, hook.ContractAddress, hook.GasLimit)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Pool Hooks Proposal:
+Title:                   %s
+Description:             %s
+Pool ID:                 %d
+Hooks:                   %s
+Removed Action Prefixes: %v
+Failure Policy:          %s
+`, p.Title, p.Description, p.PoolId, hooksStr, p.RemovedActionPrefixes, p.FailurePolicy))
+	return b.String()
+}
@@ -163,6 +163,101 @@ func (m *PoolIdToTickSpacingRecord) GetNewTickSpacing() uint64 {
 	return 0
 }
 
+// DynamicSpreadFactorProposal is a gov Content type for enabling, updating or
+// disabling the dynamic spread factor of pools. The proposal will fail if one
+// of the pools does not exist, or if one of the pools to disable does not have
+// a dynamic spread factor.
+type DynamicSpreadFactorProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// records enable the dynamic spread factor of their pool, or update it if it
+	// is already enabled.
+	Records []DynamicSpreadFactorRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records" yaml:"records"`
+	// disabled_pool_ids are the pools whose dynamic spread factor is disabled,
+	// restoring their static spread factor.
+	DisabledPoolIds []uint64 `protobuf:"varint,4,rep,packed,name=disabled_pool_ids,json=disabledPoolIds,proto3" json:"disabled_pool_ids,omitempty" yaml:"disabled_pool_ids"`
+}
+
+func (m *DynamicSpreadFactorProposal) Reset()      { *m = DynamicSpreadFactorProposal{} }
+func (*DynamicSpreadFactorProposal) ProtoMessage() {}
+func (*DynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorProposal proto.InternalMessageInfo
+
+// PoolHooksProposal is a gov Content type for configuring the CosmWasm hooks
+// of a pool and their failure policy. The proposal will fail if the pool does
+// not exist.
+type PoolHooksProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId      uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// hooks are set on the pool, replacing the hooks of the same actions.
+	Hooks []PoolHook `protobuf:"bytes,4,rep,name=hooks,proto3" json:"hooks" yaml:"hooks"`
+	// removed_action_prefixes are the actions whose hooks are removed from the
+	// pool.
+	RemovedActionPrefixes []string `protobuf:"bytes,5,rep,name=removed_action_prefixes,json=removedActionPrefixes,proto3" json:"removed_action_prefixes,omitempty" yaml:"removed_action_prefixes"`
+	// failure_policy is set as the failure policy of every hook of the pool.
+	FailurePolicy PoolHookFailurePolicy `protobuf:"varint,6,opt,name=failure_policy,json=failurePolicy,proto3,enum=osmosis.concentratedliquidity.v1beta1.PoolHookFailurePolicy" json:"failure_policy,omitempty" yaml:"failure_policy"`
+}
+
+func (m *PoolHooksProposal) Reset()      { *m = PoolHooksProposal{} }
+func (*PoolHooksProposal) ProtoMessage() {}
+func (*PoolHooksProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
+}
+func (m *PoolHooksProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHooksProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHooksProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHooksProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHooksProposal.Merge(m, src)
+}
+func (m *PoolHooksProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHooksProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHooksProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHooksProposal proto.InternalMessageInfo
+
 type PoolRecord struct {
 	Denom0      string `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
 	Denom1      string `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
@@ -176,7 +271,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{5}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,6 +325,8 @@ func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
+	proto.RegisterType((*DynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorProposal")
+	proto.RegisterType((*PoolHooksProposal)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHooksProposal")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
 
@@ -238,45 +335,61 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x9a, 0xb4, 0xe2, 0x24, 0x15, 0x5d, 0x5b, 0x1a, 0x5b, 0xc8, 0x96, 0x05, 0x21, 0x82,
-	0xdd, 0x35, 0x15, 0x3c, 0xc4, 0x8b, 0xa6, 0x45, 0x28, 0x14, 0xac, 0x6b, 0x4f, 0x22, 0xac, 0x93,
-	0xd9, 0x67, 0x3a, 0x74, 0xb3, 0x6f, 0x3b, 0x33, 0xfd, 0x91, 0xbb, 0x07, 0x41, 0x04, 0x8f, 0x1e,
-	0xfb, 0xe7, 0xf4, 0xd8, 0xa3, 0x78, 0x08, 0xd2, 0x5c, 0xbc, 0x9a, 0xbf, 0x40, 0x76, 0x26, 0x3f,
-	0xfb, 0x03, 0x14, 0x6f, 0x3b, 0x33, 0xdf, 0xf7, 0xbe, 0xef, 0x7b, 0x6f, 0x79, 0xc4, 0x47, 0xd9,
-	0x46, 0xc9, 0xa5, 0xcf, 0x30, 0x61, 0x90, 0x28, 0x41, 0x15, 0x44, 0x31, 0xdf, 0x3f, 0xe0, 0x11,
-	0x57, 0x1d, 0xff, 0xb0, 0xd6, 0x04, 0x45, 0x6b, 0x7e, 0x0b, 0x0f, 0xbd, 0x54, 0xa0, 0x42, 0xfb,
-	0xc1, 0x80, 0xe0, 0x5d, 0x49, 0xf0, 0x06, 0x84, 0xa5, 0xf9, 0x16, 0xb6, 0x50, 0x33, 0xfc, 0xec,
-	0xcb, 0x90, 0xdd, 0x9e, 0x45, 0xaa, 0xeb, 0x02, 0xa8, 0x82, 0xf5, 0x09, 0xf6, 0xd6, 0x90, 0xbd,
-	0x8d, 0x18, 0xcb, 0x6d, 0x81, 0x29, 0x4a, 0x1a, 0xdb, 0xf3, 0x64, 0x46, 0x71, 0x15, 0x43, 0xd9,
-	0x5a, 0xb1, 0xaa, 0xb7, 0x02, 0x73, 0xb0, 0x57, 0x48, 0x31, 0x02, 0xc9, 0x04, 0x4f, 0x15, 0xc7,
-	0xa4, 0x7c, 0x43, 0xbf, 0x4d, 0x5e, 0xd9, 0xfb, 0xa4, 0x94, 0x22, 0xc6, 0xa1, 0x00, 0x86, 0x22,
-	0x92, 0xe5, 0xfc, 0x4a, 0xbe, 0x5a, 0x5c, 0xab, 0x79, 0x7f, 0x65, 0xdc, 0xcb, 0x3c, 0x04, 0x9a,
-	0xd9, 0x58, 0x3e, 0xed, 0x3a, 0xb9, 0x7e, 0xd7, 0xb9, 0xd7, 0xa1, 0xed, 0xb8, 0xee, 0x4e, 0x16,
-	0x75, 0x83, 0x62, 0x3a, 0x02, 0xca, 0x7a, 0xe9, 0xd3, 0x89, 0x93, 0xfb, 0x76, 0xe2, 0xe4, 0x7e,
-	0x9d, 0x38, 0x96, 0xfb, 0xdb, 0x22, 0xcb, 0x3b, 0x9c, 0xed, 0xbd, 0x49, 0x29, 0xe3, 0x49, 0x6b,
-	0x03, 0x98, 0x00, 0x2a, 0xe1, 0xbf, 0x83, 0x7d, 0xb6, 0x88, 0xa3, 0x4d, 0xf0, 0x28, 0x54, 0x18,
-	0x2a, 0xce, 0xf6, 0x42, 0x69, 0x34, 0x2e, 0x84, 0x7d, 0xfe, 0x0f, 0x61, 0x37, 0xa3, 0x1d, 0x9c,
-	0x70, 0x3b, 0xc8, 0x5e, 0xc8, 0xb2, 0x07, 0x4b, 0xe9, 0x75, 0x80, 0x8b, 0x99, 0x23, 0x72, 0xff,
-	0xda, 0x62, 0xf6, 0x22, 0xb9, 0x39, 0xf0, 0xad, 0x23, 0x17, 0x82, 0x59, 0x53, 0xd7, 0xae, 0x92,
-	0x3b, 0x09, 0x1c, 0x4d, 0x25, 0xd1, 0xc1, 0x0b, 0xc1, 0xed, 0x04, 0x8e, 0x26, 0x0a, 0xd5, 0x0b,
-	0x5a, 0xe5, 0x4b, 0x9e, 0x90, 0xf1, 0x80, 0xec, 0x87, 0x64, 0x36, 0x82, 0x04, 0xdb, 0x8f, 0x4d,
-	0x27, 0x1b, 0x77, 0xfb, 0x5d, 0x67, 0xce, 0x0c, 0xcb, 0xdc, 0xbb, 0xc1, 0x00, 0x30, 0x82, 0xd6,
-	0x4c, 0x63, 0x2f, 0x41, 0x6b, 0x43, 0x68, 0xcd, 0xae, 0x93, 0xd2, 0x94, 0xa1, 0x7c, 0x66, 0xa8,
-	0xb1, 0x38, 0xfe, 0x11, 0x26, 0x5f, 0xdd, 0xa0, 0xa8, 0xc6, 0x36, 0xed, 0x8f, 0x16, 0x59, 0x80,
-	0xe3, 0x14, 0x13, 0x48, 0x54, 0x48, 0x55, 0x98, 0x0a, 0xce, 0x20, 0xc4, 0x04, 0xca, 0x05, 0x2d,
-	0xfb, 0x3a, 0x6b, 0xeb, 0x8f, 0xae, 0xb3, 0xc0, 0xf4, 0x80, 0x64, 0xb4, 0xe7, 0x71, 0xf4, 0xdb,
-	0x54, 0xed, 0x7a, 0x9b, 0x89, 0xea, 0x77, 0x1d, 0xcf, 0x48, 0x5c, 0x59, 0xc3, 0x7d, 0x14, 0x41,
-	0x2a, 0x80, 0x65, 0xb3, 0xac, 0xbb, 0x4a, 0x1c, 0x80, 0x5b, 0xb6, 0x02, 0x7b, 0x88, 0x7d, 0xa1,
-	0xb6, 0x33, 0xe4, 0xab, 0x04, 0xec, 0xf7, 0x64, 0x4e, 0xa6, 0x02, 0x68, 0x14, 0x7e, 0xa0, 0x4c,
-	0xa1, 0x28, 0xcf, 0x68, 0xf5, 0x67, 0x03, 0xf5, 0xe5, 0xcb, 0xea, 0x5b, 0xd0, 0xa2, 0xac, 0xb3,
-	0x01, 0xac, 0xdf, 0x75, 0xe6, 0x8d, 0x87, 0xa9, 0x0a, 0x6e, 0x50, 0x32, 0xe7, 0x97, 0xfa, 0x68,
-	0xe6, 0xd1, 0x78, 0x77, 0x7a, 0x5e, 0xb1, 0xce, 0xce, 0x2b, 0xd6, 0xcf, 0xf3, 0x8a, 0xf5, 0xb5,
-	0x57, 0xc9, 0x9d, 0xf5, 0x2a, 0xb9, 0xef, 0xbd, 0x4a, 0xee, 0x6d, 0xa3, 0xc5, 0xd5, 0xee, 0x41,
-	0xd3, 0x63, 0xd8, 0x1e, 0xae, 0x98, 0xd5, 0x98, 0x36, 0xe5, 0x68, 0xdf, 0x1c, 0xae, 0x3d, 0xf5,
-	0x8f, 0xa7, 0xb6, 0xce, 0xea, 0x78, 0xed, 0xa8, 0x4e, 0x0a, 0xb2, 0x39, 0xab, 0x97, 0xc6, 0x93,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xa3, 0xf9, 0x03, 0xa4, 0x04, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x4e, 0xaa, 0x8e, 0x9d, 0x40, 0x96, 0x84, 0x6c, 0x13, 0xe4, 0xb5, 0x56, 0x42,
+	0x32, 0x82, 0xee, 0xe2, 0x20, 0xf5, 0x60, 0x38, 0x90, 0x6d, 0x54, 0xb5, 0x52, 0x25, 0xcc, 0xb6,
+	0xa7, 0x82, 0xb4, 0x8c, 0x67, 0x5f, 0x9c, 0x51, 0xd6, 0x3b, 0xdb, 0x99, 0x49, 0x1a, 0x5f, 0x38,
+	0x71, 0x40, 0x42, 0x48, 0x1c, 0x39, 0x86, 0x5f, 0xc2, 0xb5, 0xc7, 0x1e, 0x11, 0x87, 0x15, 0x4a,
+	0x2e, 0x5c, 0xf1, 0x2f, 0x40, 0x3b, 0xb3, 0x8e, 0xd7, 0xae, 0x23, 0x25, 0xcd, 0xcd, 0x9e, 0xf7,
+	0xbd, 0xef, 0xbd, 0xef, 0x7b, 0x6f, 0x76, 0x90, 0xc7, 0xc4, 0x90, 0x09, 0x2a, 0x3c, 0xc2, 0x12,
+	0x02, 0x89, 0xe4, 0x58, 0x42, 0x14, 0xd3, 0x97, 0xc7, 0x34, 0xa2, 0x72, 0xe4, 0x9d, 0x74, 0xfa,
+	0x20, 0x71, 0xc7, 0x1b, 0xb0, 0x13, 0x37, 0xe5, 0x4c, 0x32, 0xf3, 0xe3, 0x22, 0xc1, 0x5d, 0x98,
+	0xe0, 0x16, 0x09, 0xdb, 0x1b, 0x03, 0x36, 0x60, 0x2a, 0xc3, 0xcb, 0x7f, 0xe9, 0xe4, 0xed, 0xbd,
+	0xeb, 0x55, 0x8b, 0x46, 0x09, 0x1e, 0x52, 0x12, 0x8a, 0x94, 0x03, 0x8e, 0xc2, 0x03, 0x4c, 0x24,
+	0xe3, 0x05, 0xc5, 0x83, 0xeb, 0x51, 0xa4, 0x8c, 0xc5, 0xe1, 0x21, 0x63, 0x47, 0x42, 0xe7, 0x39,
+	0x17, 0x06, 0x6a, 0x3f, 0xe4, 0x80, 0x25, 0x3c, 0x2c, 0x25, 0x3e, 0x9d, 0x24, 0xf6, 0x18, 0x8b,
+	0x45, 0x8f, 0xb3, 0x94, 0x09, 0x1c, 0x9b, 0x1b, 0x68, 0x59, 0x52, 0x19, 0x83, 0x65, 0xb4, 0x8c,
+	0xf6, 0xdd, 0x40, 0xff, 0x31, 0x5b, 0xa8, 0x1e, 0x81, 0x20, 0x9c, 0xa6, 0x92, 0xb2, 0xc4, 0x5a,
+	0x52, 0xb1, 0xf2, 0x91, 0xf9, 0x12, 0x35, 0x54, 0x61, 0x0e, 0x84, 0xf1, 0x48, 0x58, 0xd5, 0x56,
+	0xb5, 0x5d, 0xdf, 0xed, 0xb8, 0xd7, 0xf2, 0xcc, 0xcd, 0x7b, 0x08, 0x54, 0xa6, 0xbf, 0xf3, 0x3a,
+	0xb3, 0x2b, 0xe3, 0xcc, 0xfe, 0x60, 0x84, 0x87, 0x71, 0xd7, 0x29, 0x93, 0x3a, 0x41, 0x3d, 0xbd,
+	0x04, 0x8a, 0x6e, 0xe3, 0xe7, 0x33, 0xbb, 0xf2, 0xfb, 0x99, 0x5d, 0xf9, 0xf7, 0xcc, 0x36, 0x9c,
+	0xff, 0x0c, 0xb4, 0xf3, 0x9c, 0x92, 0xa3, 0x67, 0x29, 0x26, 0x34, 0x19, 0xec, 0x03, 0xe1, 0x80,
+	0x05, 0xdc, 0x5a, 0xd8, 0x2f, 0x06, 0xb2, 0x55, 0x13, 0x34, 0x0a, 0x25, 0x0b, 0x25, 0x25, 0x47,
+	0xa1, 0xd0, 0x35, 0xe6, 0xc4, 0x7e, 0x7d, 0x03, 0xb1, 0x4f, 0xa2, 0xe7, 0xac, 0xd4, 0x6d, 0xa1,
+	0xbd, 0x96, 0x6b, 0x0f, 0xb6, 0xd3, 0xab, 0x00, 0xf3, 0x9a, 0x23, 0x74, 0xef, 0x4a, 0x32, 0x73,
+	0x0b, 0xdd, 0x29, 0xfa, 0x56, 0x92, 0x6b, 0xc1, 0x8a, 0xe6, 0x35, 0xdb, 0xe8, 0xfd, 0x04, 0x5e,
+	0xcd, 0x28, 0x51, 0xc2, 0x6b, 0xc1, 0x5a, 0x02, 0xaf, 0x4a, 0x44, 0xdd, 0x9a, 0xaa, 0xf2, 0xc7,
+	0x12, 0xda, 0xd9, 0xd7, 0x7b, 0xf9, 0x4c, 0xad, 0xe5, 0x23, 0xb5, 0x95, 0xb7, 0x76, 0x96, 0xa3,
+	0x3b, 0xef, 0x66, 0xe0, 0x82, 0x66, 0x0a, 0x03, 0x3f, 0x2c, 0x96, 0x67, 0x4d, 0x2f, 0xcf, 0xe5,
+	0xde, 0x4c, 0x0a, 0x99, 0x8f, 0xd1, 0x7a, 0x44, 0x05, 0xee, 0xc7, 0x10, 0x85, 0x85, 0x3b, 0xc2,
+	0xaa, 0xb5, 0xaa, 0xed, 0x9a, 0xff, 0xd1, 0x38, 0xb3, 0x2d, 0x9d, 0xf7, 0x16, 0xc4, 0x09, 0xde,
+	0x9b, 0x9c, 0x69, 0xc3, 0xe7, 0x27, 0xf1, 0x67, 0x15, 0xad, 0xe7, 0x91, 0xc7, 0xf9, 0xbd, 0xbb,
+	0xb5, 0x33, 0x9f, 0x4e, 0x47, 0x57, 0xcd, 0x07, 0xe3, 0x9b, 0x53, 0x4d, 0x45, 0xc0, 0xb9, 0x1c,
+	0xe7, 0x77, 0x68, 0x59, 0xdd, 0x76, 0x25, 0xa3, 0xbe, 0xeb, 0xdd, 0x60, 0x0b, 0xf3, 0x6e, 0xfd,
+	0x8d, 0xc2, 0xb3, 0x86, 0xe6, 0x57, 0x5c, 0x4e, 0xa0, 0x39, 0xcd, 0x17, 0x68, 0x8b, 0xc3, 0x90,
+	0x9d, 0x40, 0x14, 0x62, 0x92, 0xf7, 0x16, 0xa6, 0x1c, 0x0e, 0xe8, 0x29, 0x08, 0x6b, 0xb9, 0x55,
+	0x6d, 0xdf, 0xf5, 0x9d, 0x71, 0x66, 0x37, 0x27, 0x6e, 0x2f, 0x04, 0x3a, 0xc1, 0x66, 0x11, 0xd9,
+	0x53, 0x81, 0x5e, 0x71, 0x6e, 0xfe, 0x88, 0xd6, 0x0e, 0x30, 0x8d, 0x8f, 0x39, 0x84, 0x29, 0x8b,
+	0x29, 0x19, 0x59, 0x2b, 0x2d, 0xa3, 0xbd, 0xb6, 0xfb, 0xd5, 0x0d, 0x15, 0x3c, 0xd2, 0x24, 0x3d,
+	0xc5, 0xe1, 0xdf, 0x1b, 0x67, 0xf6, 0xa6, 0x6e, 0x68, 0x96, 0xdd, 0x09, 0x56, 0x0f, 0xca, 0xc8,
+	0xb9, 0x09, 0xfe, 0x5a, 0x45, 0x68, 0xfa, 0x19, 0x32, 0x3f, 0x41, 0x2b, 0x11, 0x24, 0x6c, 0xf8,
+	0xb9, 0x9e, 0x9d, 0xbf, 0x3e, 0xce, 0xec, 0xd5, 0x62, 0x3b, 0xd4, 0xb9, 0x13, 0x14, 0x80, 0x4b,
+	0x68, 0xc7, 0x5a, 0x5a, 0x08, 0xed, 0x4c, 0xa0, 0x1d, 0xb3, 0x8b, 0x1a, 0x33, 0xd7, 0x4e, 0x4f,
+	0x77, 0x6b, 0xfa, 0xb9, 0x2b, 0x47, 0x9d, 0xa0, 0x2e, 0xa7, 0x97, 0xd1, 0xfc, 0xc9, 0x40, 0x9b,
+	0x70, 0x9a, 0xb2, 0x04, 0x12, 0x19, 0x62, 0x19, 0xa6, 0x9c, 0x12, 0x08, 0x59, 0x02, 0x56, 0x4d,
+	0x95, 0xfd, 0x36, 0x9f, 0xe3, 0xdf, 0x99, 0xbd, 0x49, 0x94, 0x7d, 0x22, 0x3a, 0x72, 0x29, 0xf3,
+	0x86, 0x58, 0x1e, 0xba, 0x4f, 0x12, 0x39, 0xce, 0x6c, 0x57, 0x97, 0x58, 0xc8, 0xe1, 0x7c, 0x16,
+	0x41, 0xca, 0x81, 0xe4, 0x4e, 0x77, 0x1d, 0xc9, 0x8f, 0xc1, 0xb1, 0x8c, 0xc0, 0x9c, 0x60, 0xf7,
+	0x64, 0x2f, 0x47, 0x7e, 0x93, 0x80, 0xf9, 0x03, 0x5a, 0x9d, 0x79, 0x9c, 0xac, 0x65, 0x55, 0xfd,
+	0xcb, 0xa2, 0xfa, 0xce, 0xdb, 0xd5, 0x9f, 0xc2, 0x00, 0x93, 0xd1, 0x3e, 0x90, 0x71, 0x66, 0x6f,
+	0xe8, 0x1e, 0x66, 0x18, 0x9c, 0xa0, 0x21, 0x4a, 0x57, 0x59, 0x7f, 0x75, 0xfc, 0xef, 0x5f, 0x9f,
+	0x37, 0x8d, 0x37, 0xe7, 0x4d, 0xe3, 0x9f, 0xf3, 0xa6, 0xf1, 0xdb, 0x45, 0xb3, 0xf2, 0xe6, 0xa2,
+	0x59, 0xf9, 0xeb, 0xa2, 0x59, 0x79, 0xe1, 0x0f, 0xa8, 0x3c, 0x3c, 0xee, 0xbb, 0x84, 0x0d, 0x27,
+	0x6f, 0xf8, 0xfd, 0x18, 0xf7, 0xc5, 0xe4, 0x8f, 0x77, 0xb2, 0xfb, 0xc0, 0x3b, 0x9d, 0x79, 0x25,
+	0xef, 0x4f, 0x9f, 0x49, 0x39, 0x4a, 0x41, 0xf4, 0x57, 0xd4, 0xd3, 0xf8, 0xc5, 0xff, 0x03, 0x00,
+	0xa6, 0xf2, 0x23, 0x6c, 0x05, 0x08, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -376,6 +489,98 @@ func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(&that1.Records[i]) {
+			return false
+		}
+	}
+	if len(this.DisabledPoolIds) != len(that1.DisabledPoolIds) {
+		return false
+	}
+	for i := range this.DisabledPoolIds {
+		if this.DisabledPoolIds[i] != that1.DisabledPoolIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PoolHooksProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolHooksProposal)
+	if !ok {
+		that2, ok := that.(PoolHooksProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if len(this.Hooks) != len(that1.Hooks) {
+		return false
+	}
+	for i := range this.Hooks {
+		if !this.Hooks[i].Equal(&that1.Hooks[i]) {
+			return false
+		}
+	}
+	if len(this.RemovedActionPrefixes) != len(that1.RemovedActionPrefixes) {
+		return false
+	}
+	for i := range this.RemovedActionPrefixes {
+		if this.RemovedActionPrefixes[i] != that1.RemovedActionPrefixes[i] {
+			return false
+		}
+	}
+	if this.FailurePolicy != that1.FailurePolicy {
+		return false
+	}
+	return true
+}
 func (this *PoolRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -547,6 +752,145 @@ func (m *PoolIdToTickSpacingRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledPoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.DisabledPoolIds)*10)
+		var j1 int
+		for _, num := range m.DisabledPoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHooksProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHooksProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHooksProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailurePolicy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RemovedActionPrefixes) > 0 {
+		for iNdEx := len(m.RemovedActionPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedActionPrefixes[iNdEx])
+			copy(dAtA[i:], m.RemovedActionPrefixes[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.RemovedActionPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -681,51 +1025,116 @@ func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	return n
 }
 
-func (m *PoolRecord) Size() (n int) {
+func (m *DynamicSpreadFactorProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom0)
+	l = len(m.Title)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	l = len(m.Denom1)
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	if m.TickSpacing != 0 {
-		n += 1 + sovGov(uint64(m.TickSpacing))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.DisabledPoolIds) > 0 {
+		l = 0
+		for _, e := range m.DisabledPoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
 	}
-	l = m.ExponentAtPriceOne.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.SpreadFactor.Size()
-	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+func (m *PoolHooksProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.RemovedActionPrefixes) > 0 {
+		for _, s := range m.RemovedActionPrefixes {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.FailurePolicy != 0 {
+		n += 1 + sovGov(uint64(m.FailurePolicy))
+	}
+	return n
+}
+
+func (m *PoolRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovGov(uint64(m.TickSpacing))
+	}
+	l = m.ExponentAtPriceOne.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateConcentratedLiquidityPoolsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -1095,6 +1504,448 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DynamicSpreadFactorRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DisabledPoolIds) == 0 {
+					m.DisabledPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledPoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolHooksProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolHooksProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolHooksProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, PoolHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedActionPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedActionPrefixes = append(m.RemovedActionPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= PoolHookFailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
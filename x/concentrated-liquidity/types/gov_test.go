@@ -190,3 +190,171 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestDynamicSpreadFactorProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.DynamicSpreadFactorRecord{
+		PoolId:               1,
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: osmomath.NewDec(10),
+	}
+
+	withRecord := func(modify func(*types.DynamicSpreadFactorRecord)) []types.DynamicSpreadFactorRecord {
+		record := baseRecord
+		modify(&record)
+		return []types.DynamicSpreadFactorRecord{record}
+	}
+
+	tests := []struct {
+		name            string
+		records         []types.DynamicSpreadFactorRecord
+		disabledPoolIds []uint64
+		expectPass      bool
+	}{
+		{
+			name:            "proper msg",
+			records:         []types.DynamicSpreadFactorRecord{baseRecord},
+			disabledPoolIds: []uint64{2},
+			expectPass:      true,
+		},
+		{
+			name:            "only disabled pools",
+			disabledPoolIds: []uint64{2},
+			expectPass:      true,
+		},
+		{
+			name:       "empty proposal",
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			records:    withRecord(func(r *types.DynamicSpreadFactorRecord) { r.PoolId = 0 }),
+			expectPass: false,
+		},
+		{
+			name:       "min spread factor greater than max",
+			records:    withRecord(func(r *types.DynamicSpreadFactorRecord) { r.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02") }),
+			expectPass: false,
+		},
+		{
+			name:       "max spread factor of one",
+			records:    withRecord(func(r *types.DynamicSpreadFactorRecord) { r.MaxSpreadFactor = osmomath.OneDec() }),
+			expectPass: false,
+		},
+		{
+			name:       "negative volatility multiplier",
+			records:    withRecord(func(r *types.DynamicSpreadFactorRecord) { r.VolatilityMultiplier = osmomath.NewDec(-1) }),
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			records:    []types.DynamicSpreadFactorRecord{baseRecord, baseRecord},
+			expectPass: false,
+		},
+		{
+			name:            "pool both set and disabled",
+			records:         []types.DynamicSpreadFactorRecord{baseRecord},
+			disabledPoolIds: []uint64{1},
+			expectPass:      false,
+		},
+		{
+			name:            "zero disabled pool id",
+			disabledPoolIds: []uint64{0},
+			expectPass:      false,
+		},
+	}
+
+	for _, test := range tests {
+		proposal := types.NewDynamicSpreadFactorProposal("title", "description", test.records, test.disabledPoolIds)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestPoolHooksProposal_ValidateBasic(t *testing.T) {
+	contractAddress := "osmo14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9"
+	baseHook := types.PoolHook{
+		ActionPrefix:    types.BeforeActionPrefix(types.SwapExactAmountInPrefix),
+		ContractAddress: contractAddress,
+		GasLimit:        500_000,
+	}
+
+	tests := []struct {
+		name                  string
+		poolId                uint64
+		hooks                 []types.PoolHook
+		removedActionPrefixes []string
+		failurePolicy         types.PoolHookFailurePolicy
+		expectPass            bool
+	}{
+		{
+			name:                  "proper msg",
+			poolId:                1,
+			hooks:                 []types.PoolHook{baseHook},
+			removedActionPrefixes: []string{types.AfterActionPrefix(types.CreateIncentivePrefix)},
+			failurePolicy:         types.PoolHookFailurePolicyIgnore,
+			expectPass:            true,
+		},
+		{
+			name:       "only failure policy",
+			poolId:     1,
+			expectPass: true,
+		},
+		{
+			name:       "zero pool id",
+			hooks:      []types.PoolHook{baseHook},
+			expectPass: false,
+		},
+		{
+			name:          "invalid failure policy",
+			poolId:        1,
+			failurePolicy: types.PoolHookFailurePolicy(2),
+			expectPass:    false,
+		},
+		{
+			name:       "invalid action prefix",
+			poolId:     1,
+			hooks:      []types.PoolHook{{ActionPrefix: "beforeSwap", ContractAddress: contractAddress}},
+			expectPass: false,
+		},
+		{
+			name:       "invalid contract address",
+			poolId:     1,
+			hooks:      []types.PoolHook{{ActionPrefix: baseHook.ActionPrefix, ContractAddress: "invalid"}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate action prefix",
+			poolId:     1,
+			hooks:      []types.PoolHook{baseHook, baseHook},
+			expectPass: false,
+		},
+		{
+			name:                  "action both set and removed",
+			poolId:                1,
+			hooks:                 []types.PoolHook{baseHook},
+			removedActionPrefixes: []string{baseHook.ActionPrefix},
+			expectPass:            false,
+		},
+		{
+			name:                  "invalid removed action prefix",
+			poolId:                1,
+			removedActionPrefixes: []string{"afterSwap"},
+			expectPass:            false,
+		},
+	}
+
+	for _, test := range tests {
+		proposal := types.NewPoolHooksProposal("title", "description", test.poolId, test.hooks, test.removedActionPrefixes, test.failurePolicy)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -53,6 +55,25 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	RangeOrderPrefix         = []byte{0x17}
+	RangeOrderOwnerPrefix    = []byte{0x18}
+	RangeOrderPoolPrefix     = []byte{0x19}
+	OpenRangeOrderTickPrefix = []byte{0x1A}
+
+	AutoCompoundPositionPrefix = []byte{0x1B}
+	KeyAutoCompoundCursor      = []byte{0x1C}
+	LiquiditySnapshotPrefix    = []byte{0x1D}
+	DynamicSpreadFactorPrefix  = []byte{0x1E}
+
+	PoolHookFailurePolicyPrefix = []byte{0x1F}
+	PoolHookLastErrorPrefix     = []byte{0x20}
+
+	RangeOrderSettlementQueuePrefix = []byte{0x21}
+
+	KeyLiquiditySnapshotCursor = []byte{0x22}
+
+	PositionSpreadRewardEligibilityTimePrefix = []byte{0x23}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -314,3 +335,95 @@ func GetDenomPrefix(denom string) []byte {
 func GetPoolPrefixStoreKey(poolID uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d%s", KeyContractHookPrefix, poolID, KeySeparator))
 }
+
+// KeyPoolHookFailurePolicy returns the key of the hook failure policy of the given pool.
+func KeyPoolHookFailurePolicy(poolId uint64) []byte {
+	return append(bytes.Clone(PoolHookFailurePolicyPrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPoolHookLastError returns the key of the last ignored error of the hook of the given pool and action.
+func KeyPoolHookLastError(poolId uint64, actionPrefix string) []byte {
+	return append(append(bytes.Clone(PoolHookLastErrorPrefix), sdk.Uint64ToBigEndian(poolId)...), []byte(actionPrefix)...)
+}
+
+// Range Order Prefix Keys
+
+// KeyRangeOrder returns the key used to store the range order with the given id.
+func KeyRangeOrder(orderId uint64) []byte {
+	return append(bytes.Clone(RangeOrderPrefix), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyRangeOrderOwnerPrefix returns the prefix key used to iterate over the range order ids of the given owner.
+func KeyRangeOrderOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(bytes.Clone(RangeOrderOwnerPrefix), address.MustLengthPrefix(owner)...)
+}
+
+// KeyRangeOrderOwnerPoolPrefix returns the prefix key used to iterate over the range order ids of the given owner in the given pool.
+func KeyRangeOrderOwnerPoolPrefix(owner sdk.AccAddress, poolId uint64) []byte {
+	return append(KeyRangeOrderOwnerPrefix(owner), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyRangeOrderOwner returns the key linking the given owner and pool to the given range order id.
+func KeyRangeOrderOwner(owner sdk.AccAddress, poolId uint64, orderId uint64) []byte {
+	return append(KeyRangeOrderOwnerPoolPrefix(owner, poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyRangeOrderPoolPrefix returns the prefix key used to iterate over the range order ids of the given pool.
+func KeyRangeOrderPoolPrefix(poolId uint64) []byte {
+	return append(bytes.Clone(RangeOrderPoolPrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyRangeOrderPool returns the key linking the given pool to the given range order id.
+func KeyRangeOrderPool(poolId uint64, orderId uint64) []byte {
+	return append(KeyRangeOrderPoolPrefix(poolId), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyOpenRangeOrderTickPrefix returns the prefix key used to iterate over the open range orders of the given pool
+// selling the given token, ordered by the tick the current tick must cross for them to fill.
+func KeyOpenRangeOrderTickPrefix(poolId uint64, sellsToken0 bool) []byte {
+	key := append(bytes.Clone(OpenRangeOrderTickPrefix), sdk.Uint64ToBigEndian(poolId)...)
+	if sellsToken0 {
+		return append(key, 0)
+	}
+	return append(key, 1)
+}
+
+// KeyOpenRangeOrderTick returns the key linking the given fill tick of an open range order to its id.
+func KeyOpenRangeOrderTick(poolId uint64, sellsToken0 bool, fillTick int64, orderId uint64) []byte {
+	key := append(KeyOpenRangeOrderTickPrefix(poolId, sellsToken0), TickIndexToBytes(fillTick)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyRangeOrderSettlement returns the key recording the given range order of the given pool as crossed by a swap
+// and queued for settlement at the end of the block.
+func KeyRangeOrderSettlement(poolId uint64, orderId uint64) []byte {
+	key := append(bytes.Clone(RangeOrderSettlementQueuePrefix), sdk.Uint64ToBigEndian(poolId)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// Auto-Compound Prefix Keys
+
+// KeyAutoCompoundPosition returns the key flagging the position with the given id for auto-compounding.
+func KeyAutoCompoundPosition(positionId uint64) []byte {
+	return append(bytes.Clone(AutoCompoundPositionPrefix), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyPositionSpreadRewardEligibilityTime returns the key of the spread reward eligibility time of the position with the given id.
+func KeyPositionSpreadRewardEligibilityTime(positionId uint64) []byte {
+	return append(bytes.Clone(PositionSpreadRewardEligibilityTimePrefix), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyLiquiditySnapshotPoolPrefix returns the prefix of the liquidity snapshots of the given pool.
+func KeyLiquiditySnapshotPoolPrefix(poolId uint64) []byte {
+	return append(bytes.Clone(LiquiditySnapshotPrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyLiquiditySnapshot returns the key of the liquidity snapshot of the given pool taken at the given epoch.
+func KeyLiquiditySnapshot(poolId uint64, epochNumber uint64) []byte {
+	return append(KeyLiquiditySnapshotPoolPrefix(poolId), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// KeyDynamicSpreadFactor returns the key of the dynamic spread factor of the given pool.
+func KeyDynamicSpreadFactor(poolId uint64) []byte {
+	return append(bytes.Clone(DynamicSpreadFactorPrefix), sdk.Uint64ToBigEndian(poolId)...)
+}
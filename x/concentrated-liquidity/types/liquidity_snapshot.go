@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	// LiquiditySnapshotEpochIdentifier is the identifier of the epochs at the end of which
+	// the liquidity of every pool is snapshotted.
+	LiquiditySnapshotEpochIdentifier = "day"
+
+	// MaxLiquiditySnapshotsRetained is the number of most recent liquidity snapshot epochs
+	// whose snapshots are kept in state. Older snapshots are pruned when a new one is taken.
+	MaxLiquiditySnapshotsRetained uint64 = 30
+
+	// LiquiditySnapshotGasBudget is the gas that snapshotting pools may consume at the end of a liquidity
+	// snapshot epoch. Snapshotting a pool walks its ticks within the widest liquidity depth price change,
+	// so this budget snapshots in the order of a few hundred pools per epoch.
+	LiquiditySnapshotGasBudget uint64 = 100_000_000
+
+	// MaxRangeAprTwapDuration is the maximum duration of the twaps that price the tokens of the RangeApr query.
+	// It is shorter than the history kept by the twap module, so that the twaps of the lookback can be computed.
+	MaxRangeAprTwapDuration = 24 * time.Hour
+
+	// MaxRangeAprPricePools is the maximum number of price pools of the RangeApr query.
+	// Each of them may cost a twap lookup per denom to price.
+	MaxRangeAprPricePools = 5
+)
+
+// LiquidityDepthPriceChanges are the fractions of the spot price, in increasing order,
+// that the liquidity depth of a snapshot is measured over.
+var LiquidityDepthPriceChanges = []osmomath.Dec{
+	osmomath.MustNewDecFromStr("0.01"), // 1%
+	osmomath.MustNewDecFromStr("0.02"), // 2%
+	osmomath.MustNewDecFromStr("0.05"), // 5%
+	osmomath.MustNewDecFromStr("0.1"),  // 10%
+}
+
+// Validate performs basic validation of a liquidity snapshot.
+func (s LiquiditySnapshot) Validate() error {
+	if s.PoolId == 0 {
+		return fmt.Errorf("liquidity snapshot has a zero pool id")
+	}
+	if s.ActiveLiquidity.IsNil() || s.ActiveLiquidity.IsNegative() {
+		return fmt.Errorf("liquidity snapshot of pool (%d) at epoch (%d) has invalid active liquidity (%s)", s.PoolId, s.EpochNumber, s.ActiveLiquidity)
+	}
+	if !s.SpreadRewardGrowthGlobal.IsValid() || !s.UptimeGrowthGlobal.IsValid() {
+		return fmt.Errorf("liquidity snapshot of pool (%d) at epoch (%d) has invalid growth", s.PoolId, s.EpochNumber)
+	}
+	for _, depth := range s.Depths {
+		if depth.Amount0.IsNil() || depth.Amount0.IsNegative() || depth.Amount1.IsNil() || depth.Amount1.IsNegative() {
+			return fmt.Errorf("liquidity snapshot of pool (%d) at epoch (%d) has a negative depth", s.PoolId, s.EpochNumber)
+		}
+	}
+	return nil
+}
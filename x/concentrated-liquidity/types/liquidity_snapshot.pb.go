@@ -0,0 +1,1024 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/liquidity_snapshot.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidityDepth is the amount of tokens held by a pool between its spot price
+// and the prices a given fraction away from it.
+type LiquidityDepth struct {
+	// price_change is the fraction of the spot price the depth is measured
+	// over, e.g. 0.02 for a depth within 2% of the spot price.
+	PriceChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price_change,json=priceChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_change" yaml:"price_change"`
+	// amount0 is the amount of token0 held between the spot price and the spot
+	// price increased by price_change. It is the amount of token0 that can be
+	// bought before the price rises by price_change.
+	Amount0 cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	// amount1 is the amount of token1 held between the spot price decreased by
+	// price_change and the spot price. It is the amount of token1 that can be
+	// bought before the price falls by price_change.
+	Amount1 cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+}
+
+func (m *LiquidityDepth) Reset()         { *m = LiquidityDepth{} }
+func (m *LiquidityDepth) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepth) ProtoMessage()    {}
+func (*LiquidityDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_813cccd22a58b885, []int{0}
+}
+func (m *LiquidityDepth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDepth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepth.Merge(m, src)
+}
+func (m *LiquidityDepth) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityDepth proto.InternalMessageInfo
+
+// LiquiditySnapshot is the liquidity of a pool recorded at the end of a
+// liquidity snapshot epoch.
+type LiquiditySnapshot struct {
+	PoolId           uint64                                          `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	EpochNumber      uint64                                          `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	Time             time.Time                                       `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	CurrentTick      int64                                           `protobuf:"varint,4,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	CurrentSqrtPrice github_com_osmosis_labs_osmosis_osmomath.BigDec `protobuf:"bytes,5,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/osmosis-labs/osmosis/osmomath.BigDec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// active_liquidity is the liquidity in range at the current tick.
+	ActiveLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=active_liquidity,json=activeLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"active_liquidity" yaml:"active_liquidity"`
+	// depths are the cumulative liquidity depths around the spot price, in
+	// increasing order of price change.
+	Depths []LiquidityDepth `protobuf:"bytes,7,rep,name=depths,proto3" json:"depths" yaml:"depths"`
+	// spread_reward_growth_global is the value of the spread reward accumulator
+	// of the pool.
+	SpreadRewardGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=spread_reward_growth_global,json=spreadRewardGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"spread_reward_growth_global" yaml:"spread_reward_growth_global"`
+	// uptime_growth_global is the sum of the values of the uptime accumulators
+	// of the pool, updated to the time of the snapshot.
+	UptimeGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=uptime_growth_global,json=uptimeGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"uptime_growth_global" yaml:"uptime_growth_global"`
+}
+
+func (m *LiquiditySnapshot) Reset()         { *m = LiquiditySnapshot{} }
+func (m *LiquiditySnapshot) String() string { return proto.CompactTextString(m) }
+func (*LiquiditySnapshot) ProtoMessage()    {}
+func (*LiquiditySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_813cccd22a58b885, []int{1}
+}
+func (m *LiquiditySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquiditySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquiditySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquiditySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquiditySnapshot.Merge(m, src)
+}
+func (m *LiquiditySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquiditySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquiditySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquiditySnapshot proto.InternalMessageInfo
+
+func (m *LiquiditySnapshot) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LiquiditySnapshot) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *LiquiditySnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *LiquiditySnapshot) GetCurrentTick() int64 {
+	if m != nil {
+		return m.CurrentTick
+	}
+	return 0
+}
+
+func (m *LiquiditySnapshot) GetDepths() []LiquidityDepth {
+	if m != nil {
+		return m.Depths
+	}
+	return nil
+}
+
+func (m *LiquiditySnapshot) GetSpreadRewardGrowthGlobal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.SpreadRewardGrowthGlobal
+	}
+	return nil
+}
+
+func (m *LiquiditySnapshot) GetUptimeGrowthGlobal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UptimeGrowthGlobal
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LiquidityDepth)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepth")
+	proto.RegisterType((*LiquiditySnapshot)(nil), "osmosis.concentratedliquidity.v1beta1.LiquiditySnapshot")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/liquidity_snapshot.proto", fileDescriptor_813cccd22a58b885)
+}
+
+var fileDescriptor_813cccd22a58b885 = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc7, 0x63, 0xc8, 0x97, 0x7c, 0x38, 0x7c, 0x7c, 0x74, 0x0b, 0xc2, 0x85, 0x2a, 0x46, 0x2b,
+	0x55, 0x42, 0x42, 0xd8, 0x0d, 0x55, 0x7b, 0xe0, 0xc0, 0xc1, 0x20, 0x21, 0x24, 0x54, 0x55, 0x86,
+	0x4b, 0xab, 0x56, 0xd6, 0x7a, 0xbd, 0x75, 0x56, 0x89, 0xbd, 0xc6, 0xbb, 0x81, 0xe6, 0x5a, 0xa9,
+	0x77, 0x9e, 0xa1, 0xc7, 0xde, 0xdb, 0x67, 0xe0, 0xc8, 0xb1, 0xea, 0x21, 0x54, 0xf0, 0x06, 0x3c,
+	0x41, 0xe5, 0xdd, 0x8d, 0x13, 0x28, 0x6a, 0x69, 0x4f, 0xf6, 0xec, 0x78, 0x7e, 0x33, 0x3b, 0xff,
+	0x19, 0x9b, 0x9b, 0x8c, 0x27, 0x8c, 0x53, 0xee, 0x62, 0x96, 0x62, 0x92, 0x8a, 0x1c, 0x09, 0x12,
+	0x75, 0xe9, 0x61, 0x8f, 0x46, 0x54, 0xf4, 0xdd, 0xa3, 0x56, 0x48, 0x04, 0x6a, 0xb9, 0xe5, 0x49,
+	0xc0, 0x53, 0x94, 0xf1, 0x36, 0x13, 0x4e, 0x96, 0x33, 0xc1, 0xc0, 0x23, 0x1d, 0xef, 0xdc, 0x1a,
+	0xef, 0xe8, 0xf8, 0xc5, 0xb9, 0x98, 0xc5, 0x4c, 0x46, 0xb8, 0xc5, 0x9b, 0x0a, 0x5e, 0xb4, 0x63,
+	0xc6, 0xe2, 0x2e, 0x71, 0xa5, 0x15, 0xf6, 0xde, 0xba, 0x82, 0x26, 0x84, 0x0b, 0x94, 0x64, 0xfa,
+	0x83, 0x26, 0x96, 0x78, 0x37, 0x44, 0x9c, 0x94, 0xb5, 0x60, 0x46, 0x53, 0xe5, 0x87, 0x1f, 0x26,
+	0xcc, 0x99, 0xbd, 0x61, 0xb2, 0x6d, 0x92, 0x89, 0x36, 0x78, 0x63, 0x4e, 0x67, 0x39, 0xc5, 0x24,
+	0xc0, 0x6d, 0x94, 0xc6, 0xc4, 0x32, 0x96, 0x8d, 0x95, 0x29, 0x6f, 0xe3, 0x74, 0x60, 0x57, 0xbe,
+	0x0d, 0xec, 0x25, 0x05, 0xe4, 0x51, 0xc7, 0xa1, 0xcc, 0x4d, 0x90, 0x68, 0x3b, 0x7b, 0x24, 0x46,
+	0xb8, 0xbf, 0x4d, 0xf0, 0xd5, 0xc0, 0xbe, 0xdf, 0x47, 0x49, 0x77, 0x03, 0x8e, 0x03, 0xa0, 0xdf,
+	0x90, 0xe6, 0x96, 0xb4, 0xc0, 0xae, 0x59, 0x47, 0x09, 0xeb, 0xa5, 0xe2, 0xb1, 0x35, 0x21, 0xc9,
+	0xae, 0x26, 0xcf, 0xff, 0x4c, 0xde, 0x4d, 0xc5, 0xd5, 0xc0, 0x9e, 0x51, 0x4c, 0x1d, 0x05, 0xfd,
+	0x61, 0xfc, 0x08, 0xd5, 0xb2, 0x26, 0xff, 0x02, 0xd5, 0x2a, 0x51, 0x2d, 0xf8, 0xb9, 0x6e, 0xde,
+	0x2b, 0xfb, 0xb0, 0xaf, 0x15, 0x02, 0xab, 0x66, 0x3d, 0x63, 0xac, 0x1b, 0xd0, 0x48, 0x76, 0xa1,
+	0xea, 0x81, 0x11, 0x43, 0x3b, 0xa0, 0x5f, 0x2b, 0xde, 0x76, 0x23, 0xb0, 0x61, 0x4e, 0x93, 0x8c,
+	0xe1, 0x76, 0x90, 0xf6, 0x92, 0x90, 0xe4, 0xf2, 0x76, 0x55, 0x6f, 0x61, 0xd4, 0x94, 0x71, 0x2f,
+	0xf4, 0x1b, 0xd2, 0x7c, 0x2e, 0x2d, 0xb0, 0x63, 0x56, 0x0b, 0xe5, 0xe4, 0x35, 0x1a, 0xeb, 0x8b,
+	0x8e, 0x92, 0xd5, 0x19, 0xca, 0xea, 0x1c, 0x0c, 0x65, 0xf5, 0x16, 0x8a, 0x2b, 0x5e, 0x0d, 0xec,
+	0x86, 0x62, 0x16, 0x51, 0xf0, 0xe4, 0xdc, 0x36, 0x7c, 0x09, 0x28, 0x8a, 0xc0, 0xbd, 0x3c, 0x27,
+	0xa9, 0x08, 0x04, 0xc5, 0x1d, 0xab, 0xba, 0x6c, 0xac, 0x4c, 0x8e, 0x17, 0x31, 0xee, 0x85, 0x7e,
+	0x43, 0x9b, 0x07, 0x14, 0x77, 0xc0, 0x7b, 0xc3, 0x04, 0x43, 0x37, 0x3f, 0xcc, 0x45, 0x20, 0x65,
+	0xb3, 0xfe, 0x91, 0xad, 0x3d, 0xd0, 0xad, 0x75, 0x63, 0x2a, 0xda, 0xbd, 0xd0, 0xc1, 0x2c, 0x71,
+	0xf5, 0xe4, 0xae, 0x75, 0x51, 0xc8, 0x87, 0x86, 0x7c, 0xca, 0x8e, 0x7b, 0x34, 0x56, 0x33, 0xf1,
+	0xe0, 0x7a, 0xe6, 0x11, 0x1a, 0xfa, 0xb3, 0xfa, 0x70, 0xff, 0x30, 0x17, 0x2f, 0x8a, 0x23, 0x40,
+	0xcd, 0x59, 0x84, 0x05, 0x3d, 0x22, 0x41, 0xb9, 0x03, 0x56, 0x4d, 0x56, 0xb0, 0x79, 0xb7, 0x09,
+	0x5c, 0xd0, 0x12, 0xdf, 0x80, 0x40, 0xff, 0x7f, 0x75, 0x54, 0xaa, 0x0c, 0x22, 0xb3, 0x16, 0x15,
+	0x13, 0xcf, 0xad, 0xfa, 0xf2, 0xe4, 0x4a, 0x63, 0xfd, 0xa9, 0x73, 0xa7, 0x55, 0x74, 0xae, 0xef,
+	0x8b, 0x37, 0xaf, 0x15, 0xf9, 0x4f, 0x25, 0x56, 0x48, 0xe8, 0x6b, 0x36, 0xf8, 0x62, 0x98, 0x4b,
+	0x3c, 0xcb, 0x09, 0x8a, 0x82, 0x9c, 0x1c, 0xa3, 0x3c, 0x0a, 0xe2, 0x9c, 0x1d, 0x8b, 0x76, 0x10,
+	0x77, 0x59, 0x88, 0xba, 0xd6, 0xbf, 0x32, 0xf7, 0x43, 0x47, 0xdd, 0xca, 0x29, 0x16, 0xb5, 0xcc,
+	0xb4, 0x4d, 0xf0, 0x16, 0xa3, 0xa9, 0xf7, 0x52, 0xa7, 0x80, 0x2a, 0xc5, 0x2f, 0x70, 0xf0, 0xd3,
+	0xb9, 0xbd, 0x3a, 0x26, 0x91, 0x5e, 0x7f, 0xf5, 0x58, 0xe3, 0x51, 0xc7, 0x15, 0xfd, 0x8c, 0xf0,
+	0x21, 0x99, 0xfb, 0x96, 0x82, 0xf9, 0x92, 0xb5, 0x23, 0x51, 0x3b, 0x92, 0x04, 0x3e, 0x1a, 0xe6,
+	0x5c, 0x2f, 0x2b, 0xa6, 0xea, 0x46, 0xc5, 0x53, 0x77, 0xa8, 0xd8, 0xd7, 0x15, 0x2f, 0xa9, 0x8a,
+	0x6f, 0xe3, 0xfc, 0x71, 0xa9, 0x40, 0x51, 0xc6, 0x8b, 0xf4, 0x5e, 0x9f, 0x5e, 0x34, 0x8d, 0xb3,
+	0x8b, 0xa6, 0xf1, 0xfd, 0xa2, 0x69, 0x9c, 0x5c, 0x36, 0x2b, 0x67, 0x97, 0xcd, 0xca, 0xd7, 0xcb,
+	0x66, 0xe5, 0x95, 0xf7, 0xbb, 0x41, 0x3d, 0x5a, 0x7f, 0xe6, 0xbe, 0xbb, 0xf6, 0xd7, 0x5e, 0x1b,
+	0xfd, 0xb6, 0x65, 0xea, 0xb0, 0x26, 0x17, 0xf0, 0xc9, 0x8f, 0x01, 0x00, 0x4c, 0x79, 0x5e, 0x25,
+	0xe4, 0x05, 0x00, 0x00,
+}
+
+func (m *LiquidityDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PriceChange.Size()
+		i -= size
+		if _, err := m.PriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquiditySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquiditySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquiditySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Depths) > 0 {
+		for iNdEx := len(m.Depths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Depths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.ActiveLiquidity.Size()
+		i -= size
+		if _, err := m.ActiveLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CurrentTick != 0 {
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquiditySnapshot(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquiditySnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquiditySnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidityDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceChange.Size()
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	l = m.Amount0.Size()
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	return n
+}
+
+func (m *LiquiditySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquiditySnapshot(uint64(m.PoolId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquiditySnapshot(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovLiquiditySnapshot(uint64(m.CurrentTick))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	l = m.ActiveLiquidity.Size()
+	n += 1 + l + sovLiquiditySnapshot(uint64(l))
+	if len(m.Depths) > 0 {
+		for _, e := range m.Depths {
+			l = e.Size()
+			n += 1 + l + sovLiquiditySnapshot(uint64(l))
+		}
+	}
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovLiquiditySnapshot(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovLiquiditySnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLiquiditySnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquiditySnapshot(x uint64) (n int) {
+	return sovLiquiditySnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidityDepth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquiditySnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquiditySnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquiditySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquiditySnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquiditySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquiditySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depths = append(m.Depths, LiquidityDepth{})
+			if err := m.Depths[len(m.Depths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, types.DecCoin{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquiditySnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquiditySnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquiditySnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquiditySnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquiditySnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquiditySnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquiditySnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquiditySnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquiditySnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquiditySnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquiditySnapshot = fmt.Errorf("proto: unexpected end of group")
+)
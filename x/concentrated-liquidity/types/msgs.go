@@ -19,6 +19,14 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceRangeOrder         = "place-range-order"
+	TypeMsgClaimRangeOrder         = "claim-range-order"
+	TypeMsgCancelRangeOrder        = "cancel-range-order"
+	TypeMsgRebalancePosition       = "rebalance-position"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
+	TypeMsgMintPositionToken       = "mint-position-token"
+	TypeMsgRedeemPositionToken     = "redeem-position-token"
+	TypeMsgBatchPositionOps        = "batch-position-ops"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -239,3 +247,232 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceRangeOrder{}
+
+func (msg MsgPlaceRangeOrder) Route() string { return RouterKey }
+func (msg MsgPlaceRangeOrder) Type() string  { return TypeMsgPlaceRangeOrder }
+func (msg MsgPlaceRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("Invalid pool id (%d)", msg.PoolId)
+	}
+
+	if !msg.TokenIn.IsValid() {
+		return fmt.Errorf("Invalid coin (%s)", msg.TokenIn.String())
+	}
+
+	if !msg.TokenIn.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimRangeOrder{}
+
+func (msg MsgClaimRangeOrder) Route() string { return RouterKey }
+func (msg MsgClaimRangeOrder) Type() string  { return TypeMsgClaimRangeOrder }
+func (msg MsgClaimRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid order id (%d)", msg.OrderId)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelRangeOrder{}
+
+func (msg MsgCancelRangeOrder) Route() string { return RouterKey }
+func (msg MsgCancelRangeOrder) Type() string  { return TypeMsgCancelRangeOrder }
+func (msg MsgCancelRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid order id (%d)", msg.OrderId)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRebalancePosition{}
+
+func (msg MsgRebalancePosition) Route() string { return RouterKey }
+func (msg MsgRebalancePosition) Type() string  { return TypeMsgRebalancePosition }
+func (msg MsgRebalancePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if msg.NewLowerTick >= msg.NewUpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.NewLowerTick, UpperTick: msg.NewUpperTick}
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgRebalancePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMintPositionToken{}
+
+func (msg MsgMintPositionToken) Route() string { return RouterKey }
+func (msg MsgMintPositionToken) Type() string  { return TypeMsgMintPositionToken }
+func (msg MsgMintPositionToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgMintPositionToken) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRedeemPositionToken{}
+
+func (msg MsgRedeemPositionToken) Route() string { return RouterKey }
+func (msg MsgRedeemPositionToken) Type() string  { return TypeMsgRedeemPositionToken }
+func (msg MsgRedeemPositionToken) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	return nil
+}
+
+func (msg MsgRedeemPositionToken) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBatchPositionOps{}
+
+func (msg MsgBatchPositionOps) Route() string { return RouterKey }
+func (msg MsgBatchPositionOps) Type() string  { return TypeMsgBatchPositionOps }
+func (msg MsgBatchPositionOps) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.Ops) == 0 {
+		return fmt.Errorf("Must provide at least 1 position op")
+	}
+
+	if len(msg.Ops) > MaxPositionOpsPerBatch {
+		return fmt.Errorf("Must provide at most %d position ops, got %d", MaxPositionOpsPerBatch, len(msg.Ops))
+	}
+
+	for i, op := range msg.Ops {
+		if err := op.ValidateBasic(msg.Sender); err != nil {
+			return fmt.Errorf("Invalid position op %d (%s)", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgBatchPositionOps) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
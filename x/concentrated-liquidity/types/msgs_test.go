@@ -367,6 +367,81 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgPlaceRangeOrder",
+			clMsg: &types.MsgPlaceRangeOrder{
+				PoolId:    defaultPoolId,
+				Sender:    addr1,
+				LowerTick: int64(10000),
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+		},
+		{
+			name: "MsgClaimRangeOrder",
+			clMsg: &types.MsgClaimRangeOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+		},
+		{
+			name: "MsgCancelRangeOrder",
+			clMsg: &types.MsgCancelRangeOrder{
+				OrderId: 1,
+				Sender:  addr1,
+			},
+		},
+		{
+			name: "MsgRebalancePosition",
+			clMsg: &types.MsgRebalancePosition{
+				PositionId:      1,
+				Sender:          addr1,
+				NewLowerTick:    -100,
+				NewUpperTick:    100,
+				SwapImbalance:   true,
+				TokenMinAmount0: osmomath.OneInt(),
+				TokenMinAmount1: osmomath.OneInt(),
+			},
+		},
+		{
+			name: "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+				Enabled:    true,
+			},
+		},
+		{
+			name: "MsgMintPositionToken",
+			clMsg: &types.MsgMintPositionToken{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgRedeemPositionToken",
+			clMsg: &types.MsgRedeemPositionToken{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgBatchPositionOps",
+			clMsg: &types.MsgBatchPositionOps{
+				Sender: addr1,
+				Ops: []types.PositionOp{
+					{WithdrawPosition: &types.WithdrawPositionOp{PositionId: 1, LiquidityAmount: osmomath.OneDec()}},
+					{CreatePosition: &types.CreatePositionOp{
+						PoolId:          1,
+						LowerTick:       -100,
+						UpperTick:       100,
+						TokensProvided:  sdk.NewCoins(sdk.NewCoin("foo", osmomath.OneInt()), sdk.NewCoin("bar", osmomath.OneInt())),
+						TokenMinAmount0: osmomath.ZeroInt(),
+						TokenMinAmount1: osmomath.ZeroInt(),
+					}},
+					{CollectRewards: &types.CollectRewardsOp{PositionId: 2}},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -439,3 +514,403 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgPlaceRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgPlaceRangeOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:    1,
+				Sender:    invalidAddr.String(),
+				LowerTick: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero pool id",
+			msg: types.MsgPlaceRangeOrder{
+				Sender:    addr1,
+				LowerTick: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -100,
+				TokenIn:   sdk.NewCoin("foo", osmomath.ZeroInt()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:    1,
+				Sender:    addr1,
+				LowerTick: -100,
+				TokenIn:   sdk.Coin{Denom: "1foo", Amount: osmomath.NewInt(1000)},
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgPlaceRangeOrder)
+	}
+}
+
+func TestMsgClaimAndCancelRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		orderId    uint64
+		sender     string
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			orderId:    1,
+			sender:     addr1,
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			orderId:    1,
+			sender:     invalidAddr.String(),
+			expectPass: false,
+		},
+		{
+			name:       "zero order id",
+			sender:     addr1,
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &types.MsgClaimRangeOrder{OrderId: test.orderId, Sender: test.sender}, test.expectPass, types.TypeMsgClaimRangeOrder)
+		runValidateBasicTest(t, test.name, &types.MsgCancelRangeOrder{OrderId: test.orderId, Sender: test.sender}, test.expectPass, types.TypeMsgCancelRangeOrder)
+	}
+}
+
+func TestMsgRebalancePosition(t *testing.T) {
+	baseMsg := types.MsgRebalancePosition{
+		PositionId:      1,
+		Sender:          addr1,
+		NewLowerTick:    -100,
+		NewUpperTick:    100,
+		SwapImbalance:   true,
+		TokenMinAmount0: osmomath.OneInt(),
+		TokenMinAmount1: osmomath.OneInt(),
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgRebalancePosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgRebalancePosition { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.Sender = invalidAddr.String(); return copy },
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+		{
+			name:       "lower tick equal to upper tick",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.NewLowerTick = copy.NewUpperTick; return copy },
+			expectPass: false,
+		},
+		{
+			name:       "lower tick above upper tick",
+			msgFn:      func() types.MsgRebalancePosition { copy := baseMsg; copy.NewLowerTick = 200; return copy },
+			expectPass: false,
+		},
+		{
+			name: "token min amount0 is negative",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenMinAmount0 = osmomath.OneInt().Neg()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "token min amount1 is negative",
+			msgFn: func() types.MsgRebalancePosition {
+				copy := baseMsg
+				copy.TokenMinAmount1 = osmomath.OneInt().Neg()
+				return copy
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgRebalancePosition)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	baseMsg := types.MsgSetPositionAutoCompound{
+		PositionId: 1,
+		Sender:     addr1,
+		Enabled:    true,
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgSetPositionAutoCompound { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name:       "proper msg disabling",
+			msgFn:      func() types.MsgSetPositionAutoCompound { copy := baseMsg; copy.Enabled = false; return copy },
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msgFn: func() types.MsgSetPositionAutoCompound {
+				copy := baseMsg
+				copy.Sender = invalidAddr.String()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgSetPositionAutoCompound { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
+
+func TestMsgMintPositionToken(t *testing.T) {
+	baseMsg := types.MsgMintPositionToken{
+		PositionId: 1,
+		Sender:     addr1,
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgMintPositionToken
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgMintPositionToken { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msgFn: func() types.MsgMintPositionToken {
+				copy := baseMsg
+				copy.Sender = invalidAddr.String()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgMintPositionToken { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgMintPositionToken)
+	}
+}
+
+func TestMsgRedeemPositionToken(t *testing.T) {
+	baseMsg := types.MsgRedeemPositionToken{
+		PositionId: 1,
+		Sender:     addr1,
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgRedeemPositionToken
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgRedeemPositionToken { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msgFn: func() types.MsgRedeemPositionToken {
+				copy := baseMsg
+				copy.Sender = invalidAddr.String()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name:       "position id zero",
+			msgFn:      func() types.MsgRedeemPositionToken { copy := baseMsg; copy.PositionId = 0; return copy },
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgRedeemPositionToken)
+	}
+}
+
+func TestMsgBatchPositionOps(t *testing.T) {
+	validOps := []types.PositionOp{
+		{CreatePosition: &types.CreatePositionOp{
+			PoolId:          1,
+			LowerTick:       -100,
+			UpperTick:       100,
+			TokensProvided:  sdk.NewCoins(sdk.NewCoin("foo", osmomath.OneInt()), sdk.NewCoin("bar", osmomath.OneInt())),
+			TokenMinAmount0: osmomath.ZeroInt(),
+			TokenMinAmount1: osmomath.ZeroInt(),
+		}},
+		{WithdrawPosition: &types.WithdrawPositionOp{PositionId: 1, LiquidityAmount: osmomath.OneDec()}},
+		{AddToPosition: &types.AddToPositionOp{PositionId: 2, Amount0: osmomath.OneInt(), Amount1: osmomath.OneInt(), TokenMinAmount0: osmomath.ZeroInt(), TokenMinAmount1: osmomath.ZeroInt()}},
+		{CollectRewards: &types.CollectRewardsOp{PositionId: 3}},
+	}
+	baseMsg := types.MsgBatchPositionOps{
+		Sender: addr1,
+		Ops:    validOps,
+	}
+
+	tests := []struct {
+		name       string
+		msgFn      func() types.MsgBatchPositionOps
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msgFn:      func() types.MsgBatchPositionOps { return baseMsg },
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Sender = invalidAddr.String()
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name:       "no ops",
+			msgFn:      func() types.MsgBatchPositionOps { copy := baseMsg; copy.Ops = nil; return copy },
+			expectPass: false,
+		},
+		{
+			name: "too many ops",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Ops = make([]types.PositionOp, types.MaxPositionOpsPerBatch+1)
+				for i := range copy.Ops {
+					copy.Ops[i] = types.PositionOp{CollectRewards: &types.CollectRewardsOp{PositionId: 1}}
+				}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "no operation set",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Ops = []types.PositionOp{{}}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "several operations set",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Ops = []types.PositionOp{{WithdrawPosition: validOps[1].WithdrawPosition, CollectRewards: validOps[3].CollectRewards}}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid create position",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				createPosition := *validOps[0].CreatePosition
+				createPosition.LowerTick = createPosition.UpperTick
+				copy.Ops = []types.PositionOp{{CreatePosition: &createPosition}}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid withdraw position",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Ops = []types.PositionOp{{WithdrawPosition: &types.WithdrawPositionOp{PositionId: 1, LiquidityAmount: osmomath.ZeroDec()}}}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid add to position",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				addToPosition := *validOps[2].AddToPosition
+				addToPosition.Amount0 = osmomath.NewInt(-1)
+				copy.Ops = []types.PositionOp{{AddToPosition: &addToPosition}}
+				return copy
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid collect rewards",
+			msgFn: func() types.MsgBatchPositionOps {
+				copy := baseMsg
+				copy.Ops = []types.PositionOp{{CollectRewards: &types.CollectRewardsOp{PositionId: 0}}}
+				return copy
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msgFn()
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgBatchPositionOps)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // Parameter store keys.
@@ -20,6 +21,9 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyAutoCompoundEpochIdentifier        = []byte("AutoCompoundEpochIdentifier")
+	KeyAutoCompoundGasBudget              = []byte("AutoCompoundGasBudget")
+	KeyMinSpreadRewardsPositionAge        = []byte("MinSpreadRewardsPositionAge")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -29,7 +33,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, autoCompoundEpochIdentifier string, autoCompoundGasBudget uint64, minSpreadRewardsPositionAge time.Duration) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -38,6 +42,9 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		AutoCompoundEpochIdentifier:         autoCompoundEpochIdentifier,
+		AutoCompoundGasBudget:               autoCompoundGasBudget,
+		MinSpreadRewardsPositionAge:         minSpreadRewardsPositionAge,
 	}
 }
 
@@ -51,6 +58,9 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		AutoCompoundEpochIdentifier:         DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundGasBudget:               DefaultAutoCompoundGasBudget,
+		MinSpreadRewardsPositionAge:         DefaultMinSpreadRewardsPositionAge,
 	}
 }
 
@@ -77,6 +87,15 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.AutoCompoundEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundGasBudget(p.AutoCompoundGasBudget); err != nil {
+		return err
+	}
+	if err := validateMinSpreadRewardsPositionAge(p.MinSpreadRewardsPositionAge); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -90,6 +109,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyAutoCompoundEpochIdentifier, &p.AutoCompoundEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyAutoCompoundGasBudget, &p.AutoCompoundGasBudget, validateAutoCompoundGasBudget),
+		paramtypes.NewParamSetPair(KeyMinSpreadRewardsPositionAge, &p.MinSpreadRewardsPositionAge, validateMinSpreadRewardsPositionAge),
 	}
 }
 
@@ -223,3 +245,27 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateAutoCompoundGasBudget validates that the auto-compound gas budget is of type uint64.
+func validateAutoCompoundGasBudget(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for auto-compound gas budget: %T", i)
+	}
+
+	return nil
+}
+
+// validateMinSpreadRewardsPositionAge validates that the minimum spread rewards position age is a non-negative duration.
+func validateMinSpreadRewardsPositionAge(i interface{}) error {
+	minSpreadRewardsPositionAge, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for min spread rewards position age: %T", i)
+	}
+
+	if minSpreadRewardsPositionAge < 0 {
+		return NegativeDurationError{Duration: minSpreadRewardsPositionAge}
+	}
+
+	return nil
+}
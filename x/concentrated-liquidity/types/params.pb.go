@@ -64,6 +64,21 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// auto_compound_epoch_identifier is the identifier of the epoch at the end
+	// of which the spread rewards of the positions that opted into
+	// auto-compounding are added back to these positions.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,9,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty" yaml:"auto_compound_epoch_identifier"`
+	// auto_compound_gas_budget is the gas that can be consumed compounding
+	// positions at the end of each auto-compound epoch. Once it is consumed,
+	// the remaining positions are compounded at the end of the next epochs.
+	// Zero disables auto-compounding.
+	AutoCompoundGasBudget uint64 `protobuf:"varint,10,opt,name=auto_compound_gas_budget,json=autoCompoundGasBudget,proto3" json:"auto_compound_gas_budget,omitempty" yaml:"auto_compound_gas_budget"`
+	// min_spread_rewards_position_age is the minimum age a position must reach
+	// to earn spread rewards. The spread rewards claimed by younger positions
+	// are forfeited to the other in-range positions of the pool, which prevents
+	// just-in-time liquidity from capturing the spread rewards of a swap.
+	// Zero disables the requirement.
+	MinSpreadRewardsPositionAge time.Duration `protobuf:"bytes,11,opt,name=min_spread_rewards_position_age,json=minSpreadRewardsPositionAge,proto3,stdduration" json:"min_spread_rewards_position_age" yaml:"min_spread_rewards_position_age"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -142,6 +157,27 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundGasBudget() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetMinSpreadRewardsPositionAge() time.Duration {
+	if m != nil {
+		return m.MinSpreadRewardsPositionAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -151,48 +187,57 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x98, 0x82,
-	0x2e, 0xa5, 0x4d, 0xa4, 0x42, 0x0f, 0xf5, 0x20, 0xc4, 0xd5, 0x5e, 0x2a, 0xd4, 0x54, 0x11, 0x8a,
-	0x30, 0xcc, 0x4e, 0xa6, 0xd9, 0x61, 0x93, 0x3c, 0xe9, 0xcc, 0xc4, 0xba, 0x82, 0x27, 0x11, 0x3c,
-	0x7a, 0xf0, 0xe0, 0x0b, 0xf2, 0xd0, 0x63, 0x8f, 0xe2, 0x21, 0x4a, 0x7b, 0xf3, 0xb8, 0xaf, 0x40,
-	0x36, 0xd9, 0xb5, 0x59, 0xaa, 0xd8, 0x5b, 0x66, 0xbe, 0x9f, 0xe7, 0xcf, 0x3c, 0xf9, 0xf2, 0xe8,
-	0x2b, 0x20, 0x13, 0x90, 0x5c, 0x7a, 0x14, 0x52, 0xca, 0x52, 0x25, 0x88, 0x62, 0x61, 0xcc, 0x0f,
-	0x72, 0x1e, 0x72, 0x35, 0xf0, 0x32, 0x22, 0x48, 0x22, 0xdd, 0x4c, 0x80, 0x02, 0xe3, 0xd6, 0x98,
-	0x75, 0xff, 0xc8, 0x2e, 0x5d, 0x8b, 0x20, 0x82, 0x92, 0xf4, 0x46, 0x5f, 0x55, 0xd0, 0x92, 0x15,
-	0x01, 0x44, 0x31, 0xf3, 0xca, 0x53, 0x37, 0xdf, 0xf7, 0xc2, 0x5c, 0x10, 0xc5, 0x21, 0xad, 0x74,
-	0xf4, 0x65, 0x4e, 0x9f, 0xdd, 0x29, 0xab, 0x18, 0x7b, 0xfa, 0x75, 0x92, 0xab, 0x1e, 0x08, 0xfe,
-	0x86, 0x85, 0x58, 0x71, 0xda, 0xc7, 0x32, 0x23, 0x94, 0xa7, 0x91, 0xa9, 0x39, 0xcd, 0xf6, 0x8c,
-	0x8f, 0x86, 0x85, 0x6d, 0x0d, 0x48, 0x12, 0x6f, 0xa2, 0xbf, 0x80, 0x28, 0x58, 0x3c, 0x53, 0x9e,
-	0x71, 0xda, 0xdf, 0xad, 0xee, 0x8d, 0x77, 0x9a, 0xde, 0xaa, 0xc5, 0xc8, 0x4c, 0x30, 0x12, 0xe2,
-	0x7d, 0x42, 0x15, 0x08, 0x69, 0xfe, 0xe7, 0x34, 0xdb, 0x0b, 0xfe, 0xd6, 0x51, 0x61, 0x37, 0xbe,
-	0x15, 0xf6, 0x0d, 0x5a, 0x3e, 0x54, 0x86, 0x7d, 0x97, 0x83, 0x97, 0x10, 0xd5, 0x73, 0xb7, 0x59,
-	0x44, 0xe8, 0xa0, 0xc3, 0xe8, 0xb0, 0xb0, 0x9d, 0x73, 0x1d, 0x4c, 0x67, 0x43, 0x41, 0xed, 0x19,
-	0xbb, 0xa5, 0xf4, 0xb8, 0x52, 0x8c, 0x4f, 0x9a, 0x6e, 0x77, 0x49, 0x4c, 0x52, 0xca, 0x04, 0x96,
-	0x3d, 0x22, 0x98, 0xc4, 0x82, 0x1d, 0x12, 0x11, 0xe2, 0x90, 0x4b, 0x0a, 0x79, 0xaa, 0xcc, 0xa6,
-	0xa3, 0xb5, 0x17, 0xfc, 0x27, 0x17, 0xeb, 0xe5, 0x76, 0xd5, 0xcb, 0x3f, 0x72, 0xa2, 0xe0, 0xe6,
-	0x84, 0xd8, 0x2d, 0x81, 0xa0, 0xd4, 0x3b, 0x63, 0xd9, 0x48, 0xa7, 0x06, 0x7f, 0x90, 0x83, 0x62,
-	0x38, 0x64, 0x29, 0x24, 0xd2, 0x9c, 0x29, 0x27, 0xb3, 0x31, 0x2c, 0xec, 0xbb, 0xe7, 0x9e, 0x5d,
-	0x07, 0xd1, 0x6a, 0xc8, 0x32, 0xc1, 0xe8, 0xc8, 0x12, 0x9b, 0x48, 0x89, 0x9c, 0x21, 0x53, 0xab,
-	0xff, 0x8c, 0xa7, 0x23, 0xb8, 0x53, 0xb2, 0xc6, 0x7b, 0x4d, 0x37, 0x6a, 0x79, 0xf2, 0x4c, 0xf1,
-	0x84, 0x49, 0xf3, 0x7f, 0xa7, 0xd9, 0xbe, 0xb4, 0xde, 0x72, 0x2b, 0xc7, 0xb8, 0x13, 0xc7, 0xb8,
-	0x9d, 0xb1, 0x63, 0xfc, 0xfb, 0xa3, 0xa1, 0xfc, 0x2c, 0x6c, 0x63, 0xe2, 0xa1, 0x55, 0x48, 0xb8,
-	0x62, 0x49, 0xa6, 0x06, 0xc3, 0xc2, 0x6e, 0x9d, 0x6b, 0x70, 0x9c, 0x18, 0x7d, 0xfe, 0x6e, 0x6b,
-	0xc1, 0xd5, 0x33, 0xe1, 0x79, 0x75, 0x6f, 0x7c, 0xd0, 0xf4, 0x3b, 0x5c, 0xe2, 0x8c, 0x89, 0x84,
-	0x4b, 0xc9, 0x21, 0x8d, 0x99, 0x94, 0x38, 0x03, 0x88, 0x31, 0x15, 0xac, 0xac, 0x80, 0x59, 0x4a,
-	0xba, 0x31, 0x0b, 0xcd, 0x59, 0x47, 0x6b, 0xcf, 0xfb, 0xeb, 0xc3, 0xc2, 0x76, 0xab, 0x3a, 0x17,
-	0x0c, 0x44, 0xc1, 0x32, 0x97, 0x3b, 0x53, 0xe0, 0x0e, 0x40, 0xfc, 0x70, 0x8c, 0x3d, 0xaa, 0x28,
-	0xe3, 0xad, 0xbe, 0x9c, 0xa7, 0x82, 0x49, 0x25, 0x38, 0x55, 0x2c, 0xac, 0xe5, 0x02, 0x81, 0x0f,
-	0x7b, 0x5c, 0xb1, 0x98, 0x4b, 0x65, 0xce, 0x95, 0xbf, 0xc3, 0x1d, 0x16, 0xf6, 0x4a, 0xd5, 0xc5,
-	0x05, 0x82, 0x50, 0xe0, 0xd4, 0xa9, 0xdf, 0xd5, 0x41, 0xbc, 0x98, 0x20, 0xc6, 0x03, 0xfd, 0x4a,
-	0x0f, 0xa0, 0x8f, 0x23, 0x22, 0x71, 0xcc, 0x13, 0xae, 0xcc, 0x79, 0x47, 0x6b, 0xcf, 0xf8, 0xad,
-	0x61, 0x61, 0x2f, 0x56, 0x95, 0xa6, 0x75, 0x14, 0x5c, 0x1e, 0x5d, 0x6c, 0x11, 0xb9, 0x3d, 0x3a,
-	0xfa, 0x2f, 0x8f, 0x4e, 0x2c, 0xed, 0xf8, 0xc4, 0xd2, 0x7e, 0x9c, 0x58, 0xda, 0xc7, 0x53, 0xab,
-	0x71, 0x7c, 0x6a, 0x35, 0xbe, 0x9e, 0x5a, 0x8d, 0x3d, 0x3f, 0xe2, 0xaa, 0x97, 0x77, 0x5d, 0x0a,
-	0x89, 0x37, 0x5e, 0x20, 0x6b, 0x31, 0xe9, 0xca, 0xc9, 0xc1, 0x7b, 0xb5, 0xbe, 0xe1, 0xbd, 0x9e,
-	0xda, 0x3f, 0x6b, 0x67, 0x0b, 0x48, 0x0d, 0x32, 0x26, 0xbb, 0xb3, 0xa5, 0x17, 0xee, 0xfd, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x26, 0x79, 0x09, 0x52, 0xae, 0x04, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xdd, 0x44,
+	0x14, 0xc7, 0x63, 0x12, 0x42, 0xe3, 0x22, 0x24, 0x2c, 0x2a, 0x9c, 0x06, 0x3c, 0x96, 0x23, 0xe0,
+	0x52, 0xb5, 0x36, 0x0a, 0x52, 0x17, 0x65, 0x81, 0x70, 0x53, 0x22, 0xa4, 0x22, 0x05, 0x07, 0x84,
+	0x54, 0x55, 0x1a, 0xcd, 0x1d, 0x9f, 0xf8, 0x8e, 0xae, 0x3d, 0xe3, 0xce, 0x8c, 0x29, 0x17, 0x89,
+	0x15, 0x42, 0x62, 0x89, 0x04, 0x0b, 0x1e, 0x81, 0x47, 0xe9, 0xb2, 0x4b, 0xc4, 0xc2, 0xa0, 0x64,
+	0xc7, 0xd2, 0x4f, 0x80, 0x3c, 0xe3, 0xfb, 0xa5, 0xf4, 0x23, 0x3b, 0x7b, 0xfe, 0xbf, 0x39, 0xe7,
+	0xcc, 0x9c, 0xff, 0x1c, 0xf7, 0x86, 0x50, 0x95, 0x50, 0x4c, 0x25, 0x54, 0x70, 0x0a, 0x5c, 0x4b,
+	0xa2, 0x21, 0x2f, 0xd9, 0xa3, 0x86, 0xe5, 0x4c, 0xcf, 0x92, 0x9a, 0x48, 0x52, 0xa9, 0xb8, 0x96,
+	0x42, 0x0b, 0xef, 0xdd, 0x81, 0x8d, 0x9f, 0xc9, 0x5e, 0x7f, 0xab, 0x10, 0x85, 0x30, 0x64, 0xd2,
+	0x7f, 0xd9, 0x4d, 0xd7, 0x83, 0x42, 0x88, 0xa2, 0x84, 0xc4, 0xfc, 0x8d, 0x9b, 0xd3, 0x24, 0x6f,
+	0x24, 0xd1, 0x4c, 0x70, 0xab, 0x47, 0x7f, 0xba, 0xee, 0xf6, 0xb1, 0xc9, 0xe2, 0x3d, 0x70, 0xdf,
+	0x26, 0x8d, 0x9e, 0x08, 0xc9, 0x7e, 0x80, 0x1c, 0x6b, 0x46, 0xa7, 0x58, 0xd5, 0x84, 0x32, 0x5e,
+	0xf8, 0x4e, 0xb8, 0x39, 0xda, 0x4a, 0xa3, 0xae, 0x45, 0xc1, 0x8c, 0x54, 0xe5, 0x9d, 0xe8, 0x39,
+	0x60, 0x94, 0x5d, 0x5b, 0x2a, 0x5f, 0x33, 0x3a, 0x3d, 0xb1, 0xeb, 0xde, 0x4f, 0x8e, 0xbb, 0xbb,
+	0xb2, 0x47, 0xd5, 0x12, 0x48, 0x8e, 0x4f, 0x09, 0xd5, 0x42, 0x2a, 0xff, 0x95, 0x70, 0x73, 0xb4,
+	0x93, 0x1e, 0x3d, 0x69, 0xd1, 0xc6, 0xdf, 0x2d, 0xda, 0xa3, 0xe6, 0xa0, 0x2a, 0x9f, 0xc6, 0x4c,
+	0x24, 0x15, 0xd1, 0x93, 0xf8, 0x3e, 0x14, 0x84, 0xce, 0x0e, 0x81, 0x76, 0x2d, 0x0a, 0x2f, 0x54,
+	0xb0, 0x1e, 0x2d, 0xca, 0x56, 0x8e, 0x71, 0x62, 0xa4, 0xcf, 0xad, 0xe2, 0xfd, 0xee, 0xb8, 0x68,
+	0x4c, 0x4a, 0xc2, 0x29, 0x48, 0xac, 0x26, 0x44, 0x82, 0xc2, 0x12, 0x1e, 0x13, 0x99, 0xe3, 0x9c,
+	0x29, 0x2a, 0x1a, 0xae, 0xfd, 0xcd, 0xd0, 0x19, 0xed, 0xa4, 0x5f, 0x5e, 0xae, 0x96, 0xf7, 0x6d,
+	0x2d, 0x2f, 0x89, 0x19, 0x65, 0xef, 0xcc, 0x89, 0x13, 0x03, 0x64, 0x46, 0x3f, 0x1c, 0x64, 0x8f,
+	0xaf, 0x5d, 0xfc, 0xa3, 0x46, 0x68, 0xc0, 0x39, 0x70, 0x51, 0x29, 0x7f, 0xcb, 0xdc, 0xcc, 0xed,
+	0xae, 0x45, 0x1f, 0x5d, 0x38, 0xf6, 0x2a, 0x18, 0xdd, 0xcc, 0xa1, 0x96, 0x40, 0x7b, 0x4b, 0xdc,
+	0x89, 0xb4, 0x6c, 0x20, 0xf2, 0x9d, 0xd5, 0x66, 0x7c, 0xd5, 0xc3, 0x87, 0x86, 0xf5, 0x7e, 0x76,
+	0x5c, 0x6f, 0x25, 0x4e, 0x53, 0x6b, 0x56, 0x81, 0xf2, 0x5f, 0x0d, 0x37, 0x47, 0x57, 0x0f, 0x76,
+	0x63, 0xeb, 0x98, 0x78, 0xee, 0x98, 0xf8, 0x70, 0x70, 0x4c, 0xfa, 0x49, 0x7f, 0x29, 0xff, 0xb5,
+	0xc8, 0x9b, 0x7b, 0xe8, 0xa6, 0xa8, 0x98, 0x86, 0xaa, 0xd6, 0xb3, 0xae, 0x45, 0xbb, 0x17, 0x0a,
+	0x1c, 0x02, 0x47, 0x7f, 0xfc, 0x83, 0x9c, 0xec, 0xcd, 0xa5, 0xf0, 0x8d, 0x5d, 0xf7, 0x7e, 0x71,
+	0xdc, 0x0f, 0x98, 0xc2, 0x35, 0xc8, 0x8a, 0x29, 0xc5, 0x04, 0x2f, 0x41, 0x29, 0x5c, 0x0b, 0x51,
+	0x62, 0x2a, 0xc1, 0x64, 0xc0, 0xc0, 0xc9, 0xb8, 0x84, 0xdc, 0xdf, 0x0e, 0x9d, 0xd1, 0x95, 0xf4,
+	0xa0, 0x6b, 0x51, 0x6c, 0xf3, 0x5c, 0x72, 0x63, 0x94, 0xed, 0x33, 0x75, 0xbc, 0x06, 0x1e, 0x0b,
+	0x51, 0xde, 0x1d, 0xb0, 0x7b, 0x96, 0xf2, 0x7e, 0x74, 0xf7, 0x1b, 0x2e, 0x41, 0x69, 0xc9, 0xa8,
+	0x86, 0x7c, 0x25, 0x96, 0x90, 0xf8, 0xf1, 0x84, 0x69, 0x28, 0x99, 0xd2, 0xfe, 0x6b, 0xa6, 0x1d,
+	0x71, 0xd7, 0xa2, 0x1b, 0xb6, 0x8a, 0x4b, 0x6c, 0x8a, 0xb2, 0x70, 0x95, 0x5a, 0x64, 0x17, 0xf2,
+	0xdb, 0x39, 0xe2, 0x7d, 0xea, 0xbe, 0x31, 0x11, 0x62, 0x8a, 0x0b, 0xa2, 0x70, 0xc9, 0x2a, 0xa6,
+	0xfd, 0x2b, 0xa1, 0x33, 0xda, 0x4a, 0x77, 0xbb, 0x16, 0x5d, 0xb3, 0x99, 0xd6, 0xf5, 0x28, 0x7b,
+	0xbd, 0x5f, 0x38, 0x22, 0xea, 0x7e, 0xff, 0xeb, 0x71, 0x37, 0x20, 0x8d, 0x16, 0x98, 0x8a, 0xaa,
+	0x16, 0x0d, 0xcf, 0x31, 0xd4, 0x82, 0x4e, 0x30, 0xcb, 0x81, 0x6b, 0x76, 0xca, 0x40, 0xfa, 0x3b,
+	0xc6, 0xd7, 0x1f, 0x76, 0x2d, 0x7a, 0x6f, 0xd1, 0xa8, 0x17, 0xf0, 0x51, 0xb6, 0xd7, 0x03, 0x77,
+	0x07, 0xfd, 0x5e, 0x2f, 0x7f, 0xb1, 0x50, 0xbd, 0x87, 0xae, 0xbf, 0xbe, 0xbf, 0xaf, 0x6c, 0xdc,
+	0xe4, 0x05, 0x68, 0xdf, 0x35, 0xa5, 0xef, 0x77, 0x2d, 0x42, 0xcf, 0xca, 0xb4, 0x24, 0xed, 0xb4,
+	0x58, 0xe4, 0x38, 0x22, 0x2a, 0x35, 0xeb, 0xde, 0x6f, 0x8e, 0x8b, 0x2a, 0xc6, 0xe7, 0x0f, 0xdb,
+	0x3e, 0xa7, 0xbe, 0xc1, 0x8a, 0x99, 0xde, 0x92, 0x02, 0xfc, 0xab, 0xa1, 0xf3, 0x62, 0xb7, 0x1e,
+	0xf4, 0x6e, 0x5d, 0xbe, 0xd1, 0x97, 0xc4, 0xb3, 0x26, 0xdd, 0xab, 0x18, 0xb7, 0x23, 0xc3, 0x3e,
+	0x51, 0x75, 0x3c, 0x20, 0x9f, 0x15, 0x90, 0x3e, 0x7c, 0x72, 0x16, 0x38, 0x4f, 0xcf, 0x02, 0xe7,
+	0xdf, 0xb3, 0xc0, 0xf9, 0xf5, 0x3c, 0xd8, 0x78, 0x7a, 0x1e, 0x6c, 0xfc, 0x75, 0x1e, 0x6c, 0x3c,
+	0x48, 0x0b, 0xa6, 0x27, 0xcd, 0x38, 0xa6, 0xa2, 0x4a, 0x86, 0x21, 0x7d, 0xab, 0x24, 0x63, 0x35,
+	0xff, 0x49, 0xbe, 0x3b, 0xb8, 0x9d, 0x7c, 0xbf, 0x36, 0xe3, 0x6f, 0x2d, 0x87, 0xbc, 0x9e, 0xd5,
+	0xa0, 0xc6, 0xdb, 0xe6, 0x04, 0x1f, 0xff, 0x3f, 0x00, 0xdb, 0x17, 0xae, 0xf9, 0x12, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -215,6 +260,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinSpreadRewardsPositionAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSpreadRewardsPositionAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if m.AutoCompoundGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundGasBudget))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -285,20 +350,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.AuthorizedTickSpacing) > 0 {
-		dAtA2 := make([]byte, len(m.AuthorizedTickSpacing)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.AuthorizedTickSpacing)*10)
+		var j2 int
 		for _, num := range m.AuthorizedTickSpacing {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -361,6 +426,15 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.AutoCompoundGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.AutoCompoundGasBudget))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinSpreadRewardsPositionAge)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -682,6 +756,90 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasBudget", wireType)
+			}
+			m.AutoCompoundGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadRewardsPositionAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinSpreadRewardsPositionAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -107,3 +108,38 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMinSpreadRewardsPositionAge(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: time.Hour,
+		},
+		"zero age": {
+			i: types.DefaultMinSpreadRewardsPositionAge,
+		},
+		"error: negative age": {
+			i:           -time.Nanosecond,
+			expectError: true,
+		},
+		"error: invalid type": {
+			i:           uint64(1),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMinSpreadRewardsPositionAge(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,20 +2,25 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
 // Action prefixes for pool actions
 const (
-	CreatePositionPrefix     = "CreatePosition"
-	WithdrawPositionPrefix   = "WithdrawPosition"
-	SwapExactAmountInPrefix  = "SwapExactAmountIn"
-	SwapExactAmountOutPrefix = "SwapExactAmountOut"
+	CreatePositionPrefix       = "CreatePosition"
+	WithdrawPositionPrefix     = "WithdrawPosition"
+	SwapExactAmountInPrefix    = "SwapExactAmountIn"
+	SwapExactAmountOutPrefix   = "SwapExactAmountOut"
+	CollectSpreadRewardsPrefix = "CollectSpreadRewards"
+	CollectIncentivesPrefix    = "CollectIncentives"
+	CreateIncentivePrefix      = "CreateIncentive"
 )
 
 // Helper function to generate before action prefix
@@ -36,6 +41,9 @@ func GetAllActionPrefixes() []string {
 		WithdrawPositionPrefix,
 		SwapExactAmountInPrefix,
 		SwapExactAmountOutPrefix,
+		CollectSpreadRewardsPrefix,
+		CollectIncentivesPrefix,
+		CreateIncentivePrefix,
 	} {
 		result = append(result, BeforeActionPrefix(prefix), AfterActionPrefix(prefix))
 	}
@@ -43,6 +51,17 @@ func GetAllActionPrefixes() []string {
 	return result
 }
 
+// Validate returns an error if the action prefix of the hook is not one of the valid actions or if
+// its contract address is not a valid bech32 address.
+func (h PoolHook) Validate() error {
+	validActionPrefixes := GetAllActionPrefixes()
+	if !osmoutils.Contains(validActionPrefixes, h.ActionPrefix) {
+		return InvalidActionPrefixError{ActionPrefix: h.ActionPrefix, ValidActions: validActionPrefixes}
+	}
+	_, err := sdk.AccAddressFromBech32(h.ContractAddress)
+	return err
+}
+
 // --- Sudo Message Wrappers ---
 
 type BeforeCreatePositionSudoMsg struct {
@@ -77,6 +96,30 @@ type AfterSwapExactAmountOutSudoMsg struct {
 	AfterSwapExactAmountOut AfterSwapExactAmountOutMsg `json:"after_swap_exact_amount_out"`
 }
 
+type BeforeCollectSpreadRewardsSudoMsg struct {
+	BeforeCollectSpreadRewards BeforeCollectSpreadRewardsMsg `json:"before_collect_spread_rewards"`
+}
+
+type AfterCollectSpreadRewardsSudoMsg struct {
+	AfterCollectSpreadRewards AfterCollectSpreadRewardsMsg `json:"after_collect_spread_rewards"`
+}
+
+type BeforeCollectIncentivesSudoMsg struct {
+	BeforeCollectIncentives BeforeCollectIncentivesMsg `json:"before_collect_incentives"`
+}
+
+type AfterCollectIncentivesSudoMsg struct {
+	AfterCollectIncentives AfterCollectIncentivesMsg `json:"after_collect_incentives"`
+}
+
+type BeforeCreateIncentiveSudoMsg struct {
+	BeforeCreateIncentive BeforeCreateIncentiveMsg `json:"before_create_incentive"`
+}
+
+type AfterCreateIncentiveSudoMsg struct {
+	AfterCreateIncentive AfterCreateIncentiveMsg `json:"after_create_incentive"`
+}
+
 // --- Message structs ---
 
 type BeforeCreatePositionMsg struct {
@@ -148,3 +191,49 @@ type AfterSwapExactAmountOutMsg struct {
 	TokenOut         wasmvmtypes.Coin `json:"token_out"`
 	SpreadFactor     osmomath.Dec     `json:"spread_factor"`
 }
+
+type BeforeCollectSpreadRewardsMsg struct {
+	PoolId     uint64         `json:"pool_id"`
+	Owner      sdk.AccAddress `json:"owner"`
+	PositionId uint64         `json:"position_id"`
+}
+
+type AfterCollectSpreadRewardsMsg struct {
+	PoolId                 uint64             `json:"pool_id"`
+	Owner                  sdk.AccAddress     `json:"owner"`
+	PositionId             uint64             `json:"position_id"`
+	CollectedSpreadRewards []wasmvmtypes.Coin `json:"collected_spread_rewards"`
+}
+
+type BeforeCollectIncentivesMsg struct {
+	PoolId     uint64         `json:"pool_id"`
+	Owner      sdk.AccAddress `json:"owner"`
+	PositionId uint64         `json:"position_id"`
+}
+
+type AfterCollectIncentivesMsg struct {
+	PoolId              uint64             `json:"pool_id"`
+	Owner               sdk.AccAddress     `json:"owner"`
+	PositionId          uint64             `json:"position_id"`
+	CollectedIncentives []wasmvmtypes.Coin `json:"collected_incentives"`
+	ForfeitedIncentives []wasmvmtypes.Coin `json:"forfeited_incentives"`
+}
+
+type BeforeCreateIncentiveMsg struct {
+	PoolId        uint64           `json:"pool_id"`
+	Sender        sdk.AccAddress   `json:"sender"`
+	IncentiveCoin wasmvmtypes.Coin `json:"incentive_coin"`
+	EmissionRate  osmomath.Dec     `json:"emission_rate"`
+	StartTime     time.Time        `json:"start_time"`
+	MinUptime     time.Duration    `json:"min_uptime"`
+}
+
+type AfterCreateIncentiveMsg struct {
+	PoolId        uint64           `json:"pool_id"`
+	Sender        sdk.AccAddress   `json:"sender"`
+	IncentiveCoin wasmvmtypes.Coin `json:"incentive_coin"`
+	EmissionRate  osmomath.Dec     `json:"emission_rate"`
+	StartTime     time.Time        `json:"start_time"`
+	MinUptime     time.Duration    `json:"min_uptime"`
+	IncentiveId   uint64           `json:"incentive_id"`
+}
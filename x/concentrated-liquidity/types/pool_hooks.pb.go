@@ -0,0 +1,787 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/pool_hooks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolHookFailurePolicy defines how the failure of a CosmWasm hook of a pool
+// affects the action that triggered it.
+type PoolHookFailurePolicy int32
+
+const (
+	// POOL_HOOK_FAILURE_POLICY_REVERT fails the action that triggered the hook,
+	// reverting it.
+	PoolHookFailurePolicyRevert PoolHookFailurePolicy = 0
+	// POOL_HOOK_FAILURE_POLICY_IGNORE discards the state changes of the hook
+	// and lets the action that triggered it succeed. The error is emitted in an
+	// event and recorded as the last error of the hook.
+	PoolHookFailurePolicyIgnore PoolHookFailurePolicy = 1
+)
+
+var PoolHookFailurePolicy_name = map[int32]string{
+	0: "POOL_HOOK_FAILURE_POLICY_REVERT",
+	1: "POOL_HOOK_FAILURE_POLICY_IGNORE",
+}
+
+var PoolHookFailurePolicy_value = map[string]int32{
+	"POOL_HOOK_FAILURE_POLICY_REVERT": 0,
+	"POOL_HOOK_FAILURE_POLICY_IGNORE": 1,
+}
+
+func (x PoolHookFailurePolicy) String() string {
+	return proto.EnumName(PoolHookFailurePolicy_name, int32(x))
+}
+
+func (PoolHookFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e2da6f034950395, []int{0}
+}
+
+// PoolHook is the CosmWasm contract called by a pool on one of its actions.
+type PoolHook struct {
+	// action_prefix is the action the contract is called on, e.g.
+	// "beforeSwapExactAmountIn".
+	ActionPrefix    string `protobuf:"bytes,1,opt,name=action_prefix,json=actionPrefix,proto3" json:"action_prefix,omitempty" yaml:"action_prefix"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// gas_limit is the gas the contract may consume per call. Zero defaults to
+	// the hook_gas_limit param, which also caps it.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *PoolHook) Reset()         { *m = PoolHook{} }
+func (m *PoolHook) String() string { return proto.CompactTextString(m) }
+func (*PoolHook) ProtoMessage()    {}
+func (*PoolHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2da6f034950395, []int{0}
+}
+func (m *PoolHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHook.Merge(m, src)
+}
+func (m *PoolHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHook proto.InternalMessageInfo
+
+func (m *PoolHook) GetActionPrefix() string {
+	if m != nil {
+		return m.ActionPrefix
+	}
+	return ""
+}
+
+func (m *PoolHook) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *PoolHook) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// PoolHookError is the last error of a pool hook ignored by the failure policy
+// of its pool.
+type PoolHookError struct {
+	Error       string    `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+	BlockHeight int64     `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Time        time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *PoolHookError) Reset()         { *m = PoolHookError{} }
+func (m *PoolHookError) String() string { return proto.CompactTextString(m) }
+func (*PoolHookError) ProtoMessage()    {}
+func (*PoolHookError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2da6f034950395, []int{1}
+}
+func (m *PoolHookError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHookError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHookError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHookError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHookError.Merge(m, src)
+}
+func (m *PoolHookError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHookError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHookError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHookError proto.InternalMessageInfo
+
+func (m *PoolHookError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PoolHookError) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolHookError) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.concentratedliquidity.v1beta1.PoolHookFailurePolicy", PoolHookFailurePolicy_name, PoolHookFailurePolicy_value)
+	proto.RegisterType((*PoolHook)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHook")
+	proto.RegisterType((*PoolHookError)(nil), "osmosis.concentratedliquidity.v1beta1.PoolHookError")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/pool_hooks.proto", fileDescriptor_2e2da6f034950395)
+}
+
+var fileDescriptor_2e2da6f034950395 = []byte{
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x51, 0x6b, 0xd3, 0x50,
+	0x14, 0xce, 0x75, 0x55, 0xb6, 0x6c, 0xc3, 0x12, 0x27, 0x2d, 0x1d, 0xe4, 0x96, 0x80, 0x32, 0x84,
+	0x25, 0x74, 0xc2, 0x1e, 0x0a, 0x3e, 0x2c, 0xda, 0xae, 0xc5, 0x62, 0x4a, 0x98, 0x82, 0x22, 0x84,
+	0x24, 0xbd, 0x4b, 0x2f, 0x4d, 0x7a, 0x6a, 0x72, 0x5b, 0xd6, 0x7f, 0x20, 0x3e, 0xed, 0x0f, 0x08,
+	0x82, 0x4f, 0xfe, 0x0d, 0x9f, 0x86, 0x4f, 0x7b, 0xf4, 0x29, 0x4a, 0xfb, 0xe2, 0x73, 0x7e, 0x81,
+	0xe4, 0xde, 0x46, 0x9d, 0x8c, 0xbd, 0x9d, 0xef, 0x9c, 0xf3, 0x7d, 0x9c, 0xef, 0xe3, 0x5e, 0xf9,
+	0x10, 0x92, 0x08, 0x12, 0x9a, 0x18, 0x3e, 0x8c, 0x7d, 0x32, 0x66, 0xb1, 0xcb, 0xc8, 0x20, 0xa4,
+	0xef, 0xa6, 0x74, 0x40, 0xd9, 0xdc, 0x98, 0x35, 0x3c, 0xc2, 0xdc, 0x86, 0x31, 0x01, 0x08, 0x9d,
+	0x21, 0xc0, 0x28, 0xd1, 0x27, 0x31, 0x30, 0x50, 0x1e, 0xac, 0x78, 0xfa, 0xb5, 0x3c, 0x7d, 0xc5,
+	0xab, 0xed, 0x04, 0x10, 0x00, 0x67, 0x18, 0x79, 0x25, 0xc8, 0x35, 0x1c, 0x00, 0x04, 0x21, 0x31,
+	0x38, 0xf2, 0xa6, 0xa7, 0x06, 0xa3, 0x11, 0x49, 0x98, 0x1b, 0x4d, 0xc4, 0x82, 0xf6, 0x0d, 0xc9,
+	0xeb, 0x7d, 0x80, 0xb0, 0x03, 0x30, 0x52, 0x9e, 0xc8, 0xdb, 0xae, 0xcf, 0x28, 0x8c, 0x9d, 0x49,
+	0x4c, 0x4e, 0xe9, 0x59, 0x15, 0xd5, 0xd1, 0xde, 0x86, 0x59, 0xcd, 0x52, 0xbc, 0x33, 0x77, 0xa3,
+	0xb0, 0xa9, 0x5d, 0x19, 0x6b, 0xf6, 0x96, 0xc0, 0x7d, 0x0e, 0x95, 0xb6, 0x5c, 0xf6, 0x21, 0x3f,
+	0xd0, 0x67, 0x8e, 0x3b, 0x18, 0xc4, 0x24, 0x49, 0xaa, 0xb7, 0xb8, 0xc2, 0x6e, 0x96, 0xe2, 0x8a,
+	0x50, 0xf8, 0x7f, 0x43, 0xb3, 0xef, 0x16, 0xad, 0x23, 0xd1, 0x51, 0x1a, 0xf2, 0x46, 0xe0, 0x26,
+	0x4e, 0x48, 0x23, 0xca, 0xaa, 0x6b, 0x75, 0xb4, 0x57, 0x32, 0x77, 0xb2, 0x14, 0x97, 0x85, 0xc0,
+	0x9f, 0x91, 0x66, 0xaf, 0x07, 0x6e, 0xd2, 0xcb, 0xcb, 0x66, 0xe9, 0xd7, 0x27, 0x8c, 0xb4, 0xaf,
+	0x48, 0xde, 0x2e, 0xcc, 0xb4, 0xe2, 0x18, 0x62, 0xe5, 0xa1, 0x7c, 0x9b, 0xe4, 0xc5, 0xca, 0x49,
+	0x39, 0x4b, 0xf1, 0x96, 0x90, 0xe1, 0x6d, 0xcd, 0x16, 0x63, 0xa5, 0x29, 0x6f, 0x79, 0x21, 0xf8,
+	0x23, 0x67, 0x48, 0x68, 0x30, 0x64, 0xfc, 0xec, 0x35, 0xb3, 0x92, 0xa5, 0xf8, 0x9e, 0x58, 0xff,
+	0x77, 0xaa, 0xd9, 0x9b, 0x1c, 0x76, 0x38, 0x52, 0x8e, 0xe5, 0x52, 0x9e, 0x2a, 0xbf, 0x74, 0xf3,
+	0xa0, 0xa6, 0x8b, 0xc8, 0xf5, 0x22, 0x72, 0xfd, 0xa4, 0x88, 0xdc, 0xac, 0x5c, 0xa4, 0x58, 0xca,
+	0x52, 0xbc, 0x29, 0x34, 0x73, 0x96, 0x76, 0xfe, 0x03, 0x23, 0x9b, 0x0b, 0x08, 0x13, 0x8f, 0xbe,
+	0x20, 0xf9, 0x7e, 0x61, 0xa2, 0xed, 0xd2, 0x70, 0x1a, 0x93, 0x3e, 0x84, 0xd4, 0x9f, 0x2b, 0xcf,
+	0x64, 0xdc, 0xb7, 0xac, 0x9e, 0xd3, 0xb1, 0xac, 0xe7, 0x4e, 0xfb, 0xa8, 0xdb, 0x7b, 0x69, 0xb7,
+	0x9c, 0xbe, 0xd5, 0xeb, 0x3e, 0x7d, 0xed, 0xd8, 0xad, 0x57, 0x2d, 0xfb, 0xa4, 0x2c, 0xd5, 0xf0,
+	0x87, 0x8f, 0xf5, 0xdd, 0x6b, 0xf9, 0x36, 0x99, 0x91, 0x98, 0xdd, 0xa8, 0xd2, 0x3d, 0x7e, 0x61,
+	0xd9, 0xad, 0x32, 0xba, 0x41, 0xa5, 0x1b, 0x8c, 0x21, 0x26, 0xb5, 0xd2, 0xfb, 0xcf, 0xaa, 0x64,
+	0xbe, 0xbd, 0x58, 0xa8, 0xe8, 0x72, 0xa1, 0xa2, 0x9f, 0x0b, 0x15, 0x9d, 0x2f, 0x55, 0xe9, 0x72,
+	0xa9, 0x4a, 0xdf, 0x97, 0xaa, 0xf4, 0xc6, 0x0c, 0x28, 0x1b, 0x4e, 0x3d, 0xdd, 0x87, 0xc8, 0x58,
+	0x3d, 0xe0, 0xfd, 0xd0, 0xf5, 0x92, 0x02, 0x18, 0xb3, 0x83, 0x43, 0xe3, 0xec, 0xca, 0x5f, 0xd8,
+	0xff, 0xfb, 0x19, 0xd8, 0x7c, 0x42, 0x12, 0xef, 0x0e, 0x8f, 0xf0, 0xf1, 0xef, 0x01, 0x00, 0x20,
+	0xd3, 0xfe, 0x8b, 0x3a, 0x03, 0x00, 0x00,
+}
+
+func (this *PoolHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolHook)
+	if !ok {
+		that2, ok := that.(PoolHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ActionPrefix != that1.ActionPrefix {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *PoolHookError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolHookError)
+	if !ok {
+		that2, ok := that.(PoolHookError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (m *PoolHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintPoolHooks(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintPoolHooks(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActionPrefix) > 0 {
+		i -= len(m.ActionPrefix)
+		copy(dAtA[i:], m.ActionPrefix)
+		i = encodeVarintPoolHooks(dAtA, i, uint64(len(m.ActionPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHookError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHookError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHookError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPoolHooks(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintPoolHooks(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPoolHooks(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoolHooks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoolHooks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionPrefix)
+	if l > 0 {
+		n += 1 + l + sovPoolHooks(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovPoolHooks(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovPoolHooks(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *PoolHookError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPoolHooks(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovPoolHooks(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPoolHooks(uint64(l))
+	return n
+}
+
+func sovPoolHooks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPoolHooks(x uint64) (n int) {
+	return sovPoolHooks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolHooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolHooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolHookError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolHooks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolHookError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolHookError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolHooks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolHooks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPoolHooks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPoolHooks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolHooks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPoolHooks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPoolHooks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPoolHooks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPoolHooks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPoolHooks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPoolHooks = fmt.Errorf("proto: unexpected end of group")
+)
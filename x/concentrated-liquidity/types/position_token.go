@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const (
+	// PositionTokenPrefix is the prefix of the denoms of the tokens representing the ownership of tokenized positions.
+	PositionTokenPrefix = "cl/position"
+
+	positionTokenCustodyAddressPrefix = "positionTokens"
+)
+
+// PositionTokenCustodyAddress is the address owning the tokenized positions while their tokens are outstanding.
+var PositionTokenCustodyAddress = osmoutils.NewModuleAddressWithPrefix(ModuleName, positionTokenCustodyAddressPrefix, nil)
+
+// GetPositionTokenDenom returns the denom of the token representing the ownership of the position with the given id.
+func GetPositionTokenDenom(positionId uint64) string {
+	return fmt.Sprintf("%s/%d", PositionTokenPrefix, positionId)
+}
+
+// GetPositionIdFromTokenDenom returns the id of the position represented by the given position token denom.
+func GetPositionIdFromTokenDenom(denom string) (uint64, error) {
+	positionIdStr, found := strings.CutPrefix(denom, PositionTokenPrefix+"/")
+	if !found {
+		return 0, fmt.Errorf("denom (%s) is not a position token denom", denom)
+	}
+	positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid position id in position token denom (%s): %w", denom, err)
+	}
+	return positionId, nil
+}
+
+// NewPositionToken returns the token representing the ownership of the position with the given id.
+func NewPositionToken(positionId uint64) sdk.Coin {
+	return sdk.NewInt64Coin(GetPositionTokenDenom(positionId), 1)
+}
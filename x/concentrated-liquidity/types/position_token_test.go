@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+)
+
+func TestGetPositionIdFromTokenDenom(t *testing.T) {
+	testCases := []struct {
+		name               string
+		denom              string
+		expectedPositionId uint64
+		expectErr          bool
+	}{
+		{
+			name:               "valid denom",
+			denom:              types.GetPositionTokenDenom(10),
+			expectedPositionId: 10,
+		},
+		{
+			name:      "pool share denom",
+			denom:     types.GetConcentratedLockupDenomFromPoolId(10),
+			expectErr: true,
+		},
+		{
+			name:      "missing position id",
+			denom:     types.PositionTokenPrefix + "/",
+			expectErr: true,
+		},
+		{
+			name:      "invalid position id",
+			denom:     types.PositionTokenPrefix + "/ten",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			positionId, err := types.GetPositionIdFromTokenDenom(tc.denom)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPositionId, positionId)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const (
+	rangeOrderEscrowAddressPrefix = "rangeOrders"
+
+	// MaxRangeOrdersSettledPerBlock is the maximum number of crossed range orders withdrawn at the end
+	// of a block. Crossed orders beyond it are settled in the next blocks or when claimed.
+	MaxRangeOrdersSettledPerBlock = 20
+)
+
+// RangeOrderEscrowAddress returns the address owning the positions of the range orders of the given pool
+// and holding the proceeds of its filled range orders until claimed.
+func RangeOrderEscrowAddress(poolId uint64) sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(ModuleName, rangeOrderEscrowAddressPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+// IsFillable returns true if the price has crossed the range of the order, i.e. its position holds
+// only the token it buys at the given current tick.
+func (o RangeOrder) IsFillable(sellsToken0 bool, currentTick int64) bool {
+	if sellsToken0 {
+		return currentTick >= o.UpperTick
+	}
+	return currentTick < o.LowerTick
+}
+
+// FillTick returns the tick the current tick must cross for the order to fill.
+// Token0 orders fill once the current tick reaches their upper tick, token1 orders once it falls below their lower tick.
+func (o RangeOrder) FillTick(sellsToken0 bool) int64 {
+	if sellsToken0 {
+		return o.UpperTick
+	}
+	return o.LowerTick
+}
+
+// Validate performs stateless validation of a range order.
+func (o RangeOrder) Validate() error {
+	if o.OrderId == 0 {
+		return fmt.Errorf("range order id cannot be zero")
+	}
+	if o.PoolId == 0 {
+		return fmt.Errorf("range order (%d) pool id cannot be zero", o.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return fmt.Errorf("range order (%d) has invalid owner address (%s)", o.OrderId, err)
+	}
+	if o.LowerTick >= o.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: o.LowerTick, UpperTick: o.UpperTick}
+	}
+	if !o.TokenIn.IsValid() || !o.TokenIn.IsPositive() {
+		return fmt.Errorf("range order (%d) has invalid token in (%s)", o.OrderId, o.TokenIn)
+	}
+	switch o.Status {
+	case RangeOrderStatusOpen:
+		if !o.Claimable.Empty() {
+			return fmt.Errorf("open range order (%d) cannot have claimable tokens (%s)", o.OrderId, o.Claimable)
+		}
+	case RangeOrderStatusFilled:
+		if !o.Claimable.IsValid() {
+			return fmt.Errorf("range order (%d) has invalid claimable tokens (%s)", o.OrderId, o.Claimable)
+		}
+	default:
+		return fmt.Errorf("range order (%d) has invalid status (%s)", o.OrderId, o.Status)
+	}
+	return nil
+}
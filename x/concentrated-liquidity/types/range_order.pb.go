@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/range_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RangeOrderStatus is the settlement status of a range order.
+type RangeOrderStatus int32
+
+const (
+	// RANGE_ORDER_STATUS_UNSPECIFIED is only used to filter range orders by
+	// status in queries and never set on a stored range order.
+	RangeOrderStatusUnspecified RangeOrderStatus = 0
+	// RANGE_ORDER_STATUS_OPEN is the status of a range order whose position
+	// still provides liquidity to the pool.
+	RangeOrderStatusOpen RangeOrderStatus = 1
+	// RANGE_ORDER_STATUS_FILLED is the status of a range order whose position
+	// was withdrawn after the price crossed its range. Its proceeds are
+	// claimable by the owner.
+	RangeOrderStatusFilled RangeOrderStatus = 2
+)
+
+var RangeOrderStatus_name = map[int32]string{
+	0: "RANGE_ORDER_STATUS_UNSPECIFIED",
+	1: "RANGE_ORDER_STATUS_OPEN",
+	2: "RANGE_ORDER_STATUS_FILLED",
+}
+
+var RangeOrderStatus_value = map[string]int32{
+	"RANGE_ORDER_STATUS_UNSPECIFIED": 0,
+	"RANGE_ORDER_STATUS_OPEN":        1,
+	"RANGE_ORDER_STATUS_FILLED":      2,
+}
+
+func (x RangeOrderStatus) String() string {
+	return proto.EnumName(RangeOrderStatus_name, int32(x))
+}
+
+func (RangeOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ff37f180961f2827, []int{0}
+}
+
+// RangeOrder is a single tick spacing position placed on behalf of an owner
+// that is withdrawn automatically once a swap moves the price across its
+// range. The position is held by the range order escrow of the pool and
+// shares its id with the range order.
+type RangeOrder struct {
+	OrderId   uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	PoolId    uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64  `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// token_in is the amount of the single token deposited into the position.
+	TokenIn types.Coin       `protobuf:"bytes,6,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	Status  RangeOrderStatus `protobuf:"varint,7,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.RangeOrderStatus" json:"status,omitempty" yaml:"status"`
+	// claimable is the amount withdrawn from the position upon fill, spread
+	// rewards and incentives included. Empty while the order is open.
+	Claimable  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable" yaml:"claimable"`
+	PlacedTime time.Time                                `protobuf:"bytes,9,opt,name=placed_time,json=placedTime,proto3,stdtime" json:"placed_time" yaml:"placed_time"`
+}
+
+func (m *RangeOrder) Reset()         { *m = RangeOrder{} }
+func (m *RangeOrder) String() string { return proto.CompactTextString(m) }
+func (*RangeOrder) ProtoMessage()    {}
+func (*RangeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff37f180961f2827, []int{0}
+}
+func (m *RangeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrder.Merge(m, src)
+}
+func (m *RangeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeOrder proto.InternalMessageInfo
+
+func (m *RangeOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RangeOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *RangeOrder) GetStatus() RangeOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RangeOrderStatusUnspecified
+}
+
+func (m *RangeOrder) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+func (m *RangeOrder) GetPlacedTime() time.Time {
+	if m != nil {
+		return m.PlacedTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.concentratedliquidity.v1beta1.RangeOrderStatus", RangeOrderStatus_name, RangeOrderStatus_value)
+	proto.RegisterType((*RangeOrder)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrder")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/range_order.proto", fileDescriptor_ff37f180961f2827)
+}
+
+var fileDescriptor_ff37f180961f2827 = []byte{
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0x3e, 0x12, 0x32, 0xdc, 0xcb, 0x0d, 0xbe, 0xb4, 0x18, 0x57, 0xb2, 0x2d, 0x4b,
+	0xad, 0xa2, 0x56, 0x8c, 0x05, 0xfd, 0x40, 0xed, 0x0e, 0x93, 0x50, 0x45, 0xa2, 0x04, 0x4d, 0xc2,
+	0xa6, 0xad, 0x64, 0xf9, 0x63, 0x48, 0x47, 0x71, 0x3c, 0xae, 0x3d, 0x81, 0xb2, 0xe8, 0xbe, 0x42,
+	0x5d, 0xf0, 0x02, 0xac, 0xba, 0xeb, 0x93, 0xb0, 0x2b, 0xcb, 0xae, 0x42, 0x05, 0x6f, 0x90, 0x27,
+	0xa8, 0x3c, 0xb6, 0x71, 0x1b, 0xa1, 0xaa, 0x2b, 0x9f, 0xe3, 0x73, 0x7e, 0x67, 0x8e, 0xfe, 0xf3,
+	0xd7, 0x80, 0x0d, 0x1a, 0x0f, 0x68, 0x4c, 0x62, 0xc3, 0xa5, 0x81, 0x8b, 0x03, 0x16, 0xd9, 0x0c,
+	0x7b, 0x3e, 0x79, 0x3f, 0x24, 0x1e, 0x61, 0xc7, 0xc6, 0xe1, 0x9a, 0x83, 0x99, 0xbd, 0x66, 0x44,
+	0x76, 0xd0, 0xc3, 0x16, 0x8d, 0x3c, 0x1c, 0xc1, 0x30, 0xa2, 0x8c, 0x8a, 0xf7, 0x33, 0x10, 0xde,
+	0x0a, 0xc2, 0x0c, 0x94, 0x97, 0x7a, 0xb4, 0x47, 0x39, 0x61, 0x24, 0x51, 0x0a, 0xcb, 0x8a, 0xcb,
+	0x69, 0xc3, 0xb1, 0x63, 0x7c, 0x73, 0x86, 0x4b, 0x49, 0x90, 0xd5, 0xd5, 0x1e, 0xa5, 0x3d, 0x1f,
+	0x1b, 0x3c, 0x73, 0x86, 0x07, 0x06, 0x23, 0x03, 0x1c, 0x33, 0x7b, 0x10, 0xa6, 0x0d, 0xfa, 0xe7,
+	0x59, 0x00, 0x50, 0xb2, 0x53, 0x3b, 0x59, 0x49, 0x84, 0x60, 0x8e, 0xef, 0x66, 0x11, 0x4f, 0x12,
+	0x34, 0xa1, 0x3e, 0x63, 0xfe, 0x3f, 0x1e, 0xa9, 0xff, 0x1d, 0xdb, 0x03, 0xff, 0x85, 0x9e, 0x57,
+	0x74, 0x54, 0xe1, 0x61, 0xcb, 0x13, 0x1f, 0x81, 0x4a, 0x48, 0xa9, 0x9f, 0xb4, 0x4f, 0xf1, 0x76,
+	0x71, 0x3c, 0x52, 0x17, 0xd2, 0xf6, 0xac, 0xa0, 0xa3, 0x72, 0x12, 0xb5, 0x3c, 0xf1, 0x01, 0x98,
+	0xa5, 0x47, 0x01, 0x8e, 0xa4, 0x69, 0x4d, 0xa8, 0x57, 0xcd, 0xda, 0x78, 0xa4, 0xfe, 0x93, 0x4d,
+	0x4e, 0x7e, 0xeb, 0x28, 0x2d, 0x8b, 0x4f, 0x00, 0xf0, 0xe9, 0x11, 0x8e, 0x2c, 0x46, 0xdc, 0xbe,
+	0x34, 0xa3, 0x09, 0xf5, 0x69, 0xf3, 0xce, 0x78, 0xa4, 0x2e, 0xa6, 0xcd, 0x45, 0x4d, 0x47, 0x55,
+	0x9e, 0x74, 0x89, 0xdb, 0x4f, 0xa8, 0x61, 0x18, 0xe6, 0xd4, 0xec, 0x24, 0x55, 0xd4, 0x74, 0x54,
+	0xe5, 0x09, 0xa7, 0x5e, 0x81, 0x39, 0x46, 0xfb, 0x38, 0xb0, 0x48, 0x20, 0x95, 0x35, 0xa1, 0x3e,
+	0xbf, 0xbe, 0x02, 0x53, 0x4d, 0x61, 0xa2, 0x69, 0x2e, 0x3f, 0xdc, 0xa2, 0x24, 0x30, 0x97, 0xcf,
+	0x47, 0x6a, 0xa9, 0xd0, 0x23, 0x07, 0x75, 0x54, 0xe1, 0x61, 0x2b, 0x10, 0x1d, 0x50, 0x8e, 0x99,
+	0xcd, 0x86, 0xb1, 0x54, 0xd1, 0x84, 0xfa, 0xc2, 0xfa, 0x06, 0xfc, 0xab, 0xdb, 0x85, 0xc5, 0x15,
+	0x74, 0x38, 0x6e, 0x2e, 0x8e, 0x47, 0xea, 0xbf, 0xe9, 0x31, 0xe9, 0x40, 0x1d, 0x65, 0x93, 0xc5,
+	0x8f, 0xa0, 0xea, 0xfa, 0x36, 0x19, 0xd8, 0x8e, 0x8f, 0xa5, 0x39, 0x6d, 0xfa, 0xcf, 0x3b, 0x37,
+	0xb2, 0x9d, 0x6b, 0xe9, 0xb0, 0x1b, 0x52, 0xff, 0x7a, 0xa9, 0xd6, 0x7b, 0x84, 0xbd, 0x1b, 0x3a,
+	0xd0, 0xa5, 0x03, 0x23, 0x33, 0x52, 0xfa, 0x59, 0x8d, 0xbd, 0xbe, 0xc1, 0x8e, 0x43, 0x1c, 0xf3,
+	0x21, 0x31, 0x2a, 0x4e, 0x14, 0xdf, 0x80, 0xf9, 0xd0, 0xb7, 0x5d, 0xec, 0x59, 0x89, 0x97, 0xa4,
+	0x2a, 0x17, 0x4d, 0x86, 0xa9, 0xd1, 0x60, 0x6e, 0x34, 0xd8, 0xcd, 0x8d, 0x66, 0x2a, 0xd9, 0x06,
+	0x62, 0x66, 0x8b, 0x02, 0xd6, 0x4f, 0x2f, 0x55, 0x01, 0x81, 0xf4, 0x4f, 0x02, 0x3c, 0xfc, 0x26,
+	0x80, 0xda, 0xa4, 0x16, 0xe2, 0x16, 0x50, 0xd0, 0xe6, 0xee, 0xcb, 0xa6, 0xd5, 0x46, 0x8d, 0x26,
+	0xb2, 0x3a, 0xdd, 0xcd, 0xee, 0x7e, 0xc7, 0xda, 0xdf, 0xed, 0xec, 0x35, 0xb7, 0x5a, 0xdb, 0xad,
+	0x66, 0xa3, 0x56, 0x92, 0xd5, 0x93, 0x33, 0xed, 0xde, 0x24, 0xb9, 0x1f, 0xc4, 0x21, 0x76, 0xc9,
+	0x01, 0xc1, 0x9e, 0xf8, 0x14, 0x2c, 0xdf, 0x32, 0xa4, 0xbd, 0xd7, 0xdc, 0xad, 0x09, 0xb2, 0x74,
+	0x72, 0xa6, 0x2d, 0x4d, 0xd2, 0xed, 0x10, 0x07, 0xe2, 0x73, 0xb0, 0x72, 0x0b, 0xb6, 0xdd, 0xda,
+	0xd9, 0x69, 0x36, 0x6a, 0x53, 0xb2, 0x7c, 0x72, 0xa6, 0xdd, 0x9d, 0x04, 0xb7, 0x89, 0xef, 0x63,
+	0x4f, 0x9e, 0xf9, 0xf4, 0x45, 0x29, 0x99, 0x6f, 0xcf, 0xaf, 0x14, 0xe1, 0xe2, 0x4a, 0x11, 0x7e,
+	0x5c, 0x29, 0xc2, 0xe9, 0xb5, 0x52, 0xba, 0xb8, 0x56, 0x4a, 0xdf, 0xaf, 0x95, 0xd2, 0x6b, 0xf3,
+	0x17, 0xf5, 0x33, 0x97, 0xac, 0xfa, 0xb6, 0x13, 0xe7, 0x89, 0x71, 0xb8, 0xfe, 0xcc, 0xf8, 0xf0,
+	0xdb, 0x7b, 0xb2, 0x5a, 0x3c, 0x28, 0xfc, 0x76, 0x9c, 0x32, 0xd7, 0xfb, 0xf1, 0xcf, 0x01, 0x00,
+	0xbe, 0x1e, 0xdb, 0xea, 0x7e, 0x04, 0x00, 0x00,
+}
+
+func (m *RangeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PlacedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlacedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRangeOrder(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRangeOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRangeOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRangeOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRangeOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRangeOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RangeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovRangeOrder(uint64(m.OrderId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovRangeOrder(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRangeOrder(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovRangeOrder(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovRangeOrder(uint64(m.UpperTick))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovRangeOrder(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRangeOrder(uint64(m.Status))
+	}
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovRangeOrder(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlacedTime)
+	n += 1 + l + sovRangeOrder(uint64(l))
+	return n
+}
+
+func sovRangeOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRangeOrder(x uint64) (n int) {
+	return sovRangeOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RangeOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRangeOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RangeOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PlacedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRangeOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRangeOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRangeOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRangeOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRangeOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRangeOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRangeOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRangeOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRangeOrder = fmt.Errorf("proto: unexpected end of group")
+)
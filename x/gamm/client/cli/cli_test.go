@@ -146,6 +146,78 @@ func TestNewCreatePoolCmd(t *testing.T) {
 			),
 			true,
 		},
+		"sale params": {
+			fmt.Sprintf(`
+				{
+					"%s": "1node0token,3stake",
+					"%s": "100node0token,100stake",
+					"%s": "0.001",
+					"%s": "0.001",
+					"%s": {
+						"%s": "node0token",
+						"%s": "2006-01-02T15:04:05Z",
+						"%s": "2006-01-05T15:04:05Z",
+						"%s": "10"
+					}
+				}
+				`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSaleParams, cli.PoolFileSaleDenom, cli.PoolFileSaleStartTime, cli.PoolFileSaleEndTime, cli.PoolFilePurchaseCap,
+			),
+			false,
+		},
+		"sale params - no purchase cap": {
+			fmt.Sprintf(`
+				{
+					"%s": "1node0token,3stake",
+					"%s": "100node0token,100stake",
+					"%s": "0.001",
+					"%s": "0.001",
+					"%s": {
+						"%s": "node0token",
+						"%s": "2006-01-02T15:04:05Z",
+						"%s": "2006-01-05T15:04:05Z"
+					}
+				}
+				`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSaleParams, cli.PoolFileSaleDenom, cli.PoolFileSaleStartTime, cli.PoolFileSaleEndTime,
+			),
+			false,
+		},
+		"sale params - invalid start time": {
+			fmt.Sprintf(`
+				{
+					"%s": "1node0token,3stake",
+					"%s": "100node0token,100stake",
+					"%s": "0.001",
+					"%s": "0.001",
+					"%s": {
+						"%s": "node0token",
+						"%s": "2006-01-02",
+						"%s": "2006-01-05T15:04:05Z"
+					}
+				}
+				`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSaleParams, cli.PoolFileSaleDenom, cli.PoolFileSaleStartTime, cli.PoolFileSaleEndTime,
+			),
+			true,
+		},
+		"sale params - missing end time": {
+			fmt.Sprintf(`
+				{
+					"%s": "1node0token,3stake",
+					"%s": "100node0token,100stake",
+					"%s": "0.001",
+					"%s": "0.001",
+					"%s": {
+						"%s": "node0token",
+						"%s": "2006-01-02T15:04:05Z"
+					}
+				}
+				`, cli.PoolFileWeights, cli.PoolFileInitialDeposit, cli.PoolFileSwapFee, cli.PoolFileExitFee,
+				cli.PoolFileSaleParams, cli.PoolFileSaleDenom, cli.PoolFileSaleStartTime,
+			),
+			true,
+		},
 		"unknown fields in json": {
 			fmt.Sprintf(`
 			{
@@ -376,3 +448,18 @@ func TestGetCmdEstimateSwapExactAmountOut(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdLiquidityBootstrappingPriceCurve(t *testing.T) {
+	desc, _ := cli.GetCmdLiquidityBootstrappingPriceCurve()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryLiquidityBootstrappingPriceCurveRequest]{
+		"basic test": {
+			Cmd:           "1",
+			ExpectedQuery: &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: 1},
+		},
+		"with num points": {
+			Cmd:           "1 --num-points=20",
+			ExpectedQuery: &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: 1, NumPoints: 20},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
@@ -21,6 +21,12 @@ const (
 	PoolFileDuration                 = "duration"
 	PoolFileTargetPoolWeights        = "target-pool-weights"
 
+	PoolFileSaleParams    = "sale-params"
+	PoolFileSaleDenom     = "sale-denom"
+	PoolFileSaleStartTime = "sale-start-time"
+	PoolFileSaleEndTime   = "sale-end-time"
+	PoolFilePurchaseCap   = "purchase-cap"
+
 	FlagPoolId = "pool-id"
 	// Will be parsed to osmomath.Int.
 	FlagShareAmountOut = "share-amount-out"
@@ -45,6 +51,9 @@ const (
 	FlagMigrationRecords = "migration-records"
 
 	FlagPoolRecords = "pool-records"
+
+	// Will be parsed to uint64.
+	FlagNumPoints = "num-points"
 )
 
 type createBalancerPoolInputs struct {
@@ -54,6 +63,7 @@ type createBalancerPoolInputs struct {
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
+	SaleParams               saleParamsInputs               `json:"sale-params"`
 }
 
 type createStableswapPoolInputs struct {
@@ -71,6 +81,13 @@ type smoothWeightChangeParamsInputs struct {
 	TargetPoolWeights string `json:"target-pool-weights"`
 }
 
+type saleParamsInputs struct {
+	SaleDenom     string `json:"sale-denom"`
+	SaleStartTime string `json:"sale-start-time"`
+	SaleEndTime   string `json:"sale-end-time"`
+	PurchaseCap   string `json:"purchase-cap"`
+}
+
 func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagSwapRoutePoolIds, "", "swap route pool id")
@@ -114,6 +131,12 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetNumPoints() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagNumPoints, 0, "The number of points of the price curve to return, 0 for the default")
+	return fs
+}
+
 func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs := FlagSetJustPoolId()
 	fs.String(FlagScalingFactors, "", "The scaling factors")
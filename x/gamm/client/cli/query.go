@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetConcentratedPoolIdLinkFromCFMMRequest)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCFMMConcentratedPoolLinksRequest)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPendingWeightChange)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdLiquidityBootstrappingPriceCurve)
 	cmd.AddCommand(
 		osmocli.GetParams[*types.ParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -364,3 +366,25 @@ func GetCFMMConcentratedPoolLinksRequest() (*osmocli.QueryDescriptor, *types.Que
 {{.CommandPrefix}} cfmm-cl-pool-links`,
 	}, &types.QueryCFMMConcentratedPoolLinksRequest{}
 }
+
+// GetCmdPendingWeightChange returns the pending smooth weight change schedule of a balancer pool.
+func GetCmdPendingWeightChange() (*osmocli.QueryDescriptor, *types.QueryPendingWeightChangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-weight-change",
+		Short: "Query the pending smooth weight change schedule of a balancer pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pending-weight-change 1`,
+	}, &types.QueryPendingWeightChangeRequest{}
+}
+
+// GetCmdLiquidityBootstrappingPriceCurve returns the implied price curve of the sale denom of a liquidity bootstrapping pool.
+func GetCmdLiquidityBootstrappingPriceCurve() (*osmocli.QueryDescriptor, *types.QueryLiquidityBootstrappingPriceCurveRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "lbp-price-curve",
+		Short: "Query the implied price curve of the sale denom of a liquidity bootstrapping pool until the end of the sale",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} lbp-price-curve 1 --num-points 20`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetNumPoints()}},
+		CustomFlagOverrides: map[string]string{"numpoints": FlagNumPoints},
+	}, &types.QueryLiquidityBootstrappingPriceCurveRequest{}
+}
@@ -41,6 +41,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapRampScalingFactorsCmd(),
+		NewStableSwapSetRateProviderCmd(),
+		NewStableSwapRampAmplificationCmd(),
+		NewScheduleWeightChangeCmd(),
 	)
 	return txCmd
 }
@@ -184,6 +188,58 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampScalingFactorsCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "ramp-scaling-factors [pool-id] [target-scaling-factors] [duration]",
+		Short:            "linearly ramp the scaling factors of a stableswap pool to the target scaling factors over the duration",
+		Example:          "osmosisd tx gamm ramp-scaling-factors 1 1000000,1021000 24h",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewStableSwapRampScalingFactorsMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewStableSwapSetRateProviderCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-rate-provider [pool-id] [contract-address] [max-change-per-block]",
+		Short: "set the rate provider contract the scaling factors of a stableswap pool are pulled from every block",
+		Long: `Set the rate provider contract the scaling factors of a stableswap pool are pulled from at the beginning of every block.
+Each scaling factor changes by at most max-change-per-block relative to its current value per block.
+Only the governance module account may set a rate provider, so the message is meant to be submitted through a governance proposal.
+An empty contract address removes the rate provider of the pool, which the scaling factor controller of the pool may also do.`,
+		Example:          "osmosisd tx gamm set-rate-provider 1 osmo1contract 0.001",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewStableSwapSetRateProviderMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewStableSwapRampAmplificationCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "ramp-amplification [pool-id] [target-amplification] [end-time]",
+		Short: "linearly ramp the amplification of a stableswap pool to the target amplification until the end time",
+		Long: `Linearly ramp the amplification of a stableswap pool from its current value to the target amplification,
+starting now and ending at the end time, given as a unix timestamp in seconds.
+The target amplification may differ from the current amplification by at most a factor of 10, and the ramp must last at least 24 hours.
+Only the governance module account may ramp the amplification, so the message is meant to be submitted through a governance proposal.`,
+		Example:          "osmosisd tx gamm ramp-amplification 1 100 1700000000",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewStableSwapRampAmplificationMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewScheduleWeightChangeCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "schedule-weight-change [pool-id] [target-weights] [duration] [start-time]",
+		Short: "schedule a smooth weight change of a balancer pool",
+		Long: `Schedule a smooth weight change of a balancer pool, replacing any pending one.
+The weights change linearly from their current values to the target weights over the duration, starting at the
+start time, given as a unix timestamp in seconds. A start time of 0 starts the change at the current block time.
+Only the pool creator or the governance module account may schedule weight changes.`,
+		Example:          "osmosisd tx gamm schedule-weight-change 1 1uatom,3uosmo 72h 1700000000",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewScheduleWeightChangeMsg,
+	}.BuildCommandCustomFn()
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -490,6 +546,34 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 		msg.PoolParams.SmoothWeightChangeParams = &smoothWeightParams
 	}
 
+	if (pool.SaleParams != saleParamsInputs{}) {
+		saleStartTime, err := time.Parse(time.RFC3339, pool.SaleParams.SaleStartTime)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse sale start time: %w", err)
+		}
+
+		saleEndTime, err := time.Parse(time.RFC3339, pool.SaleParams.SaleEndTime)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse sale end time: %w", err)
+		}
+
+		purchaseCap := osmomath.ZeroInt()
+		if pool.SaleParams.PurchaseCap != "" {
+			var ok bool
+			purchaseCap, ok = osmomath.NewIntFromString(pool.SaleParams.PurchaseCap)
+			if !ok {
+				return nil, fmt.Errorf("could not parse purchase cap %s", pool.SaleParams.PurchaseCap)
+			}
+		}
+
+		msg.PoolParams.LiquidityBootstrappingParams = &balancer.LiquidityBootstrappingParams{
+			SaleDenom:     pool.SaleParams.SaleDenom,
+			SaleStartTime: saleStartTime,
+			SaleEndTime:   saleEndTime,
+			PurchaseCap:   purchaseCap,
+		}
+	}
+
 	return msg, nil
 }
 
@@ -663,6 +747,110 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		return nil, err
 	}
 
+	scalingFactors, err := parseScalingFactors(scalingFactorsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+	}
+
+	return msg, nil
+}
+
+func NewStableSwapRampScalingFactorsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetScalingFactors, err := parseScalingFactors(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stableswap.NewMsgStableSwapRampScalingFactors(clientCtx.GetFromAddress().String(), poolID, targetScalingFactors, duration)
+	return &msg, nil
+}
+
+func NewStableSwapSetRateProviderMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxChangePerBlock, err := osmomath.NewDecFromStr(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stableswap.NewMsgStableSwapSetRateProvider(clientCtx.GetFromAddress().String(), poolID, args[1], maxChangePerBlock)
+	return &msg, nil
+}
+
+func NewStableSwapRampAmplificationMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetAmplification, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := osmocli.ParseUnixTime(args[2], "end-time")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stableswap.NewMsgStableSwapRampAmplification(clientCtx.GetFromAddress().String(), poolID, targetAmplification, endTime)
+	return &msg, nil
+}
+
+func NewScheduleWeightChangeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolWeightCoins, err := sdk.ParseDecCoins(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolWeights := make([]balancer.PoolAsset, len(targetPoolWeightCoins))
+	for i, targetPoolWeightCoin := range targetPoolWeightCoins {
+		targetPoolWeights[i] = balancer.PoolAsset{
+			Weight: targetPoolWeightCoin.Amount.RoundInt(),
+			Token:  sdk.NewCoin(targetPoolWeightCoin.Denom, osmomath.ZeroInt()),
+		}
+	}
+
+	duration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	startTime, err := osmocli.ParseUnixTime(args[3], "start-time")
+	if err != nil {
+		return nil, err
+	}
+
+	msg := balancer.NewMsgScheduleWeightChange(clientCtx.GetFromAddress().String(), poolID, startTime, duration, targetPoolWeights)
+	return &msg, nil
+}
+
+// parseScalingFactors parses comma-separated scaling factors.
+func parseScalingFactors(scalingFactorsStr string) ([]uint64, error) {
 	scalingFactorsStrSlice := strings.Split(scalingFactorsStr, ",")
 
 	scalingFactors := make([]uint64, len(scalingFactorsStrSlice))
@@ -673,14 +861,7 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		}
 		scalingFactors[i] = scalingFactor
 	}
-
-	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
-	}
-
-	return msg, nil
+	return scalingFactors, nil
 }
 
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 )
@@ -27,6 +28,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		if stableswapPool, ok := pool.(*stableswap.Pool); ok {
+			k.setRateProviderPoolIndex(ctx, stableswapPool)
+		}
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
@@ -41,6 +45,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	} else {
 		k.SetMigrationRecords(ctx, *genState.MigrationRecords)
 	}
+
+	for _, purchase := range genState.LiquidityBootstrappingPurchases {
+		k.setLiquidityBootstrappingPurchase(ctx, purchase.PoolId, sdk.MustAccAddressFromBech32(purchase.Address), purchase.Amount)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -62,9 +70,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		poolAnys = append(poolAnys, any)
 	}
 	return &types.GenesisState{
-		NextPoolNumber:   k.GetNextPoolId(ctx),
-		Pools:            poolAnys,
-		Params:           k.GetParams(ctx),
-		MigrationRecords: &migrationInfo,
+		NextPoolNumber:                  k.GetNextPoolId(ctx),
+		Pools:                           poolAnys,
+		Params:                          k.GetParams(ctx),
+		MigrationRecords:                &migrationInfo,
+		LiquidityBootstrappingPurchases: k.getAllLiquidityBootstrappingPurchases(ctx),
 	}
 }
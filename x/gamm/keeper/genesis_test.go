@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
 var DefaultMigrationRecords = gammmigration.MigrationRecords{BalancerToConcentratedPoolLinks: []gammmigration.BalancerToConcentratedPoolLink{
@@ -127,6 +128,37 @@ func (s *KeeperTestSuite) TestGammExportGenesis() {
 	s.Require().Equal(&DefaultMigrationRecords, genesis.MigrationRecords)
 }
 
+func (s *KeeperTestSuite) TestGammGenesis_LiquidityBootstrappingPurchases() {
+	s.SetupTest()
+
+	poolId, saleStartTime, _ := s.prepareLiquidityBootstrappingPool(osmomath.NewInt(1_000_000))
+	ctx := s.Ctx.WithBlockTime(saleStartTime)
+	for _, buyer := range s.TestAccs[1:3] {
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000)))
+		_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(ctx, buyer, []poolmanagertypes.SwapAmountInRoute{
+			{PoolId: poolId, TokenOutDenom: saleDenom},
+		}, sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000), osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+
+	genesis := s.App.GAMMKeeper.ExportGenesis(ctx)
+	s.Require().Len(genesis.LiquidityBootstrappingPurchases, 2)
+	for _, purchase := range genesis.LiquidityBootstrappingPurchases {
+		s.Require().Equal(poolId, purchase.PoolId)
+		s.Require().True(purchase.Amount.IsPositive())
+	}
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.GAMMKeeper.InitGenesis(s.Ctx, *genesis, s.App.AppCodec())
+
+	for _, purchase := range genesis.LiquidityBootstrappingPurchases {
+		buyer, err := sdk.AccAddressFromBech32(purchase.Address)
+		s.Require().NoError(err)
+		s.Require().Equal(purchase.Amount, s.App.GAMMKeeper.GetLiquidityBootstrappingPurchase(s.Ctx, poolId, buyer))
+	}
+}
+
 func (s *KeeperTestSuite) TestMarshalUnmarshalGenesis() {
 	s.SetupTest()
 	ctx := s.Ctx
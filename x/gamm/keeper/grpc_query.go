@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -329,6 +330,112 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	}
 }
 
+// PendingWeightChange returns the pending smooth weight change schedule of a balancer pool.
+// The schedule is empty if the pool has no pending or ongoing weight change.
+func (q Querier) PendingWeightChange(ctx context.Context, req *types.QueryPendingWeightChangeRequest) (*types.QueryPendingWeightChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("pool %d is not a balancer pool", req.PoolId))
+	}
+
+	if balancerPool.PoolParams.SmoothWeightChangeParams == nil {
+		return &types.QueryPendingWeightChangeResponse{}, nil
+	}
+
+	any, err := codectypes.NewAnyWithValue(balancerPool.PoolParams.SmoothWeightChangeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingWeightChangeResponse{
+		SmoothWeightChangeParams: any,
+	}, nil
+}
+
+// LiquidityBootstrappingPriceCurve returns the price of the sale denom of a liquidity bootstrapping pool
+// implied by its weights at evenly spaced points in time from now, or the sale start if the sale has not
+// started yet, until the sale end, assuming no further swaps against the pool.
+// If the sale has ended, only the current price is returned.
+func (q Querier) LiquidityBootstrappingPriceCurve(ctx context.Context, req *types.QueryLiquidityBootstrappingPriceCurveRequest) (*types.QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	numPoints := req.NumPoints
+	if numPoints == 0 {
+		numPoints = types.DefaultLiquidityBootstrappingPriceCurvePoints
+	}
+	if numPoints > types.MaxLiquidityBootstrappingPriceCurvePoints {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("num points must be at most %d, got %d", types.MaxLiquidityBootstrappingPriceCurvePoints, numPoints))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok || !balancerPool.IsLiquidityBootstrappingPool() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("pool %d is not a liquidity bootstrapping pool", req.PoolId))
+	}
+
+	quoteDenom, err := balancerPool.GetLiquidityBootstrappingQuoteDenom()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params := balancerPool.PoolParams.LiquidityBootstrappingParams
+	curveStart := sdkCtx.BlockTime()
+	if curveStart.Before(params.SaleStartTime) {
+		curveStart = params.SaleStartTime
+	}
+	if params.HasSaleEnded(curveStart) {
+		numPoints = 1
+	}
+
+	var step time.Duration
+	if numPoints > 1 {
+		step = params.SaleEndTime.Sub(curveStart) / time.Duration(numPoints-1)
+	}
+
+	priceCurve := make([]types.LiquidityBootstrappingPricePoint, numPoints)
+	for i := range priceCurve {
+		pointTime := curveStart.Add(step * time.Duration(i))
+		if numPoints > 1 && i == len(priceCurve)-1 {
+			pointTime = params.SaleEndTime
+		}
+
+		spotPrice, err := balancerPool.LiquidityBootstrappingSpotPriceAt(sdkCtx, pointTime)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		priceCurve[i] = types.LiquidityBootstrappingPricePoint{
+			Time:      pointTime,
+			SpotPrice: spotPrice.Dec(),
+		}
+	}
+
+	return &types.QueryLiquidityBootstrappingPriceCurveResponse{
+		SaleDenom:  params.SaleDenom,
+		QuoteDenom: quoteDenom,
+		PriceCurve: priceCurve,
+	}, nil
+}
+
 // TotalPoolLiquidity returns total liquidity in pool.
 // Deprecated: please use the alternative in x/poolmanager
 // nolint: staticcheck
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"errors"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
@@ -541,6 +543,97 @@ func (s *KeeperTestSuite) TestPoolType() {
 	s.Require().Equal(stableswap.PoolTypeName, res.PoolType)
 }
 
+func (s *KeeperTestSuite) TestQueryPendingWeightChange() {
+	poolIdBalancer := s.PrepareBalancerPool()
+	poolIdStableswap := s.PrepareBasicStableswapPool()
+
+	// error when querying invalid pool ID
+	_, err := s.queryClient.PendingWeightChange(gocontext.Background(), &types.QueryPendingWeightChangeRequest{PoolId: poolIdStableswap + 1})
+	s.Require().Error(err)
+
+	// error when querying a pool that is not a balancer pool
+	_, err = s.queryClient.PendingWeightChange(gocontext.Background(), &types.QueryPendingWeightChangeRequest{PoolId: poolIdStableswap})
+	s.Require().Error(err)
+
+	// empty when there is no pending weight change
+	res, err := s.queryClient.PendingWeightChange(gocontext.Background(), &types.QueryPendingWeightChangeRequest{PoolId: poolIdBalancer})
+	s.Require().NoError(err)
+	s.Require().Nil(res.SmoothWeightChangeParams)
+
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("baz", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewCoin(appparams.BaseCoinUnit, osmomath.ZeroInt())},
+	}
+	startTime := s.Ctx.BlockTime().Add(time.Hour)
+	msg := balancer.NewMsgScheduleWeightChange(s.TestAccs[0].String(), poolIdBalancer, startTime, time.Hour, targetPoolWeights)
+	_, err = keeper.NewBalancerMsgServerImpl(s.App.GAMMKeeper).ScheduleWeightChange(s.Ctx, &msg)
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.PendingWeightChange(gocontext.Background(), &types.QueryPendingWeightChangeRequest{PoolId: poolIdBalancer})
+	s.Require().NoError(err)
+	var params balancer.SmoothWeightChangeParams
+	err = s.App.AppCodec().Unmarshal(res.SmoothWeightChangeParams.Value, &params)
+	s.Require().NoError(err)
+	s.Require().Equal(startTime.UTC(), params.StartTime.UTC())
+	s.Require().Equal(time.Hour, params.Duration)
+	s.Require().Len(params.InitialPoolWeights, len(targetPoolWeights))
+	s.Require().Len(params.TargetPoolWeights, len(targetPoolWeights))
+}
+
+func (s *KeeperTestSuite) TestQueryLiquidityBootstrappingPriceCurve() {
+	poolIdBalancer := s.PrepareBalancerPool()
+	poolId, saleStartTime, saleEndTime := s.prepareLiquidityBootstrappingPool(osmomath.ZeroInt())
+
+	// error when querying invalid pool ID
+	_, err := s.queryClient.LiquidityBootstrappingPriceCurve(gocontext.Background(), &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: poolId + 1})
+	s.Require().Error(err)
+
+	// error when querying a pool that is not a liquidity bootstrapping pool
+	_, err = s.queryClient.LiquidityBootstrappingPriceCurve(gocontext.Background(), &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: poolIdBalancer})
+	s.Require().Error(err)
+
+	// error when querying too many points
+	_, err = s.queryClient.LiquidityBootstrappingPriceCurve(gocontext.Background(), &types.QueryLiquidityBootstrappingPriceCurveRequest{
+		PoolId:    poolId,
+		NumPoints: types.MaxLiquidityBootstrappingPriceCurvePoints + 1,
+	})
+	s.Require().Error(err)
+
+	// before the sale, the curve spans the whole sale window with the default number of points
+	res, err := s.queryClient.LiquidityBootstrappingPriceCurve(gocontext.Background(), &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(saleDenom, res.SaleDenom)
+	s.Require().Equal(appparams.BaseCoinUnit, res.QuoteDenom)
+	s.Require().Len(res.PriceCurve, int(types.DefaultLiquidityBootstrappingPriceCurvePoints))
+	s.Require().Equal(saleStartTime.UTC(), res.PriceCurve[0].Time.UTC())
+	s.Require().Equal(saleEndTime.UTC(), res.PriceCurve[len(res.PriceCurve)-1].Time.UTC())
+	// the weights go from 9:1 to 1:9 (sale:uosmo) with 9 sale per uosmo in the pool
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.999999999999999999"), res.PriceCurve[0].SpotPrice)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.012345679012345679"), res.PriceCurve[len(res.PriceCurve)-1].SpotPrice)
+	for i := 1; i < len(res.PriceCurve); i++ {
+		s.Require().True(res.PriceCurve[i].SpotPrice.LT(res.PriceCurve[i-1].SpotPrice))
+	}
+
+	querier := keeper.NewQuerier(*s.App.GAMMKeeper)
+
+	// mid sale, the curve starts at the block time
+	midSale := saleStartTime.Add(saleEndTime.Sub(saleStartTime) / 2)
+	res, err = querier.LiquidityBootstrappingPriceCurve(s.Ctx.WithBlockTime(midSale), &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: poolId, NumPoints: 3})
+	s.Require().NoError(err)
+	s.Require().Len(res.PriceCurve, 3)
+	s.Require().Equal(midSale.UTC(), res.PriceCurve[0].Time.UTC())
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.111111111111111111"), res.PriceCurve[0].SpotPrice)
+	s.Require().Equal(saleEndTime.UTC(), res.PriceCurve[2].Time.UTC())
+
+	// after the sale, only the final price is returned
+	res, err = querier.LiquidityBootstrappingPriceCurve(s.Ctx.WithBlockTime(saleEndTime.Add(time.Hour)), &types.QueryLiquidityBootstrappingPriceCurveRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Len(res.PriceCurve, 1)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.012345679012345679"), res.PriceCurve[0].SpotPrice)
+}
+
 func (s *KeeperTestSuite) TestQueryNumPools1() {
 	res, err := s.queryClient.NumPools(gocontext.Background(), &types.QueryNumPoolsRequest{})
 	s.Require().NoError(err)
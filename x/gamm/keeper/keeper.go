@@ -37,6 +37,7 @@ type Keeper struct {
 	concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
 	poolIncentivesKeeper        types.PoolIncentivesKeeper
 	incentivesKeeper            types.IncentivesKeeper
+	wasmKeeper                  types.WasmKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper) Keeper {
@@ -108,3 +109,8 @@ func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentiv
 func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
 	k.incentivesKeeper = incentivesKeeper
 }
+
+// Set the wasm keeper.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
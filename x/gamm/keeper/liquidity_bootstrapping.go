@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// validateJoin returns an error if the joiner may not join the given pool,
+// which is the case for anyone but the pool creator of a liquidity bootstrapping pool.
+func validateJoin(pool poolmanagertypes.PoolI, joiner sdk.AccAddress) error {
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil
+	}
+	return balancerPool.ValidateJoin(joiner)
+}
+
+// validateExit returns an error if the exiter may not exit the given pool,
+// which is the case for anyone but the pool creator of a liquidity bootstrapping pool,
+// and for the pool creator until the sale has ended.
+func validateExit(ctx sdk.Context, pool poolmanagertypes.PoolI, exiter sdk.AccAddress) error {
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil
+	}
+	return balancerPool.ValidateExit(exiter, ctx.BlockTime())
+}
+
+// isLiquidityBootstrappingPool returns true if the given pool is a liquidity bootstrapping pool.
+func isLiquidityBootstrappingPool(pool poolmanagertypes.PoolI) bool {
+	balancerPool, ok := pool.(*balancer.Pool)
+	return ok && balancerPool.IsLiquidityBootstrappingPool()
+}
+
+// recordLiquidityBootstrappingPurchase adds tokenOut to the amount of the sale denom the buyer bought from
+// the given liquidity bootstrapping pool if the pool has a purchase cap.
+// Errors if the total amount bought by the buyer exceeds the purchase cap.
+// It is a no-op for other pools and tokens.
+func (k Keeper) recordLiquidityBootstrappingPurchase(ctx sdk.Context, pool poolmanagertypes.PoolI, buyer sdk.AccAddress, tokenOut sdk.Coin) error {
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok || !balancerPool.IsLiquidityBootstrappingPool() {
+		return nil
+	}
+
+	params := balancerPool.PoolParams.LiquidityBootstrappingParams
+	if tokenOut.Denom != params.SaleDenom || !params.HasPurchaseCap() {
+		return nil
+	}
+
+	purchased := k.GetLiquidityBootstrappingPurchase(ctx, pool.GetId(), buyer).Add(tokenOut.Amount)
+	if purchased.GT(params.PurchaseCap) {
+		return errorsmod.Wrapf(types.ErrLiquidityBootstrappingPurchaseCapExceeded,
+			"%s would have bought %s%s from pool %d, purchase cap is %s", buyer, purchased, params.SaleDenom, pool.GetId(), params.PurchaseCap)
+	}
+
+	k.setLiquidityBootstrappingPurchase(ctx, pool.GetId(), buyer, purchased)
+	return nil
+}
+
+// GetLiquidityBootstrappingPurchase returns the amount of the sale denom the buyer bought from the given
+// liquidity bootstrapping pool. Purchases are only recorded for pools with a purchase cap.
+func (k Keeper) GetLiquidityBootstrappingPurchase(ctx sdk.Context, poolId uint64, buyer sdk.AccAddress) osmomath.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyLiquidityBootstrappingPurchase(poolId, buyer))
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+
+	var amount osmomath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setLiquidityBootstrappingPurchase(ctx sdk.Context, poolId uint64, buyer sdk.AccAddress, amount osmomath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyLiquidityBootstrappingPurchase(poolId, buyer), bz)
+}
+
+// deleteLiquidityBootstrappingPurchases deletes the recorded purchases of the given liquidity bootstrapping pool.
+func (k Keeper) deleteLiquidityBootstrappingPurchases(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixLiquidityBootstrappingPurchases(poolId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keysToDelete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// getAllLiquidityBootstrappingPurchases returns the recorded purchases of all liquidity bootstrapping pools,
+// ordered by pool id.
+func (k Keeper) getAllLiquidityBootstrappingPurchases(ctx sdk.Context) []types.LiquidityBootstrappingPurchase {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLiquidityBootstrappingPurchases)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	purchases := []types.LiquidityBootstrappingPurchase{}
+	for ; iterator.Valid(); iterator.Next() {
+		// keys are the big endian pool id followed by the buyer address
+		key := iterator.Key()
+		var amount osmomath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		purchases = append(purchases, types.LiquidityBootstrappingPurchase{
+			PoolId:  sdk.BigEndianToUint64(key[:8]),
+			Address: sdk.AccAddress(key[8:]).String(),
+			Amount:  amount,
+		})
+	}
+	return purchases
+}
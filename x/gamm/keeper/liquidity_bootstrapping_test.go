@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+const saleDenom = "sale"
+
+var errInactivePool = errors.New("is not active")
+
+// prepareLiquidityBootstrappingPool creates a liquidity bootstrapping pool selling saleDenom for uosmo,
+// owned by s.TestAccs[0], whose sale starts in an hour and lasts a day.
+func (s *KeeperTestSuite) prepareLiquidityBootstrappingPool(purchaseCap osmomath.Int) (poolId uint64, saleStartTime, saleEndTime time.Time) {
+	saleStartTime = s.Ctx.BlockTime().Add(time.Hour)
+	saleEndTime = saleStartTime.Add(24 * time.Hour)
+
+	poolId = s.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Weight: osmomath.NewInt(9), Token: sdk.NewInt64Coin(saleDenom, 9_000_000)},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)},
+	}, balancer.PoolParams{
+		SwapFee: osmomath.ZeroDec(),
+		ExitFee: osmomath.ZeroDec(),
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			StartTime: saleStartTime,
+			Duration:  saleEndTime.Sub(saleStartTime),
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(saleDenom, 0)},
+				{Weight: osmomath.NewInt(9), Token: sdk.NewInt64Coin(appparams.BaseCoinUnit, 0)},
+			},
+		},
+		LiquidityBootstrappingParams: &balancer.LiquidityBootstrappingParams{
+			SaleDenom:     saleDenom,
+			SaleStartTime: saleStartTime,
+			SaleEndTime:   saleEndTime,
+			PurchaseCap:   purchaseCap,
+		},
+	})
+	return poolId, saleStartTime, saleEndTime
+}
+
+func (s *KeeperTestSuite) TestLiquidityBootstrappingPoolCreation() {
+	s.SetupTest()
+
+	// a sale that has already ended cannot be created
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(
+		sdk.NewInt64Coin(appparams.BaseCoinUnit, 10_000_000_000),
+		sdk.NewInt64Coin(saleDenom, 1_000_000)))
+	msg := balancer.NewMsgCreateBalancerPool(s.TestAccs[0], balancer.PoolParams{
+		SwapFee: osmomath.ZeroDec(),
+		ExitFee: osmomath.ZeroDec(),
+		LiquidityBootstrappingParams: &balancer.LiquidityBootstrappingParams{
+			SaleDenom:     saleDenom,
+			SaleStartTime: s.Ctx.BlockTime().Add(-2 * time.Hour),
+			SaleEndTime:   s.Ctx.BlockTime().Add(-time.Hour),
+			PurchaseCap:   osmomath.ZeroInt(),
+		},
+	}, []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(saleDenom, 1_000_000)},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)},
+	}, "")
+	_, err := s.App.PoolManagerKeeper.CreatePool(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidityBootstrappingParams)
+
+	poolId, _, _ := s.prepareLiquidityBootstrappingPool(osmomath.ZeroInt())
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	balancerPool, ok := pool.(*balancer.Pool)
+	s.Require().True(ok)
+	s.Require().True(balancerPool.IsLiquidityBootstrappingPool())
+	s.Require().Equal(s.TestAccs[0].String(), balancerPool.PoolCreator)
+}
+
+func (s *KeeperTestSuite) TestLiquidityBootstrappingJoinAndExit() {
+	tests := map[string]struct {
+		sender            func() sdk.AccAddress
+		afterSaleEnd      bool
+		expectedJoinError error
+		expectedExitError error
+	}{
+		"pool creator during sale": {
+			sender:            func() sdk.AccAddress { return s.TestAccs[0] },
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+		"pool creator after sale end": {
+			sender:       func() sdk.AccAddress { return s.TestAccs[0] },
+			afterSaleEnd: true,
+		},
+		"other account during sale": {
+			sender:            func() sdk.AccAddress { return s.TestAccs[1] },
+			expectedJoinError: types.ErrLiquidityBootstrappingJoinRestricted,
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+		"other account after sale end": {
+			sender:            func() sdk.AccAddress { return s.TestAccs[1] },
+			afterSaleEnd:      true,
+			expectedJoinError: types.ErrLiquidityBootstrappingJoinRestricted,
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, saleStartTime, saleEndTime := s.prepareLiquidityBootstrappingPool(osmomath.ZeroInt())
+			sender := tc.sender()
+
+			// the pool creator holds all shares; give the other account some to exit with
+			err := s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), types.OneShare)))
+			s.Require().NoError(err)
+			s.FundAcc(sender, sdk.NewCoins(
+				sdk.NewInt64Coin(saleDenom, 1_000_000),
+				sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000_000)))
+
+			ctx := s.Ctx.WithBlockTime(saleStartTime)
+			if tc.afterSaleEnd {
+				ctx = s.Ctx.WithBlockTime(saleEndTime)
+			}
+
+			_, _, err = s.App.GAMMKeeper.JoinPoolNoSwap(ctx, sender, poolId, types.OneShare, sdk.Coins{})
+			s.requireErrorIsOrNil(tc.expectedJoinError, err)
+			_, err = s.App.GAMMKeeper.ExitPool(ctx, sender, poolId, types.OneShare.QuoRaw(2), sdk.Coins{})
+			s.requireErrorIsOrNil(tc.expectedExitError, err)
+
+			// single asset joins swap against the pool, so they are only possible during the sale,
+			// while the pool creator exits for an exact amount of a single asset after the sale
+			if tc.afterSaleEnd {
+				_, err = s.App.GAMMKeeper.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin(saleDenom, 1_000)), osmomath.ZeroInt())
+				s.Require().ErrorIs(err, types.ErrPoolLocked)
+				_, err = s.App.GAMMKeeper.ExitSwapExactAmountOut(ctx, sender, poolId, sdk.NewInt64Coin(saleDenom, 1_000), types.OneShare)
+				s.requireErrorIsOrNil(tc.expectedExitError, err)
+				return
+			}
+			_, err = s.App.GAMMKeeper.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin(saleDenom, 1_000)), osmomath.ZeroInt())
+			s.requireErrorIsOrNil(tc.expectedJoinError, err)
+			_, err = s.App.GAMMKeeper.ExitSwapShareAmountIn(ctx, sender, poolId, saleDenom, types.OneShare.QuoRaw(4), osmomath.ZeroInt())
+			s.requireErrorIsOrNil(tc.expectedExitError, err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidityBootstrappingSwap() {
+	tests := map[string]struct {
+		blockTime   func(saleStartTime, saleEndTime time.Time) time.Time
+		purchaseCap osmomath.Int
+		tokenIn     sdk.Coin
+		numSwaps    int
+		expectedErr error
+	}{
+		"before sale start": {
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime.Add(-time.Second) },
+			purchaseCap: osmomath.ZeroInt(),
+			tokenIn:     sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000),
+			numSwaps:    1,
+			expectedErr: errInactivePool,
+		},
+		"during sale": {
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+			purchaseCap: osmomath.ZeroInt(),
+			tokenIn:     sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000),
+			numSwaps:    3,
+		},
+		"after sale end": {
+			blockTime:   func(_, saleEndTime time.Time) time.Time { return saleEndTime },
+			purchaseCap: osmomath.ZeroInt(),
+			tokenIn:     sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000),
+			numSwaps:    1,
+			expectedErr: errInactivePool,
+		},
+		"purchases within the purchase cap": {
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+			purchaseCap: osmomath.NewInt(2_500),
+			tokenIn:     sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000),
+			numSwaps:    1,
+		},
+		"purchases exceeding the purchase cap": {
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+			purchaseCap: osmomath.NewInt(2_500),
+			tokenIn:     sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000),
+			numSwaps:    3,
+			expectedErr: types.ErrLiquidityBootstrappingPurchaseCapExceeded,
+		},
+		"selling the sale denom is not capped": {
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+			purchaseCap: osmomath.NewInt(1),
+			tokenIn:     sdk.NewInt64Coin(saleDenom, 10_000),
+			numSwaps:    3,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, saleStartTime, saleEndTime := s.prepareLiquidityBootstrappingPool(tc.purchaseCap)
+			buyer := s.TestAccs[1]
+			s.FundAcc(buyer, sdk.NewCoins(tc.tokenIn.AddAmount(tc.tokenIn.Amount.MulRaw(int64(tc.numSwaps)))))
+
+			tokenOutDenom := saleDenom
+			if tc.tokenIn.Denom == saleDenom {
+				tokenOutDenom = appparams.BaseCoinUnit
+			}
+
+			ctx := s.Ctx.WithBlockTime(tc.blockTime(saleStartTime, saleEndTime))
+			route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+
+			var err error
+			bought := osmomath.ZeroInt()
+			for i := 0; i < tc.numSwaps && err == nil; i++ {
+				var tokenOutAmount osmomath.Int
+				tokenOutAmount, err = s.App.PoolManagerKeeper.RouteExactAmountIn(ctx, buyer, route, tc.tokenIn, osmomath.OneInt())
+				if err == nil {
+					bought = bought.Add(tokenOutAmount)
+				}
+			}
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// purchases are only recorded for the sale denom of pools with a purchase cap
+			expectedPurchase := osmomath.ZeroInt()
+			if tokenOutDenom == saleDenom && tc.purchaseCap.IsPositive() {
+				expectedPurchase = bought
+				s.Require().True(expectedPurchase.LTE(tc.purchaseCap))
+			}
+			s.Require().Equal(expectedPurchase, s.App.GAMMKeeper.GetLiquidityBootstrappingPurchase(ctx, poolId, buyer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidityBootstrappingWeightChange() {
+	tests := map[string]struct {
+		sender      func() sdk.AccAddress
+		blockTime   func(saleStartTime, saleEndTime time.Time) time.Time
+		expectedErr error
+	}{
+		"pool creator before sale start": {
+			sender:      func() sdk.AccAddress { return s.TestAccs[0] },
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime.Add(-time.Second) },
+			expectedErr: types.ErrLiquidityBootstrappingWeightChangeRestricted,
+		},
+		"pool creator during sale": {
+			sender:      func() sdk.AccAddress { return s.TestAccs[0] },
+			blockTime:   func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+			expectedErr: types.ErrLiquidityBootstrappingWeightChangeRestricted,
+		},
+		"pool creator after sale end": {
+			sender:    func() sdk.AccAddress { return s.TestAccs[0] },
+			blockTime: func(_, saleEndTime time.Time) time.Time { return saleEndTime },
+		},
+		"governance module account during sale": {
+			sender:    func() sdk.AccAddress { return s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName) },
+			blockTime: func(saleStartTime, _ time.Time) time.Time { return saleStartTime },
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, saleStartTime, saleEndTime := s.prepareLiquidityBootstrappingPool(osmomath.ZeroInt())
+			ctx := s.Ctx.WithBlockTime(tc.blockTime(saleStartTime, saleEndTime))
+
+			msg := balancer.NewMsgScheduleWeightChange(tc.sender().String(), poolId, ctx.BlockTime().Add(time.Hour), time.Hour, []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(saleDenom, 0)},
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin(appparams.BaseCoinUnit, 0)},
+			})
+			_, err := keeper.NewBalancerMsgServerImpl(s.App.GAMMKeeper).ScheduleWeightChange(ctx, &msg)
+			s.requireErrorIsOrNil(tc.expectedErr, err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidityBootstrappingPurchasesDeletedOnExit() {
+	s.SetupTest()
+	poolId, saleStartTime, saleEndTime := s.prepareLiquidityBootstrappingPool(osmomath.NewInt(1_000_000))
+	buyer := s.TestAccs[1]
+	tokenIn := sdk.NewInt64Coin(appparams.BaseCoinUnit, 1_000)
+	s.FundAcc(buyer, sdk.NewCoins(tokenIn))
+
+	// a purchase of the sale denom is recorded during the sale
+	ctx := s.Ctx.WithBlockTime(saleStartTime)
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: saleDenom}}
+	bought, err := s.App.PoolManagerKeeper.RouteExactAmountIn(ctx, buyer, route, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(bought, s.App.GAMMKeeper.GetLiquidityBootstrappingPurchase(ctx, poolId, buyer))
+
+	// the exit of the pool creator after the sale deletes it
+	ctx = s.Ctx.WithBlockTime(saleEndTime)
+	_, err = s.App.GAMMKeeper.ExitPool(ctx, s.TestAccs[0], poolId, types.OneShare, sdk.Coins{})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroInt(), s.App.GAMMKeeper.GetLiquidityBootstrappingPurchase(ctx, poolId, buyer))
+}
+
+func (s *KeeperTestSuite) requireErrorIsOrNil(expectedErr, err error) {
+	if expectedErr != nil {
+		s.Require().ErrorIs(err, expectedErr)
+		return
+	}
+	s.Require().NoError(err)
+}
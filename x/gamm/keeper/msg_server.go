@@ -63,6 +63,48 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapRampScalingFactors) (*stableswap.MsgStableSwapRampScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapScalingFactors(ctx, msg.PoolID, msg.TargetScalingFactors, msg.Duration, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampScalingFactorsResponse{}, nil
+}
+
+func (server msgServer) StableSwapSetRateProvider(goCtx context.Context, msg *stableswap.MsgStableSwapSetRateProvider) (*stableswap.MsgStableSwapSetRateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setStableSwapRateProvider(ctx, msg.PoolID, msg.RateProvider(), msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapSetRateProviderResponse{}, nil
+}
+
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapAmplification(ctx, msg.PoolID, msg.TargetAmplification, msg.EndTime, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
+// ScheduleWeightChange installs a new smooth weight change schedule on an existing balancer pool.
+// It can only be called by the pool creator or the governance module account.
+func (server msgServer) ScheduleWeightChange(goCtx context.Context, msg *balancer.MsgScheduleWeightChange) (*balancer.MsgScheduleWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.scheduleBalancerWeightChange(ctx, msg.PoolID, msg.StartTime, msg.Duration, msg.TargetPoolWeights, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgScheduleWeightChangeResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
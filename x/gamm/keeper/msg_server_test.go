@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v26/app/params"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
@@ -366,3 +371,99 @@ func (s *KeeperTestSuite) TestExitPool_Events() {
 		})
 	}
 }
+
+// TestScheduleWeightChange tests that only the pool creator and the governance module account
+// can schedule a weight change of a balancer pool, and that events are correctly emitted.
+func (s *KeeperTestSuite) TestScheduleWeightChange() {
+	targetPoolWeights := []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(400), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(300), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(200), Token: sdk.NewCoin("baz", osmomath.ZeroInt())},
+		{Weight: osmomath.NewInt(100), Token: sdk.NewCoin(appparams.BaseCoinUnit, osmomath.ZeroInt())},
+	}
+
+	testcases := map[string]struct {
+		sender                    func() sdk.AccAddress
+		isStableswapPool          bool
+		startTime                 time.Duration
+		expectedErr               error
+		expectedWeightChangeEvent int
+	}{
+		"pool creator": {
+			sender:                    func() sdk.AccAddress { return s.TestAccs[0] },
+			startTime:                 time.Hour,
+			expectedWeightChangeEvent: 1,
+		},
+		"governance module account": {
+			sender: func() sdk.AccAddress {
+				return s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+			},
+			startTime:                 time.Hour,
+			expectedWeightChangeEvent: 1,
+		},
+		"other account": {
+			sender:      func() sdk.AccAddress { return s.TestAccs[1] },
+			startTime:   time.Hour,
+			expectedErr: types.ErrUnauthorizedWeightChange,
+		},
+		"start time before block time": {
+			sender:      func() sdk.AccAddress { return s.TestAccs[0] },
+			startTime:   -time.Hour,
+			expectedErr: types.ErrInvalidWeightChangeStartTime,
+		},
+		"not a balancer pool": {
+			sender:           func() sdk.AccAddress { return s.TestAccs[0] },
+			isStableswapPool: true,
+			startTime:        time.Hour,
+			expectedErr:      errors.New("pool id 1 is not of type balancer pool"),
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Reset()
+
+			var poolId uint64
+			if tc.isStableswapPool {
+				poolId = s.PrepareBasicStableswapPool()
+			} else {
+				poolId = s.PrepareBalancerPool()
+			}
+
+			msgServer := keeper.NewBalancerMsgServerImpl(s.App.GAMMKeeper)
+
+			// Reset event counts to 0 by creating a new manager.
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			startTime := ctx.BlockTime().Add(tc.startTime)
+
+			msg := balancer.NewMsgScheduleWeightChange(tc.sender().String(), poolId, startTime, time.Hour, targetPoolWeights)
+			response, err := msgServer.ScheduleWeightChange(ctx, &msg)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				s.AssertEventEmitted(ctx, types.TypeEvtWeightChangeScheduled, 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(response)
+			s.AssertEventEmitted(ctx, types.TypeEvtWeightChangeScheduled, tc.expectedWeightChangeEvent)
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			s.Require().NoError(err)
+			params := pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+			s.Require().NotNil(params)
+			s.Require().Equal(startTime.UTC(), params.StartTime.UTC())
+			s.Require().Equal(time.Hour, params.Duration)
+
+			// once the weight change ends, the pool has the target weights
+			ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+			pool, err = s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			s.Require().NoError(err)
+			for _, targetPoolWeight := range targetPoolWeights {
+				poolAsset, err := pool.(*balancer.Pool).GetPoolAsset(targetPoolWeight.Token.Denom)
+				s.Require().NoError(err)
+				s.Require().Equal(targetPoolWeight.Weight.MulRaw(balancer.GuaranteedWeightPrecision), poolAsset.Weight)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -9,12 +11,14 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/events"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
@@ -39,7 +43,7 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePool); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 
@@ -48,7 +52,8 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool with time-dependent parameters (e.g. balancer weights or stableswap scaling factors),
+// they are updated via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -64,7 +69,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(types.PokablePool); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
@@ -103,7 +108,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePool); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -328,6 +333,169 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// rampStableSwapScalingFactors starts linearly ramping the stable swap scaling factors to the given
+// target scaling factors over the given duration, starting at the current block time.
+// errors if the pool does not exist, the sender is not the scaling factor controller, the pool has a
+// rate provider, or due to other internal errors.
+func (k Keeper) rampStableSwapScalingFactors(ctx sdk.Context, poolId uint64, targetScalingFactors []uint64, duration time.Duration, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.RampScalingFactors(ctx.BlockTime(), targetScalingFactors, duration, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// rampStableSwapAmplification starts linearly ramping the stable swap amplification to the given
+// target amplification, starting at the current block time and ending at endTime.
+// Since the amplification reprices every swap of the pool, only the governance module account may ramp it.
+// errors if the pool does not exist, the sender is not the governance module account, the ramp
+// is out of bounds, or due to other internal errors.
+func (k Keeper) rampStableSwapAmplification(ctx sdk.Context, poolId uint64, targetAmplification uint64, endTime time.Time, sender string) error {
+	if sender != k.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).GetAddress().String() {
+		return types.ErrUnauthorizedAmplificationRamp
+	}
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.RampAmplification(ctx.BlockTime(), targetAmplification, endTime); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// InitializeStableswapAmplification sets the amplification of every stableswap pool created before the
+// amplification was introduced to 1, which reproduces the curve the pool was priced with so far.
+// Called from the v27 upgrade handler.
+func (k Keeper) InitializeStableswapAmplification(ctx sdk.Context) error {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		stableswapPool, ok := pool.(*stableswap.Pool)
+		if !ok || stableswapPool.PoolParams.Amplification != 0 {
+			continue
+		}
+
+		stableswapPool.PoolParams.Amplification = 1
+		if err := k.setPool(ctx, stableswapPool); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setStableSwapRateProvider sets the rate provider the stable swap scaling factors are pulled from at
+// the beginning of every block, or removes it if rateProvider is nil.
+// Since the contract of a rate provider is queried at the beginning of every block, only the governance module
+// account may set a rate provider, and at most StableswapMaxRateProviderPools pools may have one. The rate
+// provider of a pool may be removed by either governance or the scaling factor controller of the pool.
+// errors if the pool does not exist, the sender is not authorized, the max number of pools with a rate provider
+// is reached, or the rate provider is invalid.
+func (k Keeper) setStableSwapRateProvider(ctx sdk.Context, poolId uint64, rateProvider *stableswap.RateProvider, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+
+	isGovSender := sender == k.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).GetAddress().String()
+	if rateProvider != nil && !isGovSender {
+		return types.ErrUnauthorizedRateProvider
+	}
+	if rateProvider == nil && !isGovSender && sender != stableswapPool.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+	if rateProvider != nil && stableswapPool.RateProvider == nil {
+		rateProviderPoolIds, err := k.getRateProviderPoolIds(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rateProviderPoolIds) >= types.StableswapMaxRateProviderPools {
+			return types.ErrTooManyRateProviderPools
+		}
+	}
+
+	if err := stableswapPool.SetRateProvider(rateProvider); err != nil {
+		return err
+	}
+
+	k.setRateProviderPoolIndex(ctx, stableswapPool)
+	return k.setPool(ctx, stableswapPool)
+}
+
+// scheduleBalancerWeightChange installs a new smooth weight change schedule on the given balancer pool,
+// replacing any pending one. The schedule starts from the current weights of the pool.
+// errors if the pool does not exist or is not a balancer pool, the sender is neither the pool creator nor
+// the governance module account, or the schedule is invalid for the pool.
+// The weights of a liquidity bootstrapping pool set the price curve of its sale, so only the governance module
+// account may change them until the sale has ended.
+func (k Keeper) scheduleBalancerWeightChange(ctx sdk.Context, poolId uint64, startTime time.Time, duration time.Duration, targetPoolWeights []balancer.PoolAsset, sender string) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+
+	isGovSender := senderAddr.Equals(k.accountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).GetAddress())
+	if sender != balancerPool.PoolCreator && !isGovSender {
+		return types.ErrUnauthorizedWeightChange
+	}
+	if balancerPool.IsLiquidityBootstrappingPool() && !isGovSender &&
+		!balancerPool.PoolParams.LiquidityBootstrappingParams.HasSaleEnded(ctx.BlockTime()) {
+		return types.ErrLiquidityBootstrappingWeightChangeRestricted
+	}
+
+	if err := balancerPool.ScheduleWeightChange(startTime, duration, targetPoolWeights, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, balancerPool); err != nil {
+		return err
+	}
+
+	params := balancerPool.PoolParams.SmoothWeightChangeParams
+	events.EmitWeightChangeScheduledEvent(ctx, senderAddr, poolId, params.StartTime, params.Duration, formatPoolWeights(targetPoolWeights))
+	return nil
+}
+
+// formatPoolWeights formats the given pool weights as a comma separated list of weight and denom pairs,
+// e.g. "1uatom,2uosmo".
+func formatPoolWeights(poolWeights []balancer.PoolAsset) string {
+	formatted := make([]string, len(poolWeights))
+	for i, poolWeight := range poolWeights {
+		formatted[i] = poolWeight.Weight.String() + poolWeight.Token.Denom
+	}
+	return strings.Join(formatted, ",")
+}
+
 // setStableSwapScalingFactorController updates the scaling factor controller address for a stable swap pool
 // errors if the pool does not exist or is not a stable swap pool
 func (k Keeper) setStableSwapScalingFactorController(ctx sdk.Context, poolId uint64, controllerAddress string) error {
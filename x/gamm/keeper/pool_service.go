@@ -412,10 +412,14 @@ func (k Keeper) ExitSwapExactAmountOut(
 	tokenOut sdk.Coin,
 	shareInMaxAmount osmomath.Int,
 ) (shareInAmount osmomath.Int, err error) {
-	pool, err := k.GetCFMMPool(ctx, poolId)
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
+	// Liquidity bootstrapping pools are inactive once their sale has ended, which is when their creator may exit them.
+	if !isLiquidityBootstrappingPool(pool) && !pool.IsActive(ctx) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
 
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// BeginBlock pulls the scaling factors of the stableswap pools with a rate provider.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	k.updateRateProviderScalingFactors(ctx)
+}
+
+// updateRateProviderScalingFactors updates the scaling factors of every stableswap pool with a rate provider
+// from its rate provider. Errors are logged and the failing pool is skipped so that it does not affect the other pools.
+func (k Keeper) updateRateProviderScalingFactors(ctx sdk.Context) {
+	poolIds, err := k.getRateProviderPoolIds(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to get rate provider pools", "error", err)
+		return
+	}
+
+	for _, poolId := range poolIds {
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.updateScalingFactorsFromRateProvider(ctx, poolId)
+		})
+	}
+}
+
+// updateScalingFactorsFromRateProvider queries the rate provider of the given stableswap pool for its
+// scaling factors and sets them, clamped to the max change per block of the rate provider.
+// The query may consume at most StableswapRateProviderQueryGasLimit gas.
+func (k Keeper) updateScalingFactorsFromRateProvider(ctx sdk.Context, poolId uint64) error {
+	if k.wasmKeeper == nil {
+		return fmt.Errorf("wasm keeper is not set")
+	}
+
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if stableswapPool.RateProvider == nil {
+		return fmt.Errorf("pool %d has no rate provider", poolId)
+	}
+
+	response, err := k.queryRateProvider(ctx, poolId, stableswapPool.RateProvider.ContractAddress)
+	if err != nil {
+		return fmt.Errorf("failed to query rate provider of pool %d: %w", poolId, err)
+	}
+
+	scalingFactors := make([]uint64, len(response.ScalingFactors))
+	for i, scalingFactor := range response.ScalingFactors {
+		if scalingFactor.IsNil() || !scalingFactor.IsUint64() {
+			return types.ErrInvalidScalingFactors
+		}
+		scalingFactors[i] = scalingFactor.Uint64()
+	}
+
+	if err := stableswapPool.UpdateScalingFactorsFromRateProvider(scalingFactors); err != nil {
+		return fmt.Errorf("failed to update scaling factors of pool %d: %w", poolId, err)
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// queryRateProvider queries the scaling factors of the given pool from the given rate provider contract.
+// Returns error if the query fails or consumes more than StableswapRateProviderQueryGasLimit gas.
+func (k Keeper) queryRateProvider(ctx sdk.Context, poolId uint64, contractAddress string) (response stableswap.GetScalingFactorsQueryMsgResponse, err error) {
+	request := stableswap.GetScalingFactorsQueryMsg{
+		GetScalingFactors: stableswap.GetScalingFactors{PoolId: poolId},
+	}
+
+	// We ensure the limit only applies to this query by creating a child context with a gas
+	// limit and then metering the gas used in the parent context once the query is completed,
+	// whether it succeeded or not.
+	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.StableswapRateProviderQueryGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrRateProviderOutOfGas, "gas limit %d", uint64(types.StableswapRateProviderQueryGasLimit))
+		}
+
+		// Consume gas used for querying the contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "rate provider query")
+	}()
+
+	return cosmwasm.Query[stableswap.GetScalingFactorsQueryMsg, stableswap.GetScalingFactorsQueryMsgResponse](childCtx, k.wasmKeeper, contractAddress, request)
+}
+
+// setRateProviderPoolIndex indexes the given stableswap pool if it has a rate provider so that its scaling
+// factors are pulled at the beginning of every block, and removes it from the index otherwise.
+func (k Keeper) setRateProviderPoolIndex(ctx sdk.Context, pool *stableswap.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixRateProviderPools(pool.GetId())
+	if pool.RateProvider == nil {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(pool.GetId()))
+}
+
+// getRateProviderPoolIds returns the ids of the stableswap pools with a rate provider in ascending order.
+func (k Keeper) getRateProviderPoolIds(ctx sdk.Context) ([]uint64, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixRateProviderPools, func(bz []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(bz), nil
+	})
+}
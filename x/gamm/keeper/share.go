@@ -10,7 +10,12 @@ import (
 )
 
 func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool poolmanagertypes.PoolI, joiner sdk.AccAddress, numShares osmomath.Int, joinCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
+	err := validateJoin(pool, joiner)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
 	if err != nil {
 		return err
 	}
@@ -32,7 +37,17 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool poolmanagertypes.
 }
 
 func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool poolmanagertypes.PoolI, exiter sdk.AccAddress, numShares osmomath.Int, exitCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
+	err := validateExit(ctx, pool, exiter)
+	if err != nil {
+		return err
+	}
+
+	// Only the creator of a liquidity bootstrapping pool exits it, once the sale has ended and purchases are over.
+	if isLiquidityBootstrappingPool(pool) {
+		k.deleteLiquidityBootstrappingPurchases(ctx, pool.GetId())
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
 	if err != nil {
 		return err
 	}
@@ -74,7 +74,7 @@ func (k Keeper) SwapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin, spreadFactor); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -138,7 +138,7 @@ func (k Keeper) SwapExactAmountOut(
 		return osmomath.Int{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, spreadFactor)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -177,20 +177,79 @@ func (k Keeper) CalcInAmtGivenOut(
 	return cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, spreadFactor)
 }
 
+// SimulateSwapExactAmountIn swaps tokenIn for tokenOutDenom against the given pool and stores the
+// updated pool, without moving any funds. Callers must pass a cache context that is discarded.
+// Returns error if the given pool is not a CFMM pool. Returns error on internal calculations.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenOut sdk.Coin, err error) {
+	cfmmPool, err := asCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOut, err = cfmmPool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setPool(ctx, cfmmPool); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenOut, nil
+}
+
+// SimulateSwapExactAmountOut swaps tokenInDenom for tokenOut against the given pool and stores the
+// updated pool, without moving any funds. Callers must pass a cache context that is discarded.
+// Returns error if the given pool is not a CFMM pool. Returns error on internal calculations.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	poolI poolmanagertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenIn sdk.Coin, err error) {
+	cfmmPool, err := asCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenIn, err = cfmmPool.SwapInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.setPool(ctx, cfmmPool); err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn, nil
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
+// spreadFactor is the spread factor the swap was charged, passed on to the swap hooks.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool poolmanagertypes.PoolI,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	spreadFactor osmomath.Dec,
 ) error {
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 
-	err := k.setPool(ctx, pool)
+	err := k.recordLiquidityBootstrappingPurchase(ctx, pool, sender, tokenOut)
+	if err != nil {
+		return err
+	}
+
+	err = k.setPool(ctx, pool)
 	if err != nil {
 		return err
 	}
@@ -214,7 +273,7 @@ func (k Keeper) updatePoolForSwap(
 	// Search for references to this function to see where else it is used.
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
-	k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut, spreadFactor)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
 
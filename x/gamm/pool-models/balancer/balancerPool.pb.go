@@ -131,6 +131,80 @@ func (m *SmoothWeightChangeParams) GetTargetPoolWeights() []PoolAsset {
 	return nil
 }
 
+// LiquidityBootstrappingParams turn a balancer pool into a liquidity
+// bootstrapping pool (LBP) selling sale_denom during a sale window, usually
+// together with smooth_weight_change_params moving the weight away from
+// sale_denom. While they are set:
+//   - only the pool creator may join the pool, everyone else may only swap
+//   - the pool can only be swapped against during the sale window
+//   - only the pool creator may exit the pool, once the sale has ended
+type LiquidityBootstrappingParams struct {
+	// sale_denom is the denom sold by the pool.
+	SaleDenom string `protobuf:"bytes,1,opt,name=sale_denom,json=saleDenom,proto3" json:"sale_denom,omitempty" yaml:"sale_denom"`
+	// sale_start_time is the time swaps against the pool are enabled at.
+	SaleStartTime time.Time `protobuf:"bytes,2,opt,name=sale_start_time,json=saleStartTime,proto3,stdtime" json:"sale_start_time" yaml:"sale_start_time"`
+	// sale_end_time is the time swaps against the pool are disabled at, and the
+	// pool creator may exit the pool from.
+	SaleEndTime time.Time `protobuf:"bytes,3,opt,name=sale_end_time,json=saleEndTime,proto3,stdtime" json:"sale_end_time" yaml:"sale_end_time"`
+	// purchase_cap is the max amount of sale_denom a single address may buy
+	// from the pool over the sale. Zero means no cap.
+	PurchaseCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=purchase_cap,json=purchaseCap,proto3,customtype=cosmossdk.io/math.Int" json:"purchase_cap" yaml:"purchase_cap"`
+}
+
+func (m *LiquidityBootstrappingParams) Reset()         { *m = LiquidityBootstrappingParams{} }
+func (m *LiquidityBootstrappingParams) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBootstrappingParams) ProtoMessage()    {}
+func (*LiquidityBootstrappingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bed8b78c08e572f, []int{1}
+}
+func (m *LiquidityBootstrappingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBootstrappingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBootstrappingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBootstrappingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBootstrappingParams.Merge(m, src)
+}
+func (m *LiquidityBootstrappingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBootstrappingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBootstrappingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBootstrappingParams proto.InternalMessageInfo
+
+func (m *LiquidityBootstrappingParams) GetSaleDenom() string {
+	if m != nil {
+		return m.SaleDenom
+	}
+	return ""
+}
+
+func (m *LiquidityBootstrappingParams) GetSaleStartTime() time.Time {
+	if m != nil {
+		return m.SaleStartTime
+	}
+	return time.Time{}
+}
+
+func (m *LiquidityBootstrappingParams) GetSaleEndTime() time.Time {
+	if m != nil {
+		return m.SaleEndTime
+	}
+	return time.Time{}
+}
+
 // PoolParams defined the parameters that will be managed by the pool
 // governance in the future. This params are not managed by the chain
 // governance. Instead they will be managed by the token holders of the pool.
@@ -142,13 +216,16 @@ type PoolParams struct {
 	// fee anymore
 	ExitFee                  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"exit_fee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams   `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// liquidity_bootstrapping_params are set for liquidity bootstrapping pools
+	// only. They can only be set at pool creation.
+	LiquidityBootstrappingParams *LiquidityBootstrappingParams `protobuf:"bytes,4,opt,name=liquidity_bootstrapping_params,json=liquidityBootstrappingParams,proto3" json:"liquidity_bootstrapping_params,omitempty" yaml:"liquidity_bootstrapping_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
 func (m *PoolParams) String() string { return proto.CompactTextString(m) }
 func (*PoolParams) ProtoMessage()    {}
 func (*PoolParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bed8b78c08e572f, []int{1}
+	return fileDescriptor_8bed8b78c08e572f, []int{2}
 }
 func (m *PoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,6 +261,13 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 	return nil
 }
 
+func (m *PoolParams) GetLiquidityBootstrappingParams() *LiquidityBootstrappingParams {
+	if m != nil {
+		return m.LiquidityBootstrappingParams
+	}
+	return nil
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -200,7 +284,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bed8b78c08e572f, []int{2}
+	return fileDescriptor_8bed8b78c08e572f, []int{3}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,12 +341,15 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=cosmossdk.io/math.Int" json:"total_weight" yaml:"total_weight"`
+	// pool_creator is the address that created the pool. It may schedule weight
+	// changes of the pool. Empty for pools created before it was recorded.
+	PoolCreator string `protobuf:"bytes,8,opt,name=pool_creator,json=poolCreator,proto3" json:"pool_creator,omitempty" yaml:"pool_creator"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bed8b78c08e572f, []int{3}
+	return fileDescriptor_8bed8b78c08e572f, []int{4}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,6 +380,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*SmoothWeightChangeParams)(nil), "osmosis.gamm.v1beta1.SmoothWeightChangeParams")
+	proto.RegisterType((*LiquidityBootstrappingParams)(nil), "osmosis.gamm.v1beta1.LiquidityBootstrappingParams")
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.v1beta1.PoolParams")
 	proto.RegisterType((*PoolAsset)(nil), "osmosis.gamm.v1beta1.PoolAsset")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.v1beta1.Pool")
@@ -303,62 +391,73 @@ func init() {
 }
 
 var fileDescriptor_8bed8b78c08e572f = []byte{
-	// 872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x3a, 0x4e, 0xd2, 0x4c, 0x4a, 0x51, 0xa6, 0x46, 0x5a, 0xdb, 0xc2, 0x13, 0x0d, 0x48,
-	0x54, 0x55, 0xb3, 0xab, 0x04, 0xd4, 0x43, 0x2e, 0x88, 0x6d, 0x29, 0xaa, 0xc4, 0xa1, 0x6c, 0x91,
-	0x4a, 0x11, 0xd2, 0x6a, 0x6c, 0x8f, 0x77, 0x47, 0xdd, 0xdd, 0xb1, 0x76, 0xc6, 0x6e, 0xf3, 0x0d,
-	0x10, 0xa7, 0x1e, 0x0b, 0xa7, 0x7e, 0x04, 0x0e, 0x7c, 0x02, 0x4e, 0x11, 0x5c, 0x7a, 0x44, 0x1c,
-	0x16, 0x94, 0x1c, 0x90, 0x38, 0x5a, 0x7c, 0x00, 0x34, 0x7f, 0xd6, 0x7f, 0x52, 0x5b, 0x8d, 0x7a,
-	0xb1, 0xf6, 0xbd, 0x79, 0xef, 0xf7, 0x7e, 0xef, 0xbd, 0xdf, 0x8c, 0xc1, 0x47, 0x5c, 0x64, 0x5c,
-	0x30, 0xe1, 0xc7, 0x24, 0xcb, 0xfc, 0xc9, 0x61, 0x8f, 0x4a, 0x72, 0xe8, 0xf7, 0x48, 0x4a, 0xf2,
-	0x3e, 0x2d, 0x1e, 0x70, 0x9e, 0x7a, 0xa3, 0x82, 0x4b, 0x0e, 0x9b, 0x36, 0xd0, 0x53, 0x81, 0x9e,
-	0x0d, 0x6c, 0xb7, 0xfa, 0xda, 0x1d, 0xe9, 0x18, 0xdf, 0x18, 0x26, 0xa1, 0xdd, 0x8c, 0x79, 0xcc,
-	0x8d, 0x5f, 0x7d, 0x59, 0xef, 0x1e, 0xc9, 0x58, 0xce, 0x7d, 0xfd, 0x6b, 0x5d, 0xdd, 0x98, 0xf3,
-	0x38, 0xa5, 0xbe, 0xb6, 0x7a, 0xe3, 0xa1, 0x3f, 0x18, 0x17, 0x44, 0x32, 0x9e, 0xdb, 0x73, 0x74,
-	0xf1, 0x5c, 0xb2, 0x8c, 0x0a, 0x49, 0xb2, 0x51, 0x05, 0x60, 0xea, 0xfa, 0x64, 0x2c, 0x93, 0x59,
-	0x0b, 0xca, 0xb8, 0x70, 0xde, 0x23, 0x82, 0xce, 0xce, 0xfb, 0x9c, 0xd9, 0x02, 0xf8, 0xf7, 0x0d,
-	0xe0, 0x3e, 0xcc, 0x38, 0x97, 0xc9, 0x23, 0xca, 0xe2, 0x44, 0xde, 0x49, 0x48, 0x1e, 0xd3, 0x07,
-	0xa4, 0x20, 0x99, 0x80, 0xdf, 0x00, 0x20, 0x24, 0x29, 0x64, 0xa4, 0xaa, 0xba, 0xce, 0xbe, 0x73,
-	0x63, 0xf7, 0xa8, 0xed, 0x19, 0x4a, 0x5e, 0x45, 0xc9, 0xfb, 0xba, 0xa2, 0x14, 0xbc, 0x7f, 0x5a,
-	0xa2, 0xda, 0xb4, 0x44, 0x7b, 0x27, 0x24, 0x4b, 0x8f, 0xf1, 0x3c, 0x17, 0x3f, 0xff, 0x0b, 0x39,
-	0xe1, 0x8e, 0x76, 0xa8, 0x70, 0x98, 0x80, 0x2b, 0x55, 0xa7, 0x6e, 0x5d, 0xe3, 0xb6, 0x5e, 0xc3,
-	0xbd, 0x6b, 0x03, 0x82, 0x43, 0x05, 0xfb, 0x6f, 0x89, 0x60, 0x95, 0x72, 0x8b, 0x67, 0x4c, 0xd2,
-	0x6c, 0x24, 0x4f, 0xa6, 0x25, 0x7a, 0xd7, 0x14, 0xab, 0xce, 0xf0, 0x0b, 0x55, 0x6a, 0x86, 0x0e,
-	0x27, 0xa0, 0xc9, 0x72, 0x26, 0x19, 0x49, 0xa3, 0x11, 0xe7, 0x69, 0xf4, 0x54, 0xb7, 0x29, 0xdc,
-	0x8d, 0xfd, 0x8d, 0x1b, 0xbb, 0x47, 0xc8, 0x5b, 0xb5, 0x5a, 0x4f, 0xed, 0xfe, 0x33, 0x21, 0xa8,
-	0x0c, 0x3e, 0xb0, 0x2d, 0x75, 0x4c, 0x95, 0x55, 0x50, 0x38, 0x84, 0xd6, 0xad, 0xd2, 0xcc, 0x18,
-	0x05, 0x14, 0xe0, 0xba, 0x24, 0x45, 0x4c, 0xe5, 0x72, 0xd9, 0xc6, 0xe5, 0xca, 0x62, 0x5b, 0xb6,
-	0x6d, 0xca, 0xae, 0x40, 0xc2, 0xe1, 0x9e, 0xf1, 0x2e, 0x14, 0xc5, 0xff, 0xd5, 0x01, 0x50, 0xb6,
-	0xdd, 0xdf, 0x57, 0xe0, 0x8a, 0x78, 0x4a, 0x46, 0xd1, 0x90, 0x9a, 0xed, 0xed, 0x04, 0xb7, 0x15,
-	0xee, 0x9f, 0x25, 0xea, 0x18, 0x59, 0x88, 0xc1, 0x13, 0x8f, 0x71, 0x3f, 0x23, 0x32, 0xf1, 0xbe,
-	0xa4, 0x31, 0xe9, 0x9f, 0xdc, 0xa5, 0xfd, 0xf9, 0x4c, 0xab, 0x64, 0x1c, 0x6e, 0xab, 0xcf, 0x7b,
-	0x94, 0x2a, 0x48, 0xfa, 0x8c, 0x49, 0x0d, 0x59, 0x7f, 0x0b, 0xc8, 0x2a, 0x19, 0x87, 0xdb, 0xea,
-	0x53, 0x41, 0xfe, 0xe8, 0x80, 0x8e, 0xd0, 0x12, 0xb4, 0xbd, 0x45, 0x7d, 0x2d, 0xc2, 0x68, 0xa4,
-	0xbb, 0x70, 0x37, 0xb4, 0x3e, 0xbc, 0xd5, 0x23, 0x5b, 0xa7, 0xdd, 0xe0, 0xe6, 0x69, 0x89, 0x9c,
-	0x69, 0x89, 0xb0, 0x6d, 0x65, 0x7d, 0x01, 0x1c, 0xba, 0x62, 0x0d, 0xca, 0xf1, 0x87, 0x3f, 0xfc,
-	0xf3, 0xf3, 0x4d, 0xb4, 0xf4, 0x4e, 0x04, 0x0b, 0xef, 0x83, 0x89, 0xc2, 0x3f, 0x39, 0x60, 0x67,
-	0xb6, 0x3b, 0xf8, 0x39, 0xd8, 0x94, 0xfc, 0x09, 0xcd, 0xed, 0x85, 0x69, 0x79, 0xf6, 0x69, 0x50,
-	0x57, 0x70, 0xc6, 0xfb, 0x0e, 0x67, 0x79, 0xd0, 0xb4, 0x5b, 0xbe, 0x6a, 0xb7, 0xac, 0xb2, 0x70,
-	0x68, 0xb2, 0xe1, 0x3d, 0xb0, 0x65, 0xd8, 0xda, 0x39, 0x7b, 0x76, 0xce, 0xef, 0xbd, 0x3e, 0xe7,
-	0xfb, 0xb9, 0x9c, 0x96, 0xe8, 0x1d, 0x83, 0x62, 0x92, 0x70, 0x68, 0xb3, 0xf1, 0xaf, 0x0d, 0xd0,
-	0x50, 0xe4, 0xe0, 0x2d, 0xb0, 0x4d, 0x06, 0x83, 0x82, 0x0a, 0x61, 0xc5, 0x00, 0xa7, 0x25, 0xba,
-	0x66, 0x92, 0xec, 0x01, 0x0e, 0xab, 0x10, 0x78, 0x0d, 0xd4, 0xd9, 0x40, 0x97, 0x6e, 0x84, 0x75,
-	0x36, 0x80, 0x43, 0xb0, 0xab, 0xe5, 0xb7, 0xb4, 0x94, 0xfd, 0xf5, 0x3a, 0xb6, 0x6b, 0xb8, 0x70,
-	0x7f, 0xaa, 0xc7, 0x35, 0x5a, 0xc0, 0xc2, 0x21, 0x18, 0x2d, 0x6a, 0xb6, 0x39, 0x1c, 0xcb, 0x71,
-	0x41, 0x4d, 0x48, 0xcc, 0x27, 0xb4, 0xc8, 0x79, 0xe1, 0x36, 0x34, 0x65, 0x34, 0x87, 0x5a, 0x15,
-	0x85, 0x43, 0x68, 0xdc, 0x8a, 0xc1, 0x17, 0xd6, 0x09, 0x1f, 0x83, 0xab, 0x92, 0x4b, 0x92, 0x46,
-	0x22, 0x21, 0x05, 0x15, 0xee, 0xe6, 0x9b, 0xf6, 0xd2, 0xb1, 0xa4, 0xaf, 0x57, 0x7b, 0x99, 0x27,
-	0xe3, 0x70, 0x57, 0x9b, 0x0f, 0xb5, 0x05, 0xbf, 0xb3, 0x53, 0x21, 0x6a, 0xf3, 0xc2, 0xdd, 0xba,
-	0xdc, 0xed, 0x6e, 0x5b, 0x7c, 0x68, 0xf0, 0x17, 0x10, 0xec, 0x2c, 0x74, 0x98, 0x80, 0x8f, 0x2a,
-	0xe2, 0x56, 0x08, 0xdb, 0x7a, 0x06, 0x9f, 0xbc, 0x49, 0x08, 0x4b, 0xb4, 0x2b, 0x39, 0x18, 0xda,
-	0x46, 0xe2, 0xc7, 0xfe, 0xf7, 0x2f, 0x51, 0xed, 0xc5, 0x4b, 0x54, 0xfb, 0xed, 0x97, 0x83, 0x4d,
-	0xc5, 0xeb, 0xbe, 0xd2, 0x79, 0x6b, 0xad, 0xce, 0x83, 0xc7, 0xa7, 0x67, 0x5d, 0xe7, 0xd5, 0x59,
-	0xd7, 0xf9, 0xfb, 0xac, 0xeb, 0x3c, 0x3f, 0xef, 0xd6, 0x5e, 0x9d, 0x77, 0x6b, 0x7f, 0x9c, 0x77,
-	0x6b, 0xdf, 0x7e, 0x1a, 0x33, 0x99, 0x8c, 0x7b, 0x5e, 0x9f, 0x67, 0xbe, 0xcd, 0x3f, 0x48, 0x49,
-	0x4f, 0x54, 0x86, 0x3f, 0x39, 0xba, 0xed, 0x3f, 0x33, 0x90, 0xaa, 0xb5, 0x83, 0x8c, 0x0f, 0x68,
-	0x2a, 0x66, 0x7f, 0xb3, 0xbd, 0x2d, 0xfd, 0xe0, 0x7f, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8f, 0xaa, 0xe2, 0x05, 0x8e, 0x07, 0x00, 0x00,
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6f, 0xdc, 0x44,
+	0x18, 0x5f, 0x6f, 0x36, 0xaf, 0xd9, 0xb4, 0x55, 0x26, 0x5b, 0xd8, 0x24, 0x65, 0x1d, 0x0d, 0x20,
+	0xaa, 0xaa, 0xb1, 0x95, 0x50, 0xf5, 0x90, 0x0b, 0xc2, 0x49, 0x8b, 0x2a, 0xf5, 0x50, 0x1c, 0xa4,
+	0x52, 0x84, 0x64, 0x66, 0xed, 0x89, 0x77, 0x54, 0xdb, 0x63, 0x3c, 0xb3, 0x69, 0xf3, 0x1f, 0x20,
+	0x4e, 0x3d, 0x16, 0x0e, 0xa8, 0x37, 0xae, 0x1c, 0xf8, 0x23, 0x22, 0xb8, 0xf4, 0x88, 0x38, 0x2c,
+	0x28, 0x39, 0x20, 0x71, 0xcc, 0x85, 0x1b, 0x42, 0xf3, 0xf0, 0xae, 0x93, 0xee, 0x26, 0xa5, 0x97,
+	0x95, 0xe7, 0x7b, 0xfc, 0x7e, 0xdf, 0x37, 0xdf, 0x63, 0x16, 0x7c, 0xc0, 0x78, 0xca, 0x38, 0xe5,
+	0x6e, 0x8c, 0xd3, 0xd4, 0xdd, 0xdf, 0xe8, 0x12, 0x81, 0x37, 0xdc, 0x2e, 0x4e, 0x70, 0x16, 0x92,
+	0xe2, 0x01, 0x63, 0x89, 0x93, 0x17, 0x4c, 0x30, 0xd8, 0x32, 0x86, 0x8e, 0x34, 0x74, 0x8c, 0xe1,
+	0xca, 0x72, 0xa8, 0xc4, 0x81, 0xb2, 0x71, 0xf5, 0x41, 0x3b, 0xac, 0xb4, 0x62, 0x16, 0x33, 0x2d,
+	0x97, 0x5f, 0x46, 0xba, 0x88, 0x53, 0x9a, 0x31, 0x57, 0xfd, 0x1a, 0x51, 0x27, 0x66, 0x2c, 0x4e,
+	0x88, 0xab, 0x4e, 0xdd, 0xfe, 0x9e, 0x1b, 0xf5, 0x0b, 0x2c, 0x28, 0xcb, 0x8c, 0xde, 0x3e, 0xab,
+	0x17, 0x34, 0x25, 0x5c, 0xe0, 0x34, 0x2f, 0x01, 0x34, 0xaf, 0x8b, 0xfb, 0xa2, 0x37, 0x4c, 0x41,
+	0x1e, 0xce, 0xe8, 0xbb, 0x98, 0x93, 0xa1, 0x3e, 0x64, 0xd4, 0x10, 0xa0, 0x5f, 0xa7, 0x40, 0x7b,
+	0x37, 0x65, 0x4c, 0xf4, 0x1e, 0x12, 0x1a, 0xf7, 0xc4, 0x76, 0x0f, 0x67, 0x31, 0x79, 0x80, 0x0b,
+	0x9c, 0x72, 0xf8, 0x39, 0x00, 0x5c, 0xe0, 0x42, 0x04, 0x92, 0xb5, 0x6d, 0xad, 0x59, 0xd7, 0x9b,
+	0x9b, 0x2b, 0x8e, 0x0e, 0xc9, 0x29, 0x43, 0x72, 0x3e, 0x2b, 0x43, 0xf2, 0xde, 0x39, 0x1c, 0xd8,
+	0xb5, 0x93, 0x81, 0xbd, 0x78, 0x80, 0xd3, 0x64, 0x0b, 0x8d, 0x7c, 0xd1, 0xb3, 0x3f, 0x6c, 0xcb,
+	0x9f, 0x57, 0x02, 0x69, 0x0e, 0x7b, 0x60, 0xae, 0xcc, 0xb4, 0x5d, 0x57, 0xb8, 0xcb, 0xaf, 0xe0,
+	0xee, 0x18, 0x03, 0x6f, 0x43, 0xc2, 0xfe, 0x3d, 0xb0, 0x61, 0xe9, 0x72, 0x93, 0xa5, 0x54, 0x90,
+	0x34, 0x17, 0x07, 0x27, 0x03, 0xfb, 0x8a, 0x26, 0x2b, 0x75, 0xe8, 0xb9, 0xa4, 0x1a, 0xa2, 0xc3,
+	0x7d, 0xd0, 0xa2, 0x19, 0x15, 0x14, 0x27, 0x41, 0xce, 0x58, 0x12, 0x3c, 0x51, 0x69, 0xf2, 0xf6,
+	0xd4, 0xda, 0xd4, 0xf5, 0xe6, 0xa6, 0xed, 0x8c, 0x2b, 0xad, 0x23, 0x6b, 0xff, 0x31, 0xe7, 0x44,
+	0x78, 0xef, 0x9a, 0x94, 0x56, 0x35, 0xcb, 0x38, 0x28, 0xe4, 0x43, 0x23, 0x96, 0x6e, 0xfa, 0x1a,
+	0x39, 0xe4, 0x60, 0x49, 0xe0, 0x22, 0x26, 0xe2, 0x34, 0x6d, 0xe3, 0xf5, 0x68, 0x91, 0xa1, 0x5d,
+	0xd1, 0xb4, 0x63, 0x90, 0x90, 0xbf, 0xa8, 0xa5, 0x15, 0x52, 0xf4, 0x4f, 0x1d, 0x5c, 0xbb, 0x4f,
+	0xbf, 0xee, 0xd3, 0x88, 0x8a, 0x03, 0x8f, 0x31, 0xc1, 0x45, 0x81, 0xf3, 0x9c, 0x66, 0xb1, 0xa9,
+	0xe8, 0x2d, 0x00, 0x38, 0x4e, 0x48, 0x10, 0x91, 0x8c, 0xa5, 0xaa, 0xa2, 0xf3, 0xde, 0xd5, 0x4a,
+	0xc5, 0x86, 0x3a, 0xe4, 0xcf, 0xcb, 0xc3, 0x8e, 0xfc, 0x86, 0x7b, 0xe0, 0x8a, 0xd2, 0x54, 0x9a,
+	0xa1, 0x7e, 0x61, 0x33, 0x94, 0x29, 0xbc, 0x55, 0x81, 0x3e, 0xdb, 0x11, 0x97, 0xa4, 0x74, 0x77,
+	0xd8, 0x15, 0x5f, 0x01, 0x25, 0x08, 0x48, 0x16, 0x69, 0x96, 0xa9, 0x0b, 0x59, 0xd6, 0x0c, 0x4b,
+	0xab, 0xc2, 0x52, 0xba, 0x6b, 0x8e, 0xa6, 0x94, 0xdd, 0xc9, 0x22, 0xc5, 0xf0, 0x10, 0x2c, 0xe4,
+	0xfd, 0x22, 0xec, 0x61, 0x4e, 0x82, 0x10, 0xe7, 0xed, 0x86, 0xba, 0x81, 0x5b, 0x12, 0xe4, 0xf7,
+	0x81, 0x7d, 0x55, 0x0f, 0x0b, 0x8f, 0x1e, 0x3b, 0x94, 0xb9, 0x29, 0x16, 0x3d, 0xe7, 0x5e, 0x26,
+	0x4e, 0x06, 0xf6, 0x92, 0x46, 0xaf, 0xba, 0x22, 0xbf, 0x59, 0x1e, 0xb7, 0x71, 0x8e, 0x7e, 0x68,
+	0x00, 0x20, 0x2b, 0x61, 0xee, 0xf9, 0x53, 0x30, 0xc7, 0x9f, 0xe0, 0x3c, 0xd8, 0x23, 0xc4, 0xdc,
+	0xf2, 0x6d, 0xc3, 0xb1, 0xfa, 0x2a, 0xc7, 0x7d, 0x12, 0xe3, 0xf0, 0x60, 0x87, 0x84, 0xa3, 0x6e,
+	0x2e, 0x9d, 0x91, 0x3f, 0x2b, 0x3f, 0xef, 0x12, 0x22, 0x21, 0xc9, 0x53, 0x2a, 0x14, 0x64, 0xfd,
+	0x0d, 0x20, 0x4b, 0x67, 0xe4, 0xcf, 0xca, 0x4f, 0x09, 0xf9, 0x9d, 0x05, 0x56, 0xb9, 0x1a, 0x7e,
+	0xd3, 0x55, 0x41, 0xa8, 0xc6, 0x3f, 0xc8, 0x55, 0x16, 0xe6, 0xfa, 0x9d, 0xf1, 0xcd, 0x3a, 0x69,
+	0x6b, 0x78, 0x37, 0x0e, 0x07, 0xb6, 0x75, 0x32, 0xb0, 0x91, 0x49, 0x65, 0x32, 0x01, 0xf2, 0xdb,
+	0x7c, 0xd2, 0xee, 0xf9, 0xd1, 0x02, 0x9d, 0xa4, 0x6c, 0xe5, 0xa0, 0x5b, 0xed, 0xe5, 0x32, 0xbc,
+	0x86, 0x0a, 0x6f, 0x73, 0x7c, 0x78, 0xe7, 0x8d, 0x81, 0xb7, 0x6e, 0x42, 0x7c, 0x5f, 0x87, 0x78,
+	0x3e, 0x0f, 0xf2, 0xaf, 0x25, 0xe7, 0x80, 0x6d, 0xbd, 0xf7, 0xed, 0x5f, 0x3f, 0xdd, 0xb0, 0x4f,
+	0xbd, 0x25, 0x5e, 0xe5, 0x0d, 0xd1, 0x56, 0xe8, 0x7b, 0x0b, 0xcc, 0x0f, 0xe7, 0x1b, 0xde, 0x01,
+	0xd3, 0x82, 0x3d, 0x26, 0x99, 0x59, 0xaa, 0xcb, 0x8e, 0x79, 0x3e, 0xe4, 0x9a, 0x1e, 0xa6, 0xb0,
+	0xcd, 0x68, 0xe6, 0xb5, 0x4c, 0x83, 0x2f, 0x98, 0x4d, 0x20, 0xbd, 0x90, 0xaf, 0xbd, 0xe1, 0x5d,
+	0x30, 0xa3, 0xef, 0xd5, 0x74, 0x84, 0x73, 0x51, 0x23, 0x5f, 0xd2, 0x28, 0xda, 0x09, 0xf9, 0xc6,
+	0x1b, 0xfd, 0xdb, 0x00, 0x0d, 0x19, 0x1c, 0xbc, 0x09, 0x66, 0x71, 0x14, 0x15, 0x84, 0x73, 0xd3,
+	0xb6, 0xf0, 0x64, 0x60, 0x5f, 0xd6, 0x4e, 0x46, 0x81, 0xfc, 0xd2, 0x04, 0x5e, 0x06, 0x75, 0x1a,
+	0x29, 0xea, 0x86, 0x5f, 0xa7, 0x11, 0xdc, 0x03, 0x4d, 0xb5, 0xa2, 0x4e, 0xb5, 0xcf, 0xda, 0xe4,
+	0x5d, 0x67, 0xaa, 0x71, 0x66, 0xc7, 0x96, 0x0f, 0x70, 0x50, 0xc1, 0x42, 0x3e, 0xc8, 0xab, 0xd3,
+	0xd5, 0xda, 0xeb, 0x8b, 0x7e, 0x41, 0xb4, 0x49, 0xcc, 0xf6, 0x49, 0x91, 0xb1, 0xc2, 0x4c, 0xb3,
+	0x3d, 0x82, 0x1a, 0x67, 0x85, 0x7c, 0xa8, 0xc5, 0x32, 0x82, 0x4f, 0x8c, 0x10, 0x3e, 0x02, 0x0b,
+	0x82, 0x09, 0x9c, 0x04, 0xbc, 0x87, 0x0b, 0xc2, 0xdb, 0xd3, 0x17, 0xd5, 0x65, 0xd5, 0x04, 0xbd,
+	0x54, 0xd6, 0x65, 0xe4, 0x8c, 0xfc, 0xa6, 0x3a, 0xee, 0xaa, 0x13, 0xfc, 0xd2, 0xdc, 0x0a, 0x96,
+	0x95, 0xe7, 0xed, 0x99, 0xd7, 0x7b, 0x01, 0x56, 0x0c, 0x3e, 0x34, 0xab, 0x67, 0x84, 0x60, 0xee,
+	0x42, 0x99, 0x71, 0xb9, 0xd1, 0x34, 0xb7, 0x69, 0x84, 0xd9, 0xff, 0xb5, 0xd1, 0xaa, 0xae, 0x65,
+	0xd8, 0x7a, 0x18, 0xe1, 0x16, 0x58, 0x50, 0xa4, 0x61, 0x41, 0xb0, 0x60, 0x45, 0x7b, 0x4e, 0x01,
+	0xbf, 0x3d, 0xf2, 0xad, 0x6a, 0xe5, 0x36, 0x64, 0x2c, 0xd9, 0xd6, 0xa7, 0x2d, 0xf7, 0x9b, 0x17,
+	0x76, 0xed, 0xf9, 0x0b, 0xbb, 0xf6, 0xcb, 0xcf, 0xeb, 0xd3, 0x32, 0xa7, 0x7b, 0x72, 0x46, 0x96,
+	0x27, 0xce, 0x88, 0xf7, 0xe8, 0xf0, 0xa8, 0x63, 0xbd, 0x3c, 0xea, 0x58, 0x7f, 0x1e, 0x75, 0xac,
+	0x67, 0xc7, 0x9d, 0xda, 0xcb, 0xe3, 0x4e, 0xed, 0xb7, 0xe3, 0x4e, 0xed, 0x8b, 0x8f, 0x62, 0x2a,
+	0x7a, 0xfd, 0xae, 0x13, 0xb2, 0xd4, 0x35, 0xfe, 0xeb, 0x09, 0xee, 0xf2, 0xf2, 0xe0, 0xee, 0x6f,
+	0xde, 0x76, 0x9f, 0x6a, 0x48, 0x19, 0xc2, 0x7a, 0xca, 0x22, 0x92, 0xf0, 0xe1, 0xdf, 0xb8, 0xee,
+	0x8c, 0x7a, 0x35, 0x3e, 0xfc, 0x6f, 0x00, 0xf5, 0xf3, 0x44, 0x19, 0xee, 0x09, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -428,6 +527,62 @@ func (m *SmoothWeightChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *LiquidityBootstrappingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBootstrappingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBootstrappingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PurchaseCap.Size()
+		i -= size
+		if _, err := m.PurchaseCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SaleEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SaleEndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SaleStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SaleStartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SaleDenom) > 0 {
+		i -= len(m.SaleDenom)
+		copy(dAtA[i:], m.SaleDenom)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.SaleDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -448,6 +603,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquidityBootstrappingParams != nil {
+		{
+			size, err := m.LiquidityBootstrappingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -546,6 +713,13 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolCreator) > 0 {
+		i -= len(m.PoolCreator)
+		copy(dAtA[i:], m.PoolCreator)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.PoolCreator)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -648,6 +822,25 @@ func (m *SmoothWeightChangeParams) Size() (n int) {
 	return n
 }
 
+func (m *LiquidityBootstrappingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SaleDenom)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SaleStartTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SaleEndTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = m.PurchaseCap.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	return n
+}
+
 func (m *PoolParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -662,6 +855,10 @@ func (m *PoolParams) Size() (n int) {
 		l = m.SmoothWeightChangeParams.Size()
 		n += 1 + l + sovBalancerPool(uint64(l))
 	}
+	if m.LiquidityBootstrappingParams != nil {
+		l = m.LiquidityBootstrappingParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -707,6 +904,10 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	l = len(m.PoolCreator)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -900,6 +1101,188 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LiquidityBootstrappingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityBootstrappingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityBootstrappingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SaleStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SaleEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PurchaseCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1033,6 +1416,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBootstrappingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityBootstrappingParams == nil {
+				m.LiquidityBootstrappingParams = &LiquidityBootstrappingParams{}
+			}
+			if err := m.LiquidityBootstrappingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1417,6 +1836,38 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
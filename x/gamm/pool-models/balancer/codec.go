@@ -16,6 +16,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
+	cdc.RegisterConcrete(&MsgScheduleWeightChange{}, "osmosis/gamm/schedule-weight-change", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 }
 
@@ -33,10 +34,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgScheduleWeightChange{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
 		&PoolParams{},
+		&SmoothWeightChangeParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,115 @@
+package balancer
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// Validate returns an error if the pool does not have exactly two assets, one of which is the sale denom,
+// the sale window is empty or the purchase cap is negative.
+func (params LiquidityBootstrappingParams) Validate(poolWeights []PoolAsset) error {
+	if len(poolWeights) != 2 {
+		return errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "pool must have exactly 2 assets, got %d", len(poolWeights))
+	}
+
+	if params.SaleDenom != poolWeights[0].Token.Denom && params.SaleDenom != poolWeights[1].Token.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "sale denom %s is not a pool asset", params.SaleDenom)
+	}
+
+	if !params.SaleEndTime.After(params.SaleStartTime) {
+		return errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "sale end time %s must be after sale start time %s", params.SaleEndTime, params.SaleStartTime)
+	}
+
+	if !params.PurchaseCap.IsNil() && params.PurchaseCap.IsNegative() {
+		return errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "purchase cap must not be negative, got %s", params.PurchaseCap)
+	}
+
+	return nil
+}
+
+// HasPurchaseCap returns true if the amount of the sale denom each address may buy is capped.
+func (params LiquidityBootstrappingParams) HasPurchaseCap() bool {
+	return !params.PurchaseCap.IsNil() && params.PurchaseCap.IsPositive()
+}
+
+// IsSaleActive returns true if the pool may be swapped against at blockTime,
+// i.e. sale_start_time <= blockTime < sale_end_time.
+func (params LiquidityBootstrappingParams) IsSaleActive(blockTime time.Time) bool {
+	return !blockTime.Before(params.SaleStartTime) && blockTime.Before(params.SaleEndTime)
+}
+
+// HasSaleEnded returns true if the sale is over at blockTime.
+func (params LiquidityBootstrappingParams) HasSaleEnded(blockTime time.Time) bool {
+	return !blockTime.Before(params.SaleEndTime)
+}
+
+// IsLiquidityBootstrappingPool returns true if the pool is a liquidity bootstrapping pool.
+func (p Pool) IsLiquidityBootstrappingPool() bool {
+	return p.PoolParams.LiquidityBootstrappingParams != nil
+}
+
+// ValidateJoin returns an error if the pool is a liquidity bootstrapping pool and the joiner is not
+// the pool creator.
+func (p Pool) ValidateJoin(joiner sdk.AccAddress) error {
+	if !p.IsLiquidityBootstrappingPool() {
+		return nil
+	}
+
+	if joiner.String() != p.PoolCreator {
+		return types.ErrLiquidityBootstrappingJoinRestricted
+	}
+
+	return nil
+}
+
+// ValidateExit returns an error if the pool is a liquidity bootstrapping pool and either the exiter is
+// not the pool creator or the sale has not ended at blockTime.
+func (p Pool) ValidateExit(exiter sdk.AccAddress, blockTime time.Time) error {
+	if !p.IsLiquidityBootstrappingPool() {
+		return nil
+	}
+
+	if exiter.String() != p.PoolCreator || !p.PoolParams.LiquidityBootstrappingParams.HasSaleEnded(blockTime) {
+		return types.ErrLiquidityBootstrappingExitRestricted
+	}
+
+	return nil
+}
+
+// GetLiquidityBootstrappingQuoteDenom returns the denom the sale denom of the liquidity bootstrapping
+// pool is bought with, i.e. its other pool asset.
+func (p Pool) GetLiquidityBootstrappingQuoteDenom() (string, error) {
+	if !p.IsLiquidityBootstrappingPool() {
+		return "", types.ErrNotLiquidityBootstrappingPool
+	}
+
+	for _, poolAsset := range p.PoolAssets {
+		if poolAsset.Token.Denom != p.PoolParams.LiquidityBootstrappingParams.SaleDenom {
+			return poolAsset.Token.Denom, nil
+		}
+	}
+	return "", errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "pool %d has no quote denom", p.Id)
+}
+
+// LiquidityBootstrappingSpotPriceAt returns the spot price of the sale denom of the liquidity bootstrapping
+// pool in its quote denom implied by the pool weights at the given time, assuming the pool liquidity does
+// not change until then. The pool is expected to have been poked at the current block time beforehand.
+func (p Pool) LiquidityBootstrappingSpotPriceAt(ctx sdk.Context, t time.Time) (osmomath.BigDec, error) {
+	quoteDenom, err := p.GetLiquidityBootstrappingQuoteDenom()
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	// poke a copy of the pool, so that the weights of the pool itself are left as they are
+	poolAtTime := p
+	poolAtTime.PoolAssets = make([]PoolAsset, len(p.PoolAssets))
+	copy(poolAtTime.PoolAssets, p.PoolAssets)
+	poolAtTime.PokePool(t)
+
+	return poolAtTime.SpotPrice(ctx, quoteDenom, p.PoolParams.LiquidityBootstrappingParams.SaleDenom)
+}
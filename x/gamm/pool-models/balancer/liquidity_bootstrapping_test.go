@@ -0,0 +1,264 @@
+package balancer_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+var (
+	defaultSaleStartTime = defaultCurBlockTime.Add(time.Hour)
+	defaultSaleEndTime   = defaultSaleStartTime.Add(72 * time.Hour)
+
+	defaultLiquidityBootstrappingPoolAssets = []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(9), Token: sdk.NewInt64Coin("sale", 9_000_000)},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+	}
+)
+
+func newLiquidityBootstrappingParams() *balancer.LiquidityBootstrappingParams {
+	return &balancer.LiquidityBootstrappingParams{
+		SaleDenom:     "sale",
+		SaleStartTime: defaultSaleStartTime,
+		SaleEndTime:   defaultSaleEndTime,
+		PurchaseCap:   osmomath.ZeroInt(),
+	}
+}
+
+// newLiquidityBootstrappingPool returns a liquidity bootstrapping pool created by creator, whose weights
+// go from 9:1 to 1:9 (sale:uosmo) over the sale window.
+func newLiquidityBootstrappingPool(t *testing.T, creator sdk.AccAddress) balancer.Pool {
+	t.Helper()
+	pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: defaultZeroExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			StartTime: defaultSaleStartTime,
+			Duration:  defaultSaleEndTime.Sub(defaultSaleStartTime),
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("sale", 0)},
+				{Weight: osmomath.NewInt(9), Token: sdk.NewInt64Coin("uosmo", 0)},
+			},
+		},
+		LiquidityBootstrappingParams: newLiquidityBootstrappingParams(),
+	}, defaultLiquidityBootstrappingPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	pool.PoolCreator = creator.String()
+	return pool
+}
+
+func TestLiquidityBootstrappingParamsValidate(t *testing.T) {
+	tests := map[string]struct {
+		modifyParams func(*balancer.LiquidityBootstrappingParams)
+		poolAssets   []balancer.PoolAsset
+		expectedErr  error
+	}{
+		"valid params": {
+			modifyParams: func(*balancer.LiquidityBootstrappingParams) {},
+		},
+		"valid params with purchase cap": {
+			modifyParams: func(params *balancer.LiquidityBootstrappingParams) {
+				params.PurchaseCap = osmomath.NewInt(100)
+			},
+		},
+		"sale denom is not a pool asset": {
+			modifyParams: func(params *balancer.LiquidityBootstrappingParams) {
+				params.SaleDenom = "foo"
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrappingParams,
+		},
+		"more than two pool assets": {
+			modifyParams: func(*balancer.LiquidityBootstrappingParams) {},
+			poolAssets: append([]balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("foo", 1_000_000)},
+			}, defaultLiquidityBootstrappingPoolAssets...),
+			expectedErr: types.ErrInvalidLiquidityBootstrappingParams,
+		},
+		"sale end time equal to sale start time": {
+			modifyParams: func(params *balancer.LiquidityBootstrappingParams) {
+				params.SaleEndTime = params.SaleStartTime
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrappingParams,
+		},
+		"sale end time before sale start time": {
+			modifyParams: func(params *balancer.LiquidityBootstrappingParams) {
+				params.SaleEndTime = params.SaleStartTime.Add(-time.Second)
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrappingParams,
+		},
+		"negative purchase cap": {
+			modifyParams: func(params *balancer.LiquidityBootstrappingParams) {
+				params.PurchaseCap = osmomath.NewInt(-1)
+			},
+			expectedErr: types.ErrInvalidLiquidityBootstrappingParams,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := newLiquidityBootstrappingParams()
+			tc.modifyParams(params)
+
+			poolAssets := tc.poolAssets
+			if poolAssets == nil {
+				poolAssets = defaultLiquidityBootstrappingPoolAssets
+			}
+
+			err := params.Validate(poolAssets)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewLiquidityBootstrappingPool_SaleEnded(t *testing.T) {
+	_, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+		SwapFee:                      defaultSpreadFactor,
+		ExitFee:                      defaultZeroExitFee,
+		LiquidityBootstrappingParams: newLiquidityBootstrappingParams(),
+	}, defaultLiquidityBootstrappingPoolAssets, defaultFutureGovernor, defaultSaleEndTime)
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityBootstrappingParams)
+}
+
+func TestLiquidityBootstrappingIsActive(t *testing.T) {
+	pool := newLiquidityBootstrappingPool(t, sdk.AccAddress("creator"))
+
+	tests := map[string]struct {
+		blockTime        time.Time
+		expectedIsActive bool
+	}{
+		"before sale start": {
+			blockTime:        defaultSaleStartTime.Add(-time.Second),
+			expectedIsActive: false,
+		},
+		"at sale start": {
+			blockTime:        defaultSaleStartTime,
+			expectedIsActive: true,
+		},
+		"during sale": {
+			blockTime:        defaultSaleEndTime.Add(-time.Second),
+			expectedIsActive: true,
+		},
+		"at sale end": {
+			blockTime:        defaultSaleEndTime,
+			expectedIsActive: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(tc.blockTime)
+			require.Equal(t, tc.expectedIsActive, pool.IsActive(ctx))
+		})
+	}
+}
+
+func TestLiquidityBootstrappingValidateJoinAndExit(t *testing.T) {
+	creator := sdk.AccAddress("creator")
+	other := sdk.AccAddress("other")
+	pool := newLiquidityBootstrappingPool(t, creator)
+
+	tests := map[string]struct {
+		pool              balancer.Pool
+		address           sdk.AccAddress
+		blockTime         time.Time
+		expectedJoinError error
+		expectedExitError error
+	}{
+		"creator during sale": {
+			pool:              pool,
+			address:           creator,
+			blockTime:         defaultSaleStartTime,
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+		"creator after sale end": {
+			pool:      pool,
+			address:   creator,
+			blockTime: defaultSaleEndTime,
+		},
+		"other address during sale": {
+			pool:              pool,
+			address:           other,
+			blockTime:         defaultSaleStartTime,
+			expectedJoinError: types.ErrLiquidityBootstrappingJoinRestricted,
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+		"other address after sale end": {
+			pool:              pool,
+			address:           other,
+			blockTime:         defaultSaleEndTime,
+			expectedJoinError: types.ErrLiquidityBootstrappingJoinRestricted,
+			expectedExitError: types.ErrLiquidityBootstrappingExitRestricted,
+		},
+		"other address of a regular balancer pool": {
+			pool:      balancer.Pool{PoolCreator: creator.String()},
+			address:   other,
+			blockTime: defaultSaleStartTime,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.pool.ValidateJoin(tc.address)
+			if tc.expectedJoinError != nil {
+				require.ErrorIs(t, err, tc.expectedJoinError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = tc.pool.ValidateExit(tc.address, tc.blockTime)
+			if tc.expectedExitError != nil {
+				require.ErrorIs(t, err, tc.expectedExitError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLiquidityBootstrappingSpotPriceAt(t *testing.T) {
+	pool := newLiquidityBootstrappingPool(t, sdk.AccAddress("creator"))
+	midSale := defaultSaleStartTime.Add(defaultSaleEndTime.Sub(defaultSaleStartTime) / 2)
+
+	tests := map[string]struct {
+		time              time.Time
+		expectedSpotPrice osmomath.Dec
+	}{
+		// spot price = (sale weight / uosmo weight) * (uosmo supply / sale supply)
+		"before sale start": {
+			time:              defaultCurBlockTime,
+			expectedSpotPrice: osmomath.MustNewDecFromStr("0.999999999999999999"), // 9 * 1/9
+		},
+		"mid sale": {
+			time:              midSale,
+			expectedSpotPrice: osmomath.MustNewDecFromStr("0.111111111111111111"), // 1 * 1/9
+		},
+		"sale end": {
+			time:              defaultSaleEndTime,
+			expectedSpotPrice: osmomath.MustNewDecFromStr("0.012345679012345679"), // 1/9 * 1/9
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			spotPrice, err := pool.LiquidityBootstrappingSpotPriceAt(sdk.Context{}, tc.time)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSpotPrice, spotPrice.Dec())
+
+			// the weights of the pool itself are left untouched
+			require.Equal(t, pool.PoolParams.SmoothWeightChangeParams.InitialPoolWeights[0].Weight, pool.PoolAssets[0].Weight)
+		})
+	}
+
+	_, err := balancer.Pool{}.LiquidityBootstrappingSpotPriceAt(sdk.Context{}, defaultCurBlockTime)
+	require.ErrorIs(t, err, types.ErrNotLiquidityBootstrappingPool)
+}
@@ -16,6 +16,7 @@ type balancerPoolPretty struct {
 	TotalWeight        osmomath.Dec   `json:"total_weight" yaml:"total_weight"`
 	TotalShares        sdk.Coin       `json:"total_shares" yaml:"total_shares"`
 	PoolAssets         []PoolAsset    `json:"pool_assets" yaml:"pool_assets"`
+	PoolCreator        string         `json:"pool_creator,omitempty" yaml:"pool_creator,omitempty"`
 }
 
 func (p Pool) String() string {
@@ -43,6 +44,7 @@ func (p Pool) MarshalJSON() ([]byte, error) {
 		TotalWeight:        decTotalWeight,
 		TotalShares:        p.TotalShares,
 		PoolAssets:         p.PoolAssets,
+		PoolCreator:        p.PoolCreator,
 	})
 }
 
@@ -60,6 +62,7 @@ func (p *Pool) UnmarshalJSON(bz []byte) error {
 	p.TotalWeight = alias.TotalWeight.RoundInt()
 	p.TotalShares = alias.TotalShares
 	p.PoolAssets = alias.PoolAssets
+	p.PoolCreator = alias.PoolCreator
 
 	return nil
 }
@@ -1,6 +1,9 @@
 package balancer
 
 import (
+	"fmt"
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,7 +13,8 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool   = "create_balancer_pool"
+	TypeMsgScheduleWeightChange = "schedule_weight_change"
 )
 
 var (
@@ -94,9 +98,76 @@ func (msg MsgCreateBalancerPool) InitialLiquidity() sdk.Coins {
 
 func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
 	poolI, err := NewBalancerPool(poolID, *msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, ctx.BlockTime())
+	poolI.PoolCreator = msg.Sender
 	return &poolI, err
 }
 
 func (msg MsgCreateBalancerPool) GetPoolType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Balancer
 }
+
+var _ sdk.Msg = &MsgScheduleWeightChange{}
+
+func NewMsgScheduleWeightChange(
+	sender string,
+	poolID uint64,
+	startTime time.Time,
+	duration time.Duration,
+	targetPoolWeights []PoolAsset,
+) MsgScheduleWeightChange {
+	return MsgScheduleWeightChange{
+		Sender:            sender,
+		PoolID:            poolID,
+		StartTime:         startTime,
+		Duration:          duration,
+		TargetPoolWeights: targetPoolWeights,
+	}
+}
+
+func (msg MsgScheduleWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Duration <= 0 {
+		return fmt.Errorf("weight change must have a positive duration, got %s", msg.Duration)
+	}
+
+	if len(msg.TargetPoolWeights) < types.MinNumOfAssetsInPool {
+		return types.ErrTooFewPoolAssets
+	}
+
+	if len(msg.TargetPoolWeights) > types.MaxNumOfAssetsInPool {
+		return errorsmod.Wrapf(types.ErrTooManyPoolAssets, "%d", len(msg.TargetPoolWeights))
+	}
+
+	// the token amounts of the target weights are ignored, so only their denoms are validated
+	denomExists := map[string]bool{}
+	for _, targetWeight := range msg.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(targetWeight.Weight); err != nil {
+			return err
+		}
+
+		if err := sdk.ValidateDenom(targetWeight.Token.Denom); err != nil {
+			return err
+		}
+
+		if denomExists[targetWeight.Token.Denom] {
+			return errorsmod.Wrapf(types.ErrPoolParamsInvalidDenom, "target weight of %s is specified more than once", targetWeight.Token.Denom)
+		}
+		denomExists[targetWeight.Token.Denom] = true
+	}
+
+	return nil
+}
+
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
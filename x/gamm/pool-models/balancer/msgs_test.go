@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -291,6 +292,136 @@ func (s *KeeperTestSuite) TestMsgCreateBalancerPool() {
 
 			s.Require().Equal(expectedPoolLiquidity, cfmmPool.GetTotalPoolLiquidity(s.Ctx))
 			s.Require().Equal(types.InitPoolSharesSupply, cfmmPool.GetTotalShares())
+			s.Require().Equal(tc.msg.Sender, pool.(*balancer.Pool).PoolCreator)
 		})
 	}
 }
+
+func TestMsgScheduleWeightChange_ValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+		targetPoolWeights := []balancer.PoolAsset{
+			{
+				Weight: osmomath.NewInt(100),
+				Token:  sdk.NewCoin("test", osmomath.ZeroInt()),
+			},
+			{
+				Weight: osmomath.NewInt(300),
+				Token:  sdk.NewCoin("test2", osmomath.ZeroInt()),
+			},
+		}
+
+		msg := balancer.NewMsgScheduleWeightChange(addr1, 1, time.Unix(1_700_000_000, 0), time.Hour, targetPoolWeights)
+		return after(msg)
+	}
+
+	default_msg := createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "schedule_weight_change")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty start time",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.StartTime = time.Time{}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative duration",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.Duration = -time.Hour
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too few target weights",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.TargetPoolWeights = msg.TargetPoolWeights[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Weight = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a target weight",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Weight = osmomath.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid target weight denom",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Token.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate target weight denom",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.TargetPoolWeights[1].Token.Denom = msg.TargetPoolWeights[0].Token.Denom
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -67,6 +67,10 @@ func NewBalancerPool(poolId uint64, balancerPoolParams PoolParams, assets []Pool
 		return Pool{}, err
 	}
 
+	if lbpParams := balancerPoolParams.LiquidityBootstrappingParams; lbpParams != nil && lbpParams.HasSaleEnded(blockTime) {
+		return Pool{}, errorsmod.Wrapf(types.ErrInvalidLiquidityBootstrappingParams, "sale end time %s must be after the current block time", lbpParams.SaleEndTime)
+	}
+
 	err = pool.setInitialPoolParams(balancerPoolParams, sortedPoolAssets, blockTime)
 	if err != nil {
 		return Pool{}, err
@@ -211,6 +215,31 @@ func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset,
 	return nil
 }
 
+// ScheduleWeightChange installs a new smooth weight change schedule on the pool, replacing any pending one.
+// The weights change linearly from the current pool weights to the given target weights between startTime
+// and startTime + duration, where a zero startTime starts the weight change at blockTime.
+// The pool is expected to have been poked at blockTime beforehand, so that the weight change starts
+// from the current weights.
+func (p *Pool) ScheduleWeightChange(startTime time.Time, duration time.Duration, targetPoolWeights []PoolAsset, blockTime time.Time) error {
+	if startTime.Unix() > 0 && startTime.Before(blockTime) {
+		return types.ErrInvalidWeightChangeStartTime
+	}
+
+	params := p.PoolParams
+	params.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+		StartTime:         startTime,
+		Duration:          duration,
+		TargetPoolWeights: sortPoolAssetsOutOfPlaceByDenom(targetPoolWeights),
+	}
+
+	sortedPoolAssets := p.GetAllPoolAssets()
+	if err := params.Validate(sortedPoolAssets); err != nil {
+		return err
+	}
+
+	return p.setInitialPoolParams(params, sortedPoolAssets, blockTime)
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
@@ -479,7 +508,12 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// IsActive returns true if the pool may be swapped against, which is always the case
+// except for liquidity bootstrapping pools outside of their sale window.
 func (p Pool) IsActive(ctx sdk.Context) bool {
+	if p.IsLiquidityBootstrappingPool() {
+		return p.PoolParams.LiquidityBootstrappingParams.IsSaleActive(ctx.BlockTime())
+	}
 	return true
 }
 
@@ -65,6 +65,12 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 		}
 	}
 
+	if params.LiquidityBootstrappingParams != nil {
+		if err := params.LiquidityBootstrappingParams.Validate(poolWeights); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
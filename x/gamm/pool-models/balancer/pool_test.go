@@ -1383,3 +1383,129 @@ func (s *KeeperTestSuite) TestGetPoolDenoms() {
 	s.Require().Equal(expectedDenom1, denoms[0])
 	s.Require().Equal(expectedDenom2, denoms[1])
 }
+
+func TestScheduleWeightChange(t *testing.T) {
+	blockTime := defaultCurBlockTime.Add(50 * time.Second)
+	duration := 100 * time.Second
+
+	newPoolAsset := func(denom string, weight int64) balancer.PoolAsset {
+		return balancer.PoolAsset{
+			Weight: osmomath.NewInt(weight),
+			Token:  sdk.NewCoin(denom, osmomath.ZeroInt()),
+		}
+	}
+
+	tests := map[string]struct {
+		pendingWeightChange    *balancer.SmoothWeightChangeParams
+		startTime              time.Time
+		duration               time.Duration
+		targetPoolWeights      []balancer.PoolAsset
+		expectedStartTime      time.Time
+		expectedInitialWeights []int64
+		expectedTargetWeights  []int64
+		expectedErr            error
+	}{
+		"future start time": {
+			startTime:              blockTime.Add(time.Hour),
+			duration:               duration,
+			targetPoolWeights:      []balancer.PoolAsset{newPoolAsset("asset2", 3), newPoolAsset("asset1", 1)},
+			expectedStartTime:      blockTime.Add(time.Hour),
+			expectedInitialWeights: []int64{1, 1},
+			expectedTargetWeights:  []int64{1, 3},
+		},
+		"start time at block time": {
+			startTime:              blockTime,
+			duration:               duration,
+			targetPoolWeights:      []balancer.PoolAsset{newPoolAsset("asset1", 2), newPoolAsset("asset2", 1)},
+			expectedStartTime:      blockTime,
+			expectedInitialWeights: []int64{1, 1},
+			expectedTargetWeights:  []int64{2, 1},
+		},
+		"empty start time defaults to block time": {
+			duration:               duration,
+			targetPoolWeights:      []balancer.PoolAsset{newPoolAsset("asset1", 2), newPoolAsset("asset2", 1)},
+			expectedStartTime:      blockTime,
+			expectedInitialWeights: []int64{1, 1},
+			expectedTargetWeights:  []int64{2, 1},
+		},
+		"replaces ongoing weight change, starting from the current weights": {
+			pendingWeightChange: &balancer.SmoothWeightChangeParams{
+				StartTime:         defaultCurBlockTime,
+				Duration:          duration,
+				TargetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 1), newPoolAsset("asset2", 2)},
+			},
+			duration:          duration,
+			targetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 1), newPoolAsset("asset2", 1)},
+			expectedStartTime: blockTime,
+			// halfway between 1:1 and 1:2
+			expectedInitialWeights: []int64{2, 3},
+			expectedTargetWeights:  []int64{1, 1},
+		},
+		"start time before block time": {
+			startTime:         blockTime.Add(-time.Second),
+			duration:          duration,
+			targetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 2), newPoolAsset("asset2", 1)},
+			expectedErr:       types.ErrInvalidWeightChangeStartTime,
+		},
+		"target weights of other denoms": {
+			duration:          duration,
+			targetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 2), newPoolAsset("asset3", 1)},
+			expectedErr:       types.ErrPoolParamsInvalidDenom,
+		},
+		"wrong number of target weights": {
+			duration:          duration,
+			targetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 2)},
+			expectedErr:       types.ErrPoolParamsInvalidNumDenoms,
+		},
+		"zero target weight": {
+			duration:          duration,
+			targetPoolWeights: []balancer.PoolAsset{newPoolAsset("asset1", 0), newPoolAsset("asset2", 1)},
+			expectedErr:       types.ErrNotPositiveWeight,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+				SmoothWeightChangeParams: tc.pendingWeightChange,
+				SwapFee:                  defaultSpreadFactor,
+				ExitFee:                  defaultZeroExitFee,
+			}, []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("asset1", 1000)},
+				{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("asset2", 1000)},
+			}, defaultFutureGovernor, defaultCurBlockTime)
+			require.NoError(t, err)
+			pool.PokePool(blockTime)
+
+			err = pool.ScheduleWeightChange(tc.startTime, tc.duration, tc.targetPoolWeights, blockTime)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			params := pool.PoolParams.SmoothWeightChangeParams
+			require.NotNil(t, params)
+			require.Equal(t, tc.expectedStartTime, params.StartTime)
+			require.Equal(t, tc.duration, params.Duration)
+
+			initialWeightRatio := params.InitialPoolWeights[0].Weight.ToLegacyDec().Quo(params.InitialPoolWeights[1].Weight.ToLegacyDec())
+			require.Equal(t, osmomath.NewDec(tc.expectedInitialWeights[0]).Quo(osmomath.NewDec(tc.expectedInitialWeights[1])), initialWeightRatio)
+			for i, targetWeight := range params.TargetPoolWeights {
+				require.Equal(t, fmt.Sprintf("asset%d", i+1), targetWeight.Token.Denom)
+				require.Equal(t, osmomath.NewInt(tc.expectedTargetWeights[i]*balancer.GuaranteedWeightPrecision), targetWeight.Weight)
+			}
+
+			// the weights only start changing at the start time
+			pool.PokePool(blockTime)
+			require.Equal(t, params.InitialPoolWeights[0].Weight, pool.PoolAssets[0].Weight)
+			require.Equal(t, params.InitialPoolWeights[1].Weight, pool.PoolAssets[1].Weight)
+
+			// and reach the target weights once the weight change ends
+			pool.PokePool(tc.expectedStartTime.Add(tc.duration).Add(time.Second))
+			require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+			require.Equal(t, osmomath.NewInt(tc.expectedTargetWeights[0]*balancer.GuaranteedWeightPrecision), pool.PoolAssets[0].Weight)
+			require.Equal(t, osmomath.NewInt(tc.expectedTargetWeights[1]*balancer.GuaranteedWeightPrecision), pool.PoolAssets[1].Weight)
+		})
+	}
+}
@@ -12,19 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -146,9 +150,133 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgScheduleWeightChange
+// MsgScheduleWeightChange installs a new smooth weight change schedule on an
+// existing balancer pool, replacing any pending one. The weights change
+// linearly from the current pool weights to target_pool_weights between
+// start_time and start_time + duration.
+// Sender must be the pool creator or the governance module account.
+type MsgScheduleWeightChange struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// start_time is the time the weight change starts at. If unset, the weight
+	// change starts at the current block time. It must not be in the past.
+	StartTime time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// target_pool_weights are the weights of every pool asset at the end of the
+	// weight change. The token amounts are ignored.
+	TargetPoolWeights []PoolAsset `protobuf:"bytes,5,rep,name=target_pool_weights,json=targetPoolWeights,proto3" json:"target_pool_weights" yaml:"target_pool_weights"`
+}
+
+func (m *MsgScheduleWeightChange) Reset()         { *m = MsgScheduleWeightChange{} }
+func (m *MsgScheduleWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChange) ProtoMessage()    {}
+func (*MsgScheduleWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d22c5192b37962a, []int{2}
+}
+func (m *MsgScheduleWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChange.Merge(m, src)
+}
+func (m *MsgScheduleWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChange proto.InternalMessageInfo
+
+func (m *MsgScheduleWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgScheduleWeightChange) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgScheduleWeightChange) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgScheduleWeightChange) GetTargetPoolWeights() []PoolAsset {
+	if m != nil {
+		return m.TargetPoolWeights
+	}
+	return nil
+}
+
+type MsgScheduleWeightChangeResponse struct {
+}
+
+func (m *MsgScheduleWeightChangeResponse) Reset()         { *m = MsgScheduleWeightChangeResponse{} }
+func (m *MsgScheduleWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d22c5192b37962a, []int{3}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgScheduleWeightChange)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChange")
+	proto.RegisterType((*MsgScheduleWeightChangeResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChangeResponse")
 }
 
 func init() {
@@ -156,39 +284,53 @@ func init() {
 }
 
 var fileDescriptor_4d22c5192b37962a = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xdb, 0x28, 0x88, 0x8b, 0x18, 0x7a, 0x2a, 0x10, 0x05, 0x61, 0x07, 0x33, 0x10, 0x2a,
-	0xe5, 0x4e, 0x09, 0x12, 0x43, 0x97, 0x0a, 0x53, 0x51, 0x75, 0xa8, 0x54, 0xb2, 0x95, 0xa5, 0x3a,
-	0x3b, 0xd7, 0xc3, 0x92, 0xcf, 0xcf, 0xf2, 0x39, 0x51, 0x59, 0x19, 0x99, 0xf8, 0x05, 0x8c, 0xcc,
-	0xfd, 0x19, 0x1d, 0x3b, 0x32, 0x59, 0x28, 0x19, 0x3a, 0xb0, 0xe5, 0x17, 0xa0, 0x3b, 0x9f, 0xdb,
-	0x06, 0x05, 0x09, 0x89, 0xc5, 0xbe, 0x7b, 0xef, 0xfb, 0xbe, 0xf7, 0xee, 0x7b, 0x77, 0x68, 0x08,
-	0x4a, 0x82, 0x8a, 0x15, 0x15, 0x4c, 0x4a, 0x9a, 0x01, 0x24, 0x12, 0x26, 0x3c, 0x51, 0x34, 0x64,
-	0x09, 0x4b, 0x23, 0x9e, 0xd3, 0xd9, 0x30, 0xe4, 0x05, 0x1b, 0xd2, 0xe2, 0x9c, 0x64, 0x39, 0x14,
-	0x80, 0xfb, 0x96, 0x42, 0x34, 0x85, 0xdc, 0x52, 0x48, 0x4d, 0x21, 0x96, 0xd2, 0xdd, 0x16, 0x20,
-	0xc0, 0x90, 0xa8, 0x5e, 0x55, 0xfc, 0xee, 0x16, 0x93, 0x71, 0x0a, 0xd4, 0x7c, 0x6d, 0xe8, 0xc5,
-	0x4a, 0x17, 0x75, 0xc5, 0x5a, 0xef, 0x18, 0x20, 0xb1, 0x40, 0x37, 0x32, 0x48, 0x1a, 0x32, 0xc5,
-	0x6f, 0x70, 0x11, 0xc4, 0xa9, 0xcd, 0x7b, 0x02, 0x40, 0x24, 0x9c, 0x9a, 0x5d, 0x38, 0x3d, 0xa3,
-	0x45, 0x2c, 0xb9, 0x2a, 0x98, 0xcc, 0x2c, 0xe0, 0xb1, 0x15, 0x90, 0x4a, 0xd0, 0xd9, 0x50, 0xff,
-	0xaa, 0x84, 0xff, 0x6b, 0x03, 0x3d, 0x3c, 0x52, 0xe2, 0x6d, 0xce, 0x59, 0xc1, 0x83, 0x3b, 0x95,
-	0xf1, 0x4b, 0xd4, 0x52, 0x3c, 0x9d, 0xf0, 0xbc, 0xe3, 0xf4, 0x9c, 0xfe, 0xfd, 0x60, 0x6b, 0x59,
-	0x7a, 0x0f, 0x3e, 0x31, 0x99, 0xec, 0xfa, 0x55, 0xdc, 0x1f, 0x5b, 0x00, 0x3e, 0x41, 0x6d, 0xed,
-	0xc7, 0x69, 0xc6, 0x72, 0x26, 0x55, 0x67, 0xa3, 0xe7, 0xf4, 0xdb, 0xa3, 0x1e, 0x59, 0x31, 0xcc,
-	0x76, 0x4d, 0xb4, 0xf6, 0xb1, 0xc1, 0x05, 0x8f, 0x96, 0xa5, 0x87, 0x2b, 0xc5, 0x3b, 0x74, 0x7f,
-	0x8c, 0xb2, 0x1b, 0x0c, 0x7e, 0x67, 0xa5, 0x99, 0x52, 0xbc, 0x50, 0x9d, 0xcd, 0xde, 0x66, 0xbf,
-	0x3d, 0xf2, 0xfe, 0x2e, 0xfd, 0x46, 0xe3, 0x82, 0xe6, 0x65, 0xe9, 0x35, 0x2a, 0x1d, 0x13, 0x50,
-	0xf8, 0x3d, 0xda, 0x3e, 0x9b, 0x16, 0xd3, 0x9c, 0x9f, 0x1a, 0x39, 0x01, 0x33, 0x9e, 0xa7, 0x90,
-	0x77, 0x9a, 0xe6, 0x6c, 0xde, 0xb2, 0xf4, 0x9e, 0x54, 0x9d, 0xac, 0x43, 0xf9, 0x63, 0x5c, 0x85,
-	0x75, 0x85, 0x03, 0x1b, 0xdc, 0x1d, 0x7e, 0xbe, 0xbe, 0xd8, 0xb1, 0x16, 0x7c, 0xb9, 0xbe, 0xd8,
-	0x79, 0xb6, 0x32, 0xcd, 0xc8, 0x38, 0x3a, 0xa8, 0x87, 0x39, 0xd0, 0x82, 0xfe, 0x3e, 0x7a, 0xba,
-	0xd6, 0xec, 0x31, 0x57, 0x19, 0xa4, 0x8a, 0xe3, 0xe7, 0xe8, 0x9e, 0xa9, 0x1c, 0x4f, 0x8c, 0xeb,
-	0xcd, 0x00, 0xcd, 0x4b, 0xaf, 0xa5, 0x21, 0x87, 0xfb, 0xe3, 0x96, 0x4e, 0x1d, 0x4e, 0x46, 0xdf,
-	0x1d, 0xb4, 0x79, 0xa4, 0x04, 0xfe, 0xe6, 0x20, 0xbc, 0x66, 0x70, 0x7b, 0xe4, 0x5f, 0x6f, 0x2a,
-	0x59, 0xdb, 0x4c, 0xf7, 0xe0, 0x3f, 0x05, 0xea, 0xd3, 0x04, 0x27, 0x97, 0x73, 0xd7, 0xb9, 0x9a,
-	0xbb, 0xce, 0xcf, 0xb9, 0xeb, 0x7c, 0x5d, 0xb8, 0x8d, 0xab, 0x85, 0xdb, 0xf8, 0xb1, 0x70, 0x1b,
-	0x1f, 0xf6, 0x44, 0x5c, 0x7c, 0x9c, 0x86, 0x24, 0x02, 0x49, 0x6d, 0xb1, 0x41, 0xc2, 0x42, 0x55,
-	0x6f, 0xe8, 0x6c, 0xf4, 0x9a, 0x9e, 0xdf, 0xbe, 0xce, 0xc1, 0x1f, 0xcf, 0x33, 0x6c, 0x99, 0xeb,
-	0xfb, 0xea, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x7b, 0x27, 0x3b, 0xc9, 0x03, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xb6, 0x58, 0x65, 0x1a, 0x63, 0xba, 0xa2, 0xd4, 0x1a, 0xba, 0x65, 0x39, 0x58, 0x89,
+	0xdd, 0x4d, 0x4b, 0xe2, 0x81, 0x0b, 0x61, 0x21, 0x12, 0x0e, 0x24, 0x58, 0x4d, 0x14, 0x2f, 0x64,
+	0xda, 0x0e, 0xd3, 0x4d, 0x76, 0x3b, 0xcd, 0xce, 0xb4, 0xc2, 0xd5, 0xa3, 0x27, 0x8e, 0x9e, 0x3c,
+	0xf9, 0x03, 0xf8, 0x19, 0x5c, 0x4c, 0xb8, 0xe9, 0x69, 0x35, 0xe5, 0x40, 0xa2, 0xb7, 0xfe, 0x02,
+	0x33, 0x5f, 0x85, 0xe2, 0x92, 0x40, 0xb8, 0xb4, 0xdd, 0x77, 0x9e, 0x8f, 0x99, 0xf7, 0x7d, 0xba,
+	0x03, 0x6a, 0x84, 0x86, 0x84, 0xfa, 0xd4, 0xc5, 0x30, 0x0c, 0xdd, 0x1e, 0x21, 0x41, 0x48, 0xda,
+	0x28, 0xa0, 0x6e, 0x13, 0x06, 0xb0, 0xdb, 0x42, 0x91, 0x3b, 0xa8, 0x35, 0x11, 0x83, 0x35, 0x97,
+	0xed, 0x3b, 0xbd, 0x88, 0x30, 0x62, 0x56, 0x14, 0xc5, 0xe1, 0x14, 0xe7, 0x9c, 0xe2, 0x68, 0x8a,
+	0xa3, 0x28, 0xc5, 0x19, 0x4c, 0x30, 0x11, 0x24, 0x97, 0xff, 0x92, 0xfc, 0x62, 0x1e, 0x86, 0x7e,
+	0x97, 0xb8, 0xe2, 0x53, 0x95, 0x9e, 0x4d, 0xec, 0x42, 0x3b, 0x6a, 0xbd, 0x6d, 0x42, 0x02, 0x05,
+	0x2c, 0xb5, 0x04, 0xd2, 0x6d, 0x42, 0x8a, 0xc6, 0xb8, 0x16, 0xf1, 0xbb, 0x6a, 0xdd, 0xc2, 0x84,
+	0xe0, 0x00, 0xb9, 0xe2, 0xa9, 0xd9, 0xdf, 0x73, 0x99, 0x1f, 0x22, 0xca, 0x60, 0xd8, 0xd3, 0x02,
+	0x97, 0x01, 0xed, 0x7e, 0x04, 0x99, 0x4f, 0xb4, 0xc0, 0xac, 0x32, 0x08, 0x29, 0x76, 0x07, 0x35,
+	0xfe, 0x25, 0x17, 0xec, 0xbf, 0x69, 0xf0, 0x68, 0x8b, 0xe2, 0xb5, 0x08, 0x41, 0x86, 0xbc, 0x0b,
+	0x3b, 0x33, 0x9f, 0x83, 0x2c, 0x45, 0xdd, 0x36, 0x8a, 0x0a, 0x46, 0xd9, 0xa8, 0x4c, 0x7b, 0xf9,
+	0x51, 0x6c, 0xdd, 0x3f, 0x80, 0x61, 0xb0, 0x6c, 0xcb, 0xba, 0xdd, 0x50, 0x00, 0x73, 0x07, 0xe4,
+	0x78, 0xbf, 0x76, 0x7b, 0x30, 0x82, 0x21, 0x2d, 0xa4, 0xcb, 0x46, 0x25, 0x57, 0x2f, 0x3b, 0x13,
+	0x0d, 0x55, 0xa7, 0x72, 0xb8, 0xf6, 0xb6, 0xc0, 0x79, 0x8f, 0x47, 0xb1, 0x65, 0x4a, 0xc5, 0x0b,
+	0x74, 0xbb, 0x01, 0x7a, 0x63, 0x8c, 0xf9, 0x4a, 0x49, 0x43, 0x4a, 0x11, 0xa3, 0x85, 0x4c, 0x39,
+	0x53, 0xc9, 0xd5, 0xad, 0xab, 0xa5, 0x57, 0x39, 0xce, 0x9b, 0x3a, 0x8e, 0xad, 0x94, 0xd4, 0x11,
+	0x05, 0x6a, 0xbe, 0x06, 0x33, 0x7b, 0x7d, 0xd6, 0x8f, 0xd0, 0xae, 0x90, 0xc3, 0x64, 0x80, 0xa2,
+	0x2e, 0x89, 0x0a, 0x53, 0xe2, 0x6c, 0xd6, 0x28, 0xb6, 0x9e, 0xca, 0x9d, 0x24, 0xa1, 0xec, 0x86,
+	0x29, 0xcb, 0xdc, 0x61, 0x43, 0x15, 0x97, 0x6b, 0x9f, 0xce, 0x8e, 0x16, 0x55, 0x0b, 0x3e, 0x9f,
+	0x1d, 0x2d, 0xce, 0x4f, 0x4c, 0xbb, 0x25, 0x3a, 0x5a, 0xd5, 0xc3, 0xae, 0x72, 0x41, 0x7b, 0x1d,
+	0xcc, 0x25, 0x36, 0xbb, 0x81, 0x68, 0x8f, 0x74, 0x29, 0x32, 0x17, 0xc0, 0x5d, 0xe1, 0xec, 0xb7,
+	0x45, 0xd7, 0xa7, 0x3c, 0x30, 0x8c, 0xad, 0x2c, 0x87, 0x6c, 0xae, 0x37, 0xb2, 0x7c, 0x69, 0xb3,
+	0x6d, 0xff, 0xc8, 0x80, 0xd9, 0x2d, 0x8a, 0xdf, 0xb4, 0x3a, 0xa8, 0xdd, 0x0f, 0xd0, 0x3b, 0xe4,
+	0xe3, 0x0e, 0x5b, 0xeb, 0xc0, 0x2e, 0x46, 0x37, 0x99, 0xda, 0x05, 0xaf, 0xf4, 0x55, 0x5e, 0xe6,
+	0x7b, 0x00, 0x28, 0x83, 0x11, 0xdb, 0xe5, 0x89, 0x2b, 0x64, 0xc4, 0x64, 0x8b, 0x8e, 0x4c, 0x9b,
+	0xa3, 0xd3, 0xe6, 0xbc, 0xd5, 0x71, 0xf4, 0xe6, 0x78, 0xe7, 0x47, 0xb1, 0x95, 0x57, 0x9e, 0x63,
+	0xae, 0x7d, 0xf8, 0xcb, 0x32, 0x1a, 0xd3, 0xa2, 0xc0, 0xe1, 0x66, 0x07, 0xdc, 0xd3, 0x21, 0x15,
+	0x53, 0xc8, 0xd5, 0x9f, 0xfc, 0xa7, 0xbb, 0xae, 0x00, 0x5e, 0x8d, 0xcb, 0xfe, 0x89, 0x2d, 0x53,
+	0x53, 0x5e, 0x90, 0xd0, 0x67, 0x28, 0xec, 0xb1, 0x83, 0x51, 0x6c, 0x3d, 0x90, 0x66, 0x7a, 0xcd,
+	0xfe, 0xc2, 0xad, 0xc6, 0xea, 0x26, 0x05, 0x0f, 0x19, 0x8c, 0x30, 0x62, 0x72, 0xaa, 0x1f, 0x45,
+	0xbf, 0x68, 0xe1, 0xce, 0xf5, 0xb2, 0x64, 0xab, 0x13, 0x15, 0xa5, 0x49, 0x82, 0x92, 0xdd, 0xc8,
+	0xcb, 0x2a, 0x27, 0xc9, 0x69, 0xd0, 0xe5, 0xa5, 0x4b, 0xe9, 0x58, 0x98, 0x48, 0x07, 0x55, 0xb3,
+	0xab, 0x4a, 0x89, 0x6a, 0x4b, 0x4c, 0xcf, 0x9e, 0x07, 0xd6, 0x15, 0x83, 0xd5, 0x09, 0xa9, 0x7f,
+	0x4f, 0x83, 0xcc, 0x16, 0xc5, 0xe6, 0x57, 0x03, 0x98, 0x09, 0xff, 0xda, 0x15, 0xe7, 0xba, 0xaf,
+	0x31, 0x27, 0x31, 0x89, 0xc5, 0x8d, 0x5b, 0x0a, 0x8c, 0xa3, 0xfc, 0xcd, 0x00, 0x33, 0x89, 0x11,
+	0x5d, 0xbd, 0x91, 0x43, 0x92, 0x44, 0x71, 0xf3, 0xd6, 0x12, 0x7a, 0x9b, 0xde, 0xce, 0xf1, 0xb0,
+	0x64, 0x9c, 0x0c, 0x4b, 0xc6, 0xef, 0x61, 0xc9, 0x38, 0x3c, 0x2d, 0xa5, 0x4e, 0x4e, 0x4b, 0xa9,
+	0x9f, 0xa7, 0xa5, 0xd4, 0x87, 0x15, 0xec, 0xb3, 0x4e, 0xbf, 0xe9, 0xb4, 0x48, 0xe8, 0x2a, 0xbb,
+	0x6a, 0x00, 0x9b, 0x54, 0x3f, 0xb8, 0x83, 0xfa, 0x4b, 0x77, 0xff, 0xfc, 0x86, 0xa9, 0x5e, 0xba,
+	0x62, 0x9a, 0x59, 0x91, 0xe3, 0xa5, 0x7f, 0x03, 0x00, 0x60, 0x58, 0x18, 0xec, 0x8d, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -204,6 +346,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error)
 }
 
 type msgClient struct {
@@ -223,9 +366,19 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error) {
+	out := new(MsgScheduleWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	ScheduleWeightChange(context.Context, *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -235,6 +388,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleWeightChange(ctx context.Context, req *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWeightChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -258,6 +414,24 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, req.(*MsgScheduleWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -266,6 +440,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "ScheduleWeightChange",
+			Handler:    _Msg_ScheduleWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/poolmodels/balancer/v1beta1/tx.proto",
@@ -362,6 +540,94 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetPoolWeights) > 0 {
+		for iNdEx := len(m.TargetPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -412,6 +678,41 @@ func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TargetPoolWeights) > 0 {
+		for _, e := range m.TargetPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -671,6 +972,257 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
+			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
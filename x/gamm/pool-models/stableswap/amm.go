@@ -177,6 +177,31 @@ func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice osmomath.Dec, e
 	return res, err
 }
 
+// amplifiedReserves returns the given scaled reserves deepened by the amplification A of the pool.
+// Every reserve is increased by (A - 1) times the mean of the reserves, so the CFMM is evaluated
+// as if the pool held that much more of every asset. This flattens the curve around the balanced
+// point, while leaving the spot price of a balanced pool at 1.
+// An amplification of 1 returns the reserves unchanged, i.e. the unamplified CFMM.
+// The offset is rounded down, as it only ever lowers the price impact of a swap.
+func (p Pool) amplifiedReserves(reserves []osmomath.BigDec) []osmomath.BigDec {
+	amplification := p.GetAmplification()
+	if amplification <= 1 {
+		return reserves
+	}
+
+	sumReserves := osmomath.ZeroBigDec()
+	for _, reserve := range reserves {
+		sumReserves = sumReserves.Add(reserve)
+	}
+	offset := sumReserves.MulInt64(int64(amplification - 1)).QuoTruncateMut(osmomath.NewBigDec(int64(len(reserves))))
+
+	amplified := make([]osmomath.BigDec, len(reserves))
+	for i, reserve := range reserves {
+		amplified[i] = reserve.Add(offset)
+	}
+	return amplified
+}
+
 func oneMinus(spreadFactor osmomath.Dec) osmomath.BigDec {
 	return osmomath.BigDecFromDecMut(osmomath.OneDec().SubMut(spreadFactor))
 }
@@ -188,7 +213,9 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFa
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply, remReserves := reserves[0], reserves[1], reserves[2:]
+	tokenOutReserve := reserves[1]
+	amplified := p.amplifiedReserves(reserves)
+	tokenInSupply, tokenOutSupply, remReserves := amplified[0], amplified[1], amplified[2:]
 	tokenInDec, err := p.scaleCoin(tokenIn, osmomath.RoundDown)
 	if err != nil {
 		return osmomath.Dec{}, err
@@ -200,6 +227,10 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFa
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
 	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
 	// fmt.Println("cfmmout ", cfmmOut)
+	// the amplified reserves are deeper than the pool reserves, so the output must be checked against the latter
+	if cfmmOut.GTE(tokenOutReserve) {
+		return osmomath.Dec{}, types.ErrSwapExceedsPoolReserves
+	}
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
@@ -211,11 +242,17 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply, remReserves := reserves[0], reserves[1], reserves[2:]
+	tokenOutReserve := reserves[1]
+	amplified := p.amplifiedReserves(reserves)
+	tokenInSupply, tokenOutSupply, remReserves := amplified[0], amplified[1], amplified[2:]
 	tokenOutAmount, err := p.scaleCoin(tokenOut, osmomath.RoundUp)
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
+	// the amplified reserves are deeper than the pool reserves, so the output must be checked against the latter
+	if tokenOutAmount.GTE(tokenOutReserve) {
+		return osmomath.Dec{}, types.ErrSwapExceedsPoolReserves
+	}
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
@@ -976,6 +976,7 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 		poolAssets     sdk.Coins
 		scalingFactors []uint64
 		spreadFactor   osmomath.Dec
+		amplification  uint64
 		expectedOut    osmomath.Int
 	}
 
@@ -1053,12 +1054,31 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 			spreadFactor:   osmomath.MustNewDecFromStr("0.03"),
 			expectedOut:    osmomath.NewInt(100 - 3),
 		},
+
+		// with amplification
+		"uneven two asset pool, amplified, no spread factor": {
+			tokenIn:        sdk.NewCoin("foo", osmomath.NewInt(100)),
+			poolAssets:     twoUnevenStablePoolAssets,
+			scalingFactors: defaultTwoAssetScalingFactors,
+			spreadFactor:   osmomath.ZeroDec(),
+			amplification:  100,
+			expectedOut:    osmomath.NewInt(100),
+		},
+		"uneven 3-asset pool, amplified, default spread factor": {
+			tokenIn:        sdk.NewCoin("asset/a", osmomath.NewInt(100)),
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			spreadFactor:   defaultSpreadFactor,
+			amplification:  100,
+			expectedOut:    osmomath.NewInt(100 - 3),
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctx := sdk.Context{}
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			p.PoolParams.Amplification = tc.amplification
 
 			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor)
 			require.NoError(t, err, "test: %s", name)
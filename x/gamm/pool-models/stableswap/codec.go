@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/StableswapPool", nil)
 	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "osmosis/gamm/create-stableswap-pool", nil)
 	cdc.RegisterConcrete(&MsgStableSwapAdjustScalingFactors{}, "osmosis/gamm/stableswap-adjust-scaling-factors", nil)
+	cdc.RegisterConcrete(&MsgStableSwapRampScalingFactors{}, "osmosis/gamm/stableswap-ramp-scaling-factors", nil)
+	cdc.RegisterConcrete(&MsgStableSwapSetRateProvider{}, "osmosis/gamm/stableswap-set-rate-provider", nil)
+	cdc.RegisterConcrete(&MsgStableSwapRampAmplification{}, "osmosis/gamm/stableswap-ramp-amplification", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/StableswapPoolParams", nil)
 }
 
@@ -34,6 +37,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateStableswapPool{},
 		&MsgStableSwapAdjustScalingFactors{},
+		&MsgStableSwapRampScalingFactors{},
+		&MsgStableSwapSetRateProvider{},
+		&MsgStableSwapRampAmplification{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -2,17 +2,28 @@
 // We expect tests for:
 // * MsgCreatePool creating correct pool as expected
 // * MsgStableSwapAdjustScalingFactors works as expected
+// * MsgStableSwapRampScalingFactors works as expected
+// * MsgStableSwapSetRateProvider works as expected
+// * MsgStableSwapRampAmplification works as expected
 package stableswap_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 )
 
 type TestSuite struct {
@@ -57,3 +68,227 @@ func (s *TestSuite) TestSetScalingFactors() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRampScalingFactors() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	createMsg := *baseCreatePoolMsgGen(addr1)
+	createMsg.ScalingFactorController = createMsg.Sender
+	s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.FundAcc(addr1, createMsg.InitialPoolLiquidity.Sort())
+	poolId := s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx)
+	_, err := s.RunMsg(&createMsg)
+	s.Require().NoError(err)
+
+	rampMsg := stableswap.NewMsgStableSwapRampScalingFactors(createMsg.Sender, poolId, []uint64{3, 1}, time.Hour)
+	_, err = s.RunMsg(&rampMsg)
+	s.Require().NoError(err)
+
+	// halfway through the ramp
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2, 1}, pool.(*stableswap.Pool).ScalingFactors)
+
+	// adjusting the scaling factors abruptly cancels the ramp
+	adjustMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(createMsg.Sender, poolId, []uint64{1, 2})
+	_, err = s.RunMsg(&adjustMsg)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2}, pool.(*stableswap.Pool).ScalingFactors)
+	s.Require().Nil(pool.(*stableswap.Pool).ScalingFactorRampParams)
+}
+
+// mockRateProvider is a wasm keeper returning fixed scaling factors for any rate provider query,
+// consuming the given gas.
+type mockRateProvider struct {
+	response string
+	err      error
+	gas      storetypes.Gas
+}
+
+func (m mockRateProvider) QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gas, "mock rate provider query")
+	return []byte(m.response), m.err
+}
+
+func (m mockRateProvider) QueryGasLimit() storetypes.Gas {
+	return 3_000_000
+}
+
+func (s *TestSuite) TestRampAmplification() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	createMsg := *baseCreatePoolMsgGen(addr1)
+	createMsg.ScalingFactorController = createMsg.Sender
+	s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.FundAcc(addr1, createMsg.InitialPoolLiquidity.Sort())
+	poolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+	_, err := s.RunMsg(&createMsg)
+	s.Require().NoError(err)
+
+	// pools are created unamplified
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), pool.(*stableswap.Pool).PoolParams.Amplification)
+
+	// only governance may ramp the amplification, even the scaling factor controller may not
+	endTime := s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration)
+	rampMsg := stableswap.NewMsgStableSwapRampAmplification(createMsg.Sender, poolId, 10, endTime)
+	_, err = s.RunMsg(&rampMsg)
+	s.Require().ErrorIs(err, types.ErrUnauthorizedAmplificationRamp)
+
+	// ramps shorter than the min amplification ramp duration are rejected
+	govAddr := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+	rampMsg = stableswap.NewMsgStableSwapRampAmplification(govAddr, poolId, 10, endTime.Add(-time.Second))
+	_, err = s.RunMsg(&rampMsg)
+	s.Require().ErrorIs(err, types.ErrAmplificationRampTooShort)
+
+	rampMsg = stableswap.NewMsgStableSwapRampAmplification(govAddr, poolId, 10, endTime)
+	_, err = s.RunMsg(&rampMsg)
+	s.Require().NoError(err)
+
+	// halfway through the ramp
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.StableswapMinAmplificationRampDuration / 2))
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), pool.(*stableswap.Pool).GetAmplification())
+
+	// after the ramp
+	s.Ctx = s.Ctx.WithBlockTime(endTime)
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), pool.(*stableswap.Pool).GetAmplification())
+	s.Require().Nil(pool.(*stableswap.Pool).AmplificationRampParams)
+}
+
+func (s *TestSuite) TestSetRateProvider() {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := map[string]struct {
+		rateProvider      mockRateProvider
+		expScalingFactors []uint64
+	}{
+		"scaling factors pulled from rate provider": {
+			rateProvider:      mockRateProvider{response: `{"scaling_factors":["1005000","1000000"]}`},
+			expScalingFactors: []uint64{1005000, 1000000},
+		},
+		"scaling factors clamped to max change per block": {
+			rateProvider:      mockRateProvider{response: `{"scaling_factors":["1100000","900000"]}`},
+			expScalingFactors: []uint64{1010000, 990000},
+		},
+		"failing rate provider leaves scaling factors unchanged": {
+			rateProvider:      mockRateProvider{err: errors.New("contract error")},
+			expScalingFactors: []uint64{1000000, 1000000},
+		},
+		"invalid rate provider response leaves scaling factors unchanged": {
+			rateProvider:      mockRateProvider{response: `{"scaling_factors":["-1","1000000"]}`},
+			expScalingFactors: []uint64{1000000, 1000000},
+		},
+		"rate provider running out of gas leaves scaling factors unchanged": {
+			rateProvider:      mockRateProvider{response: `{"scaling_factors":["1005000","1000000"]}`, gas: types.StableswapRateProviderQueryGasLimit + 1},
+			expScalingFactors: []uint64{1000000, 1000000},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.GAMMKeeper.SetWasmKeeper(tc.rateProvider)
+
+			createMsg := *baseCreatePoolMsgGen(addr1)
+			createMsg.InitialPoolLiquidity = sdk.NewCoins(sdk.NewCoin("atom", osmomath.NewInt(1_000_000_000)), sdk.NewCoin("osmo", osmomath.NewInt(1_000_000_000)))
+			createMsg.ScalingFactors = []uint64{1000000, 1000000}
+			createMsg.ScalingFactorController = createMsg.Sender
+			s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+			s.FundAcc(addr1, createMsg.InitialPoolLiquidity)
+			poolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+			_, err := s.RunMsg(&createMsg)
+			s.Require().NoError(err)
+
+			// only governance may set a rate provider
+			setMsg := stableswap.NewMsgStableSwapSetRateProvider(createMsg.Sender, poolId, contractAddr.String(), osmomath.NewDecWithPrec(1, 2))
+			_, err = s.RunMsg(&setMsg)
+			s.Require().ErrorIs(err, types.ErrUnauthorizedRateProvider)
+
+			setMsg.Sender = s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+			_, err = s.RunMsg(&setMsg)
+			s.Require().NoError(err)
+
+			// the scaling factors can no longer be adjusted abruptly
+			adjustMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(createMsg.Sender, poolId, []uint64{1, 1})
+			_, err = s.RunMsg(&adjustMsg)
+			s.Require().ErrorIs(err, types.ErrRateProviderSet)
+
+			// the gas used by the query, up to its limit, is charged to the block
+			gasBefore := s.Ctx.GasMeter().GasConsumed()
+			s.App.GAMMKeeper.BeginBlock(s.Ctx)
+			s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasBefore, min(tc.rateProvider.gas, types.StableswapRateProviderQueryGasLimit))
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expScalingFactors, pool.(*stableswap.Pool).ScalingFactors)
+
+			// the scaling factor controller may remove the rate provider, which stops pulling the scaling factors
+			removeMsg := stableswap.NewMsgStableSwapSetRateProvider(createMsg.Sender, poolId, "", osmomath.ZeroDec())
+			_, err = s.RunMsg(&removeMsg)
+			s.Require().NoError(err)
+
+			s.App.GAMMKeeper.SetWasmKeeper(mockRateProvider{response: `{"scaling_factors":["1009000","991000"]}`})
+			s.App.GAMMKeeper.BeginBlock(s.Ctx)
+
+			pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expScalingFactors, pool.(*stableswap.Pool).ScalingFactors)
+			s.Require().Nil(pool.(*stableswap.Pool).RateProvider)
+		})
+	}
+}
+
+func (s *TestSuite) TestSetRateProvider_MaxPools() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	govAddr := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+
+	createPool := func() uint64 {
+		createMsg := *baseCreatePoolMsgGen(addr1)
+		createMsg.ScalingFactorController = createMsg.Sender
+		s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+		s.FundAcc(addr1, createMsg.InitialPoolLiquidity)
+		poolId := s.App.PoolManagerKeeper.GetNextPoolId(s.Ctx)
+		_, err := s.RunMsg(&createMsg)
+		s.Require().NoError(err)
+		return poolId
+	}
+
+	poolIds := make([]uint64, types.StableswapMaxRateProviderPools)
+	for i := range poolIds {
+		poolIds[i] = createPool()
+		setMsg := stableswap.NewMsgStableSwapSetRateProvider(govAddr, poolIds[i], contractAddr.String(), osmomath.NewDecWithPrec(1, 2))
+		_, err := s.RunMsg(&setMsg)
+		s.Require().NoError(err)
+	}
+
+	// no more pools may have a rate provider
+	poolId := createPool()
+	setMsg := stableswap.NewMsgStableSwapSetRateProvider(govAddr, poolId, contractAddr.String(), osmomath.NewDecWithPrec(1, 2))
+	_, err := s.RunMsg(&setMsg)
+	s.Require().ErrorIs(err, types.ErrTooManyRateProviderPools)
+
+	// the rate provider of a pool that already has one can still be replaced
+	replaceMsg := stableswap.NewMsgStableSwapSetRateProvider(govAddr, poolIds[0], contractAddr.String(), osmomath.NewDecWithPrec(2, 2))
+	_, err = s.RunMsg(&replaceMsg)
+	s.Require().NoError(err)
+
+	// removing a rate provider makes room for another pool
+	removeMsg := stableswap.NewMsgStableSwapSetRateProvider(addr1.String(), poolIds[0], "", osmomath.ZeroDec())
+	_, err = s.RunMsg(&removeMsg)
+	s.Require().NoError(err)
+	_, err = s.RunMsg(&setMsg)
+	s.Require().NoError(err)
+}
@@ -1,10 +1,13 @@
 package stableswap
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
@@ -12,6 +15,9 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampScalingFactors   = "stable_swap_ramp_scaling_factors"
+	TypeMsgStableSwapSetRateProvider      = "stable_swap_set_rate_provider"
+	TypeMsgStableSwapRampAmplification    = "stable_swap_ramp_amplification"
 )
 
 var (
@@ -161,3 +167,151 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampScalingFactors{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampScalingFactors(
+	sender string,
+	poolID uint64,
+	targetScalingFactors []uint64,
+	duration time.Duration,
+) MsgStableSwapRampScalingFactors {
+	return MsgStableSwapRampScalingFactors{
+		Sender:               sender,
+		PoolID:               poolID,
+		TargetScalingFactors: targetScalingFactors,
+		Duration:             duration,
+	}
+}
+
+func (msg MsgStableSwapRampScalingFactors) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampScalingFactors) Type() string { return TypeMsgStableSwapRampScalingFactors }
+func (msg MsgStableSwapRampScalingFactors) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Duration <= 0 {
+		return types.ErrInvalidRampDuration
+	}
+
+	for _, scalingFactor := range msg.TargetScalingFactors {
+		if int64(scalingFactor) <= 0 {
+			return types.ErrInvalidScalingFactors
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRampScalingFactors) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
+
+var _ sdk.Msg = &MsgStableSwapSetRateProvider{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapSetRateProvider(
+	sender string,
+	poolID uint64,
+	contractAddress string,
+	maxChangePerBlock osmomath.Dec,
+) MsgStableSwapSetRateProvider {
+	return MsgStableSwapSetRateProvider{
+		Sender:            sender,
+		PoolID:            poolID,
+		ContractAddress:   contractAddress,
+		MaxChangePerBlock: maxChangePerBlock,
+	}
+}
+
+func (msg MsgStableSwapSetRateProvider) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapSetRateProvider) Type() string { return TypeMsgStableSwapSetRateProvider }
+func (msg MsgStableSwapSetRateProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// an empty contract address removes the rate provider of the pool
+	if msg.ContractAddress == "" {
+		return nil
+	}
+
+	return msg.RateProvider().Validate()
+}
+
+func (msg MsgStableSwapSetRateProvider) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
+
+// RateProvider returns the rate provider set by the message, or nil if it removes
+// the rate provider of the pool.
+func (msg MsgStableSwapSetRateProvider) RateProvider() *RateProvider {
+	if msg.ContractAddress == "" {
+		return nil
+	}
+
+	return &RateProvider{
+		ContractAddress:   msg.ContractAddress,
+		MaxChangePerBlock: msg.MaxChangePerBlock,
+	}
+}
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	targetAmplification uint64,
+	endTime time.Time,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:              sender,
+		PoolID:              poolID,
+		TargetAmplification: targetAmplification,
+		EndTime:             endTime,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampAmplification) Type() string { return TypeMsgStableSwapRampAmplification }
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateAmplification(msg.TargetAmplification)
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	scalingFactorController, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorController}
+}
@@ -2,6 +2,7 @@ package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -204,6 +205,22 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "max amplification",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams.Amplification = types.StableswapMaxAmplification
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "amplification above max",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams.Amplification = types.StableswapMaxAmplification + 1
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "multi assets pool",
 			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
@@ -297,6 +314,94 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStableSwapRampScalingFactorsValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapRampScalingFactors
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        stableswap.NewMsgStableSwapRampScalingFactors(addr1, 1, []uint64{1, 2}, time.Hour),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg:  stableswap.NewMsgStableSwapRampScalingFactors("", 1, []uint64{1, 2}, time.Hour),
+		},
+		{
+			name: "zero duration",
+			msg:  stableswap.NewMsgStableSwapRampScalingFactors(addr1, 1, []uint64{1, 2}, 0),
+		},
+		{
+			name: "zero target scaling factor",
+			msg:  stableswap.NewMsgStableSwapRampScalingFactors(addr1, 1, []uint64{1, 0}, time.Hour),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapSetRateProviderValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapSetRateProvider
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, contractAddr, osmomath.NewDecWithPrec(1, 3)),
+			expectPass: true,
+		},
+		{
+			name:       "remove rate provider",
+			msg:        stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, "", osmomath.Dec{}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg:  stableswap.NewMsgStableSwapSetRateProvider("", 1, contractAddr, osmomath.NewDecWithPrec(1, 3)),
+		},
+		{
+			name: "invalid contract address",
+			msg:  stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, "osmo1invalid", osmomath.NewDecWithPrec(1, 3)),
+		},
+		{
+			name: "nil max change per block",
+			msg:  stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, contractAddr, osmomath.Dec{}),
+		},
+		{
+			name: "zero max change per block",
+			msg:  stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, contractAddr, osmomath.ZeroDec()),
+		},
+		{
+			name: "max change per block of one",
+			msg:  stableswap.NewMsgStableSwapSetRateProvider(addr1, 1, contractAddr, osmomath.OneDec()),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func (suite *TestSuite) TestMsgCreateStableswapPool() {
 	suite.SetupTest()
 
@@ -357,3 +462,41 @@ func (suite *TestSuite) TestMsgCreateStableswapPool() {
 		})
 	}
 }
+
+func TestMsgStableSwapRampAmplificationValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	endTime := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapRampAmplification
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        stableswap.NewMsgStableSwapRampAmplification(addr1, 1, 100, endTime),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg:  stableswap.NewMsgStableSwapRampAmplification("", 1, 100, endTime),
+		},
+		{
+			name: "zero target amplification",
+			msg:  stableswap.NewMsgStableSwapRampAmplification(addr1, 1, 0, endTime),
+		},
+		{
+			name: "target amplification above max",
+			msg:  stableswap.NewMsgStableSwapRampAmplification(addr1, 1, types.StableswapMaxAmplification+1, endTime),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,6 +19,7 @@ import (
 var (
 	_ poolmanagertypes.PoolI = &Pool{}
 	_ types.CFMMPoolI        = &Pool{}
+	_ types.PokablePool      = &Pool{}
 )
 
 // NewStableswapPool returns a stableswap pool
@@ -52,6 +54,14 @@ func NewStableswapPool(poolId uint64,
 		return Pool{}, err
 	}
 
+	if stableswapPoolParams.Amplification == 0 {
+		stableswapPoolParams.Amplification = 1
+	}
+
+	if err = validateAmplification(stableswapPoolParams.Amplification); err != nil {
+		return Pool{}, err
+	}
+
 	pool := Pool{
 		Address:                 poolmanagertypes.NewPoolAddress(poolId).String(),
 		Id:                      poolId,
@@ -111,6 +121,15 @@ func (p Pool) GetScalingFactors() []uint64 {
 	return p.ScalingFactors
 }
 
+// GetAmplification returns the amplification coefficient of the pool.
+// Pools created before the amplification was introduced are unamplified.
+func (p Pool) GetAmplification() uint64 {
+	if p.PoolParams.Amplification == 0 {
+		return 1
+	}
+	return p.PoolParams.Amplification
+}
+
 func (p Pool) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Stableswap
 }
@@ -398,11 +417,17 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares osmomat
 // SetScalingFactors sets scaling factors for pool to the given amount
 // It should only be able to be successfully called by the pool's ScalingFactorGovernor
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
+// It errors if the scaling factors of the pool are pulled from a rate provider, and cancels any ongoing ramp
+// of the scaling factors.
 func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sender string) error {
 	if sender != p.ScalingFactorController {
 		return types.ErrNotScalingFactorGovernor
 	}
 
+	if p.RateProvider != nil {
+		return types.ErrRateProviderSet
+	}
+
 	scalingFactors, err := applyScalingFactorMultiplier(scalingFactors)
 	if err != nil {
 		return err
@@ -417,6 +442,169 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 	}
 
 	p.ScalingFactors = scalingFactors
+	p.ScalingFactorRampParams = nil
+	return nil
+}
+
+// RampScalingFactors starts linearly ramping the scaling factors of the pool from their current values
+// to the given target scaling factors over the given duration, starting at blockTime. Any ongoing ramp
+// is replaced, so the pool is expected to have been poked at blockTime beforehand.
+// It should only be able to be successfully called by the pool's ScalingFactorController, and errors
+// if the scaling factors of the pool are pulled from a rate provider.
+func (p *Pool) RampScalingFactors(blockTime time.Time, targetScalingFactors []uint64, duration time.Duration, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if p.RateProvider != nil {
+		return types.ErrRateProviderSet
+	}
+
+	if duration <= 0 {
+		return types.ErrInvalidRampDuration
+	}
+
+	targetScalingFactors, err := applyScalingFactorMultiplier(targetScalingFactors)
+	if err != nil {
+		return err
+	}
+
+	if err = validateScalingFactors(targetScalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+
+	if err = validatePoolLiquidity(p.PoolLiquidity, targetScalingFactors); err != nil {
+		return err
+	}
+
+	initialScalingFactors := make([]uint64, len(p.ScalingFactors))
+	copy(initialScalingFactors, p.ScalingFactors)
+
+	p.ScalingFactorRampParams = &ScalingFactorRampParams{
+		StartTime:             blockTime,
+		Duration:              duration,
+		InitialScalingFactors: initialScalingFactors,
+		TargetScalingFactors:  targetScalingFactors,
+	}
+	return nil
+}
+
+// RampAmplification starts linearly ramping the amplification of the pool from its current value
+// to the given target amplification, starting at blockTime and ending at endTime. Any ongoing ramp
+// is replaced, so the pool is expected to have been poked at blockTime beforehand.
+// Authorization of the ramp is left to the caller.
+// It errors if the target amplification is out of bounds, differs from the current amplification by
+// more than StableswapMaxAmplificationChangeFactor, or the ramp is shorter than
+// StableswapMinAmplificationRampDuration.
+func (p *Pool) RampAmplification(blockTime time.Time, targetAmplification uint64, endTime time.Time) error {
+	if err := validateAmplification(targetAmplification); err != nil {
+		return err
+	}
+
+	if endTime.Sub(blockTime) < types.StableswapMinAmplificationRampDuration {
+		return types.ErrAmplificationRampTooShort
+	}
+
+	initialAmplification := p.GetAmplification()
+	// both amplifications are at most StableswapMaxAmplification, so the products cannot overflow
+	if targetAmplification > initialAmplification*types.StableswapMaxAmplificationChangeFactor ||
+		initialAmplification > targetAmplification*types.StableswapMaxAmplificationChangeFactor {
+		return types.ErrAmplificationChangeTooLarge
+	}
+
+	p.PoolParams.Amplification = initialAmplification
+	p.AmplificationRampParams = &AmplificationRampParams{
+		StartTime:            blockTime,
+		EndTime:              endTime,
+		InitialAmplification: initialAmplification,
+		TargetAmplification:  targetAmplification,
+	}
+	return nil
+}
+
+// PokePool checks to see if the pool's scaling factors or amplification are being
+// ramped, and if so, updates them to their values at blockTime.
+func (p *Pool) PokePool(blockTime time.Time) {
+	p.pokeScalingFactors(blockTime)
+	p.pokeAmplification(blockTime)
+}
+
+// pokeScalingFactors updates the scaling factors of the pool to their values at blockTime
+// if they are being ramped.
+func (p *Pool) pokeScalingFactors(blockTime time.Time) {
+	if p.ScalingFactorRampParams == nil {
+		return
+	}
+
+	params := *p.ScalingFactorRampParams
+
+	switch {
+	case !blockTime.After(params.StartTime):
+		// t <= start_time: s(t) = initial_scaling_factors
+		return
+
+	case !blockTime.Before(params.StartTime.Add(params.Duration)):
+		// t >= start_time + duration: s(t) = target_scaling_factors
+		p.ScalingFactors = params.TargetScalingFactors
+
+		// the ramp is over, so reset the ramp params
+		p.ScalingFactorRampParams = nil
+
+	default:
+		// start_time < t < start_time + duration:
+		//     s(t) = initial_scaling_factors + (t - start_time) *
+		//       (target_scaling_factors - initial_scaling_factors) / (duration)
+		percentDurationElapsed := osmomath.NewDec(int64(blockTime.Sub(params.StartTime))).QuoInt64(int64(params.Duration))
+
+		scalingFactors := make([]uint64, len(params.TargetScalingFactors))
+		for i := range scalingFactors {
+			initial := osmomath.NewIntFromUint64(params.InitialScalingFactors[i]).ToLegacyDec()
+			target := osmomath.NewIntFromUint64(params.TargetScalingFactors[i]).ToLegacyDec()
+			// truncation keeps the scaling factor between its initial and target values, both of which are positive
+			scalingFactors[i] = initial.Add(target.Sub(initial).Mul(percentDurationElapsed)).TruncateInt().Uint64()
+		}
+		p.ScalingFactors = scalingFactors
+	}
+}
+
+// pokeAmplification updates the amplification of the pool to its value at blockTime
+// if it is being ramped.
+func (p *Pool) pokeAmplification(blockTime time.Time) {
+	if p.AmplificationRampParams == nil {
+		return
+	}
+
+	params := *p.AmplificationRampParams
+
+	switch {
+	case !blockTime.After(params.StartTime):
+		// t <= start_time: A(t) = initial_amplification
+		return
+
+	case !blockTime.Before(params.EndTime):
+		// t >= end_time: A(t) = target_amplification
+		p.PoolParams.Amplification = params.TargetAmplification
+
+		// the ramp is over, so reset the ramp params
+		p.AmplificationRampParams = nil
+
+	default:
+		// start_time < t < end_time:
+		//     A(t) = initial_amplification + (t - start_time) *
+		//       (target_amplification - initial_amplification) / (end_time - start_time)
+		percentDurationElapsed := osmomath.NewDec(int64(blockTime.Sub(params.StartTime))).QuoInt64(int64(params.EndTime.Sub(params.StartTime)))
+
+		initial := osmomath.NewIntFromUint64(params.InitialAmplification).ToLegacyDec()
+		target := osmomath.NewIntFromUint64(params.TargetAmplification).ToLegacyDec()
+		// truncation keeps the amplification between its initial and target values, both of which are at least 1
+		p.PoolParams.Amplification = initial.Add(target.Sub(initial).Mul(percentDurationElapsed)).TruncateInt().Uint64()
+	}
+}
+
+func validateAmplification(amplification uint64) error {
+	if amplification == 0 || amplification > types.StableswapMaxAmplification {
+		return types.ErrInvalidAmplification
+	}
 	return nil
 }
 
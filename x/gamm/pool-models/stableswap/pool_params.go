@@ -21,5 +21,10 @@ func (params PoolParams) Validate() error {
 	if params.SwapFee.GTE(osmomath.OneDec()) {
 		return types.ErrTooMuchSpreadFactor
 	}
+
+	// an amplification of 0 defaults to 1 on pool creation
+	if params.Amplification > types.StableswapMaxAmplification {
+		return types.ErrInvalidAmplification
+	}
 	return nil
 }
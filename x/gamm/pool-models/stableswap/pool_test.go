@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1142,6 +1143,7 @@ func TestSetScalingFactors(t *testing.T) {
 		scalingFactors []uint64
 		sender         string
 		poolAssets     sdk.Coins
+		rateProvider   *RateProvider
 		expError       error
 	}{
 		"Sender is not scaling factor governor in pool": {
@@ -1150,6 +1152,13 @@ func TestSetScalingFactors(t *testing.T) {
 			poolAssets:     twoEvenStablePoolAssets,
 			expError:       types.ErrNotScalingFactorGovernor,
 		},
+		"Scaling factors are pulled from a rate provider": {
+			scalingFactors: defaultTwoAssetScalingFactors,
+			sender:         addr.String(),
+			poolAssets:     twoEvenStablePoolAssets,
+			rateProvider:   &RateProvider{ContractAddress: failAddr.String(), MaxChangePerBlock: osmomath.NewDecWithPrec(1, 2)},
+			expError:       types.ErrRateProviderSet,
+		},
 
 		"Invalid scaling factor's length": {
 			scalingFactors: defaultTwoAssetScalingFactors,
@@ -1189,17 +1198,409 @@ func TestSetScalingFactors(t *testing.T) {
 			ctx := sdk.Context{}
 			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 			pool.ScalingFactorController = addr.String()
+			pool.RateProvider = tc.rateProvider
+			pool.ScalingFactorRampParams = &ScalingFactorRampParams{}
 			err := pool.SetScalingFactors(ctx, tc.scalingFactors, tc.sender)
 			if tc.expError != nil {
 				require.Error(t, err)
 				require.Equal(t, err, tc.expError)
 			} else {
 				require.NoError(t, err)
+				// an abrupt change of the scaling factors cancels the ongoing ramp
+				require.Nil(t, pool.ScalingFactorRampParams)
+			}
+		})
+	}
+}
+
+func TestRampScalingFactors(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	failAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		targetScalingFactors []uint64
+		duration             time.Duration
+		sender               string
+		rateProvider         *RateProvider
+		expError             error
+	}{
+		"valid ramp": {
+			targetScalingFactors: []uint64{2000, 500},
+			duration:             time.Hour,
+			sender:               addr.String(),
+		},
+		"sender is not scaling factor controller": {
+			targetScalingFactors: []uint64{2000, 500},
+			duration:             time.Hour,
+			sender:               failAddr.String(),
+			expError:             types.ErrNotScalingFactorGovernor,
+		},
+		"scaling factors are pulled from a rate provider": {
+			targetScalingFactors: []uint64{2000, 500},
+			duration:             time.Hour,
+			sender:               addr.String(),
+			rateProvider:         &RateProvider{ContractAddress: failAddr.String(), MaxChangePerBlock: osmomath.NewDecWithPrec(1, 2)},
+			expError:             types.ErrRateProviderSet,
+		},
+		"zero duration": {
+			targetScalingFactors: []uint64{2000, 500},
+			sender:               addr.String(),
+			expError:             types.ErrInvalidRampDuration,
+		},
+		"invalid target scaling factors length": {
+			targetScalingFactors: []uint64{2000, 500, 1000},
+			duration:             time.Hour,
+			sender:               addr.String(),
+			expError:             types.ErrInvalidScalingFactorLength,
+		},
+		"target scaling factors exceed pool liquidity": {
+			targetScalingFactors: []uint64{2000000000, 500},
+			duration:             time.Hour,
+			sender:               addr.String(),
+			expError:             types.ErrHitMinScaledAssets,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{1000, 1000})
+			pool.ScalingFactorController = addr.String()
+			pool.RateProvider = tc.rateProvider
+
+			err := pool.RampScalingFactors(startTime, tc.targetScalingFactors, tc.duration, tc.sender)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Nil(t, pool.ScalingFactorRampParams)
+				return
 			}
+
+			require.NoError(t, err)
+			require.Equal(t, &ScalingFactorRampParams{
+				StartTime:             startTime,
+				Duration:              tc.duration,
+				InitialScalingFactors: []uint64{1000, 1000},
+				TargetScalingFactors:  tc.targetScalingFactors,
+			}, pool.ScalingFactorRampParams)
+			// the scaling factors only change once the pool is poked after the start time
+			require.Equal(t, []uint64{1000, 1000}, pool.ScalingFactors)
 		})
 	}
 }
 
+func TestPokePool(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	rampParams := ScalingFactorRampParams{
+		StartTime:             startTime,
+		Duration:              time.Hour,
+		InitialScalingFactors: []uint64{1000, 1000},
+		TargetScalingFactors:  []uint64{2000, 500},
+	}
+
+	tests := map[string]struct {
+		blockTime              time.Time
+		expScalingFactors      []uint64
+		expRampParamsUnchanged bool
+	}{
+		"before start time": {
+			blockTime:              startTime.Add(-time.Minute),
+			expScalingFactors:      []uint64{1000, 1000},
+			expRampParamsUnchanged: true,
+		},
+		"at start time": {
+			blockTime:              startTime,
+			expScalingFactors:      []uint64{1000, 1000},
+			expRampParamsUnchanged: true,
+		},
+		"quarter of the duration elapsed": {
+			blockTime:              startTime.Add(15 * time.Minute),
+			expScalingFactors:      []uint64{1250, 875},
+			expRampParamsUnchanged: true,
+		},
+		"a third of the duration elapsed, truncated towards initial scaling factors": {
+			blockTime:              startTime.Add(20 * time.Minute),
+			expScalingFactors:      []uint64{1333, 833},
+			expRampParamsUnchanged: true,
+		},
+		"at end time": {
+			blockTime:         startTime.Add(time.Hour),
+			expScalingFactors: []uint64{2000, 500},
+		},
+		"after end time": {
+			blockTime:         startTime.Add(2 * time.Hour),
+			expScalingFactors: []uint64{2000, 500},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{1000, 1000})
+			params := rampParams
+			pool.ScalingFactorRampParams = &params
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expScalingFactors, pool.ScalingFactors)
+			if tc.expRampParamsUnchanged {
+				require.Equal(t, &rampParams, pool.ScalingFactorRampParams)
+			} else {
+				require.Nil(t, pool.ScalingFactorRampParams)
+			}
+		})
+	}
+}
+
+func TestUpdateScalingFactorsFromRateProvider(t *testing.T) {
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	rateProvider := &RateProvider{ContractAddress: contractAddr.String(), MaxChangePerBlock: osmomath.NewDecWithPrec(1, 2)}
+
+	tests := map[string]struct {
+		rateProvider      *RateProvider
+		scalingFactors    []uint64
+		expScalingFactors []uint64
+		expError          bool
+	}{
+		"within max change per block": {
+			rateProvider:      rateProvider,
+			scalingFactors:    []uint64{1005000, 995000},
+			expScalingFactors: []uint64{1005000, 995000},
+		},
+		"clamped to max change per block": {
+			rateProvider:      rateProvider,
+			scalingFactors:    []uint64{1100000, 900000},
+			expScalingFactors: []uint64{1010000, 990000},
+		},
+		"no rate provider": {
+			scalingFactors: []uint64{1005000, 995000},
+			expError:       true,
+		},
+		"invalid scaling factors length": {
+			rateProvider:   rateProvider,
+			scalingFactors: []uint64{1005000},
+			expError:       true,
+		},
+		"zero scaling factor": {
+			rateProvider:   rateProvider,
+			scalingFactors: []uint64{1005000, 0},
+			expError:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{1000000, 1000000})
+			pool.RateProvider = tc.rateProvider
+
+			err := pool.UpdateScalingFactorsFromRateProvider(tc.scalingFactors)
+			if tc.expError {
+				require.Error(t, err)
+				require.Equal(t, []uint64{1000000, 1000000}, pool.ScalingFactors)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expScalingFactors, pool.ScalingFactors)
+		})
+	}
+}
+
+func TestRampAmplification(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	endTime := startTime.Add(types.StableswapMinAmplificationRampDuration)
+
+	tests := map[string]struct {
+		amplification       uint64
+		targetAmplification uint64
+		endTime             time.Time
+		expInitial          uint64
+		expError            error
+	}{
+		"valid ramp up": {
+			amplification:       10,
+			targetAmplification: 100,
+			endTime:             endTime,
+			expInitial:          10,
+		},
+		"valid ramp down": {
+			amplification:       100,
+			targetAmplification: 10,
+			endTime:             endTime,
+			expInitial:          100,
+		},
+		"unset amplification ramps from 1": {
+			targetAmplification: 10,
+			endTime:             endTime,
+			expInitial:          1,
+		},
+		"zero target amplification": {
+			amplification: 10,
+			endTime:       endTime,
+			expError:      types.ErrInvalidAmplification,
+		},
+		"target amplification above max": {
+			amplification:       types.StableswapMaxAmplification,
+			targetAmplification: types.StableswapMaxAmplification + 1,
+			endTime:             endTime,
+			expError:            types.ErrInvalidAmplification,
+		},
+		"ramp up exceeds max change factor": {
+			amplification:       10,
+			targetAmplification: 101,
+			endTime:             endTime,
+			expError:            types.ErrAmplificationChangeTooLarge,
+		},
+		"ramp down exceeds max change factor": {
+			amplification:       101,
+			targetAmplification: 10,
+			endTime:             endTime,
+			expError:            types.ErrAmplificationChangeTooLarge,
+		},
+		"ramp shorter than min duration": {
+			amplification:       10,
+			targetAmplification: 100,
+			endTime:             endTime.Add(-time.Second),
+			expError:            types.ErrAmplificationRampTooShort,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.PoolParams.Amplification = tc.amplification
+
+			err := pool.RampAmplification(startTime, tc.targetAmplification, tc.endTime)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Nil(t, pool.AmplificationRampParams)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &AmplificationRampParams{
+				StartTime:            startTime,
+				EndTime:              tc.endTime,
+				InitialAmplification: tc.expInitial,
+				TargetAmplification:  tc.targetAmplification,
+			}, pool.AmplificationRampParams)
+			// the amplification only changes once the pool is poked after the start time
+			require.Equal(t, tc.expInitial, pool.GetAmplification())
+		})
+	}
+}
+
+func TestPokePoolAmplification(t *testing.T) {
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	rampParams := AmplificationRampParams{
+		StartTime:            startTime,
+		EndTime:              startTime.Add(10 * time.Hour),
+		InitialAmplification: 10,
+		TargetAmplification:  100,
+	}
+
+	tests := map[string]struct {
+		blockTime              time.Time
+		expAmplification       uint64
+		expRampParamsUnchanged bool
+	}{
+		"before start time": {
+			blockTime:              startTime.Add(-time.Minute),
+			expAmplification:       10,
+			expRampParamsUnchanged: true,
+		},
+		"half of the duration elapsed": {
+			blockTime:              startTime.Add(5 * time.Hour),
+			expAmplification:       55,
+			expRampParamsUnchanged: true,
+		},
+		"a quarter of the duration elapsed, truncated towards initial amplification": {
+			blockTime:              startTime.Add(150 * time.Minute),
+			expAmplification:       32,
+			expRampParamsUnchanged: true,
+		},
+		"at end time": {
+			blockTime:        startTime.Add(10 * time.Hour),
+			expAmplification: 100,
+		},
+		"after end time": {
+			blockTime:        startTime.Add(20 * time.Hour),
+			expAmplification: 100,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.PoolParams.Amplification = 10
+			params := rampParams
+			pool.AmplificationRampParams = &params
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expAmplification, pool.GetAmplification())
+			if tc.expRampParamsUnchanged {
+				require.Equal(t, &rampParams, pool.AmplificationRampParams)
+			} else {
+				require.Nil(t, pool.AmplificationRampParams)
+			}
+		})
+	}
+}
+
+func TestAmplifiedSwaps(t *testing.T) {
+	ctx := sdk.Context{}
+	amplifiedPool := func(assets sdk.Coins, amplification uint64) Pool {
+		p := poolStructFromAssets(assets, defaultTwoAssetScalingFactors)
+		p.PoolParams.Amplification = amplification
+		return p
+	}
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000_000))
+
+	t.Run("amplification of 1 reproduces the unamplified curve", func(t *testing.T) {
+		unamplified, err := amplifiedPool(twoUnevenStablePoolAssets, 0).CalcOutAmtGivenIn(ctx, tokenIn, "bar", osmomath.ZeroDec())
+		require.NoError(t, err)
+		amplified, err := amplifiedPool(twoUnevenStablePoolAssets, 1).CalcOutAmtGivenIn(ctx, tokenIn, "bar", osmomath.ZeroDec())
+		require.NoError(t, err)
+		require.Equal(t, unamplified, amplified)
+	})
+
+	t.Run("higher amplification lowers the price impact", func(t *testing.T) {
+		previousOut := osmomath.ZeroInt()
+		previousIn := osmomath.NewInt(1_000_000_000)
+		for _, amplification := range []uint64{1, 10, 100} {
+			pool := amplifiedPool(twoEvenStablePoolAssets, amplification)
+			out, err := pool.CalcOutAmtGivenIn(ctx, tokenIn, "bar", osmomath.ZeroDec())
+			require.NoError(t, err)
+			require.True(t, out.Amount.GT(previousOut), "amplification %d: out %s not greater than %s", amplification, out.Amount, previousOut)
+			require.True(t, out.Amount.LTE(tokenIn[0].Amount))
+			previousOut = out.Amount
+
+			in, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000_000)), "foo", osmomath.ZeroDec())
+			require.NoError(t, err)
+			require.True(t, in.Amount.LT(previousIn), "amplification %d: in %s not less than %s", amplification, in.Amount, previousIn)
+			require.True(t, in.Amount.GTE(tokenIn[0].Amount))
+			previousIn = in.Amount
+		}
+	})
+
+	t.Run("higher amplification moves the spot price of an imbalanced pool towards 1", func(t *testing.T) {
+		unamplified, err := amplifiedPool(twoUnevenStablePoolAssets, 1).SpotPrice(ctx, "bar", "foo")
+		require.NoError(t, err)
+		amplified, err := amplifiedPool(twoUnevenStablePoolAssets, 100).SpotPrice(ctx, "bar", "foo")
+		require.NoError(t, err)
+		require.True(t, amplified.Sub(osmomath.OneBigDec()).Abs().LT(unamplified.Sub(osmomath.OneBigDec()).Abs()))
+	})
+
+	t.Run("out given in exceeds pool reserves", func(t *testing.T) {
+		_, err := amplifiedPool(twoEvenStablePoolAssets, 1000).CalcOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_500_000_000)), "bar", osmomath.ZeroDec())
+		require.ErrorIs(t, err, types.ErrSwapExceedsPoolReserves)
+	})
+
+	t.Run("in given out exceeds pool reserves", func(t *testing.T) {
+		_, err := amplifiedPool(twoEvenStablePoolAssets, 1000).CalcInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000_000)), "foo", osmomath.ZeroDec())
+		require.ErrorIs(t, err, types.ErrSwapExceedsPoolReserves)
+	})
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
@@ -0,0 +1,103 @@
+package stableswap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+)
+
+// GetScalingFactorsQueryMsg is the query sent to the rate provider of a pool
+// to pull the scaling factors of the pool.
+//
+// e.g. {"get_scaling_factors":{"pool_id":1}}
+type GetScalingFactorsQueryMsg struct {
+	GetScalingFactors GetScalingFactors `json:"get_scaling_factors"`
+}
+
+type GetScalingFactors struct {
+	PoolId uint64 `json:"pool_id"`
+}
+
+// GetScalingFactorsQueryMsgResponse is the response of the rate provider of a pool
+// to GetScalingFactorsQueryMsg. The scaling factors are in the same order as the
+// pool liquidity and use the same precision as MsgStableSwapAdjustScalingFactors.
+//
+// e.g. {"scaling_factors":["1000000","1021000"]}
+type GetScalingFactorsQueryMsgResponse struct {
+	ScalingFactors []osmomath.Int `json:"scaling_factors"`
+}
+
+// Validate returns an error if the contract address of the rate provider is invalid
+// or its max change per block is not in (0, 1).
+func (r RateProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return err
+	}
+
+	if r.MaxChangePerBlock.IsNil() || !r.MaxChangePerBlock.IsPositive() || r.MaxChangePerBlock.GTE(osmomath.OneDec()) {
+		return types.ErrInvalidMaxChangePerBlock
+	}
+
+	return nil
+}
+
+// SetRateProvider sets the rate provider the scaling factors of the pool are pulled from, cancelling
+// any ongoing ramp of the scaling factors. A nil rate provider removes the rate provider of the pool,
+// leaving its scaling factors as they are.
+// The sender is authorized by the keeper, since setting a rate provider is restricted to governance.
+func (p *Pool) SetRateProvider(rateProvider *RateProvider) error {
+	if rateProvider != nil {
+		if err := rateProvider.Validate(); err != nil {
+			return err
+		}
+		p.ScalingFactorRampParams = nil
+	}
+
+	p.RateProvider = rateProvider
+	return nil
+}
+
+// UpdateScalingFactorsFromRateProvider sets the scaling factors of the pool to the given scaling factors
+// pulled from its rate provider. Each scaling factor is clamped so that it changes by at most the max
+// change per block of the rate provider relative to its current value.
+// Errors if the pool has no rate provider or the resulting scaling factors are invalid for the pool liquidity.
+func (p *Pool) UpdateScalingFactorsFromRateProvider(scalingFactors []uint64) error {
+	if p.RateProvider == nil {
+		return fmt.Errorf("pool %d has no rate provider", p.Id)
+	}
+
+	scalingFactors, err := applyScalingFactorMultiplier(scalingFactors)
+	if err != nil {
+		return err
+	}
+
+	if err = validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+
+	clampedScalingFactors := make([]uint64, len(scalingFactors))
+	for i, scalingFactor := range scalingFactors {
+		current := osmomath.NewIntFromUint64(p.ScalingFactors[i])
+		// max change is strictly less than the current scaling factor, so the lower bound stays positive
+		maxChange := current.ToLegacyDec().Mul(p.RateProvider.MaxChangePerBlock).TruncateInt()
+
+		clamped := osmomath.NewIntFromUint64(scalingFactor)
+		clamped = osmomath.MaxInt(clamped, current.Sub(maxChange))
+		clamped = osmomath.MinInt(clamped, current.Add(maxChange))
+		clampedScalingFactors[i] = clamped.Uint64()
+	}
+
+	if err = validateScalingFactors(clampedScalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+
+	if err = validatePoolLiquidity(p.PoolLiquidity, clampedScalingFactors); err != nil {
+		return err
+	}
+
+	p.ScalingFactors = clampedScalingFactors
+	return nil
+}
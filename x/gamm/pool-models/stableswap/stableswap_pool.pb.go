@@ -13,17 +13,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +44,11 @@ type PoolParams struct {
 	// pools can maintain a non-zero fee. No new pool can be created with non-zero
 	// fee anymore
 	ExitFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"exit_fee" yaml:"exit_fee"`
+	// amplification is the amplification coefficient (A) of the pool. Swaps are
+	// priced as if every scaled reserve of the pool was deepened by (A - 1) times
+	// the mean scaled reserve, flattening the curve around the balanced point.
+	// A = 1 is the unamplified CFMM. Pools are created with A = 1 if unset.
+	Amplification uint64 `protobuf:"varint,3,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -76,6 +84,13 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -98,6 +113,15 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_ramp_params is set while the scaling factors of the pool
+	// are being ramped towards new values.
+	ScalingFactorRampParams *ScalingFactorRampParams `protobuf:"bytes,9,opt,name=scaling_factor_ramp_params,json=scalingFactorRampParams,proto3" json:"scaling_factor_ramp_params,omitempty" yaml:"scaling_factor_ramp_params"`
+	// rate_provider is set if the scaling factors of the pool are pulled from a
+	// CosmWasm contract at the beginning of every block.
+	RateProvider *RateProvider `protobuf:"bytes,10,opt,name=rate_provider,json=rateProvider,proto3" json:"rate_provider,omitempty" yaml:"rate_provider"`
+	// amplification_ramp_params is set while the amplification of the pool is
+	// being ramped towards a new value.
+	AmplificationRampParams *AmplificationRampParams `protobuf:"bytes,11,opt,name=amplification_ramp_params,json=amplificationRampParams,proto3" json:"amplification_ramp_params,omitempty" yaml:"amplification_ramp_params"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -132,9 +156,226 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ScalingFactorRampParams defines the parameters for linearly changing the
+// scaling factors of a pool over time.
+//
+// The scaling factors s(t) of the pool at time `t` are:
+//
+// 1. t <= start_time: s(t) = initial_scaling_factors
+//
+//  2. start_time < t < start_time + duration:
+//     s(t) = initial_scaling_factors + (t - start_time) *
+//     (target_scaling_factors - initial_scaling_factors) / (duration)
+//
+// 3. t >= start_time + duration: s(t) = target_scaling_factors
+type ScalingFactorRampParams struct {
+	StartTime time.Time     `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// initial_scaling_factors are the scaling factors of the pool when the ramp
+	// started.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// target_scaling_factors are the scaling factors of the pool once the ramp
+	// ends.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorRampParams) Reset()         { *m = ScalingFactorRampParams{} }
+func (m *ScalingFactorRampParams) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRampParams) ProtoMessage()    {}
+func (*ScalingFactorRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99ab4400f54fe92, []int{2}
+}
+func (m *ScalingFactorRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRampParams.Merge(m, src)
+}
+func (m *ScalingFactorRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRampParams proto.InternalMessageInfo
+
+func (m *ScalingFactorRampParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRampParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorRampParams) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRampParams) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+// AmplificationRampParams defines the parameters for linearly changing the
+// amplification of a pool over time.
+//
+// The amplification A(t) of the pool at time `t` is:
+//
+// 1. t <= start_time: A(t) = initial_amplification
+//
+//  2. start_time < t < end_time:
+//     A(t) = initial_amplification + (t - start_time) *
+//     (target_amplification - initial_amplification) / (end_time - start_time)
+//
+// 3. t >= end_time: A(t) = target_amplification
+type AmplificationRampParams struct {
+	StartTime            time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime              time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	InitialAmplification uint64    `protobuf:"varint,3,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	TargetAmplification  uint64    `protobuf:"varint,4,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+}
+
+func (m *AmplificationRampParams) Reset()         { *m = AmplificationRampParams{} }
+func (m *AmplificationRampParams) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRampParams) ProtoMessage()    {}
+func (*AmplificationRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99ab4400f54fe92, []int{3}
+}
+func (m *AmplificationRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRampParams.Merge(m, src)
+}
+func (m *AmplificationRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRampParams proto.InternalMessageInfo
+
+func (m *AmplificationRampParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRampParams) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRampParams) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRampParams) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+// RateProvider is a CosmWasm contract that the scaling factors of a pool are
+// pulled from, e.g. the redemption rate of a liquid staking token.
+type RateProvider struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// max_change_per_block is the maximum relative change of each scaling factor
+	// of the pool in a single block, e.g. 0.001 for 0.1%. Scaling factors
+	// returned by the contract beyond it are clamped.
+	MaxChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_change_per_block,json=maxChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_per_block" yaml:"max_change_per_block"`
+}
+
+func (m *RateProvider) Reset()         { *m = RateProvider{} }
+func (m *RateProvider) String() string { return proto.CompactTextString(m) }
+func (*RateProvider) ProtoMessage()    {}
+func (*RateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99ab4400f54fe92, []int{4}
+}
+func (m *RateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateProvider.Merge(m, src)
+}
+func (m *RateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateProvider proto.InternalMessageInfo
+
+func (m *RateProvider) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*ScalingFactorRampParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRampParams")
+	proto.RegisterType((*AmplificationRampParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.AmplificationRampParams")
+	proto.RegisterType((*RateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.RateProvider")
 }
 
 func init() {
@@ -142,50 +383,78 @@ func init() {
 }
 
 var fileDescriptor_b99ab4400f54fe92 = []byte{
-	// 684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbb, 0x6f, 0xd3, 0x40,
-	0x18, 0xc0, 0xe3, 0x36, 0x6d, 0x8a, 0x0b, 0xa9, 0x30, 0x95, 0x70, 0x1b, 0x61, 0xa7, 0x16, 0x45,
-	0x51, 0x45, 0x7c, 0xb4, 0x48, 0x1d, 0x3a, 0x41, 0x8a, 0x8a, 0x90, 0x2a, 0x54, 0xdc, 0x09, 0x18,
-	0xc2, 0xd9, 0xbe, 0x38, 0xa7, 0xda, 0x39, 0xe3, 0x3b, 0x97, 0x66, 0x61, 0x46, 0x4c, 0x8c, 0x8c,
-	0x9d, 0x99, 0x18, 0xf8, 0x23, 0x2a, 0x58, 0x3a, 0xa2, 0x0e, 0x06, 0xb5, 0x03, 0x7b, 0xfe, 0x02,
-	0x74, 0xe7, 0xcb, 0xab, 0x85, 0x0a, 0xb1, 0x24, 0xf7, 0xbd, 0x7e, 0xdf, 0xe3, 0x3e, 0x9f, 0xfa,
-	0x80, 0xd0, 0x88, 0x50, 0x4c, 0x41, 0x00, 0xa3, 0x08, 0xc4, 0x84, 0x84, 0x11, 0xf1, 0x51, 0x48,
-	0x01, 0x65, 0xd0, 0x0d, 0x11, 0x7d, 0x03, 0x63, 0xb0, 0xbf, 0xea, 0x22, 0x06, 0x57, 0x47, 0x54,
-	0x4d, 0xee, 0x68, 0xc7, 0x09, 0x61, 0x44, 0x5b, 0x91, 0x04, 0x9b, 0x13, 0xec, 0x21, 0xc1, 0x1e,
-	0xba, 0xdb, 0x92, 0xb0, 0xb8, 0xe0, 0x09, 0xe7, 0xa6, 0x88, 0x04, 0xb9, 0x90, 0x63, 0x16, 0xe7,
-	0x03, 0x12, 0x90, 0x5c, 0xcf, 0x4f, 0x52, 0x7b, 0x1d, 0x46, 0xb8, 0x43, 0x80, 0xf8, 0x95, 0x2a,
-	0x23, 0x20, 0x24, 0x08, 0x11, 0x10, 0x92, 0x9b, 0xb6, 0x80, 0x9f, 0x26, 0x90, 0x61, 0xd2, 0x91,
-	0x76, 0xf3, 0xbc, 0x9d, 0xe1, 0x08, 0x51, 0x06, 0xa3, 0xb8, 0x0f, 0xc8, 0xf3, 0x02, 0x98, 0xb2,
-	0xf6, 0xa0, 0x37, 0x2e, 0x9c, 0xb3, 0xbb, 0x90, 0xa2, 0x81, 0xdd, 0x23, 0x58, 0x26, 0xb0, 0x4e,
-	0x14, 0x55, 0xdd, 0x21, 0x24, 0xdc, 0x81, 0x09, 0x8c, 0xa8, 0xf6, 0x4c, 0x9d, 0x11, 0x23, 0x69,
-	0x21, 0xa4, 0x2b, 0x55, 0xa5, 0x76, 0xa5, 0xb1, 0x7e, 0x94, 0x99, 0x85, 0x93, 0xcc, 0xac, 0xe4,
-	0x20, 0xea, 0xef, 0xd9, 0x98, 0x80, 0x08, 0xb2, 0xb6, 0xbd, 0x8d, 0x02, 0xe8, 0x75, 0x1f, 0x21,
-	0xaf, 0x97, 0x99, 0x73, 0x5d, 0x18, 0x85, 0x1b, 0x56, 0x3f, 0xd8, 0x72, 0x4a, 0xfc, 0xb8, 0x85,
-	0x10, 0x47, 0xa2, 0x03, 0xcc, 0x04, 0x72, 0xe2, 0x3f, 0x90, 0xfd, 0x60, 0xcb, 0x29, 0xf1, 0xe3,
-	0x16, 0x42, 0x1b, 0x77, 0xde, 0xff, 0xfa, 0xbc, 0xb2, 0x34, 0x76, 0xd9, 0xbb, 0x83, 0xfb, 0x19,
-	0x76, 0x63, 0x7d, 0x9b, 0x52, 0x8b, 0x5c, 0xd4, 0xee, 0xaa, 0x25, 0xe8, 0xfb, 0x09, 0xa2, 0x54,
-	0x76, 0xa5, 0xf5, 0x32, 0xb3, 0x9c, 0xf3, 0xa5, 0xc1, 0x72, 0xfa, 0x2e, 0x5a, 0x59, 0x9d, 0xc0,
-	0xbe, 0xa8, 0xb5, 0xe8, 0x4c, 0x60, 0x5f, 0x7b, 0xab, 0xce, 0xf2, 0x4d, 0x68, 0xc6, 0x82, 0xaa,
-	0x4f, 0x56, 0x95, 0xda, 0xec, 0xda, 0xba, 0xfd, 0xef, 0xab, 0x62, 0x0f, 0x6b, 0x6a, 0x2c, 0xf3,
-	0xe6, 0x7b, 0x99, 0x79, 0x4b, 0x0e, 0x6c, 0x7c, 0x0d, 0x65, 0x0e, 0xcb, 0x51, 0xe3, 0xd1, 0x4b,
-	0x99, 0x6f, 0xa5, 0x2c, 0x4d, 0x50, 0xee, 0x12, 0x90, 0x7d, 0x94, 0x74, 0x48, 0xa2, 0x17, 0x45,
-	0x2b, 0x66, 0x2f, 0x33, 0x2b, 0x39, 0xec, 0x4f, 0x5e, 0x96, 0xa3, 0xe5, 0x6a, 0x5e, 0xc3, 0x63,
-	0xa9, 0xd4, 0x9e, 0xab, 0x57, 0x19, 0x61, 0x30, 0x6c, 0xd2, 0x36, 0x4c, 0x10, 0xd5, 0xa7, 0x44,
-	0x4f, 0x0b, 0xb6, 0xdc, 0x62, 0xbe, 0x2d, 0x83, 0xe2, 0x37, 0x09, 0xee, 0x34, 0x2a, 0xb2, 0xec,
-	0x1b, 0x79, 0xa6, 0xd1, 0x60, 0xcb, 0x99, 0x15, 0xe2, 0xae, 0x90, 0xb4, 0x44, 0x2d, 0x8b, 0x02,
-	0x42, 0xfc, 0x3a, 0xc5, 0x3e, 0x66, 0x5d, 0x7d, 0xba, 0x3a, 0x79, 0x39, 0xfc, 0x1e, 0x87, 0x7f,
-	0xfa, 0x61, 0xd6, 0x02, 0xcc, 0xda, 0xa9, 0x6b, 0x7b, 0x24, 0x92, 0xdf, 0x93, 0xfc, 0xab, 0x53,
-	0x7f, 0x0f, 0xb0, 0x6e, 0x8c, 0xa8, 0x08, 0xa0, 0xce, 0x35, 0x9e, 0x62, 0xbb, 0x9f, 0x41, 0x7b,
-	0xaa, 0xce, 0x51, 0x0f, 0x86, 0xb8, 0x13, 0x34, 0x5b, 0xd0, 0x63, 0x24, 0xa1, 0x7a, 0xa9, 0x3a,
-	0x59, 0x2b, 0x36, 0x96, 0x7b, 0x99, 0xb9, 0x74, 0x61, 0xd2, 0xe7, 0x7c, 0x2d, 0xa7, 0x2c, 0x35,
-	0x5b, 0xb9, 0x42, 0x7b, 0xa5, 0x2e, 0x8c, 0xfb, 0x34, 0x3d, 0xd2, 0x61, 0x09, 0x09, 0x43, 0x94,
-	0xe8, 0x33, 0x62, 0xec, 0xb7, 0x7b, 0x99, 0x59, 0x95, 0xe4, 0xbf, 0xb9, 0x5a, 0xce, 0xcd, 0x31,
-	0xf0, 0xe6, 0xc0, 0xb2, 0xb1, 0xfa, 0xee, 0xd0, 0x2c, 0x7c, 0x3c, 0x34, 0x0b, 0x5f, 0xbf, 0xd4,
-	0xa7, 0xf8, 0xd5, 0x3c, 0xe1, 0x3b, 0x5d, 0xb9, 0x64, 0xa7, 0x1b, 0x2f, 0x8f, 0x4e, 0x0d, 0xe5,
-	0xf8, 0xd4, 0x50, 0x7e, 0x9e, 0x1a, 0xca, 0x87, 0x33, 0xa3, 0x70, 0x7c, 0x66, 0x14, 0xbe, 0x9f,
-	0x19, 0x85, 0x17, 0x0f, 0x47, 0xe6, 0x26, 0x09, 0xf5, 0x10, 0xba, 0xb4, 0x2f, 0x80, 0xfd, 0xb5,
-	0x75, 0x70, 0x30, 0x7c, 0x15, 0xeb, 0x17, 0x9e, 0x45, 0x77, 0x5a, 0x3c, 0x07, 0xf7, 0x7f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x6b, 0x1d, 0x1a, 0x72, 0x43, 0x05, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x1d, 0xb7, 0x49, 0x26, 0x5f, 0x64, 0x6b, 0x88, 0x93, 0xb4, 0x5e, 0x67, 0x44, 0x51,
+	0xa8, 0x9a, 0x5d, 0x12, 0xa4, 0x08, 0xe5, 0x80, 0x88, 0x53, 0x05, 0x21, 0x55, 0x28, 0x4c, 0x40,
+	0x40, 0x39, 0x2c, 0xe3, 0xdd, 0x89, 0x3d, 0xca, 0xae, 0x67, 0xd9, 0x19, 0x87, 0x44, 0x48, 0x9c,
+	0x11, 0xa7, 0x8a, 0x53, 0xc5, 0xa9, 0xe2, 0x06, 0x5c, 0x38, 0xf0, 0x47, 0x44, 0x70, 0xe9, 0x11,
+	0x71, 0xd8, 0xa2, 0xe4, 0x80, 0xc4, 0xd1, 0x7f, 0x01, 0x9a, 0xd9, 0x59, 0x7b, 0xd7, 0x71, 0x4a,
+	0x83, 0xd4, 0x4b, 0xe2, 0x79, 0x1f, 0xbf, 0xf7, 0xe6, 0x37, 0xef, 0x63, 0xc1, 0x3b, 0x8c, 0x07,
+	0x8c, 0x53, 0x6e, 0xb7, 0x70, 0x10, 0xd8, 0x21, 0x63, 0x7e, 0xc0, 0x3c, 0xe2, 0x73, 0x9b, 0x0b,
+	0xdc, 0xf4, 0x09, 0xff, 0x12, 0x87, 0xf6, 0xd1, 0x7a, 0x93, 0x08, 0xbc, 0x9e, 0x11, 0x39, 0xd2,
+	0xd0, 0x0a, 0x23, 0x26, 0x98, 0x71, 0x47, 0x23, 0x58, 0x12, 0xc1, 0x1a, 0x20, 0x58, 0x03, 0x73,
+	0x4b, 0x23, 0x2c, 0x2d, 0xba, 0xca, 0xd8, 0x51, 0x9e, 0x76, 0x72, 0x48, 0x60, 0x96, 0x2a, 0x2d,
+	0xd6, 0x62, 0x89, 0x5c, 0xfe, 0xd2, 0xd2, 0x79, 0x1c, 0xd0, 0x0e, 0xb3, 0xd5, 0x5f, 0x2d, 0xaa,
+	0xb5, 0x18, 0x6b, 0xf9, 0xc4, 0x56, 0xa7, 0x66, 0xf7, 0xc0, 0xf6, 0xba, 0x11, 0x16, 0x94, 0x75,
+	0xb4, 0xde, 0x1c, 0xd6, 0x0b, 0x1a, 0x10, 0x2e, 0x70, 0x10, 0xa6, 0x00, 0x49, 0x5c, 0x1b, 0x77,
+	0x45, 0xbb, 0x7f, 0x37, 0x79, 0x18, 0xd2, 0x37, 0x31, 0x27, 0x7d, 0xbd, 0xcb, 0xa8, 0x0e, 0x00,
+	0xbf, 0x2f, 0x01, 0xb0, 0xc7, 0x98, 0xbf, 0x87, 0x23, 0x1c, 0x70, 0xe3, 0x03, 0x30, 0xa1, 0x28,
+	0x39, 0x20, 0xa4, 0x5a, 0xac, 0x17, 0x57, 0x27, 0x1b, 0x9b, 0xa7, 0xb1, 0x59, 0xf8, 0x33, 0x36,
+	0x97, 0x13, 0x20, 0xee, 0x1d, 0x5a, 0x94, 0xd9, 0x01, 0x16, 0x6d, 0xeb, 0x3e, 0x69, 0x61, 0xf7,
+	0xe4, 0x1e, 0x71, 0x7b, 0xb1, 0x39, 0x77, 0x82, 0x03, 0x7f, 0x0b, 0xa6, 0xce, 0x10, 0x8d, 0xcb,
+	0x9f, 0xbb, 0x84, 0x48, 0x48, 0x72, 0x4c, 0x85, 0x82, 0x2c, 0xfd, 0x0f, 0xc8, 0xd4, 0x19, 0xa2,
+	0x71, 0xf9, 0x53, 0x42, 0xbe, 0x0d, 0x66, 0x70, 0x10, 0xfa, 0xf4, 0x80, 0xba, 0x8a, 0xac, 0xea,
+	0x58, 0xbd, 0xb8, 0x5a, 0x6e, 0x54, 0x7b, 0xb1, 0x59, 0x49, 0x9c, 0x72, 0x6a, 0x88, 0xf2, 0xe6,
+	0x5b, 0xaf, 0x7d, 0xfb, 0xf7, 0x2f, 0x77, 0x56, 0x72, 0xc5, 0xb2, 0xdf, 0x7f, 0xdf, 0x01, 0x1b,
+	0xf0, 0x87, 0x49, 0x50, 0x96, 0x47, 0xe3, 0x2e, 0x18, 0xc7, 0x9e, 0x17, 0x11, 0xce, 0x35, 0x2b,
+	0x46, 0x2f, 0x36, 0x67, 0x75, 0xa8, 0x44, 0x01, 0x51, 0x6a, 0x62, 0xcc, 0x82, 0x12, 0xf5, 0xd4,
+	0x5d, 0xcb, 0xa8, 0x44, 0x3d, 0xe3, 0x6b, 0x30, 0x25, 0x2b, 0xc9, 0x09, 0x15, 0xaa, 0x4a, 0x76,
+	0x6a, 0x63, 0xd3, 0x7a, 0xfe, 0x52, 0xb3, 0x06, 0x39, 0x35, 0x6e, 0x4b, 0xf2, 0x7a, 0xb1, 0x79,
+	0x4b, 0x13, 0x9e, 0x2f, 0x63, 0x1d, 0x03, 0x22, 0x10, 0x66, 0x1f, 0xb5, 0x72, 0xd0, 0x15, 0xdd,
+	0x88, 0x24, 0x26, 0x2d, 0x76, 0x44, 0xa2, 0x0e, 0x8b, 0xaa, 0x65, 0x75, 0x15, 0xb3, 0x17, 0x9b,
+	0xcb, 0x09, 0xd8, 0x28, 0x2b, 0x88, 0x8c, 0x44, 0x2c, 0x73, 0x78, 0x57, 0x0b, 0x8d, 0x4f, 0xc1,
+	0xb4, 0x60, 0x02, 0xfb, 0x0e, 0x6f, 0xe3, 0x88, 0xf0, 0xea, 0x35, 0x75, 0xa7, 0x45, 0x4b, 0x77,
+	0x81, 0xac, 0xb6, 0x7e, 0xf2, 0x3b, 0x8c, 0x76, 0x1a, 0xcb, 0x3a, 0xed, 0x1b, 0x49, 0xa4, 0xac,
+	0x33, 0x44, 0x53, 0xea, 0xb8, 0xaf, 0x4e, 0x46, 0x04, 0x66, 0x55, 0x02, 0x3e, 0xfd, 0xa2, 0x4b,
+	0x3d, 0x2a, 0x4e, 0xaa, 0xd7, 0xeb, 0x63, 0xcf, 0x06, 0x7f, 0x43, 0x82, 0xff, 0xf4, 0xd4, 0x5c,
+	0x6d, 0x51, 0xd1, 0xee, 0x36, 0x2d, 0x97, 0x05, 0xba, 0x1f, 0xf5, 0xbf, 0x35, 0xee, 0x1d, 0xda,
+	0xe2, 0x24, 0x24, 0x5c, 0x39, 0x70, 0x34, 0x23, 0x43, 0xdc, 0x4f, 0x23, 0x18, 0xef, 0x83, 0x39,
+	0xee, 0x62, 0x9f, 0x76, 0x5a, 0xce, 0x01, 0x76, 0x05, 0x8b, 0x78, 0x75, 0xbc, 0x3e, 0xb6, 0x5a,
+	0x6e, 0xdc, 0xee, 0xc5, 0xe6, 0xca, 0x05, 0xa6, 0x87, 0x6c, 0x21, 0x9a, 0xd5, 0x92, 0xdd, 0x44,
+	0x60, 0x7c, 0x0e, 0x16, 0xf3, 0x36, 0x8e, 0xcb, 0x3a, 0x22, 0x62, 0xbe, 0x4f, 0xa2, 0xea, 0x84,
+	0xa2, 0xfd, 0xd5, 0x5e, 0x6c, 0xd6, 0x35, 0xf2, 0x65, 0xa6, 0x10, 0x2d, 0xe4, 0x80, 0x77, 0xfa,
+	0x1a, 0xe3, 0xe7, 0x22, 0x58, 0x1a, 0xf2, 0x8b, 0x70, 0x10, 0xa6, 0x35, 0x36, 0xa9, 0xde, 0x63,
+	0xe7, 0x2a, 0x35, 0xb6, 0x9f, 0x8d, 0x84, 0x70, 0x10, 0xea, 0x82, 0x7b, 0xfd, 0x34, 0x36, 0x8b,
+	0x19, 0x1a, 0x2e, 0x0d, 0x3a, 0x9c, 0xed, 0x00, 0xc3, 0xf8, 0x0a, 0xcc, 0x44, 0x58, 0x10, 0x39,
+	0x2a, 0x8f, 0xa8, 0x47, 0xa2, 0x2a, 0x50, 0xf9, 0xbd, 0x75, 0x95, 0xfc, 0x10, 0x16, 0x64, 0x4f,
+	0xfb, 0x37, 0x6e, 0xea, 0xa4, 0x74, 0xbb, 0xe7, 0xc0, 0x21, 0x9a, 0x8e, 0x32, 0xb6, 0xc6, 0x8f,
+	0x45, 0xb0, 0x98, 0xeb, 0xff, 0x1c, 0x53, 0x53, 0x57, 0x67, 0x6a, 0x3b, 0x0b, 0x96, 0x61, 0x6a,
+	0x55, 0x27, 0x55, 0x1f, 0x31, 0x83, 0x86, 0x88, 0xc2, 0xa3, 0x21, 0xb6, 0xd6, 0xbf, 0x79, 0x6c,
+	0x16, 0x1e, 0x3d, 0x36, 0x0b, 0xbf, 0xfd, 0xba, 0x76, 0x4d, 0x76, 0xdc, 0x7b, 0x72, 0x54, 0x2d,
+	0x3f, 0x63, 0x54, 0xc1, 0xef, 0xc6, 0xc0, 0xc2, 0x25, 0x6f, 0x67, 0x7c, 0x02, 0x00, 0x17, 0x38,
+	0x12, 0x8e, 0x5c, 0x1b, 0x6a, 0x74, 0x4d, 0x6d, 0x2c, 0x59, 0xc9, 0x4e, 0xb1, 0xd2, 0x9d, 0x62,
+	0x7d, 0x98, 0xee, 0x94, 0xc6, 0x2d, 0xdd, 0xa5, 0xf3, 0xfd, 0x92, 0xd7, 0xbe, 0xf0, 0xe1, 0x53,
+	0xb3, 0x88, 0x26, 0x95, 0x40, 0x9a, 0x1b, 0x6d, 0x30, 0x91, 0xae, 0x2a, 0x35, 0xe9, 0x64, 0x7f,
+	0x0e, 0xe3, 0xde, 0xd3, 0x06, 0x8d, 0x75, 0x09, 0xfb, 0x4f, 0x6c, 0x1a, 0xa9, 0xcb, 0x5d, 0x16,
+	0x50, 0x41, 0x82, 0x50, 0x9c, 0x0c, 0xe6, 0x7c, 0xaa, 0x83, 0x8f, 0x64, 0xa8, 0x3e, 0xba, 0xf1,
+	0x00, 0x2c, 0xd0, 0x0e, 0x15, 0x54, 0xce, 0x8b, 0xa1, 0x1e, 0x1d, 0x53, 0x3d, 0x0a, 0x7b, 0xb1,
+	0x59, 0x4b, 0x30, 0x2e, 0x31, 0x84, 0xe8, 0x65, 0xad, 0xd9, 0xcf, 0xf7, 0xe9, 0xc7, 0xe0, 0x15,
+	0x81, 0xa3, 0x16, 0x11, 0x17, 0xa0, 0xcb, 0x0a, 0x7a, 0x65, 0x30, 0x68, 0x47, 0xdb, 0x41, 0x54,
+	0x49, 0x14, 0x79, 0x60, 0xd8, 0x2b, 0x81, 0x85, 0x4b, 0xca, 0xe4, 0x05, 0x3e, 0x0a, 0x02, 0x13,
+	0xa4, 0xe3, 0x25, 0xb8, 0xa5, 0xff, 0xc4, 0x4d, 0x47, 0x72, 0xba, 0x67, 0x3b, 0x5e, 0x06, 0x75,
+	0x9c, 0x74, 0x3c, 0x85, 0xf9, 0x11, 0x48, 0xb9, 0x73, 0x46, 0xed, 0xdc, 0x7a, 0x2f, 0x36, 0x6f,
+	0xe6, 0xc9, 0x1f, 0xda, 0xbd, 0x15, 0x2d, 0xcf, 0xd1, 0x61, 0x20, 0xa0, 0x89, 0x1b, 0x42, 0x2d,
+	0x2b, 0xd4, 0xcc, 0x4e, 0x1a, 0x65, 0x05, 0xd1, 0x8d, 0x44, 0x9c, 0xc3, 0x84, 0xbf, 0x17, 0xc1,
+	0x74, 0x76, 0x4a, 0x18, 0xbb, 0xe0, 0x25, 0x35, 0x4c, 0xb1, 0x2b, 0x9c, 0xfc, 0xfe, 0x5e, 0xee,
+	0xc5, 0xe6, 0x42, 0x12, 0x60, 0xd8, 0x02, 0xa2, 0xb9, 0x54, 0xb4, 0xad, 0x17, 0x3a, 0x07, 0x95,
+	0x00, 0x1f, 0x3b, 0x6e, 0x1b, 0x77, 0x5a, 0xc4, 0x09, 0x49, 0xe4, 0x34, 0x7d, 0xe6, 0x1e, 0xea,
+	0xcf, 0x99, 0xc6, 0xf3, 0x7d, 0xce, 0xe8, 0xfb, 0x8c, 0x02, 0x82, 0x68, 0x3e, 0xc0, 0xc7, 0x3b,
+	0x4a, 0xba, 0x47, 0xa2, 0x86, 0x94, 0x35, 0x3e, 0x3b, 0x3d, 0xab, 0x15, 0x9f, 0x9c, 0xd5, 0x8a,
+	0x7f, 0x9d, 0xd5, 0x8a, 0x0f, 0xcf, 0x6b, 0x85, 0x27, 0xe7, 0xb5, 0xc2, 0x1f, 0xe7, 0xb5, 0xc2,
+	0x83, 0xed, 0xcc, 0x9a, 0xd3, 0x93, 0x61, 0xcd, 0xc7, 0x4d, 0x9e, 0x1e, 0xec, 0xa3, 0x8d, 0x4d,
+	0xfb, 0x78, 0xf0, 0x11, 0xbc, 0x76, 0xe1, 0x2b, 0xb8, 0x79, 0x5d, 0xd5, 0xc3, 0x9b, 0xff, 0x0e,
+	0x00, 0xe0, 0x16, 0xd1, 0x1e, 0x32, 0x0b, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -208,6 +477,11 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -251,6 +525,42 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRampParams != nil {
+		{
+			size, err := m.AmplificationRampParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RateProvider != nil {
+		{
+			size, err := m.RateProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ScalingFactorRampParams != nil {
+		{
+			size, err := m.ScalingFactorRampParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -259,20 +569,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA5 := make([]byte, len(m.ScalingFactors)*10)
+		var j4 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j4++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -332,6 +642,170 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA9 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j8 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA11 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j10 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AmplificationRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -353,6 +827,9 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.Amplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Amplification))
+	}
 	return n
 }
 
@@ -394,19 +871,92 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorRampParams != nil {
+		l = m.ScalingFactorRampParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.RateProvider != nil {
+		l = m.RateProvider.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.AmplificationRampParams != nil {
+		l = m.AmplificationRampParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
-func sovStableswapPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ScalingFactorRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	return n
 }
-func sozStableswapPool(x uint64) (n int) {
-	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AmplificationRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.InitialAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.InitialAmplification))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TargetAmplification))
+	}
+	return n
 }
-func (m *PoolParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *RateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = m.MaxChangePerBlock.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
+func sovStableswapPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStableswapPool(x uint64) (n int) {
+	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -500,6 +1050,25 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -841,6 +1410,652 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRampParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRampParams == nil {
+				m.ScalingFactorRampParams = &ScalingFactorRampParams{}
+			}
+			if err := m.ScalingFactorRampParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateProvider == nil {
+				m.RateProvider = &RateProvider{}
+			}
+			if err := m.RateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRampParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRampParams == nil {
+				m.AmplificationRampParams = &AmplificationRampParams{}
+			}
+			if err := m.AmplificationRampParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package stableswap
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -13,18 +14,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -264,11 +270,339 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Linearly ramps the stableswap scaling factors from their current
+// values to target_scaling_factors over duration, starting at the current block
+// time.
+type MsgStableSwapRampScalingFactors struct {
+	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID               uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TargetScalingFactors []uint64      `protobuf:"varint,3,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	Duration             time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgStableSwapRampScalingFactors) Reset()         { *m = MsgStableSwapRampScalingFactors{} }
+func (m *MsgStableSwapRampScalingFactors) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactors) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{4}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactors proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampScalingFactors) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapRampScalingFactorsResponse struct {
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Reset() {
+	*m = MsgStableSwapRampScalingFactorsResponse{}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactorsResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{5}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse proto.InternalMessageInfo
+
+// Sets the rate provider the stableswap scaling factors are pulled from at the
+// beginning of every block. Sender must be the governance module account in
+// order to set a rate provider. An empty contract_address removes the rate
+// provider of the pool, for which sender may also be the pool's
+// scaling_factor_controller.
+type MsgStableSwapSetRateProvider struct {
+	Sender            string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID            uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ContractAddress   string                      `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	MaxChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_per_block,json=maxChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_per_block" yaml:"max_change_per_block"`
+}
+
+func (m *MsgStableSwapSetRateProvider) Reset()         { *m = MsgStableSwapSetRateProvider{} }
+func (m *MsgStableSwapSetRateProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapSetRateProvider) ProtoMessage()    {}
+func (*MsgStableSwapSetRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{6}
+}
+func (m *MsgStableSwapSetRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetRateProvider.Merge(m, src)
+}
+func (m *MsgStableSwapSetRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetRateProvider proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetRateProvider) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetRateProvider) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapSetRateProvider) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type MsgStableSwapSetRateProviderResponse struct {
+}
+
+func (m *MsgStableSwapSetRateProviderResponse) Reset()         { *m = MsgStableSwapSetRateProviderResponse{} }
+func (m *MsgStableSwapSetRateProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapSetRateProviderResponse) ProtoMessage()    {}
+func (*MsgStableSwapSetRateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{7}
+}
+func (m *MsgStableSwapSetRateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetRateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetRateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetRateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetRateProviderResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetRateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetRateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetRateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetRateProviderResponse proto.InternalMessageInfo
+
+// Sender must be the governance module account in order for the tx to
+// succeed. Linearly ramps the stableswap amplification from its current value
+// to target_amplification, starting at the current block time and ending at
+// end_time.
+type MsgStableSwapRampAmplification struct {
+	Sender              string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID              uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TargetAmplification uint64    `protobuf:"varint,3,opt,name=target_amplification,json=targetAmplification,proto3" json:"target_amplification,omitempty" yaml:"target_amplification"`
+	EndTime             time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{8}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetTargetAmplification() uint64 {
+	if m != nil {
+		return m.TargetAmplification
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{9}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactors")
+	proto.RegisterType((*MsgStableSwapRampScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapSetRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetRateProvider")
+	proto.RegisterType((*MsgStableSwapSetRateProviderResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetRateProviderResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
 }
 
 func init() {
@@ -276,51 +610,78 @@ func init() {
 }
 
 var fileDescriptor_3a59a47ae7445405 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x93, 0x10, 0xc4, 0x55, 0x80, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8, 0x0c, 0x69,
-	0x91, 0x6d, 0x9a, 0x4a, 0x1d, 0x3a, 0x20, 0x9a, 0xa0, 0xa2, 0x0a, 0x22, 0x15, 0x57, 0x2c, 0x30,
-	0x84, 0x8b, 0x7d, 0x35, 0x07, 0xb6, 0xcf, 0xf8, 0x2e, 0x69, 0x3b, 0xd2, 0x91, 0x89, 0x3f, 0x03,
-	0x31, 0xf5, 0xaf, 0x40, 0x1d, 0x3b, 0x32, 0x05, 0x94, 0x0e, 0xdd, 0xb3, 0xb1, 0x21, 0x9f, 0x2f,
-	0xbf, 0x20, 0xa1, 0x04, 0x75, 0x89, 0x9d, 0x77, 0xef, 0xfb, 0xbe, 0xf7, 0xbe, 0xf7, 0x7c, 0x60,
-	0x9d, 0x50, 0x9f, 0x50, 0x4c, 0x4d, 0x17, 0xfa, 0xbe, 0x19, 0x12, 0xe2, 0xf9, 0xc4, 0x41, 0x1e,
-	0x35, 0x29, 0x83, 0x4d, 0x0f, 0xd1, 0x03, 0x18, 0x9a, 0xed, 0xb5, 0x26, 0x62, 0x70, 0xcd, 0x64,
-	0x87, 0x46, 0x18, 0x11, 0x46, 0xe4, 0x55, 0x01, 0x32, 0x62, 0x90, 0x31, 0x04, 0x19, 0x43, 0x90,
-	0x21, 0x40, 0x45, 0xc5, 0xe6, 0xc9, 0x66, 0x13, 0x52, 0x34, 0x60, 0xb2, 0x09, 0x0e, 0x12, 0xae,
-	0x62, 0xde, 0x25, 0x2e, 0xe1, 0xaf, 0x66, 0xfc, 0x26, 0xa2, 0xf3, 0xd0, 0xc7, 0x01, 0x31, 0xf9,
-	0xaf, 0x08, 0x3d, 0x9a, 0xa1, 0xd2, 0x61, 0xa8, 0x11, 0x27, 0x0a, 0x86, 0x45, 0x51, 0x8a, 0x4f,
-	0x5d, 0xb3, 0xbd, 0x16, 0x3f, 0x92, 0x03, 0xad, 0x97, 0x05, 0x8b, 0x75, 0xea, 0xd6, 0x22, 0x04,
-	0x19, 0xda, 0x1b, 0x60, 0x77, 0x09, 0xf1, 0xe4, 0x15, 0x90, 0xa3, 0x28, 0x70, 0x50, 0x54, 0x90,
-	0x4a, 0x52, 0xf9, 0x46, 0x75, 0xbe, 0xd7, 0x51, 0x6f, 0x1e, 0x41, 0xdf, 0xdb, 0xd4, 0x92, 0xb8,
-	0x66, 0x89, 0x04, 0x99, 0x80, 0xb9, 0x58, 0xad, 0x11, 0xc2, 0x08, 0xfa, 0xb4, 0x90, 0x2e, 0x49,
-	0xe5, 0xb9, 0xca, 0x86, 0xf1, 0xef, 0x66, 0x19, 0xb1, 0xe2, 0x2e, 0x47, 0x57, 0x17, 0x7a, 0x1d,
-	0x55, 0x4e, 0x74, 0x46, 0x48, 0x35, 0x0b, 0x84, 0x83, 0x1c, 0xf9, 0x83, 0x04, 0x16, 0x70, 0x80,
-	0x19, 0x86, 0x1e, 0xef, 0xb3, 0xe1, 0xe1, 0xf7, 0x2d, 0xec, 0x60, 0x76, 0x54, 0xc8, 0x94, 0x32,
-	0xe5, 0xb9, 0xca, 0x92, 0x91, 0xb4, 0x6c, 0xc4, 0xee, 0x0f, 0x54, 0x6a, 0x04, 0x07, 0xd5, 0x07,
-	0xa7, 0x1d, 0x35, 0xf5, 0xe5, 0xbb, 0x5a, 0x76, 0x31, 0x7b, 0xd3, 0x6a, 0x1a, 0x36, 0xf1, 0x4d,
-	0xe1, 0x4f, 0xf2, 0xd0, 0xa9, 0xf3, 0xce, 0x64, 0x47, 0x21, 0xa2, 0x1c, 0x40, 0xad, 0xbc, 0x90,
-	0x8a, 0x8b, 0x7c, 0xd6, 0x17, 0x92, 0xeb, 0xe0, 0x36, 0xb5, 0xa1, 0x87, 0x03, 0xb7, 0xb1, 0x0f,
-	0x6d, 0x46, 0x22, 0x5a, 0xc8, 0x96, 0x32, 0xe5, 0x6c, 0xf5, 0x5e, 0xaf, 0xa3, 0x96, 0x84, 0x51,
-	0xc3, 0x71, 0x8c, 0xe7, 0x6a, 0xd6, 0x2d, 0x11, 0xd8, 0x4e, 0xb0, 0xf2, 0x73, 0x90, 0xdf, 0x6f,
-	0xb1, 0x56, 0x84, 0x92, 0x86, 0x5c, 0xd2, 0x46, 0x51, 0x40, 0xa2, 0xc2, 0x35, 0x6e, 0xbe, 0xda,
-	0xeb, 0xa8, 0x77, 0x12, 0xce, 0x49, 0x59, 0x9a, 0x25, 0x27, 0xe1, 0xb8, 0xc4, 0x27, 0x22, 0x28,
-	0xbf, 0x06, 0x4b, 0xe3, 0xaa, 0x0d, 0x9b, 0x04, 0x2c, 0x22, 0x9e, 0x87, 0xa2, 0x42, 0x8e, 0xf3,
-	0x8e, 0xd6, 0x3a, 0x2d, 0x55, 0xb3, 0x16, 0xc7, 0x6a, 0xad, 0x0d, 0x4e, 0x36, 0xd7, 0x8f, 0x2f,
-	0x4e, 0x56, 0xc5, 0x16, 0x7c, 0xbc, 0x38, 0x59, 0x5d, 0x1e, 0x5b, 0x55, 0x9b, 0xaf, 0x95, 0x3e,
-	0x34, 0x41, 0x8f, 0x8b, 0xd6, 0xb6, 0x81, 0x3a, 0x65, 0xe7, 0x2c, 0x44, 0x43, 0x12, 0x50, 0x24,
-	0x2f, 0x83, 0xeb, 0xbc, 0x3f, 0xec, 0xf0, 0xe5, 0xcb, 0x56, 0x41, 0xb7, 0xa3, 0xe6, 0xe2, 0x94,
-	0x9d, 0xc7, 0x56, 0x2e, 0x3e, 0xda, 0x71, 0xb4, 0xe3, 0x34, 0xb8, 0x5b, 0xa7, 0x6e, 0x42, 0xb1,
-	0x77, 0x00, 0xc3, 0x2d, 0xe7, 0x6d, 0x8b, 0xb2, 0xbd, 0x71, 0x5f, 0x67, 0x58, 0xe3, 0x11, 0xd5,
-	0xf4, 0x34, 0xd5, 0x49, 0x63, 0xcf, 0xfc, 0xff, 0xd8, 0x37, 0x1f, 0xfe, 0xe6, 0xa0, 0x31, 0xe6,
-	0xe0, 0x88, 0x75, 0x90, 0x37, 0xa7, 0x0b, 0xb8, 0x2e, 0xb4, 0xb5, 0xfb, 0x60, 0xe5, 0x52, 0x0f,
-	0xfa, 0xb6, 0x56, 0x7e, 0xa6, 0x41, 0xa6, 0x4e, 0x5d, 0xf9, 0xb3, 0x04, 0xf2, 0x13, 0xbf, 0xf9,
-	0xda, 0x2c, 0xdf, 0xec, 0x94, 0x21, 0x16, 0x9f, 0x5e, 0x01, 0xc9, 0x60, 0x13, 0xbe, 0x4a, 0x40,
-	0xb9, 0x64, 0xc2, 0xf5, 0x19, 0xf5, 0xfe, 0x4e, 0x57, 0x7c, 0x71, 0xa5, 0x74, 0xfd, 0x46, 0xaa,
-	0xaf, 0x4e, 0xbb, 0x8a, 0x74, 0xd6, 0x55, 0xa4, 0x1f, 0x5d, 0x45, 0xfa, 0x74, 0xae, 0xa4, 0xce,
-	0xce, 0x95, 0xd4, 0xb7, 0x73, 0x25, 0xf5, 0x72, 0x6b, 0xe4, 0x22, 0x12, 0xd2, 0xba, 0x07, 0x9b,
-	0xb4, 0xff, 0xc7, 0x6c, 0x57, 0x36, 0xcc, 0xc3, 0xe1, 0xed, 0xaf, 0xff, 0x71, 0xfd, 0x37, 0x73,
-	0xfc, 0x3a, 0x5f, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xde, 0x16, 0xbe, 0xd5, 0x06, 0x00,
-	0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x37, 0xfb, 0x4d, 0xda, 0x89, 0xbe, 0x84, 0x98, 0x55, 0xb2, 0xd9, 0x94, 0x75, 0xea,
+	0x56, 0x65, 0x13, 0xba, 0x36, 0x49, 0xa4, 0x4a, 0xac, 0x10, 0x22, 0x4e, 0x14, 0x28, 0x6d, 0xa4,
+	0xe0, 0x80, 0x90, 0xe0, 0x60, 0x66, 0xed, 0x89, 0x63, 0x62, 0x7b, 0x8c, 0x67, 0x36, 0x4d, 0x8e,
+	0xf4, 0xc8, 0xa9, 0x47, 0xfe, 0x04, 0xc4, 0xa9, 0x27, 0x38, 0x21, 0x71, 0x42, 0x3d, 0xf6, 0xc0,
+	0x01, 0x71, 0x70, 0x51, 0x22, 0x51, 0x89, 0xe3, 0xfe, 0x05, 0x68, 0xec, 0xb1, 0x77, 0xbd, 0xbf,
+	0x42, 0xc2, 0x72, 0xd9, 0x1f, 0x6f, 0xde, 0xfb, 0xbc, 0x37, 0x9f, 0xcf, 0x9b, 0x37, 0x36, 0xd8,
+	0xc0, 0xc4, 0xc3, 0xc4, 0x21, 0xaa, 0x0d, 0x3d, 0x4f, 0x0d, 0x30, 0x76, 0x3d, 0x6c, 0x21, 0x97,
+	0xa8, 0x84, 0xc2, 0xa6, 0x8b, 0xc8, 0x23, 0x18, 0xa8, 0xc7, 0x6b, 0x4d, 0x44, 0xe1, 0x9a, 0x4a,
+	0x4f, 0x94, 0x20, 0xc4, 0x14, 0x8b, 0xab, 0x3c, 0x48, 0x61, 0x41, 0x4a, 0x27, 0x48, 0xe9, 0x04,
+	0x29, 0x3c, 0xa8, 0x52, 0x35, 0x63, 0x67, 0xb5, 0x09, 0x09, 0xca, 0x90, 0x4c, 0xec, 0xf8, 0x09,
+	0x56, 0xa5, 0x64, 0x63, 0x1b, 0xc7, 0x3f, 0x55, 0xf6, 0x8b, 0x5b, 0xe7, 0xa0, 0xe7, 0xf8, 0x58,
+	0x8d, 0x3f, 0xb9, 0xe9, 0xbd, 0x4b, 0x54, 0xda, 0x31, 0x19, 0xcc, 0x91, 0x23, 0x2c, 0xf0, 0x52,
+	0x3c, 0x62, 0xab, 0xc7, 0x6b, 0xec, 0x8b, 0x2f, 0x54, 0x6d, 0x8c, 0x6d, 0x17, 0xa9, 0xf1, 0xbf,
+	0x66, 0xeb, 0x40, 0xb5, 0x5a, 0x21, 0xa4, 0x0e, 0x4e, 0x6b, 0x94, 0x7a, 0xd7, 0xa9, 0xe3, 0x21,
+	0x42, 0xa1, 0x17, 0x24, 0x0e, 0x72, 0xbb, 0x08, 0x16, 0x76, 0x89, 0xbd, 0x15, 0x22, 0x48, 0xd1,
+	0x7e, 0x96, 0x7c, 0x0f, 0x63, 0x57, 0x5c, 0x01, 0x53, 0x04, 0xf9, 0x16, 0x0a, 0xcb, 0xc2, 0xb2,
+	0x50, 0xbb, 0xae, 0xcd, 0xb5, 0x23, 0xe9, 0xff, 0xa7, 0xd0, 0x73, 0x1b, 0x72, 0x62, 0x97, 0x75,
+	0xee, 0x20, 0x62, 0x30, 0xc3, 0xca, 0x35, 0x02, 0x18, 0x42, 0x8f, 0x94, 0x0b, 0xcb, 0x42, 0x6d,
+	0x66, 0xfd, 0x9e, 0xf2, 0xcf, 0xd9, 0x56, 0x58, 0xc6, 0xbd, 0x38, 0x5a, 0x9b, 0x6f, 0x47, 0x92,
+	0x98, 0xe4, 0xe9, 0x02, 0x95, 0x75, 0x10, 0x64, 0x3e, 0xe2, 0xd7, 0x02, 0x98, 0x77, 0x7c, 0x87,
+	0x3a, 0xd0, 0x8d, 0x89, 0x32, 0x5c, 0xe7, 0xab, 0x96, 0x63, 0x39, 0xf4, 0xb4, 0x3c, 0xb9, 0x3c,
+	0x59, 0x9b, 0x59, 0x5f, 0x54, 0x12, 0xce, 0x14, 0x26, 0x5f, 0x96, 0x65, 0x0b, 0x3b, 0xbe, 0xf6,
+	0xd6, 0xb3, 0x48, 0x9a, 0xf8, 0xfe, 0x85, 0x54, 0xb3, 0x1d, 0x7a, 0xd8, 0x6a, 0x2a, 0x26, 0xf6,
+	0x54, 0x4e, 0x70, 0xf2, 0x55, 0x27, 0xd6, 0x91, 0x4a, 0x4f, 0x03, 0x44, 0xe2, 0x00, 0xa2, 0x97,
+	0x78, 0x2a, 0x56, 0xe4, 0xc3, 0x34, 0x91, 0xb8, 0x0b, 0x66, 0x89, 0x09, 0x5d, 0xc7, 0xb7, 0x8d,
+	0x03, 0x68, 0x52, 0x1c, 0x92, 0x72, 0x71, 0x79, 0xb2, 0x56, 0xd4, 0x6e, 0xb7, 0x23, 0x69, 0x99,
+	0x13, 0xd5, 0xd1, 0x33, 0xef, 0x2b, 0xeb, 0xaf, 0x70, 0xc3, 0x4e, 0x12, 0x2b, 0x7e, 0x04, 0x4a,
+	0x07, 0x2d, 0xda, 0x0a, 0x51, 0xb2, 0x21, 0x1b, 0x1f, 0xa3, 0xd0, 0xc7, 0x61, 0xf9, 0x7f, 0x31,
+	0xf9, 0x52, 0x3b, 0x92, 0x96, 0x12, 0xcc, 0x41, 0x5e, 0xb2, 0x2e, 0x26, 0x66, 0x56, 0xe2, 0xfb,
+	0xdc, 0x28, 0x7e, 0x01, 0x16, 0xf3, 0x59, 0x0d, 0x13, 0xfb, 0x34, 0xc4, 0xae, 0x8b, 0xc2, 0xf2,
+	0x54, 0x8c, 0xdb, 0x5d, 0xeb, 0x30, 0x57, 0x59, 0x5f, 0xc8, 0xd5, 0xba, 0x95, 0xad, 0x34, 0x36,
+	0x1e, 0xbf, 0x7c, 0xba, 0xca, 0xbb, 0xe0, 0x9b, 0x97, 0x4f, 0x57, 0x6f, 0xe5, 0x7a, 0xdd, 0x8c,
+	0xdb, 0xaa, 0xde, 0x21, 0xa1, 0xce, 0x8a, 0x96, 0x77, 0x80, 0x34, 0xa4, 0xe7, 0x74, 0x44, 0x02,
+	0xec, 0x13, 0x24, 0xde, 0x02, 0xd3, 0xf1, 0xfe, 0x1c, 0x2b, 0x6e, 0xbe, 0xa2, 0x06, 0xce, 0x22,
+	0x69, 0x8a, 0xb9, 0xdc, 0xdf, 0xd6, 0xa7, 0xd8, 0xd2, 0x7d, 0x4b, 0x7e, 0x5c, 0x00, 0x37, 0x77,
+	0x89, 0x9d, 0x40, 0xec, 0x3f, 0x82, 0xc1, 0xa6, 0xf5, 0x65, 0x8b, 0xd0, 0xfd, 0x3c, 0xaf, 0x97,
+	0x68, 0xe3, 0xae, 0xac, 0x85, 0x61, 0x59, 0x07, 0xc9, 0x3e, 0x79, 0x75, 0xd9, 0x1b, 0xef, 0xf6,
+	0x30, 0xa8, 0xe4, 0x18, 0xec, 0xa2, 0x0e, 0xc6, 0x9b, 0xab, 0xf3, 0xf0, 0x3a, 0xcf, 0x2d, 0xbf,
+	0x09, 0x56, 0x2e, 0xe4, 0x20, 0xa5, 0x55, 0x6e, 0x17, 0x80, 0x94, 0xf3, 0xd6, 0xa1, 0x17, 0xfc,
+	0xc7, 0x7c, 0x7d, 0x0a, 0xe6, 0x29, 0x0c, 0x6d, 0x44, 0x8d, 0xc1, 0xb4, 0xdd, 0x6c, 0x47, 0xd2,
+	0xeb, 0x09, 0xfe, 0x60, 0x3f, 0x59, 0x2f, 0x25, 0x0b, 0x3d, 0x85, 0x1e, 0x82, 0x6b, 0xe9, 0xb8,
+	0x2b, 0x17, 0xe3, 0x89, 0xb3, 0xa8, 0x24, 0xf3, 0x4e, 0x49, 0xe7, 0x9d, 0xb2, 0xcd, 0x1d, 0xb4,
+	0x35, 0x76, 0xe8, 0xff, 0x8a, 0x24, 0x31, 0x0d, 0xb9, 0x8b, 0x3d, 0x87, 0x22, 0x2f, 0xa0, 0xa7,
+	0xed, 0x48, 0x9a, 0x4d, 0xf2, 0xa7, 0x6b, 0xf2, 0xb7, 0x2f, 0x24, 0x41, 0xcf, 0xd0, 0x1b, 0xef,
+	0xf4, 0x68, 0x74, 0x77, 0x98, 0x46, 0x21, 0xf4, 0x82, 0x3e, 0x85, 0x56, 0xc0, 0x1b, 0x17, 0x70,
+	0x9e, 0xe9, 0xf3, 0x67, 0x01, 0xdc, 0xc8, 0xf9, 0xee, 0x23, 0xaa, 0x43, 0x8a, 0xf6, 0x42, 0x7c,
+	0xec, 0x30, 0xc6, 0xc7, 0x2d, 0xce, 0x0e, 0x78, 0x35, 0x3e, 0xe7, 0xd0, 0xa4, 0x06, 0xb4, 0xac,
+	0x10, 0x11, 0x26, 0x0b, 0x43, 0x5e, 0x6a, 0x47, 0xd2, 0x42, 0x82, 0xdc, 0xeb, 0x21, 0xeb, 0xb3,
+	0xa9, 0x69, 0x33, 0xb1, 0x88, 0x04, 0x94, 0x3c, 0x78, 0x62, 0x98, 0x87, 0xd0, 0xb7, 0x91, 0x11,
+	0xa0, 0xd0, 0x68, 0xba, 0xd8, 0x3c, 0x8a, 0x75, 0xb9, 0xae, 0x69, 0x8c, 0xfc, 0xdf, 0x23, 0x69,
+	0x29, 0x99, 0xaf, 0xc4, 0x3a, 0x52, 0x1c, 0xac, 0x7a, 0x90, 0x1e, 0x2a, 0x0f, 0x91, 0x0d, 0xcd,
+	0xd3, 0x6d, 0x64, 0x76, 0xe6, 0xdb, 0x20, 0x20, 0x59, 0x9f, 0xf3, 0xe0, 0xc9, 0x56, 0x6c, 0xdd,
+	0x43, 0xa1, 0xc6, 0x6c, 0x8d, 0xb7, 0x7b, 0x64, 0x59, 0x19, 0x26, 0x0b, 0x41, 0xb4, 0x1e, 0xb2,
+	0x49, 0x14, 0x70, 0x1e, 0xe5, 0x3b, 0xe0, 0xf6, 0x28, 0x9e, 0x33, 0x41, 0x7e, 0x2d, 0x80, 0x6a,
+	0x9f, 0x78, 0x9b, 0x5e, 0xe0, 0x3a, 0x07, 0x8e, 0x19, 0x37, 0xc7, 0xd8, 0x25, 0xd1, 0x01, 0x6f,
+	0x77, 0x03, 0x76, 0xe7, 0x89, 0x65, 0x29, 0x76, 0xdf, 0x03, 0x83, 0xbc, 0x64, 0xfd, 0xb5, 0xc4,
+	0x9c, 0xaf, 0x51, 0x07, 0xd7, 0x90, 0x6f, 0x19, 0xec, 0xf6, 0xe7, 0x47, 0xa5, 0xd2, 0x77, 0x54,
+	0x3e, 0x4e, 0x1f, 0x0d, 0xb4, 0x25, 0x26, 0x57, 0xe7, 0x54, 0xa4, 0x91, 0xf2, 0x13, 0x76, 0x2a,
+	0xa6, 0x91, 0x6f, 0x31, 0xd7, 0x46, 0xa3, 0x87, 0xfd, 0xd5, 0x91, 0x87, 0x22, 0x5f, 0x65, 0x0d,
+	0xdc, 0x19, 0xcd, 0x6a, 0x2a, 0xc0, 0xfa, 0x0f, 0xd3, 0x60, 0x72, 0x97, 0xd8, 0xe2, 0x77, 0x02,
+	0x28, 0x0d, 0x7c, 0x4a, 0xd9, 0xba, 0xcc, 0x53, 0xc6, 0x90, 0x6b, 0xa7, 0xf2, 0x60, 0x0c, 0x20,
+	0xd9, 0xdd, 0xf5, 0x8b, 0x00, 0xaa, 0x17, 0xdc, 0x49, 0xbb, 0x97, 0xcc, 0x37, 0x1a, 0xae, 0xf2,
+	0xc9, 0x58, 0xe1, 0xb2, 0x8d, 0xfc, 0x2c, 0x80, 0x1b, 0x23, 0xaf, 0x8a, 0x07, 0x57, 0xce, 0xdb,
+	0x0f, 0x56, 0xd9, 0x1f, 0x23, 0x58, 0xb6, 0x85, 0x1f, 0x05, 0xb0, 0x38, 0x7c, 0x9a, 0x7e, 0x70,
+	0xe5, 0x94, 0x3d, 0x48, 0x95, 0xbd, 0x71, 0x21, 0x65, 0x95, 0xff, 0x24, 0x80, 0xa5, 0x51, 0x63,
+	0xe7, 0xc3, 0x7f, 0x45, 0x57, 0x0e, 0xab, 0xa2, 0x8f, 0x0f, 0x2b, 0xad, 0x5f, 0xfb, 0xfc, 0xd9,
+	0x59, 0x55, 0x78, 0x7e, 0x56, 0x15, 0xfe, 0x38, 0xab, 0x0a, 0x4f, 0xce, 0xab, 0x13, 0xcf, 0xcf,
+	0xab, 0x13, 0xbf, 0x9d, 0x57, 0x27, 0x3e, 0xdb, 0xec, 0x7a, 0xee, 0xe6, 0x79, 0xeb, 0x2e, 0x6c,
+	0x92, 0xf4, 0x8f, 0x7a, 0xbc, 0x7e, 0x4f, 0x3d, 0xe9, 0xbc, 0x2d, 0xd5, 0xfb, 0x5e, 0x97, 0x9a,
+	0x53, 0xf1, 0xd4, 0xda, 0xf8, 0x7b, 0x00, 0xe7, 0xe3, 0x7d, 0xdb, 0x05, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +698,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error)
+	StableSwapSetRateProvider(ctx context.Context, in *MsgStableSwapSetRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetRateProviderResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 type msgClient struct {
@@ -365,10 +729,40 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	out := new(MsgStableSwapRampScalingFactorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapSetRateProvider(ctx context.Context, in *MsgStableSwapSetRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetRateProviderResponse, error) {
+	out := new(MsgStableSwapSetRateProviderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetRateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(context.Context, *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error)
+	StableSwapSetRateProvider(context.Context, *MsgStableSwapSetRateProvider) (*MsgStableSwapSetRateProviderResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -381,6 +775,15 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampScalingFactors(ctx context.Context, req *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampScalingFactors not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapSetRateProvider(ctx context.Context, req *MsgStableSwapSetRateProvider) (*MsgStableSwapSetRateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetRateProvider not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -422,6 +825,60 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampScalingFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampScalingFactors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, req.(*MsgStableSwapRampScalingFactors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapSetRateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetRateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetRateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetRateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetRateProvider(ctx, req.(*MsgStableSwapSetRateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -434,6 +891,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampScalingFactors",
+			Handler:    _Msg_StableSwapRampScalingFactors_Handler,
+		},
+		{
+			MethodName: "StableSwapSetRateProvider",
+			Handler:    _Msg_StableSwapSetRateProvider_Handler,
+		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/poolmodels/stableswap/v1beta1/tx.proto",
@@ -631,8 +1100,238 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
+func (m *MsgStableSwapRampScalingFactors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA8 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j7 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetRateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetRateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetRateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.TargetAmplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -724,6 +1423,101 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampScalingFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapSetRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxChangePerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapSetRateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.TargetAmplification != 0 {
+		n += 1 + sovTx(uint64(m.TargetAmplification))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +2112,686 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampScalingFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetRateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetRateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetRateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplification", wireType)
+			}
+			m.TargetAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
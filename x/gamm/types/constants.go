@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
@@ -15,6 +17,26 @@ const (
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
 
+	// StableswapMaxAmplification is the max amplification coefficient of a stableswap pool.
+	StableswapMaxAmplification = 1_000_000
+	// StableswapMaxAmplificationChangeFactor is the max factor a single amplification ramp
+	// can increase or decrease the amplification of a stableswap pool by.
+	StableswapMaxAmplificationChangeFactor = 10
+	// StableswapMaxRateProviderPools is the max number of stableswap pools with a rate provider,
+	// which bounds the number of rate provider queries at the beginning of every block.
+	StableswapMaxRateProviderPools = 20
+	// StableswapRateProviderQueryGasLimit is the max gas a single rate provider query may consume.
+	StableswapRateProviderQueryGasLimit = 1_000_000
+	// StableswapMinAmplificationRampDuration is the min duration of an amplification ramp.
+	StableswapMinAmplificationRampDuration = 24 * time.Hour
+
+	// DefaultLiquidityBootstrappingPriceCurvePoints is the number of points of the price curve of a
+	// liquidity bootstrapping pool returned by default.
+	DefaultLiquidityBootstrappingPriceCurvePoints = 10
+	// MaxLiquidityBootstrappingPriceCurvePoints is the max number of points of the price curve of a
+	// liquidity bootstrapping pool that can be queried.
+	MaxLiquidityBootstrappingPriceCurvePoints = 100
+
 	// pools can be created with min and max number of assets defined with this constants
 	MinNumOfAssetsInPool = 2
 	MaxNumOfAssetsInPool = 8
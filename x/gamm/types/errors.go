@@ -108,4 +108,29 @@ var (
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
+	ErrRateProviderSet            = errorsmod.Register(ModuleName, 69, "scaling factors are pulled from the rate provider of the pool")
+	ErrInvalidMaxChangePerBlock   = errorsmod.Register(ModuleName, 70, "rate provider max change per block must be in (0, 1)")
+	ErrInvalidRampDuration        = errorsmod.Register(ModuleName, 71, "scaling factor ramp duration must be positive")
+
+	ErrInvalidAmplification        = errorsmod.Register(ModuleName, 72, "stableswap amplification must be between 1 and the max amplification")
+	ErrAmplificationChangeTooLarge = errorsmod.Register(ModuleName, 73, "stableswap amplification ramp exceeds the max amplification change factor")
+	ErrAmplificationRampTooShort   = errorsmod.Register(ModuleName, 74, "stableswap amplification ramp is shorter than the min amplification ramp duration")
+	ErrSwapExceedsPoolReserves     = errorsmod.Register(ModuleName, 75, "swap exceeds the pool reserves of the token out")
+
+	ErrUnauthorizedWeightChange     = errorsmod.Register(ModuleName, 76, "weight changes can only be scheduled by the pool creator or the governance module account")
+	ErrInvalidWeightChangeStartTime = errorsmod.Register(ModuleName, 77, "weight change start time must not be before the current block time")
+
+	ErrInvalidLiquidityBootstrappingParams       = errorsmod.Register(ModuleName, 78, "invalid liquidity bootstrapping params")
+	ErrNotLiquidityBootstrappingPool             = errorsmod.Register(ModuleName, 79, "not a liquidity bootstrapping pool")
+	ErrLiquidityBootstrappingJoinRestricted      = errorsmod.Register(ModuleName, 80, "only the pool creator may join a liquidity bootstrapping pool")
+	ErrLiquidityBootstrappingExitRestricted      = errorsmod.Register(ModuleName, 81, "only the pool creator may exit a liquidity bootstrapping pool, once the sale has ended")
+	ErrLiquidityBootstrappingPurchaseCapExceeded = errorsmod.Register(ModuleName, 82, "purchase exceeds the purchase cap of the liquidity bootstrapping pool")
+
+	ErrUnauthorizedRateProvider = errorsmod.Register(ModuleName, 83, "rate providers can only be set by the governance module account")
+	ErrTooManyRateProviderPools = errorsmod.Register(ModuleName, 84, "the max number of stableswap pools with a rate provider is reached")
+	ErrRateProviderOutOfGas     = errorsmod.Register(ModuleName, 87, "rate provider query ran out of gas")
+
+	ErrUnauthorizedAmplificationRamp = errorsmod.Register(ModuleName, 85, "the amplification can only be ramped by the governance module account")
+
+	ErrLiquidityBootstrappingWeightChangeRestricted = errorsmod.Register(ModuleName, 86, "only the governance module account may schedule a weight change of a liquidity bootstrapping pool until the sale has ended")
 )
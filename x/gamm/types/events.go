@@ -6,6 +6,8 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
+	TypeEvtWeightChangeScheduled = "weight_change_scheduled"
+
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
 	AttributeKeyPoolIdEntering = "pool_id_entering"
@@ -14,6 +16,10 @@ const (
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
 
+	AttributeKeyStartTime         = "start_time"
+	AttributeKeyDuration          = "duration"
+	AttributeKeyTargetPoolWeights = "target_pool_weights"
+
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
 	AttributeAmount1    = "amount1"
@@ -4,6 +4,7 @@ import (
 	context "context"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -110,3 +111,9 @@ type PoolIncentivesKeeper interface {
 type IncentivesKeeper interface {
 	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
 }
+
+// WasmKeeper defines the contract needed to be fulfilled for the wasm keeper.
+type WasmKeeper interface {
+	QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+	QueryGasLimit() storetypes.Gas
+}
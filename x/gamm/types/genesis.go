@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 )
 
@@ -21,5 +25,13 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, purchase := range gs.LiquidityBootstrappingPurchases {
+		if _, err := sdk.AccAddressFromBech32(purchase.Address); err != nil {
+			return err
+		}
+		if purchase.Amount.IsNil() || !purchase.Amount.IsPositive() {
+			return fmt.Errorf("liquidity bootstrapping purchase of %s from pool %d must be positive, got %s", purchase.Address, purchase.PoolId, purchase.Amount)
+		}
+	}
 	return nil
 }
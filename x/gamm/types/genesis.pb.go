@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -31,9 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
-	NextPoolNumber   uint64                      `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params           Params                      `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
-	MigrationRecords *migration.MigrationRecords `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	NextPoolNumber                  uint64                           `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                          Params                           `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	MigrationRecords                *migration.MigrationRecords      `protobuf:"bytes,4,opt,name=migration_records,json=migrationRecords,proto3" json:"migration_records,omitempty"`
+	LiquidityBootstrappingPurchases []LiquidityBootstrappingPurchase `protobuf:"bytes,5,rep,name=liquidity_bootstrapping_purchases,json=liquidityBootstrappingPurchases,proto3" json:"liquidity_bootstrapping_purchases"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,8 +99,71 @@ func (m *GenesisState) GetMigrationRecords() *migration.MigrationRecords {
 	return nil
 }
 
+func (m *GenesisState) GetLiquidityBootstrappingPurchases() []LiquidityBootstrappingPurchase {
+	if m != nil {
+		return m.LiquidityBootstrappingPurchases
+	}
+	return nil
+}
+
+// LiquidityBootstrappingPurchase is the amount of the sale denom an address
+// bought from a liquidity bootstrapping pool with a purchase cap.
+type LiquidityBootstrappingPurchase struct {
+	PoolId  uint64                `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Address string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *LiquidityBootstrappingPurchase) Reset()         { *m = LiquidityBootstrappingPurchase{} }
+func (m *LiquidityBootstrappingPurchase) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBootstrappingPurchase) ProtoMessage()    {}
+func (*LiquidityBootstrappingPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *LiquidityBootstrappingPurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBootstrappingPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBootstrappingPurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBootstrappingPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBootstrappingPurchase.Merge(m, src)
+}
+func (m *LiquidityBootstrappingPurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBootstrappingPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBootstrappingPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBootstrappingPurchase proto.InternalMessageInfo
+
+func (m *LiquidityBootstrappingPurchase) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LiquidityBootstrappingPurchase) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
+	proto.RegisterType((*LiquidityBootstrappingPurchase)(nil), "osmosis.gamm.v1beta1.LiquidityBootstrappingPurchase")
 }
 
 func init() {
@@ -106,30 +171,40 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0xfe, 0x83, 0x2f, 0xfd, 0x90, 0x1a, 0xba, 0x88, 0x45, 0x62, 0xed, 0x42, 0xb2,
-	0xe9, 0x8c, 0xad, 0xe8, 0xc2, 0x9d, 0xdd, 0x88, 0x82, 0x52, 0xd2, 0x9d, 0x9b, 0x30, 0x49, 0xc7,
-	0x69, 0x20, 0x93, 0x1b, 0x32, 0xd3, 0xd2, 0xee, 0x7c, 0x04, 0x1f, 0xc6, 0x87, 0x28, 0xae, 0xba,
-	0x74, 0x25, 0xd2, 0xbe, 0x88, 0x24, 0x93, 0xb8, 0x90, 0xe2, 0x2e, 0xe7, 0xde, 0xdf, 0x3d, 0x39,
-	0x73, 0x8c, 0x1e, 0x08, 0x0e, 0x22, 0x14, 0x98, 0x11, 0xce, 0xf1, 0x62, 0xe0, 0x53, 0x49, 0x06,
-	0x98, 0xd1, 0x98, 0x8a, 0x50, 0xa0, 0x24, 0x05, 0x09, 0x66, 0xbb, 0x60, 0x50, 0xc6, 0xa0, 0x82,
-	0xe9, 0xb4, 0x19, 0x30, 0xc8, 0x01, 0x9c, 0x7d, 0x29, 0xb6, 0x73, 0xc4, 0x00, 0x58, 0x44, 0x71,
-	0xae, 0xfc, 0xf9, 0x33, 0x26, 0xf1, 0xaa, 0x5c, 0x05, 0xb9, 0x8f, 0xa7, 0x6e, 0x94, 0x28, 0x56,
-	0xb6, 0x52, 0xd8, 0x27, 0x82, 0xfe, 0x84, 0x08, 0x20, 0x8c, 0x8b, 0xfd, 0xe9, 0xde, 0x94, 0x62,
-	0x46, 0x52, 0x3a, 0xfd, 0x13, 0x49, 0x48, 0x4a, 0x78, 0xf1, 0x97, 0xde, 0x4b, 0xc5, 0xf8, 0x7f,
-	0xab, 0x5e, 0x36, 0x91, 0x44, 0x52, 0xf3, 0xd2, 0xa8, 0x27, 0x00, 0x91, 0xb0, 0xf4, 0x6e, 0xd5,
-	0x69, 0x0e, 0xdb, 0x48, 0x85, 0x47, 0x65, 0x78, 0x74, 0x13, 0xaf, 0x46, 0xff, 0xde, 0xdf, 0xfa,
-	0xf5, 0x31, 0x40, 0x74, 0xe7, 0x2a, 0xda, 0x74, 0x8c, 0x56, 0x4c, 0x97, 0xd2, 0xcb, 0x94, 0x17,
-	0xcf, 0xb9, 0x4f, 0x53, 0xab, 0xd2, 0xd5, 0x9d, 0x9a, 0x7b, 0x90, 0xcd, 0x33, 0xf6, 0x31, 0x9f,
-	0x9a, 0xd7, 0x46, 0x43, 0x25, 0xb0, 0xaa, 0x5d, 0xdd, 0x69, 0x0e, 0x8f, 0xd1, 0xbe, 0x2a, 0xd1,
-	0x38, 0x67, 0x46, 0xb5, 0xf5, 0xe7, 0x89, 0xe6, 0x16, 0x17, 0xe6, 0xc4, 0x38, 0xe4, 0x21, 0x4b,
-	0x89, 0x0c, 0x21, 0xf6, 0x52, 0x1a, 0x40, 0x3a, 0x15, 0x56, 0x2d, 0xb7, 0x39, 0xdb, 0x6f, 0xf3,
-	0x50, 0xe2, 0xae, 0xa2, 0xdd, 0x16, 0xff, 0x35, 0x19, 0xdd, 0xaf, 0xb7, 0xb6, 0xbe, 0xd9, 0xda,
-	0xfa, 0xd7, 0xd6, 0xd6, 0x5f, 0x77, 0xb6, 0xb6, 0xd9, 0xd9, 0xda, 0xc7, 0xce, 0xd6, 0x9e, 0xce,
-	0x59, 0x28, 0x67, 0x73, 0x1f, 0x05, 0xc0, 0x71, 0xe1, 0xde, 0x8f, 0x88, 0x2f, 0x4a, 0x81, 0x17,
-	0xc3, 0x2b, 0xbc, 0x54, 0xed, 0xca, 0x55, 0x42, 0x85, 0xdf, 0xc8, 0x6b, 0xba, 0xf8, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0x1a, 0xaa, 0xcb, 0x2f, 0x43, 0x02, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xd6, 0x76, 0xaa, 0x07, 0xd3, 0x88, 0x8a, 0x14, 0x26, 0x94, 0x76, 0x39, 0xa0,
+	0x4a, 0x30, 0x9b, 0x95, 0x3f, 0x87, 0xdd, 0xc8, 0x01, 0x54, 0x04, 0xa8, 0xca, 0x6e, 0x5c, 0x22,
+	0xa7, 0x31, 0xa9, 0x45, 0x1c, 0x07, 0xdb, 0x99, 0xd6, 0x2f, 0x80, 0xc4, 0x8d, 0x0f, 0xc3, 0x37,
+	0xe0, 0x32, 0x71, 0xda, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x0d, 0xf6, 0x09, 0x50, 0x6c, 0x07, 0x4d,
+	0xa8, 0xea, 0xcd, 0xef, 0xfb, 0xfe, 0x9e, 0xd7, 0x8f, 0x5f, 0xbf, 0x20, 0xe0, 0x92, 0x71, 0x49,
+	0x25, 0xca, 0x30, 0x63, 0xe8, 0xfc, 0x24, 0x21, 0x0a, 0x9f, 0xa0, 0x8c, 0x14, 0x44, 0x52, 0x09,
+	0x4b, 0xc1, 0x15, 0x77, 0xfb, 0x96, 0x81, 0x35, 0x03, 0x2d, 0x73, 0xd8, 0xcf, 0x78, 0xc6, 0x35,
+	0x80, 0xea, 0x93, 0x61, 0x0f, 0xef, 0x67, 0x9c, 0x67, 0x39, 0x41, 0x3a, 0x4a, 0xaa, 0x8f, 0x08,
+	0x17, 0x8b, 0xa6, 0x34, 0xd3, 0x7d, 0x62, 0xa3, 0x31, 0x81, 0x2d, 0xf9, 0x26, 0x42, 0x09, 0x96,
+	0xe4, 0x9f, 0x89, 0x19, 0xa7, 0x85, 0xad, 0x1f, 0x6d, 0x74, 0x29, 0xe7, 0x58, 0x90, 0x74, 0x2b,
+	0x52, 0x62, 0x81, 0x99, 0xbd, 0x25, 0xf8, 0xba, 0x03, 0x6e, 0xbf, 0x36, 0x2f, 0x3b, 0x53, 0x58,
+	0x11, 0xf7, 0x39, 0xe8, 0x94, 0x9c, 0xe7, 0xd2, 0x73, 0x86, 0x3b, 0xa3, 0xbd, 0x71, 0x1f, 0x1a,
+	0xf3, 0xb0, 0x31, 0x0f, 0x5f, 0x16, 0x8b, 0xb0, 0xf7, 0xf3, 0xfb, 0x71, 0x67, 0xca, 0x79, 0x3e,
+	0x89, 0x0c, 0xed, 0x8e, 0xc0, 0x41, 0x41, 0x2e, 0x54, 0x5c, 0x47, 0x71, 0x51, 0xb1, 0x84, 0x08,
+	0xef, 0xd6, 0xd0, 0x19, 0xb5, 0xa3, 0xfd, 0x3a, 0x5f, 0xb3, 0xef, 0x75, 0xd6, 0x3d, 0x05, 0x5d,
+	0xe3, 0xc0, 0xdb, 0x19, 0x3a, 0xa3, 0xbd, 0xf1, 0x03, 0xb8, 0x69, 0x94, 0x70, 0xaa, 0x99, 0xb0,
+	0x7d, 0xb9, 0x1c, 0xb4, 0x22, 0xab, 0x70, 0xcf, 0xc0, 0x5d, 0x46, 0x33, 0x81, 0x15, 0xe5, 0x45,
+	0x2c, 0xc8, 0x8c, 0x8b, 0x54, 0x7a, 0x6d, 0xdd, 0xe6, 0xe1, 0xe6, 0x36, 0xef, 0x1a, 0x3c, 0x32,
+	0x74, 0x74, 0xc0, 0xfe, 0xcb, 0xb8, 0x5f, 0x1c, 0x70, 0x94, 0xd3, 0xcf, 0x15, 0x4d, 0xa9, 0x5a,
+	0xc4, 0x09, 0xe7, 0x4a, 0x2a, 0x81, 0xcb, 0x92, 0x16, 0x59, 0x5c, 0x56, 0x62, 0x36, 0xc7, 0x92,
+	0x48, 0xaf, 0xa3, 0xc7, 0xf1, 0x6c, 0xf3, 0x2d, 0x6f, 0x1b, 0x79, 0x78, 0x53, 0x3d, 0xb5, 0x62,
+	0xfb, 0x88, 0x41, 0xbe, 0x95, 0x92, 0xc1, 0x0f, 0x07, 0xf8, 0xdb, 0x3b, 0xb9, 0x8f, 0xc0, 0xae,
+	0x9e, 0x30, 0x4d, 0x3d, 0xa7, 0x9e, 0x6e, 0xe8, 0x5e, 0x2f, 0x07, 0xfb, 0x0b, 0xcc, 0xf2, 0xd3,
+	0xc0, 0x16, 0x82, 0xa8, 0x5b, 0x9f, 0x26, 0xa9, 0xfb, 0x18, 0xec, 0xe2, 0x34, 0x15, 0x44, 0x4a,
+	0xfd, 0x15, 0xbd, 0x9b, 0xb0, 0x2d, 0x04, 0x51, 0x83, 0xb8, 0xaf, 0x40, 0x17, 0x33, 0x5e, 0x15,
+	0x4a, 0xff, 0x4b, 0x2f, 0x84, 0xb5, 0xe9, 0xdf, 0xcb, 0xc1, 0x3d, 0xb3, 0x87, 0x32, 0xfd, 0x04,
+	0x29, 0x47, 0x0c, 0xab, 0x39, 0x9c, 0x14, 0xea, 0x7a, 0x39, 0xb8, 0x63, 0x3b, 0x69, 0x51, 0x10,
+	0x59, 0x75, 0xf8, 0xe6, 0x72, 0xe5, 0x3b, 0x57, 0x2b, 0xdf, 0xf9, 0xb3, 0xf2, 0x9d, 0x6f, 0x6b,
+	0xbf, 0x75, 0xb5, 0xf6, 0x5b, 0xbf, 0xd6, 0x7e, 0xeb, 0xc3, 0x93, 0x8c, 0xaa, 0x79, 0x95, 0xc0,
+	0x19, 0x67, 0xc8, 0x8e, 0xf1, 0x38, 0xc7, 0x89, 0x6c, 0x02, 0x74, 0x3e, 0x7e, 0x81, 0x2e, 0xcc,
+	0xb2, 0xaa, 0x45, 0x49, 0x64, 0xd2, 0xd5, 0x5b, 0xf7, 0xf4, 0xef, 0x00, 0xf3, 0x9f, 0x9a, 0x7b,
+	0x92, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +227,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LiquidityBootstrappingPurchases) > 0 {
+		for iNdEx := len(m.LiquidityBootstrappingPurchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityBootstrappingPurchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.MigrationRecords != nil {
 		{
 			size, err := m.MigrationRecords.MarshalToSizedBuffer(dAtA[:i])
@@ -196,6 +285,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LiquidityBootstrappingPurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBootstrappingPurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBootstrappingPurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -228,6 +362,30 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MigrationRecords.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.LiquidityBootstrappingPurchases) > 0 {
+		for _, e := range m.LiquidityBootstrappingPurchases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityBootstrappingPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -388,6 +546,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBootstrappingPurchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityBootstrappingPurchases = append(m.LiquidityBootstrappingPurchases, LiquidityBootstrappingPurchase{})
+			if err := m.LiquidityBootstrappingPurchases[len(m.LiquidityBootstrappingPurchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityBootstrappingPurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityBootstrappingPurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityBootstrappingPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
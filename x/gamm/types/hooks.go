@@ -17,7 +17,8 @@ type GammHooks interface {
 	AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins)
 
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
-	AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// spreadFactor is the spread factor the swap was charged, out of the input.
+	AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -48,8 +49,8 @@ func (h MultiGammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, po
 	}
 }
 
-func (h MultiGammHooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (h MultiGammHooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
 	for i := range h {
-		h[i].AfterCFMMSwap(ctx, sender, poolId, input, output)
+		h[i].AfterCFMMSwap(ctx, sender, poolId, input, output, spreadFactor)
 	}
 }
@@ -30,6 +30,12 @@ var (
 
 	KeyPrefixMigrationInfoBalancerPool = []byte{0x04}
 	KeyPrefixMigrationInfoCLPool       = []byte{0x05}
+
+	// KeyPrefixRateProviderPools defines prefix to index the stableswap pools with a rate provider.
+	KeyPrefixRateProviderPools = []byte{0x06}
+	// KeyPrefixLiquidityBootstrappingPurchases defines prefix to store the amount of the sale denom
+	// each address bought from a liquidity bootstrapping pool.
+	KeyPrefixLiquidityBootstrappingPurchases = []byte{0x07}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -61,6 +67,18 @@ func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
 
+func GetKeyPrefixRateProviderPools(poolId uint64) []byte {
+	return append(KeyPrefixRateProviderPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixLiquidityBootstrappingPurchases(poolId uint64) []byte {
+	return append(KeyPrefixLiquidityBootstrappingPurchases, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyLiquidityBootstrappingPurchase(poolId uint64, buyer sdk.AccAddress) []byte {
+	return append(GetKeyPrefixLiquidityBootstrappingPurchases(poolId), buyer...)
+}
+
 func GetKeyPrefixMigrationInfoBalancerPool(balancerPoolId uint64) []byte {
 	return append(KeyPrefixMigrationInfoBalancerPool, sdk.Uint64ToBigEndian(balancerPoolId)...)
 }
@@ -101,14 +101,21 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut osmomath.Int, coinsIn sdk.Coins)
 }
 
-// WeightedPoolExtension is an extension of the PoolI interface
-// That defines an additional API for handling the pool's weights.
-type WeightedPoolExtension interface {
+// PokablePool is an extension of the CFMMPoolI interface
+// for pools with parameters that change over time, e.g. balancer weights
+// or stableswap scaling factors.
+type PokablePool interface {
 	CFMMPoolI
 
-	// PokePool determines if a pool's weights need to be updated and updates
-	// them if so.
+	// PokePool determines if a pool's time-dependent parameters need to be updated
+	// and updates them if so.
 	PokePool(blockTime time.Time)
+}
+
+// WeightedPoolExtension is an extension of the PoolI interface
+// That defines an additional API for handling the pool's weights.
+type WeightedPoolExtension interface {
+	PokablePool
 
 	// GetTokenWeight returns the weight of the specified token in the pool.
 	GetTokenWeight(denom string) (osmomath.Int, error)
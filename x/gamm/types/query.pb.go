@@ -15,21 +15,25 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	migration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 	types2 "github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -766,6 +770,268 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
+// =============================== PendingWeightChange
+type QueryPendingWeightChangeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPendingWeightChangeRequest) Reset()         { *m = QueryPendingWeightChangeRequest{} }
+func (m *QueryPendingWeightChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWeightChangeRequest) ProtoMessage()    {}
+func (*QueryPendingWeightChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{16}
+}
+func (m *QueryPendingWeightChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWeightChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWeightChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingWeightChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWeightChangeRequest.Merge(m, src)
+}
+func (m *QueryPendingWeightChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWeightChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWeightChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingWeightChangeRequest proto.InternalMessageInfo
+
+func (m *QueryPendingWeightChangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// smooth_weight_change_params is the pending balancer SmoothWeightChangeParams
+// of the pool, unset if the pool has no pending weight change.
+type QueryPendingWeightChangeResponse struct {
+	SmoothWeightChangeParams *types.Any `protobuf:"bytes,1,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty"`
+}
+
+func (m *QueryPendingWeightChangeResponse) Reset()         { *m = QueryPendingWeightChangeResponse{} }
+func (m *QueryPendingWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWeightChangeResponse) ProtoMessage()    {}
+func (*QueryPendingWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{17}
+}
+func (m *QueryPendingWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWeightChangeResponse.Merge(m, src)
+}
+func (m *QueryPendingWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingWeightChangeResponse proto.InternalMessageInfo
+
+func (m *QueryPendingWeightChangeResponse) GetSmoothWeightChangeParams() *types.Any {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
+// =============================== LiquidityBootstrappingPriceCurve
+type QueryLiquidityBootstrappingPriceCurveRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// num_points is the number of evenly spaced points of the price curve,
+	// including the current time and the sale end time. Zero defaults to 10.
+	NumPoints uint64 `protobuf:"varint,2,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty" yaml:"num_points"`
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Reset() {
+	*m = QueryLiquidityBootstrappingPriceCurveRequest{}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLiquidityBootstrappingPriceCurveRequest) ProtoMessage() {}
+func (*QueryLiquidityBootstrappingPriceCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.Merge(m, src)
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) GetNumPoints() uint64 {
+	if m != nil {
+		return m.NumPoints
+	}
+	return 0
+}
+
+type QueryLiquidityBootstrappingPriceCurveResponse struct {
+	SaleDenom string `protobuf:"bytes,1,opt,name=sale_denom,json=saleDenom,proto3" json:"sale_denom,omitempty" yaml:"sale_denom"`
+	// quote_denom is the denom the price of the sale denom is quoted in.
+	QuoteDenom string                             `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	PriceCurve []LiquidityBootstrappingPricePoint `protobuf:"bytes,3,rep,name=price_curve,json=priceCurve,proto3" json:"price_curve" yaml:"price_curve"`
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Reset() {
+	*m = QueryLiquidityBootstrappingPriceCurveResponse{}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLiquidityBootstrappingPriceCurveResponse) ProtoMessage() {}
+func (*QueryLiquidityBootstrappingPriceCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.Merge(m, src)
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityBootstrappingPriceCurveResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) GetSaleDenom() string {
+	if m != nil {
+		return m.SaleDenom
+	}
+	return ""
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) GetPriceCurve() []LiquidityBootstrappingPricePoint {
+	if m != nil {
+		return m.PriceCurve
+	}
+	return nil
+}
+
+// LiquidityBootstrappingPricePoint is the price of the sale denom of a
+// liquidity bootstrapping pool at a point in time.
+type LiquidityBootstrappingPricePoint struct {
+	Time      time.Time                   `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price" yaml:"spot_price"`
+}
+
+func (m *LiquidityBootstrappingPricePoint) Reset()         { *m = LiquidityBootstrappingPricePoint{} }
+func (m *LiquidityBootstrappingPricePoint) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBootstrappingPricePoint) ProtoMessage()    {}
+func (*LiquidityBootstrappingPricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
+}
+func (m *LiquidityBootstrappingPricePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBootstrappingPricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBootstrappingPricePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBootstrappingPricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBootstrappingPricePoint.Merge(m, src)
+}
+func (m *LiquidityBootstrappingPricePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBootstrappingPricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBootstrappingPricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBootstrappingPricePoint proto.InternalMessageInfo
+
+func (m *LiquidityBootstrappingPricePoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // =============================== PoolLiquidity
 // Deprecated: please use the alternative in x/poolmanager
 //
@@ -778,7 +1044,7 @@ func (m *QueryTotalPoolLiquidityRequest) Reset()         { *m = QueryTotalPoolLi
 func (m *QueryTotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{16}
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
 }
 func (m *QueryTotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +1091,7 @@ func (m *QueryTotalPoolLiquidityResponse) Reset()         { *m = QueryTotalPoolL
 func (m *QueryTotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{17}
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
 func (m *QueryTotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1136,7 @@ func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest
 func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesRequest) ProtoMessage()    {}
 func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
 func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1180,7 @@ func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesRespon
 func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSharesResponse) ProtoMessage()    {}
 func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
 func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,7 +1226,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJ
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{20}
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1278,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalc
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{21}
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1330,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{22}
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1134,7 +1400,7 @@ func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilte
 func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
 func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{23}
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
 func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1460,7 @@ func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilt
 func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
 func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{24}
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
 func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1516,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{25}
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1566,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{26}
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1632,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,7 +1675,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{28}
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,7 +1741,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{29}
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1511,7 +1777,7 @@ func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityR
 func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{30}
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
 }
 func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,7 +1814,7 @@ func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidity
 func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{31}
+	return fileDescriptor_d9a717df9ca609ef, []int{36}
 }
 func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +1863,7 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) String() string {
 }
 func (*QueryConcentratedPoolIdLinkFromCFMMRequest) ProtoMessage() {}
 func (*QueryConcentratedPoolIdLinkFromCFMMRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{32}
+	return fileDescriptor_d9a717df9ca609ef, []int{37}
 }
 func (m *QueryConcentratedPoolIdLinkFromCFMMRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1645,7 +1911,7 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) String() string {
 }
 func (*QueryConcentratedPoolIdLinkFromCFMMResponse) ProtoMessage() {}
 func (*QueryConcentratedPoolIdLinkFromCFMMResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{33}
+	return fileDescriptor_d9a717df9ca609ef, []int{38}
 }
 func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1955,7 @@ func (m *QueryCFMMConcentratedPoolLinksRequest) Reset()         { *m = QueryCFMM
 func (m *QueryCFMMConcentratedPoolLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCFMMConcentratedPoolLinksRequest) ProtoMessage()    {}
 func (*QueryCFMMConcentratedPoolLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{34}
+	return fileDescriptor_d9a717df9ca609ef, []int{39}
 }
 func (m *QueryCFMMConcentratedPoolLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1728,7 +1994,7 @@ func (m *QueryCFMMConcentratedPoolLinksResponse) Reset() {
 func (m *QueryCFMMConcentratedPoolLinksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCFMMConcentratedPoolLinksResponse) ProtoMessage()    {}
 func (*QueryCFMMConcentratedPoolLinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{35}
+	return fileDescriptor_d9a717df9ca609ef, []int{40}
 }
 func (m *QueryCFMMConcentratedPoolLinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,6 +2047,11 @@ func init() {
 	proto.RegisterType((*QueryCalcExitPoolCoinsFromSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcExitPoolCoinsFromSharesResponse")
 	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsRequest")
 	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryPendingWeightChangeRequest)(nil), "osmosis.gamm.v1beta1.QueryPendingWeightChangeRequest")
+	proto.RegisterType((*QueryPendingWeightChangeResponse)(nil), "osmosis.gamm.v1beta1.QueryPendingWeightChangeResponse")
+	proto.RegisterType((*QueryLiquidityBootstrappingPriceCurveRequest)(nil), "osmosis.gamm.v1beta1.QueryLiquidityBootstrappingPriceCurveRequest")
+	proto.RegisterType((*QueryLiquidityBootstrappingPriceCurveResponse)(nil), "osmosis.gamm.v1beta1.QueryLiquidityBootstrappingPriceCurveResponse")
+	proto.RegisterType((*LiquidityBootstrappingPricePoint)(nil), "osmosis.gamm.v1beta1.LiquidityBootstrappingPricePoint")
 	proto.RegisterType((*QueryTotalPoolLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityRequest")
 	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
 	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
@@ -1806,142 +2077,162 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 2149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5d, 0x6c, 0x1c, 0x57,
-	0x15, 0xf6, 0x38, 0x8e, 0x6b, 0x9f, 0xc4, 0x7f, 0xb7, 0x4e, 0xb3, 0x5e, 0x3b, 0xbb, 0xe9, 0x6d,
-	0x6a, 0xbb, 0x89, 0xbd, 0x6b, 0x3b, 0x2e, 0x2d, 0x26, 0x69, 0x63, 0xbb, 0x76, 0xe2, 0x28, 0x8e,
-	0xd3, 0x49, 0x25, 0x04, 0x08, 0x46, 0xe3, 0xdd, 0xf1, 0x7a, 0xea, 0x9d, 0x99, 0xcd, 0xce, 0x9d,
-	0xc6, 0x56, 0x15, 0x55, 0x02, 0x09, 0xb5, 0xbc, 0x14, 0x09, 0xe8, 0x13, 0x82, 0x97, 0x0a, 0x01,
-	0xcf, 0x48, 0x3c, 0xf1, 0x80, 0x78, 0x89, 0x78, 0x8a, 0x80, 0x07, 0xc4, 0xc3, 0x82, 0x12, 0xe0,
-	0x1d, 0xbf, 0xf0, 0x8a, 0xee, 0xbd, 0x67, 0x7e, 0x76, 0x77, 0x3c, 0xfb, 0x53, 0x45, 0x2a, 0x4f,
-	0xde, 0xb9, 0xf7, 0xfc, 0x7c, 0xe7, 0x9c, 0x7b, 0xce, 0x3d, 0xf7, 0x18, 0x2e, 0x3a, 0xae, 0xe5,
-	0xb8, 0xa6, 0x9b, 0x2f, 0xe9, 0x96, 0x95, 0xff, 0x60, 0x71, 0xd7, 0x60, 0xfa, 0x62, 0xfe, 0x81,
-	0x67, 0x54, 0x8f, 0x72, 0x95, 0xaa, 0xc3, 0x1c, 0x32, 0x8e, 0x14, 0x39, 0x4e, 0x91, 0x43, 0x8a,
-	0xf4, 0x78, 0xc9, 0x29, 0x39, 0x82, 0x20, 0xcf, 0x7f, 0x49, 0xda, 0xf4, 0x85, 0x58, 0x69, 0xec,
-	0x10, 0xb7, 0xe7, 0xfc, 0xed, 0x8a, 0xe3, 0x94, 0x2d, 0xdd, 0xd6, 0x4b, 0x46, 0x35, 0xa0, 0x72,
-	0x1f, 0xea, 0x15, 0xad, 0xea, 0x78, 0xcc, 0x40, 0xea, 0x4c, 0x41, 0x90, 0xe7, 0x77, 0x75, 0xd7,
-	0x08, 0xa8, 0x0a, 0x8e, 0x69, 0xe3, 0xfe, 0xe5, 0xe8, 0xbe, 0x40, 0x1c, 0x50, 0x55, 0xf4, 0x92,
-	0x69, 0xeb, 0xcc, 0x74, 0x7c, 0xda, 0xa9, 0x92, 0xe3, 0x94, 0xca, 0x46, 0x5e, 0xaf, 0x98, 0x79,
-	0xdd, 0xb6, 0x1d, 0x26, 0x36, 0x5d, 0xdc, 0x9d, 0xc0, 0x5d, 0xf1, 0xb5, 0xeb, 0xed, 0xe5, 0x75,
-	0xfb, 0xc8, 0xdf, 0x92, 0x4a, 0x34, 0x69, 0xaa, 0xfc, 0xc0, 0xad, 0x97, 0x63, 0x8d, 0x75, 0xf7,
-	0xf5, 0xaa, 0x51, 0x4c, 0x24, 0xa9, 0xe8, 0x55, 0xdd, 0x42, 0x29, 0x74, 0x04, 0x86, 0xee, 0x89,
-	0x6f, 0xd5, 0x78, 0xe0, 0x19, 0x2e, 0xa3, 0x77, 0x60, 0xd8, 0x5f, 0x70, 0x2b, 0x8e, 0xed, 0x1a,
-	0x64, 0x05, 0xfa, 0x25, 0x4b, 0x4a, 0xb9, 0xa8, 0xcc, 0x9e, 0x59, 0x9a, 0xca, 0xc5, 0x85, 0x24,
-	0x27, 0xb9, 0xd6, 0xfa, 0x1e, 0xd7, 0xb2, 0x3d, 0x2a, 0x72, 0xd0, 0x75, 0x18, 0x7d, 0x97, 0xbb,
-	0xe6, 0x9e, 0xe3, 0x94, 0x51, 0x03, 0xb9, 0x02, 0x2f, 0xf0, 0x00, 0x68, 0x66, 0x51, 0x08, 0xec,
-	0x5b, 0x23, 0xc7, 0xb5, 0xec, 0xf0, 0x91, 0x6e, 0x95, 0x57, 0x28, 0x6e, 0x50, 0xb5, 0x9f, 0xff,
-	0xda, 0x2a, 0xae, 0xf4, 0xa6, 0x14, 0x7a, 0x07, 0xc6, 0x22, 0x42, 0x10, 0xd5, 0x55, 0xe8, 0xe3,
-	0x24, 0x88, 0x69, 0x3c, 0x27, 0x7d, 0x98, 0xf3, 0x7d, 0x98, 0x5b, 0xb5, 0x8f, 0xd6, 0x06, 0xff,
-	0xf8, 0x9b, 0xf9, 0xd3, 0x9c, 0x6b, 0x4b, 0x15, 0xc4, 0x42, 0xda, 0xb7, 0x22, 0xd2, 0x7c, 0xab,
-	0xc9, 0x26, 0x40, 0x18, 0xb4, 0x54, 0xaf, 0x90, 0x39, 0x9d, 0x43, 0x7f, 0xf3, 0x08, 0xe7, 0xe4,
-	0x99, 0x0c, 0x8d, 0x2d, 0x19, 0xc8, 0xab, 0x46, 0x38, 0xe9, 0x8f, 0x15, 0x20, 0x51, 0xe9, 0x08,
-	0xf6, 0x75, 0x38, 0xcd, 0xf5, 0x73, 0x0f, 0x9e, 0x6a, 0x07, 0xad, 0xa4, 0x26, 0x37, 0x63, 0x50,
-	0xcd, 0xb4, 0x44, 0x25, 0x75, 0xd6, 0xc1, 0x4a, 0xc3, 0xb8, 0x40, 0x75, 0xd7, 0xb3, 0xa2, 0x66,
-	0x0b, 0x7f, 0xdc, 0x85, 0x73, 0x0d, 0x7b, 0x08, 0x7a, 0x11, 0x06, 0x6d, 0xcf, 0xd2, 0x7c, 0xe0,
-	0x3c, 0x52, 0xe3, 0xc7, 0xb5, 0xec, 0xa8, 0x8c, 0x54, 0xb0, 0x45, 0xd5, 0x01, 0x1b, 0x59, 0x85,
-	0xbc, 0x75, 0xd4, 0xc5, 0x57, 0xde, 0x3b, 0xaa, 0x18, 0xdd, 0x84, 0x9d, 0xde, 0x46, 0x50, 0xa1,
-	0x90, 0x10, 0x94, 0x20, 0x66, 0x47, 0x15, 0x43, 0xc8, 0x19, 0x8c, 0x82, 0x0a, 0xb6, 0xa8, 0x3a,
-	0x50, 0x41, 0x56, 0xfa, 0x5b, 0x05, 0x32, 0x42, 0xd8, 0xba, 0x5e, 0x2e, 0xdc, 0x76, 0x4c, 0x9b,
-	0x0b, 0xbd, 0xcf, 0xf3, 0xc4, 0xed, 0x06, 0x1b, 0xd9, 0x87, 0x41, 0xe6, 0x1c, 0x18, 0xb6, 0xab,
-	0x99, 0x3c, 0x28, 0x3c, 0xa0, 0x13, 0x75, 0x41, 0xf1, 0xc3, 0xb1, 0xee, 0x98, 0xf6, 0xda, 0x02,
-	0xcf, 0x87, 0x5f, 0xff, 0x3d, 0x3b, 0x5b, 0x32, 0xd9, 0xbe, 0xb7, 0x9b, 0x2b, 0x38, 0x16, 0xe6,
-	0x31, 0xfe, 0x99, 0x77, 0x8b, 0x07, 0x79, 0x8e, 0xd9, 0x15, 0x0c, 0xae, 0x3a, 0x20, 0xa5, 0x6f,
-	0xd9, 0xf4, 0x3f, 0x0a, 0x64, 0x4f, 0x44, 0x8e, 0x0e, 0xd9, 0x85, 0x51, 0x91, 0xf3, 0x9a, 0xe3,
-	0x31, 0x4d, 0xb7, 0x1c, 0xcf, 0x66, 0xe8, 0x97, 0x37, 0xb9, 0xe6, 0xbf, 0xd5, 0xb2, 0xe7, 0xa4,
-	0x1e, 0xb7, 0x78, 0x90, 0x33, 0x9d, 0xbc, 0xa5, 0xb3, 0xfd, 0xdc, 0x96, 0xcd, 0x8e, 0x6b, 0xd9,
-	0xf3, 0xd2, 0xc0, 0x46, 0x76, 0xaa, 0x0e, 0x8b, 0xa5, 0x1d, 0x8f, 0xad, 0x8a, 0x05, 0xf2, 0x3e,
-	0x00, 0x5a, 0xec, 0x78, 0xec, 0x79, 0x98, 0x8c, 0x0e, 0xdd, 0xf1, 0x18, 0xfd, 0x44, 0x81, 0x99,
-	0xc0, 0xe6, 0x8d, 0x43, 0x93, 0x71, 0x9b, 0x05, 0xd5, 0x66, 0xd5, 0xb1, 0xea, 0xc3, 0x76, 0xbe,
-	0x21, 0x6c, 0x41, 0x88, 0x36, 0x60, 0x44, 0x5a, 0x65, 0xda, 0xbe, 0x4f, 0x7a, 0x85, 0x4f, 0x2e,
-	0x24, 0xfa, 0x44, 0x1d, 0x12, 0x5c, 0x5b, 0xb6, 0xb4, 0x9b, 0x7e, 0xa6, 0xc0, 0x6c, 0x6b, 0x2c,
-	0x18, 0x88, 0x7a, 0x27, 0x29, 0xcf, 0xd5, 0x49, 0x1b, 0xf0, 0x52, 0x90, 0x1e, 0x75, 0xe5, 0xbb,
-	0xb3, 0x2c, 0xbb, 0x09, 0xe7, 0x9b, 0xc4, 0xa0, 0x35, 0x73, 0x0d, 0x45, 0x3f, 0xb6, 0x64, 0x05,
-	0x65, 0xfe, 0x5d, 0xcc, 0xb0, 0xf7, 0x1c, 0xa6, 0x97, 0xb9, 0xb4, 0x3b, 0xe6, 0x03, 0xcf, 0x2c,
-	0x9a, 0xec, 0xa8, 0xeb, 0xa2, 0xff, 0xb9, 0x7f, 0xf6, 0xe3, 0x64, 0x22, 0xc8, 0x47, 0x30, 0x58,
-	0xf6, 0x17, 0x5b, 0x7b, 0xfc, 0x1d, 0xee, 0xf1, 0xb0, 0x56, 0x04, 0x9c, 0xb4, 0xb3, 0x28, 0x04,
-	0x7c, 0x02, 0xe6, 0x26, 0xba, 0x50, 0xa0, 0xec, 0xbe, 0xa8, 0x50, 0x0f, 0x52, 0xcd, 0x72, 0xd0,
-	0xcc, 0x6f, 0xc0, 0x59, 0xc6, 0x97, 0x35, 0x71, 0x3a, 0xfd, 0x88, 0x24, 0x58, 0x3a, 0x89, 0x96,
-	0xbe, 0x28, 0x95, 0x45, 0x99, 0xa9, 0x7a, 0x86, 0x85, 0x2a, 0xe8, 0xef, 0x14, 0xb8, 0xd4, 0x54,
-	0x61, 0xee, 0x3a, 0xf7, 0x1f, 0xea, 0x95, 0xff, 0x8b, 0x0a, 0xf9, 0x6f, 0x05, 0x5e, 0x6d, 0x81,
-	0x1f, 0x9d, 0xf8, 0x51, 0x67, 0xe9, 0xb9, 0x81, 0x2e, 0x1c, 0xf3, 0x5d, 0xe8, 0xb3, 0xd2, 0x2e,
-	0x73, 0x96, 0x5c, 0x03, 0x90, 0x21, 0xc0, 0x22, 0xda, 0x46, 0x39, 0x1a, 0x94, 0x0c, 0x3c, 0xe3,
-	0x7f, 0xd5, 0x8b, 0x37, 0xe2, 0xfd, 0x8a, 0xc3, 0xee, 0x55, 0xcd, 0x42, 0x57, 0xf7, 0x2a, 0xd9,
-	0x80, 0x51, 0x6e, 0xab, 0xa6, 0xbb, 0xae, 0xc1, 0xb4, 0xa2, 0x61, 0x3b, 0x16, 0x42, 0x99, 0x0c,
-	0x2f, 0x84, 0x46, 0x0a, 0xaa, 0x0e, 0xf3, 0xa5, 0x55, 0xbe, 0xf2, 0x0e, 0x5f, 0x20, 0xb7, 0x60,
-	0xec, 0x81, 0xe7, 0xb0, 0x7a, 0x39, 0xa7, 0x84, 0x9c, 0xa9, 0xe3, 0x5a, 0x36, 0x25, 0xe5, 0x34,
-	0x91, 0x50, 0x75, 0x44, 0xac, 0x45, 0x24, 0xdd, 0x85, 0x33, 0x0f, 0x4d, 0xb6, 0xcf, 0x03, 0xb6,
-	0x69, 0x18, 0xa9, 0xbe, 0x8b, 0xca, 0xec, 0xc0, 0xda, 0xdc, 0x71, 0x2d, 0x3b, 0x2d, 0x65, 0xf0,
-	0x4d, 0x4d, 0xf4, 0xe6, 0x7b, 0x86, 0x41, 0xe7, 0x8a, 0x46, 0xa5, 0x6a, 0x14, 0x74, 0x66, 0x14,
-	0x57, 0x28, 0xab, 0x7a, 0x06, 0x4d, 0x29, 0x6a, 0x54, 0x80, 0xc8, 0xc9, 0xdf, 0x2b, 0x30, 0x19,
-	0x36, 0x61, 0x5f, 0x37, 0xd9, 0xfe, 0xa6, 0x59, 0x66, 0x46, 0xd5, 0xf7, 0xd8, 0x75, 0x18, 0xb2,
-	0x4c, 0x5b, 0x8b, 0x96, 0x0e, 0x8e, 0x3c, 0x75, 0x5c, 0xcb, 0x8e, 0x4b, 0xad, 0x75, 0xdb, 0x54,
-	0x3d, 0x6b, 0x99, 0x76, 0x50, 0x7d, 0xc8, 0x64, 0xb4, 0x05, 0x11, 0xce, 0x0b, 0x9b, 0x8d, 0x86,
-	0x46, 0xf2, 0x54, 0xd7, 0x8d, 0xe4, 0xcf, 0x14, 0x98, 0x8a, 0xb7, 0xe1, 0x4b, 0xd2, 0x52, 0xaa,
-	0x78, 0x05, 0x45, 0xce, 0x23, 0x22, 0x5b, 0x06, 0x70, 0x2b, 0x0e, 0xd3, 0x2a, 0x7c, 0x15, 0x7d,
-	0x7b, 0x2e, 0x4c, 0xa5, 0x70, 0x8f, 0xaa, 0x83, 0xae, 0xcf, 0x2d, 0x02, 0xf7, 0x83, 0x5e, 0xb8,
-	0x20, 0x85, 0x3e, 0xd4, 0x2b, 0x1b, 0x87, 0x7a, 0x01, 0x1b, 0x90, 0x2d, 0xdb, 0x0f, 0xdd, 0x6b,
-	0xd0, 0xef, 0x1a, 0x76, 0xd1, 0xa8, 0xa2, 0xdc, 0xb1, 0xe3, 0x5a, 0x76, 0x08, 0xe5, 0x8a, 0x75,
-	0xaa, 0x22, 0x41, 0x34, 0x2f, 0x7a, 0x5b, 0xe6, 0x45, 0x0e, 0x64, 0x4d, 0xe1, 0x05, 0x4b, 0x9e,
-	0xe3, 0x17, 0x8f, 0x6b, 0xd9, 0x91, 0x48, 0xf2, 0x6b, 0xa6, 0x4d, 0xd5, 0x17, 0xc4, 0xcf, 0x2d,
-	0x9b, 0x7c, 0x1b, 0xfa, 0xc5, 0x5b, 0xd1, 0x4d, 0xf5, 0x09, 0xf7, 0xe7, 0x82, 0x37, 0x51, 0xe4,
-	0x6d, 0x19, 0x38, 0x91, 0x9b, 0x13, 0x58, 0xc2, 0xd9, 0xd6, 0xce, 0x61, 0x79, 0x41, 0xec, 0x52,
-	0x16, 0x55, 0x51, 0xa8, 0x70, 0xc6, 0xc7, 0x7e, 0xdb, 0x1a, 0xe3, 0x8c, 0xb0, 0xf7, 0x93, 0xd8,
-	0xba, 0xee, 0xfd, 0x1a, 0xd9, 0xa9, 0x3a, 0x2c, 0x96, 0x82, 0xde, 0x4f, 0x40, 0xf9, 0xb4, 0x37,
-	0x1e, 0xca, 0x8e, 0xc7, 0x9e, 0x77, 0x60, 0xbe, 0x13, 0x38, 0xfa, 0x94, 0x70, 0x74, 0xbe, 0x4d,
-	0x47, 0x73, 0x68, 0x6d, 0x78, 0x9a, 0xbf, 0x27, 0x02, 0x1f, 0x88, 0xea, 0x53, 0xf7, 0x9e, 0x08,
-	0xb6, 0x28, 0xde, 0x39, 0x3b, 0x9e, 0xf4, 0xc8, 0xf7, 0xfd, 0xee, 0x24, 0xce, 0x23, 0x18, 0x1d,
-	0x0d, 0x46, 0xfc, 0x93, 0x53, 0x1f, 0x9c, 0x37, 0x5a, 0x05, 0xe7, 0xa5, 0xfa, 0x73, 0x17, 0xc4,
-	0x66, 0x08, 0x8f, 0x5f, 0x24, 0x34, 0x53, 0x90, 0x0e, 0xfb, 0x86, 0xc6, 0xae, 0x8b, 0xfe, 0xd4,
-	0xaf, 0x84, 0x8d, 0xdb, 0x5f, 0x8a, 0x06, 0x8a, 0x96, 0xe0, 0xb2, 0xbc, 0xbc, 0x1d, 0xbb, 0x60,
-	0xd8, 0xac, 0xca, 0xeb, 0xba, 0xa8, 0x56, 0xc5, 0x3b, 0xa6, 0x7d, 0xc0, 0x7b, 0xec, 0xf5, 0xcd,
-	0xed, 0x6d, 0xff, 0x88, 0x7d, 0x15, 0xce, 0x16, 0xf6, 0x2c, 0xf9, 0xea, 0x0c, 0x6f, 0xbb, 0xf3,
-	0x61, 0x9f, 0x13, 0xdd, 0xa5, 0x2a, 0xf0, 0x4f, 0x29, 0x8d, 0x6a, 0x70, 0xa5, 0x2d, 0x45, 0xe8,
-	0x96, 0x05, 0x18, 0x2f, 0x44, 0x28, 0xeb, 0x35, 0xaa, 0xa4, 0xd0, 0x24, 0x85, 0xce, 0xf8, 0x6d,
-	0xc8, 0xe6, 0xf6, 0x76, 0xa3, 0x12, 0xae, 0x22, 0x18, 0xaf, 0x3c, 0x82, 0xe9, 0x56, 0x84, 0x08,
-	0xe2, 0x3e, 0x8c, 0x59, 0x66, 0xa9, 0x2a, 0xaa, 0xad, 0x56, 0x35, 0x0a, 0x4e, 0xb5, 0xe8, 0xb7,
-	0x7e, 0xd3, 0xf1, 0x13, 0x98, 0x6d, 0x9f, 0x5c, 0x95, 0xd4, 0xea, 0xa8, 0xd5, 0xb0, 0xb2, 0xf4,
-	0xbd, 0x09, 0x38, 0x2d, 0xf4, 0x93, 0x8f, 0x40, 0x5c, 0x0c, 0x2e, 0x99, 0x89, 0x17, 0xd6, 0x34,
-	0x23, 0x49, 0xcf, 0xb6, 0x26, 0x94, 0xd0, 0xe9, 0x2b, 0xdf, 0xfd, 0xf3, 0x3f, 0x7f, 0xd4, 0x7b,
-	0x81, 0x4c, 0xe6, 0xe3, 0x07, 0x50, 0x42, 0xef, 0xa7, 0x0a, 0x0c, 0xf8, 0x33, 0x07, 0x72, 0x39,
-	0x41, 0x76, 0xc3, 0xd0, 0x22, 0x7d, 0xa5, 0x2d, 0x5a, 0x84, 0x72, 0x59, 0x40, 0x79, 0x99, 0x64,
-	0xe3, 0xa1, 0x04, 0x53, 0x8c, 0x8f, 0x7b, 0x15, 0xf2, 0xb9, 0x02, 0xc3, 0xf5, 0x89, 0x42, 0x16,
-	0x12, 0x74, 0xc5, 0xa6, 0x5c, 0x7a, 0xb1, 0x03, 0x0e, 0xc4, 0x38, 0x2f, 0x30, 0xce, 0x90, 0x57,
-	0xe3, 0x31, 0xca, 0xf6, 0x3d, 0xc8, 0x1a, 0xf2, 0x0b, 0x05, 0x46, 0x1a, 0xba, 0x02, 0xb2, 0xd8,
-	0x2a, 0x36, 0x4d, 0x5d, 0x50, 0x7a, 0xa9, 0x13, 0x16, 0x44, 0x3a, 0x27, 0x90, 0x4e, 0x93, 0x4b,
-	0xf1, 0x48, 0xf7, 0x04, 0x35, 0x26, 0x8c, 0x4b, 0x3e, 0x51, 0xa0, 0x8f, 0x4b, 0x22, 0xd3, 0x2d,
-	0x54, 0xf9, 0x90, 0x66, 0x5a, 0xd2, 0x21, 0x8e, 0x85, 0x64, 0x8f, 0x09, 0xf5, 0xf9, 0x0f, 0x31,
-	0x6d, 0x1f, 0xf1, 0xd8, 0x7e, 0xa6, 0xc0, 0x80, 0x3f, 0x4c, 0x4a, 0x3c, 0x6d, 0x0d, 0x63, 0xab,
-	0xc4, 0xd3, 0xd6, 0x38, 0x9d, 0xa2, 0x8b, 0x02, 0xd7, 0x15, 0xf2, 0xda, 0xc9, 0xb8, 0x44, 0xdb,
-	0x18, 0x62, 0x23, 0x3f, 0x51, 0x20, 0x75, 0xd2, 0xe3, 0x85, 0xac, 0x24, 0x28, 0x6f, 0xf1, 0x62,
-	0x4b, 0x7f, 0xad, 0x2b, 0x5e, 0x34, 0xa4, 0x87, 0xfc, 0x41, 0x01, 0xd2, 0x3c, 0x76, 0x22, 0xcb,
-	0x6d, 0x4a, 0xad, 0xc7, 0xf2, 0x7a, 0x87, 0x5c, 0x88, 0xe2, 0x86, 0x70, 0xe7, 0x0a, 0x79, 0xb3,
-	0xad, 0x30, 0xe7, 0xdf, 0x77, 0x4c, 0x5b, 0xbe, 0x15, 0x0c, 0x7e, 0x23, 0x6b, 0xa6, 0x4d, 0xfe,
-	0xa5, 0xc0, 0x64, 0xc2, 0xf0, 0x86, 0x5c, 0x6f, 0x01, 0x2c, 0x79, 0x00, 0x95, 0x7e, 0xab, 0x5b,
-	0x76, 0x34, 0xf0, 0xa6, 0x30, 0x70, 0x95, 0xbc, 0xdd, 0x9e, 0x81, 0xc6, 0xa1, 0xc9, 0xa4, 0x81,
-	0x72, 0xba, 0x25, 0xfb, 0x02, 0x6e, 0xe7, 0xcf, 0x15, 0x80, 0x70, 0x8a, 0x43, 0xe6, 0x5a, 0x1c,
-	0xda, 0xba, 0x99, 0x51, 0x7a, 0xbe, 0x4d, 0x6a, 0x04, 0xbd, 0x2c, 0x40, 0xe7, 0xc8, 0x5c, 0x7b,
-	0xa0, 0xe5, 0x88, 0x88, 0x3c, 0x56, 0x80, 0x34, 0x8f, 0x72, 0x12, 0xcf, 0xd3, 0x89, 0xd3, 0xa4,
-	0xc4, 0xf3, 0x74, 0xf2, 0xbc, 0x88, 0x6e, 0x08, 0xe4, 0xd7, 0xc8, 0x4a, 0x7b, 0xc8, 0x65, 0xe1,
-	0x15, 0x9f, 0x41, 0xf5, 0xe5, 0xb5, 0xe4, 0x97, 0x0a, 0x9c, 0x89, 0xcc, 0x69, 0xc8, 0x7c, 0x2b,
-	0x34, 0xf5, 0x87, 0x26, 0xd7, 0x2e, 0x39, 0xa2, 0x5e, 0x11, 0xa8, 0x97, 0xc9, 0x52, 0x27, 0xa8,
-	0xe5, 0xe4, 0x80, 0x9f, 0x8b, 0xc1, 0xe0, 0x85, 0x46, 0x92, 0x6a, 0x59, 0xe3, 0x5c, 0x21, 0x3d,
-	0xd7, 0x1e, 0x31, 0x82, 0x7c, 0xa3, 0xc3, 0x43, 0xc1, 0x99, 0xc5, 0xa5, 0xfb, 0x44, 0x81, 0x89,
-	0x0d, 0x97, 0x99, 0x96, 0xce, 0x8c, 0xa6, 0x97, 0x0e, 0xb9, 0x9a, 0x04, 0xe2, 0x84, 0x47, 0x62,
-	0x7a, 0xb9, 0x33, 0x26, 0xb4, 0xe0, 0x96, 0xb0, 0xe0, 0x6d, 0x72, 0x3d, 0xde, 0x82, 0x48, 0x16,
-	0x22, 0xda, 0x7c, 0xa4, 0xd4, 0x04, 0x99, 0xc8, 0x4d, 0xfa, 0x8b, 0x02, 0xe9, 0x13, 0x4c, 0xda,
-	0xf1, 0x18, 0xe9, 0x00, 0x5e, 0xf8, 0xc0, 0x4a, 0x3c, 0xf2, 0x27, 0x3f, 0x42, 0xe8, 0x96, 0xb0,
-	0xea, 0x06, 0x79, 0xeb, 0x0b, 0x58, 0xe5, 0x78, 0x8c, 0x9b, 0xf5, 0x5f, 0x05, 0x32, 0xc9, 0x0d,
-	0x34, 0xb9, 0x91, 0x54, 0x0f, 0xdb, 0x69, 0xf2, 0xd3, 0xab, 0x5f, 0x40, 0x02, 0x9a, 0x7c, 0x4f,
-	0x98, 0x7c, 0x9b, 0xdc, 0x8a, 0x37, 0x39, 0xae, 0xb3, 0xd7, 0xca, 0xa6, 0x7d, 0xa0, 0xed, 0x55,
-	0x1d, 0x4b, 0xe3, 0xaf, 0x86, 0xfc, 0x87, 0xd1, 0xa7, 0xc4, 0x23, 0xf2, 0x27, 0x05, 0x26, 0x4e,
-	0x6c, 0xd8, 0x49, 0xe2, 0x45, 0xdb, 0xe2, 0x3d, 0x90, 0xbe, 0xd6, 0x1d, 0x73, 0x7b, 0xa5, 0x41,
-	0x58, 0xd1, 0x6c, 0x6f, 0x59, 0xc0, 0x3e, 0x82, 0x7e, 0xbc, 0x2d, 0x5e, 0x49, 0xfa, 0x87, 0xae,
-	0x0f, 0xf4, 0x52, 0x32, 0x11, 0x02, 0xba, 0x24, 0x00, 0x65, 0xc8, 0x54, 0x3e, 0xe1, 0x5f, 0xcf,
-	0x6b, 0xb7, 0x1f, 0x3f, 0xcd, 0x28, 0x4f, 0x9e, 0x66, 0x94, 0x7f, 0x3c, 0xcd, 0x28, 0x3f, 0x7c,
-	0x96, 0xe9, 0x79, 0xf2, 0x2c, 0xd3, 0xf3, 0xd7, 0x67, 0x99, 0x9e, 0x6f, 0x2e, 0x44, 0x9e, 0x91,
-	0x28, 0x61, 0xbe, 0xac, 0xef, 0xba, 0x81, 0xb8, 0x0f, 0x96, 0xbe, 0x92, 0x3f, 0x94, 0x42, 0xc5,
-	0xa3, 0x72, 0xb7, 0x5f, 0x0c, 0xbc, 0xae, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xd7, 0xbb,
-	0x06, 0x4a, 0x20, 0x00, 0x00,
+	// 2480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4d, 0x6c, 0x14, 0xc9,
+	0x15, 0xa6, 0x07, 0xaf, 0xd7, 0x7e, 0x06, 0x1b, 0x17, 0x06, 0x0f, 0x6d, 0xe3, 0x61, 0x6b, 0x59,
+	0x9b, 0x05, 0x7b, 0x06, 0x83, 0x81, 0x8d, 0x17, 0x76, 0x61, 0x8c, 0x0d, 0x46, 0xfc, 0x6d, 0x83,
+	0x84, 0x92, 0x28, 0x69, 0xb5, 0x67, 0x9a, 0x71, 0x2f, 0xd3, 0x5d, 0xcd, 0x74, 0x35, 0xb6, 0xb5,
+	0x42, 0x2b, 0xe5, 0x10, 0xed, 0xe6, 0xb2, 0x1b, 0x6d, 0xb2, 0xa7, 0x28, 0xb9, 0xac, 0xa2, 0x24,
+	0xe7, 0x48, 0xb9, 0x24, 0x87, 0x28, 0x39, 0x90, 0x9c, 0x50, 0x92, 0x43, 0x94, 0xc3, 0x6c, 0x04,
+	0x49, 0x0e, 0xb9, 0xc5, 0x97, 0x5c, 0xa3, 0xfa, 0xe9, 0x9e, 0x9e, 0x99, 0x76, 0x4f, 0xcf, 0x20,
+	0xa4, 0xcd, 0xc9, 0xd3, 0x5d, 0xef, 0xbd, 0xfa, 0xbe, 0xf7, 0xaa, 0x5e, 0xbd, 0x7a, 0x6d, 0x38,
+	0x42, 0x3c, 0x9b, 0x78, 0x96, 0x57, 0xa8, 0x18, 0xb6, 0x5d, 0x78, 0x34, 0xbf, 0x66, 0x52, 0x63,
+	0xbe, 0xf0, 0xd0, 0x37, 0x6b, 0x5b, 0x79, 0xb7, 0x46, 0x28, 0x41, 0x63, 0x52, 0x22, 0xcf, 0x24,
+	0xf2, 0x52, 0x42, 0x1d, 0xab, 0x90, 0x0a, 0xe1, 0x02, 0x05, 0xf6, 0x4b, 0xc8, 0xaa, 0x87, 0x63,
+	0xad, 0xd1, 0x4d, 0x39, 0x3c, 0x1b, 0x0c, 0xbb, 0x84, 0x54, 0x6d, 0xc3, 0x31, 0x2a, 0x66, 0x2d,
+	0x94, 0xf2, 0x36, 0x0c, 0x57, 0xaf, 0x11, 0x9f, 0x9a, 0x52, 0x7a, 0xaa, 0xc4, 0xc5, 0x0b, 0x6b,
+	0x86, 0x67, 0x86, 0x52, 0x25, 0x62, 0x39, 0x72, 0xfc, 0x78, 0x74, 0x9c, 0x23, 0x0e, 0xa5, 0x5c,
+	0xa3, 0x62, 0x39, 0x06, 0xb5, 0x48, 0x20, 0x3b, 0x59, 0x21, 0xa4, 0x52, 0x35, 0x0b, 0x86, 0x6b,
+	0x15, 0x0c, 0xc7, 0x21, 0x94, 0x0f, 0x7a, 0x72, 0xf4, 0x90, 0x1c, 0xe5, 0x4f, 0x6b, 0xfe, 0xfd,
+	0x82, 0xe1, 0x48, 0xf6, 0x6a, 0xae, 0x75, 0x88, 0x5a, 0xb6, 0xe9, 0x51, 0xc3, 0x76, 0x03, 0x5d,
+	0x81, 0x42, 0x17, 0xbe, 0x10, 0x0f, 0x72, 0xe8, 0xb5, 0x58, 0x6f, 0x78, 0xeb, 0x46, 0xcd, 0x2c,
+	0x27, 0x8a, 0xb8, 0x46, 0xcd, 0xb0, 0xa5, 0x15, 0x3c, 0x02, 0x7b, 0x6f, 0xf3, 0x67, 0xcd, 0x7c,
+	0xe8, 0x9b, 0x1e, 0xc5, 0xd7, 0x61, 0x38, 0x78, 0xe1, 0xb9, 0xc4, 0xf1, 0x4c, 0xb4, 0x08, 0xfd,
+	0x42, 0x25, 0xab, 0x1c, 0x51, 0x8e, 0x0d, 0x9d, 0x9a, 0xcc, 0xc7, 0xc5, 0x2c, 0x2f, 0xb4, 0x8a,
+	0x7d, 0x4f, 0xea, 0xb9, 0x5d, 0x9a, 0xd4, 0xc0, 0x4b, 0xb0, 0xef, 0x3d, 0xe6, 0xbb, 0xdb, 0x84,
+	0x54, 0xe5, 0x0c, 0xe8, 0x04, 0xbc, 0xca, 0x22, 0xa4, 0x5b, 0x65, 0x6e, 0xb0, 0xaf, 0x88, 0xb6,
+	0xeb, 0xb9, 0xe1, 0x2d, 0xc3, 0xae, 0x2e, 0x62, 0x39, 0x80, 0xb5, 0x7e, 0xf6, 0x6b, 0xb5, 0xbc,
+	0x98, 0xc9, 0x2a, 0xf8, 0x3a, 0x8c, 0x46, 0x8c, 0x48, 0x54, 0xa7, 0xa1, 0x8f, 0x89, 0x48, 0x4c,
+	0x63, 0x79, 0xe1, 0xc9, 0x7c, 0xe0, 0xc9, 0xfc, 0x25, 0x67, 0xab, 0x38, 0xf8, 0xc7, 0x5f, 0xce,
+	0xbd, 0xc2, 0xb4, 0x56, 0x35, 0x2e, 0xcc, 0xad, 0x7d, 0x33, 0x62, 0x2d, 0x60, 0x8d, 0x56, 0x00,
+	0x1a, 0x51, 0xcd, 0x66, 0xb8, 0xcd, 0xe9, 0xbc, 0xf4, 0x37, 0x5b, 0x02, 0x79, 0xb1, 0x68, 0x1b,
+	0x64, 0x2b, 0xa6, 0xd4, 0xd5, 0x22, 0x9a, 0xf8, 0x07, 0x0a, 0xa0, 0xa8, 0x75, 0x09, 0xf6, 0x0c,
+	0xbc, 0xc2, 0xe6, 0x67, 0x1e, 0xdc, 0x9d, 0x06, 0xad, 0x90, 0x46, 0x57, 0x62, 0x50, 0xcd, 0x74,
+	0x44, 0x25, 0xe6, 0x6c, 0x82, 0xa5, 0xc2, 0x18, 0x47, 0x75, 0xd3, 0xb7, 0xa3, 0xb4, 0xb9, 0x3f,
+	0x6e, 0xc2, 0x81, 0x96, 0x31, 0x09, 0x7a, 0x1e, 0x06, 0x1d, 0xdf, 0xd6, 0x03, 0xe0, 0x2c, 0x52,
+	0x63, 0xdb, 0xf5, 0xdc, 0x3e, 0x11, 0xa9, 0x70, 0x08, 0x6b, 0x03, 0x8e, 0x54, 0xe5, 0xf6, 0x96,
+	0xe4, 0x5c, 0xec, 0xcd, 0xdd, 0x2d, 0xd7, 0xec, 0x25, 0xec, 0xf8, 0x1a, 0x1c, 0x68, 0x31, 0xd2,
+	0x00, 0xc5, 0x85, 0xe9, 0x96, 0x6b, 0x72, 0x3b, 0x83, 0x51, 0x50, 0xe1, 0x10, 0xd6, 0x06, 0x5c,
+	0xa9, 0x8a, 0x7f, 0xa5, 0xc0, 0x14, 0x37, 0xb6, 0x64, 0x54, 0x4b, 0xd7, 0x88, 0xe5, 0x30, 0xa3,
+	0x77, 0xd8, 0x3e, 0xf1, 0x7a, 0xc1, 0x86, 0xd6, 0x61, 0x90, 0x92, 0x07, 0xa6, 0xe3, 0xe9, 0x16,
+	0x0b, 0x0a, 0x0b, 0xe8, 0xa1, 0xa6, 0xa0, 0x04, 0xe1, 0x58, 0x22, 0x96, 0x53, 0x3c, 0xc9, 0xf6,
+	0xc3, 0x2f, 0xbe, 0xcc, 0x1d, 0xab, 0x58, 0x74, 0xdd, 0x5f, 0xcb, 0x97, 0x88, 0x2d, 0xf7, 0xb1,
+	0xfc, 0x33, 0xe7, 0x95, 0x1f, 0x14, 0x18, 0x66, 0x8f, 0x2b, 0x78, 0xda, 0x80, 0xb0, 0xbe, 0xea,
+	0xe0, 0xff, 0x28, 0x90, 0xdb, 0x11, 0xb9, 0x74, 0xc8, 0x1a, 0xec, 0xe3, 0x7b, 0x5e, 0x27, 0x3e,
+	0xd5, 0x0d, 0x9b, 0xf8, 0x0e, 0x95, 0x7e, 0x79, 0x8b, 0xcd, 0xfc, 0xb7, 0x7a, 0xee, 0x80, 0x98,
+	0xc7, 0x2b, 0x3f, 0xc8, 0x5b, 0xa4, 0x60, 0x1b, 0x74, 0x3d, 0xbf, 0xea, 0xd0, 0xed, 0x7a, 0x6e,
+	0x5c, 0x10, 0x6c, 0x55, 0xc7, 0xda, 0x30, 0x7f, 0x75, 0xcb, 0xa7, 0x97, 0xf8, 0x0b, 0xf4, 0x3e,
+	0x80, 0x64, 0x4c, 0x7c, 0xfa, 0x32, 0x28, 0x4b, 0x87, 0xde, 0xf2, 0x29, 0xfe, 0x58, 0x81, 0x99,
+	0x90, 0xf3, 0xf2, 0xa6, 0x45, 0x19, 0x67, 0x2e, 0xb5, 0x52, 0x23, 0x76, 0x73, 0xd8, 0xc6, 0x5b,
+	0xc2, 0x16, 0x86, 0x68, 0x19, 0x46, 0x04, 0x2b, 0xcb, 0x09, 0x7c, 0x92, 0xe1, 0x3e, 0x39, 0x9c,
+	0xe8, 0x13, 0x6d, 0x2f, 0xd7, 0x5a, 0x75, 0x04, 0x6f, 0xfc, 0xb9, 0x02, 0xc7, 0x3a, 0x63, 0x91,
+	0x81, 0x68, 0x76, 0x92, 0xf2, 0x52, 0x9d, 0xb4, 0x0c, 0x07, 0xc3, 0xed, 0xd1, 0x94, 0xbe, 0xbb,
+	0xdb, 0x65, 0x57, 0x60, 0xbc, 0xcd, 0x8c, 0x64, 0x33, 0xdb, 0x92, 0xf4, 0x63, 0x53, 0x56, 0x98,
+	0xe6, 0x6f, 0xca, 0x75, 0x7a, 0xdb, 0x74, 0xca, 0x96, 0x53, 0xb9, 0x67, 0x5a, 0x95, 0x75, 0xba,
+	0xb4, 0x6e, 0x38, 0x95, 0xde, 0xb6, 0xff, 0x06, 0x1c, 0xd9, 0xd9, 0x9e, 0x44, 0x78, 0x07, 0x26,
+	0x3c, 0x9b, 0x10, 0xba, 0xae, 0x6f, 0xf0, 0x61, 0xbd, 0xc4, 0xc7, 0xf5, 0x14, 0xb0, 0xb3, 0x42,
+	0x31, 0x6a, 0x56, 0xd0, 0xc7, 0xdf, 0x57, 0x60, 0x96, 0xcf, 0x7c, 0xdd, 0x7a, 0xe8, 0x5b, 0x65,
+	0x8b, 0x6e, 0x15, 0x09, 0xa1, 0x1e, 0xad, 0x19, 0xae, 0x6b, 0x39, 0x95, 0xdb, 0x35, 0xab, 0x64,
+	0x2e, 0xf9, 0xb5, 0x47, 0x3d, 0xd1, 0x42, 0x0b, 0x00, 0x22, 0x6d, 0x5a, 0x0e, 0xf5, 0xf8, 0x8a,
+	0xec, 0x2b, 0x1e, 0xd8, 0xae, 0xe7, 0x46, 0xa3, 0x29, 0x95, 0x8d, 0x61, 0x6d, 0x90, 0xe7, 0x54,
+	0xfe, 0xfb, 0xb3, 0x0c, 0xcc, 0xa5, 0xc4, 0x24, 0x5d, 0xb3, 0x00, 0xe0, 0x19, 0x55, 0x53, 0x2f,
+	0x9b, 0x0e, 0xb1, 0x65, 0x36, 0x88, 0xcc, 0xd3, 0x18, 0xc3, 0xda, 0x20, 0x7b, 0xb8, 0xcc, 0x7e,
+	0xa3, 0x73, 0x30, 0xf4, 0xd0, 0x27, 0x34, 0x50, 0x13, 0x1b, 0xe6, 0xe0, 0x76, 0x3d, 0x87, 0x84,
+	0x5a, 0x64, 0x10, 0x6b, 0xc0, 0x9f, 0x84, 0xa2, 0x07, 0x43, 0x2e, 0x03, 0xa1, 0x97, 0x18, 0x8a,
+	0xec, 0x6e, 0xbe, 0xf4, 0xcf, 0xc6, 0x57, 0x09, 0x09, 0x1c, 0x38, 0xdd, 0xa2, 0xca, 0xf6, 0x45,
+	0x63, 0xd2, 0x88, 0x61, 0xac, 0x81, 0x1b, 0x72, 0xc5, 0xbf, 0x57, 0xe0, 0x48, 0x27, 0x63, 0xe8,
+	0x0a, 0xf4, 0xb1, 0x8a, 0x4a, 0x2e, 0x06, 0xb5, 0x6d, 0x31, 0xdc, 0x0d, 0xca, 0xad, 0xe2, 0xb8,
+	0x9c, 0x76, 0x48, 0x4c, 0xcb, 0xb4, 0xf0, 0xa7, 0x5f, 0xe6, 0x14, 0x8d, 0x1b, 0x40, 0xf7, 0x00,
+	0x3c, 0x97, 0x50, 0x9d, 0x03, 0xc8, 0x66, 0x9a, 0xf2, 0xeb, 0x44, 0x7b, 0x2e, 0xb9, 0x6e, 0x56,
+	0x8c, 0xd2, 0xd6, 0x65, 0xb3, 0x14, 0x71, 0x7a, 0xa8, 0xce, 0x9c, 0xee, 0x12, 0xca, 0x61, 0xe2,
+	0xf7, 0xe4, 0xd9, 0x74, 0x97, 0x50, 0xa3, 0xca, 0xf6, 0x61, 0xc8, 0xa9, 0xe7, 0x72, 0xe9, 0x8b,
+	0xe0, 0xd4, 0x88, 0xb3, 0x29, 0x57, 0xc8, 0x63, 0x18, 0xac, 0x06, 0x2f, 0x3b, 0xe7, 0xaa, 0xcb,
+	0xd2, 0x39, 0xf2, 0x94, 0x0d, 0x35, 0x71, 0x77, 0xf9, 0x2b, 0xd4, 0xe3, 0x30, 0x57, 0x60, 0xbc,
+	0x81, 0xb2, 0xf7, 0xe3, 0x18, 0xfb, 0x90, 0x6d, 0xb7, 0x23, 0x69, 0x7e, 0x1d, 0xf6, 0x50, 0xf6,
+	0x5a, 0xe7, 0x79, 0x3d, 0x48, 0x0a, 0x09, 0x4c, 0x27, 0x24, 0xd3, 0xfd, 0x72, 0x19, 0x44, 0x94,
+	0xb1, 0x36, 0x44, 0x1b, 0x53, 0xe0, 0xdf, 0x28, 0x70, 0xb4, 0xed, 0x6c, 0xbe, 0x49, 0xee, 0x6c,
+	0x18, 0xee, 0xff, 0x45, 0x6d, 0xf1, 0x2f, 0x05, 0xde, 0xe8, 0x80, 0x5f, 0x3a, 0xf1, 0xc3, 0xee,
+	0x0e, 0xb6, 0x65, 0xe9, 0xc2, 0xd1, 0xc0, 0x85, 0x81, 0x2a, 0xee, 0xf1, 0xb4, 0x43, 0xe7, 0x01,
+	0x44, 0x08, 0x64, 0xf9, 0x91, 0xe2, 0x20, 0x1f, 0x14, 0x0a, 0xec, 0xac, 0xfc, 0x79, 0x46, 0xd6,
+	0x92, 0x77, 0x82, 0x4d, 0xd7, 0x53, 0x64, 0x96, 0x61, 0x1f, 0xe3, 0xaa, 0x1b, 0x9e, 0x67, 0xd2,
+	0xa6, 0x14, 0x39, 0xd1, 0x28, 0xa5, 0x5a, 0x25, 0xb0, 0x36, 0xcc, 0x5e, 0x5d, 0x62, 0x6f, 0x44,
+	0xae, 0xbc, 0x0a, 0xa3, 0x22, 0x8f, 0x46, 0xed, 0xec, 0xe6, 0x76, 0x26, 0xb7, 0xeb, 0xb9, 0x6c,
+	0x34, 0xd5, 0x36, 0x19, 0x1a, 0xe1, 0xef, 0x22, 0x96, 0x6e, 0xc2, 0xd0, 0x86, 0x45, 0xd7, 0x59,
+	0xc0, 0x56, 0x4c, 0x33, 0xdb, 0x77, 0x44, 0x39, 0x36, 0x50, 0x9c, 0xdd, 0xae, 0xe7, 0xa6, 0x85,
+	0x0d, 0x36, 0xa8, 0xf3, 0x6b, 0xef, 0x7d, 0xd3, 0xc4, 0xb3, 0x65, 0xd3, 0xad, 0x99, 0x25, 0x83,
+	0x9a, 0xe5, 0x45, 0x4c, 0x6b, 0xbe, 0x89, 0xb3, 0x8a, 0x16, 0x35, 0xc0, 0xf7, 0xe4, 0x6f, 0x15,
+	0x98, 0x68, 0x5c, 0x5f, 0xee, 0x59, 0x74, 0x7d, 0xc5, 0xaa, 0x52, 0xb3, 0x16, 0x78, 0xec, 0x02,
+	0xec, 0xb5, 0x2d, 0x47, 0x8f, 0xa6, 0x0e, 0x86, 0x3c, 0xbb, 0x5d, 0xcf, 0x8d, 0x89, 0x59, 0x9b,
+	0x86, 0xb1, 0xb6, 0xc7, 0xb6, 0x9c, 0x30, 0xfb, 0xa0, 0x89, 0x68, 0xf1, 0xce, 0x9d, 0xd7, 0x28,
+	0xd3, 0x5b, 0xae, 0x60, 0xbb, 0x7b, 0xbe, 0x82, 0xfd, 0x58, 0x81, 0xc9, 0x78, 0x0e, 0x5f, 0x91,
+	0xcb, 0x98, 0x06, 0x07, 0x5b, 0xd7, 0x63, 0xe4, 0xdc, 0x6e, 0x9c, 0x32, 0xed, 0xe7, 0x76, 0xec,
+	0x11, 0xc2, 0x03, 0xf7, 0xbd, 0x0c, 0x1c, 0x16, 0x46, 0x37, 0x0c, 0x77, 0x79, 0xd3, 0x28, 0xc9,
+	0xd2, 0x7d, 0xd5, 0x09, 0x42, 0xf7, 0x26, 0xf4, 0x7b, 0xa6, 0x53, 0x36, 0x6b, 0xd2, 0xee, 0xe8,
+	0x76, 0x3d, 0xb7, 0x57, 0xda, 0xe5, 0xef, 0xb1, 0x26, 0x05, 0xa2, 0xfb, 0x22, 0xd3, 0x71, 0x5f,
+	0xe4, 0x41, 0xe4, 0x14, 0xdd, 0x12, 0x41, 0x1b, 0x2c, 0xee, 0xdf, 0xae, 0xe7, 0x46, 0x22, 0x9b,
+	0x5f, 0xb7, 0x1c, 0xac, 0xbd, 0xca, 0x7f, 0xae, 0x3a, 0xe8, 0x5b, 0xd0, 0xcf, 0xdb, 0x30, 0x5e,
+	0xb6, 0x8f, 0xbb, 0x3f, 0x1f, 0xd6, 0x09, 0x91, 0xb6, 0x4d, 0xe8, 0x44, 0x46, 0x27, 0x64, 0xc2,
+	0xd4, 0x8a, 0x07, 0x64, 0x7a, 0x91, 0xd8, 0x85, 0x2d, 0xac, 0x49, 0xa3, 0xdc, 0x19, 0x1f, 0x05,
+	0x17, 0xbe, 0x18, 0x67, 0x34, 0x6e, 0x4d, 0x02, 0x5b, 0xcf, 0xb7, 0xa6, 0x56, 0x75, 0xac, 0x0d,
+	0xf3, 0x57, 0xe1, 0xad, 0x89, 0x43, 0xf9, 0x24, 0x13, 0x0f, 0xe5, 0x96, 0x4f, 0x5f, 0x76, 0x60,
+	0xbe, 0x1d, 0x3a, 0x5a, 0x14, 0x64, 0x85, 0x94, 0x8e, 0x66, 0xd0, 0x52, 0x78, 0x9a, 0xdd, 0xc4,
+	0x43, 0x1f, 0x64, 0xfb, 0x5a, 0x6f, 0xe2, 0xe1, 0x10, 0x96, 0x67, 0xce, 0x2d, 0x5f, 0x78, 0xe4,
+	0xbb, 0x41, 0x75, 0x12, 0xe7, 0x11, 0x19, 0x1d, 0x1d, 0x46, 0x82, 0x95, 0xd3, 0x1c, 0x9c, 0x73,
+	0x9d, 0x82, 0x73, 0xb0, 0x79, 0xdd, 0x85, 0xb1, 0xd9, 0x2b, 0x97, 0x5f, 0x24, 0x34, 0x93, 0xa0,
+	0x36, 0xea, 0x86, 0xd6, 0xaa, 0x0b, 0xff, 0x28, 0xc8, 0x84, 0xad, 0xc3, 0x5f, 0x89, 0x02, 0x0a,
+	0x57, 0xe0, 0xb8, 0x38, 0xbc, 0x89, 0x53, 0x32, 0x1d, 0x5a, 0x63, 0x79, 0x9d, 0x67, 0xab, 0xf2,
+	0x75, 0xcb, 0x79, 0xc0, 0x6e, 0xa7, 0x4b, 0x2b, 0x37, 0x6e, 0x04, 0x4b, 0xec, 0x6b, 0xb0, 0xa7,
+	0x74, 0xdf, 0xb6, 0xf5, 0x60, 0xf1, 0x88, 0xd3, 0x6e, 0xbc, 0x51, 0xe7, 0x44, 0x47, 0xb1, 0x06,
+	0xec, 0x51, 0x58, 0xc3, 0x3a, 0x9c, 0x48, 0x35, 0x91, 0x74, 0xcb, 0x49, 0x18, 0x2b, 0x45, 0x24,
+	0x9b, 0x67, 0xd4, 0x50, 0xa9, 0xcd, 0x0a, 0x9e, 0x09, 0xca, 0x90, 0x95, 0x1b, 0x37, 0x5a, 0x27,
+	0x61, 0x53, 0x84, 0x8d, 0xc9, 0xc7, 0x30, 0xdd, 0x49, 0x30, 0xbc, 0x19, 0x8e, 0xda, 0x56, 0xa5,
+	0xc6, 0xb3, 0xad, 0x5e, 0x33, 0x4b, 0xa4, 0x56, 0x0e, 0x4a, 0xbf, 0xe9, 0xf8, 0x5b, 0xc9, 0x8d,
+	0x40, 0x5c, 0x13, 0xd2, 0xda, 0x3e, 0xbb, 0xe5, 0xcd, 0xa9, 0x5f, 0x4f, 0xc2, 0x2b, 0x7c, 0x7e,
+	0xf4, 0x21, 0xf0, 0x83, 0xc1, 0x43, 0x33, 0xf1, 0xc6, 0xda, 0xba, 0x8b, 0xea, 0xb1, 0xce, 0x82,
+	0x02, 0x3a, 0x7e, 0xfd, 0x3b, 0x7f, 0xfe, 0xc7, 0x67, 0x99, 0xc3, 0x68, 0xa2, 0x10, 0xdf, 0xba,
+	0xe5, 0xf3, 0x7e, 0xa2, 0xc0, 0x40, 0xd0, 0xad, 0x43, 0xc7, 0x13, 0x6c, 0xb7, 0xb4, 0xfb, 0xd4,
+	0x13, 0xa9, 0x64, 0x25, 0x94, 0xe3, 0x1c, 0xca, 0x6b, 0x28, 0x17, 0x0f, 0x25, 0xec, 0xff, 0x7d,
+	0x94, 0x51, 0xd0, 0x17, 0x0a, 0x0c, 0x37, 0x6f, 0x14, 0x74, 0x32, 0x61, 0xae, 0xd8, 0x2d, 0xa7,
+	0xce, 0x77, 0xa1, 0x21, 0x31, 0xce, 0x71, 0x8c, 0x33, 0xe8, 0x8d, 0x78, 0x8c, 0xa2, 0x7c, 0x0f,
+	0x77, 0x0d, 0xfa, 0xa9, 0x02, 0x23, 0x2d, 0x55, 0x01, 0x9a, 0xef, 0x14, 0x9b, 0xb6, 0x2a, 0x48,
+	0x3d, 0xd5, 0x8d, 0x8a, 0x44, 0x3a, 0xcb, 0x91, 0x4e, 0xa3, 0xa3, 0xf1, 0x48, 0xef, 0x73, 0x69,
+	0xb9, 0x61, 0x3c, 0xf4, 0xb1, 0x02, 0x7d, 0xcc, 0x12, 0x9a, 0xee, 0x30, 0x55, 0x00, 0x69, 0xa6,
+	0xa3, 0x9c, 0xc4, 0x71, 0x32, 0xd9, 0x63, 0x7c, 0xfa, 0xc2, 0x07, 0x72, 0xdb, 0x3e, 0x66, 0xb1,
+	0xfd, 0x5c, 0x81, 0x81, 0xa0, 0x0d, 0x9b, 0xb8, 0xda, 0x5a, 0x1a, 0xbe, 0xea, 0x89, 0x54, 0xb2,
+	0x12, 0xd7, 0x3c, 0xc7, 0x75, 0x02, 0xbd, 0xb9, 0x33, 0x2e, 0x5e, 0x36, 0x36, 0xb0, 0xa1, 0x1f,
+	0x2a, 0x90, 0xdd, 0xe9, 0xf2, 0x82, 0x16, 0x13, 0x26, 0xef, 0x70, 0x63, 0x53, 0xdf, 0xee, 0x49,
+	0x57, 0x12, 0xd9, 0x85, 0x7e, 0xa7, 0x00, 0x6a, 0x6f, 0xd8, 0xa2, 0x85, 0x94, 0x56, 0x9b, 0xb1,
+	0x9c, 0xe9, 0x52, 0x4b, 0xa2, 0xb8, 0xc8, 0xdd, 0xb9, 0x88, 0xde, 0x4a, 0x15, 0xe6, 0xc2, 0xfb,
+	0xc4, 0x72, 0xc4, 0x5d, 0xc1, 0x64, 0x27, 0xb2, 0x6e, 0x39, 0xe8, 0x9f, 0x0a, 0x4c, 0x24, 0xb4,
+	0x3d, 0xd1, 0x85, 0x0e, 0xc0, 0x92, 0x5b, 0xb7, 0xea, 0x3b, 0xbd, 0xaa, 0x4b, 0x82, 0x57, 0x38,
+	0xc1, 0x4b, 0xe8, 0xdd, 0x74, 0x04, 0xcd, 0x4d, 0x8b, 0x0a, 0x82, 0xa2, 0x2f, 0x2c, 0xea, 0x02,
+	0xc6, 0xf3, 0x27, 0x0a, 0x40, 0xa3, 0xff, 0x89, 0x66, 0x3b, 0x2c, 0xda, 0xa6, 0x6e, 0xab, 0x3a,
+	0x97, 0x52, 0x5a, 0x82, 0x5e, 0xe0, 0xa0, 0xf3, 0x68, 0x36, 0x1d, 0x68, 0xd1, 0xc9, 0x44, 0x4f,
+	0x14, 0x40, 0xed, 0xad, 0x9c, 0xc4, 0xf5, 0xb4, 0x63, 0x37, 0x49, 0x3d, 0xd3, 0xa5, 0x96, 0x44,
+	0xbe, 0xcc, 0x91, 0x9f, 0x47, 0x8b, 0xe9, 0x90, 0x8b, 0xc4, 0xcb, 0x1f, 0xc3, 0xec, 0xcb, 0x72,
+	0xc9, 0xcf, 0x14, 0x18, 0x8a, 0xf4, 0x69, 0xd0, 0x5c, 0x27, 0x34, 0xcd, 0x8b, 0x26, 0x9f, 0x56,
+	0x5c, 0xa2, 0x5e, 0xe4, 0xa8, 0x17, 0xd0, 0xa9, 0x6e, 0x50, 0x8b, 0xce, 0x01, 0x5b, 0x17, 0x83,
+	0xe1, 0x0d, 0x0d, 0x25, 0xe5, 0xb2, 0xd6, 0xbe, 0x82, 0x3a, 0x9b, 0x4e, 0x58, 0x82, 0x3c, 0xd7,
+	0xe5, 0xa2, 0x60, 0xca, 0xfc, 0xd0, 0x7d, 0xaa, 0xc0, 0xa1, 0x65, 0x8f, 0x5a, 0xb6, 0x41, 0xcd,
+	0xb6, 0x9b, 0x0e, 0x3a, 0x9d, 0x04, 0x62, 0x87, 0x4b, 0xa2, 0xba, 0xd0, 0x9d, 0x92, 0x64, 0x70,
+	0x95, 0x33, 0x78, 0x17, 0x5d, 0x88, 0x67, 0x10, 0xd9, 0x85, 0x12, 0x6d, 0x21, 0x92, 0x6a, 0xc2,
+	0x9d, 0xc8, 0x28, 0xfd, 0x45, 0x01, 0x75, 0x07, 0x4a, 0xac, 0x11, 0xd4, 0x05, 0xbc, 0xc6, 0x05,
+	0x4b, 0x3d, 0xd3, 0xa5, 0x96, 0x64, 0xb5, 0xca, 0x59, 0x5d, 0x44, 0xef, 0xbc, 0x00, 0x2b, 0xe2,
+	0x53, 0x46, 0xeb, 0xbf, 0x0a, 0x4c, 0x25, 0x17, 0xd0, 0xe8, 0x62, 0x52, 0x3e, 0x4c, 0x53, 0xe4,
+	0xab, 0x97, 0x5e, 0xc0, 0x82, 0xa4, 0x7c, 0x9b, 0x53, 0xbe, 0x86, 0xae, 0xc6, 0x53, 0x8e, 0xab,
+	0xec, 0xf5, 0xaa, 0xe5, 0x3c, 0xd0, 0xef, 0xd7, 0x88, 0xad, 0xb3, 0x5b, 0x43, 0xe1, 0x83, 0xe8,
+	0x55, 0xe2, 0x31, 0xfa, 0x93, 0x02, 0x87, 0x76, 0x2c, 0xd8, 0x51, 0xe2, 0x41, 0xdb, 0xe1, 0x3e,
+	0xa0, 0x9e, 0xef, 0x4d, 0x39, 0x5d, 0x6a, 0xe0, 0x2c, 0xda, 0xf9, 0x56, 0x39, 0xec, 0x3f, 0x28,
+	0xb0, 0x3f, 0xe6, 0xcb, 0x14, 0x4a, 0x5a, 0x68, 0x3b, 0x7f, 0x19, 0x53, 0xcf, 0x76, 0xab, 0x26,
+	0x29, 0x2c, 0x71, 0x0a, 0x17, 0xd0, 0xdb, 0x29, 0x13, 0x87, 0x30, 0xd5, 0xfc, 0xb5, 0x0c, 0xfd,
+	0x3b, 0xf9, 0x33, 0x0a, 0xff, 0xd6, 0x82, 0x8a, 0x09, 0x08, 0x53, 0x7e, 0x28, 0x53, 0x97, 0x5e,
+	0xc8, 0x86, 0xa4, 0x7c, 0x81, 0x53, 0x3e, 0x87, 0xce, 0xa4, 0xa3, 0x5c, 0x5d, 0x73, 0xf5, 0xc8,
+	0x07, 0x24, 0xb4, 0x05, 0xfd, 0xf2, 0x98, 0x7f, 0x3d, 0xe9, 0x7f, 0x58, 0x02, 0xc8, 0x47, 0x93,
+	0x85, 0x24, 0xa6, 0xa3, 0x1c, 0xd3, 0x14, 0x9a, 0x2c, 0x24, 0xfc, 0xb7, 0x4d, 0xf1, 0xda, 0x93,
+	0x67, 0x53, 0xca, 0xd3, 0x67, 0x53, 0xca, 0xdf, 0x9f, 0x4d, 0x29, 0x9f, 0x3e, 0x9f, 0xda, 0xf5,
+	0xf4, 0xf9, 0xd4, 0xae, 0xbf, 0x3e, 0x9f, 0xda, 0xf5, 0x8d, 0x93, 0x91, 0xfb, 0xbf, 0xb4, 0x30,
+	0x57, 0x35, 0xd6, 0xbc, 0xd0, 0xdc, 0xa3, 0x53, 0x67, 0x0b, 0x9b, 0xc2, 0x28, 0xef, 0x06, 0xac,
+	0xf5, 0xf3, 0x4e, 0xe5, 0xe9, 0xff, 0x0d, 0x00, 0x98, 0x06, 0x3f, 0xb3, 0x5e, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1991,6 +2282,13 @@ type QueryClient interface {
 	// CFMMConcentratedPoolLinks returns migration links between CFMM and
 	// Concentrated pools.
 	CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCFMMConcentratedPoolLinksRequest, opts ...grpc.CallOption) (*QueryCFMMConcentratedPoolLinksResponse, error)
+	// PendingWeightChange returns the smooth weight change schedule of a balancer
+	// pool that has not finished yet, if any.
+	PendingWeightChange(ctx context.Context, in *QueryPendingWeightChangeRequest, opts ...grpc.CallOption) (*QueryPendingWeightChangeResponse, error)
+	// LiquidityBootstrappingPriceCurve returns the price of the sale denom of a
+	// liquidity bootstrapping pool implied by its weights over the rest of the
+	// sale, assuming no further swaps against the pool.
+	LiquidityBootstrappingPriceCurve(ctx context.Context, in *QueryLiquidityBootstrappingPriceCurveRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPriceCurveResponse, error)
 	// Params returns gamm module params.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
@@ -2162,6 +2460,24 @@ func (c *queryClient) CFMMConcentratedPoolLinks(ctx context.Context, in *QueryCF
 	return out, nil
 }
 
+func (c *queryClient) PendingWeightChange(ctx context.Context, in *QueryPendingWeightChangeRequest, opts ...grpc.CallOption) (*QueryPendingWeightChangeResponse, error) {
+	out := new(QueryPendingWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PendingWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityBootstrappingPriceCurve(ctx context.Context, in *QueryLiquidityBootstrappingPriceCurveRequest, opts ...grpc.CallOption) (*QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	out := new(QueryLiquidityBootstrappingPriceCurveResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/LiquidityBootstrappingPriceCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Params", in, out, opts...)
@@ -2208,6 +2524,13 @@ type QueryServer interface {
 	// CFMMConcentratedPoolLinks returns migration links between CFMM and
 	// Concentrated pools.
 	CFMMConcentratedPoolLinks(context.Context, *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error)
+	// PendingWeightChange returns the smooth weight change schedule of a balancer
+	// pool that has not finished yet, if any.
+	PendingWeightChange(context.Context, *QueryPendingWeightChangeRequest) (*QueryPendingWeightChangeResponse, error)
+	// LiquidityBootstrappingPriceCurve returns the price of the sale denom of a
+	// liquidity bootstrapping pool implied by its weights over the rest of the
+	// sale, assuming no further swaps against the pool.
+	LiquidityBootstrappingPriceCurve(context.Context, *QueryLiquidityBootstrappingPriceCurveRequest) (*QueryLiquidityBootstrappingPriceCurveResponse, error)
 	// Params returns gamm module params.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
@@ -2267,6 +2590,12 @@ func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Cont
 func (*UnimplementedQueryServer) CFMMConcentratedPoolLinks(ctx context.Context, req *QueryCFMMConcentratedPoolLinksRequest) (*QueryCFMMConcentratedPoolLinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CFMMConcentratedPoolLinks not implemented")
 }
+func (*UnimplementedQueryServer) PendingWeightChange(ctx context.Context, req *QueryPendingWeightChangeRequest) (*QueryPendingWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWeightChange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityBootstrappingPriceCurve(ctx context.Context, req *QueryLiquidityBootstrappingPriceCurveRequest) (*QueryLiquidityBootstrappingPriceCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityBootstrappingPriceCurve not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2581,6 +2910,42 @@ func _Query_CFMMConcentratedPoolLinks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingWeightChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PendingWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWeightChange(ctx, req.(*QueryPendingWeightChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityBootstrappingPriceCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityBootstrappingPriceCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityBootstrappingPriceCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/LiquidityBootstrappingPriceCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityBootstrappingPriceCurve(ctx, req.(*QueryLiquidityBootstrappingPriceCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -2671,6 +3036,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CFMMConcentratedPoolLinks",
 			Handler:    _Query_CFMMConcentratedPoolLinks_Handler,
 		},
+		{
+			MethodName: "PendingWeightChange",
+			Handler:    _Query_PendingWeightChange_Handler,
+		},
+		{
+			MethodName: "LiquidityBootstrappingPriceCurve",
+			Handler:    _Query_LiquidityBootstrappingPriceCurve_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -3219,7 +3592,7 @@ func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingWeightChangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3229,12 +3602,12 @@ func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingWeightChangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingWeightChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3247,7 +3620,7 @@ func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingWeightChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3257,25 +3630,213 @@ func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceCurve) > 0 {
+		for iNdEx := len(m.PriceCurve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceCurve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SaleDenom) > 0 {
+		i -= len(m.SaleDenom)
+		copy(dAtA[i:], m.SaleDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SaleDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityBootstrappingPricePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityBootstrappingPricePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityBootstrappingPricePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPoolLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
 			dAtA[i] = 0xa
@@ -4189,6 +4750,82 @@ func (m *QueryPoolParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingWeightChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPendingWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.NumPoints != 0 {
+		n += 1 + sovQuery(uint64(m.NumPoints))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SaleDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PriceCurve) > 0 {
+		for _, e := range m.PriceCurve {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityBootstrappingPricePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5827,6 +6464,514 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingWeightChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingWeightChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingWeightChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &types.Any{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPriceCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPoints", wireType)
+			}
+			m.NumPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityBootstrappingPriceCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityBootstrappingPriceCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceCurve = append(m.PriceCurve, LiquidityBootstrappingPricePoint{})
+			if err := m.PriceCurve[len(m.PriceCurve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityBootstrappingPricePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityBootstrappingPricePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityBootstrappingPricePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,8 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+
+	FlagSplittingPolicy = "splitting-policy"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -29,3 +31,11 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
 	return fs
 }
+
+// FlagSetCreateGroup returns flags for creating groups.
+func FlagSetCreateGroup() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplittingPolicy, "ByVolume", "The policy splitting incentives across the pools of the group, ByVolume or ByEpochVolume")
+	return fs
+}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
@@ -146,9 +148,26 @@ func NewCreateGroupCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgCreateGroup](&osmocli.TxCliDesc{
 		Use:   "create-group",
 		Short: "create a group in order to split incentives between pools",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"SplittingPolicy": osmocli.FlagOnlyParser(splittingPolicy),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetCreateGroup()}},
 	})
 }
 
+// splittingPolicy parses the group splitting policy from its name in the splitting policy flag.
+func splittingPolicy(fs *flag.FlagSet) (types.SplittingPolicy, error) {
+	splittingPolicyStr, err := fs.GetString(FlagSplittingPolicy)
+	if err != nil {
+		return types.ByVolume, err
+	}
+	splittingPolicy, ok := types.SplittingPolicy_value[splittingPolicyStr]
+	if !ok {
+		return types.ByVolume, fmt.Errorf("invalid splitting policy %s", splittingPolicyStr)
+	}
+	return types.SplittingPolicy(splittingPolicy), nil
+}
+
 // NewCmdHandleCreateGroupsProposal implements a command handler for the group creation proposal transaction.
 func NewCmdHandleCreateGroupsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -444,7 +444,7 @@ func (k Keeper) distributeSyntheticInternal(
 // - the splitting policy is not supported
 // - a lower level issue arises when syncing weights (e.g. the volume for a linked pool cannot be found under volume-splitting policy)
 func (k Keeper) syncGroupWeights(ctx sdk.Context, group types.Group) error {
-	if group.SplittingPolicy == types.ByVolume {
+	if group.SplittingPolicy == types.ByVolume || group.SplittingPolicy == types.ByEpochVolume {
 		err := k.syncVolumeSplitGroup(ctx, group)
 		// This error implies that there was volume initialized at some point
 		// but has not been updated since the last epoch.
@@ -460,6 +460,8 @@ func (k Keeper) syncGroupWeights(ctx sdk.Context, group types.Group) error {
 }
 
 // calculateGroupWeights calculates the updated weights of the group records based on the pool volumes.
+// Under the volume splitting policy, the weight of a pool is its volume since the last sync, derived from cumulative volume.
+// Under the epoch volume splitting policy, it is its volume during the last poolmanager volume epoch.
 // It returns the updated group and an error if any. It does not mutate the passed in object.
 func (k Keeper) calculateGroupWeights(ctx sdk.Context, group types.Group) (types.Group, error) {
 	totalWeight := zeroInt
@@ -514,10 +516,21 @@ func (k Keeper) calculateGroupWeights(ctx sdk.Context, group types.Group) (types
 			return types.Group{}, types.NoPoolVolumeError{PoolId: poolId}
 		}
 
-		// Update gauge record's weight to new volume - last volume snapshot
-		volumeDelta := cumulativePoolVolume.Sub(gaugeRecord.CumulativeWeight)
-		if volumeDelta.IsNegative() {
-			return types.Group{}, types.CumulativeVolumeDecreasedError{PoolId: poolId, PreviousVolume: gaugeRecord.CumulativeWeight, NewVolume: cumulativePoolVolume}
+		var volumeDelta osmomath.Int
+		switch group.SplittingPolicy {
+		case types.ByVolume:
+			// Update gauge record's weight to new volume - last volume snapshot
+			volumeDelta = cumulativePoolVolume.Sub(gaugeRecord.CumulativeWeight)
+			if volumeDelta.IsNegative() {
+				return types.Group{}, types.CumulativeVolumeDecreasedError{PoolId: poolId, PreviousVolume: gaugeRecord.CumulativeWeight, NewVolume: cumulativePoolVolume}
+			}
+		case types.ByEpochVolume:
+			// Update gauge record's weight to the volume of the last volume epoch.
+			// Incentives are distributed at the end of an epoch, before the next volume epoch starts,
+			// so this is the volume of the epoch that just ended.
+			volumeDelta = k.pmk.GetOsmoVolumeForPoolLastEpochs(ctx, poolId, types.EpochVolumeSplittingNumEpochs)
+		default:
+			return types.Group{}, types.UnsupportedSplittingPolicyError{GroupGaugeId: group.GroupGaugeId, SplittingPolicy: group.SplittingPolicy}
 		}
 
 		// This check implies that there was volume initialized at some point
@@ -1692,6 +1692,87 @@ func (s *KeeperTestSuite) TestCalculateGroupWeights() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCalculateGroupWeights_ByEpochVolume() {
+	const clPoolID uint64 = 1
+	defaultCumulativeVolumes := []osmomath.Int{osmomath.NewInt(1000), osmomath.NewInt(2000)}
+	tests := map[string]struct {
+		cumulativePoolVolumes []osmomath.Int
+		// Volumes of the pools during the previous and the last volume epoch.
+		previousEpochVolumes []osmomath.Int
+		lastEpochVolumes     []osmomath.Int
+
+		expectedWeights []osmomath.Int
+		expectedError   error
+	}{
+		"weights are the volumes of the last epoch": {
+			cumulativePoolVolumes: defaultCumulativeVolumes,
+			previousEpochVolumes:  []osmomath.Int{osmomath.NewInt(500), osmomath.NewInt(100)},
+			lastEpochVolumes:      []osmomath.Int{osmomath.NewInt(30), osmomath.NewInt(70)},
+
+			expectedWeights: []osmomath.Int{osmomath.NewInt(30), osmomath.NewInt(70)},
+		},
+		"no volume during the last epoch for a pool": {
+			cumulativePoolVolumes: defaultCumulativeVolumes,
+			previousEpochVolumes:  []osmomath.Int{osmomath.NewInt(500), osmomath.NewInt(100)},
+			lastEpochVolumes:      []osmomath.Int{osmomath.NewInt(30), osmomath.ZeroInt()},
+
+			expectedError: types.NoVolumeSinceLastSyncError{PoolID: uint64(2)},
+		},
+		"no pool volume at all": {
+			cumulativePoolVolumes: []osmomath.Int{osmomath.NewInt(1000), osmomath.ZeroInt()},
+			previousEpochVolumes:  []osmomath.Int{osmomath.ZeroInt(), osmomath.ZeroInt()},
+			lastEpochVolumes:      []osmomath.Int{osmomath.NewInt(30), osmomath.ZeroInt()},
+
+			expectedError: types.NoPoolVolumeError{PoolId: uint64(2)},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			ik := s.App.IncentivesKeeper
+			bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			clPool := s.PrepareConcentratedPool()
+			s.Require().Equal(clPoolID, clPool.GetId())
+			balPoolId := s.PrepareBalancerPool()
+			poolIds := []uint64{clPool.GetId(), balPoolId}
+
+			s.overwriteVolumes(poolIds, tc.cumulativePoolVolumes)
+			for i, poolId := range poolIds {
+				s.App.PoolManagerKeeper.SetEpochVolume(s.Ctx, poolId, 0, sdk.NewCoins(sdk.NewCoin(bondDenom, tc.previousEpochVolumes[i])))
+			}
+			s.Require().NoError(s.App.PoolManagerKeeper.EpochHooks().BeforeEpochStart(s.Ctx, poolmanagertypes.VolumeEpochIdentifier, 1))
+			for i, poolId := range poolIds {
+				s.App.PoolManagerKeeper.SetEpochVolume(s.Ctx, poolId, 1, sdk.NewCoins(sdk.NewCoin(bondDenom, tc.lastEpochVolumes[i])))
+			}
+
+			groupToSync := withSplittingPolicy(defaultGroup, types.ByEpochVolume)
+
+			// --- System under test ---
+			updatedGroup, err := ik.CalculateGroupWeights(s.Ctx, groupToSync)
+
+			// --- Assertions ---
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(types.ByEpochVolume, updatedGroup.SplittingPolicy)
+			totalWeight := osmomath.ZeroInt()
+			for i, record := range updatedGroup.InternalGaugeInfo.GaugeRecords {
+				s.Require().Equal(groupToSync.InternalGaugeInfo.GaugeRecords[i].GaugeId, record.GaugeId)
+				s.Require().Equal(tc.expectedWeights[i], record.CurrentWeight)
+				s.Require().Equal(tc.cumulativePoolVolumes[i], record.CumulativeWeight)
+				totalWeight = totalWeight.Add(record.CurrentWeight)
+			}
+			s.Require().Equal(totalWeight, updatedGroup.InternalGaugeInfo.TotalWeight)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSyncVolumeSplitGroup() {
 	const clPoolID uint64 = 1
 	tests := map[string]struct {
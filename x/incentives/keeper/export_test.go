@@ -92,7 +92,7 @@ func (k Keeper) ChargeGroupCreationFeeIfNotWhitelisted(ctx sdk.Context, sender s
 }
 
 func (k Keeper) CreateGroupInternal(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (types.Group, error) {
-	return k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs)
+	return k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs, types.ByVolume)
 }
 
 func (k Keeper) CalculateGroupWeights(ctx sdk.Context, group types.Group) (types.Group, error) {
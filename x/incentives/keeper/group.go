@@ -27,13 +27,25 @@ var emptyCoins = sdk.NewCoins()
 // Charges group creation fee, unless incentives module account.
 // Returns nil on success.
 // Returns error if:
+// - the splitting policy is not supported
 // - given pool IDs slice is empty or has 1 pool only
 // - fails to initialize gauge information for every pool ID
 // - fails to send coins from owner to the incentives module for the Group's Gauge
 // - fails to charge group creation fee
 // - fails to set the Group's Gauge to state
 func (k Keeper) CreateGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (uint64, error) {
-	newGroup, err := k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs)
+	return k.CreateGroupWithSplittingPolicy(ctx, coins, numEpochPaidOver, owner, poolIDs, types.ByVolume)
+}
+
+// CreateGroupWithSplittingPolicy creates a new group that splits incentives across its pools
+// according to the given splitting policy. See CreateGroup for details.
+// Returns group gauge ID on success.
+// Returns error if:
+// - the splitting policy is not supported
+// - fails to create Group
+// - fails to sync group weights
+func (k Keeper) CreateGroupWithSplittingPolicy(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, splittingPolicy types.SplittingPolicy) (uint64, error) {
+	newGroup, err := k.createGroup(ctx, coins, numEpochPaidOver, owner, poolIDs, splittingPolicy)
 	if err != nil {
 		return 0, err
 	}
@@ -73,7 +85,7 @@ func (k Keeper) CreateGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 // - fails to create Group
 func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOver uint64, poolIDs []uint64) (uint64, error) {
 	incentivesModuleAddress := k.ak.GetModuleAddress(types.ModuleName)
-	newGroup, err := k.createGroup(ctx, emptyCoins, numEpochPaidOver, incentivesModuleAddress, poolIDs)
+	newGroup, err := k.createGroup(ctx, emptyCoins, numEpochPaidOver, incentivesModuleAddress, poolIDs, types.ByVolume)
 	if err != nil {
 		return 0, err
 	}
@@ -105,7 +117,10 @@ func (k Keeper) CreateGroupAsIncentivesModuleAcc(ctx sdk.Context, numEpochPaidOv
 // - does not persist the group to state
 // - persists group's Gauge to state
 // - does not charge group creation fee if sender is the incentives module account
-func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64) (types.Group, error) {
+func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver uint64, owner sdk.AccAddress, poolIDs []uint64, splittingPolicy types.SplittingPolicy) (types.Group, error) {
+	if err := splittingPolicy.Validate(); err != nil {
+		return types.Group{}, err
+	}
 	if len(poolIDs) == 0 {
 		return types.Group{}, types.ErrNoPoolIDsGiven
 	}
@@ -137,10 +152,7 @@ func (k Keeper) createGroup(ctx sdk.Context, coins sdk.Coins, numEpochPaidOver u
 	newGroup := types.Group{
 		GroupGaugeId:      groupGaugeID,
 		InternalGaugeInfo: initialInternalGaugeInfo,
-		// Note: only Volume splitting exists today.
-		// We allow for other splitting policies to be added in the future
-		// by extending the enum.
-		SplittingPolicy: types.ByVolume,
+		SplittingPolicy:   splittingPolicy,
 	}
 
 	return newGroup, nil
@@ -87,7 +87,7 @@ func (server msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	groupID, err := server.keeper.CreateGroup(ctx, msg.Coins, msg.NumEpochsPaidOver, owner, msg.PoolIds)
+	groupID, err := server.keeper.CreateGroupWithSplittingPolicy(ctx, msg.Coins, msg.NumEpochsPaidOver, owner, msg.PoolIds, msg.SplittingPolicy)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
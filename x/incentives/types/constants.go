@@ -21,4 +21,8 @@ var (
 	// other than zero, the gauge is non-perpetual. Zero is invalid.
 	PerpetualNumEpochsPaidOver = uint64(0)
 	DefaultMinValueForDistr    = sdk.NewCoin(appparams.BaseCoinUnit, sdkmath.NewInt(10000)) // 0.01 OSMO
+
+	// EpochVolumeSplittingNumEpochs is the number of most recent poolmanager volume epochs
+	// whose volume weighs the pools of a group under the epoch volume splitting policy.
+	EpochVolumeSplittingNumEpochs = uint64(1)
 )
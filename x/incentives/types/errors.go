@@ -22,6 +22,14 @@ func (e UnsupportedSplittingPolicyError) Error() string {
 	return fmt.Sprintf("Attempted to sync group gauge (%d) with unsupported splitting policy: %s", e.GroupGaugeId, e.SplittingPolicy)
 }
 
+type InvalidSplittingPolicyError struct {
+	SplittingPolicy SplittingPolicy
+}
+
+func (e InvalidSplittingPolicyError) Error() string {
+	return fmt.Sprintf("Invalid splitting policy: %s", e.SplittingPolicy)
+}
+
 type NoPoolVolumeError struct {
 	PoolId uint64
 }
@@ -71,6 +71,7 @@ type GAMMKeeper interface {
 type PoolManagerKeeper interface {
 	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
 	GetOsmoVolumeForPool(ctx sdk.Context, poolId uint64) osmomath.Int
+	GetOsmoVolumeForPoolLastEpochs(ctx sdk.Context, poolId, numEpochs uint64) osmomath.Int
 	GetPoolModuleAndPool(ctx sdk.Context, poolId uint64) (swapModule poolmanagertypes.PoolModuleI, pool poolmanagertypes.PoolI, err error)
 }
 
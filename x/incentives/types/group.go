@@ -0,0 +1,9 @@
+package types
+
+// Validate returns an error if the splitting policy is not one of the supported policies.
+func (p SplittingPolicy) Validate() error {
+	if _, ok := SplittingPolicy_name[int32(p)]; !ok {
+		return InvalidSplittingPolicyError{SplittingPolicy: p}
+	}
+	return nil
+}
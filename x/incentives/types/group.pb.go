@@ -32,15 +32,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type SplittingPolicy int32
 
 const (
+	// ByVolume splits incentives by the volume generated by each pool since the
+	// last sync, derived from the pool's cumulative volume.
 	ByVolume SplittingPolicy = 0
+	// ByEpochVolume splits incentives by the volume generated by each pool
+	// during the last volume epoch, as bucketed by the poolmanager module.
+	ByEpochVolume SplittingPolicy = 1
 )
 
 var SplittingPolicy_name = map[int32]string{
 	0: "ByVolume",
+	1: "ByEpochVolume",
 }
 
 var SplittingPolicy_value = map[string]int32{
-	"ByVolume": 0,
+	"ByVolume":      0,
+	"ByEpochVolume": 1,
 }
 
 func (x SplittingPolicy) String() string {
@@ -222,6 +229,9 @@ func (m *Group) GetSplittingPolicy() SplittingPolicy {
 // It takes an array of pool IDs to split the incentives across.
 type CreateGroup struct {
 	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// splitting_policy is the policy used to split the incentives across the
+	// pools.
+	SplittingPolicy SplittingPolicy `protobuf:"varint,2,opt,name=splitting_policy,json=splittingPolicy,proto3,enum=osmosis.incentives.SplittingPolicy" json:"splitting_policy,omitempty"`
 }
 
 func (m *CreateGroup) Reset()         { *m = CreateGroup{} }
@@ -264,6 +274,13 @@ func (m *CreateGroup) GetPoolIds() []uint64 {
 	return nil
 }
 
+func (m *CreateGroup) GetSplittingPolicy() SplittingPolicy {
+	if m != nil {
+		return m.SplittingPolicy
+	}
+	return ByVolume
+}
+
 // GroupsWithGauge is a helper struct that stores a group and its
 // associated gauge.
 type GroupsWithGauge struct {
@@ -330,46 +347,47 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/group.proto", fileDescriptor_90cab10cb3a674f3) }
 
 var fileDescriptor_90cab10cb3a674f3 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x36, 0xa5, 0x65, 0x93, 0xe6, 0x8f, 0x03, 0x52, 0x12, 0x09, 0x3b, 0x32, 0x54,
-	0x44, 0x48, 0x78, 0xd5, 0x00, 0x3d, 0xf4, 0x18, 0x90, 0xa2, 0x70, 0x40, 0x95, 0x91, 0x88, 0x04,
-	0x87, 0x68, 0x6d, 0x6f, 0x9c, 0x55, 0x6d, 0xaf, 0xe5, 0x5d, 0x07, 0x72, 0xe2, 0xca, 0x91, 0x47,
-	0x40, 0xe2, 0x45, 0x38, 0xf6, 0xd8, 0x23, 0xaa, 0x44, 0x84, 0x92, 0x0b, 0xe7, 0x3e, 0x01, 0xf2,
-	0xda, 0x26, 0x4d, 0x1b, 0x01, 0x27, 0x7b, 0x76, 0xbe, 0x6f, 0x76, 0x7e, 0xa3, 0x59, 0xa0, 0x52,
-	0xe6, 0x53, 0x46, 0x18, 0x24, 0x81, 0x8d, 0x03, 0x4e, 0xa6, 0x98, 0x41, 0x37, 0xa2, 0x71, 0x68,
-	0x84, 0x11, 0xe5, 0x54, 0x51, 0xb2, 0xbc, 0xb1, 0xca, 0xb7, 0xee, 0xb8, 0xd4, 0xa5, 0x22, 0x0d,
-	0x93, 0xbf, 0x54, 0xd9, 0x52, 0x5d, 0x4a, 0x5d, 0x0f, 0x43, 0x11, 0x59, 0xf1, 0x18, 0x3a, 0x71,
-	0x84, 0x38, 0xa1, 0x41, 0x96, 0xd7, 0xae, 0xe7, 0x39, 0xf1, 0x31, 0xe3, 0xc8, 0x0f, 0xf3, 0x02,
-	0xb6, 0xb8, 0x0b, 0x5a, 0x88, 0x61, 0x38, 0x3d, 0xb4, 0x30, 0x47, 0x87, 0xd0, 0xa6, 0x24, 0x2f,
-	0xd0, 0xcc, 0x5b, 0xf5, 0xa8, 0x7d, 0x1a, 0x87, 0xe2, 0x93, 0x5b, 0x37, 0x51, 0xa0, 0xd8, 0xc5,
-	0x69, 0x5e, 0xff, 0x26, 0x83, 0xda, 0x20, 0xe0, 0x38, 0x0a, 0x90, 0xd7, 0x4f, 0xce, 0x07, 0xc1,
-	0x98, 0x2a, 0x43, 0x50, 0xe2, 0x94, 0x23, 0x6f, 0xf4, 0x1e, 0x13, 0x77, 0xc2, 0x1b, 0x72, 0x5b,
-	0xee, 0xdc, 0xee, 0x3d, 0x3d, 0x9b, 0x6b, 0xd2, 0xc5, 0x5c, 0xbb, 0x9b, 0xb6, 0xc3, 0x9c, 0x53,
-	0x83, 0x50, 0xe8, 0x23, 0x3e, 0x31, 0x06, 0x01, 0xbf, 0x9c, 0x6b, 0xf5, 0x19, 0xf2, 0xbd, 0x63,
-	0xfd, 0xaa, 0x55, 0x37, 0x8b, 0x22, 0x1c, 0x8a, 0x48, 0x31, 0xc1, 0xbe, 0xb8, 0x7d, 0x14, 0x61,
-	0x9b, 0x46, 0x0e, 0x6b, 0x6c, 0xb5, 0xb7, 0x3b, 0xc5, 0xee, 0x43, 0xe3, 0xe6, 0x30, 0x8d, 0xb5,
-	0xb6, 0x4c, 0xa1, 0xef, 0x15, 0x92, 0x16, 0xcc, 0x92, 0xbb, 0x3a, 0x62, 0xfa, 0x0f, 0x19, 0xd4,
-	0x37, 0x68, 0x15, 0x03, 0xec, 0xa5, 0x77, 0x11, 0x47, 0x00, 0x14, 0x7a, 0xf5, 0xcb, 0xb9, 0x56,
-	0x49, 0x7b, 0xcc, 0x33, 0xba, 0xb9, 0x2b, 0x7e, 0x07, 0x8e, 0xf2, 0x02, 0x94, 0xed, 0x38, 0x8a,
-	0x70, 0xc0, 0x73, 0xec, 0x2d, 0x81, 0x7d, 0xef, 0xaf, 0xd8, 0xe6, 0x7e, 0x66, 0xca, 0x08, 0x5f,
-	0x82, 0x9a, 0x1d, 0xfb, 0xb1, 0x87, 0x12, 0x88, 0xbc, 0xd0, 0xf6, 0xff, 0x14, 0xaa, 0xae, 0x7c,
-	0x69, 0xad, 0xe3, 0xc2, 0xaf, 0x2f, 0x9a, 0xac, 0x5f, 0xc8, 0x60, 0xa7, 0x9f, 0x2c, 0x9e, 0xf2,
-	0x00, 0x94, 0xc5, 0x06, 0x8e, 0xd6, 0xb9, 0xcc, 0x92, 0x38, 0xed, 0x67, 0x1c, 0xef, 0x40, 0x9d,
-	0x64, 0xe3, 0xc8, 0x85, 0xc1, 0x98, 0x0a, 0x98, 0x62, 0xf7, 0xe0, 0x9f, 0x93, 0x4e, 0x16, 0x20,
-	0x9b, 0x73, 0x8d, 0xdc, 0xd8, 0x8c, 0x57, 0xa0, 0xca, 0x42, 0x8f, 0x70, 0x4e, 0x02, 0x77, 0x14,
-	0x52, 0x8f, 0xd8, 0x33, 0x41, 0x57, 0xee, 0xde, 0xdf, 0x54, 0xf9, 0x75, 0xae, 0x3d, 0x11, 0x52,
-	0xb3, 0xc2, 0xd6, 0x0f, 0xf4, 0x0e, 0x28, 0x3e, 0x8f, 0x30, 0xe2, 0x38, 0x25, 0x6c, 0x82, 0xbd,
-	0x90, 0x52, 0x6f, 0x44, 0x1c, 0xd6, 0x90, 0xdb, 0xdb, 0x9d, 0x82, 0xb9, 0x9b, 0xc4, 0x03, 0x87,
-	0xe9, 0x1f, 0x41, 0x45, 0x68, 0xd8, 0x90, 0xf0, 0x89, 0x68, 0x48, 0x79, 0x06, 0x76, 0x04, 0xb9,
-	0x18, 0x43, 0xb1, 0xdb, 0xdc, 0xd4, 0x81, 0xf0, 0x64, 0x3c, 0xa9, 0x5a, 0xd8, 0x12, 0x7f, 0x36,
-	0x92, 0xcd, 0xb6, 0x44, 0xf0, 0xc7, 0x96, 0x04, 0x8f, 0x0e, 0x40, 0xe5, 0x1a, 0x8e, 0x52, 0x02,
-	0x7b, 0xbd, 0xd9, 0x1b, 0xea, 0xc5, 0x3e, 0xae, 0x4a, 0xad, 0xc2, 0xa7, 0xaf, 0xaa, 0xd4, 0x3b,
-	0x39, 0x5b, 0xa8, 0xf2, 0xf9, 0x42, 0x95, 0x7f, 0x2e, 0x54, 0xf9, 0xf3, 0x52, 0x95, 0xce, 0x97,
-	0xaa, 0xf4, 0x7d, 0xa9, 0x4a, 0x6f, 0x8f, 0x5c, 0xc2, 0x27, 0xb1, 0x65, 0xd8, 0xd4, 0x87, 0xd9,
-	0x95, 0x8f, 0x3d, 0x64, 0xb1, 0x3c, 0x80, 0xd3, 0xee, 0x11, 0xfc, 0x70, 0xf5, 0xa5, 0xf2, 0x59,
-	0x88, 0x99, 0x75, 0x4b, 0x3c, 0xd5, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x6f, 0x30,
-	0xb6, 0x92, 0x04, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x51, 0x6b, 0xd3, 0x50,
+	0x14, 0x4e, 0xba, 0xce, 0xcd, 0xdb, 0x6e, 0x5d, 0x53, 0x85, 0x6e, 0x60, 0x32, 0xa2, 0x62, 0x11,
+	0xcc, 0x65, 0x55, 0x87, 0xec, 0x31, 0x2a, 0xa5, 0x3e, 0xc8, 0x88, 0x60, 0x41, 0x1f, 0xca, 0x4d,
+	0x72, 0x9b, 0x5e, 0x96, 0xe4, 0x86, 0xdc, 0x9b, 0xba, 0x3e, 0xf9, 0xea, 0xa3, 0x3f, 0x41, 0xf0,
+	0x8f, 0xf8, 0xb8, 0xc7, 0x3d, 0xca, 0xc0, 0x22, 0xed, 0x8b, 0xcf, 0xfb, 0x05, 0x92, 0x9b, 0xc4,
+	0xae, 0x5b, 0x99, 0x82, 0x4f, 0xc9, 0x39, 0xe7, 0xfb, 0xce, 0x39, 0xdf, 0xc7, 0xe1, 0x02, 0x95,
+	0xb2, 0x80, 0x32, 0xc2, 0x20, 0x09, 0x1d, 0x1c, 0x72, 0x32, 0xc2, 0x0c, 0x7a, 0x31, 0x4d, 0x22,
+	0x23, 0x8a, 0x29, 0xa7, 0x8a, 0x92, 0xd7, 0x8d, 0x79, 0x7d, 0xe7, 0x96, 0x47, 0x3d, 0x2a, 0xca,
+	0x30, 0xfd, 0xcb, 0x90, 0x3b, 0xaa, 0x47, 0xa9, 0xe7, 0x63, 0x28, 0x22, 0x3b, 0x19, 0x40, 0x37,
+	0x89, 0x11, 0x27, 0x34, 0xcc, 0xeb, 0xda, 0xe5, 0x3a, 0x27, 0x01, 0x66, 0x1c, 0x05, 0x51, 0xd1,
+	0xc0, 0x11, 0xb3, 0xa0, 0x8d, 0x18, 0x86, 0xa3, 0x3d, 0x1b, 0x73, 0xb4, 0x07, 0x1d, 0x4a, 0x8a,
+	0x06, 0xdb, 0xc5, 0xaa, 0x3e, 0x75, 0x8e, 0x92, 0x48, 0x7c, 0x0a, 0xea, 0x32, 0x15, 0x28, 0xf1,
+	0x70, 0x56, 0xd7, 0xbf, 0xc9, 0xa0, 0xde, 0x0d, 0x39, 0x8e, 0x43, 0xe4, 0x77, 0xd2, 0x7c, 0x37,
+	0x1c, 0x50, 0xa5, 0x07, 0xaa, 0x9c, 0x72, 0xe4, 0xf7, 0x3f, 0x60, 0xe2, 0x0d, 0x79, 0x53, 0xde,
+	0x95, 0x5b, 0x37, 0xcd, 0x27, 0x27, 0x13, 0x4d, 0x3a, 0x9b, 0x68, 0xb7, 0xb3, 0x75, 0x98, 0x7b,
+	0x64, 0x10, 0x0a, 0x03, 0xc4, 0x87, 0x46, 0x37, 0xe4, 0xe7, 0x13, 0xad, 0x31, 0x46, 0x81, 0x7f,
+	0xa0, 0x5f, 0xa4, 0xea, 0x56, 0x45, 0x84, 0x3d, 0x11, 0x29, 0x16, 0xd8, 0x10, 0xd3, 0xfb, 0x31,
+	0x76, 0x68, 0xec, 0xb2, 0x66, 0x69, 0x77, 0xa5, 0x55, 0x69, 0x3f, 0x30, 0xae, 0x9a, 0x69, 0x2c,
+	0xac, 0x65, 0x09, 0xbc, 0x59, 0x4e, 0x57, 0xb0, 0xaa, 0xde, 0x3c, 0xc5, 0xf4, 0x1f, 0x32, 0x68,
+	0x2c, 0xc1, 0x2a, 0x06, 0x58, 0xcf, 0x66, 0x11, 0x57, 0x08, 0x28, 0x9b, 0x8d, 0xf3, 0x89, 0x56,
+	0xcb, 0x76, 0x2c, 0x2a, 0xba, 0xb5, 0x26, 0x7e, 0xbb, 0xae, 0xf2, 0x02, 0x6c, 0x3a, 0x49, 0x1c,
+	0xe3, 0x90, 0x17, 0xb2, 0x4b, 0x42, 0xf6, 0x9d, 0x6b, 0x65, 0x5b, 0x1b, 0x39, 0x29, 0x57, 0xf8,
+	0x0a, 0xd4, 0x9d, 0x24, 0x48, 0x7c, 0x94, 0x8a, 0x28, 0x1a, 0xad, 0xfc, 0x4b, 0xa3, 0xad, 0x39,
+	0x2f, 0xeb, 0x75, 0x50, 0xfe, 0xf5, 0x45, 0x93, 0xf5, 0x33, 0x19, 0xac, 0x76, 0xd2, 0xc3, 0x53,
+	0xee, 0x81, 0x4d, 0x71, 0x81, 0xfd, 0x45, 0x5d, 0x56, 0x55, 0x64, 0x3b, 0xb9, 0x8e, 0xf7, 0xa0,
+	0x41, 0x72, 0x3b, 0x0a, 0x60, 0x38, 0xa0, 0x42, 0x4c, 0xa5, 0x7d, 0xff, 0xaf, 0x4e, 0xa7, 0x07,
+	0x90, 0xfb, 0x5c, 0x27, 0x57, 0x2e, 0xe3, 0x35, 0xd8, 0x62, 0x91, 0x4f, 0x38, 0x27, 0xa1, 0xd7,
+	0x8f, 0xa8, 0x4f, 0x9c, 0xb1, 0x50, 0xb7, 0xd9, 0xbe, 0xbb, 0xac, 0xf3, 0x9b, 0x02, 0x7b, 0x28,
+	0xa0, 0x56, 0x8d, 0x2d, 0x26, 0xf4, 0x63, 0x50, 0x79, 0x1e, 0x63, 0xc4, 0x71, 0xa6, 0x70, 0x1b,
+	0xac, 0x47, 0x94, 0xfa, 0x7d, 0xe2, 0xb2, 0xa6, 0xbc, 0xbb, 0xd2, 0x2a, 0x5b, 0x6b, 0x69, 0xdc,
+	0x75, 0xd9, 0xd2, 0xc9, 0xa5, 0xff, 0x98, 0xfc, 0x11, 0xd4, 0xc4, 0x4c, 0xd6, 0x23, 0x7c, 0x28,
+	0x04, 0x2a, 0x4f, 0xc1, 0xaa, 0x70, 0x52, 0xd8, 0x5a, 0x69, 0x6f, 0x2f, 0xeb, 0x2b, 0x38, 0xb9,
+	0x3f, 0x19, 0x5a, 0xd0, 0x52, 0x7e, 0xb3, 0x74, 0x0d, 0x2d, 0x05, 0xfc, 0xa1, 0xa5, 0xc1, 0xc3,
+	0x67, 0xa0, 0x76, 0x69, 0x49, 0xa5, 0x0a, 0xd6, 0xcd, 0xf1, 0x5b, 0xea, 0x27, 0x01, 0xde, 0x92,
+	0x94, 0x3a, 0xd8, 0x30, 0xc7, 0x2f, 0x23, 0xea, 0x0c, 0xf3, 0x94, 0xbc, 0x53, 0xfe, 0xf4, 0x55,
+	0x95, 0xcc, 0xc3, 0x93, 0xa9, 0x2a, 0x9f, 0x4e, 0x55, 0xf9, 0xe7, 0x54, 0x95, 0x3f, 0xcf, 0x54,
+	0xe9, 0x74, 0xa6, 0x4a, 0xdf, 0x67, 0xaa, 0xf4, 0x6e, 0xdf, 0x23, 0x7c, 0x98, 0xd8, 0x86, 0x43,
+	0x03, 0x98, 0x6f, 0xf1, 0xc8, 0x47, 0x36, 0x2b, 0x02, 0x38, 0x6a, 0xef, 0xc3, 0xe3, 0x8b, 0x8f,
+	0x01, 0x1f, 0x47, 0x98, 0xd9, 0x37, 0xc4, 0x6b, 0xf0, 0xf8, 0xf7, 0x00, 0x5e, 0x0c, 0xdf, 0xfe,
+	0xf5, 0x04, 0x00, 0x00,
 }
 
 func (this *InternalGaugeRecord) Equal(that interface{}) bool {
@@ -560,6 +578,11 @@ func (m *CreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SplittingPolicy != 0 {
+		i = encodeVarintGroup(dAtA, i, uint64(m.SplittingPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PoolIds) > 0 {
 		dAtA3 := make([]byte, len(m.PoolIds)*10)
 		var j2 int
@@ -698,6 +721,9 @@ func (m *CreateGroup) Size() (n int) {
 		}
 		n += 1 + sovGroup(uint64(l)) + l
 	}
+	if m.SplittingPolicy != 0 {
+		n += 1 + sovGroup(uint64(m.SplittingPolicy))
+	}
 	return n
 }
 
@@ -1201,6 +1227,25 @@ func (m *CreateGroup) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplittingPolicy", wireType)
+			}
+			m.SplittingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplittingPolicy |= SplittingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGroup(dAtA[iNdEx:])
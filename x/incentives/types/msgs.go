@@ -162,6 +162,10 @@ func (m MsgCreateGroup) ValidateBasic() error {
 		return errors.New("pool ids should be unique")
 	}
 
+	if err := m.SplittingPolicy.Validate(); err != nil {
+		return err
+	}
+
 	// Temporarily disable non perpetual group creation
 	// https://github.com/osmosis-labs/osmosis/issues/6540
 	if m.NumEpochsPaidOver != PerpetualNumEpochsPaidOver {
@@ -369,6 +369,22 @@ func TestMsgCreateGroup(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "epoch volume splitting policy",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroup) incentivestypes.MsgCreateGroup {
+				msg.SplittingPolicy = incentivestypes.ByEpochVolume
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unsupported splitting policy",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroup) incentivestypes.MsgCreateGroup {
+				msg.SplittingPolicy = incentivestypes.SplittingPolicy(100)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -296,6 +296,9 @@ type MsgCreateGroup struct {
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	// pool_ids are the IDs of pools that the group is comprised of
 	PoolIds []uint64 `protobuf:"varint,4,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// splitting_policy is the policy used to split the incentives across the
+	// pools of the group
+	SplittingPolicy SplittingPolicy `protobuf:"varint,5,opt,name=splitting_policy,json=splittingPolicy,proto3,enum=osmosis.incentives.SplittingPolicy" json:"splitting_policy,omitempty" yaml:"splitting_policy"`
 }
 
 func (m *MsgCreateGroup) Reset()         { *m = MsgCreateGroup{} }
@@ -359,6 +362,13 @@ func (m *MsgCreateGroup) GetPoolIds() []uint64 {
 	return nil
 }
 
+func (m *MsgCreateGroup) GetSplittingPolicy() SplittingPolicy {
+	if m != nil {
+		return m.SplittingPolicy
+	}
+	return ByVolume
+}
+
 type MsgCreateGroupResponse struct {
 	// group_id is the ID of the group that is created from this msg
 	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -416,54 +426,57 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x4f, 0xdb, 0x4c,
-	0x18, 0x8e, 0x93, 0x40, 0xe0, 0x02, 0x9f, 0xc0, 0xe2, 0xfb, 0x30, 0xf9, 0x2a, 0x27, 0x78, 0xa8,
-	0x52, 0xa4, 0xdc, 0x95, 0x20, 0x31, 0xb0, 0x35, 0xa8, 0xaa, 0x32, 0xa0, 0x52, 0x0b, 0xa9, 0x12,
-	0x52, 0x15, 0x5d, 0xec, 0xab, 0x39, 0x11, 0xfb, 0x2c, 0xdf, 0x39, 0xc0, 0xda, 0xb1, 0x13, 0x5b,
-	0xc7, 0xee, 0x9d, 0xf8, 0x33, 0x18, 0x19, 0x3b, 0x54, 0x50, 0xc1, 0x80, 0xba, 0xf2, 0x17, 0x54,
-	0x77, 0xb6, 0xf3, 0x43, 0x85, 0xa6, 0x95, 0xda, 0x25, 0x97, 0xf7, 0x7d, 0xde, 0x7b, 0xfd, 0xde,
-	0xf3, 0x3c, 0x3e, 0x83, 0xff, 0x19, 0xf7, 0x19, 0xa7, 0x1c, 0xd1, 0xc0, 0x21, 0x81, 0xa0, 0x7d,
-	0xc2, 0x91, 0x38, 0x86, 0x61, 0xc4, 0x04, 0xd3, 0xf5, 0x14, 0x84, 0x43, 0xb0, 0xb2, 0xe4, 0x31,
-	0x8f, 0x29, 0x18, 0xc9, 0x7f, 0x49, 0x65, 0x65, 0x11, 0xfb, 0x34, 0x60, 0x48, 0xfd, 0xa6, 0xa9,
-	0xaa, 0xc7, 0x98, 0xd7, 0x23, 0x48, 0x45, 0xdd, 0xf8, 0x2d, 0x12, 0xd4, 0x27, 0x5c, 0x60, 0x3f,
-	0x4c, 0x0b, 0x4c, 0x47, 0xb5, 0x47, 0x5d, 0xcc, 0x09, 0xea, 0xaf, 0x77, 0x89, 0xc0, 0xeb, 0xc8,
-	0x61, 0x34, 0xc8, 0xf0, 0x7b, 0x46, 0xf3, 0x70, 0xec, 0x91, 0x14, 0x5f, 0xc9, 0xf0, 0x1e, 0x73,
-	0x0e, 0xe3, 0x50, 0x2d, 0x29, 0xb4, 0x9c, 0xb6, 0xf6, 0xb9, 0x87, 0xfa, 0xeb, 0x72, 0x49, 0x00,
-	0xeb, 0x4b, 0x01, 0xfc, 0xb3, 0xc3, 0xbd, 0xed, 0x88, 0x60, 0x41, 0x5e, 0xc8, 0x66, 0xfa, 0x2a,
-	0x98, 0xa3, 0xbc, 0x13, 0x92, 0x28, 0x24, 0x22, 0xc6, 0x3d, 0x43, 0xab, 0x69, 0xf5, 0x19, 0xbb,
-	0x4c, 0xf9, 0x6e, 0x96, 0xd2, 0x1f, 0x83, 0x29, 0x76, 0x14, 0x90, 0xc8, 0xc8, 0xd7, 0xb4, 0xfa,
-	0x6c, 0x6b, 0xe1, 0xee, 0xb2, 0x3a, 0x77, 0x82, 0xfd, 0xde, 0x96, 0xa5, 0xd2, 0x96, 0x9d, 0xc0,
-	0x7a, 0x1b, 0xcc, 0xbb, 0x94, 0x8b, 0x88, 0x76, 0x63, 0x41, 0x3a, 0x82, 0x19, 0x85, 0x9a, 0x56,
-	0x2f, 0x37, 0x4d, 0x98, 0xf1, 0x98, 0x4c, 0x0a, 0x5f, 0xc5, 0x24, 0x3a, 0xd9, 0x66, 0x81, 0x4b,
-	0x05, 0x65, 0x41, 0xab, 0x78, 0x7e, 0x59, 0xcd, 0xd9, 0x73, 0xc3, 0xad, 0x7b, 0x4c, 0xc7, 0x60,
-	0x4a, 0x52, 0xc1, 0x8d, 0x62, 0xad, 0x50, 0x2f, 0x37, 0x57, 0x60, 0x72, 0x22, 0x28, 0xc9, 0x82,
-	0x29, 0x59, 0x70, 0x9b, 0xd1, 0xa0, 0xf5, 0x54, 0xee, 0xfe, 0x74, 0x55, 0xad, 0x7b, 0x54, 0x1c,
-	0xc4, 0x5d, 0xe8, 0x30, 0x1f, 0xa5, 0xc7, 0x4f, 0x96, 0x06, 0x77, 0x0f, 0x91, 0x38, 0x09, 0x09,
-	0x57, 0x1b, 0xb8, 0x9d, 0x74, 0xd6, 0x5f, 0x03, 0xc0, 0x05, 0x8e, 0x44, 0x47, 0x0a, 0x63, 0x4c,
-	0xa9, 0x51, 0x2b, 0x30, 0x51, 0x0d, 0x66, 0xaa, 0xc1, 0xbd, 0x4c, 0xb5, 0xd6, 0x23, 0xf9, 0xa0,
-	0xbb, 0xcb, 0xea, 0x42, 0x72, 0xf4, 0x81, 0x9c, 0xd6, 0xe9, 0x55, 0x55, 0xb3, 0x67, 0x55, 0x2f,
-	0x59, 0xad, 0x23, 0xb0, 0x14, 0xc4, 0x7e, 0x87, 0x84, 0xcc, 0x39, 0xe0, 0x9d, 0x10, 0x53, 0xb7,
-	0xc3, 0xfa, 0x24, 0x32, 0xa6, 0x6b, 0x5a, 0xbd, 0x68, 0x2f, 0x06, 0xb1, 0xff, 0x5c, 0x41, 0xbb,
-	0x98, 0xba, 0x2f, 0xfb, 0x24, 0xd2, 0x97, 0x41, 0x29, 0x64, 0xac, 0xd7, 0xa1, 0xae, 0x51, 0x52,
-	0x35, 0xd3, 0x32, 0x6c, 0xbb, 0x5b, 0xf0, 0xdd, 0xed, 0xd9, 0x5a, 0x42, 0xee, 0xfb, 0xdb, 0xb3,
-	0xb5, 0xea, 0x3d, 0x8e, 0x70, 0x94, 0x94, 0x0d, 0x65, 0x0c, 0xcb, 0x00, 0xff, 0x8d, 0xab, 0x6b,
-	0x13, 0x1e, 0xb2, 0x80, 0x13, 0xeb, 0x9b, 0x06, 0xe6, 0x77, 0xb8, 0xf7, 0xcc, 0x75, 0xf7, 0x58,
-	0xa2, 0xfb, 0x40, 0x54, 0xed, 0xe7, 0xa2, 0xae, 0x80, 0x19, 0xd5, 0x5c, 0x4e, 0x97, 0x57, 0xd3,
-	0x95, 0x54, 0xdc, 0x76, 0x75, 0x02, 0x4a, 0x11, 0x39, 0xc2, 0x91, 0xcb, 0x8d, 0xc2, 0x9f, 0x97,
-	0x29, 0xeb, 0xfd, 0x2b, 0x2c, 0x60, 0xd7, 0x6d, 0x08, 0x96, 0xb2, 0xb0, 0x0c, 0xfe, 0x1d, 0x3b,
-	0xea, 0x80, 0x84, 0x0f, 0xf9, 0x51, 0xf7, 0x47, 0x2c, 0x0e, 0x87, 0x3e, 0xd3, 0xfe, 0x9a, 0xcf,
-	0x1e, 0xb2, 0x43, 0xfe, 0x21, 0x3b, 0x0c, 0x94, 0x29, 0x4c, 0x54, 0x26, 0xb5, 0x4d, 0xf2, 0x9a,
-	0x14, 0xed, 0x52, 0xe2, 0x1b, 0xfe, 0x3b, 0xc6, 0x91, 0x34, 0x58, 0x1b, 0xa3, 0xc6, 0x91, 0x99,
-	0x8c, 0x33, 0x25, 0xbf, 0x4c, 0x48, 0xf9, 0xb5, 0x54, 0x7e, 0x19, 0xb7, 0xdd, 0xe6, 0xc7, 0x3c,
-	0x28, 0xec, 0x70, 0x4f, 0x7f, 0x03, 0xca, 0xa3, 0x17, 0x8a, 0x05, 0x7f, 0xbc, 0x36, 0xe1, 0xb8,
-	0x2d, 0x2b, 0x6b, 0x93, 0x6b, 0x06, 0x13, 0xec, 0x03, 0x30, 0x62, 0xdb, 0xd5, 0x07, 0x76, 0x0e,
-	0x4b, 0x2a, 0x4f, 0x26, 0x96, 0x0c, 0x7a, 0x0f, 0x47, 0x57, 0x6e, 0x98, 0x30, 0xba, 0xac, 0x99,
-	0x34, 0xfa, 0x28, 0x79, 0xad, 0xdd, 0xf3, 0x6b, 0x53, 0xbb, 0xb8, 0x36, 0xb5, 0xaf, 0xd7, 0xa6,
-	0x76, 0x7a, 0x63, 0xe6, 0x2e, 0x6e, 0xcc, 0xdc, 0xe7, 0x1b, 0x33, 0xb7, 0xbf, 0x39, 0xe2, 0xa2,
-	0xb4, 0x5f, 0xa3, 0x87, 0xbb, 0x3c, 0x0b, 0x50, 0xbf, 0xb9, 0x89, 0x8e, 0xc7, 0xbe, 0x4a, 0xd2,
-	0x59, 0xdd, 0x69, 0x75, 0x31, 0x6d, 0x7c, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x9f, 0x3c, 0xb6,
-	0xb8, 0x06, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x93, 0x40, 0x60, 0x02, 0x2c, 0x58, 0xec, 0xc6, 0x84, 0x95, 0x1d, 0xbc, 0xd2, 0x2a,
+	0x1b, 0x29, 0xf6, 0x12, 0x24, 0x0e, 0xdc, 0x36, 0x68, 0xb5, 0xca, 0x01, 0x6d, 0xd6, 0x8b, 0x54,
+	0x09, 0xa9, 0xb2, 0x1c, 0x7b, 0x6a, 0x46, 0xd8, 0x1e, 0xcb, 0x33, 0x0e, 0xe4, 0x5a, 0xf5, 0xd4,
+	0x13, 0xff, 0x41, 0xef, 0x3d, 0xf1, 0x67, 0x70, 0xe4, 0xd8, 0x43, 0x15, 0x2a, 0x38, 0xa0, 0x5e,
+	0xf9, 0x0b, 0xaa, 0x19, 0xdb, 0xf9, 0xd1, 0x02, 0x69, 0xa5, 0xf6, 0x92, 0xc9, 0xcc, 0xfb, 0xe6,
+	0x9b, 0xf7, 0xde, 0xf7, 0xcd, 0x18, 0x6c, 0x62, 0xe2, 0x63, 0x82, 0x88, 0x8e, 0x02, 0x1b, 0x06,
+	0x14, 0xf5, 0x21, 0xd1, 0xe9, 0x99, 0x16, 0x46, 0x98, 0x62, 0x51, 0x4c, 0x83, 0xda, 0x38, 0x58,
+	0x5d, 0x77, 0xb1, 0x8b, 0x79, 0x58, 0x67, 0xff, 0x12, 0x64, 0x75, 0xcd, 0xf2, 0x51, 0x80, 0x75,
+	0xfe, 0x9b, 0x2e, 0x29, 0x2e, 0xc6, 0xae, 0x07, 0x75, 0x3e, 0xeb, 0xc5, 0x2f, 0x74, 0x8a, 0x7c,
+	0x48, 0xa8, 0xe5, 0x87, 0x29, 0x40, 0xb6, 0x39, 0xbd, 0xde, 0xb3, 0x08, 0xd4, 0xfb, 0xdb, 0x3d,
+	0x48, 0xad, 0x6d, 0xdd, 0xc6, 0x28, 0xc8, 0xe2, 0x0f, 0xa4, 0xe6, 0x5a, 0xb1, 0x0b, 0x9f, 0x8a,
+	0x47, 0x38, 0xce, 0xf8, 0x37, 0xb2, 0xb8, 0x87, 0xed, 0x93, 0x38, 0xe4, 0x43, 0x1a, 0xaa, 0xa4,
+	0x47, 0xfb, 0xc4, 0xd5, 0xfb, 0xdb, 0x6c, 0x48, 0x02, 0xea, 0xfb, 0x02, 0x58, 0x39, 0x20, 0xee,
+	0x7e, 0x04, 0x2d, 0x0a, 0xff, 0x61, 0x87, 0x89, 0x5b, 0x60, 0x09, 0x11, 0x33, 0x84, 0x51, 0x08,
+	0x69, 0x6c, 0x79, 0x92, 0x50, 0x13, 0xea, 0x0b, 0x46, 0x19, 0x91, 0x6e, 0xb6, 0x24, 0xfe, 0x0e,
+	0xe6, 0xf0, 0x69, 0x00, 0x23, 0x29, 0x5f, 0x13, 0xea, 0x8b, 0xed, 0xd5, 0xfb, 0xa1, 0xb2, 0x34,
+	0xb0, 0x7c, 0x6f, 0x4f, 0xe5, 0xcb, 0xaa, 0x91, 0x84, 0xc5, 0x0e, 0x58, 0x76, 0x10, 0xa1, 0x11,
+	0xea, 0xc5, 0x14, 0x9a, 0x14, 0x4b, 0x85, 0x9a, 0x50, 0x2f, 0xb7, 0x64, 0x2d, 0xeb, 0x73, 0x92,
+	0xa9, 0xf6, 0x5f, 0x0c, 0xa3, 0xc1, 0x3e, 0x0e, 0x1c, 0x44, 0x11, 0x0e, 0xda, 0xc5, 0xcb, 0xa1,
+	0x92, 0x33, 0x96, 0xc6, 0x5b, 0x0f, 0xb1, 0x68, 0x81, 0x39, 0xd6, 0x2a, 0x22, 0x15, 0x6b, 0x85,
+	0x7a, 0xb9, 0xb5, 0xa1, 0x25, 0x15, 0x69, 0xac, 0x99, 0x5a, 0xda, 0x4c, 0x6d, 0x1f, 0xa3, 0xa0,
+	0xfd, 0x27, 0xdb, 0xfd, 0xf6, 0x5a, 0xa9, 0xbb, 0x88, 0x1e, 0xc7, 0x3d, 0xcd, 0xc6, 0xbe, 0x9e,
+	0x96, 0x9f, 0x0c, 0x4d, 0xe2, 0x9c, 0xe8, 0x74, 0x10, 0x42, 0xc2, 0x37, 0x10, 0x23, 0x61, 0x16,
+	0x9f, 0x01, 0x40, 0xa8, 0x15, 0x51, 0x93, 0x09, 0x27, 0xcd, 0xf1, 0x54, 0xab, 0x5a, 0xa2, 0xaa,
+	0x96, 0xa9, 0xaa, 0x1d, 0x66, 0xaa, 0xb6, 0x7f, 0x65, 0x07, 0xdd, 0x0f, 0x95, 0xd5, 0xa4, 0xf4,
+	0x91, 0xdc, 0xea, 0xf9, 0xb5, 0x22, 0x18, 0x8b, 0x9c, 0x8b, 0xa1, 0x45, 0x1d, 0xac, 0x07, 0xb1,
+	0x6f, 0xc2, 0x10, 0xdb, 0xc7, 0xc4, 0x0c, 0x2d, 0xe4, 0x98, 0xb8, 0x0f, 0x23, 0x69, 0xbe, 0x26,
+	0xd4, 0x8b, 0xc6, 0x5a, 0x10, 0xfb, 0x7f, 0xf3, 0x50, 0xd7, 0x42, 0xce, 0xbf, 0x7d, 0x18, 0x89,
+	0x15, 0x50, 0x0a, 0x31, 0xf6, 0x4c, 0xe4, 0x48, 0x25, 0x8e, 0x99, 0x67, 0xd3, 0x8e, 0xb3, 0xa7,
+	0xbd, 0xbc, 0xbb, 0x68, 0x24, 0xcd, 0x7d, 0x7d, 0x77, 0xd1, 0x50, 0x1e, 0x70, 0x84, 0xcd, 0xa5,
+	0x6c, 0x72, 0xe3, 0xa8, 0x12, 0xf8, 0x65, 0x5a, 0x5d, 0x03, 0x92, 0x10, 0x07, 0x04, 0xaa, 0x1f,
+	0x05, 0xb0, 0x7c, 0x40, 0xdc, 0xbf, 0x1c, 0xe7, 0x10, 0x27, 0xba, 0x8f, 0x44, 0x15, 0x9e, 0x16,
+	0x75, 0x03, 0x2c, 0x70, 0x72, 0x96, 0x5d, 0x9e, 0x67, 0x57, 0xe2, 0xf3, 0x8e, 0x23, 0x42, 0x50,
+	0x8a, 0xe0, 0xa9, 0x15, 0x39, 0x44, 0x2a, 0x7c, 0x7f, 0x99, 0x32, 0xee, 0xaf, 0xe9, 0x82, 0xe5,
+	0x38, 0x4d, 0x8a, 0xd3, 0x2e, 0x54, 0xc0, 0xcf, 0x53, 0xa5, 0x8e, 0x9a, 0xf0, 0x6a, 0xca, 0xfd,
+	0xec, 0x2a, 0x8d, 0x7d, 0x26, 0xfc, 0x30, 0x9f, 0x3d, 0x66, 0x87, 0xfc, 0x63, 0x76, 0x18, 0x29,
+	0x53, 0x98, 0xa9, 0x4c, 0x6a, 0x9b, 0xe4, 0x9a, 0x14, 0x8d, 0x52, 0xe2, 0x1b, 0x22, 0x9e, 0x80,
+	0x55, 0x12, 0x7a, 0x88, 0x52, 0x14, 0xb8, 0x66, 0x88, 0x3d, 0x64, 0x0f, 0xb8, 0xc3, 0x57, 0x5a,
+	0xbf, 0x69, 0x5f, 0x3e, 0x7a, 0xda, 0xff, 0x19, 0xb6, 0xcb, 0xa1, 0xed, 0xcd, 0xfb, 0xa1, 0x52,
+	0x49, 0x8e, 0xfc, 0x9c, 0x46, 0x35, 0x7e, 0x22, 0xd3, 0xe8, 0x6f, 0x71, 0x29, 0xeb, 0xb9, 0xba,
+	0x33, 0xe9, 0x52, 0xb6, 0x92, 0x09, 0xc4, 0xbd, 0xc6, 0x16, 0x98, 0xd7, 0x84, 0xd4, 0x6b, 0x6c,
+	0xde, 0x71, 0x5a, 0x6f, 0xf2, 0xa0, 0x70, 0x40, 0x5c, 0xf1, 0x39, 0x28, 0x4f, 0xbe, 0x5e, 0xea,
+	0x43, 0xe5, 0x4c, 0xdf, 0x81, 0x6a, 0x63, 0x36, 0x66, 0x94, 0xc1, 0x11, 0x00, 0x13, 0x77, 0x64,
+	0xeb, 0x91, 0x9d, 0x63, 0x48, 0xf5, 0x8f, 0x99, 0x90, 0x11, 0xf7, 0x38, 0x75, 0x6e, 0xbd, 0x19,
+	0xa9, 0x33, 0x4c, 0xb5, 0x31, 0x1b, 0x93, 0xd1, 0xb7, 0xbb, 0x97, 0x37, 0xb2, 0x70, 0x75, 0x23,
+	0x0b, 0x1f, 0x6e, 0x64, 0xe1, 0xfc, 0x56, 0xce, 0x5d, 0xdd, 0xca, 0xb9, 0x77, 0xb7, 0x72, 0xee,
+	0x68, 0x77, 0xc2, 0xb2, 0x29, 0x5f, 0xd3, 0xb3, 0x7a, 0x24, 0x9b, 0xe8, 0xfd, 0xd6, 0xae, 0x7e,
+	0x36, 0xf5, 0x89, 0x64, 0x36, 0xee, 0xcd, 0xf3, 0x57, 0x70, 0xe7, 0xd3, 0x00, 0xc7, 0x7d, 0x93,
+	0xed, 0x45, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -815,6 +828,11 @@ func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SplittingPolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplittingPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.PoolIds) > 0 {
 		dAtA4 := make([]byte, len(m.PoolIds)*10)
 		var j3 int
@@ -999,6 +1017,9 @@ func (m *MsgCreateGroup) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if m.SplittingPolicy != 0 {
+		n += 1 + sovTx(uint64(m.SplittingPolicy))
+	}
 	return n
 }
 
@@ -1685,6 +1706,25 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplittingPolicy", wireType)
+			}
+			m.SplittingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplittingPolicy |= SplittingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -479,6 +479,42 @@ func (s *KeeperTestSuite) TestLocksLongerThanDurationDenom() {
 	s.Require().Len(locks, 1)
 }
 
+func (s *KeeperTestSuite) TestGetLocksDenomPaginated() {
+	s.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("gamm/pool/1", 10)}
+
+	// lock coins, along with coins of a denom prefixed by the queried denom
+	s.LockTokens(addr1, coins, 2*time.Second)
+	s.LockTokens(addr1, coins, time.Second)
+	s.LockTokens(addr1, coins, time.Second)
+	s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("gamm/pool/10", 10)}, time.Second)
+	_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, 1, nil)
+	s.Require().NoError(err)
+
+	// locks are ordered by duration, then lock id
+	locks, nextKey := s.App.LockupKeeper.GetLocksDenomPaginated(s.Ctx, false, "gamm/pool/1", nil, 1)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(uint64(2), locks[0].ID)
+	s.Require().NotNil(nextKey)
+
+	locks, nextKey = s.App.LockupKeeper.GetLocksDenomPaginated(s.Ctx, false, "gamm/pool/1", nextKey, 1)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(uint64(3), locks[0].ID)
+	s.Require().Nil(nextKey)
+
+	locks, nextKey = s.App.LockupKeeper.GetLocksDenomPaginated(s.Ctx, false, "gamm/pool/1", nil, 10)
+	s.Require().Len(locks, 2)
+	s.Require().Nil(nextKey)
+
+	// unlocking locks are returned separately
+	locks, nextKey = s.App.LockupKeeper.GetLocksDenomPaginated(s.Ctx, true, "gamm/pool/1", nil, 10)
+	s.Require().Len(locks, 1)
+	s.Require().Equal(uint64(1), locks[0].ID)
+	s.Require().Nil(nextKey)
+}
+
 func (s *KeeperTestSuite) TestCreateLock() {
 	s.SetupTest()
 
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/lockup/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -220,6 +221,31 @@ func (k Keeper) GetLocksDenom(ctx sdk.Context, denom string) []types.PeriodLock
 	return k.GetLocksLongerThanDurationDenom(ctx, denom, time.Duration(0))
 }
 
+// GetLocksDenomPaginated returns up to limit locks of the given denom that have or have not started unlocking,
+// ordered by duration and lock ID, starting from the lock at startKey. It also returns the key of the lock to continue
+// from, which is nil once all the locks have been returned.
+func (k Keeper) GetLocksDenomPaginated(ctx sdk.Context, isUnlocking bool, denom string, startKey []byte, limit uint64) ([]types.PeriodLock, []byte) {
+	// The duration prefix excludes the locks of the denoms the given denom is a prefix of.
+	denomPrefix := combineKeys(unlockingPrefix(isUnlocking), types.KeyPrefixDenomLockDuration, []byte(denom), types.KeyPrefixDuration)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), denomPrefix)
+	iterator := store.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	locks := []types.PeriodLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(locks)) == limit {
+			return locks, append([]byte{}, iterator.Key()...)
+		}
+
+		lock, err := k.GetLockByID(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		locks = append(locks, *lock)
+	}
+	return locks, nil
+}
+
 // GetLockedDenom Returns the total amount of denom that are locked.
 func (k Keeper) GetLockedDenom(ctx sdk.Context, denom string, duration time.Duration) osmomath.Int {
 	totalAmtLocked := k.GetPeriodLocksAccumulation(ctx, types.QueryCondition{
@@ -37,7 +37,7 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 }
 
 // AfterCFMMSwap hook is a noop.
-func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
 }
 
 // Distribute coins after minter module allocate assets to pool-incentives module.
@@ -0,0 +1,332 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// routeCandidate is a route found by the best route search along with its estimate.
+type routeCandidate struct {
+	route          []types.SwapAmountInRoute
+	tokenOutAmount osmomath.Int
+	takerFees      sdk.Coins
+}
+
+// routeSearch holds the state of a single best route search. Pools per denom are
+// memoized since ListPoolsByDenom iterates over every pool of every module.
+type routeSearch struct {
+	k                Keeper
+	ctx              sdk.Context
+	tokenOutDenom    string
+	maxHops          int
+	maxPoolsPerDenom int
+
+	poolsByDenom map[string][]types.PoolI
+	candidates   [][]types.SwapAmountInRoute
+}
+
+// BestRoute searches the routes from tokenIn to tokenOutDenom of at most maxHops pools,
+// considering for every denom along the way only the maxPoolsPerDenom active pools with
+// the deepest liquidity in that denom. Every candidate is estimated with taker fees
+// applied and the maxRoutes routes with the highest output are returned. The taker fees
+// are those the given sender would be charged, or the trading pair taker fees if it is nil.
+// If split is true, token in is also greedily split across the returned routes that
+// share no pools, and the split is returned if it beats the best single route.
+// Zero limits are replaced by their defaults.
+func (k Keeper) BestRoute(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops, maxRoutes, maxPoolsPerDenom uint64,
+	split bool,
+) (*queryproto.BestRouteResponse, error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, fmt.Errorf("token in (%s) must be a valid positive coin", tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, fmt.Errorf("token in denom and token out denom must differ, both are (%s)", tokenOutDenom)
+	}
+
+	maxHops, err := bestRouteLimit("max hops", maxHops, types.DefaultBestRouteMaxHops, types.MaxBestRouteMaxHops)
+	if err != nil {
+		return nil, err
+	}
+	maxRoutes, err = bestRouteLimit("max routes", maxRoutes, types.DefaultBestRouteMaxRoutes, types.MaxBestRouteMaxRoutes)
+	if err != nil {
+		return nil, err
+	}
+	maxPoolsPerDenom, err = bestRouteLimit("max pools per denom", maxPoolsPerDenom, types.DefaultBestRouteMaxPoolsPerDenom, types.MaxBestRouteMaxPoolsPerDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	search := &routeSearch{
+		k:                k,
+		ctx:              ctx,
+		tokenOutDenom:    tokenOutDenom,
+		maxHops:          int(maxHops),
+		maxPoolsPerDenom: int(maxPoolsPerDenom),
+		poolsByDenom:     make(map[string][]types.PoolI),
+	}
+	if err := search.findRoutes(tokenIn.Denom, nil, map[string]bool{tokenIn.Denom: true}, map[uint64]bool{}); err != nil {
+		return nil, err
+	}
+
+	var candidates []routeCandidate
+	for _, route := range search.candidates {
+		tokenOutAmount, takerFees, err := k.multihopEstimateOutGivenExactAmountInInternal(ctx, sender, route, tokenIn, true)
+		if err != nil {
+			// Routes that cannot absorb the swap are not candidates.
+			continue
+		}
+		candidates = append(candidates, routeCandidate{route: route, tokenOutAmount: tokenOutAmount, takerFees: takerFees})
+	}
+	if len(candidates) == 0 {
+		return nil, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+
+	sortRouteCandidates(candidates)
+	if uint64(len(candidates)) > maxRoutes {
+		candidates = candidates[:maxRoutes]
+	}
+
+	response := &queryproto.BestRouteResponse{
+		Routes:              make([]queryproto.RouteQuote, 0, len(candidates)),
+		SplitTokenOutAmount: osmomath.ZeroInt(),
+	}
+	for _, candidate := range candidates {
+		response.Routes = append(response.Routes, candidate.quote(tokenIn.Amount))
+	}
+
+	if split {
+		splitQuotes, splitTokenOutAmount := k.estimateBestRouteSplit(ctx, sender, tokenIn, candidates)
+		if len(splitQuotes) > 1 && splitTokenOutAmount.GT(candidates[0].tokenOutAmount) {
+			response.Split = splitQuotes
+			response.SplitTokenOutAmount = splitTokenOutAmount
+		}
+	}
+
+	return response, nil
+}
+
+// bestRouteLimit returns the given limit, or defaultValue if it is zero.
+// Returns error if the limit is above maxValue.
+func bestRouteLimit(name string, value, defaultValue, maxValue uint64) (uint64, error) {
+	if value == 0 {
+		return defaultValue, nil
+	}
+	if value > maxValue {
+		return 0, types.BestRouteLimitExceededError{Limit: name, Value: value, Max: maxValue}
+	}
+	return value, nil
+}
+
+// findRoutes does a depth first search from denom, appending to the search candidates every
+// route ending in the token out denom. Routes never revisit a denom or a pool.
+// The search stops once types.BestRouteMaxCandidates routes have been found.
+func (s *routeSearch) findRoutes(denom string, route []types.SwapAmountInRoute, visitedDenoms map[string]bool, usedPools map[uint64]bool) error {
+	pools, err := s.getPoolsByDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		poolId := pool.GetId()
+		if usedPools[poolId] {
+			continue
+		}
+
+		for _, nextDenom := range pool.GetPoolDenoms(s.ctx) {
+			if len(s.candidates) >= types.BestRouteMaxCandidates {
+				return nil
+			}
+			if visitedDenoms[nextDenom] {
+				continue
+			}
+
+			nextRoute := make([]types.SwapAmountInRoute, len(route), len(route)+1)
+			copy(nextRoute, route)
+			nextRoute = append(nextRoute, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: nextDenom})
+
+			if nextDenom == s.tokenOutDenom {
+				s.candidates = append(s.candidates, nextRoute)
+				continue
+			}
+			if len(nextRoute) >= s.maxHops {
+				continue
+			}
+
+			visitedDenoms[nextDenom] = true
+			usedPools[poolId] = true
+			if err := s.findRoutes(nextDenom, nextRoute, visitedDenoms, usedPools); err != nil {
+				return err
+			}
+			delete(visitedDenoms, nextDenom)
+			delete(usedPools, poolId)
+		}
+	}
+	return nil
+}
+
+// getPoolsByDenom returns the active pools containing denom, sorted by descending liquidity
+// of denom and truncated to the search's max pools per denom.
+// The liquidity of denom is read from the balance of the pool address rather than from the
+// pool module, so that ranking the pools of hub denoms does not load the full liquidity of,
+// or query the contract of, every pool listing them.
+func (s *routeSearch) getPoolsByDenom(denom string) ([]types.PoolI, error) {
+	if pools, ok := s.poolsByDenom[denom]; ok {
+		return pools, nil
+	}
+
+	allPools, err := s.k.ListPoolsByDenom(s.ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]types.PoolI, 0, len(allPools))
+	liquidity := make(map[uint64]osmomath.Int, len(allPools))
+	for _, pool := range allPools {
+		if !pool.IsActive(s.ctx) {
+			continue
+		}
+		pools = append(pools, pool)
+		liquidity[pool.GetId()] = s.k.bankKeeper.GetBalance(s.ctx, pool.GetAddress(), denom).Amount
+	}
+
+	sort.SliceStable(pools, func(i, j int) bool {
+		return liquidity[pools[i].GetId()].GT(liquidity[pools[j].GetId()])
+	})
+	if len(pools) > s.maxPoolsPerDenom {
+		pools = pools[:s.maxPoolsPerDenom]
+	}
+
+	s.poolsByDenom[denom] = pools
+	return pools, nil
+}
+
+// sortRouteCandidates sorts candidates by descending token out amount. Ties are broken by
+// preferring fewer hops, then lower pool ids, so that results are deterministic.
+func sortRouteCandidates(candidates []routeCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if !a.tokenOutAmount.Equal(b.tokenOutAmount) {
+			return a.tokenOutAmount.GT(b.tokenOutAmount)
+		}
+		if len(a.route) != len(b.route) {
+			return len(a.route) < len(b.route)
+		}
+		for hop := range a.route {
+			if a.route[hop].PoolId != b.route[hop].PoolId {
+				return a.route[hop].PoolId < b.route[hop].PoolId
+			}
+		}
+		return false
+	})
+}
+
+// estimateBestRouteSplit greedily splits tokenIn across the given routes that share no pools.
+// Token in is divided into types.BestRouteSplitSteps equal parts, each assigned to the route
+// with the highest marginal output for it. Since the routes are disjoint, each route is
+// estimated independently of the amounts assigned to the others.
+// Returns the quotes of the routes that were assigned an amount and their total output.
+func (k Keeper) estimateBestRouteSplit(ctx sdk.Context, sender sdk.AccAddress, tokenIn sdk.Coin, candidates []routeCandidate) ([]queryproto.RouteQuote, osmomath.Int) {
+	var disjoint []routeCandidate
+	usedPools := map[uint64]bool{}
+	for _, candidate := range candidates {
+		sharesPool := false
+		for _, hop := range candidate.route {
+			if usedPools[hop.PoolId] {
+				sharesPool = true
+				break
+			}
+		}
+		if sharesPool {
+			continue
+		}
+		for _, hop := range candidate.route {
+			usedPools[hop.PoolId] = true
+		}
+		disjoint = append(disjoint, candidate)
+	}
+
+	stepAmount := tokenIn.Amount.QuoRaw(types.BestRouteSplitSteps)
+	if len(disjoint) < 2 || !stepAmount.IsPositive() {
+		return nil, osmomath.ZeroInt()
+	}
+
+	amountsIn := make([]osmomath.Int, len(disjoint))
+	amountsOut := make([]osmomath.Int, len(disjoint))
+	takerFees := make([]sdk.Coins, len(disjoint))
+	for i := range disjoint {
+		amountsIn[i] = osmomath.ZeroInt()
+		amountsOut[i] = osmomath.ZeroInt()
+	}
+
+	remaining := tokenIn.Amount
+	for step := 0; step < types.BestRouteSplitSteps; step++ {
+		amount := stepAmount
+		// The last step takes the rounding remainder.
+		if step == types.BestRouteSplitSteps-1 {
+			amount = remaining
+		}
+
+		bestIndex := -1
+		bestMarginalOut := osmomath.ZeroInt()
+		var bestAmountOut osmomath.Int
+		var bestTakerFees sdk.Coins
+		for i, candidate := range disjoint {
+			amountOut, fees, err := k.multihopEstimateOutGivenExactAmountInInternal(ctx, sender, candidate.route, sdk.NewCoin(tokenIn.Denom, amountsIn[i].Add(amount)), true)
+			if err != nil {
+				continue
+			}
+			marginalOut := amountOut.Sub(amountsOut[i])
+			if bestIndex == -1 || marginalOut.GT(bestMarginalOut) {
+				bestIndex, bestMarginalOut, bestAmountOut, bestTakerFees = i, marginalOut, amountOut, fees
+			}
+		}
+		if bestIndex == -1 {
+			return nil, osmomath.ZeroInt()
+		}
+
+		amountsIn[bestIndex] = amountsIn[bestIndex].Add(amount)
+		amountsOut[bestIndex] = bestAmountOut
+		takerFees[bestIndex] = bestTakerFees
+		remaining = remaining.Sub(amount)
+	}
+
+	quotes := []queryproto.RouteQuote{}
+	totalOut := osmomath.ZeroInt()
+	for i, candidate := range disjoint {
+		if amountsIn[i].IsZero() {
+			continue
+		}
+		quotes = append(quotes, queryproto.RouteQuote{
+			Pools:          candidate.route,
+			TokenInAmount:  amountsIn[i],
+			TokenOutAmount: amountsOut[i],
+			TakerFees:      takerFees[i],
+		})
+		totalOut = totalOut.Add(amountsOut[i])
+	}
+	return quotes, totalOut
+}
+
+// quote returns the route quote of the candidate for the given token in amount.
+func (c routeCandidate) quote(tokenInAmount osmomath.Int) queryproto.RouteQuote {
+	return queryproto.RouteQuote{
+		Pools:          c.route,
+		TokenInAmount:  tokenInAmount,
+		TokenOutAmount: c.tokenOutAmount,
+		TakerFees:      c.takerFees,
+	}
+}
@@ -0,0 +1,205 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestBestRoute() {
+	var (
+		deepAmount    = defaultInitPoolAmount
+		shallowAmount = osmomath.NewInt(10_000_000)
+	)
+
+	type poolCoins struct {
+		denomA, denomB string
+		amount         osmomath.Int
+	}
+
+	tests := map[string]struct {
+		pools            []poolCoins
+		tokenIn          sdk.Coin
+		tokenOutDenom    string
+		maxHops          uint64
+		maxRoutes        uint64
+		maxPoolsPerDenom uint64
+		split            bool
+
+		// expectedRoutes are the expected route pool ids, by index of pools.
+		expectedRoutes [][]int
+		expectSplit    bool
+		expectedErr    error
+	}{
+		"direct route beats two hop route": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAR, deepAmount},
+				{UOSMO, BAR, deepAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]int{{2}, {0, 1}},
+		},
+		"two hop route when there is no direct pool": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAR, deepAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]int{{0, 1}},
+		},
+		"two hop route through deep pools beats shallow direct pool": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAR, deepAmount},
+				{UOSMO, BAR, shallowAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:  BAR,
+			expectedRoutes: [][]int{{0, 1}, {2}},
+		},
+		"three hop route only found with max hops of three": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAZ, deepAmount},
+				{BAZ, BAR, deepAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:  BAR,
+			maxHops:        3,
+			expectedRoutes: [][]int{{0, 1, 2}},
+		},
+		"error: route longer than max hops": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAR, deepAmount},
+			},
+			tokenIn:       sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom: BAR,
+			maxHops:       1,
+			expectedErr:   types.NoRouteFoundError{},
+		},
+		"max routes limits the returned routes": {
+			pools: []poolCoins{
+				{UOSMO, FOO, deepAmount},
+				{FOO, BAR, deepAmount},
+				{UOSMO, BAR, deepAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:  BAR,
+			maxRoutes:      1,
+			expectedRoutes: [][]int{{2}},
+		},
+		"max pools per denom keeps the deepest pools": {
+			pools: []poolCoins{
+				{UOSMO, BAR, shallowAmount},
+				{UOSMO, BAR, deepAmount},
+			},
+			tokenIn:          sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:    BAR,
+			maxPoolsPerDenom: 1,
+			expectedRoutes:   [][]int{{1}},
+		},
+		"split across disjoint shallow pools beats the best single route": {
+			pools: []poolCoins{
+				{UOSMO, BAR, shallowAmount},
+				{UOSMO, BAR, shallowAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(5_000_000)),
+			tokenOutDenom:  BAR,
+			split:          true,
+			expectedRoutes: [][]int{{0}, {1}},
+			expectSplit:    true,
+		},
+		"no split when the best route is deep enough": {
+			pools: []poolCoins{
+				{UOSMO, BAR, deepAmount},
+				{UOSMO, BAR, shallowAmount},
+			},
+			tokenIn:        sdk.NewCoin(UOSMO, osmomath.NewInt(1_000)),
+			tokenOutDenom:  BAR,
+			split:          true,
+			expectedRoutes: [][]int{{0}, {1}},
+		},
+		"error: max hops above maximum": {
+			pools:         []poolCoins{{UOSMO, BAR, deepAmount}},
+			tokenIn:       sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom: BAR,
+			maxHops:       types.MaxBestRouteMaxHops + 1,
+			expectedErr:   types.BestRouteLimitExceededError{},
+		},
+		"error: max pools per denom above maximum": {
+			pools:            []poolCoins{{UOSMO, BAR, deepAmount}},
+			tokenIn:          sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+			tokenOutDenom:    BAR,
+			maxPoolsPerDenom: types.MaxBestRouteMaxPoolsPerDenom + 1,
+			expectedErr:      types.BestRouteLimitExceededError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.DefaultTakerFee = testDefaultTakerFee
+			s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+			poolIds := make([]uint64, len(tc.pools))
+			for i, pool := range tc.pools {
+				poolIds[i] = s.PrepareBalancerPoolWithCoins(sdk.NewCoin(pool.denomA, pool.amount), sdk.NewCoin(pool.denomB, pool.amount))
+			}
+
+			response, err := s.App.PoolManagerKeeper.BestRoute(s.Ctx, nil, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxRoutes, tc.maxPoolsPerDenom, tc.split)
+			if tc.expectedErr != nil {
+				s.Require().IsType(tc.expectedErr, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Len(response.Routes, len(tc.expectedRoutes))
+			for i, expectedRoute := range tc.expectedRoutes {
+				quote := response.Routes[i]
+				s.Require().Len(quote.Pools, len(expectedRoute))
+				for hop, poolIndex := range expectedRoute {
+					s.Require().Equal(poolIds[poolIndex], quote.Pools[hop].PoolId)
+				}
+				s.Require().Equal(tc.tokenIn.Amount, quote.TokenInAmount)
+
+				// The quote matches the estimate of the same route.
+				expectedTokenOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, quote.Pools, tc.tokenIn)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedTokenOut, quote.TokenOutAmount)
+
+				// The taker fee of the first hop is charged on token in.
+				takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, tc.tokenIn.Denom, quote.Pools[0].TokenOutDenom)
+				s.Require().NoError(err)
+				_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tc.tokenIn, takerFee)
+				s.Require().Equal(expectedTakerFee.Amount, quote.TakerFees.AmountOf(tc.tokenIn.Denom))
+
+				if i > 0 {
+					s.Require().True(quote.TokenOutAmount.LTE(response.Routes[i-1].TokenOutAmount))
+				}
+			}
+
+			if !tc.expectSplit {
+				s.Require().Empty(response.Split)
+				s.Require().True(response.SplitTokenOutAmount.IsZero())
+				return
+			}
+
+			s.Require().Greater(len(response.Split), 1)
+			s.Require().True(response.SplitTokenOutAmount.GT(response.Routes[0].TokenOutAmount))
+			totalIn, totalOut := osmomath.ZeroInt(), osmomath.ZeroInt()
+			for _, quote := range response.Split {
+				totalIn = totalIn.Add(quote.TokenInAmount)
+				totalOut = totalOut.Add(quote.TokenOutAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalIn)
+			s.Require().Equal(response.SplitTokenOutAmount, totalOut)
+		})
+	}
+}
@@ -198,6 +198,33 @@ func TestGetCmdNumPools(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdPoolVolumeByEpochs(t *testing.T) {
+	desc, _ := cli.GetCmdPoolVolumeByEpochs()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.PoolVolumeByEpochsRequest]{
+		"basic test": {
+			Cmd: "1 7",
+			ExpectedQuery: &queryproto.PoolVolumeByEpochsRequest{
+				PoolId:    1,
+				NumEpochs: 7,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdUserTakerFeeTier(t *testing.T) {
+	desc, _ := cli.GetCmdUserTakerFeeTier()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.UserTakerFeeTierRequest]{
+		"basic test": {
+			Cmd: "osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha",
+			ExpectedQuery: &queryproto.UserTakerFeeTierRequest{
+				Address: "osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha",
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdEstimateSwapExactAmountIn(t *testing.T) {
 	desc, _ := cli.GetCmdEstimateSwapExactAmountIn()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateSwapExactAmountInRequest]{
@@ -209,6 +236,15 @@ func TestGetCmdEstimateSwapExactAmountIn(t *testing.T) {
 				Routes:  []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}},
 			},
 		},
+		"with sender": {
+			Cmd: "1 10stake --swap-route-pool-ids=2 --swap-route-denoms=node0token --sender=osmo1sender",
+			ExpectedQuery: &queryproto.EstimateSwapExactAmountInRequest{
+				Sender:  "osmo1sender",
+				PoolId:  1,
+				TokenIn: "10stake",
+				Routes:  []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}},
+			},
+		},
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
@@ -228,6 +264,44 @@ func TestGetCmdEstimateSwapExactAmountOut(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestGetCmdSimulateSwap(t *testing.T) {
+	desc, _ := cli.GetCmdSimulateSwap()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.SimulateSwapRequest]{
+		"exact amount in": {
+			Cmd: "10stake --swap-route-pool-ids=2,3 --swap-route-denoms=node0token,uosmo",
+			ExpectedQuery: &queryproto.SimulateSwapRequest{
+				TokenIn:            "10stake",
+				SwapAmountInRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}, {PoolId: 3, TokenOutDenom: "uosmo"}},
+			},
+		},
+		"exact amount in, with sender": {
+			Cmd: "10stake --swap-route-pool-ids=2 --swap-route-denoms=node0token --sender=osmo1sender",
+			ExpectedQuery: &queryproto.SimulateSwapRequest{
+				Sender:             "osmo1sender",
+				TokenIn:            "10stake",
+				SwapAmountInRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "node0token"}},
+			},
+		},
+		"exact amount out": {
+			Cmd: "10stake --swap-route-pool-ids=2 --swap-route-denoms=node0token --exact-out",
+			ExpectedQuery: &queryproto.SimulateSwapRequest{
+				TokenOut:            "10stake",
+				SwapAmountOutRoutes: []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "node0token"}},
+				TokenInMaxAmount:    osmomath.ZeroInt(),
+			},
+		},
+		"exact amount out, with token in max amount": {
+			Cmd: "10stake --swap-route-pool-ids=2 --swap-route-denoms=node0token --exact-out --token-in-max-amount=12",
+			ExpectedQuery: &queryproto.SimulateSwapRequest{
+				TokenOut:            "10stake",
+				SwapAmountOutRoutes: []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "node0token"}},
+				TokenInMaxAmount:    osmomath.NewInt(12),
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdEstimateSinglePoolSwapExactAmountIn(t *testing.T) {
 	desc, _ := cli.GetCmdEstimateSinglePoolSwapExactAmountIn()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.EstimateSinglePoolSwapExactAmountInRequest]{
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
@@ -71,3 +73,46 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	}
 	return routes, nil
 }
+
+// swapConstraints parses the optional swap constraints from the swap constraints flags.
+// Returns nil if none of the flags are set.
+func swapConstraints(fs *flag.FlagSet) (*types.SwapConstraints, error) {
+	deadlineTimeStr, err := fs.GetString(FlagSwapDeadlineTime)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlineHeight, err := fs.GetInt64(FlagSwapDeadlineHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPricesStr, err := fs.GetString(FlagSwapMaxPricesPerHop)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadlineTimeStr == "" && deadlineHeight == 0 && maxPricesStr == "" {
+		return nil, nil
+	}
+
+	constraints := &types.SwapConstraints{DeadlineHeight: deadlineHeight}
+	if deadlineTimeStr != "" {
+		deadlineTime, err := time.Parse(time.RFC3339, deadlineTimeStr)
+		if err != nil {
+			return nil, err
+		}
+		constraints.DeadlineTime = &deadlineTime
+	}
+
+	if maxPricesStr != "" {
+		for _, maxPriceStr := range strings.Split(maxPricesStr, ",") {
+			maxPrice, err := osmomath.NewDecFromStr(strings.TrimSpace(maxPriceStr))
+			if err != nil {
+				return nil, err
+			}
+			constraints.MaxPricePerHop = append(constraints.MaxPricePerHop, maxPrice)
+		}
+	}
+	return constraints, nil
+}
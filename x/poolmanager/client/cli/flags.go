@@ -27,6 +27,18 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to time.Time, in RFC3339 format.
+	FlagSwapDeadlineTime = "deadline-time"
+	// Will be parsed to int64.
+	FlagSwapDeadlineHeight = "deadline-height"
+	// Will be parsed to []osmomath.Dec.
+	FlagSwapMaxPricesPerHop = "max-prices-per-hop"
+	// Will be parsed to bool.
+	FlagSimulateExactOut = "exact-out"
+	// Will be parsed to string.
+	FlagQuoteSender = "sender"
+	// Will be parsed to osmomath.Int.
+	FlagSimulateTokenInMaxAmount = "token-in-max-amount"
 )
 
 type createBalancerPoolInputs struct {
@@ -77,6 +89,27 @@ func FlagSetMultihopSwapRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSwapConstraints() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSwapDeadlineTime, "", "latest block time (RFC3339) at which the swap may execute")
+	fs.Int64(FlagSwapDeadlineHeight, 0, "latest block height at which the swap may execute")
+	fs.String(FlagSwapMaxPricesPerHop, "", "comma separated max effective price of every hop, in token in per token out. 0 disables the check of a hop")
+	return fs
+}
+
+func FlagSetQuoteSender() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagQuoteSender, "", "address whose taker fee, including its volume tier discount, the quote applies")
+	return fs
+}
+
+func FlagSetSimulateSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagSimulateExactOut, false, "simulate an exact amount out swap, the token argument being the token out and the route denoms the tokens in")
+	fs.String(FlagSimulateTokenInMaxAmount, "", "maximum token in of an exact amount out swap, the simulation failing if the route requires more")
+	return fs
+}
+
 func FlagSetQuerySwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
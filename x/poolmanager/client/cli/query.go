@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
@@ -16,6 +18,21 @@ var customRouterFlagOverride = map[string]string{
 	"router": FlagSwapRouteDenoms,
 }
 
+// quoteSenderFlagOverride reads the optional sender of a taker fee quote from a flag.
+var quoteSenderFlagOverride = map[string]string{
+	"sender": FlagQuoteSender,
+}
+
+// simulateSwapFlagOverride lists the fields SimulateSwapParseArgs reads from flags,
+// leaving the token swapped as the only argument.
+var simulateSwapFlagOverride = map[string]string{
+	"sender":              FlagQuoteSender,
+	"swapamountinroutes":  FlagSwapRoutePoolIds,
+	"swapamountoutroutes": FlagSwapRoutePoolIds,
+	"tokenout":            FlagSimulateExactOut,
+	"tokeninmaxamount":    FlagSimulateTokenInMaxAmount,
+}
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
@@ -30,7 +47,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalVolumeForPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolumeByEpochs)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeVolumeTiers)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdUserTakerFeeTier)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllTakerFeeShareAgreements)
@@ -40,6 +60,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllRegisteredAlloyedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdBestRoute)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSimulateSwap)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -56,7 +78,7 @@ func GetCmdEstimateSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.Es
 		Long: `Query estimate-swap-exact-amount-in.{{.ExampleHeader}}
 {{.CommandPrefix}} estimate-swap-exact-amount-in 1000stake --swap-route-pool-ids=2 --swap-route-pool-ids=3`,
 		ParseQuery:          EstimateSwapExactAmountInParseArgs,
-		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}, OptionalFlags: []*flag.FlagSet{FlagSetQuoteSender()}},
 		QueryFnName:         "EstimateSwapExactAmountIn",
 		CustomFlagOverrides: customRouterFlagOverride,
 	}, &queryproto.EstimateSwapExactAmountInRequest{}
@@ -70,7 +92,7 @@ func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.E
 		Long: `Query estimate-swap-exact-amount-out.{{.ExampleHeader}}
 {{.CommandPrefix}} estimate-swap-exact-amount-out 1000stake --swap-route-pool-ids=2 --swap-route-pool-ids=3`,
 		ParseQuery:          EstimateSwapExactAmountOutParseArgs,
-		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}, OptionalFlags: []*flag.FlagSet{FlagSetQuoteSender()}},
 		QueryFnName:         "EstimateSwapExactAmountOut",
 		CustomFlagOverrides: customRouterFlagOverride,
 	}, &queryproto.EstimateSwapExactAmountOutRequest{}
@@ -134,7 +156,13 @@ func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.
 		return nil, err
 	}
 
+	sender, err := fs.GetString(FlagQuoteSender)
+	if err != nil {
+		return nil, err
+	}
+
 	return &queryproto.EstimateSwapExactAmountInRequest{
+		Sender:  sender,
 		PoolId:  uint64(poolID), // TODO: is this poolId used?
 		TokenIn: args[1],
 		Routes:  routes,
@@ -152,7 +180,13 @@ func EstimateSwapExactAmountOutParseArgs(args []string, fs *flag.FlagSet) (proto
 		return nil, err
 	}
 
+	sender, err := fs.GetString(FlagQuoteSender)
+	if err != nil {
+		return nil, err
+	}
+
 	return &queryproto.EstimateSwapExactAmountOutRequest{
+		Sender:   sender,
 		PoolId:   uint64(poolID), // TODO: is this poolId used?
 		Routes:   routes,
 		TokenOut: args[1],
@@ -199,12 +233,41 @@ func GetCmdTotalVolumeForPool() (*osmocli.QueryDescriptor, *queryproto.TotalVolu
 	}, &queryproto.TotalVolumeForPoolRequest{}
 }
 
+func GetCmdPoolVolumeByEpochs() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeByEpochsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume-by-epochs",
+		Short: "Query the volume of a pool over its last num-epochs volume epochs (0 for all retained epochs)",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} pool-volume-by-epochs 1 7`,
+	}, &queryproto.PoolVolumeByEpochsRequest{}
+}
+
+func GetCmdTakerFeeVolumeTiers() (*osmocli.QueryDescriptor, *queryproto.TakerFeeVolumeTiersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-volume-tiers",
+		Short: "Query the taker fee volume tier table",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} taker-fee-volume-tiers`,
+	}, &queryproto.TakerFeeVolumeTiersRequest{}
+}
+
+func GetCmdUserTakerFeeTier() (*osmocli.QueryDescriptor, *queryproto.UserTakerFeeTierRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "user-taker-fee-tier",
+		Short: "Query the trailing swap volume of an address and the taker fee volume tier it qualifies for",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} user-taker-fee-tier osmo1...`,
+	}, &queryproto.UserTakerFeeTierRequest{}
+}
+
 func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingPairTakerFeeRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "trading-pair-taker-fee",
 		Short: "Query trading pair taker fee",
 		Long: `{{.Short}}
-		{{.CommandPrefix}} trading-pair-taker-fee uosmo uatom`,
+		{{.CommandPrefix}} trading-pair-taker-fee uosmo uatom --sender=osmo1...`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetQuoteSender()}},
+		CustomFlagOverrides: quoteSenderFlagOverride,
 	}, &queryproto.TradingPairTakerFeeRequest{}
 }
 
@@ -279,3 +342,87 @@ func GetAllRegisteredAlloyedPools() (*osmocli.QueryDescriptor, *queryproto.AllRe
 		Long:  "{{.Short}}",
 	}, &queryproto.AllRegisteredAlloyedPoolsRequest{}
 }
+
+// GetCmdBestRoute returns the best routes for swapping a token in to a token out denom.
+func GetCmdBestRoute() (*osmocli.QueryDescriptor, *queryproto.BestRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "best-route",
+		Short: "Query the best routes from token in to token out denom",
+		Long: `{{.Short}}
+Arguments are token in, token out denom, max hops, max routes, max pools per denom and whether to split.
+A zero limit uses its default.{{.ExampleHeader}}
+{{.CommandPrefix}} best-route 1000000uosmo uatom 2 3 20 true --sender=osmo1...`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetQuoteSender()}},
+		QueryFnName:         "BestRoute",
+		CustomFlagOverrides: quoteSenderFlagOverride,
+	}, &queryproto.BestRouteRequest{}
+}
+
+// GetCmdSimulateSwap returns the per hop breakdown of a simulated swap.
+func GetCmdSimulateSwap() (*osmocli.QueryDescriptor, *queryproto.SimulateSwapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap",
+		Short: "Query the per hop amounts, fees and prices of a simulated swap",
+		Long: `{{.Short}}
+No funds are moved, and the optional sender only determines the taker fee charged.{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap 1000stake --swap-route-pool-ids=2,3 --swap-route-denoms=uatom,uosmo --sender=osmo1...
+{{.CommandPrefix}} simulate-swap 1000uosmo --swap-route-pool-ids=2,3 --swap-route-denoms=stake,uatom --exact-out --token-in-max-amount=1100`,
+		ParseQuery: SimulateSwapParseArgs,
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSimulateSwap(), FlagSetQuoteSender()},
+		},
+		QueryFnName:         "SimulateSwap",
+		CustomFlagOverrides: simulateSwapFlagOverride,
+	}, &queryproto.SimulateSwapRequest{}
+}
+
+func SimulateSwapParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
+	exactOut, err := fs.GetBool(FlagSimulateExactOut)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := fs.GetString(FlagQuoteSender)
+	if err != nil {
+		return nil, err
+	}
+
+	if exactOut {
+		routes, err := swapAmountOutRoutes(fs)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenInMaxAmount := osmomath.ZeroInt()
+		tokenInMaxAmountStr, err := fs.GetString(FlagSimulateTokenInMaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		if tokenInMaxAmountStr != "" {
+			var ok bool
+			tokenInMaxAmount, ok = osmomath.NewIntFromString(tokenInMaxAmountStr)
+			if !ok {
+				return nil, fmt.Errorf("invalid token in max amount: %s", tokenInMaxAmountStr)
+			}
+		}
+
+		return &queryproto.SimulateSwapRequest{
+			Sender:              sender,
+			TokenOut:            args[0],
+			SwapAmountOutRoutes: routes,
+			TokenInMaxAmount:    tokenInMaxAmount,
+		}, nil
+	}
+
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.SimulateSwapRequest{
+		Sender:             sender,
+		TokenIn:            args[0],
+		SwapAmountInRoutes: routes,
+	}, nil
+}
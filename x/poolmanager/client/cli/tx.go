@@ -52,9 +52,13 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 		Short:   "swap exact amount in",
 		Example: "osmosisd tx poolmanager swap-exact-amount-in 2000000uosmo 1 --swap-route-pool-ids 5 --swap-route-denoms uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"Routes":      osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"Constraints": osmocli.FlagOnlyParser(swapConstraints),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapConstraints()},
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
 	}, &types.MsgSwapExactAmountIn{}
 }
 
@@ -66,7 +70,10 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Example:          "osmosisd tx poolmanager swap-exact-amount-out 100uion 1000000 --swap-route-pool-ids 1 --swap-route-denoms uosmo --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		NumArgs:          2,
 		ParseAndBuildMsg: NewBuildSwapExactAmountOutMsg,
-		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapConstraints()},
+		},
 	}, &types.MsgSwapExactAmountOut{}
 }
 
@@ -236,11 +243,17 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *
 	if !ok {
 		return nil, errors.New("invalid token in max amount")
 	}
+
+	constraints, err := swapConstraints(fs)
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgSwapExactAmountOut{
 		Sender:           clientCtx.GetFromAddress().String(),
 		Routes:           routes,
 		TokenInMaxAmount: tokenInMaxAmount,
 		TokenOut:         tokenOut,
+		Constraints:      constraints,
 	}, nil
 }
 
@@ -614,3 +627,107 @@ func ParseDenomPairTakerFee(arg string) ([]types.DenomPairTakerFee, error) {
 
 	return finaldenomPairTakerFeeRecordsRecords, nil
 }
+
+// NewCmdHandleTakerFeeVolumeTiersProposal implements a command handler for taker fee volume tiers proposal
+func NewCmdHandleTakerFeeVolumeTiersProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taker-fee-volume-tiers-proposal [tiers] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a taker fee volume tiers proposal",
+		Long: strings.TrimSpace(`Submit a taker fee volume tiers proposal, replacing the whole taker fee volume tier table.
+
+Passing in tiers separated by commas would be parsed automatically to taker fee volume tier records, ordered by ascending min volume.
+The min volume is the OSMO-denominated (uosmo) trailing swap volume needed to qualify for the tier, the discount is the fraction of the trading pair taker fee waived.
+Ex) taker-fee-volume-tiers-proposal 1000000000000,0.1,10000000000000,0.25 ->
+[1,000,000 OSMO of trailing volume, 10% taker fee discount]
+[10,000,000 OSMO of trailing volume, 25% taker fee discount]
+
+Passing in an empty string removes all the tiers, disabling volume based taker fee discounts.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseTakerFeeVolumeTiersArgToContent(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseTakerFeeVolumeTiersArgToContent(cmd *cobra.Command, arg string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := ParseTakerFeeVolumeTiers(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.TakerFeeVolumeTiersProposal{
+		Title:       title,
+		Description: description,
+		Tiers:       tiers,
+	}
+
+	return content, nil
+}
+
+func ParseTakerFeeVolumeTiers(arg string) ([]types.TakerFeeVolumeTier, error) {
+	tiers := []types.TakerFeeVolumeTier{}
+	if strings.TrimSpace(arg) == "" {
+		return tiers, nil
+	}
+
+	tierRecords := strings.Split(arg, ",")
+	if len(tierRecords)%2 != 0 {
+		return nil, fmt.Errorf("taker fee volume tiers must be a list of minVolume and discount separated by commas")
+	}
+
+	for i := 0; i < len(tierRecords); i += 2 {
+		minVolume, ok := osmomath.NewIntFromString(tierRecords[i])
+		if !ok {
+			return nil, fmt.Errorf("invalid min volume: %s", tierRecords[i])
+		}
+
+		discount, err := osmomath.NewDecFromStr(tierRecords[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		tiers = append(tiers, types.TakerFeeVolumeTier{
+			MinVolume: minVolume,
+			Discount:  discount,
+		})
+	}
+
+	return tiers, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/cli"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
 )
 
 func TestParseCoinsNoSort(t *testing.T) {
@@ -69,3 +70,47 @@ func TestParseCoinsNoSort(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTakerFeeVolumeTiers(t *testing.T) {
+	tests := map[string]struct {
+		arg           string
+		expectedTiers []types.TakerFeeVolumeTier
+		expectErr     bool
+	}{
+		"two tiers": {
+			arg: "1000000000000,0.1,10000000000000,0.25",
+			expectedTiers: []types.TakerFeeVolumeTier{
+				{MinVolume: osmomath.NewInt(1000000000000), Discount: osmomath.MustNewDecFromStr("0.1")},
+				{MinVolume: osmomath.NewInt(10000000000000), Discount: osmomath.MustNewDecFromStr("0.25")},
+			},
+		},
+		"empty string removes all tiers": {
+			arg:           "",
+			expectedTiers: []types.TakerFeeVolumeTier{},
+		},
+		"missing discount": {
+			arg:       "1000,0.1,5000",
+			expectErr: true,
+		},
+		"invalid min volume": {
+			arg:       "abc,0.1",
+			expectErr: true,
+		},
+		"invalid discount": {
+			arg:       "1000,abc",
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tiers, err := cli.ParseTakerFeeVolumeTiers(tc.arg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTiers, tiers)
+		})
+	}
+}
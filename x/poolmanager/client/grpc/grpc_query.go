@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) UserTakerFeeTier(grpcCtx context.Context,
+	req *queryproto.UserTakerFeeTierRequest,
+) (*queryproto.UserTakerFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserTakerFeeTier(ctx, *req)
+}
+
 func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
 	req *queryproto.TradingPairTakerFeeRequest,
 ) (*queryproto.TradingPairTakerFeeResponse, error) {
@@ -61,6 +71,16 @@ func (q Querier) TotalLiquidity(grpcCtx context.Context,
 	return q.Q.TotalLiquidity(ctx, *req)
 }
 
+func (q Querier) TakerFeeVolumeTiers(grpcCtx context.Context,
+	req *queryproto.TakerFeeVolumeTiersRequest,
+) (*queryproto.TakerFeeVolumeTiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeVolumeTiers(ctx, *req)
+}
+
 func (q Querier) TakerFeeShareDenomsToAccruedValue(grpcCtx context.Context,
 	req *queryproto.TakerFeeShareDenomsToAccruedValueRequest,
 ) (*queryproto.TakerFeeShareDenomsToAccruedValueResponse, error) {
@@ -91,6 +111,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) SimulateSwap(grpcCtx context.Context,
+	req *queryproto.SimulateSwapRequest,
+) (*queryproto.SimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SimulateSwap(ctx, *req)
+}
+
 func (q Querier) RegisteredAlloyedPoolFromPoolId(grpcCtx context.Context,
 	req *queryproto.RegisteredAlloyedPoolFromPoolIdRequest,
 ) (*queryproto.RegisteredAlloyedPoolFromPoolIdResponse, error) {
@@ -111,6 +141,16 @@ func (q Querier) RegisteredAlloyedPoolFromDenom(grpcCtx context.Context,
 	return q.Q.RegisteredAlloyedPoolFromDenom(ctx, *req)
 }
 
+func (q Querier) PoolVolumeByEpochs(grpcCtx context.Context,
+	req *queryproto.PoolVolumeByEpochsRequest,
+) (*queryproto.PoolVolumeByEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolumeByEpochs(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -221,6 +261,16 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) BestRoute(grpcCtx context.Context,
+	req *queryproto.BestRouteRequest,
+) (*queryproto.BestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BestRoute(ctx, *req)
+}
+
 func (q Querier) AllTakerFeeShareAgreements(grpcCtx context.Context,
 	req *queryproto.AllTakerFeeShareAgreementsRequest,
 ) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
@@ -7,5 +7,6 @@ import (
 )
 
 var (
-	DenomPairTakerFeeProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal)
+	DenomPairTakerFeeProposalHandler   = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal)
+	TakerFeeVolumeTiersProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleTakerFeeVolumeTiersProposal)
 )
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -52,7 +54,12 @@ func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context, req queryproto.Estim
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountIn(ctx, req.Routes, tokenIn)
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountInForSender(ctx, sender, req.Routes, tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -109,7 +116,12 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOut(ctx, req.Routes, tokenOut)
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOutForSender(ctx, sender, req.Routes, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -321,9 +333,38 @@ func (q Querier) TotalVolumeForPool(ctx sdk.Context, req queryproto.TotalVolumeF
 	}, nil
 }
 
-// TradingPairTakerFee returns the taker fee for the given trading pair
+// PoolVolumeByEpochs returns the volume of the given pool over its most recent volume epochs.
+func (q Querier) PoolVolumeByEpochs(ctx sdk.Context, req queryproto.PoolVolumeByEpochsRequest) (*queryproto.PoolVolumeByEpochsResponse, error) {
+	response, err := q.K.GetPoolVolumeForLastEpochs(ctx, req.PoolId, req.NumEpochs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
+// TakerFeeVolumeTiers returns the taker fee volume tier table.
+func (q Querier) TakerFeeVolumeTiers(ctx sdk.Context, req queryproto.TakerFeeVolumeTiersRequest) (*queryproto.TakerFeeVolumeTiersResponse, error) {
+	return q.K.GetTakerFeeVolumeTiers(ctx)
+}
+
+// UserTakerFeeTier returns the trailing swap volume of the given address and the taker fee volume tier it qualifies for.
+func (q Querier) UserTakerFeeTier(ctx sdk.Context, req queryproto.UserTakerFeeTierRequest) (*queryproto.UserTakerFeeTierResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return q.K.GetUserTakerFeeTier(ctx, address)
+}
+
+// TradingPairTakerFee returns the taker fee for the given trading pair, or the taker fee charged
+// to the given sender for it if set.
 func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPairTakerFeeRequest) (*queryproto.TradingPairTakerFeeResponse, error) {
-	tradingPairTakerFee, err := q.K.GetTradingPairTakerFee(ctx, req.Denom_0, req.Denom_1)
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tradingPairTakerFee, err := q.K.GetTradingPairTakerFeeForSender(ctx, req.Denom_0, req.Denom_1, sender)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -405,6 +446,85 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 	}
 }
 
+// BestRoute returns the best routes, and optionally a split across them, for swapping
+// the given token in to the given token out denom.
+func (q Querier) BestRoute(ctx sdk.Context, req queryproto.BestRouteRequest) (*queryproto.BestRouteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in")
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := q.K.BestRoute(ctx, sender, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxRoutes, req.MaxPoolsPerDenom, req.Split)
+	if err != nil {
+		var noRouteFoundErr types.NoRouteFoundError
+		if errors.As(err, &noRouteFoundErr) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return response, nil
+}
+
+// SimulateSwap simulates an exact amount in or exact amount out swap, charged the
+// taker fee of the optional sender, and returns the per hop breakdown of amounts, fees and prices.
+func (q Querier) SimulateSwap(ctx sdk.Context, req queryproto.SimulateSwapRequest) (*queryproto.SimulateSwapResponse, error) {
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	isExactIn := req.TokenIn != "" || len(req.SwapAmountInRoutes) > 0
+	isExactOut := req.TokenOut != "" || len(req.SwapAmountOutRoutes) > 0
+	if isExactIn == isExactOut {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of token in with exact amount in routes or token out with exact amount out routes must be set")
+	}
+
+	var response *queryproto.SimulateSwapResponse
+	if isExactIn {
+		tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid token in")
+		}
+
+		response, err = q.K.SimulateSwapExactAmountIn(ctx, sender, req.SwapAmountInRoutes, tokenIn)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid token out")
+		}
+
+		// A token in max amount that is not positive enforces no maximum.
+		tokenInMaxAmount := osmomath.Int{}
+		if !req.TokenInMaxAmount.IsNil() && req.TokenInMaxAmount.IsPositive() {
+			tokenInMaxAmount = req.TokenInMaxAmount
+		}
+
+		response, err = q.K.SimulateSwapExactAmountOut(ctx, sender, req.SwapAmountOutRoutes, tokenInMaxAmount, tokenOut)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
 func (q Querier) AllTakerFeeShareAgreements(ctx sdk.Context, req queryproto.AllTakerFeeShareAgreementsRequest) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
 	takerFeeShareAgreements, err := q.K.GetAllTakerFeesShareAgreements(ctx)
 	if err != nil {
@@ -479,3 +599,15 @@ func (q Querier) AllRegisteredAlloyedPools(ctx sdk.Context, req queryproto.AllRe
 		ContractStates: contractStates,
 	}, nil
 }
+
+// parseOptionalSender parses the sender of a quote, returning a nil address if it is unset.
+func parseOptionalSender(sender string) (sdk.AccAddress, error) {
+	if sender == "" {
+		return nil, nil
+	}
+	address, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid sender")
+	}
+	return address, nil
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -60,3 +61,21 @@ func newRemoveLiquidityEvent(sender sdk.AccAddress, poolId uint64, liquidity sdk
 		sdk.NewAttribute(types.AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+func EmitWeightChangeScheduledEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, startTime time.Time, duration time.Duration, targetPoolWeights string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newWeightChangeScheduledEvent(sender, poolId, startTime, duration, targetPoolWeights),
+	})
+}
+
+func newWeightChangeScheduledEvent(sender sdk.AccAddress, poolId uint64, startTime time.Time, duration time.Duration, targetPoolWeights string) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtWeightChangeScheduled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, startTime.UTC().Format(time.RFC3339)),
+		sdk.NewAttribute(types.AttributeKeyDuration, duration.String()),
+		sdk.NewAttribute(types.AttributeKeyTargetPoolWeights, targetPoolWeights),
+	)
+}
@@ -3,6 +3,7 @@ package events_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -185,3 +186,62 @@ func (suite *PoolManagerEventsTestSuite) TestEmitRemoveLiquidityEvent() {
 		})
 	}
 }
+
+func (suite *PoolManagerEventsTestSuite) TestEmitWeightChangeScheduledEvent() {
+	testcases := map[string]struct {
+		ctx               sdk.Context
+		testAccountAddr   sdk.AccAddress
+		poolId            uint64
+		startTime         time.Time
+		duration          time.Duration
+		targetPoolWeights string
+	}{
+		"basic valid": {
+			ctx:               suite.CreateTestContext(),
+			testAccountAddr:   sdk.AccAddress([]byte(addressString)),
+			poolId:            1,
+			startTime:         time.Unix(1_700_000_000, 0),
+			duration:          time.Hour,
+			targetPoolWeights: "1denoma,3denomb",
+		},
+		"valid with more tokens": {
+			ctx:               suite.CreateTestContext(),
+			testAccountAddr:   sdk.AccAddress([]byte(addressString)),
+			poolId:            200,
+			startTime:         time.Unix(1_800_000_000, 0),
+			duration:          48 * time.Hour,
+			targetPoolWeights: "1denoma,1denomb,2denomc,4denomd",
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtWeightChangeScheduled,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyStartTime, tc.startTime.UTC().Format(time.RFC3339)),
+					sdk.NewAttribute(types.AttributeKeyDuration, tc.duration.String()),
+					sdk.NewAttribute(types.AttributeKeyTargetPoolWeights, tc.targetPoolWeights),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitWeightChangeScheduledEvent(tc.ctx, tc.testAccountAddr, tc.poolId, tc.startTime, tc.duration, tc.targetPoolWeights)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
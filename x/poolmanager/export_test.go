@@ -47,11 +47,12 @@ func (k Keeper) CreateMultihopExpectedSwapOuts(
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
-	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	return k.createMultihopExpectedSwapOuts(ctx, nil, route, tokenOut)
 }
 
-func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
-	k.trackVolume(ctx, poolId, volumeGenerated)
+func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, volumeGenerated sdk.Coin) {
+	// The volume is tracked as if the sender was charged a taker fee for it.
+	k.trackVolume(ctx, poolId, sender, volumeGenerated, sdk.NewCoin(volumeGenerated.Denom, osmomath.OneInt()))
 }
 
 func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
@@ -17,11 +17,18 @@ func (k Keeper) HandleDenomPairTakerFeeProposal(ctx sdk.Context, p *types.DenomP
 	return nil
 }
 
+func (k Keeper) HandleTakerFeeVolumeTiersProposal(ctx sdk.Context, p *types.TakerFeeVolumeTiersProposal) error {
+	k.SetTakerFeeVolumeTiers(ctx, p.Tiers)
+	return nil
+}
+
 func NewPoolManagerProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
 		case *types.DenomPairTakerFeeProposal:
 			return k.HandleDenomPairTakerFeeProposal(ctx, c)
+		case *types.TakerFeeVolumeTiersProposal:
+			return k.HandleTakerFeeVolumeTiersProposal(ctx, c)
 
 		default:
 			return fmt.Errorf("unrecognized pool manager proposal content type: %T", c)
@@ -0,0 +1,37 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = &epochHooks{}
+
+type epochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks that bucket pool volume by volume epoch.
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (*epochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+func (hook *epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// BeforeEpochStart moves volume tracking to the starting volume epoch, pruning buckets that are no longer retained.
+// It runs after the AfterEpochEnd hooks of all modules, so these still observe the volume of the epoch that ended.
+func (hook *epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.VolumeEpochIdentifier && epochNumber > 0 {
+		hook.k.startVolumeEpoch(ctx, uint64(epochNumber))
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ type Keeper struct {
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
 	wasmKeeper           types.WasmKeeper
+	twapKeeper           types.TwapKeeper
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -145,6 +146,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		k.SetVolume(ctx, poolVolume.PoolId, poolVolume.PoolVolume)
 	}
 
+	// Set the pool epoch volumes KVStore.
+	k.setCurrentVolumeEpoch(ctx, genState.CurrentVolumeEpoch)
+	for _, poolEpochVolume := range genState.PoolEpochVolumes {
+		k.SetEpochVolume(ctx, poolEpochVolume.PoolId, poolEpochVolume.EpochNumber, poolEpochVolume.Volume)
+	}
+
+	// Set the taker fee volume tiers and the sender epoch volumes KVStore, rebuilding the senders' trailing volumes.
+	k.SetTakerFeeVolumeTiers(ctx, genState.TakerFeeVolumeTiers)
+	for _, userEpochVolume := range genState.UserEpochVolumes {
+		sender := sdk.MustAccAddressFromBech32(userEpochVolume.Address)
+		k.SetUserEpochVolume(ctx, sender, userEpochVolume.EpochNumber, userEpochVolume.Volume)
+		k.addUserTrailingVolume(ctx, sender, userEpochVolume.Volume)
+	}
+
 	// Set the denom pair taker fees KVStore.
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
@@ -187,6 +202,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolEpochVolumes:       k.getAllPoolEpochVolumes(ctx),
+		CurrentVolumeEpoch:     k.GetCurrentVolumeEpoch(ctx),
+		TakerFeeVolumeTiers:    k.getTakerFeeVolumeTiers(ctx),
+		UserEpochVolumes:       k.getAllUserEpochVolumes(ctx),
 	}
 }
 
@@ -224,6 +243,11 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// SetTwapKeeper sets twap keeper
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // BeginBlock sets the poolmanager caches if they are empty
 func (k *Keeper) BeginBlock(ctx sdk.Context) {
 	// Here, the only time in which these caches are empty is during the start up of the node.
@@ -244,9 +268,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 // AlloyedAssetCompositionUpdateRate is the rate in blocks at which the taker fee share alloy composition is updated in the end block.
 var AlloyedAssetCompositionUpdateRate = int64(700)
 
-// EndBlock updates the taker fee share alloy composition for all registered alloyed pools
-// if the current block height is a multiple of the alloyedAssetCompositionUpdateRate.
+// EndBlock prunes the sender volume buckets that left the retention window, and updates the taker fee
+// share alloy composition for all registered alloyed pools if the current block height is a multiple
+// of the alloyedAssetCompositionUpdateRate.
 func (k *Keeper) EndBlock(ctx sdk.Context) {
+	k.pruneUserEpochVolumes(ctx)
+
 	if ctx.BlockHeight()%AlloyedAssetCompositionUpdateRate == 0 {
 		registeredAlloyPoolIds, err := k.getAllRegisteredAlloyedPoolsIdArray(ctx)
 		if err != nil {
@@ -67,6 +67,50 @@ var (
 		},
 	}
 
+	testCurrentVolumeEpoch = uint64(5)
+
+	testPoolEpochVolumes = []types.PoolEpochVolume{
+		{
+			PoolId:      1,
+			EpochNumber: 4,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000000))),
+		},
+		{
+			PoolId:      1,
+			EpochNumber: 5,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(2000000))),
+		},
+		{
+			PoolId:      2,
+			EpochNumber: 5,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(3000000))),
+		},
+	}
+
+	testTakerFeeVolumeTiersGenesis = []types.TakerFeeVolumeTier{
+		{MinVolume: osmomath.NewInt(1000000), Discount: osmomath.MustNewDecFromStr("0.1")},
+		{MinVolume: osmomath.NewInt(5000000), Discount: osmomath.MustNewDecFromStr("0.25")},
+	}
+
+	// Ordered the way they are stored, by epoch and then by address bytes.
+	testUserEpochVolumes = []types.UserEpochVolume{
+		{
+			Address:     testAdminAddresses[0],
+			EpochNumber: 4,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000000))),
+		},
+		{
+			Address:     testAdminAddresses[1],
+			EpochNumber: 5,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(2000000))),
+		},
+		{
+			Address:     testAdminAddresses[0],
+			EpochNumber: 5,
+			Volume:      sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(5000000))),
+		},
+	}
+
 	testDenomPairTakerFees = []types.DenomPairTakerFee{
 		{
 			TokenInDenom:  "uion",
@@ -143,6 +187,10 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolEpochVolumes:       testPoolEpochVolumes,
+		CurrentVolumeEpoch:     testCurrentVolumeEpoch,
+		TakerFeeVolumeTiers:    testTakerFeeVolumeTiersGenesis,
+		UserEpochVolumes:       testUserEpochVolumes,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -160,6 +208,21 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testTakerFeesTracker.HeightAccountingStartsFrom, s.App.PoolManagerKeeper.GetTakerFeeTrackerStartHeight(s.Ctx))
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, s.App.PoolManagerKeeper.GetTotalVolumeForPool(s.Ctx, testPoolVolumes[0].PoolId))
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, s.App.PoolManagerKeeper.GetTotalVolumeForPool(s.Ctx, testPoolVolumes[1].PoolId))
+	s.Require().Equal(testCurrentVolumeEpoch, s.App.PoolManagerKeeper.GetCurrentVolumeEpoch(s.Ctx))
+	for _, poolEpochVolume := range testPoolEpochVolumes {
+		s.Require().Equal(poolEpochVolume.Volume, s.App.PoolManagerKeeper.GetEpochVolumeForPool(s.Ctx, poolEpochVolume.PoolId, poolEpochVolume.EpochNumber))
+	}
+	takerFeeVolumeTiers, err := s.App.PoolManagerKeeper.GetTakerFeeVolumeTiers(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(testTakerFeeVolumeTiersGenesis, takerFeeVolumeTiers.Tiers)
+	for _, userEpochVolume := range testUserEpochVolumes {
+		s.Require().Equal(userEpochVolume.Volume, s.App.PoolManagerKeeper.GetUserEpochVolume(s.Ctx, sdk.MustAccAddressFromBech32(userEpochVolume.Address), userEpochVolume.EpochNumber))
+	}
+	// Trailing volumes are rebuilt from the epoch volumes.
+	userTakerFeeTier, err := s.App.PoolManagerKeeper.GetUserTakerFeeTier(s.Ctx, sdk.MustAccAddressFromBech32(testAdminAddresses[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(6000000), userTakerFeeTier.Volume)
+	s.Require().Equal(uint64(2), userTakerFeeTier.Tier)
 
 	takerFee, err := s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[0].TokenInDenom, testDenomPairTakerFees[0].TokenOutDenom)
 	s.Require().NoError(err)
@@ -191,6 +254,10 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolEpochVolumes:       testPoolEpochVolumes,
+		CurrentVolumeEpoch:     testCurrentVolumeEpoch,
+		TakerFeeVolumeTiers:    testTakerFeeVolumeTiersGenesis,
+		UserEpochVolumes:       testUserEpochVolumes,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -209,6 +276,10 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testPoolEpochVolumes, genesis.PoolEpochVolumes)
+	s.Require().Equal(testCurrentVolumeEpoch, genesis.CurrentVolumeEpoch)
+	s.Require().Equal(testTakerFeeVolumeTiersGenesis, genesis.TakerFeeVolumeTiers)
+	s.Require().Equal(testUserEpochVolumes, genesis.UserEpochVolumes)
 }
 
 // TestBeginBlock tests that, if any one of the cache trackers is empty, all cache trackers are updated.
@@ -29,7 +29,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.RouteExactAmountInWithConstraints(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.Constraints)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.RouteExactAmountOutWithConstraints(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.Constraints)
 	if err != nil {
 		return nil, err
 	}
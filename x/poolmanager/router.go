@@ -56,12 +56,35 @@ func (k Keeper) RouteExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.RouteExactAmountInWithConstraints(ctx, sender, route, tokenIn, tokenOutMinAmount, nil)
+}
+
+// RouteExactAmountInWithConstraints is RouteExactAmountIn, additionally enforcing the given
+// optional swap constraints. The swap fails if it is executed after the constraints' deadline,
+// or if the effective price of any hop, including the taker fee, exceeds the hop's max price.
+// A nil constraints imposes no constraints.
+func (k Keeper) RouteExactAmountInWithConstraints(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+	constraints *types.SwapConstraints,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
 		return osmomath.Int{}, err
 	}
 
+	if err := constraints.Validate(len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if err := constraints.CheckDeadline(ctx.BlockTime(), ctx.BlockHeight()); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	totalTakerFeesCharged := sdk.Coins{}
 	denomsInvolvedInRoute := []string{tokenIn.Denom}
 
@@ -80,6 +103,10 @@ func (k Keeper) RouteExactAmountIn(
 			return osmomath.Int{}, err
 		}
 
+		if err := constraints.CheckHopPrice(i, routeStep.PoolId, tokenIn.Amount, tokenOutAmount); err != nil {
+			return osmomath.Int{}, err
+		}
+
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
 
@@ -204,7 +231,7 @@ func (k Keeper) SwapExactAmountIn(
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackVolume(ctx, pool.GetId(), sender, tokenIn, takerFeeCharged)
 
 	return tokenOutAmount, takerFeeCharged, nil
 }
@@ -239,7 +266,7 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackVolume(ctx, pool.GetId(), sender, tokenIn, sdk.NewCoin(tokenIn.Denom, osmomath.ZeroInt()))
 
 	return tokenOutAmount, nil
 }
@@ -249,7 +276,8 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountInNoTakerFee(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount osmomath.Int, err error) {
-	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, false)
+	tokenOutAmount, _, err = k.multihopEstimateOutGivenExactAmountInInternal(ctx, nil, route, tokenIn, false)
+	return tokenOutAmount, err
 }
 
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
@@ -257,19 +285,33 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount osmomath.Int, err error) {
-	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, true)
+	return k.MultihopEstimateOutGivenExactAmountInForSender(ctx, nil, route, tokenIn)
+}
+
+// MultihopEstimateOutGivenExactAmountInForSender is MultihopEstimateOutGivenExactAmountIn, charging every hop the
+// taker fee the given sender would be charged, see GetTradingPairTakerFeeForSender.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInForSender(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (tokenOutAmount osmomath.Int, err error) {
+	tokenOutAmount, _, err = k.multihopEstimateOutGivenExactAmountInInternal(ctx, sender, route, tokenIn, true)
+	return tokenOutAmount, err
 }
 
 func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 	ctx sdk.Context,
+	sender sdk.AccAddress,
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	applyTakerFee bool,
-) (tokenOutAmount osmomath.Int, err error) {
+) (tokenOutAmount osmomath.Int, takerFeesCharged sdk.Coins, err error) {
 	// recover from panic
 	defer func() {
 		if r := recover(); r != nil {
 			tokenOutAmount = osmomath.Int{}
+			takerFeesCharged = nil
 			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
 				err = fmt.Errorf("function MultihopEstimateOutGivenExactAmountIn failed due to lack of gas: %v", d)
 			} else {
@@ -279,13 +321,13 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 	}()
 
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
-		return osmomath.Int{}, err
+		return osmomath.Int{}, nil, err
 	}
 
 	for _, routeStep := range route {
 		swapModule, poolI, err := k.GetPoolModuleAndPool(ctx, routeStep.PoolId)
 		if err != nil {
-			return osmomath.Int{}, err
+			return osmomath.Int{}, nil, err
 		}
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
@@ -293,22 +335,24 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		actualTokenIn := tokenIn
 		// apply taker fee if applicable
 		if applyTakerFee {
-			takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom)
+			takerFee, err := k.GetTradingPairTakerFeeForSender(ctx, tokenIn.Denom, routeStep.TokenOutDenom, sender)
 			if err != nil {
-				return osmomath.Int{}, err
+				return osmomath.Int{}, nil, err
 			}
 
-			actualTokenIn, _ = CalcTakerFeeExactIn(tokenIn, takerFee)
+			var takerFeeCharged sdk.Coin
+			actualTokenIn, takerFeeCharged = CalcTakerFeeExactIn(tokenIn, takerFee)
+			takerFeesCharged = takerFeesCharged.Add(takerFeeCharged)
 		}
 
 		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, poolI, actualTokenIn, routeStep.TokenOutDenom, spreadFactor)
 		if err != nil {
-			return osmomath.Int{}, err
+			return osmomath.Int{}, nil, err
 		}
 
 		tokenOutAmount = tokenOut.Amount
 		if !tokenOutAmount.IsPositive() {
-			return osmomath.Int{}, errors.New("token amount must be positive")
+			return osmomath.Int{}, nil, errors.New("token amount must be positive")
 		}
 
 		// Chain output of current pool as the input for the next routed pool
@@ -316,7 +360,7 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		// as CalcOutAmtGivenIn is responsible for ensuring the denom exists in the pool.
 		tokenIn = sdk.Coin{Denom: routeStep.TokenOutDenom, Amount: tokenOutAmount}
 	}
-	return tokenOutAmount, err
+	return tokenOutAmount, takerFeesCharged, err
 }
 
 // RouteExactAmountOut processes a swap along the given route using the swap function corresponding
@@ -330,6 +374,20 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenInMaxAmount osmomath.Int,
 	tokenOut sdk.Coin,
+) (tokenInAmount osmomath.Int, err error) {
+	return k.RouteExactAmountOutWithConstraints(ctx, sender, route, tokenInMaxAmount, tokenOut, nil)
+}
+
+// RouteExactAmountOutWithConstraints is RouteExactAmountOut, additionally enforcing the given
+// optional swap constraints. The swap fails if it is executed after the constraints' deadline,
+// or if the effective price of any hop, including the taker fee, exceeds the hop's max price.
+// A nil constraints imposes no constraints.
+func (k Keeper) RouteExactAmountOutWithConstraints(ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenInMaxAmount osmomath.Int,
+	tokenOut sdk.Coin,
+	constraints *types.SwapConstraints,
 ) (tokenInAmount osmomath.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
 	// Ensure that provided route is not empty and has valid denom format.
@@ -337,6 +395,14 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		return osmomath.Int{}, err
 	}
 
+	if err := constraints.Validate(len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if err := constraints.CheckDeadline(ctx.BlockTime(), ctx.BlockHeight()); err != nil {
+		return osmomath.Int{}, err
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			tokenInAmount = osmomath.Int{}
@@ -349,7 +415,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	}()
 
 	var insExpected []osmomath.Int
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, sender, route, tokenOut)
 
 	if err != nil {
 		return osmomath.Int{}, err
@@ -402,8 +468,12 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return osmomath.Int{}, err
 		}
 
+		if err := constraints.CheckHopPrice(i, pool.GetId(), tokenInAfterAddTakerFee.Amount, _tokenOut.Amount); err != nil {
+			return osmomath.Int{}, err
+		}
+
 		// Track volume for volume-splitting incentives
-		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		k.trackVolume(ctx, pool.GetId(), sender, sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount), takerFeeCharged)
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
@@ -539,6 +609,17 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+) (tokenInAmount osmomath.Int, err error) {
+	return k.MultihopEstimateInGivenExactAmountOutForSender(ctx, nil, route, tokenOut)
+}
+
+// MultihopEstimateInGivenExactAmountOutForSender is MultihopEstimateInGivenExactAmountOut, charging every hop the
+// taker fee the given sender would be charged, see GetTradingPairTakerFeeForSender.
+func (k Keeper) MultihopEstimateInGivenExactAmountOutForSender(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
 ) (tokenInAmount osmomath.Int, err error) {
 	var insExpected []osmomath.Int
 
@@ -560,7 +641,7 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	}
 
 	// Determine what the estimated input would be for each pool along the multi-hop route
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, sender, route, tokenOut)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -638,8 +719,10 @@ func (k Keeper) ListPoolsByDenom(
 // amount for this last pool and then chains that input as the output of the previous pool in the routeStep, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
 // routeStep of pools for the original multihop transaction.
+// Every pool is charged the taker fee of the given sender, see GetTradingPairTakerFeeForSender.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
+	sender sdk.AccAddress,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
@@ -654,7 +737,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, err := k.GetTradingPairTakerFeeForSender(ctx, routeStep.TokenInDenom, tokenOut.Denom, sender)
 		if err != nil {
 			return nil, err
 		}
@@ -707,13 +790,19 @@ func (k Keeper) TotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
 }
 
 // nolint: unused
-// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID.
+// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID,
+// as well as to the swap volume of the sender used for taker fee volume tiers. Every hop of a multihop swap counts
+// towards the sender's volume, the same way every hop is charged a taker fee. Hops that were charged no taker fee,
+// such as swaps of whitelisted senders or of zero taker fee trading pairs, do not count towards the sender's volume,
+// so that volume tiers cannot be reached by swapping for free.
 // Fails quietly if an OSMO paired pool cannot be found, although this should only happen in rare scenarios where OSMO is
 // removed as a base denom from the protorev module (which this function relies on).
 //
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
-func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, volumeGenerated, takerFeeCharged sdk.Coin) {
+	tracksUserVolume := takerFeeCharged.IsPositive()
+
 	// If the denom is already denominated in uosmo, we can just use it directly
 	OSMO, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
@@ -721,6 +810,9 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	}
 	if volumeGenerated.Denom == OSMO {
 		k.addVolume(ctx, poolId, volumeGenerated)
+		if tracksUserVolume {
+			k.addUserVolume(ctx, sender, volumeGenerated)
+		}
 		return
 	}
 
@@ -757,9 +849,23 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 
 	// Add this new volume to the global tracked volume for the pool ID
 	k.addVolume(ctx, poolId, sdk.NewCoin(OSMO, volumeInOsmo))
+	if !tracksUserVolume {
+		return
+	}
+
+	// The sender's volume lowers the taker fee they are charged, so it is priced with a TWAP rather than the spot price,
+	// which the sender could move within the same transaction to inflate their volume.
+	// If there is no TWAP over the full duration, such as for a pool younger than it, the sender's volume is left unchanged.
+	osmoPerInputTokenTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, osmoPairedPoolId, volumeGenerated.Denom, OSMO, ctx.BlockTime().Add(-types.UserVolumeTwapDuration))
+	if err != nil {
+		return
+	}
+	userVolumeInOsmo := osmomath.BigDecFromSDKInt(volumeGenerated.Amount).MulDec(osmoPerInputTokenTwap).Dec().TruncateInt()
+	k.addUserVolume(ctx, sender, sdk.NewCoin(OSMO, userVolumeInOsmo))
 }
 
-// addVolume adds the given volume to the global tracked volume for the given pool ID.
+// addVolume adds the given volume to the global tracked volume for the given pool ID,
+// as well as to the pool's bucket for the current volume epoch.
 func (k Keeper) addVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
 	// Get the current volume for the pool ID
 	currentTotalVolume := k.GetTotalVolumeForPool(ctx, poolId)
@@ -767,6 +873,8 @@ func (k Keeper) addVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Co
 	// Add newly generated volume to existing volume and set updated volume in state
 	newTotalVolume := currentTotalVolume.Add(volumeGenerated)
 	k.SetVolume(ctx, poolId, newTotalVolume)
+
+	k.addEpochVolume(ctx, poolId, volumeGenerated)
 }
 
 // SetVolume sets the given volume to the global tracked volume for the given pool ID.
@@ -3,6 +3,7 @@ package poolmanager_test
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -3348,7 +3349,7 @@ func (suite *KeeperTestSuite) TestCreateMultihopExpectedSwapOuts() {
 // runMultipleTrackVolumes runs TrackVolume on the same pool multiple times
 func (s *KeeperTestSuite) runMultipleTrackVolumes(poolId uint64, volume sdk.Coin, times int64) {
 	for i := 0; i < int(times); i++ {
-		s.App.PoolManagerKeeper.TrackVolume(s.Ctx, poolId, volume)
+		s.App.PoolManagerKeeper.TrackVolume(s.Ctx, poolId, s.TestAccs[0], volume)
 	}
 }
 
@@ -3924,3 +3925,85 @@ func (suite *KeeperTestSuite) TestListPoolsByDenom() {
 		})
 	}
 }
+
+// TestRouteWithConstraints tests that the deadline and max price per hop of the swap constraints
+// are enforced by both exact amount in and exact amount out routing.
+func (s *KeeperTestSuite) TestRouteWithConstraints() {
+	const blockHeight int64 = 100
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	pastTime := blockTime.Add(-time.Second)
+
+	tests := map[string]struct {
+		constraints *types.SwapConstraints
+		expectedErr error
+	}{
+		"nil constraints": {},
+		"deadline time at block time": {
+			constraints: &types.SwapConstraints{DeadlineTime: &blockTime},
+		},
+		"deadline time before block time": {
+			constraints: &types.SwapConstraints{DeadlineTime: &pastTime},
+			expectedErr: types.SwapDeadlineExceededError{},
+		},
+		"deadline height at block height": {
+			constraints: &types.SwapConstraints{DeadlineHeight: blockHeight},
+		},
+		"deadline height before block height": {
+			constraints: &types.SwapConstraints{DeadlineHeight: blockHeight - 1},
+			expectedErr: types.SwapDeadlineExceededError{},
+		},
+		"max prices above effective prices": {
+			constraints: &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.NewDec(2), osmomath.NewDec(2)}},
+		},
+		"max price of second hop below effective price": {
+			constraints: &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.NewDec(2), osmomath.OneDec()}},
+			expectedErr: types.MaxPriceExceededError{},
+		},
+		"zero max price disables the check of its hop": {
+			constraints: &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.ZeroDec(), osmomath.NewDec(2)}},
+		},
+		"max price per hop length mismatch": {
+			constraints: &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.NewDec(2)}},
+			expectedErr: types.MaxPricePerHopLengthMismatchError{},
+		},
+	}
+
+	for name, tc := range tests {
+		// Both hops have equal reserves, so the effective price of each hop is slightly above 1
+		// due to the spread factor, the taker fee and the price impact.
+		setup := func() (uint64, uint64) {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+			poolIdA := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+			poolIdB := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(BAR, defaultInitPoolAmount))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))))
+			return poolIdA, poolIdB
+		}
+
+		s.Run(name+"; exact amount in", func() {
+			poolIdA, poolIdB := setup()
+			route := []types.SwapAmountInRoute{{PoolId: poolIdA, TokenOutDenom: FOO}, {PoolId: poolIdB, TokenOutDenom: BAR}}
+
+			tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountInWithConstraints(s.Ctx, s.TestAccs[0], route, sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)), osmomath.OneInt(), tc.constraints)
+			if tc.expectedErr != nil {
+				s.Require().IsType(tc.expectedErr, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.IsPositive())
+		})
+
+		s.Run(name+"; exact amount out", func() {
+			poolIdA, poolIdB := setup()
+			route := []types.SwapAmountOutRoute{{PoolId: poolIdA, TokenInDenom: UOSMO}, {PoolId: poolIdB, TokenInDenom: FOO}}
+
+			tokenInAmount, err := s.App.PoolManagerKeeper.RouteExactAmountOutWithConstraints(s.Ctx, s.TestAccs[0], route, osmomath.NewInt(1_000_000), sdk.NewCoin(BAR, osmomath.NewInt(900_000)), tc.constraints)
+			if tc.expectedErr != nil {
+				s.Require().IsType(tc.expectedErr, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tokenInAmount.IsPositive())
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package poolmanager
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SimulateSwapExactAmountIn simulates swapping tokenIn along the given route. Every hop is charged the
+// taker fee of the given optional sender, see GetTradingPairTakerFeeForSender, and is applied to the pool
+// in a cache context that is never written, without moving any funds. The sender therefore need not hold
+// tokenIn. No minimum amount out is enforced.
+func (k Keeper) SimulateSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (response *queryproto.SimulateSwapResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			response = nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function SimulateSwapExactAmountIn failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function SimulateSwapExactAmountIn failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	hops := make([]queryproto.SwapHopSimulation, 0, len(route))
+	for _, routeStep := range route {
+		swapModule, pool, err := k.GetPoolModuleAndPool(cacheCtx, routeStep.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !pool.IsActive(cacheCtx) {
+			return nil, types.InactivePoolError{PoolId: pool.GetId()}
+		}
+
+		spotPriceBefore, err := swapModule.CalculateSpotPrice(cacheCtx, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
+		if err != nil {
+			return nil, err
+		}
+		spreadFactor := pool.GetSpreadFactor(cacheCtx)
+
+		takerFee, err := k.GetTradingPairTakerFeeForSender(cacheCtx, tokenIn.Denom, routeStep.TokenOutDenom, sender)
+		if err != nil {
+			return nil, err
+		}
+		tokenInAfterSubTakerFee, takerFeeCharged := CalcTakerFeeExactIn(tokenIn, takerFee)
+
+		tokenOut, spotPriceAfter, err := simulateHopExactAmountIn(cacheCtx, swapModule, pool, tokenInAfterSubTakerFee, routeStep.TokenOutDenom, spreadFactor)
+		if err != nil {
+			return nil, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return nil, errors.New("token amount must be positive")
+		}
+
+		hops = append(hops, newSwapHopSimulation(routeStep.PoolId, tokenIn, tokenOut, spreadFactor, takerFeeCharged, spotPriceBefore, spotPriceAfter))
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = tokenOut
+	}
+
+	return newSimulateSwapResponse(hops), nil
+}
+
+// SimulateSwapExactAmountOut simulates swapping for tokenOut along the given route. Every hop is charged the
+// taker fee of the given optional sender, see GetTradingPairTakerFeeForSender, and is applied to the pool
+// in a cache context that is never written, without moving any funds. The sender therefore need not hold
+// the token in. Hops are simulated from the last one backwards, the same way RouteExactAmountOut estimates
+// them. The simulation fails if the token in of the route exceeds tokenInMaxAmount, unless it is nil.
+func (k Keeper) SimulateSwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenInMaxAmount osmomath.Int,
+	tokenOut sdk.Coin,
+) (response *queryproto.SimulateSwapResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			response = nil
+			if isErr, d := osmoutils.IsOutOfGasError(r); isErr {
+				err = fmt.Errorf("function SimulateSwapExactAmountOut failed due to lack of gas: %v", d)
+			} else {
+				err = fmt.Errorf("function SimulateSwapExactAmountOut failed due to internal reason: %v", r)
+			}
+		}
+	}()
+
+	if err := types.SwapAmountOutRoutes(route).Validate(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	hops := make([]queryproto.SwapHopSimulation, len(route))
+	for i := len(route) - 1; i >= 0; i-- {
+		routeStep := route[i]
+		swapModule, pool, err := k.GetPoolModuleAndPool(cacheCtx, routeStep.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !pool.IsActive(cacheCtx) {
+			return nil, types.InactivePoolError{PoolId: pool.GetId()}
+		}
+
+		spotPriceBefore, err := swapModule.CalculateSpotPrice(cacheCtx, routeStep.PoolId, routeStep.TokenInDenom, tokenOut.Denom)
+		if err != nil {
+			return nil, err
+		}
+		spreadFactor := pool.GetSpreadFactor(cacheCtx)
+
+		takerFee, err := k.GetTradingPairTakerFeeForSender(cacheCtx, routeStep.TokenInDenom, tokenOut.Denom, sender)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIn, spotPriceAfter, err := simulateHopExactAmountOut(cacheCtx, swapModule, pool, tokenOut, routeStep.TokenInDenom, spreadFactor)
+		if err != nil {
+			return nil, err
+		}
+		tokenInAfterAddTakerFee, takerFeeCharged := CalcTakerFeeExactOut(tokenIn, takerFee)
+
+		hops[i] = newSwapHopSimulation(routeStep.PoolId, tokenInAfterAddTakerFee, tokenOut, spreadFactor, takerFeeCharged, spotPriceBefore, spotPriceAfter)
+
+		// The token in of the current pool is the expected output of the previous routed pool
+		tokenOut = tokenInAfterAddTakerFee
+	}
+
+	if !tokenInMaxAmount.IsNil() && tokenOut.Amount.GT(tokenInMaxAmount) {
+		return nil, types.PriceImpactProtectionExactOutError{Actual: tokenOut.Amount, MaxAmount: tokenInMaxAmount}
+	}
+
+	return newSimulateSwapResponse(hops), nil
+}
+
+// simulateHopExactAmountIn swaps tokenIn for tokenOutDenom against the pool in the given cache context,
+// and returns the token out along with the spot price of the pool after the swap. Pools of modules that
+// do not implement types.SwapSimulatorI are only quoted, and report a zero spot price after the swap.
+func simulateHopExactAmountIn(
+	cacheCtx sdk.Context,
+	swapModule types.PoolModuleI,
+	pool types.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenOut sdk.Coin, spotPriceAfter osmomath.BigDec, err error) {
+	swapSimulator, ok := swapModule.(types.SwapSimulatorI)
+	if !ok {
+		tokenOut, err = swapModule.CalcOutAmtGivenIn(cacheCtx, pool, tokenIn, tokenOutDenom, spreadFactor)
+		return tokenOut, osmomath.ZeroBigDec(), err
+	}
+
+	tokenOut, err = swapSimulator.SimulateSwapExactAmountIn(cacheCtx, pool, tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, osmomath.BigDec{}, err
+	}
+
+	spotPriceAfter, err = swapModule.CalculateSpotPrice(cacheCtx, pool.GetId(), tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.BigDec{}, err
+	}
+	return tokenOut, spotPriceAfter, nil
+}
+
+// simulateHopExactAmountOut swaps tokenInDenom for tokenOut against the pool in the given cache context,
+// and returns the token in along with the spot price of the pool after the swap. Pools of modules that
+// do not implement types.SwapSimulatorI are only quoted, and report a zero spot price after the swap.
+func simulateHopExactAmountOut(
+	cacheCtx sdk.Context,
+	swapModule types.PoolModuleI,
+	pool types.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	spreadFactor osmomath.Dec,
+) (tokenIn sdk.Coin, spotPriceAfter osmomath.BigDec, err error) {
+	swapSimulator, ok := swapModule.(types.SwapSimulatorI)
+	if !ok {
+		tokenIn, err = swapModule.CalcInAmtGivenOut(cacheCtx, pool, tokenOut, tokenInDenom, spreadFactor)
+		return tokenIn, osmomath.ZeroBigDec(), err
+	}
+
+	tokenIn, err = swapSimulator.SimulateSwapExactAmountOut(cacheCtx, pool, tokenOut, tokenInDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, osmomath.BigDec{}, err
+	}
+
+	spotPriceAfter, err = swapModule.CalculateSpotPrice(cacheCtx, pool.GetId(), tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.BigDec{}, err
+	}
+	return tokenIn, spotPriceAfter, nil
+}
+
+// newSwapHopSimulation returns the simulation of a hop swapping tokenIn, taker fee included, for tokenOut.
+// The spread fee is the spread factor applied to the amount swapped into the pool. The price impact is
+// zero if either spot price is unknown.
+func newSwapHopSimulation(
+	poolId uint64,
+	tokenIn, tokenOut sdk.Coin,
+	spreadFactor osmomath.Dec,
+	takerFee sdk.Coin,
+	spotPriceBefore, spotPriceAfter osmomath.BigDec,
+) queryproto.SwapHopSimulation {
+	amountSwapped := tokenIn.Amount.Sub(takerFee.Amount)
+	spreadFee := sdk.NewCoin(tokenIn.Denom, spreadFactor.MulInt(amountSwapped).TruncateInt())
+
+	priceImpact := osmomath.ZeroBigDec()
+	if spotPriceBefore.IsPositive() && spotPriceAfter.IsPositive() {
+		priceImpact = spotPriceAfter.Quo(spotPriceBefore).Sub(osmomath.OneBigDec())
+	}
+
+	return queryproto.SwapHopSimulation{
+		PoolId:          poolId,
+		TokenIn:         tokenIn,
+		TokenOut:        tokenOut,
+		SpreadFactor:    spreadFactor,
+		SpreadFee:       spreadFee,
+		TakerFee:        takerFee,
+		SpotPriceBefore: spotPriceBefore,
+		SpotPriceAfter:  spotPriceAfter,
+		EffectivePrice:  effectivePrice(tokenIn.Amount, tokenOut.Amount),
+		PriceImpact:     priceImpact,
+	}
+}
+
+// newSimulateSwapResponse returns the simulation of a swap made of the given hops, in route order.
+func newSimulateSwapResponse(hops []queryproto.SwapHopSimulation) *queryproto.SimulateSwapResponse {
+	spreadFees, takerFees := sdk.Coins{}, sdk.Coins{}
+	for _, hop := range hops {
+		spreadFees = spreadFees.Add(hop.SpreadFee)
+		takerFees = takerFees.Add(hop.TakerFee)
+	}
+
+	tokenIn, tokenOut := hops[0].TokenIn, hops[len(hops)-1].TokenOut
+	return &queryproto.SimulateSwapResponse{
+		Hops:           hops,
+		TokenIn:        tokenIn,
+		TokenOut:       tokenOut,
+		SpreadFees:     spreadFees,
+		TakerFees:      takerFees,
+		EffectivePrice: effectivePrice(tokenIn.Amount, tokenOut.Amount),
+	}
+}
+
+// effectivePrice returns amountIn / amountOut, or zero if amountOut is zero.
+func effectivePrice(amountIn, amountOut osmomath.Int) osmomath.BigDec {
+	if amountOut.IsZero() {
+		return osmomath.ZeroBigDec()
+	}
+	return osmomath.BigDecFromSDKInt(amountIn).Quo(osmomath.BigDecFromSDKInt(amountOut))
+}
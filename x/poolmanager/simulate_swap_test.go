@@ -0,0 +1,229 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestSimulateSwap() {
+	const (
+		senderIndex            = 0
+		whitelistedSenderIndex = 1
+		unfundedSenderIndex    = 2
+		noSenderIndex          = -1
+	)
+	tokenIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))
+	tokenOut := sdk.NewCoin(BAR, osmomath.NewInt(900_000))
+
+	tests := map[string]struct {
+		senderIndex       int
+		exactOut          bool
+		emptyRoute        bool
+		concentratedPoolB bool
+		tokenInMaxAmount  osmomath.Int
+
+		expectNoTakerFee bool
+		expectErr        error
+	}{
+		"exact amount in": {
+			senderIndex: senderIndex,
+		},
+		"exact amount out": {
+			senderIndex: senderIndex,
+			exactOut:    true,
+		},
+		"exact amount in, concentrated pool": {
+			senderIndex:       senderIndex,
+			concentratedPoolB: true,
+		},
+		"exact amount out, concentrated pool": {
+			senderIndex:       senderIndex,
+			exactOut:          true,
+			concentratedPoolB: true,
+		},
+		"exact amount in, whitelisted sender pays no taker fee": {
+			senderIndex:      whitelistedSenderIndex,
+			expectNoTakerFee: true,
+		},
+		"exact amount out, whitelisted sender pays no taker fee": {
+			senderIndex:      whitelistedSenderIndex,
+			exactOut:         true,
+			expectNoTakerFee: true,
+		},
+		"exact amount in, sender without funds": {
+			senderIndex: unfundedSenderIndex,
+		},
+		"exact amount out, sender without funds": {
+			senderIndex: unfundedSenderIndex,
+			exactOut:    true,
+		},
+		"exact amount in, no sender": {
+			senderIndex: noSenderIndex,
+		},
+		"exact amount out, no sender": {
+			senderIndex: noSenderIndex,
+			exactOut:    true,
+		},
+		"exact amount out, within token in max amount": {
+			senderIndex:      senderIndex,
+			exactOut:         true,
+			tokenInMaxAmount: tokenIn.Amount,
+		},
+		"error: exact amount out, token in max amount exceeded": {
+			senderIndex:      senderIndex,
+			exactOut:         true,
+			tokenInMaxAmount: tokenOut.Amount,
+			expectErr:        types.PriceImpactProtectionExactOutError{},
+		},
+		"error: empty route": {
+			senderIndex: senderIndex,
+			emptyRoute:  true,
+			expectErr:   types.ErrEmptyRoutes,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+
+			poolManagerParams := poolManager.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.DefaultTakerFee = testDefaultTakerFee
+			poolManagerParams.TakerFeeParams.ReducedFeeWhitelist = []string{s.TestAccs[whitelistedSenderIndex].String()}
+			poolManager.SetParams(s.Ctx, poolManagerParams)
+
+			poolIdA := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+			var poolIdB uint64
+			if tc.concentratedPoolB {
+				poolIdB = s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(FOO, BAR).GetId()
+			} else {
+				poolIdB = s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultInitPoolAmount), sdk.NewCoin(BAR, defaultInitPoolAmount))
+			}
+			s.FundAcc(s.TestAccs[senderIndex], sdk.NewCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount)))
+			s.FundAcc(s.TestAccs[whitelistedSenderIndex], sdk.NewCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount)))
+
+			var sender sdk.AccAddress
+			if tc.senderIndex != noSenderIndex {
+				sender = s.TestAccs[tc.senderIndex]
+			}
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[senderIndex])
+			liquidityABefore, err := poolManager.GetTotalPoolLiquidity(s.Ctx, poolIdA)
+			s.Require().NoError(err)
+			liquidityBBefore, err := poolManager.GetTotalPoolLiquidity(s.Ctx, poolIdB)
+			s.Require().NoError(err)
+			poolBBefore, err := poolManager.GetPool(s.Ctx, poolIdB)
+			s.Require().NoError(err)
+
+			inRoute := []types.SwapAmountInRoute{{PoolId: poolIdA, TokenOutDenom: FOO}, {PoolId: poolIdB, TokenOutDenom: BAR}}
+			outRoute := []types.SwapAmountOutRoute{{PoolId: poolIdA, TokenInDenom: UOSMO}, {PoolId: poolIdB, TokenInDenom: FOO}}
+			if tc.emptyRoute {
+				inRoute, outRoute = nil, nil
+			}
+
+			var response *queryproto.SimulateSwapResponse
+			if tc.exactOut {
+				response, err = poolManager.SimulateSwapExactAmountOut(s.Ctx, sender, outRoute, tc.tokenInMaxAmount, tokenOut)
+			} else {
+				response, err = poolManager.SimulateSwapExactAmountIn(s.Ctx, sender, inRoute, tokenIn)
+			}
+
+			// The simulation never changes state.
+			s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[senderIndex]))
+			liquidityAAfter, err2 := poolManager.GetTotalPoolLiquidity(s.Ctx, poolIdA)
+			s.Require().NoError(err2)
+			s.Require().Equal(liquidityABefore, liquidityAAfter)
+			liquidityBAfter, err2 := poolManager.GetTotalPoolLiquidity(s.Ctx, poolIdB)
+			s.Require().NoError(err2)
+			s.Require().Equal(liquidityBBefore, liquidityBAfter)
+			poolBAfter, err2 := poolManager.GetPool(s.Ctx, poolIdB)
+			s.Require().NoError(err2)
+			s.Require().Equal(poolBBefore, poolBAfter)
+
+			if tc.expectErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectErr, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(response.Hops, 2)
+
+			// Totals match the estimates of the same route for the same sender.
+			if tc.exactOut {
+				s.Require().Equal(tokenOut, response.TokenOut)
+				expectedTokenInAmount, err := poolManager.MultihopEstimateInGivenExactAmountOutForSender(s.Ctx, sender, outRoute, tokenOut)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoin(UOSMO, expectedTokenInAmount), response.TokenIn)
+			} else {
+				s.Require().Equal(tokenIn, response.TokenIn)
+				expectedTokenOutAmount, err := poolManager.MultihopEstimateOutGivenExactAmountInForSender(s.Ctx, sender, inRoute, tokenIn)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoin(BAR, expectedTokenOutAmount), response.TokenOut)
+			}
+
+			spreadFees, takerFees := sdk.Coins{}, sdk.Coins{}
+			for i, hop := range response.Hops {
+				// Hops are chained.
+				if i > 0 {
+					s.Require().Equal(response.Hops[i-1].TokenOut, hop.TokenIn)
+				}
+
+				pool, err := poolManager.GetPool(s.Ctx, hop.PoolId)
+				s.Require().NoError(err)
+				spreadFactor := pool.GetSpreadFactor(s.Ctx)
+				s.Require().Equal(spreadFactor, hop.SpreadFactor)
+
+				if tc.expectNoTakerFee {
+					s.Require().True(hop.TakerFee.IsZero())
+				} else {
+					if tc.exactOut {
+						_, expectedTakerFee := poolmanager.CalcTakerFeeExactOut(hop.TokenIn.Sub(hop.TakerFee), testDefaultTakerFee)
+						s.Require().Equal(expectedTakerFee, hop.TakerFee)
+					} else {
+						_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(hop.TokenIn, testDefaultTakerFee)
+						s.Require().Equal(expectedTakerFee, hop.TakerFee)
+					}
+					s.Require().True(hop.TakerFee.IsPositive())
+				}
+
+				expectedSpreadFee := spreadFactor.MulInt(hop.TokenIn.Amount.Sub(hop.TakerFee.Amount)).TruncateInt()
+				s.Require().Equal(expectedSpreadFee, hop.SpreadFee.Amount)
+
+				// Swapping in makes the token out more expensive.
+				s.Require().True(hop.SpotPriceAfter.GT(hop.SpotPriceBefore))
+				s.Require().True(hop.PriceImpact.IsPositive())
+				s.Require().True(hop.EffectivePrice.GT(hop.SpotPriceBefore))
+
+				spreadFees = spreadFees.Add(hop.SpreadFee)
+				takerFees = takerFees.Add(hop.TakerFee)
+			}
+			s.Require().Equal(spreadFees, response.SpreadFees)
+			s.Require().Equal(takerFees, response.TakerFees)
+
+			// A funded sender executing the swap gets the simulated amounts and spot prices.
+			if tc.senderIndex != senderIndex {
+				return
+			}
+			cacheCtx, _ := s.Ctx.CacheContext()
+			if tc.exactOut {
+				tokenInAmount, err := poolManager.RouteExactAmountOut(cacheCtx, sender, outRoute, response.TokenIn.Amount, tokenOut)
+				s.Require().NoError(err)
+				s.Require().Equal(response.TokenIn.Amount, tokenInAmount)
+			} else {
+				tokenOutAmount, err := poolManager.RouteExactAmountIn(cacheCtx, sender, inRoute, tokenIn, osmomath.OneInt())
+				s.Require().NoError(err)
+				s.Require().Equal(response.TokenOut.Amount, tokenOutAmount)
+			}
+			for _, hop := range response.Hops {
+				swapModule, err := poolManager.GetPoolModule(cacheCtx, hop.PoolId)
+				s.Require().NoError(err)
+				spotPriceAfter, err := swapModule.CalculateSpotPrice(cacheCtx, hop.PoolId, hop.TokenIn.Denom, hop.TokenOut.Denom)
+				s.Require().NoError(err)
+				s.Require().Equal(spotPriceAfter, hop.SpotPriceAfter)
+			}
+		})
+	}
+}
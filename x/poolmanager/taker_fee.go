@@ -99,6 +99,42 @@ func (k Keeper) GetTradingPairTakerFee(ctx sdk.Context, tokenInDenom, tokenOutDe
 	return takerFee.Dec, nil
 }
 
+// GetTradingPairTakerFeeForSender returns the taker fee the given sender is charged for the given trading pair:
+// zero if the sender is in the reduced taker fee whitelist, and otherwise the trading pair taker fee
+// discounted by the taker fee volume tier the sender qualifies for.
+// A nil sender is charged the trading pair taker fee.
+func (k Keeper) GetTradingPairTakerFeeForSender(ctx sdk.Context, tokenInDenom, tokenOutDenom string, sender sdk.AccAddress) (osmomath.Dec, error) {
+	if sender == nil {
+		return k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+	}
+	if k.isReducedTakerFeeWhitelisted(ctx, sender) {
+		return osmomath.ZeroDec(), nil
+	}
+	return k.getDiscountedTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom, sender)
+}
+
+// getDiscountedTradingPairTakerFee returns the trading pair taker fee discounted by the taker fee
+// volume tier the given sender qualifies for.
+func (k Keeper) getDiscountedTradingPairTakerFee(ctx sdk.Context, tokenInDenom, tokenOutDenom string, sender sdk.AccAddress) (osmomath.Dec, error) {
+	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	discount := k.getTakerFeeVolumeDiscount(ctx, sender)
+	if discount.IsPositive() {
+		takerFee = takerFee.Mul(osmomath.OneDec().Sub(discount))
+	}
+	return takerFee, nil
+}
+
+// isReducedTakerFeeWhitelisted returns whether the given sender is exempt from taker fees.
+func (k Keeper) isReducedTakerFeeWhitelisted(ctx sdk.Context, sender sdk.AccAddress) bool {
+	reducedFeeWhitelist := []string{}
+	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
+	return osmoutils.Contains(reducedFeeWhitelist, sender.String())
+}
+
 // GetAllTradingPairTakerFees returns all the custom taker fees for trading pairs.
 func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTakerFee, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -131,15 +167,13 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
 	takerFeeModuleAccountName := txfeestypes.TakerFeeCollectorName
 
-	reducedFeeWhitelist := []string{}
-	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
-
 	// Determine if eligible to bypass taker fee.
-	if osmoutils.Contains(reducedFeeWhitelist, sender.String()) {
+	if k.isReducedTakerFeeWhitelisted(ctx, sender) {
 		return tokenIn, sdk.Coin{Denom: tokenIn.Denom, Amount: zero}, nil
 	}
 
-	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, tokenOutDenom)
+	// Apply the discount of the taker fee volume tier the sender qualifies for.
+	takerFee, err := k.getDiscountedTradingPairTakerFee(ctx, tokenIn.Denom, tokenOutDenom, sender)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
@@ -0,0 +1,190 @@
+package poolmanager
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// SetTakerFeeVolumeTiers replaces the taker fee volume tier table.
+// An empty table disables volume based taker fee discounts.
+func (k Keeper) SetTakerFeeVolumeTiers(ctx sdk.Context, tiers []types.TakerFeeVolumeTier) {
+	store := ctx.KVStore(k.storeKey)
+	if len(tiers) == 0 {
+		store.Delete(types.KeyTakerFeeVolumeTiers)
+		return
+	}
+	osmoutils.MustSet(store, types.KeyTakerFeeVolumeTiers, &types.TakerFeeVolumeTiers{Tiers: tiers})
+}
+
+// getTakerFeeVolumeTiers returns the taker fee volume tier table, ordered by ascending minimum volume.
+func (k Keeper) getTakerFeeVolumeTiers(ctx sdk.Context) []types.TakerFeeVolumeTier {
+	var takerFeeVolumeTiers types.TakerFeeVolumeTiers
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTakerFeeVolumeTiers, &takerFeeVolumeTiers); err != nil {
+		panic(err)
+	}
+	return takerFeeVolumeTiers.Tiers
+}
+
+// GetTakerFeeVolumeTiers returns the taker fee volume tier table.
+func (k Keeper) GetTakerFeeVolumeTiers(ctx sdk.Context) (*queryproto.TakerFeeVolumeTiersResponse, error) {
+	return &queryproto.TakerFeeVolumeTiersResponse{
+		Tiers: k.getTakerFeeVolumeTiers(ctx),
+	}, nil
+}
+
+// GetUserTakerFeeTier returns the trailing swap volume of the given sender and the
+// taker fee volume tier it qualifies for.
+func (k Keeper) GetUserTakerFeeTier(ctx sdk.Context, sender sdk.AccAddress) (*queryproto.UserTakerFeeTierResponse, error) {
+	volume := k.GetUserTrailingOsmoVolume(ctx, sender)
+	tier, discount := types.TakerFeeVolumeTierForVolume(k.getTakerFeeVolumeTiers(ctx), volume)
+	return &queryproto.UserTakerFeeTierResponse{
+		Volume:   volume,
+		Tier:     tier,
+		Discount: discount,
+	}, nil
+}
+
+// getTakerFeeVolumeDiscount returns the fraction of the trading pair taker fee waived for
+// the given sender based on its trailing swap volume.
+func (k Keeper) getTakerFeeVolumeDiscount(ctx sdk.Context, sender sdk.AccAddress) osmomath.Dec {
+	tiers := k.getTakerFeeVolumeTiers(ctx)
+	if len(tiers) == 0 {
+		return osmomath.ZeroDec()
+	}
+	_, discount := types.TakerFeeVolumeTierForVolume(tiers, k.GetUserTrailingOsmoVolume(ctx, sender))
+	return discount
+}
+
+// SetUserEpochVolume sets the volume swapped by the given sender during the given volume epoch.
+// Note that it does not update the sender's trailing volume, see addUserVolume.
+func (k Keeper) SetUserEpochVolume(ctx sdk.Context, sender sdk.AccAddress, epochNumber uint64, volume sdk.Coins) {
+	storedVolume := types.TrackedVolume{Amount: volume}
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyUserEpochVolume(sender, epochNumber), &storedVolume)
+}
+
+// GetUserEpochVolume gets the volume swapped by the given sender during the given volume epoch.
+// Returns empty coins if none was tracked or if the epoch was pruned.
+func (k Keeper) GetUserEpochVolume(ctx sdk.Context, sender sdk.AccAddress, epochNumber uint64) sdk.Coins {
+	return k.getTrackedVolume(ctx, types.KeyUserEpochVolume(sender, epochNumber))
+}
+
+// GetUserTrailingOsmoVolume gets the OSMO-denominated volume swapped by the given sender over the
+// retained volume epochs, the current one included.
+func (k Keeper) GetUserTrailingOsmoVolume(ctx sdk.Context, sender sdk.AccAddress) osmomath.Int {
+	OSMO, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return k.getTrackedVolume(ctx, types.KeyUserTrailingVolume(sender)).AmountOf(OSMO)
+}
+
+// addUserVolume adds the given volume to the current volume epoch bucket of the given sender,
+// as well as to the sender's trailing volume.
+func (k Keeper) addUserVolume(ctx sdk.Context, sender sdk.AccAddress, volumeGenerated sdk.Coin) {
+	currentVolumeEpoch := k.GetCurrentVolumeEpoch(ctx)
+	currentEpochVolume := k.GetUserEpochVolume(ctx, sender, currentVolumeEpoch)
+	k.SetUserEpochVolume(ctx, sender, currentVolumeEpoch, currentEpochVolume.Add(volumeGenerated))
+	k.addUserTrailingVolume(ctx, sender, sdk.NewCoins(volumeGenerated))
+}
+
+// addUserTrailingVolume adds the given volume to the trailing volume of the given sender.
+func (k Keeper) addUserTrailingVolume(ctx sdk.Context, sender sdk.AccAddress, volume sdk.Coins) {
+	trailingVolumeKey := types.KeyUserTrailingVolume(sender)
+	trailingVolume := k.getTrackedVolume(ctx, trailingVolumeKey)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), trailingVolumeKey, &types.TrackedVolume{Amount: trailingVolume.Add(volume...)})
+}
+
+// pruneUserEpochVolumes deletes up to types.MaxUserEpochVolumesPrunedPerBlock volume buckets of senders from
+// volume epochs that left the retention window, and subtracts them from the senders' trailing volume.
+// Called at the end of every block, so that the buckets of a volume epoch are pruned incrementally.
+func (k Keeper) pruneUserEpochVolumes(ctx sdk.Context) {
+	currentVolumeEpoch := k.GetCurrentVolumeEpoch(ctx)
+	if currentVolumeEpoch < types.MaxVolumeEpochsRetained {
+		return
+	}
+	oldestRetainedEpoch := currentVolumeEpoch - types.MaxVolumeEpochsRetained + 1
+
+	kvStore := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(kvStore, types.KeyUserEpochVolumePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(oldestRetainedEpoch))
+	defer iter.Close()
+
+	var expiredEpochVolumes []types.UserEpochVolume
+	var keysToDelete [][]byte
+	for ; iter.Valid() && len(keysToDelete) < types.MaxUserEpochVolumesPrunedPerBlock; iter.Next() {
+		sender, epochNumber, err := types.ParseUserEpochVolumeKey(iter.Key())
+		if err != nil {
+			panic(err)
+		}
+		var expiredVolume types.TrackedVolume
+		if err := expiredVolume.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+
+		expiredEpochVolumes = append(expiredEpochVolumes, types.UserEpochVolume{
+			Address:     sender.String(),
+			EpochNumber: epochNumber,
+			Volume:      expiredVolume.Amount,
+		})
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+
+	for _, expiredEpochVolume := range expiredEpochVolumes {
+		trailingVolumeKey := types.KeyUserTrailingVolume(sdk.MustAccAddressFromBech32(expiredEpochVolume.Address))
+		trailingVolume, isNegative := k.getTrackedVolume(ctx, trailingVolumeKey).SafeSub(expiredEpochVolume.Volume...)
+		if isNegative || trailingVolume.IsZero() {
+			kvStore.Delete(trailingVolumeKey)
+		} else {
+			osmoutils.MustSet(kvStore, trailingVolumeKey, &types.TrackedVolume{Amount: trailingVolume})
+		}
+	}
+}
+
+// getAllUserEpochVolumes returns the retained volume epoch buckets of all senders.
+func (k Keeper) getAllUserEpochVolumes(ctx sdk.Context) []types.UserEpochVolume {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyUserEpochVolumePrefix)
+	defer iter.Close()
+
+	userEpochVolumes := []types.UserEpochVolume{}
+	for ; iter.Valid(); iter.Next() {
+		sender, epochNumber, err := types.ParseUserEpochVolumeKey(iter.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var trackedVolume types.TrackedVolume
+		if err := trackedVolume.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+
+		userEpochVolumes = append(userEpochVolumes, types.UserEpochVolume{
+			Address:     sender.String(),
+			EpochNumber: epochNumber,
+			Volume:      trackedVolume.Amount,
+		})
+	}
+	return userEpochVolumes
+}
+
+// getTrackedVolume gets the tracked volume stored under the given key. Returns empty coins if none is stored.
+func (k Keeper) getTrackedVolume(ctx sdk.Context, key []byte) sdk.Coins {
+	var trackedVolume types.TrackedVolume
+	volumeFound, err := osmoutils.Get(ctx.KVStore(k.storeKey), key, &trackedVolume)
+	if err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	if !volumeFound {
+		return sdk.NewCoins()
+	}
+	return trackedVolume.Amount
+}
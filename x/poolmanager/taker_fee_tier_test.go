@@ -0,0 +1,319 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+var testTakerFeeVolumeTiers = []types.TakerFeeVolumeTier{
+	{MinVolume: osmomath.NewInt(1000), Discount: osmomath.MustNewDecFromStr("0.1")},
+	{MinVolume: osmomath.NewInt(5000), Discount: osmomath.MustNewDecFromStr("0.5")},
+}
+
+// validates that the taker fee is discounted by the tier the sender's trailing volume qualifies for.
+func (s *KeeperTestSuite) TestChargeTakerFee_VolumeTiers() {
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		amount   = osmomath.NewInt(10000000)
+	)
+
+	tests := map[string]struct {
+		tiers          []types.TakerFeeVolumeTier
+		trailingVolume osmomath.Int
+		exactIn        bool
+
+		expectedTakerFee osmomath.Dec
+	}{
+		"no tiers": {
+			trailingVolume:   osmomath.NewInt(10000),
+			exactIn:          true,
+			expectedTakerFee: takerFee,
+		},
+		"below the lowest tier": {
+			tiers:            testTakerFeeVolumeTiers,
+			trailingVolume:   osmomath.NewInt(999),
+			exactIn:          true,
+			expectedTakerFee: takerFee,
+		},
+		"first tier": {
+			tiers:            testTakerFeeVolumeTiers,
+			trailingVolume:   osmomath.NewInt(1000),
+			exactIn:          true,
+			expectedTakerFee: takerFee.Mul(osmomath.MustNewDecFromStr("0.9")),
+		},
+		"highest tier": {
+			tiers:            testTakerFeeVolumeTiers,
+			trailingVolume:   osmomath.NewInt(10000),
+			exactIn:          true,
+			expectedTakerFee: takerFee.Mul(osmomath.MustNewDecFromStr("0.5")),
+		},
+		"highest tier, exact out": {
+			tiers:            testTakerFeeVolumeTiers,
+			trailingVolume:   osmomath.NewInt(10000),
+			exactIn:          false,
+			expectedTakerFee: takerFee.Mul(osmomath.MustNewDecFromStr("0.5")),
+		},
+		"full discount": {
+			tiers:            []types.TakerFeeVolumeTier{{MinVolume: osmomath.NewInt(1), Discount: osmomath.OneDec()}},
+			trailingVolume:   osmomath.NewInt(1),
+			exactIn:          true,
+			expectedTakerFee: osmomath.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[0]
+			tokenIn := sdk.NewCoin(apptesting.ETH, amount)
+
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+			poolManager.SetTakerFeeVolumeTiers(s.Ctx, tc.tiers)
+			poolManager.TrackVolume(s.Ctx, s.PrepareBalancerPool(), sender, sdk.NewCoin(UOSMO, tc.trailingVolume))
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+			// System under test.
+			tokenInAfterTakerFee, takerFeeCharged, err := poolManager.ChargeTakerFee(s.Ctx, tokenIn, apptesting.USDC, sender, tc.exactIn)
+			s.Require().NoError(err)
+
+			var expectedTokenInAfterTakerFee, expectedTakerFeeCharged sdk.Coin
+			if tc.exactIn {
+				expectedTokenInAfterTakerFee, expectedTakerFeeCharged = poolmanager.CalcTakerFeeExactIn(tokenIn, tc.expectedTakerFee)
+			} else {
+				expectedTokenInAfterTakerFee, expectedTakerFeeCharged = poolmanager.CalcTakerFeeExactOut(tokenIn, tc.expectedTakerFee)
+			}
+			s.Require().Equal(expectedTokenInAfterTakerFee, tokenInAfterTakerFee)
+			s.Require().Equal(expectedTakerFeeCharged, takerFeeCharged)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUserTakerFeeTier() {
+	hundredUosmo := sdk.NewCoin(UOSMO, osmomath.NewInt(100))
+
+	s.Run("swaps accrue the sender's trailing volume", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+		sender := s.TestAccs[0]
+		poolManager.SetDenomPairTakerFee(s.Ctx, UOSMO, FOO, osmomath.MustNewDecFromStr("0.01"))
+
+		tokenIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1000))
+		s.FundAcc(sender, sdk.NewCoins(tokenIn))
+		_, _, err := poolManager.SwapExactAmountIn(s.Ctx, sender, poolId, tokenIn, FOO, osmomath.OneInt())
+		s.Require().NoError(err)
+
+		// The sender's volume is tracked the same way as the pool's, in OSMO.
+		poolVolume := poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0)
+		s.Require().Equal(sdk.NewCoins(tokenIn), poolVolume)
+		s.Require().Equal(poolVolume, poolManager.GetUserEpochVolume(s.Ctx, sender, 0))
+		s.Require().Equal(poolVolume.AmountOf(UOSMO), poolManager.GetUserTrailingOsmoVolume(s.Ctx, sender))
+		s.Require().True(poolManager.GetUserTrailingOsmoVolume(s.Ctx, s.TestAccs[1]).IsZero())
+	})
+
+	s.Run("tier follows the trailing volume as buckets are pruned", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPool()
+		sender := s.TestAccs[0]
+		poolManager.SetTakerFeeVolumeTiers(s.Ctx, []types.TakerFeeVolumeTier{
+			{MinVolume: osmomath.NewInt(100), Discount: osmomath.MustNewDecFromStr("0.1")},
+			{MinVolume: osmomath.NewInt(200), Discount: osmomath.MustNewDecFromStr("0.2")},
+		})
+
+		response, err := poolManager.GetUserTakerFeeTier(s.Ctx, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), response.Tier)
+		s.Require().True(response.Volume.IsZero())
+		s.Require().True(response.Discount.IsZero())
+
+		// Volume in epoch zero and epoch one qualifies for the second tier.
+		poolManager.TrackVolume(s.Ctx, poolId, sender, hundredUosmo)
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, 1))
+		poolManager.TrackVolume(s.Ctx, poolId, sender, hundredUosmo)
+
+		response, err = poolManager.GetUserTakerFeeTier(s.Ctx, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), response.Tier)
+		s.Require().Equal(osmomath.NewInt(200), response.Volume)
+		s.Require().Equal(osmomath.MustNewDecFromStr("0.2"), response.Discount)
+
+		// Once epoch zero leaves the retention window, only the first tier is met.
+		for epochNumber := uint64(2); epochNumber <= types.TakerFeeVolumeTierNumEpochs; epochNumber++ {
+			s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, int64(epochNumber)))
+		}
+		// Expired buckets are pruned at the end of the block.
+		s.Require().Equal(sdk.NewCoins(hundredUosmo), poolManager.GetUserEpochVolume(s.Ctx, sender, 0))
+		poolManager.EndBlock(s.Ctx)
+		s.Require().Empty(poolManager.GetUserEpochVolume(s.Ctx, sender, 0))
+
+		response, err = poolManager.GetUserTakerFeeTier(s.Ctx, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), response.Tier)
+		s.Require().Equal(hundredUosmo.Amount, response.Volume)
+
+		// Once epoch one leaves the retention window too, the trailing volume is removed from state.
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, int64(types.TakerFeeVolumeTierNumEpochs+1)))
+		poolManager.EndBlock(s.Ctx)
+		response, err = poolManager.GetUserTakerFeeTier(s.Ctx, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), response.Tier)
+		s.Require().True(response.Volume.IsZero())
+		s.Require().Empty(poolManager.ExportGenesis(s.Ctx).UserEpochVolumes)
+	})
+
+	s.Run("swaps charged no taker fee do not accrue the sender's trailing volume", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+		poolManager.SetDenomPairTakerFee(s.Ctx, UOSMO, FOO, osmomath.ZeroDec())
+		sender := s.TestAccs[0]
+
+		tokenIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1000))
+		s.FundAcc(sender, sdk.NewCoins(tokenIn))
+		_, takerFeeCharged, err := poolManager.SwapExactAmountIn(s.Ctx, sender, poolId, tokenIn, FOO, osmomath.OneInt())
+		s.Require().NoError(err)
+		s.Require().True(takerFeeCharged.IsZero())
+
+		// The pool volume is still tracked.
+		s.Require().Equal(sdk.NewCoins(tokenIn), poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0))
+		s.Require().Empty(poolManager.GetUserEpochVolume(s.Ctx, sender, 0))
+		s.Require().True(poolManager.GetUserTrailingOsmoVolume(s.Ctx, sender).IsZero())
+	})
+
+	s.Run("sender's volume is priced with a TWAP rather than a skewed spot price", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+		s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, FOO, poolId)
+		sender := s.TestAccs[0]
+		volume := sdk.NewCoin(FOO, osmomath.NewInt(1000000))
+		poolManager.SetTakerFeeVolumeTiers(s.Ctx, []types.TakerFeeVolumeTier{
+			{MinVolume: volume.Amount.MulRaw(2), Discount: osmomath.MustNewDecFromStr("0.5")},
+		})
+
+		// The pool is younger than the TWAP duration, so the sender's volume is not tracked.
+		poolManager.TrackVolume(s.Ctx, poolId, sender, volume)
+		s.Require().Equal(volume.Amount, poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0).AmountOf(UOSMO))
+		s.Require().True(poolManager.GetUserTrailingOsmoVolume(s.Ctx, sender).IsZero())
+
+		// Skew the spot price of FOO in OSMO within the block.
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.UserVolumeTwapDuration))
+		tokenIn := sdk.NewCoin(UOSMO, defaultInitPoolAmount)
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		_, _, err := poolManager.SwapExactAmountIn(s.Ctx, s.TestAccs[1], poolId, tokenIn, FOO, osmomath.OneInt())
+		s.Require().NoError(err)
+		poolVolumeBefore := poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0).AmountOf(UOSMO)
+
+		poolManager.TrackVolume(s.Ctx, poolId, sender, volume)
+
+		// The pool volume is priced with the skewed spot price, which would qualify for the tier.
+		poolVolume := poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0).AmountOf(UOSMO).Sub(poolVolumeBefore)
+		s.Require().True(poolVolume.GTE(volume.Amount.MulRaw(2)))
+
+		// The sender's volume is priced with the TWAP, which the swap did not move.
+		response, err := poolManager.GetUserTakerFeeTier(s.Ctx, sender)
+		s.Require().NoError(err)
+		s.Require().Equal(volume.Amount, response.Volume)
+		s.Require().Equal(uint64(0), response.Tier)
+	})
+
+	s.Run("expired buckets are pruned over several blocks", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPool()
+
+		senders := apptesting.CreateRandomAccounts(types.MaxUserEpochVolumesPrunedPerBlock + 1)
+		for _, sender := range senders {
+			poolManager.TrackVolume(s.Ctx, poolId, sender, hundredUosmo)
+		}
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, int64(types.MaxVolumeEpochsRetained)))
+
+		poolManager.EndBlock(s.Ctx)
+		s.Require().Len(poolManager.ExportGenesis(s.Ctx).UserEpochVolumes, 1)
+
+		poolManager.EndBlock(s.Ctx)
+		s.Require().Empty(poolManager.ExportGenesis(s.Ctx).UserEpochVolumes)
+		for _, sender := range senders {
+			s.Require().True(poolManager.GetUserTrailingOsmoVolume(s.Ctx, sender).IsZero())
+		}
+	})
+
+	s.Run("tiers proposal replaces the table", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+
+		err := poolManager.HandleTakerFeeVolumeTiersProposal(s.Ctx, &types.TakerFeeVolumeTiersProposal{Tiers: testTakerFeeVolumeTiers})
+		s.Require().NoError(err)
+		response, err := poolManager.GetTakerFeeVolumeTiers(s.Ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(testTakerFeeVolumeTiers, response.Tiers)
+
+		// An empty table disables the tiers.
+		err = poolManager.HandleTakerFeeVolumeTiersProposal(s.Ctx, &types.TakerFeeVolumeTiersProposal{})
+		s.Require().NoError(err)
+		response, err = poolManager.GetTakerFeeVolumeTiers(s.Ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(response.Tiers)
+	})
+}
+
+// validates that quotes charge the taker fee of the given sender, matching the swaps it executes.
+func (s *KeeperTestSuite) TestTakerFeeVolumeTiers_Quotes() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultInitPoolAmount), sdk.NewCoin(FOO, defaultInitPoolAmount))
+	takerFee := osmomath.MustNewDecFromStr("0.01")
+	poolManager.SetDenomPairTakerFee(s.Ctx, UOSMO, FOO, takerFee)
+	poolManager.SetTakerFeeVolumeTiers(s.Ctx, testTakerFeeVolumeTiers)
+
+	sender, whitelistedSender, otherSender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	poolManager.TrackVolume(s.Ctx, poolId, sender, sdk.NewCoin(UOSMO, osmomath.NewInt(5000)))
+	poolManagerParams := poolManager.GetParams(s.Ctx)
+	poolManagerParams.TakerFeeParams.ReducedFeeWhitelist = []string{whitelistedSender.String()}
+	poolManager.SetParams(s.Ctx, poolManagerParams)
+
+	for _, tc := range []struct {
+		sender           sdk.AccAddress
+		expectedTakerFee osmomath.Dec
+	}{
+		{sender: nil, expectedTakerFee: takerFee},
+		{sender: otherSender, expectedTakerFee: takerFee},
+		{sender: sender, expectedTakerFee: takerFee.Mul(osmomath.MustNewDecFromStr("0.5"))},
+		{sender: whitelistedSender, expectedTakerFee: osmomath.ZeroDec()},
+	} {
+		actualTakerFee, err := poolManager.GetTradingPairTakerFeeForSender(s.Ctx, UOSMO, FOO, tc.sender)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expectedTakerFee, actualTakerFee)
+	}
+
+	tokenIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1000000))
+	inRoute := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: FOO}}
+	pairEstimate, err := poolManager.MultihopEstimateOutGivenExactAmountIn(s.Ctx, inRoute, tokenIn)
+	s.Require().NoError(err)
+	senderEstimate, err := poolManager.MultihopEstimateOutGivenExactAmountInForSender(s.Ctx, sender, inRoute, tokenIn)
+	s.Require().NoError(err)
+	s.Require().True(senderEstimate.GT(pairEstimate))
+
+	tokenOut := sdk.NewCoin(FOO, osmomath.NewInt(1000000))
+	outRoute := []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: UOSMO}}
+	senderInEstimate, err := poolManager.MultihopEstimateInGivenExactAmountOutForSender(s.Ctx, sender, outRoute, tokenOut)
+	s.Require().NoError(err)
+
+	// The estimates match the swaps executed by the sender.
+	s.FundAcc(sender, sdk.NewCoins(tokenIn.AddAmount(senderInEstimate)))
+	cacheCtx, _ := s.Ctx.CacheContext()
+	tokenOutAmount, err := poolManager.RouteExactAmountIn(cacheCtx, sender, inRoute, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(senderEstimate, tokenOutAmount)
+
+	cacheCtx, _ = s.Ctx.CacheContext()
+	tokenInAmount, err := poolManager.RouteExactAmountOut(cacheCtx, sender, outRoute, senderInEstimate, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(senderInEstimate, tokenInAmount)
+}
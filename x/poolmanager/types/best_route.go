@@ -0,0 +1,30 @@
+package types
+
+const (
+	// DefaultBestRouteMaxHops is the maximum number of pools in a route
+	// searched by the BestRoute query if the request leaves it unset.
+	DefaultBestRouteMaxHops uint64 = 2
+	// MaxBestRouteMaxHops is the upper bound on the hops a BestRoute request may ask for.
+	MaxBestRouteMaxHops uint64 = 3
+
+	// DefaultBestRouteMaxRoutes is the number of routes returned by the BestRoute query
+	// if the request leaves it unset.
+	DefaultBestRouteMaxRoutes uint64 = 3
+	// MaxBestRouteMaxRoutes is the upper bound on the routes a BestRoute request may ask for.
+	MaxBestRouteMaxRoutes uint64 = 10
+
+	// DefaultBestRouteMaxPoolsPerDenom is the number of pools considered for every denom
+	// along a route if the request leaves it unset.
+	DefaultBestRouteMaxPoolsPerDenom uint64 = 20
+	// MaxBestRouteMaxPoolsPerDenom is the upper bound on the pools per denom a BestRoute
+	// request may ask for.
+	MaxBestRouteMaxPoolsPerDenom uint64 = 50
+
+	// BestRouteMaxCandidates bounds the number of candidate routes estimated by a single
+	// BestRoute query, keeping its cost predictable regardless of pool topology.
+	BestRouteMaxCandidates = 500
+
+	// BestRouteSplitSteps is the number of equal parts token in is divided into when
+	// estimating a split across routes.
+	BestRouteSplitSteps = 10
+)
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -204,3 +205,66 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type SwapDeadlineExceededError struct {
+	DeadlineTime   *time.Time
+	DeadlineHeight int64
+	BlockTime      time.Time
+	BlockHeight    int64
+}
+
+func (e SwapDeadlineExceededError) Error() string {
+	deadlineTime := "none"
+	if e.DeadlineTime != nil {
+		deadlineTime = e.DeadlineTime.String()
+	}
+	return fmt.Sprintf("swap deadline exceeded: deadline time (%s), deadline height (%d), block time (%s), block height (%d)", deadlineTime, e.DeadlineHeight, e.BlockTime, e.BlockHeight)
+}
+
+type MaxPricePerHopLengthMismatchError struct {
+	NumMaxPrices int
+	NumHops      int
+}
+
+func (e MaxPricePerHopLengthMismatchError) Error() string {
+	return fmt.Sprintf("max price per hop must have one entry per hop, had (%d) max prices for (%d) hops", e.NumMaxPrices, e.NumHops)
+}
+
+type MaxPriceExceededError struct {
+	PoolId         uint64
+	EffectivePrice osmomath.Dec
+	MaxPrice       osmomath.Dec
+}
+
+func (e MaxPriceExceededError) Error() string {
+	return fmt.Sprintf("effective price (%s) of swap in pool (%d) exceeds max price (%s)", e.EffectivePrice, e.PoolId, e.MaxPrice)
+}
+
+type BestRouteLimitExceededError struct {
+	Limit string
+	Value uint64
+	Max   uint64
+}
+
+func (e BestRouteLimitExceededError) Error() string {
+	return fmt.Sprintf("best route %s (%d) exceeds the maximum (%d)", e.Limit, e.Value, e.Max)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       uint64
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s) within (%d) hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
+
+type VolumeEpochsLimitExceededError struct {
+	NumEpochs uint64
+	Max       uint64
+}
+
+func (e VolumeEpochsLimitExceededError) Error() string {
+	return fmt.Sprintf("requested volume over %d epochs, only the last %d are retained", e.NumEpochs, e.Max)
+}
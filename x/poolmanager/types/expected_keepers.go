@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -25,6 +26,7 @@ type BankI interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -97,6 +99,29 @@ type PoolModuleI interface {
 	GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error)
 }
 
+// SwapSimulatorI is optionally implemented by pool modules that can apply a swap
+// to the state of a pool without moving any funds. It is used to simulate swaps.
+type SwapSimulatorI interface {
+	// SimulateSwapExactAmountIn applies the swap of tokenIn for tokenOutDenom to the state of the pool
+	// in ctx and returns the token out. No funds are moved, so callers must pass a cache context that is discarded.
+	SimulateSwapExactAmountIn(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		spreadFactor osmomath.Dec,
+	) (tokenOut sdk.Coin, err error)
+	// SimulateSwapExactAmountOut applies the swap of tokenInDenom for tokenOut to the state of the pool
+	// in ctx and returns the token in. No funds are moved, so callers must pass a cache context that is discarded.
+	SimulateSwapExactAmountOut(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		spreadFactor osmomath.Dec,
+	) (tokenIn sdk.Coin, err error)
+}
+
 type ConcentratedI interface {
 	PoolModuleI
 	GetWhitelistedAddresses(ctx sdk.Context) []string
@@ -123,3 +148,7 @@ type ProtorevKeeper interface {
 type WasmKeeper interface {
 	QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
 }
+
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,5 +29,27 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, poolEpochVolume := range gs.PoolEpochVolumes {
+		if poolEpochVolume.EpochNumber > gs.CurrentVolumeEpoch {
+			return fmt.Errorf("pool (%d) has volume for epoch (%d) after the current volume epoch (%d)", poolEpochVolume.PoolId, poolEpochVolume.EpochNumber, gs.CurrentVolumeEpoch)
+		}
+		if err := poolEpochVolume.Volume.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := ValidateTakerFeeVolumeTiers(gs.TakerFeeVolumeTiers); err != nil {
+		return err
+	}
+	for _, userEpochVolume := range gs.UserEpochVolumes {
+		if _, err := sdk.AccAddressFromBech32(userEpochVolume.Address); err != nil {
+			return err
+		}
+		if userEpochVolume.EpochNumber > gs.CurrentVolumeEpoch {
+			return fmt.Errorf("address (%s) has volume for epoch (%d) after the current volume epoch (%d)", userEpochVolume.Address, userEpochVolume.EpochNumber, gs.CurrentVolumeEpoch)
+		}
+		if err := userEpochVolume.Volume.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -111,6 +111,16 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// pool_epoch_volumes are the retained per-epoch volume buckets of the pools.
+	PoolEpochVolumes []PoolEpochVolume `protobuf:"bytes,7,rep,name=pool_epoch_volumes,json=poolEpochVolumes,proto3" json:"pool_epoch_volumes"`
+	// current_volume_epoch is the number of the epoch volume is currently
+	// tracked in.
+	CurrentVolumeEpoch uint64 `protobuf:"varint,8,opt,name=current_volume_epoch,json=currentVolumeEpoch,proto3" json:"current_volume_epoch,omitempty" yaml:"current_volume_epoch"`
+	// taker_fee_volume_tiers is the taker fee volume tier table.
+	TakerFeeVolumeTiers []TakerFeeVolumeTier `protobuf:"bytes,9,rep,name=taker_fee_volume_tiers,json=takerFeeVolumeTiers,proto3" json:"taker_fee_volume_tiers"`
+	// user_epoch_volumes are the retained per-epoch swap volume buckets of the
+	// senders.
+	UserEpochVolumes []UserEpochVolume `protobuf:"bytes,10,rep,name=user_epoch_volumes,json=userEpochVolumes,proto3" json:"user_epoch_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +198,34 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolEpochVolumes() []PoolEpochVolume {
+	if m != nil {
+		return m.PoolEpochVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentVolumeEpoch() uint64 {
+	if m != nil {
+		return m.CurrentVolumeEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTakerFeeVolumeTiers() []TakerFeeVolumeTier {
+	if m != nil {
+		return m.TakerFeeVolumeTiers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUserEpochVolumes() []UserEpochVolume {
+	if m != nil {
+		return m.UserEpochVolumes
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -453,6 +491,71 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolEpochVolume stores the KVStore entries for the volume of a pool
+// generated during a single epoch, which is used in export/import genesis.
+type PoolEpochVolume struct {
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// epoch_number is the number of the epoch the volume was generated in.
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// volume is the volume of the pool generated during the epoch.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *PoolEpochVolume) Reset()         { *m = PoolEpochVolume{} }
+func (m *PoolEpochVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolEpochVolume) ProtoMessage()    {}
+func (*PoolEpochVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
+}
+func (m *PoolEpochVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolEpochVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolEpochVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolEpochVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolEpochVolume.Merge(m, src)
+}
+func (m *PoolEpochVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolEpochVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolEpochVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolEpochVolume proto.InternalMessageInfo
+
+func (m *PoolEpochVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolEpochVolume) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PoolEpochVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
@@ -460,6 +563,7 @@ func init() {
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*PoolEpochVolume)(nil), "osmosis.poolmanager.v1beta1.PoolEpochVolume")
 }
 
 func init() {
@@ -467,75 +571,85 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1081 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
-	0x18, 0x8e, 0xbb, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0xe2, 0xa5, 0x41, 0x0a, 0x12, 0xd0, 0x43, 0x36, 0x51, 0x10, 0xa8, 0xb4, 0xa9, 0x13,
-	0x81, 0x54, 0x0e, 0xa3, 0x59, 0xfb, 0x8d, 0xd7, 0x5a, 0xdb, 0xb3, 0xcc, 0x8c, 0xf3, 0xc1, 0x5f,
-	0xe0, 0x82, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x90, 0xf8, 0x11, 0x3d, 0xf6, 0x88, 0x38, 0x6c, 0x51,
-	0x72, 0xe6, 0xb2, 0xbf, 0x00, 0x79, 0x66, 0xf6, 0xb3, 0xc9, 0x36, 0xc0, 0x69, 0xd7, 0xef, 0xfb,
-	0x3e, 0x8f, 0x9f, 0xf7, 0x6b, 0xc6, 0xe8, 0x03, 0x26, 0x52, 0x26, 0x62, 0x51, 0xef, 0x30, 0x96,
-	0xa4, 0x34, 0xa3, 0x11, 0xf0, 0xfa, 0xf1, 0x83, 0x26, 0x48, 0xfa, 0xa0, 0x1e, 0x41, 0x06, 0x22,
-	0x16, 0x5e, 0x87, 0x33, 0xc9, 0xf0, 0xba, 0x09, 0xf5, 0x46, 0x42, 0x3d, 0x13, 0xba, 0xf6, 0x6e,
-	0xc4, 0x22, 0xa6, 0xe2, 0xea, 0xc5, 0x3f, 0x0d, 0x59, 0xbb, 0x13, 0x31, 0x16, 0x25, 0x50, 0x57,
-	0x4f, 0xcd, 0xfc, 0xa8, 0x4e, 0xb3, 0xb3, 0xbe, 0x2b, 0x50, 0x74, 0x44, 0x63, 0xf4, 0x83, 0x71,
-	0x55, 0x26, 0x51, 0x61, 0xce, 0xa9, 0x8c, 0x59, 0xd6, 0xf7, 0xeb, 0xe8, 0x7a, 0x93, 0x0a, 0x18,
-	0x68, 0x0d, 0x58, 0xdc, 0xf7, 0x7b, 0xd3, 0x72, 0x4a, 0x59, 0x98, 0x27, 0x40, 0x38, 0xcb, 0x25,
-	0x98, 0xf8, 0xfb, 0xd3, 0xe2, 0xe5, 0xa9, 0x8e, 0x72, 0x7b, 0x37, 0x50, 0x79, 0x9f, 0x72, 0x9a,
-	0x0a, 0xfc, 0xdc, 0x42, 0xb7, 0x8a, 0x58, 0x12, 0x70, 0x50, 0xc2, 0xc8, 0x11, 0x80, 0x6d, 0x55,
-	0x4b, 0xb5, 0xf9, 0xcd, 0x3b, 0x9e, 0xc9, 0xa5, 0x50, 0xd7, 0x2f, 0x8f, 0xb7, 0xc3, 0xe2, 0xac,
-	0xf1, 0xe8, 0x45, 0xd7, 0x99, 0xe9, 0x75, 0x1d, 0xfb, 0x8c, 0xa6, 0xc9, 0xa7, 0xee, 0x6b, 0x0c,
-	0xee, 0xaf, 0xaf, 0x9c, 0x5a, 0x14, 0xcb, 0x56, 0xde, 0xf4, 0x02, 0x96, 0x9a, 0xa2, 0x98, 0x9f,
-	0x0d, 0x11, 0xb6, 0xeb, 0xf2, 0xac, 0x03, 0x42, 0x91, 0x09, 0x7f, 0xa9, 0xc0, 0xef, 0x18, 0xf8,
-	0x1e, 0x00, 0x3e, 0x46, 0xcb, 0x92, 0xb6, 0x81, 0x17, 0x54, 0xa4, 0xa3, 0x94, 0xda, 0x37, 0xaa,
-	0x56, 0x6d, 0x7e, 0xf3, 0x43, 0x6f, 0x4a, 0xeb, 0xbc, 0xc3, 0x02, 0xb4, 0x07, 0xa0, 0x93, 0x6b,
-	0x38, 0x46, 0xe5, 0xaa, 0x56, 0x39, 0x49, 0xe9, 0xfa, 0x8b, 0x72, 0x0c, 0x80, 0x9f, 0xa1, 0x55,
-	0x9a, 0xcb, 0x16, 0xe3, 0xf1, 0x0f, 0x10, 0x92, 0xef, 0x73, 0x26, 0x81, 0x84, 0x90, 0xb1, 0x54,
-	0xd8, 0xa5, 0x6a, 0xa9, 0x36, 0xd7, 0x70, 0x7b, 0x5d, 0xa7, 0xa2, 0xd9, 0xae, 0x08, 0x74, 0xfd,
-	0x95, 0xa1, 0xe7, 0x69, 0xe1, 0xd8, 0xd5, 0xf6, 0x57, 0x25, 0xb4, 0xf0, 0x85, 0x9e, 0xc2, 0x03,
-	0x49, 0x25, 0xe0, 0x2a, 0x5a, 0xc8, 0xe0, 0x54, 0x12, 0x55, 0xbc, 0x38, 0xb4, 0xad, 0xaa, 0x55,
-	0x9b, 0xf5, 0x51, 0x61, 0xdb, 0x67, 0x2c, 0xf9, 0x32, 0xc4, 0xdb, 0xa8, 0x3c, 0x96, 0xfc, 0xbd,
-	0xa9, 0xc9, 0x9b, 0xa4, 0x67, 0x8b, 0xa4, 0x7d, 0x03, 0xc4, 0x4f, 0xd0, 0xbc, 0xe2, 0x57, 0x43,
-	0xa2, 0xb3, 0x98, 0xdf, 0xac, 0x4d, 0xe5, 0xf9, 0x5a, 0x8d, 0x95, 0x5f, 0x00, 0x0c, 0x19, 0x2a,
-	0xc2, 0x94, 0x41, 0xe0, 0xef, 0x10, 0x1e, 0xd4, 0x51, 0x10, 0xc9, 0x69, 0xd0, 0x06, 0x6e, 0xcf,
-	0x2a, 0x7d, 0x1b, 0xd7, 0x6a, 0x8e, 0x38, 0xd4, 0x20, 0x7f, 0x59, 0x4e, 0x58, 0xf0, 0x57, 0x68,
-	0x41, 0xa9, 0x3d, 0x66, 0x49, 0x9e, 0x82, 0xb0, 0x6f, 0x2a, 0xb9, 0xef, 0x4f, 0x4f, 0x9b, 0xb1,
-	0xe4, 0x1b, 0x15, 0xef, 0xab, 0x54, 0xf5, 0x7f, 0x81, 0x3b, 0x68, 0x4d, 0x75, 0x84, 0x74, 0x68,
-	0xcc, 0xc9, 0xb0, 0xf7, 0x42, 0x32, 0x0e, 0x76, 0x59, 0x31, 0x7b, 0x53, 0x99, 0x55, 0xe3, 0xf6,
-	0x69, 0xcc, 0xfb, 0xca, 0x4d, 0x39, 0x6e, 0x87, 0x93, 0x8e, 0x83, 0x82, 0xd3, 0xfd, 0xb1, 0x8c,
-	0x16, 0xc7, 0x27, 0x10, 0x37, 0xd1, 0xad, 0x10, 0x8e, 0x68, 0x9e, 0xc8, 0xa1, 0x02, 0xd5, 0xe8,
-	0xb9, 0xc6, 0x56, 0xc1, 0xf5, 0x67, 0xd7, 0x59, 0xd7, 0x4b, 0x21, 0xc2, 0xb6, 0x17, 0xb3, 0x7a,
-	0x4a, 0x65, 0xcb, 0x7b, 0x04, 0x11, 0x0d, 0xce, 0x76, 0x21, 0x38, 0xef, 0x3a, 0x4b, 0xbb, 0x1a,
-	0xdf, 0x27, 0xf6, 0x97, 0xc2, 0x71, 0x03, 0xfe, 0xd9, 0x42, 0xea, 0x3c, 0x1b, 0xc9, 0x31, 0x8c,
-	0x85, 0xe4, 0x71, 0x33, 0x2f, 0xf6, 0xc9, 0xcc, 0xce, 0x67, 0xd7, 0xea, 0xcd, 0xee, 0x08, 0x70,
-	0x1f, 0x78, 0x00, 0x99, 0xa4, 0x11, 0x34, 0xaa, 0x85, 0xd6, 0xf3, 0xae, 0x63, 0x3f, 0x11, 0x29,
-	0xbb, 0x2c, 0xd6, 0xb7, 0xd9, 0x15, 0x1e, 0xfc, 0x8b, 0x85, 0x9c, 0x8c, 0x65, 0x64, 0x9a, 0xc4,
-	0xd2, 0xff, 0x97, 0x78, 0xcf, 0x48, 0x5c, 0x7f, 0xcc, 0xb2, 0x2b, 0x55, 0xae, 0x67, 0x57, 0x3b,
-	0xf1, 0x0e, 0x5a, 0xa2, 0x61, 0x1a, 0x67, 0x84, 0x86, 0x21, 0x07, 0x21, 0x40, 0xd8, 0xb3, 0x6a,
-	0xe9, 0xd7, 0x7a, 0x5d, 0xe7, 0xb6, 0x59, 0xfa, 0xf1, 0x00, 0xd7, 0x5f, 0x54, 0x96, 0xed, 0xbe,
-	0x01, 0xff, 0x66, 0xa1, 0xad, 0x80, 0xa5, 0x69, 0x9e, 0xc5, 0xf2, 0x4c, 0xaf, 0xb6, 0x9e, 0x42,
-	0xc9, 0x88, 0x38, 0xa1, 0x1d, 0x52, 0x94, 0xe2, 0xa4, 0x15, 0x4b, 0x48, 0x62, 0x21, 0x21, 0x24,
-	0x54, 0x08, 0x90, 0x82, 0x48, 0x66, 0xdf, 0x54, 0x63, 0xb1, 0xdd, 0xeb, 0x3a, 0x0f, 0xf5, 0xcb,
-	0xfe, 0x1b, 0x8f, 0xeb, 0x7b, 0x03, 0x60, 0xb1, 0x1b, 0x6a, 0x8a, 0x0f, 0xd9, 0xc1, 0x09, 0xed,
-	0x3c, 0x66, 0xd9, 0xb7, 0x43, 0xc8, 0xb6, 0x42, 0x1c, 0x32, 0x7c, 0x88, 0x56, 0x38, 0x84, 0x79,
-	0x00, 0xa1, 0xea, 0xcc, 0x80, 0x55, 0x2d, 0xc9, 0x5c, 0xa3, 0xda, 0xeb, 0x3a, 0x77, 0xb5, 0xa2,
-	0x4b, 0xc3, 0x5c, 0xff, 0x1d, 0x63, 0xdf, 0x03, 0x18, 0xf0, 0xbb, 0x7f, 0x5b, 0xa8, 0x32, 0xbd,
-	0x67, 0xf8, 0x08, 0x2d, 0x09, 0x49, 0xdb, 0x71, 0x16, 0x11, 0x0e, 0x27, 0x94, 0x87, 0xc2, 0xec,
-	0xc6, 0xc3, 0x6b, 0xec, 0xc6, 0xb0, 0x29, 0x13, 0x1c, 0xae, 0xbf, 0x68, 0x2c, 0xbe, 0x36, 0xe0,
-	0x00, 0x2d, 0x8e, 0xd7, 0x52, 0xed, 0xc4, 0x5c, 0xe3, 0xf3, 0xeb, 0xbd, 0x66, 0xe5, 0xb2, 0x76,
-	0xb8, 0xfe, 0xdb, 0x63, 0x65, 0x76, 0x7f, 0xbf, 0x81, 0x96, 0x27, 0x8f, 0x38, 0xec, 0xa3, 0x95,
-	0xd1, 0xd3, 0x92, 0x11, 0xa1, 0x1e, 0xc5, 0x9b, 0x6f, 0x58, 0x7d, 0xd4, 0xe0, 0xe1, 0x11, 0xc9,
-	0x0e, 0x34, 0x14, 0x13, 0x74, 0x77, 0x9c, 0xf3, 0xb5, 0xdc, 0xae, 0x45, 0x6d, 0x8f, 0x50, 0xef,
-	0x8c, 0x66, 0x82, 0xdb, 0xe8, 0xbd, 0x16, 0xc4, 0x51, 0x4b, 0x12, 0x1a, 0x04, 0x2c, 0xcf, 0x64,
-	0x51, 0x5c, 0x21, 0x29, 0x97, 0x82, 0x1c, 0x71, 0x96, 0xaa, 0x75, 0x2d, 0x35, 0x6a, 0xbd, 0xae,
-	0x73, 0x5f, 0x97, 0x66, 0x6a, 0xb8, 0xeb, 0xaf, 0x69, 0xff, 0xf6, 0xc0, 0x7d, 0xa0, 0xbc, 0x7b,
-	0x85, 0xf3, 0xb9, 0x85, 0xd0, 0xf0, 0x08, 0xc7, 0xab, 0xe8, 0xad, 0xf1, 0xfb, 0xb0, 0xdc, 0xd1,
-	0x77, 0x61, 0x62, 0x2e, 0x32, 0x7d, 0x35, 0xbc, 0x39, 0xc9, 0x8f, 0x8a, 0x24, 0xff, 0xd5, 0x57,
-	0x08, 0x1a, 0xde, 0x1e, 0x8d, 0xa7, 0x2f, 0xce, 0x2b, 0xd6, 0xcb, 0xf3, 0x8a, 0xf5, 0xd7, 0x79,
-	0xc5, 0xfa, 0xe9, 0xa2, 0x32, 0xf3, 0xf2, 0xa2, 0x32, 0xf3, 0xc7, 0x45, 0x65, 0xe6, 0xd9, 0x27,
-	0x23, 0x7c, 0xe6, 0xb8, 0xda, 0x48, 0x68, 0x53, 0xf4, 0x1f, 0xea, 0xc7, 0x9b, 0x5b, 0xf5, 0xd3,
-	0xb1, 0xef, 0x2f, 0xf5, 0x92, 0x66, 0x59, 0x7d, 0x7b, 0x7d, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x22, 0x45, 0x06, 0x9b, 0xa7, 0x0a, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x36, 0xa9, 0x4b, 0x26, 0x21, 0x49, 0xa7, 0x4d, 0xbb, 0x4d, 0x8a, 0xd7, 0x6c, 0x2b,
+	0x61, 0x04, 0x5d, 0xb7, 0x41, 0x2a, 0x12, 0xd0, 0x43, 0x9c, 0x10, 0x04, 0x2a, 0x6d, 0xba, 0x09,
+	0x20, 0x95, 0xc3, 0x32, 0xde, 0x7d, 0xb1, 0x97, 0x78, 0x77, 0x96, 0x99, 0xd9, 0xa4, 0xe1, 0x2b,
+	0x70, 0x41, 0xea, 0x95, 0x33, 0x07, 0x6e, 0x20, 0x3e, 0x44, 0x8f, 0x3d, 0x22, 0x0e, 0x06, 0xa5,
+	0x67, 0x2e, 0xfe, 0x04, 0x68, 0xfe, 0xf8, 0xcf, 0xba, 0x89, 0x63, 0xfe, 0x9c, 0xec, 0x7d, 0xef,
+	0xfd, 0x7e, 0xf3, 0x7b, 0xef, 0xcd, 0xbc, 0x19, 0xf4, 0x26, 0xe5, 0x09, 0xe5, 0x31, 0xaf, 0x65,
+	0x94, 0xb6, 0x13, 0x92, 0x92, 0x26, 0xb0, 0xda, 0xc1, 0x9d, 0x06, 0x08, 0x72, 0xa7, 0xd6, 0x84,
+	0x14, 0x78, 0xcc, 0xbd, 0x8c, 0x51, 0x41, 0xf1, 0xaa, 0x09, 0xf5, 0x86, 0x42, 0x3d, 0x13, 0xba,
+	0x72, 0xb9, 0x49, 0x9b, 0x54, 0xc5, 0xd5, 0xe4, 0x3f, 0x0d, 0x59, 0xb9, 0xd6, 0xa4, 0xb4, 0xd9,
+	0x86, 0x9a, 0xfa, 0x6a, 0xe4, 0x7b, 0x35, 0x92, 0x1e, 0xf5, 0x5c, 0xa1, 0xa2, 0x0b, 0x34, 0x46,
+	0x7f, 0x18, 0x57, 0x79, 0x14, 0x15, 0xe5, 0x8c, 0x88, 0x98, 0xa6, 0x3d, 0xbf, 0x8e, 0xae, 0x35,
+	0x08, 0x87, 0xbe, 0xd6, 0x90, 0xc6, 0x3d, 0xbf, 0x37, 0x2e, 0xa7, 0x84, 0x46, 0x79, 0x1b, 0x02,
+	0x46, 0x73, 0x01, 0x26, 0xfe, 0xe6, 0xb8, 0x78, 0xf1, 0xc4, 0x44, 0xdd, 0x1e, 0x1b, 0x45, 0xf6,
+	0x81, 0x05, 0x7b, 0x00, 0x81, 0x88, 0x81, 0x69, 0x84, 0xdb, 0x3d, 0x87, 0x4a, 0xdb, 0x84, 0x91,
+	0x84, 0xe3, 0xa7, 0x16, 0xba, 0x28, 0x71, 0x41, 0xc8, 0x40, 0xa5, 0x22, 0x63, 0x6d, 0xab, 0x32,
+	0x5d, 0x9d, 0x5b, 0xbb, 0xe6, 0x99, 0xec, 0x65, 0x3e, 0xbd, 0x82, 0x7a, 0x1b, 0x34, 0x4e, 0xeb,
+	0xf7, 0x9f, 0x75, 0x9c, 0xa9, 0x6e, 0xc7, 0xb1, 0x8f, 0x48, 0xd2, 0x7e, 0xcf, 0x7d, 0x89, 0xc1,
+	0xfd, 0xe9, 0x0f, 0xa7, 0xda, 0x8c, 0x45, 0x2b, 0x6f, 0x78, 0x21, 0x4d, 0x4c, 0x19, 0xcd, 0xcf,
+	0x2d, 0x1e, 0xed, 0xd7, 0xc4, 0x51, 0x06, 0x5c, 0x91, 0x71, 0x7f, 0x51, 0xe2, 0x37, 0x0c, 0x7c,
+	0x0b, 0x00, 0x1f, 0xa0, 0xa5, 0x81, 0xf0, 0x4c, 0x29, 0xb5, 0xcf, 0x55, 0xac, 0xea, 0xdc, 0xda,
+	0x5b, 0xde, 0x98, 0x66, 0x7b, 0xbb, 0x12, 0xb4, 0x05, 0xa0, 0x93, 0xab, 0x3b, 0x46, 0xe5, 0x55,
+	0xad, 0x72, 0x94, 0xd2, 0xf5, 0x17, 0x44, 0x01, 0x80, 0x1f, 0xa3, 0xab, 0x24, 0x17, 0x2d, 0xca,
+	0xe2, 0x6f, 0x21, 0x0a, 0xbe, 0xc9, 0xa9, 0x80, 0x20, 0x82, 0x94, 0x26, 0xdc, 0x9e, 0xae, 0x4c,
+	0x57, 0x67, 0xeb, 0x6e, 0xb7, 0xe3, 0x94, 0x35, 0xdb, 0x29, 0x81, 0xae, 0xbf, 0x3c, 0xf0, 0x3c,
+	0x92, 0x8e, 0x4d, 0x6d, 0x3f, 0x2e, 0xa1, 0xf9, 0x8f, 0xf4, 0xbe, 0xdd, 0x11, 0x44, 0x00, 0xae,
+	0xa0, 0xf9, 0x14, 0x9e, 0x88, 0x40, 0x15, 0x2f, 0x8e, 0x6c, 0xab, 0x62, 0x55, 0x67, 0x7c, 0x24,
+	0x6d, 0xdb, 0x94, 0xb6, 0x3f, 0x8e, 0xf0, 0x3a, 0x2a, 0x15, 0x92, 0xbf, 0x31, 0x36, 0x79, 0x93,
+	0xf4, 0x8c, 0x4c, 0xda, 0x37, 0x40, 0xfc, 0x10, 0xcd, 0x29, 0x7e, 0xb5, 0xad, 0x74, 0x16, 0x73,
+	0x6b, 0xd5, 0xb1, 0x3c, 0x9f, 0xaa, 0x8d, 0xe8, 0x4b, 0x80, 0x21, 0x43, 0x32, 0x4c, 0x19, 0x38,
+	0xfe, 0x12, 0xe1, 0x7e, 0x1d, 0x79, 0x20, 0x18, 0x09, 0xf7, 0x81, 0xd9, 0x33, 0x4a, 0xdf, 0xad,
+	0x89, 0x9a, 0xc3, 0x77, 0x35, 0xc8, 0x5f, 0x12, 0x23, 0x16, 0xfc, 0x09, 0x9a, 0x57, 0x6a, 0x0f,
+	0x68, 0x3b, 0x4f, 0x80, 0xdb, 0xe7, 0x95, 0xdc, 0x37, 0xc6, 0xa7, 0x4d, 0x69, 0xfb, 0x73, 0x15,
+	0xef, 0xcf, 0x65, 0xfd, 0xff, 0x1c, 0x67, 0x68, 0x45, 0x75, 0x24, 0xc8, 0x48, 0xcc, 0x82, 0x41,
+	0xef, 0xb9, 0xa0, 0x0c, 0xec, 0x92, 0x62, 0xf6, 0xc6, 0x32, 0xab, 0xc6, 0x6d, 0x93, 0x98, 0xf5,
+	0x94, 0x9b, 0x72, 0x5c, 0x89, 0x46, 0x1d, 0x3b, 0x92, 0x13, 0x7f, 0x85, 0xb0, 0x52, 0x0f, 0x19,
+	0x0d, 0x5b, 0xfd, 0x1c, 0x2e, 0xa8, 0x95, 0xde, 0x3e, 0x33, 0x87, 0x0f, 0x25, 0x4a, 0x8b, 0x37,
+	0xeb, 0x2c, 0x65, 0x45, 0x33, 0xc7, 0x8f, 0xd0, 0xe5, 0x30, 0x67, 0x0c, 0x52, 0x61, 0xe8, 0xf5,
+	0x5a, 0xf6, 0x2b, 0x72, 0xeb, 0xd4, 0x9d, 0x6e, 0xc7, 0x59, 0xd5, 0x9b, 0xf3, 0xa4, 0x28, 0xd7,
+	0xc7, 0xc6, 0xac, 0xe9, 0x14, 0x33, 0xfe, 0x1a, 0x5d, 0x19, 0xd4, 0xc6, 0x84, 0xcb, 0x51, 0xc1,
+	0xed, 0x59, 0x25, 0xbc, 0x36, 0x51, 0x4f, 0x35, 0xe3, 0x6e, 0x0c, 0xcc, 0x68, 0xbf, 0x24, 0x5e,
+	0xf2, 0x70, 0x59, 0xa0, 0x9c, 0x03, 0x1b, 0x29, 0x10, 0x9a, 0xa0, 0x40, 0x9f, 0x71, 0x60, 0x27,
+	0x14, 0x28, 0x2f, 0x9a, 0xb9, 0xfb, 0x5d, 0x09, 0x2d, 0x14, 0x87, 0x00, 0x6e, 0xa0, 0x8b, 0x11,
+	0xec, 0x91, 0xbc, 0x2d, 0x06, 0x9b, 0x40, 0x9d, 0xb5, 0xd9, 0xfa, 0x5d, 0xc9, 0xf2, 0x7b, 0xc7,
+	0x59, 0xd5, 0x73, 0x89, 0x47, 0xfb, 0x5e, 0x4c, 0x6b, 0x09, 0x11, 0x2d, 0xef, 0x3e, 0x34, 0x49,
+	0x78, 0xb4, 0x09, 0xe1, 0x71, 0xc7, 0x59, 0xdc, 0xd4, 0xf8, 0x1e, 0xb1, 0xbf, 0x18, 0x15, 0x0d,
+	0xf8, 0x07, 0x0b, 0xa9, 0x4b, 0x68, 0x68, 0x9b, 0x45, 0x31, 0x17, 0x2c, 0x6e, 0xe4, 0x72, 0xa4,
+	0x99, 0xe3, 0xfb, 0xfe, 0x44, 0xa5, 0xdc, 0x1c, 0x02, 0x6e, 0x03, 0x0b, 0x21, 0x15, 0xa4, 0x09,
+	0xf5, 0x8a, 0xd4, 0x7a, 0xdc, 0x71, 0xec, 0x87, 0x3c, 0xa1, 0x27, 0xc5, 0xfa, 0x36, 0x3d, 0xc5,
+	0x83, 0x7f, 0xb4, 0x90, 0x93, 0xd2, 0x34, 0x18, 0x27, 0x71, 0xfa, 0xbf, 0x4b, 0xbc, 0x61, 0x24,
+	0xae, 0x3e, 0xa0, 0xe9, 0xa9, 0x2a, 0x57, 0xd3, 0xd3, 0x9d, 0x78, 0x03, 0x2d, 0x92, 0x28, 0x89,
+	0xd3, 0x80, 0x44, 0x11, 0x03, 0xce, 0x81, 0xdb, 0x33, 0x6a, 0xee, 0xae, 0x74, 0x3b, 0xce, 0x15,
+	0x33, 0x77, 0x8b, 0x01, 0xae, 0xbf, 0xa0, 0x2c, 0xeb, 0x3d, 0x03, 0xfe, 0xd9, 0x42, 0x77, 0x43,
+	0x9a, 0x24, 0x79, 0x1a, 0x8b, 0x23, 0x3d, 0x5d, 0xf5, 0x20, 0x10, 0x34, 0xe0, 0x87, 0x24, 0x0b,
+	0x64, 0x29, 0x0e, 0x5b, 0xb1, 0x80, 0x76, 0xcc, 0x05, 0x44, 0x01, 0xe1, 0x1c, 0x04, 0x0f, 0x04,
+	0xb5, 0xcf, 0xab, 0x6d, 0xb1, 0xde, 0xed, 0x38, 0xf7, 0xcc, 0x39, 0xfa, 0x57, 0x3c, 0xae, 0xef,
+	0xf5, 0x81, 0xf2, 0x68, 0xab, 0x41, 0xb2, 0x4b, 0x77, 0x0e, 0x49, 0xf6, 0x80, 0xa6, 0x5f, 0x0c,
+	0x20, 0xeb, 0x0a, 0xb1, 0x4b, 0xf1, 0x2e, 0x5a, 0x66, 0x10, 0xe5, 0x21, 0x44, 0xaa, 0x33, 0x7d,
+	0x56, 0x35, 0xa7, 0x66, 0xeb, 0x95, 0x6e, 0xc7, 0xb9, 0xae, 0x15, 0x9d, 0x18, 0xe6, 0xfa, 0x97,
+	0x8c, 0x7d, 0x0b, 0xa0, 0xcf, 0xef, 0xfe, 0x65, 0xa1, 0xf2, 0xf8, 0x9e, 0xe1, 0x3d, 0xb4, 0xc8,
+	0x05, 0xd9, 0x8f, 0xd3, 0x66, 0xc0, 0xe0, 0x90, 0xb0, 0x88, 0x9b, 0xb3, 0x71, 0x6f, 0x82, 0xb3,
+	0x31, 0x68, 0xca, 0x08, 0x87, 0xeb, 0x2f, 0x18, 0x8b, 0xaf, 0x0d, 0x38, 0x44, 0x0b, 0xc5, 0x5a,
+	0xaa, 0x33, 0x31, 0x5b, 0xff, 0x60, 0xb2, 0x65, 0x96, 0x4f, 0x6a, 0x87, 0xeb, 0xbf, 0x5a, 0x28,
+	0xb3, 0xfb, 0xeb, 0x39, 0xb4, 0x34, 0x7a, 0xcb, 0x60, 0x1f, 0x2d, 0x0f, 0x5f, 0x58, 0x34, 0xe0,
+	0xea, 0x93, 0x9f, 0xfd, 0xc8, 0xd1, 0x43, 0x06, 0x0f, 0x6e, 0x29, 0xba, 0xa3, 0xa1, 0x38, 0x40,
+	0xd7, 0x8b, 0x9c, 0x2f, 0xe5, 0x36, 0x11, 0xb5, 0x3d, 0x44, 0xbd, 0x31, 0x9c, 0x09, 0xde, 0x47,
+	0xaf, 0xb5, 0x20, 0x6e, 0xb6, 0x44, 0x40, 0xc2, 0x90, 0xe6, 0xa9, 0x90, 0xc5, 0xe5, 0x82, 0x30,
+	0xc1, 0x83, 0x3d, 0x46, 0x13, 0x75, 0x5c, 0xa7, 0xeb, 0xd5, 0x6e, 0xc7, 0xb9, 0xa9, 0x4b, 0x33,
+	0x36, 0xdc, 0xf5, 0x57, 0xb4, 0x7f, 0xbd, 0xef, 0xde, 0x51, 0xde, 0x2d, 0xe9, 0x7c, 0x6a, 0x21,
+	0x34, 0xb8, 0x45, 0xf1, 0x55, 0x74, 0xa1, 0xf8, 0x24, 0x29, 0x65, 0xfa, 0x39, 0xd2, 0x36, 0x6f,
+	0x09, 0x3d, 0xb8, 0xcf, 0x4e, 0xf2, 0xb6, 0x4c, 0xf2, 0x1f, 0x3d, 0x04, 0xd1, 0xe0, 0x02, 0x77,
+	0x7f, 0xb1, 0xd0, 0xe2, 0xc8, 0xbd, 0x78, 0xba, 0xb4, 0xd7, 0xd1, 0xbc, 0xbe, 0x54, 0xd2, 0x3c,
+	0x69, 0x00, 0x53, 0x9b, 0x6b, 0xc6, 0x9f, 0x53, 0xb6, 0x07, 0xca, 0x84, 0x43, 0x54, 0x32, 0xc2,
+	0xa7, 0xff, 0x7f, 0xe1, 0x86, 0xba, 0xfe, 0xe8, 0xd9, 0x71, 0xd9, 0x7a, 0x7e, 0x5c, 0xb6, 0xfe,
+	0x3c, 0x2e, 0x5b, 0xdf, 0xbf, 0x28, 0x4f, 0x3d, 0x7f, 0x51, 0x9e, 0xfa, 0xed, 0x45, 0x79, 0xea,
+	0xf1, 0xbb, 0x43, 0x5c, 0x66, 0xc6, 0xde, 0x6a, 0x93, 0x06, 0xef, 0x7d, 0xd4, 0x0e, 0xd6, 0xee,
+	0xd6, 0x9e, 0x14, 0xde, 0xf0, 0x6a, 0x81, 0x46, 0x49, 0xbd, 0xd9, 0xdf, 0xf9, 0x7b, 0x00, 0x9e,
+	0x4b, 0x08, 0x14, 0x11, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +728,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UserEpochVolumes) > 0 {
+		for iNdEx := len(m.UserEpochVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserEpochVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TakerFeeVolumeTiers) > 0 {
+		for iNdEx := len(m.TakerFeeVolumeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeVolumeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CurrentVolumeEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentVolumeEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PoolEpochVolumes) > 0 {
+		for iNdEx := len(m.PoolEpochVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolEpochVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -905,6 +1066,53 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolEpochVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolEpochVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolEpochVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -972,6 +1180,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolEpochVolumes) > 0 {
+		for _, e := range m.PoolEpochVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CurrentVolumeEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.CurrentVolumeEpoch))
+	}
+	if len(m.TakerFeeVolumeTiers) > 0 {
+		for _, e := range m.TakerFeeVolumeTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UserEpochVolumes) > 0 {
+		for _, e := range m.UserEpochVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1061,6 +1290,27 @@ func (m *PoolVolume) Size() (n int) {
 	return n
 }
 
+func (m *PoolEpochVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +1685,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolEpochVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolEpochVolumes = append(m.PoolEpochVolumes, PoolEpochVolume{})
+			if err := m.PoolEpochVolumes[len(m.PoolEpochVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVolumeEpoch", wireType)
+			}
+			m.CurrentVolumeEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentVolumeEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeVolumeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeVolumeTiers = append(m.TakerFeeVolumeTiers, TakerFeeVolumeTier{})
+			if err := m.TakerFeeVolumeTiers[len(m.TakerFeeVolumeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserEpochVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserEpochVolumes = append(m.UserEpochVolumes, UserEpochVolume{})
+			if err := m.UserEpochVolumes[len(m.UserEpochVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2060,6 +2431,128 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolEpochVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolEpochVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolEpochVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
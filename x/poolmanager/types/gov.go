@@ -8,15 +8,18 @@ import (
 )
 
 const (
-	ProposalTypeDenomPairTakerFee = "DenomPairTakerFee"
+	ProposalTypeDenomPairTakerFee   = "DenomPairTakerFee"
+	ProposalTypeTakerFeeVolumeTiers = "TakerFeeVolumeTiers"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeDenomPairTakerFee)
+	govtypesv1.RegisterProposalType(ProposalTypeTakerFeeVolumeTiers)
 }
 
 var (
 	_ govtypesv1.Content = &DenomPairTakerFeeProposal{}
+	_ govtypesv1.Content = &TakerFeeVolumeTiersProposal{}
 )
 
 // NewDenomPairTakerFeeProposal returns a new instance of a denom pair taker fee proposal struct.
@@ -66,3 +69,51 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewTakerFeeVolumeTiersProposal returns a new instance of a taker fee volume tiers proposal struct.
+func NewTakerFeeVolumeTiersProposal(title, description string, tiers []TakerFeeVolumeTier) govtypesv1.Content {
+	return &TakerFeeVolumeTiersProposal{
+		Title:       title,
+		Description: description,
+		Tiers:       tiers,
+	}
+}
+
+func (p *TakerFeeVolumeTiersProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *TakerFeeVolumeTiersProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *TakerFeeVolumeTiersProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *TakerFeeVolumeTiersProposal) ProposalType() string {
+	return ProposalTypeTakerFeeVolumeTiers
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *TakerFeeVolumeTiersProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateTakerFeeVolumeTiers(p.Tiers)
+}
+
+// String returns a string containing the taker fee volume tiers proposal.
+func (p TakerFeeVolumeTiersProposal) String() string {
+	tiersStr := ""
+	for _, tier := range p.Tiers {
+		tiersStr = tiersStr + fmt.Sprintf("(MinVolume: %s, Discount: %s) ", tier.MinVolume, tier.Discount)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Taker Fee Volume Tiers Proposal:
+Title:       %s
+Description: %s
+Tiers:       %s
+`, p.Title, p.Description, tiersStr))
+	return b.String()
+}
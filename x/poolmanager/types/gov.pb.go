@@ -63,8 +63,49 @@ func (m *DenomPairTakerFeeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DenomPairTakerFeeProposal proto.InternalMessageInfo
 
+// TakerFeeVolumeTiersProposal is a type for replacing the taker fee volume tier
+// table. An empty table disables volume based taker fee discounts.
+type TakerFeeVolumeTiersProposal struct {
+	Title       string               `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tiers       []TakerFeeVolumeTier `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *TakerFeeVolumeTiersProposal) Reset()      { *m = TakerFeeVolumeTiersProposal{} }
+func (*TakerFeeVolumeTiersProposal) ProtoMessage() {}
+func (*TakerFeeVolumeTiersProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{1}
+}
+func (m *TakerFeeVolumeTiersProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeVolumeTiersProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeVolumeTiersProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeVolumeTiersProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeVolumeTiersProposal.Merge(m, src)
+}
+func (m *TakerFeeVolumeTiersProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeVolumeTiersProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeVolumeTiersProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeVolumeTiersProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DenomPairTakerFeeProposal)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFeeProposal")
+	proto.RegisterType((*TakerFeeVolumeTiersProposal)(nil), "osmosis.poolmanager.v1beta1.TakerFeeVolumeTiersProposal")
 }
 
 func init() {
@@ -72,25 +113,29 @@ func init() {
 }
 
 var fileDescriptor_c95b3c1cda2a8632 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x86, 0x2a, 0xd3, 0x43, 0x52, 0xa6, 0x07, 0x55, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xa9, 0xe0, 0x33, 0xb9, 0xa4, 0x02, 0xa2,
-	0x4a, 0xe9, 0x08, 0x23, 0x97, 0xa4, 0x4b, 0x6a, 0x5e, 0x7e, 0x6e, 0x40, 0x62, 0x66, 0x51, 0x48,
-	0x62, 0x76, 0x6a, 0x91, 0x5b, 0x6a, 0x6a, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0x62, 0x8e, 0x90,
-	0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x84,
-	0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92, 0x99, 0x9f, 0x27,
-	0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0x4a, 0xe5, 0x12, 0x49, 0x01, 0x19, 0x1a, 0x5f, 0x90, 0x98,
-	0x59, 0x14, 0x5f, 0x02, 0x32, 0x36, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb,
-	0x48, 0x4f, 0x0f, 0x8f, 0x6f, 0xf4, 0x30, 0x5c, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90,
-	0x60, 0x0a, 0xba, 0x84, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x02,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xad, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3,
-	0x5f, 0x66, 0x64, 0xa6, 0x5f, 0x81, 0x12, 0x48, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x31, 0x1b, 0xd3, 0xcd, 0xa2, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xbd, 0x4e, 0x02, 0x41,
+	0x10, 0x80, 0x6f, 0x45, 0x8d, 0x1e, 0x95, 0x17, 0x0a, 0x84, 0xe4, 0x20, 0x44, 0x13, 0x1a, 0x77,
+	0x05, 0x13, 0x4d, 0x2c, 0x89, 0xb1, 0xb1, 0x41, 0x42, 0x2c, 0x6c, 0x2e, 0x7b, 0x30, 0x9e, 0x1b,
+	0xef, 0x98, 0xcb, 0xee, 0x42, 0xf0, 0x0d, 0x2c, 0x2d, 0x2d, 0x79, 0x00, 0x1f, 0xc3, 0x82, 0x92,
+	0xd2, 0xca, 0x18, 0x78, 0x11, 0x73, 0x3f, 0x28, 0x4a, 0x72, 0x8d, 0xdd, 0xee, 0xcc, 0x37, 0x33,
+	0xdf, 0x64, 0xcc, 0x43, 0x54, 0x01, 0x2a, 0xa1, 0x58, 0x88, 0xe8, 0x07, 0x7c, 0xc0, 0x3d, 0x90,
+	0x6c, 0xd4, 0x70, 0x41, 0xf3, 0x06, 0xf3, 0x70, 0x44, 0x43, 0x89, 0x1a, 0xad, 0x72, 0x8a, 0xd1,
+	0x15, 0x8c, 0xa6, 0x58, 0xa9, 0xe0, 0xa1, 0x87, 0x31, 0xc7, 0xa2, 0x57, 0x52, 0x52, 0x3a, 0xc8,
+	0xea, 0xac, 0xc7, 0x29, 0x75, 0x9c, 0x49, 0xf1, 0x07, 0x90, 0xce, 0x1d, 0x80, 0xa3, 0x05, 0xc8,
+	0xa4, 0xa2, 0xf6, 0x46, 0xcc, 0xfd, 0x0b, 0x18, 0x60, 0xd0, 0xe6, 0x42, 0x76, 0x23, 0xe2, 0x12,
+	0xa0, 0x2d, 0x31, 0x44, 0xc5, 0x7d, 0xab, 0x60, 0x6e, 0x69, 0xa1, 0x7d, 0x28, 0x92, 0x2a, 0xa9,
+	0xef, 0x76, 0x92, 0x8f, 0x55, 0x35, 0xf3, 0x7d, 0x50, 0x3d, 0x29, 0x42, 0x2d, 0x70, 0x50, 0xdc,
+	0x88, 0x73, 0xab, 0x21, 0x0b, 0xcc, 0x42, 0x3f, 0x6a, 0xea, 0x84, 0x5c, 0x48, 0xe7, 0x7b, 0x70,
+	0x31, 0x57, 0xcd, 0xd5, 0xf3, 0x4d, 0x4a, 0x33, 0xf6, 0xa7, 0x6b, 0x36, 0xad, 0xcd, 0xe9, 0x47,
+	0xc5, 0xe8, 0xec, 0xf5, 0xff, 0x26, 0xce, 0x77, 0x9e, 0x26, 0x15, 0xe3, 0x65, 0x52, 0x31, 0x6a,
+	0xaf, 0xc4, 0x2c, 0x2f, 0xc3, 0x37, 0xe8, 0x0f, 0x03, 0xe8, 0x0a, 0x90, 0xea, 0xdf, 0x8b, 0x5c,
+	0x45, 0x75, 0x20, 0x55, 0x6a, 0xce, 0x32, 0xcd, 0xd7, 0x05, 0x52, 0xf5, 0xa4, 0xc7, 0x8f, 0x6e,
+	0xeb, 0x7a, 0x3a, 0xb7, 0xc9, 0x6c, 0x6e, 0x93, 0xcf, 0xb9, 0x4d, 0x9e, 0x17, 0xb6, 0x31, 0x5b,
+	0xd8, 0xc6, 0xfb, 0xc2, 0x36, 0x6e, 0xcf, 0x3c, 0xa1, 0xef, 0x87, 0x2e, 0xed, 0x61, 0xc0, 0xd2,
+	0x59, 0x47, 0x3e, 0x77, 0xd5, 0xf2, 0xc3, 0x46, 0xcd, 0x53, 0x36, 0xfe, 0x75, 0x5f, 0xfd, 0x18,
+	0x82, 0x72, 0xb7, 0xe3, 0x7b, 0x9e, 0x7c, 0x0d, 0x00, 0x39, 0x38, 0x8b, 0xf1, 0x83, 0x02, 0x00,
+	0x00,
 }
 
 func (m *DenomPairTakerFeeProposal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +189,57 @@ func (m *DenomPairTakerFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeVolumeTiersProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeVolumeTiersProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeVolumeTiersProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -178,6 +274,29 @@ func (m *DenomPairTakerFeeProposal) Size() (n int) {
 	return n
 }
 
+func (m *TakerFeeVolumeTiersProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -332,6 +451,154 @@ func (m *DenomPairTakerFeeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TakerFeeVolumeTiersProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeVolumeTiersProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeVolumeTiersProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, TakerFeeVolumeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
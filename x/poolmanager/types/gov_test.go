@@ -150,3 +150,76 @@ func TestDenomPairTakerFeeProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestTakerFeeVolumeTiersProposal_ValidateBasic(t *testing.T) {
+	tier := func(minVolume int64, discount string) types.TakerFeeVolumeTier {
+		return types.TakerFeeVolumeTier{MinVolume: osmomath.NewInt(minVolume), Discount: osmomath.MustNewDecFromStr(discount)}
+	}
+
+	tooManyTiers := []types.TakerFeeVolumeTier{}
+	for i := int64(1); i <= types.MaxTakerFeeVolumeTiers+1; i++ {
+		tooManyTiers = append(tooManyTiers, tier(i, "0.1"))
+	}
+
+	tests := []struct {
+		name       string
+		tiers      []types.TakerFeeVolumeTier
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			tiers:      []types.TakerFeeVolumeTier{tier(1000, "0.1"), tier(5000, "0.1"), tier(10000, "1")},
+			expectPass: true,
+		},
+		{
+			name:       "empty table",
+			tiers:      []types.TakerFeeVolumeTier{},
+			expectPass: true,
+		},
+		{
+			name:       "zero min volume",
+			tiers:      []types.TakerFeeVolumeTier{tier(0, "0.1")},
+			expectPass: false,
+		},
+		{
+			name:       "zero discount",
+			tiers:      []types.TakerFeeVolumeTier{tier(1000, "0")},
+			expectPass: false,
+		},
+		{
+			name:       "discount greater than one",
+			tiers:      []types.TakerFeeVolumeTier{tier(1000, "1.01")},
+			expectPass: false,
+		},
+		{
+			name:       "min volume not ascending",
+			tiers:      []types.TakerFeeVolumeTier{tier(1000, "0.1"), tier(1000, "0.2")},
+			expectPass: false,
+		},
+		{
+			name:       "discount decreasing",
+			tiers:      []types.TakerFeeVolumeTier{tier(1000, "0.2"), tier(5000, "0.1")},
+			expectPass: false,
+		},
+		{
+			name:       "too many tiers",
+			tiers:      tooManyTiers,
+			expectPass: false,
+		},
+		{
+			name:       "invalid record",
+			tiers:      []types.TakerFeeVolumeTier{{}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		takerFeeVolumeTiersProposal := types.NewTakerFeeVolumeTiersProposal("title", "description", test.tiers)
+
+		if test.expectPass {
+			require.NoError(t, takerFeeVolumeTiersProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, takerFeeVolumeTiersProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
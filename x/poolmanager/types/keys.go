@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -57,6 +58,21 @@ var (
 
 	// KeyRegisteredAlloyPool defines the key to store registered alloy pool data.
 	KeyRegisteredAlloyPool = []byte{0x0C}
+
+	// KeyPoolEpochVolumePrefix defines prefix to store the volume of a pool generated during a volume epoch.
+	KeyPoolEpochVolumePrefix = []byte{0x0D}
+
+	// KeyCurrentVolumeEpoch defines key to store the number of the volume epoch volume is currently tracked in.
+	KeyCurrentVolumeEpoch = []byte{0x0E}
+
+	// KeyUserEpochVolumePrefix defines prefix to store the volume swapped by a sender during a volume epoch.
+	KeyUserEpochVolumePrefix = []byte{0x0F}
+
+	// KeyUserTrailingVolumePrefix defines prefix to store the volume swapped by a sender over the retained volume epochs.
+	KeyUserTrailingVolumePrefix = []byte{0x10}
+
+	// KeyTakerFeeVolumeTiers defines key to store the taker fee volume tier table.
+	KeyTakerFeeVolumeTiers = []byte{0x11}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -97,6 +113,51 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// KeyPoolEpochVolumeEpochPrefix returns the prefix of the volumes of all pools generated during the given epoch.
+func KeyPoolEpochVolumeEpochPrefix(epochNumber uint64) []byte {
+	return append(bytes.Clone(KeyPoolEpochVolumePrefix), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// KeyPoolEpochVolume returns the key for the volume of the given pool generated during the given epoch.
+// Keys are ordered by epoch first so that all the pools of an expired epoch are pruned with a single range.
+func KeyPoolEpochVolume(poolId, epochNumber uint64) []byte {
+	return append(KeyPoolEpochVolumeEpochPrefix(epochNumber), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// ParsePoolEpochVolumeKey parses the raw bytes of the KeyPoolEpochVolume into a pool id and epoch number.
+func ParsePoolEpochVolumeKey(key []byte) (poolId, epochNumber uint64, err error) {
+	key = bytes.TrimPrefix(key, KeyPoolEpochVolumePrefix)
+	if len(key) != 16 {
+		return 0, 0, fmt.Errorf("invalid pool epoch volume key length %d, expected 16", len(key))
+	}
+	return sdk.BigEndianToUint64(key[8:]), sdk.BigEndianToUint64(key[:8]), nil
+}
+
+// KeyUserEpochVolumeEpochPrefix returns the prefix of the volumes of all senders swapped during the given epoch.
+func KeyUserEpochVolumeEpochPrefix(epochNumber uint64) []byte {
+	return append(bytes.Clone(KeyUserEpochVolumePrefix), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// KeyUserEpochVolume returns the key for the volume of the given sender swapped during the given epoch.
+// Keys are ordered by epoch first so that all the senders of an expired epoch are pruned with a single range.
+func KeyUserEpochVolume(sender sdk.AccAddress, epochNumber uint64) []byte {
+	return append(KeyUserEpochVolumeEpochPrefix(epochNumber), address.MustLengthPrefix(sender)...)
+}
+
+// ParseUserEpochVolumeKey parses the raw bytes of the KeyUserEpochVolume into a sender and epoch number.
+func ParseUserEpochVolumeKey(key []byte) (sender sdk.AccAddress, epochNumber uint64, err error) {
+	key = bytes.TrimPrefix(key, KeyUserEpochVolumePrefix)
+	if len(key) < 9 || len(key) != 9+int(key[8]) {
+		return nil, 0, fmt.Errorf("invalid user epoch volume key length %d", len(key))
+	}
+	return sdk.AccAddress(key[9:]), sdk.BigEndianToUint64(key[:8]), nil
+}
+
+// KeyUserTrailingVolume returns the key for the volume of the given sender swapped over the retained epochs.
+func KeyUserTrailingVolume(sender sdk.AccAddress) []byte {
+	return append(bytes.Clone(KeyUserTrailingVolumePrefix), address.MustLengthPrefix(sender)...)
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (tokenInDenom, tokenOutDenom string, err error) {
 	keyStr := string(key)
@@ -43,7 +43,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return msg.Constraints.Validate(len(msg.Routes))
 }
 
 func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -77,7 +77,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
-	return nil
+	return msg.Constraints.Validate(len(msg.Routes))
 }
 
 func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
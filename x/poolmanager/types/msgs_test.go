@@ -159,6 +159,38 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid constraints",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Constraints = &types.SwapConstraints{DeadlineHeight: 10, MaxPricePerHop: []osmomath.Dec{osmomath.OneDec(), osmomath.ZeroDec()}}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative deadline height",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Constraints = &types.SwapConstraints{DeadlineHeight: -1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price per hop length mismatch",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Constraints = &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Constraints = &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.OneDec(), osmomath.NewDec(-1)}}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -286,6 +318,38 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid constraints",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.Constraints = &types.SwapConstraints{DeadlineHeight: 10, MaxPricePerHop: []osmomath.Dec{osmomath.OneDec(), osmomath.ZeroDec()}}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative deadline height",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.Constraints = &types.SwapConstraints{DeadlineHeight: -1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price per hop length mismatch",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.Constraints = &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max price",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.Constraints = &types.SwapConstraints{MaxPricePerHop: []osmomath.Dec{osmomath.OneDec(), osmomath.NewDec(-1)}}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate validates the swap constraints of a route with numHops hops.
+// A nil SwapConstraints is valid, and imposes no constraints.
+func (c *SwapConstraints) Validate(numHops int) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.DeadlineHeight < 0 {
+		return fmt.Errorf("swap deadline height cannot be negative, was (%d)", c.DeadlineHeight)
+	}
+
+	if len(c.MaxPricePerHop) == 0 {
+		return nil
+	}
+
+	if len(c.MaxPricePerHop) != numHops {
+		return MaxPricePerHopLengthMismatchError{NumMaxPrices: len(c.MaxPricePerHop), NumHops: numHops}
+	}
+
+	for _, maxPrice := range c.MaxPricePerHop {
+		if maxPrice.IsNil() || maxPrice.IsNegative() {
+			return errors.New("max price per hop cannot be nil or negative")
+		}
+	}
+	return nil
+}
+
+// CheckDeadline returns an error if the swap is executed after its deadline time or height.
+// The deadlines are inclusive, so a swap may execute in the block at its deadline.
+func (c *SwapConstraints) CheckDeadline(blockTime time.Time, blockHeight int64) error {
+	if c == nil {
+		return nil
+	}
+
+	timeExceeded := c.DeadlineTime != nil && blockTime.After(*c.DeadlineTime)
+	heightExceeded := c.DeadlineHeight > 0 && blockHeight > c.DeadlineHeight
+	if timeExceeded || heightExceeded {
+		return SwapDeadlineExceededError{
+			DeadlineTime:   c.DeadlineTime,
+			DeadlineHeight: c.DeadlineHeight,
+			BlockTime:      blockTime,
+			BlockHeight:    blockHeight,
+		}
+	}
+	return nil
+}
+
+// CheckHopPrice returns an error if the effective price of hop hopIndex, swapping amountIn
+// for amountOut in pool poolId, exceeds the hop's max price.
+// The effective price is in units of the hop's token in per unit of the hop's token out.
+func (c *SwapConstraints) CheckHopPrice(hopIndex int, poolId uint64, amountIn, amountOut osmomath.Int) error {
+	if c == nil || hopIndex >= len(c.MaxPricePerHop) {
+		return nil
+	}
+
+	maxPrice := c.MaxPricePerHop[hopIndex]
+	if maxPrice.IsZero() {
+		return nil
+	}
+
+	if !amountOut.IsPositive() {
+		return FinalAmountIsNotPositiveError{IsAmountOut: true, Amount: amountOut}
+	}
+
+	effectivePrice := amountIn.ToLegacyDec().Quo(amountOut.ToLegacyDec())
+	if effectivePrice.GT(maxPrice) {
+		return MaxPriceExceededError{PoolId: poolId, EffectivePrice: effectivePrice, MaxPrice: maxPrice}
+	}
+	return nil
+}
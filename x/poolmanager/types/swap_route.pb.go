@@ -8,15 +8,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -218,11 +222,76 @@ func (m *SwapAmountOutSplitRoute) GetPools() []SwapAmountOutRoute {
 	return nil
 }
 
+// SwapConstraints are optional execution constraints of a swap, protecting
+// transactions that sit in the mempool for too long from executing at stale
+// prices.
+type SwapConstraints struct {
+	// deadline_time is the latest block time at which the swap may execute.
+	// If unset, the swap has no time deadline.
+	DeadlineTime *time.Time `protobuf:"bytes,1,opt,name=deadline_time,json=deadlineTime,proto3,stdtime" json:"deadline_time,omitempty" yaml:"deadline_time"`
+	// deadline_height is the latest block height at which the swap may execute.
+	// If zero, the swap has no height deadline.
+	DeadlineHeight int64 `protobuf:"varint,2,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
+	// max_price_per_hop is the maximum effective price of every hop of the
+	// route, in units of the hop's token in per unit of the hop's token out.
+	// If set, it must have one entry per hop. A zero entry disables the check
+	// for its hop.
+	MaxPricePerHop []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=max_price_per_hop,json=maxPricePerHop,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_per_hop" yaml:"max_price_per_hop"`
+}
+
+func (m *SwapConstraints) Reset()         { *m = SwapConstraints{} }
+func (m *SwapConstraints) String() string { return proto.CompactTextString(m) }
+func (*SwapConstraints) ProtoMessage()    {}
+func (*SwapConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cddd97a9a05492a8, []int{4}
+}
+func (m *SwapConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapConstraints.Merge(m, src)
+}
+func (m *SwapConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapConstraints proto.InternalMessageInfo
+
+func (m *SwapConstraints) GetDeadlineTime() *time.Time {
+	if m != nil {
+		return m.DeadlineTime
+	}
+	return nil
+}
+
+func (m *SwapConstraints) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountInSplitRoute")
 	proto.RegisterType((*SwapAmountOutSplitRoute)(nil), "osmosis.poolmanager.v1beta1.SwapAmountOutSplitRoute")
+	proto.RegisterType((*SwapConstraints)(nil), "osmosis.poolmanager.v1beta1.SwapConstraints")
 }
 
 func init() {
@@ -230,35 +299,45 @@ func init() {
 }
 
 var fileDescriptor_cddd97a9a05492a8 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcb, 0xaa, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0x5e, 0x8e, 0x38, 0x1e, 0xab, 0x86, 0x73, 0xa9, 0x47, 0x48, 0x4a, 0x56, 0x05,
-	0x75, 0x86, 0x56, 0xb0, 0xe2, 0x46, 0x0c, 0x6e, 0xb2, 0x2a, 0xa6, 0xbb, 0xba, 0x08, 0x93, 0x26,
-	0xa4, 0xa1, 0xc9, 0x4c, 0xe8, 0x4c, 0x5a, 0xbb, 0x15, 0x1f, 0xc0, 0xc7, 0xea, 0xb2, 0x1b, 0x41,
-	0xba, 0x08, 0xd2, 0xbe, 0x41, 0x9e, 0x40, 0x72, 0xb3, 0x49, 0x85, 0x2a, 0xee, 0xe6, 0xf2, 0xff,
-	0xe6, 0xff, 0xfb, 0xbe, 0x99, 0x81, 0x2f, 0x18, 0x0f, 0x19, 0xf7, 0x39, 0x8e, 0x18, 0x0b, 0x42,
-	0x42, 0x89, 0xe7, 0xce, 0xf1, 0xa2, 0x67, 0xbb, 0x82, 0xf4, 0x30, 0x5f, 0x92, 0xc8, 0x9a, 0xb3,
-	0x58, 0xb8, 0x28, 0x9a, 0x33, 0xc1, 0xe4, 0x67, 0x65, 0x35, 0xaa, 0x55, 0xa3, 0xb2, 0xfa, 0xe6,
-	0xc2, 0x63, 0x1e, 0xcb, 0xeb, 0x70, 0x36, 0x2a, 0x24, 0xda, 0x57, 0x00, 0x9f, 0x8c, 0x96, 0x24,
-	0x7a, 0x1f, 0xb2, 0x98, 0x0a, 0x83, 0x9a, 0xd9, 0x71, 0xf2, 0x73, 0x78, 0x2f, 0x3b, 0xc2, 0xf2,
-	0x9d, 0x36, 0xe8, 0x80, 0xee, 0x1d, 0x5d, 0x4e, 0x13, 0xb5, 0xb5, 0x22, 0x61, 0xf0, 0x56, 0x2b,
-	0x37, 0x34, 0xf3, 0x2c, 0x1b, 0x19, 0x8e, 0xac, 0xc3, 0x47, 0x82, 0xcd, 0x5c, 0x6a, 0xb1, 0x58,
-	0x58, 0x8e, 0x4b, 0x59, 0xd8, 0xbe, 0xd5, 0x01, 0xdd, 0xfb, 0xfa, 0x4d, 0x9a, 0xa8, 0x57, 0x85,
-	0xe8, 0xa8, 0x40, 0x33, 0x1f, 0xe6, 0x2b, 0xc3, 0x58, 0x7c, 0xc8, 0xe7, 0x5f, 0x00, 0x94, 0x0f,
-	0x18, 0xc3, 0x58, 0xfc, 0x07, 0xc7, 0x3b, 0xd8, 0x2a, 0xda, 0xf8, 0xb4, 0x81, 0xf1, 0x34, 0x4d,
-	0xd4, 0xcb, 0x3a, 0x46, 0xb5, 0xaf, 0x99, 0xe7, 0xf9, 0x82, 0x41, 0x0b, 0x88, 0xef, 0x00, 0x5e,
-	0xd5, 0xb3, 0x18, 0x45, 0x81, 0x5f, 0x82, 0x8c, 0xe1, 0xdd, 0xac, 0x0b, 0x6f, 0x83, 0xce, 0xed,
-	0xee, 0x83, 0x3e, 0x42, 0x27, 0x92, 0x46, 0x7f, 0xe4, 0xa9, 0x5f, 0xac, 0x13, 0x55, 0x4a, 0x13,
-	0xf5, 0xfc, 0x80, 0xce, 0x35, 0xb3, 0x38, 0x52, 0xb6, 0xaa, 0xfc, 0x7c, 0x6a, 0x91, 0x5c, 0x56,
-	0x82, 0x0f, 0x32, 0xd5, 0x36, 0x51, 0x2f, 0x27, 0x79, 0x37, 0xee, 0xcc, 0x90, 0xcf, 0x70, 0x48,
-	0xc4, 0x14, 0x19, 0x54, 0x1c, 0x87, 0xfb, 0x5b, 0x5d, 0x85, 0x6b, 0xd0, 0x02, 0x42, 0xdb, 0x02,
-	0x78, 0xdd, 0x08, 0xb7, 0x66, 0xec, 0x53, 0xd3, 0x18, 0xfe, 0x47, 0x63, 0xd5, 0x0d, 0x9d, 0x76,
-	0x66, 0xc3, 0xc7, 0x87, 0x8b, 0x6f, 0x58, 0x7b, 0xf3, 0x37, 0x6b, 0xd7, 0xc7, 0xef, 0xa6, 0xf2,
-	0xd6, 0xaa, 0x1e, 0x4e, 0x01, 0xa2, 0x7f, 0x5c, 0xef, 0x14, 0xb0, 0xd9, 0x29, 0xe0, 0xe7, 0x4e,
-	0x01, 0xdf, 0xf6, 0x8a, 0xb4, 0xd9, 0x2b, 0xd2, 0x8f, 0xbd, 0x22, 0x8d, 0x07, 0x9e, 0x2f, 0xa6,
-	0xb1, 0x8d, 0x26, 0x2c, 0xc4, 0xa5, 0xab, 0x97, 0x01, 0xb1, 0x79, 0x35, 0xc1, 0x8b, 0xfe, 0x6b,
-	0xfc, 0xb9, 0xf1, 0xb3, 0xc4, 0x2a, 0x72, 0xb9, 0x7d, 0x96, 0x7f, 0x8d, 0x57, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0xb1, 0x15, 0xd4, 0x7d, 0x03, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0x61, 0x15, 0xc3, 0x00, 0x8b, 0x34, 0x7c, 0xac, 0x4b, 0xd2, 0x6e, 0x7a, 0xda, 0x44,
+	0x9d, 0x06, 0x4c, 0xc4, 0x78, 0x21, 0x16, 0x0e, 0x6c, 0x62, 0x02, 0x16, 0x4f, 0x78, 0x68, 0xa6,
+	0xdb, 0xb1, 0x3b, 0xd2, 0xce, 0x34, 0x9d, 0x29, 0x1f, 0x57, 0xe3, 0x0f, 0xe0, 0x4f, 0xf8, 0x5f,
+	0x38, 0x72, 0x31, 0x31, 0x1c, 0xaa, 0x81, 0x7f, 0xd0, 0x5f, 0x60, 0xfa, 0xc5, 0x6e, 0x77, 0x13,
+	0x34, 0xde, 0x3a, 0x6f, 0x9f, 0xb7, 0xcf, 0xc7, 0xfb, 0x4e, 0xe1, 0x0b, 0x2e, 0x02, 0x2e, 0xa8,
+	0x30, 0x42, 0xce, 0xfd, 0x00, 0x33, 0xec, 0x91, 0xc8, 0x38, 0xdd, 0x74, 0x88, 0xc4, 0x9b, 0x86,
+	0x38, 0xc3, 0xa1, 0x1d, 0xf1, 0x58, 0x12, 0x14, 0x46, 0x5c, 0x72, 0x65, 0xa3, 0x44, 0xa3, 0x31,
+	0x34, 0x2a, 0xd1, 0x9d, 0x15, 0x8f, 0x7b, 0x3c, 0xc7, 0x19, 0xd9, 0x53, 0xd1, 0xd2, 0xd1, 0x3c,
+	0xce, 0x3d, 0x9f, 0x18, 0xf9, 0xc9, 0x89, 0x3f, 0x1b, 0x92, 0x06, 0x44, 0x48, 0x1c, 0x84, 0x05,
+	0x40, 0xff, 0x06, 0xe0, 0xf2, 0xd1, 0x19, 0x0e, 0xdf, 0x05, 0x3c, 0x66, 0xb2, 0xcf, 0xac, 0x8c,
+	0x4f, 0x79, 0x0e, 0x9f, 0x64, 0x1c, 0x36, 0x75, 0xdb, 0xa0, 0x0b, 0x7a, 0x8f, 0x4c, 0x25, 0x4d,
+	0xb4, 0xd6, 0x05, 0x0e, 0xfc, 0xb7, 0x7a, 0xf9, 0x42, 0xb7, 0x66, 0xb3, 0xa7, 0xbe, 0xab, 0x98,
+	0x70, 0x49, 0xf2, 0x13, 0xc2, 0x6c, 0x1e, 0x4b, 0xdb, 0x25, 0x8c, 0x07, 0xed, 0x99, 0x2e, 0xe8,
+	0xcd, 0x99, 0x9d, 0x34, 0xd1, 0xd6, 0x8a, 0xa6, 0x09, 0x80, 0x6e, 0x2d, 0xe6, 0x95, 0x83, 0x58,
+	0xee, 0xe5, 0xe7, 0xaf, 0x00, 0x2a, 0x23, 0x19, 0x07, 0xb1, 0xfc, 0x0f, 0x1d, 0x3b, 0xb0, 0x55,
+	0xd0, 0x50, 0x56, 0x93, 0xf1, 0x2c, 0x4d, 0xb4, 0xd5, 0x71, 0x19, 0xd5, 0x7b, 0xdd, 0x5a, 0xc8,
+	0x0b, 0x7d, 0x56, 0x88, 0xf8, 0x01, 0xe0, 0xda, 0x78, 0x16, 0x47, 0xa1, 0x4f, 0x4b, 0x21, 0xc7,
+	0xf0, 0x71, 0xc6, 0x22, 0xda, 0xa0, 0xdb, 0xec, 0xcd, 0x6f, 0x21, 0xf4, 0xc0, 0x28, 0xd0, 0x54,
+	0x9e, 0xe6, 0xca, 0x55, 0xa2, 0x35, 0xd2, 0x44, 0x5b, 0x18, 0x49, 0x17, 0xba, 0x55, 0x7c, 0x52,
+	0xb1, 0xab, 0xfc, 0x28, 0xb3, 0x71, 0xde, 0x56, 0x0a, 0xdf, 0xce, 0xba, 0x6e, 0x12, 0x6d, 0x75,
+	0x90, 0xb3, 0x09, 0xf7, 0x04, 0x51, 0x6e, 0x04, 0x58, 0x0e, 0x51, 0x9f, 0xc9, 0xc9, 0x70, 0xef,
+	0xbb, 0xab, 0x70, 0xfb, 0xac, 0x10, 0xa1, 0xdf, 0x00, 0xb8, 0x5e, 0x0b, 0x77, 0xcc, 0xd8, 0xa7,
+	0xba, 0x31, 0xe3, 0x1f, 0x8d, 0x55, 0x13, 0x7a, 0xd8, 0x99, 0x03, 0x9f, 0x8e, 0x06, 0x5f, 0xb3,
+	0xf6, 0xe6, 0x6f, 0xd6, 0xd6, 0x27, 0xf7, 0xa6, 0xf2, 0xd6, 0xaa, 0x16, 0xa7, 0x34, 0xf7, 0x7d,
+	0x06, 0x2e, 0x65, 0xba, 0x76, 0x39, 0x13, 0x32, 0xc2, 0x94, 0x49, 0xa1, 0x60, 0xb8, 0xe8, 0x12,
+	0xec, 0xfa, 0x94, 0x11, 0x3b, 0x5b, 0xf8, 0x7c, 0x79, 0xe6, 0xb7, 0x3a, 0xa8, 0xb8, 0x0d, 0xa8,
+	0xba, 0x0d, 0xe8, 0x63, 0x75, 0x1b, 0xcc, 0xee, 0x55, 0xa2, 0x81, 0x34, 0xd1, 0x56, 0x0a, 0xde,
+	0x5a, 0xbb, 0x7e, 0xf9, 0x4b, 0x03, 0xd6, 0x42, 0x55, 0xcb, 0x9a, 0x94, 0x5d, 0xb8, 0x74, 0x8f,
+	0x19, 0x12, 0xea, 0x0d, 0x0b, 0x67, 0xcd, 0xf1, 0xa5, 0x9f, 0x00, 0xe8, 0x56, 0xab, 0xaa, 0xec,
+	0xe7, 0x05, 0xe5, 0x0b, 0x5c, 0x0e, 0xf0, 0xb9, 0x1d, 0x46, 0x74, 0x40, 0xec, 0x90, 0x44, 0xf6,
+	0x90, 0x87, 0xed, 0x66, 0xb7, 0xd9, 0x9b, 0x33, 0x77, 0xca, 0x80, 0x36, 0xa6, 0x03, 0x7a, 0x4f,
+	0x3c, 0x3c, 0xb8, 0xd8, 0x23, 0x83, 0x34, 0xd1, 0xda, 0x05, 0xd3, 0xd4, 0x57, 0x74, 0xab, 0x15,
+	0xe0, 0xf3, 0xc3, 0xac, 0x74, 0x48, 0xa2, 0x7d, 0x1e, 0x9a, 0x1f, 0xae, 0x6e, 0x55, 0x70, 0x7d,
+	0xab, 0x82, 0xdf, 0xb7, 0x2a, 0xb8, 0xbc, 0x53, 0x1b, 0xd7, 0x77, 0x6a, 0xe3, 0xe7, 0x9d, 0xda,
+	0x38, 0xde, 0xf6, 0xa8, 0x1c, 0xc6, 0x0e, 0x1a, 0xf0, 0xc0, 0x28, 0xa7, 0xff, 0xd2, 0xc7, 0x8e,
+	0xa8, 0x0e, 0xc6, 0xe9, 0xd6, 0x6b, 0xe3, 0xbc, 0xf6, 0x8b, 0x92, 0x17, 0x21, 0x11, 0xce, 0x6c,
+	0x9e, 0xe3, 0xab, 0x3f, 0x03, 0x00, 0x65, 0xfa, 0x8f, 0xb8, 0xc6, 0x04, 0x00, 0x00,
 }
 
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
@@ -425,6 +504,58 @@ func (m *SwapAmountOutSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPricePerHop) > 0 {
+		for iNdEx := len(m.MaxPricePerHop) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxPricePerHop[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxPricePerHop[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintSwapRoute(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DeadlineTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DeadlineTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DeadlineTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSwapRoute(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -502,6 +633,28 @@ func (m *SwapAmountOutSplitRoute) Size() (n int) {
 	return n
 }
 
+func (m *SwapConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadlineTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DeadlineTime)
+		n += 1 + l + sovSwapRoute(uint64(l))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovSwapRoute(uint64(m.DeadlineHeight))
+	}
+	if len(m.MaxPricePerHop) > 0 {
+		for _, e := range m.MaxPricePerHop {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -946,6 +1099,147 @@ func (m *SwapAmountOutSplitRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadlineTime == nil {
+				m.DeadlineTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DeadlineTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePerHop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxPricePerHop = append(m.MaxPricePerHop, v)
+			if err := m.MaxPricePerHop[len(m.MaxPricePerHop)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	// TakerFeeVolumeTierNumEpochs is the number of most recent volume epochs, the current one included,
+	// whose swap volume counts towards the taker fee volume tier of a sender.
+	TakerFeeVolumeTierNumEpochs = MaxVolumeEpochsRetained
+
+	// MaxTakerFeeVolumeTiers is the maximum number of tiers in the taker fee volume tier table.
+	MaxTakerFeeVolumeTiers = 10
+
+	// MaxUserEpochVolumesPrunedPerBlock is the maximum number of sender volume buckets that left the
+	// retention window pruned at the end of a block. The buckets of a volume epoch are pruned over as
+	// many blocks as needed, and count towards the trailing volume of their sender until then.
+	MaxUserEpochVolumesPrunedPerBlock = 500
+
+	// UserVolumeTwapDuration is the duration of the TWAP that prices the volume of a sender in OSMO.
+	// Unlike the spot price, it cannot be moved within a block to inflate the volume of a sender.
+	UserVolumeTwapDuration = 10 * time.Minute
+)
+
+// ValidateTakerFeeVolumeTiers validates the taker fee volume tier table.
+// Tiers must be ordered by strictly ascending minimum volume with non-decreasing discounts.
+// An empty table is valid and disables volume based taker fee discounts.
+func ValidateTakerFeeVolumeTiers(tiers []TakerFeeVolumeTier) error {
+	if len(tiers) > MaxTakerFeeVolumeTiers {
+		return fmt.Errorf("taker fee volume tier table has %d tiers, maximum is %d", len(tiers), MaxTakerFeeVolumeTiers)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return fmt.Errorf("taker fee volume tier %d min volume must be positive", i+1)
+		}
+
+		if tier.Discount.IsNil() || !tier.Discount.IsPositive() || tier.Discount.GT(OneDec) {
+			return fmt.Errorf("taker fee volume tier %d discount must be in (0, 1]: %s", i+1, tier.Discount)
+		}
+
+		if i == 0 {
+			continue
+		}
+		previous := tiers[i-1]
+		if tier.MinVolume.LTE(previous.MinVolume) {
+			return fmt.Errorf("taker fee volume tier %d min volume (%s) must be greater than the previous tier's (%s)", i+1, tier.MinVolume, previous.MinVolume)
+		}
+		if tier.Discount.LT(previous.Discount) {
+			return fmt.Errorf("taker fee volume tier %d discount (%s) must not be less than the previous tier's (%s)", i+1, tier.Discount, previous.Discount)
+		}
+	}
+	return nil
+}
+
+// TakerFeeVolumeTierForVolume returns the one-based index and discount of the highest tier
+// of the given valid tier table the volume qualifies for. Returns zero index and zero discount
+// if it qualifies for none.
+func TakerFeeVolumeTierForVolume(tiers []TakerFeeVolumeTier, volume osmomath.Int) (uint64, osmomath.Dec) {
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if volume.GTE(tiers[i].MinVolume) {
+			return uint64(i + 1), tiers[i].Discount
+		}
+	}
+	return 0, osmomath.ZeroDec()
+}
@@ -0,0 +1,845 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolmanager/v1beta1/taker_fee_tier.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TakerFeeVolumeTier is a tier of the governance defined taker fee volume tier
+// table. A sender whose trailing swap volume is at least min_volume has the
+// taker fee of every trading pair reduced by the discount of the highest tier
+// they qualify for.
+type TakerFeeVolumeTier struct {
+	// min_volume is the OSMO-denominated trailing swap volume a sender needs to
+	// qualify for the tier.
+	MinVolume cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=cosmossdk.io/math.Int" json:"min_volume" yaml:"min_volume"`
+	// discount is the fraction of the trading pair taker fee waived for senders
+	// in the tier.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *TakerFeeVolumeTier) Reset()         { *m = TakerFeeVolumeTier{} }
+func (m *TakerFeeVolumeTier) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeVolumeTier) ProtoMessage()    {}
+func (*TakerFeeVolumeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f3ca39fc45741b, []int{0}
+}
+func (m *TakerFeeVolumeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeVolumeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeVolumeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeVolumeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeVolumeTier.Merge(m, src)
+}
+func (m *TakerFeeVolumeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeVolumeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeVolumeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeVolumeTier proto.InternalMessageInfo
+
+// TakerFeeVolumeTiers stores the taker fee volume tier table, ordered by
+// ascending minimum volume.
+type TakerFeeVolumeTiers struct {
+	Tiers []TakerFeeVolumeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *TakerFeeVolumeTiers) Reset()         { *m = TakerFeeVolumeTiers{} }
+func (m *TakerFeeVolumeTiers) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeVolumeTiers) ProtoMessage()    {}
+func (*TakerFeeVolumeTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f3ca39fc45741b, []int{1}
+}
+func (m *TakerFeeVolumeTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeVolumeTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeVolumeTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeVolumeTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeVolumeTiers.Merge(m, src)
+}
+func (m *TakerFeeVolumeTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeVolumeTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeVolumeTiers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeVolumeTiers proto.InternalMessageInfo
+
+func (m *TakerFeeVolumeTiers) GetTiers() []TakerFeeVolumeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+// UserEpochVolume stores the KVStore entries for the swap volume of a sender
+// during a single volume epoch, which is used in export/import genesis.
+type UserEpochVolume struct {
+	// address is the address of the sender.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// epoch_number is the number of the epoch the volume was generated in.
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// volume is the volume swapped by the sender during the epoch.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *UserEpochVolume) Reset()         { *m = UserEpochVolume{} }
+func (m *UserEpochVolume) String() string { return proto.CompactTextString(m) }
+func (*UserEpochVolume) ProtoMessage()    {}
+func (*UserEpochVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f3ca39fc45741b, []int{2}
+}
+func (m *UserEpochVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserEpochVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserEpochVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserEpochVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEpochVolume.Merge(m, src)
+}
+func (m *UserEpochVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserEpochVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEpochVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEpochVolume proto.InternalMessageInfo
+
+func (m *UserEpochVolume) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UserEpochVolume) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *UserEpochVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TakerFeeVolumeTier)(nil), "osmosis.poolmanager.v1beta1.TakerFeeVolumeTier")
+	proto.RegisterType((*TakerFeeVolumeTiers)(nil), "osmosis.poolmanager.v1beta1.TakerFeeVolumeTiers")
+	proto.RegisterType((*UserEpochVolume)(nil), "osmosis.poolmanager.v1beta1.UserEpochVolume")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/taker_fee_tier.proto", fileDescriptor_d4f3ca39fc45741b)
+}
+
+var fileDescriptor_d4f3ca39fc45741b = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xc0, 0x1b, 0x36, 0x06, 0xf3, 0x10, 0x13, 0x19, 0x88, 0xb2, 0x49, 0xc9, 0x94, 0x53, 0x0f,
+	0xcc, 0xde, 0x8a, 0x34, 0xa4, 0x1d, 0xc3, 0x1f, 0x09, 0x81, 0x90, 0x16, 0x0d, 0x0e, 0x5c, 0x2a,
+	0x27, 0xf9, 0x48, 0xad, 0xd6, 0x71, 0x65, 0xbb, 0x15, 0x7d, 0x0b, 0x9e, 0x83, 0x57, 0xe0, 0x05,
+	0x76, 0xdc, 0x11, 0x71, 0x08, 0x55, 0xfb, 0x06, 0x79, 0x02, 0x14, 0xdb, 0x2d, 0x45, 0x95, 0x76,
+	0x6a, 0xbf, 0x7c, 0xfe, 0xfd, 0xfc, 0xfd, 0x31, 0x3a, 0x15, 0x8a, 0x0b, 0xc5, 0x14, 0x19, 0x09,
+	0x31, 0xe4, 0xb4, 0xa4, 0x05, 0x48, 0x32, 0x39, 0x4b, 0x41, 0xd3, 0x33, 0xa2, 0xe9, 0x00, 0x64,
+	0xef, 0x2b, 0x40, 0x4f, 0x33, 0x90, 0x78, 0x24, 0x85, 0x16, 0xfe, 0x91, 0x23, 0xf0, 0x1a, 0x81,
+	0x1d, 0x71, 0xf8, 0xb8, 0x10, 0x85, 0x30, 0xe7, 0x48, 0xf3, 0xcf, 0x22, 0x87, 0x41, 0x66, 0x18,
+	0x92, 0x52, 0x05, 0x2b, 0x79, 0x26, 0x58, 0x69, 0xf3, 0xd1, 0x4f, 0x0f, 0xf9, 0x57, 0xcd, 0x5d,
+	0x6f, 0x01, 0x3e, 0x8b, 0xe1, 0x98, 0xc3, 0x15, 0x03, 0xe9, 0x5f, 0x22, 0xc4, 0x59, 0xd9, 0x9b,
+	0x98, 0x2f, 0x6d, 0xef, 0xd8, 0xeb, 0xec, 0xc6, 0xdd, 0xeb, 0x2a, 0x6c, 0xfd, 0xae, 0xc2, 0x27,
+	0x56, 0xa9, 0xf2, 0x01, 0x66, 0x82, 0x70, 0xaa, 0xfb, 0xf8, 0x5d, 0xa9, 0xeb, 0x2a, 0x7c, 0x34,
+	0xa5, 0x7c, 0x78, 0x11, 0xfd, 0x03, 0xa3, 0x64, 0x97, 0xb3, 0xd2, 0x6a, 0xfd, 0x04, 0xdd, 0xcf,
+	0x99, 0xca, 0xc4, 0xb8, 0xd4, 0xed, 0x3b, 0x46, 0x78, 0xee, 0x84, 0x47, 0x9b, 0xc2, 0x0f, 0x50,
+	0xd0, 0x6c, 0xfa, 0x1a, 0xb2, 0xba, 0x0a, 0xf7, 0xad, 0x76, 0x09, 0x47, 0xc9, 0xca, 0x13, 0xa5,
+	0xe8, 0x60, 0xb3, 0x78, 0xe5, 0xbf, 0x47, 0x77, 0x9b, 0xa9, 0xa9, 0xb6, 0x77, 0xbc, 0xd5, 0xd9,
+	0xeb, 0x12, 0x7c, 0xcb, 0xdc, 0xf0, 0xa6, 0x20, 0xde, 0x6e, 0x0a, 0x4b, 0xac, 0x23, 0x9a, 0x79,
+	0x68, 0xff, 0x93, 0x02, 0xf9, 0x66, 0x24, 0xb2, 0xbe, 0xeb, 0xe5, 0x39, 0xba, 0x47, 0xf3, 0x5c,
+	0x82, 0x52, 0x6e, 0x36, 0x7e, 0x5d, 0x85, 0x0f, 0x6d, 0x9d, 0x2e, 0x11, 0x25, 0xcb, 0x23, 0xfe,
+	0x05, 0x7a, 0x00, 0x0d, 0xdc, 0x2b, 0xc7, 0x3c, 0x05, 0x69, 0xba, 0xdf, 0x8e, 0x9f, 0xd6, 0x55,
+	0x78, 0x60, 0x91, 0xf5, 0x6c, 0x94, 0xec, 0x99, 0xf0, 0xa3, 0x89, 0xfc, 0x0c, 0xed, 0xb8, 0x25,
+	0x6c, 0x99, 0x5e, 0x9e, 0x61, 0x3b, 0x2c, 0xdc, 0x2c, 0x74, 0xd5, 0xc3, 0x2b, 0xc1, 0xca, 0xf8,
+	0xb4, 0xa9, 0xfa, 0xc7, 0x9f, 0xb0, 0x53, 0x30, 0xdd, 0x1f, 0xa7, 0x38, 0x13, 0x9c, 0xb8, 0xed,
+	0xdb, 0x9f, 0x13, 0x95, 0x0f, 0x88, 0x9e, 0x8e, 0x40, 0x19, 0x40, 0x25, 0x4e, 0x1d, 0x5f, 0x5e,
+	0xcf, 0x03, 0xef, 0x66, 0x1e, 0x78, 0xb3, 0x79, 0xe0, 0x7d, 0x5f, 0x04, 0xad, 0x9b, 0x45, 0xd0,
+	0xfa, 0xb5, 0x08, 0x5a, 0x5f, 0x5e, 0xae, 0xb9, 0xdc, 0x10, 0x4f, 0x86, 0x34, 0x55, 0xcb, 0x80,
+	0x4c, 0xba, 0xe7, 0xe4, 0xdb, 0x7f, 0x2f, 0xd8, 0x5c, 0x90, 0xee, 0x98, 0xe7, 0xf5, 0xe2, 0xef,
+	0x00, 0x22, 0x41, 0x2b, 0xff, 0xe5, 0x02, 0x00, 0x00,
+}
+
+func (m *TakerFeeVolumeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeVolumeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeVolumeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTakerFeeTier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTakerFeeTier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeVolumeTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeVolumeTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeVolumeTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerFeeTier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserEpochVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserEpochVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserEpochVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTakerFeeTier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTakerFeeTier(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTakerFeeTier(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTakerFeeTier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTakerFeeTier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TakerFeeVolumeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovTakerFeeTier(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovTakerFeeTier(uint64(l))
+	return n
+}
+
+func (m *TakerFeeVolumeTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovTakerFeeTier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserEpochVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTakerFeeTier(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovTakerFeeTier(uint64(m.EpochNumber))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovTakerFeeTier(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTakerFeeTier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTakerFeeTier(x uint64) (n int) {
+	return sovTakerFeeTier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TakerFeeVolumeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerFeeTier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeVolumeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeVolumeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeTier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeVolumeTiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerFeeTier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeVolumeTiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeVolumeTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, TakerFeeVolumeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeTier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserEpochVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTakerFeeTier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserEpochVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserEpochVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTakerFeeTier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTakerFeeTier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTakerFeeTier(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTakerFeeTier
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTakerFeeTier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTakerFeeTier
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTakerFeeTier
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTakerFeeTier
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTakerFeeTier        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTakerFeeTier          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTakerFeeTier = fmt.Errorf("proto: unexpected end of group")
+)
@@ -38,6 +38,8 @@ type MsgSwapExactAmountIn struct {
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// constraints are the optional execution constraints of the swap.
+	Constraints *SwapConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty" yaml:"constraints"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -94,6 +96,13 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetConstraints() *SwapConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -238,6 +247,8 @@ type MsgSwapExactAmountOut struct {
 	Routes           []SwapAmountOutRoute  `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin            `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// constraints are the optional execution constraints of the swap.
+	Constraints *SwapConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty" yaml:"constraints"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -294,6 +305,13 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountOut) GetConstraints() *SwapConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -829,89 +847,91 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0x46, 0x98, 0xf0, 0xb1, 0x10, 0x82, 0x15, 0x28, 0x8e, 0x49, 0x2d, 0x2a, 0x48, 0x4a, 0x52,
-	0x24, 0xc7, 0xc0, 0x34, 0xc4, 0xd0, 0x49, 0x70, 0x68, 0x66, 0x98, 0xc6, 0x03, 0x51, 0x72, 0xea,
-	0x4c, 0xc7, 0xb3, 0xb6, 0x36, 0x46, 0xc5, 0xd2, 0x7a, 0xa4, 0x35, 0x81, 0x5b, 0x9b, 0x66, 0xda,
-	0x29, 0xd3, 0x43, 0x4f, 0xbd, 0x76, 0xa6, 0xbf, 0x20, 0xb9, 0xf4, 0x37, 0xe4, 0x98, 0x63, 0xa7,
-	0x07, 0x4f, 0x0b, 0x87, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x85, 0xfc, 0x05, 0x13,
-	0x2e, 0x89, 0xa4, 0x7d, 0x9f, 0xf7, 0xe3, 0x79, 0x9f, 0x7d, 0x77, 0x0d, 0x98, 0xc7, 0x8e, 0x89,
-	0x1d, 0xc3, 0x49, 0x96, 0x31, 0x2e, 0x99, 0xd0, 0x82, 0x45, 0x64, 0x27, 0xf7, 0x53, 0x79, 0x44,
-	0x60, 0x2a, 0x49, 0x0e, 0xd4, 0xb2, 0x8d, 0x09, 0x16, 0x67, 0xb8, 0x95, 0xda, 0x60, 0xa5, 0x72,
-	0xab, 0xf8, 0x64, 0x11, 0x17, 0xb1, 0x6b, 0x97, 0xa4, 0x4f, 0x0c, 0x12, 0x8f, 0x42, 0xd3, 0xb0,
-	0x70, 0xd2, 0xfd, 0x97, 0x7f, 0x4a, 0x14, 0x5c, 0x37, 0xc9, 0x3c, 0x74, 0x90, 0x1f, 0xa3, 0x80,
-	0x0d, 0x8b, 0xaf, 0x2f, 0xb6, 0xcb, 0xc5, 0x79, 0x01, 0xcb, 0x39, 0x1b, 0x57, 0x08, 0xe2, 0xd6,
-	0xd3, 0xdc, 0x9b, 0xe9, 0x14, 0x93, 0xfb, 0x29, 0xfa, 0x1f, 0x5b, 0x90, 0x7f, 0x8a, 0x80, 0xc9,
-	0xac, 0x53, 0x7c, 0xfa, 0x02, 0x96, 0xbf, 0x3c, 0x80, 0x05, 0xb2, 0x61, 0xe2, 0x8a, 0x45, 0xb6,
-	0x2c, 0xf1, 0x16, 0x18, 0x74, 0x90, 0xa5, 0x23, 0x3b, 0x26, 0xcc, 0x0a, 0x0b, 0x23, 0x99, 0x68,
-	0xad, 0x2a, 0x5d, 0x3e, 0x84, 0x66, 0x29, 0x2d, 0xb3, 0xef, 0xb2, 0xc6, 0x0d, 0xc4, 0xc7, 0x60,
-	0xd0, 0x8d, 0xe5, 0xc4, 0xfa, 0x67, 0x23, 0x0b, 0xa3, 0x4b, 0xaa, 0xda, 0x86, 0x01, 0x95, 0x86,
-	0xf2, 0xa2, 0x68, 0x14, 0x96, 0x19, 0x78, 0x5b, 0x95, 0xfa, 0x34, 0xee, 0x43, 0xcc, 0x82, 0x61,
-	0x82, 0xf7, 0x90, 0x95, 0x33, 0xac, 0x58, 0x64, 0x56, 0x58, 0x18, 0x5d, 0xba, 0xa6, 0xb2, 0xec,
-	0x55, 0xca, 0x85, 0xef, 0xe7, 0x21, 0x36, 0xac, 0xcc, 0x34, 0x85, 0xd6, 0xaa, 0xd2, 0x15, 0x96,
-	0x99, 0x07, 0x94, 0xb5, 0x21, 0xf7, 0x71, 0xcb, 0x12, 0x4d, 0x30, 0xc9, 0xbe, 0xe2, 0x0a, 0xc9,
-	0x99, 0x86, 0x95, 0x83, 0x6e, 0xec, 0xd8, 0x80, 0x5b, 0xd5, 0x3a, 0xc5, 0xff, 0x5d, 0x95, 0xa6,
-	0x58, 0x04, 0x47, 0xdf, 0x53, 0x0d, 0x9c, 0x34, 0x21, 0xd9, 0x55, 0xb7, 0x2c, 0x52, 0xab, 0x4a,
-	0x33, 0x8d, 0x8e, 0x9b, 0x5d, 0xc8, 0x5a, 0xd4, 0xfd, 0xbc, 0x5d, 0x21, 0x59, 0xc3, 0x62, 0x25,
-	0xa5, 0x57, 0x5f, 0xbe, 0x7f, 0x7d, 0x9b, 0x13, 0x73, 0xf4, 0xfe, 0xf5, 0xed, 0x85, 0xb0, 0x36,
-	0xd1, 0xf6, 0x28, 0x88, 0xd2, 0xad, 0x30, 0x57, 0x8a, 0x61, 0xc9, 0x2f, 0x05, 0x70, 0x3d, 0xac,
-	0x13, 0x1a, 0x72, 0xca, 0xd8, 0x72, 0x90, 0x98, 0x07, 0x13, 0xf5, 0x34, 0x78, 0x15, 0xac, 0x37,
-	0xab, 0x9d, 0xaa, 0x98, 0x0e, 0x56, 0xe1, 0x55, 0x30, 0xee, 0x55, 0xc0, 0xa2, 0xc9, 0x3f, 0x44,
-	0x40, 0x82, 0x26, 0x51, 0x2e, 0x19, 0xc4, 0x6d, 0xce, 0xb9, 0x84, 0xf1, 0x24, 0x20, 0x8c, 0xe5,
-	0xae, 0x85, 0x51, 0x4f, 0x20, 0xa0, 0x8e, 0xfb, 0x60, 0xdc, 0x6b, 0x72, 0x4e, 0x47, 0x16, 0x36,
-	0x5d, 0x8d, 0x8c, 0x64, 0xae, 0xd5, 0xaa, 0xd2, 0x54, 0xb3, 0x08, 0xd8, 0xba, 0xac, 0x8d, 0x71,
-	0x29, 0x6c, 0xd2, 0xd7, 0x8b, 0xd6, 0xc3, 0x72, 0x40, 0x0f, 0x73, 0xa1, 0x7a, 0xa0, 0xd5, 0x36,
-	0x48, 0xe1, 0x17, 0x01, 0xdc, 0x6c, 0xdf, 0x85, 0x0b, 0x15, 0xc5, 0x51, 0x04, 0x4c, 0x9d, 0x56,
-	0xe6, 0x76, 0x85, 0xf4, 0xa2, 0x85, 0x6c, 0x40, 0x0b, 0xc9, 0x2e, 0xb5, 0xb0, 0x5d, 0x09, 0xd5,
-	0xc1, 0xb7, 0xe0, 0xaa, 0xdf, 0x67, 0x13, 0x1e, 0x78, 0xa5, 0x33, 0x31, 0xac, 0x75, 0x2a, 0x3d,
-	0x1e, 0x50, 0x4a, 0xdd, 0x83, 0xac, 0x4d, 0x70, 0xb9, 0x64, 0xe1, 0x01, 0xcb, 0x40, 0xdc, 0x01,
-	0x23, 0x3e, 0x49, 0xae, 0x4e, 0xda, 0x8e, 0xa4, 0x18, 0x1f, 0x49, 0x13, 0x01, 0x7a, 0x65, 0x6d,
-	0xd8, 0xe3, 0x35, 0x7d, 0x2f, 0xa0, 0x8a, 0x5b, 0xdd, 0x4d, 0x09, 0xea, 0xe5, 0x3b, 0x01, 0x7c,
-	0x1c, 0xda, 0x0c, 0x5f, 0x12, 0x39, 0x70, 0xc5, 0x2f, 0xac, 0x49, 0x11, 0x77, 0x3b, 0xd1, 0xf2,
-	0x51, 0x80, 0x16, 0x8f, 0x92, 0xcb, 0x9c, 0x12, 0xae, 0x87, 0x1f, 0x23, 0x40, 0x6a, 0x27, 0xcf,
-	0x1e, 0x95, 0xa1, 0x05, 0x94, 0xb1, 0xd2, 0xbd, 0x32, 0x5a, 0x8e, 0x89, 0x8c, 0xc7, 0x01, 0xd5,
-	0x75, 0xe3, 0x9c, 0x88, 0x07, 0xcb, 0xf4, 0x0d, 0xbc, 0x32, 0xb7, 0x2b, 0x84, 0x4d, 0x8a, 0x16,
-	0x12, 0x1b, 0xf8, 0x00, 0x12, 0x4b, 0xaf, 0x04, 0x04, 0x31, 0xdf, 0x71, 0x4c, 0x50, 0x2d, 0x1c,
-	0x09, 0xe0, 0xd3, 0x0e, 0x8d, 0xb8, 0x38, 0x55, 0xfc, 0xdc, 0x0f, 0xa6, 0x69, 0x32, 0x88, 0xd1,
-	0xb7, 0x03, 0x0d, 0xfb, 0x19, 0xdc, 0x43, 0xf6, 0x23, 0x84, 0x7a, 0x51, 0xc3, 0x2b, 0x01, 0x4c,
-	0xba, 0xfd, 0xc8, 0x95, 0xa1, 0x61, 0xe7, 0x08, 0x75, 0x91, 0x7b, 0x8e, 0x50, 0x57, 0x77, 0x8b,
-	0x53, 0x91, 0x33, 0x73, 0x7c, 0x37, 0xf2, 0xb9, 0x1d, 0xe6, 0x59, 0xd6, 0xa2, 0x7a, 0x10, 0x97,
-	0x5e, 0x0f, 0x34, 0x24, 0xf4, 0xba, 0xe5, 0x20, 0xa2, 0xb8, 0x50, 0x85, 0x7a, 0x54, 0x5c, 0x8f,
-	0x0a, 0xf5, 0xb8, 0xc6, 0x36, 0x48, 0x08, 0x15, 0x7e, 0x3f, 0x62, 0x60, 0xc8, 0xa9, 0x14, 0x0a,
-	0xc8, 0x71, 0x5c, 0x4e, 0x86, 0x35, 0xef, 0x55, 0xfe, 0xb7, 0x1f, 0xcc, 0x33, 0xb4, 0x07, 0x7a,
-	0xba, 0x0b, 0x6d, 0xb4, 0x51, 0xb4, 0x11, 0x32, 0x91, 0x45, 0x1e, 0x61, 0x9b, 0x09, 0xb4, 0x07,
-	0x56, 0x6f, 0x82, 0x4b, 0x6c, 0x17, 0xf4, 0xbb, 0x96, 0x13, 0xb5, 0xaa, 0x34, 0xd6, 0xc0, 0x88,
-	0xac, 0xb1, 0x65, 0xf1, 0x1b, 0x30, 0xe6, 0xec, 0x19, 0x66, 0xae, 0x8c, 0xec, 0x02, 0xf2, 0xe7,
-	0x69, 0x9a, 0x4b, 0x64, 0xe6, 0xb4, 0x44, 0x1e, 0xa3, 0x22, 0x2c, 0x1c, 0x6e, 0xa2, 0x42, 0xad,
-	0x2a, 0x5d, 0xe5, 0xb1, 0x1b, 0x1c, 0xc8, 0xda, 0x28, 0x7d, 0xdd, 0x61, 0x6f, 0x62, 0x9a, 0xbb,
-	0x87, 0xba, 0x6e, 0xd3, 0xca, 0xd9, 0x5e, 0x9a, 0x0e, 0x60, 0xf9, 0x2a, 0xc7, 0x6e, 0xb0, 0xb7,
-	0xf4, 0x57, 0x81, 0x8e, 0xac, 0xb5, 0xea, 0x88, 0xdf, 0x06, 0xc5, 0xa1, 0xbc, 0x29, 0xd0, 0x23,
-	0x4e, 0x79, 0x8e, 0x6d, 0xd6, 0x2f, 0x59, 0x05, 0x8b, 0xdd, 0x50, 0xec, 0x75, 0x4b, 0xfe, 0x53,
-	0x00, 0x33, 0x0c, 0xa0, 0xa1, 0xa2, 0xe1, 0x10, 0x64, 0x23, 0x7d, 0xa3, 0x54, 0xc2, 0x87, 0x48,
-	0xdf, 0xc1, 0xb8, 0xd4, 0x4b, 0x2b, 0x3e, 0x03, 0x43, 0x34, 0xe3, 0x9c, 0xa1, 0xbb, 0xcd, 0x18,
-	0xc8, 0x88, 0xb5, 0xaa, 0x34, 0xce, 0x6c, 0xf9, 0x82, 0xac, 0x0d, 0xd2, 0xa7, 0x2d, 0x3d, 0x7d,
-	0x3f, 0x50, 0x74, 0xb2, 0x55, 0xd1, 0xb6, 0x9f, 0x96, 0x02, 0x59, 0x5e, 0x0a, 0x35, 0x91, 0x6f,
-	0x80, 0xb9, 0x36, 0x79, 0xfb, 0xf5, 0xfd, 0xd7, 0x0f, 0xa2, 0xa7, 0xb7, 0xed, 0x17, 0x60, 0xd0,
-	0xa5, 0xeb, 0x0e, 0xaf, 0xea, 0x46, 0xad, 0x2a, 0x49, 0x0d, 0xb2, 0xb9, 0x23, 0x2f, 0xea, 0xa8,
-	0x6c, 0xa3, 0x02, 0x24, 0x48, 0x4f, 0xcb, 0xc4, 0xae, 0x20, 0x39, 0x26, 0x68, 0x1c, 0xe4, 0xc3,
-	0x53, 0x5c, 0x75, 0x41, 0x78, 0xaa, 0x1d, 0x3c, 0x25, 0x3e, 0x03, 0x23, 0xf5, 0xdd, 0x1f, 0x69,
-	0x9a, 0x55, 0x1d, 0x84, 0xe8, 0x1d, 0xbd, 0xf5, 0x1d, 0x3e, 0x4c, 0xea, 0x35, 0x35, 0xdd, 0x07,
-	0xb9, 0x04, 0xbb, 0xbe, 0x3e, 0x3e, 0x00, 0xcd, 0xa7, 0x44, 0xec, 0x52, 0x8f, 0xc7, 0xca, 0xd2,
-	0x9b, 0x61, 0x10, 0xc9, 0x3a, 0x45, 0xf1, 0x7b, 0x01, 0x44, 0x4f, 0xdf, 0xae, 0x53, 0x6d, 0xe7,
-	0x5b, 0xd8, 0xef, 0x83, 0xf8, 0xbd, 0x9e, 0x21, 0xfe, 0x10, 0x7a, 0x25, 0x00, 0x31, 0xe4, 0xf0,
-	0x5e, 0xea, 0xd1, 0xe3, 0x76, 0x85, 0xc4, 0xd3, 0xbd, 0x63, 0xfc, 0x34, 0x7e, 0x17, 0xc0, 0x4c,
-	0xbb, 0x9f, 0x1c, 0x6b, 0x1d, 0x7d, 0xb7, 0x06, 0xc7, 0x1f, 0x9e, 0x03, 0xec, 0x67, 0xf8, 0x87,
-	0x00, 0xae, 0xb7, 0xbd, 0xef, 0xac, 0x9f, 0x39, 0x0a, 0x25, 0x6f, 0xf3, 0x3c, 0x68, 0x3f, 0xc9,
-	0x23, 0x01, 0x4c, 0x86, 0x1e, 0xbf, 0x2b, 0x1d, 0xdd, 0x87, 0xa0, 0xe2, 0xeb, 0x67, 0x41, 0xf9,
-	0xc9, 0xbc, 0x11, 0xc0, 0x27, 0x9d, 0x8f, 0xb0, 0x8d, 0x2e, 0x62, 0xb4, 0x77, 0x11, 0xdf, 0x3a,
-	0xb7, 0x0b, 0x3f, 0xe7, 0xdf, 0x04, 0x10, 0x6b, 0x39, 0xe2, 0x57, 0xbb, 0x88, 0x13, 0x8a, 0x8c,
-	0x3f, 0x38, 0x2b, 0xd2, 0x4b, 0x2c, 0xf3, 0xe4, 0xed, 0x71, 0x42, 0x78, 0x77, 0x9c, 0x10, 0xfe,
-	0x39, 0x4e, 0x08, 0xbf, 0x9e, 0x24, 0xfa, 0xde, 0x9d, 0x24, 0xfa, 0xfe, 0x3a, 0x49, 0xf4, 0x7d,
-	0x7d, 0xb7, 0x68, 0x90, 0xdd, 0x4a, 0x5e, 0x2d, 0x60, 0xd3, 0x3b, 0x1b, 0x94, 0x12, 0xcc, 0x3b,
-	0xfe, 0x41, 0xb1, 0xbf, 0xf4, 0x79, 0xf2, 0xa0, 0xe9, 0xb8, 0x20, 0x87, 0x65, 0xe4, 0xe4, 0x07,
-	0xdd, 0xbf, 0xff, 0x2c, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xb3, 0x30, 0xd1, 0xd4, 0x12,
-	0x00, 0x00,
+	// 1333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0x13, 0x47,
+	0x18, 0xce, 0xc6, 0x21, 0x24, 0x13, 0x3e, 0xe2, 0x25, 0x90, 0xc5, 0xa1, 0x5e, 0xba, 0x7c, 0x34,
+	0xd0, 0xec, 0x2e, 0x0e, 0xa8, 0x80, 0x93, 0x0a, 0x62, 0x28, 0x52, 0x54, 0xac, 0x84, 0x85, 0x53,
+	0xa5, 0xca, 0x1a, 0x7b, 0x07, 0xb3, 0x8d, 0x77, 0xc7, 0xda, 0x19, 0x43, 0x72, 0x6b, 0x29, 0xaa,
+	0x54, 0xd4, 0x43, 0x4f, 0xbd, 0x56, 0xea, 0x2f, 0x80, 0x4b, 0x7f, 0x03, 0x47, 0x8e, 0x55, 0x0f,
+	0x56, 0x0b, 0x07, 0x7a, 0xab, 0xe4, 0x5f, 0x50, 0xcd, 0xce, 0xec, 0xda, 0xde, 0xac, 0xbf, 0x12,
+	0x35, 0x97, 0x64, 0x67, 0x67, 0x9e, 0xf7, 0xe3, 0x79, 0x9f, 0x79, 0x67, 0xd6, 0xe0, 0x3c, 0x26,
+	0x2e, 0x26, 0x0e, 0x31, 0xeb, 0x18, 0xd7, 0x5c, 0xe8, 0xc1, 0x2a, 0xf2, 0xcd, 0xa7, 0xb9, 0x32,
+	0xa2, 0x30, 0x67, 0xd2, 0x6d, 0xa3, 0xee, 0x63, 0x8a, 0xe5, 0x05, 0xb1, 0xca, 0xe8, 0x58, 0x65,
+	0x88, 0x55, 0x99, 0xb9, 0x2a, 0xae, 0xe2, 0x60, 0x9d, 0xc9, 0x9e, 0x38, 0x24, 0x93, 0x86, 0xae,
+	0xe3, 0x61, 0x33, 0xf8, 0x2b, 0x5e, 0x65, 0x2b, 0x81, 0x19, 0xb3, 0x0c, 0x09, 0x8a, 0x7c, 0x54,
+	0xb0, 0xe3, 0x89, 0xf9, 0xa5, 0x7e, 0xb1, 0x90, 0x67, 0xb0, 0x5e, 0xf2, 0x71, 0x83, 0x22, 0xb1,
+	0x7a, 0x5e, 0x58, 0x73, 0x49, 0xd5, 0x7c, 0x9a, 0x63, 0xff, 0xf8, 0x84, 0xf6, 0x6f, 0x0a, 0xcc,
+	0x15, 0x49, 0xf5, 0xe1, 0x33, 0x58, 0xff, 0x62, 0x1b, 0x56, 0xe8, 0x9a, 0x8b, 0x1b, 0x1e, 0x5d,
+	0xf7, 0xe4, 0x4b, 0x60, 0x92, 0x20, 0xcf, 0x46, 0xbe, 0x22, 0x9d, 0x95, 0x16, 0xa7, 0x0b, 0xe9,
+	0x56, 0x53, 0x3d, 0xba, 0x03, 0xdd, 0x5a, 0x5e, 0xe3, 0xef, 0x35, 0x4b, 0x2c, 0x90, 0xef, 0x83,
+	0xc9, 0xc0, 0x17, 0x51, 0xc6, 0xcf, 0xa6, 0x16, 0x67, 0x96, 0x0d, 0xa3, 0x0f, 0x03, 0x06, 0x73,
+	0x15, 0x7a, 0xb1, 0x18, 0xac, 0x30, 0xf1, 0xa6, 0xa9, 0x8e, 0x59, 0xc2, 0x86, 0x5c, 0x04, 0x53,
+	0x14, 0x6f, 0x21, 0xaf, 0xe4, 0x78, 0x4a, 0xea, 0xac, 0xb4, 0x38, 0xb3, 0x7c, 0xda, 0xe0, 0xd1,
+	0x1b, 0x8c, 0x8b, 0xc8, 0xce, 0x1d, 0xec, 0x78, 0x85, 0x79, 0x06, 0x6d, 0x35, 0xd5, 0xe3, 0x3c,
+	0xb2, 0x10, 0xa8, 0x59, 0x87, 0x83, 0xc7, 0x75, 0x4f, 0x76, 0xc1, 0x1c, 0x7f, 0x8b, 0x1b, 0xb4,
+	0xe4, 0x3a, 0x5e, 0x09, 0x06, 0xbe, 0x95, 0x89, 0x20, 0xab, 0x55, 0x86, 0xff, 0xb3, 0xa9, 0x9e,
+	0xe4, 0x1e, 0x88, 0xbd, 0x65, 0x38, 0xd8, 0x74, 0x21, 0x7d, 0x62, 0xac, 0x7b, 0xb4, 0xd5, 0x54,
+	0x17, 0x3a, 0x0d, 0x77, 0x9b, 0xd0, 0xac, 0x74, 0xf0, 0x7a, 0xa3, 0x41, 0x8b, 0x8e, 0xc7, 0x53,
+	0x92, 0x1f, 0x83, 0x99, 0x0a, 0xf6, 0x08, 0xf5, 0xa1, 0xe3, 0x51, 0xa2, 0x1c, 0x0a, 0x12, 0x58,
+	0x1a, 0x48, 0xc8, 0x9d, 0x36, 0xa6, 0x70, 0xaa, 0xd5, 0x54, 0x65, 0xee, 0xb6, 0xc3, 0x94, 0x66,
+	0x75, 0x1a, 0xce, 0xdf, 0x78, 0xfe, 0xe1, 0xd5, 0x65, 0x51, 0x80, 0x97, 0x1f, 0x5e, 0x5d, 0x5e,
+	0x4c, 0x92, 0x03, 0x93, 0x81, 0x8e, 0x58, 0x59, 0x75, 0x1e, 0xb2, 0xee, 0x78, 0xda, 0x73, 0x09,
+	0x9c, 0x49, 0xaa, 0xb8, 0x85, 0x48, 0x1d, 0x7b, 0x04, 0xc9, 0x65, 0x30, 0xdb, 0x4e, 0x57, 0xb0,
+	0xc5, 0x35, 0x70, 0x63, 0x10, 0x5b, 0xf3, 0x71, 0xb6, 0x42, 0xa6, 0x8e, 0x85, 0x4c, 0x71, 0x6f,
+	0xda, 0xf7, 0x29, 0x90, 0x65, 0x41, 0xd4, 0x6b, 0x0e, 0x0d, 0x44, 0xb0, 0x2f, 0x01, 0x3e, 0x88,
+	0x09, 0xf0, 0xea, 0xd0, 0x02, 0x6c, 0x07, 0x10, 0x53, 0xe1, 0x2d, 0x70, 0x2c, 0x14, 0x53, 0xc9,
+	0x46, 0x1e, 0x76, 0x03, 0x2d, 0x4e, 0x17, 0x4e, 0xb7, 0x9a, 0xea, 0xc9, 0x6e, 0xb1, 0xf1, 0x79,
+	0xcd, 0x3a, 0x22, 0x24, 0x77, 0x97, 0x0d, 0x0f, 0x58, 0x77, 0xf9, 0xab, 0x31, 0x3d, 0x9c, 0x4b,
+	0xd4, 0x03, 0xcb, 0xb6, 0x43, 0x0a, 0x3f, 0x49, 0xe0, 0x62, 0xff, 0x2a, 0x1c, 0xa8, 0x28, 0x5a,
+	0x29, 0x70, 0x72, 0xb7, 0x32, 0x37, 0x1a, 0x74, 0x14, 0x2d, 0x14, 0x63, 0x5a, 0x30, 0x87, 0xd4,
+	0xc2, 0x46, 0x23, 0x51, 0x07, 0xdf, 0x80, 0x13, 0x51, 0x9d, 0x5d, 0xb8, 0x1d, 0xa6, 0xce, 0xc5,
+	0xb0, 0x32, 0x28, 0xf5, 0x4c, 0x4c, 0x29, 0x6d, 0x0b, 0x9a, 0x35, 0x2b, 0xe4, 0x52, 0x84, 0xdb,
+	0xa2, 0x77, 0x6c, 0x82, 0xe9, 0x88, 0x24, 0x65, 0x62, 0x50, 0xeb, 0x53, 0x44, 0xeb, 0x9b, 0x8d,
+	0xd1, 0xab, 0x59, 0x53, 0x21, 0xaf, 0x07, 0xd6, 0x8d, 0x6e, 0xc6, 0xd4, 0x77, 0x69, 0xb8, 0x6e,
+	0xc4, 0xa2, 0xfd, 0x56, 0x02, 0x1f, 0x25, 0x16, 0x3d, 0x92, 0x5e, 0x09, 0x1c, 0x8f, 0x08, 0xec,
+	0x52, 0xde, 0xf5, 0x41, 0xf4, 0x9f, 0x8a, 0xd1, 0x1f, 0x52, 0x7f, 0x54, 0x50, 0x2f, 0x74, 0xf7,
+	0x43, 0x0a, 0xa8, 0xfd, 0xb6, 0xc1, 0x88, 0x0a, 0xb4, 0x62, 0x0a, 0xbc, 0x36, 0xbc, 0x02, 0x7b,
+	0xb6, 0xa3, 0x02, 0x38, 0xde, 0xde, 0x3f, 0x9d, 0xfd, 0x28, 0x13, 0x4f, 0x33, 0x5a, 0x10, 0xa6,
+	0xb9, 0xd1, 0xa0, 0xbc, 0x23, 0xf5, 0x90, 0xf2, 0xc4, 0xff, 0x20, 0xe5, 0xfc, 0xb5, 0x98, 0x20,
+	0xce, 0x0f, 0x6c, 0x47, 0x4c, 0x0b, 0x2f, 0x25, 0xf0, 0xc9, 0x80, 0x42, 0x1c, 0x9c, 0x2a, 0x7e,
+	0x1c, 0x07, 0xf3, 0x2c, 0x18, 0xc4, 0xe9, 0xdb, 0x84, 0x8e, 0xff, 0x08, 0x6e, 0x21, 0xff, 0x1e,
+	0x42, 0xa3, 0xa8, 0xe1, 0x85, 0x04, 0xe6, 0x82, 0x7a, 0x94, 0xea, 0xd0, 0xf1, 0x4b, 0x94, 0x99,
+	0x28, 0x3d, 0x46, 0x68, 0xa8, 0xbb, 0xd2, 0x2e, 0xcf, 0x85, 0x73, 0x62, 0xd7, 0x8b, 0xf3, 0x21,
+	0xc9, 0xb2, 0x66, 0xa5, 0xed, 0x38, 0x2e, 0xbf, 0x1a, 0x2b, 0x48, 0xe2, 0xf5, 0x91, 0x20, 0xaa,
+	0x07, 0x50, 0x9d, 0x59, 0xd4, 0x03, 0x8b, 0x3a, 0xb3, 0xb8, 0x02, 0xd4, 0x1e, 0x54, 0x44, 0xf5,
+	0x50, 0xc0, 0x61, 0xd2, 0xa8, 0x54, 0x10, 0x21, 0x01, 0x27, 0x53, 0x56, 0x38, 0xd4, 0xfe, 0x1e,
+	0x07, 0xe7, 0x39, 0x3a, 0x04, 0x3d, 0x7c, 0x02, 0x7d, 0xb4, 0x56, 0xf5, 0x11, 0x72, 0x91, 0x47,
+	0xef, 0x61, 0x9f, 0x0b, 0x74, 0x04, 0x56, 0x2f, 0x82, 0x43, 0x7c, 0x17, 0x8c, 0x07, 0x2b, 0x67,
+	0x5b, 0x4d, 0xf5, 0x48, 0x07, 0x23, 0x9a, 0xc5, 0xa7, 0xe5, 0xaf, 0xc1, 0x11, 0xb2, 0xe5, 0xb8,
+	0xa5, 0x3a, 0xf2, 0x2b, 0x28, 0xea, 0xdb, 0x79, 0x21, 0x91, 0x85, 0xdd, 0x12, 0xb9, 0x8f, 0xaa,
+	0xb0, 0xb2, 0x73, 0x17, 0x55, 0x5a, 0x4d, 0xf5, 0x84, 0xf0, 0xdd, 0x61, 0x40, 0xb3, 0x66, 0xd8,
+	0x70, 0x93, 0x8f, 0xe4, 0xbc, 0x30, 0x0f, 0x6d, 0xdb, 0x67, 0x99, 0xf3, 0xbd, 0x34, 0x1f, 0xc3,
+	0x8a, 0x59, 0x81, 0x5d, 0xe3, 0xa3, 0xfc, 0x97, 0xb1, 0x8a, 0xac, 0xf4, 0xaa, 0x48, 0x54, 0x06,
+	0x9d, 0x30, 0xde, 0x74, 0x18, 0x12, 0xa7, 0x3f, 0xc6, 0x3e, 0xaf, 0x97, 0x66, 0x80, 0xa5, 0x61,
+	0x28, 0x0e, 0xab, 0xa5, 0xfd, 0x2e, 0x81, 0x05, 0x0e, 0xb0, 0x50, 0xd5, 0x21, 0x14, 0xf9, 0xc8,
+	0x5e, 0xab, 0xd5, 0xf0, 0x0e, 0xb2, 0x37, 0x31, 0xae, 0x8d, 0x52, 0x8a, 0x4f, 0xc1, 0x61, 0x16,
+	0x71, 0xc9, 0xb1, 0x83, 0x62, 0x4c, 0x14, 0xe4, 0x56, 0x53, 0x3d, 0xc6, 0xd7, 0x8a, 0x09, 0xcd,
+	0x9a, 0x64, 0x4f, 0xeb, 0x76, 0xfe, 0x56, 0x2c, 0x69, 0xb3, 0x57, 0xd2, 0x7e, 0x14, 0x96, 0x0e,
+	0x79, 0x5c, 0x3a, 0x5b, 0xa2, 0x5d, 0x00, 0xe7, 0xfa, 0xc4, 0x1d, 0xe5, 0xf7, 0xcf, 0x38, 0x48,
+	0xef, 0xde, 0xb6, 0x9f, 0x83, 0xc9, 0x80, 0xae, 0x2b, 0x22, 0xab, 0x0b, 0xad, 0xa6, 0xaa, 0x76,
+	0xc8, 0xe6, 0x8a, 0xb6, 0x64, 0xa3, 0xba, 0x8f, 0x2a, 0x90, 0x22, 0x3b, 0xaf, 0x51, 0xbf, 0x81,
+	0x34, 0x45, 0xb2, 0x04, 0x28, 0x82, 0xe7, 0x94, 0xf1, 0x44, 0x78, 0xae, 0x1f, 0x3c, 0x27, 0x3f,
+	0x02, 0xd3, 0xed, 0xdd, 0x9f, 0xea, 0xea, 0x55, 0x03, 0x84, 0x18, 0x1e, 0xf1, 0xed, 0x1d, 0x3e,
+	0x45, 0xdb, 0x39, 0x75, 0xdd, 0x3b, 0x95, 0x89, 0xd1, 0xae, 0xa9, 0xb7, 0x41, 0xf7, 0x29, 0xa1,
+	0x1c, 0x1a, 0xf1, 0x58, 0x59, 0x7e, 0x3d, 0x05, 0x52, 0x45, 0x52, 0x95, 0xbf, 0x93, 0x40, 0x7a,
+	0xf7, 0x2d, 0x3e, 0xd7, 0xb7, 0xbf, 0x25, 0x7d, 0x87, 0x64, 0x6e, 0x8e, 0x0c, 0x89, 0x9a, 0xd0,
+	0x0b, 0x09, 0xc8, 0x09, 0x87, 0xf7, 0xf2, 0x88, 0x16, 0x37, 0x1a, 0x34, 0x93, 0x1f, 0x1d, 0x13,
+	0x85, 0xf1, 0xab, 0x04, 0x16, 0xfa, 0x7d, 0xda, 0xac, 0x0c, 0xb4, 0xdd, 0x1b, 0x9c, 0xb9, 0xb3,
+	0x0f, 0x70, 0x14, 0xe1, 0x6f, 0x12, 0x38, 0xd3, 0xf7, 0xbe, 0xb3, 0xba, 0x67, 0x2f, 0x8c, 0xbc,
+	0xbb, 0xfb, 0x41, 0x47, 0x41, 0xbe, 0x94, 0xc0, 0x5c, 0xe2, 0xf1, 0x7b, 0x6d, 0xa0, 0xf9, 0x04,
+	0x54, 0x66, 0x75, 0x2f, 0xa8, 0x28, 0x98, 0xd7, 0x12, 0xf8, 0x78, 0xf0, 0x11, 0xb6, 0x36, 0x84,
+	0x8f, 0xfe, 0x26, 0x32, 0xeb, 0xfb, 0x36, 0x11, 0xc5, 0xfc, 0x8b, 0x04, 0x94, 0x9e, 0x2d, 0xfe,
+	0xc6, 0x10, 0x7e, 0x12, 0x91, 0x99, 0xdb, 0x7b, 0x45, 0x86, 0x81, 0x15, 0x1e, 0xbc, 0x79, 0x97,
+	0x95, 0xde, 0xbe, 0xcb, 0x4a, 0x7f, 0xbd, 0xcb, 0x4a, 0x3f, 0xbf, 0xcf, 0x8e, 0xbd, 0x7d, 0x9f,
+	0x1d, 0xfb, 0xe3, 0x7d, 0x76, 0xec, 0xab, 0xeb, 0x55, 0x87, 0x3e, 0x69, 0x94, 0x8d, 0x0a, 0x76,
+	0xc3, 0xb3, 0x41, 0xaf, 0xc1, 0x32, 0x09, 0x07, 0xe6, 0xd3, 0xe5, 0xcf, 0xcc, 0xed, 0xae, 0xe3,
+	0x82, 0xee, 0xd4, 0x11, 0x29, 0x4f, 0x06, 0xbf, 0x67, 0x5d, 0xfd, 0x6f, 0x00, 0xce, 0x1a, 0x1b,
+	0xe3, 0xa4, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1230,6 +1250,18 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1421,6 +1453,18 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1896,6 +1940,10 @@ func (m *MsgSwapExactAmountIn) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1966,6 +2014,10 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenOut.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2299,6 +2351,42 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &SwapConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2832,6 +2920,42 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &SwapConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,11 @@
+package types
+
+const (
+	// VolumeEpochIdentifier is the identifier of the epochs pool volume is bucketed by.
+	VolumeEpochIdentifier = "day"
+
+	// MaxVolumeEpochsRetained is the number of most recent volume epochs, the current one
+	// included, whose per-pool volume is kept in state. Older buckets are pruned at the start
+	// of every volume epoch.
+	MaxVolumeEpochsRetained uint64 = 30
+)
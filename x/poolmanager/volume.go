@@ -0,0 +1,162 @@
+package poolmanager
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+// GetCurrentVolumeEpoch returns the number of the volume epoch volume is currently tracked in.
+// It is zero until the first volume epoch starts.
+func (k Keeper) GetCurrentVolumeEpoch(ctx sdk.Context) uint64 {
+	currentVolumeEpoch := gogotypes.UInt64Value{}
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyCurrentVolumeEpoch, &currentVolumeEpoch); err != nil {
+		panic(err)
+	}
+	return currentVolumeEpoch.Value
+}
+
+// setCurrentVolumeEpoch sets the number of the volume epoch volume is currently tracked in.
+func (k Keeper) setCurrentVolumeEpoch(ctx sdk.Context, epochNumber uint64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyCurrentVolumeEpoch, &gogotypes.UInt64Value{Value: epochNumber})
+}
+
+// SetEpochVolume sets the volume of the given pool generated during the given volume epoch.
+// Note that this function is exported for genesis and cross-module testing purposes and should not be
+// called directly from other modules.
+func (k Keeper) SetEpochVolume(ctx sdk.Context, poolId, epochNumber uint64, volume sdk.Coins) {
+	storedVolume := types.TrackedVolume{Amount: volume}
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPoolEpochVolume(poolId, epochNumber), &storedVolume)
+}
+
+// GetEpochVolumeForPool gets the volume in all supported denominations of the given pool
+// generated during the given volume epoch. Returns empty coins if none was tracked or if the epoch was pruned.
+func (k Keeper) GetEpochVolumeForPool(ctx sdk.Context, poolId, epochNumber uint64) sdk.Coins {
+	var trackedVolume types.TrackedVolume
+	volumeFound, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPoolEpochVolume(poolId, epochNumber), &trackedVolume)
+	if err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+	if !volumeFound {
+		return sdk.NewCoins()
+	}
+	return trackedVolume.Amount
+}
+
+// addEpochVolume adds the given volume to the current volume epoch bucket of the given pool ID.
+func (k Keeper) addEpochVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+	currentVolumeEpoch := k.GetCurrentVolumeEpoch(ctx)
+	currentEpochVolume := k.GetEpochVolumeForPool(ctx, poolId, currentVolumeEpoch)
+	k.SetEpochVolume(ctx, poolId, currentVolumeEpoch, currentEpochVolume.Add(volumeGenerated))
+}
+
+// GetPoolVolumeForLastEpochs returns the volume of the given pool over the last numEpochs volume epochs,
+// from the current one backwards. Epochs without volume are returned with empty coins.
+// If numEpochs is zero, all the retained epochs are returned.
+// Returns error if numEpochs exceeds the number of retained epochs.
+func (k Keeper) GetPoolVolumeForLastEpochs(ctx sdk.Context, poolId, numEpochs uint64) (*queryproto.PoolVolumeByEpochsResponse, error) {
+	if numEpochs == 0 {
+		numEpochs = types.MaxVolumeEpochsRetained
+	}
+	if numEpochs > types.MaxVolumeEpochsRetained {
+		return nil, types.VolumeEpochsLimitExceededError{NumEpochs: numEpochs, Max: types.MaxVolumeEpochsRetained}
+	}
+
+	currentVolumeEpoch := k.GetCurrentVolumeEpoch(ctx)
+	// No epoch precedes epoch zero.
+	if numEpochs > currentVolumeEpoch+1 {
+		numEpochs = currentVolumeEpoch + 1
+	}
+
+	epochVolumes := make([]types.PoolEpochVolume, 0, numEpochs)
+	totalVolume := sdk.NewCoins()
+	for i := uint64(0); i < numEpochs; i++ {
+		epochNumber := currentVolumeEpoch - i
+		volume := k.GetEpochVolumeForPool(ctx, poolId, epochNumber)
+		epochVolumes = append(epochVolumes, types.PoolEpochVolume{
+			PoolId:      poolId,
+			EpochNumber: epochNumber,
+			Volume:      volume,
+		})
+		totalVolume = totalVolume.Add(volume...)
+	}
+
+	return &queryproto.PoolVolumeByEpochsResponse{
+		EpochVolumes: epochVolumes,
+		Volume:       totalVolume,
+	}, nil
+}
+
+// GetOsmoVolumeForPoolLastEpochs gets the OSMO-denominated volume of the given pool over the last numEpochs
+// volume epochs, the current one included. numEpochs is capped to the number of retained epochs.
+func (k Keeper) GetOsmoVolumeForPoolLastEpochs(ctx sdk.Context, poolId, numEpochs uint64) osmomath.Int {
+	if numEpochs > types.MaxVolumeEpochsRetained {
+		numEpochs = types.MaxVolumeEpochsRetained
+	}
+	volume, err := k.GetPoolVolumeForLastEpochs(ctx, poolId, numEpochs)
+	if err != nil {
+		panic(err)
+	}
+	OSMO, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return volume.Volume.AmountOf(OSMO)
+}
+
+// startVolumeEpoch moves volume tracking to the given volume epoch and prunes the buckets
+// of every pool that fall out of the retention window. The buckets of senders are pruned
+// incrementally at the end of every block, see pruneUserEpochVolumes.
+func (k Keeper) startVolumeEpoch(ctx sdk.Context, epochNumber uint64) {
+	k.setCurrentVolumeEpoch(ctx, epochNumber)
+
+	if epochNumber < types.MaxVolumeEpochsRetained {
+		return
+	}
+	oldestRetainedEpoch := epochNumber - types.MaxVolumeEpochsRetained + 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPoolEpochVolumePrefix)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(oldestRetainedEpoch))
+	defer iter.Close()
+
+	var keysToDelete [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// getAllPoolEpochVolumes returns the retained volume epoch buckets of all pools.
+func (k Keeper) getAllPoolEpochVolumes(ctx sdk.Context) []types.PoolEpochVolume {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPoolEpochVolumePrefix)
+	defer iter.Close()
+
+	poolEpochVolumes := []types.PoolEpochVolume{}
+	for ; iter.Valid(); iter.Next() {
+		poolId, epochNumber, err := types.ParsePoolEpochVolumeKey(iter.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var trackedVolume types.TrackedVolume
+		if err := trackedVolume.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+
+		poolEpochVolumes = append(poolEpochVolumes, types.PoolEpochVolume{
+			PoolId:      poolId,
+			EpochNumber: epochNumber,
+			Volume:      trackedVolume.Amount,
+		})
+	}
+	return poolEpochVolumes
+}
@@ -0,0 +1,100 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestEpochVolume() {
+	hundredUosmo := sdk.NewCoin(UOSMO, osmomath.NewInt(100))
+	fiftyUosmo := sdk.NewCoin(UOSMO, osmomath.NewInt(50))
+
+	s.Run("volume is bucketed by volume epoch", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolId := s.PrepareBalancerPool()
+		s.Require().Equal(uint64(0), poolManager.GetCurrentVolumeEpoch(s.Ctx))
+
+		poolManager.TrackVolume(s.Ctx, poolId, s.TestAccs[0], hundredUosmo)
+
+		// Epochs with another identifier do not start a volume epoch.
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, "week", 1))
+		s.Require().Equal(uint64(0), poolManager.GetCurrentVolumeEpoch(s.Ctx))
+
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, 1))
+		s.Require().Equal(uint64(1), poolManager.GetCurrentVolumeEpoch(s.Ctx))
+		poolManager.TrackVolume(s.Ctx, poolId, s.TestAccs[0], fiftyUosmo)
+		poolManager.TrackVolume(s.Ctx, poolId, s.TestAccs[0], fiftyUosmo)
+
+		s.Require().Equal(sdk.NewCoins(hundredUosmo), poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 0))
+		s.Require().Equal(sdk.NewCoins(hundredUosmo), poolManager.GetEpochVolumeForPool(s.Ctx, poolId, 1))
+		// The all time volume is still tracked.
+		s.Require().Equal(sdk.NewCoins(hundredUosmo.Add(hundredUosmo)), poolManager.GetTotalVolumeForPool(s.Ctx, poolId))
+
+		response, err := poolManager.GetPoolVolumeForLastEpochs(s.Ctx, poolId, 1)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PoolEpochVolume{{PoolId: poolId, EpochNumber: 1, Volume: sdk.NewCoins(hundredUosmo)}}, response.EpochVolumes)
+		s.Require().Equal(sdk.NewCoins(hundredUosmo), response.Volume)
+
+		// Requesting more epochs than exist returns every epoch since epoch zero.
+		response, err = poolManager.GetPoolVolumeForLastEpochs(s.Ctx, poolId, 0)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PoolEpochVolume{
+			{PoolId: poolId, EpochNumber: 1, Volume: sdk.NewCoins(hundredUosmo)},
+			{PoolId: poolId, EpochNumber: 0, Volume: sdk.NewCoins(hundredUosmo)},
+		}, response.EpochVolumes)
+		s.Require().Equal(sdk.NewCoins(hundredUosmo.Add(hundredUosmo)), response.Volume)
+		s.Require().Equal(hundredUosmo.Amount.MulRaw(2), poolManager.GetOsmoVolumeForPoolLastEpochs(s.Ctx, poolId, 2))
+
+		// Epochs without volume are returned with empty coins.
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, 2))
+		response, err = poolManager.GetPoolVolumeForLastEpochs(s.Ctx, poolId, 2)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.PoolEpochVolume{
+			{PoolId: poolId, EpochNumber: 2, Volume: sdk.NewCoins()},
+			{PoolId: poolId, EpochNumber: 1, Volume: sdk.NewCoins(hundredUosmo)},
+		}, response.EpochVolumes)
+		s.Require().True(poolManager.GetOsmoVolumeForPoolLastEpochs(s.Ctx, poolId, 1).IsZero())
+	})
+
+	s.Run("buckets outside of the retention window are pruned", func() {
+		s.SetupTest()
+		poolManager := s.App.PoolManagerKeeper
+		poolIdA := s.PrepareBalancerPool()
+		poolIdB := s.PrepareBalancerPool()
+
+		for epochNumber := uint64(0); epochNumber < types.MaxVolumeEpochsRetained; epochNumber++ {
+			if epochNumber > 0 {
+				s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, int64(epochNumber)))
+			}
+			poolManager.TrackVolume(s.Ctx, poolIdA, s.TestAccs[0], hundredUosmo)
+			poolManager.TrackVolume(s.Ctx, poolIdB, s.TestAccs[0], fiftyUosmo)
+		}
+		response, err := poolManager.GetPoolVolumeForLastEpochs(s.Ctx, poolIdA, 0)
+		s.Require().NoError(err)
+		s.Require().Len(response.EpochVolumes, int(types.MaxVolumeEpochsRetained))
+
+		s.Require().NoError(poolManager.EpochHooks().BeforeEpochStart(s.Ctx, types.VolumeEpochIdentifier, int64(types.MaxVolumeEpochsRetained)))
+
+		s.Require().Empty(poolManager.GetEpochVolumeForPool(s.Ctx, poolIdA, 0))
+		s.Require().Empty(poolManager.GetEpochVolumeForPool(s.Ctx, poolIdB, 0))
+		s.Require().Equal(sdk.NewCoins(hundredUosmo), poolManager.GetEpochVolumeForPool(s.Ctx, poolIdA, 1))
+		s.Require().Equal(sdk.NewCoins(fiftyUosmo), poolManager.GetEpochVolumeForPool(s.Ctx, poolIdB, 1))
+
+		response, err = poolManager.GetPoolVolumeForLastEpochs(s.Ctx, poolIdA, 0)
+		s.Require().NoError(err)
+		s.Require().Len(response.EpochVolumes, int(types.MaxVolumeEpochsRetained))
+		s.Require().Equal(types.MaxVolumeEpochsRetained, response.EpochVolumes[0].EpochNumber)
+		s.Require().Equal(sdk.NewCoins(sdk.NewCoin(UOSMO, hundredUosmo.Amount.MulRaw(int64(types.MaxVolumeEpochsRetained)-1))), response.Volume)
+	})
+
+	s.Run("error: more epochs than retained", func() {
+		s.SetupTest()
+		poolId := s.PrepareBalancerPool()
+
+		_, err := s.App.PoolManagerKeeper.GetPoolVolumeForLastEpochs(s.Ctx, poolId, types.MaxVolumeEpochsRetained+1)
+		s.Require().ErrorIs(err, types.VolumeEpochsLimitExceededError{NumEpochs: types.MaxVolumeEpochsRetained + 1, Max: types.MaxVolumeEpochsRetained})
+	})
+}
@@ -54,7 +54,7 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 }
 
 // AfterCFMMSwap stores swaps to be checked by protorev given the coins swapped in the pool.
-func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
 	// Checked to avoid future unintended behavior based on how the hook is called
 	if len(input) != 1 || len(output) != 1 {
 		return
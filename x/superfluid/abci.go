@@ -16,4 +16,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper) {
 	if numBlocksSinceEpochStart == 0 {
 		k.AfterEpochStartBeginBlock(ctx)
 	}
+
+	k.ProcessBalancerPoolMigrations(ctx)
 }
@@ -5,4 +5,7 @@ const (
 	FlagSuperfluidAssets = "superfluid-assets"
 	FlagPoolIds          = "pool-ids"
 	FlagOverwrite        = "is-overwrite"
+
+	FlagBalancerPoolId     = "balancer-pool-id"
+	FlagMigrationsPerBlock = "migrations-per-block"
 )
@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalSuperfluidDelegations(),
 		GetCmdTotalDelegationByDelegator(),
 		GetCmdUnpoolWhitelist(),
+		GetCmdBalancerPoolMigrations(),
 	)
 
 	return cmd
@@ -157,3 +158,11 @@ func GetCmdUnpoolWhitelist() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+func GetCmdBalancerPoolMigrations() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryBalancerPoolMigrationsRequest](
+		"balancer-pool-migrations",
+		"Query the balancer pool to concentrated pool migrations in progress", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
@@ -346,6 +346,49 @@ func NewCmdUpdateUnpoolWhitelistProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdMigrateBalancerPoolToConcentratedProposal defines the command to create a new migrate balancer pool to concentrated proposal command.
+func NewCmdMigrateBalancerPoolToConcentratedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-balancer-pool-to-concentrated [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Migrate balancer pool to concentrated proposal",
+		Long: "This proposal will migrate all the remaining shares of a balancer pool to full range positions in its linked concentrated pool if passed. " +
+			"Locked, superfluid staked and unlocked shares are migrated over multiple blocks, at most the given number of locks or accounts per block. " +
+			"Locks keep their remaining duration and superfluid delegations are carried over to the concentrated positions.",
+		Example: "osmosisd tx gov submit-proposal migrate-balancer-pool-to-concentrated --balancer-pool-id 1 --migrations-per-block 100 --title \"Title\" --summary \"Description\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseMigrateBalancerPoolToConcentratedArgsToContent(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Uint64(FlagBalancerPoolId, 0, "The id of the balancer pool to migrate")
+	cmd.Flags().Uint64(FlagMigrationsPerBlock, 0, "The maximum number of locks or accounts to migrate per block")
+
+	return cmd
+}
+
 func NewCreateFullRangePositionAndSuperfluidDelegateCmd() (*osmocli.TxCliDesc, *types.MsgCreateFullRangePositionAndSuperfluidDelegate) {
 	return &osmocli.TxCliDesc{
 		Use:     "create-full-range-position-and-sf-delegate",
@@ -389,6 +432,36 @@ func parseUpdateUnpoolWhitelistArgsToContent(flags *flag.FlagSet) (govtypesv1bet
 	return content, nil
 }
 
+func parseMigrateBalancerPoolToConcentratedArgsToContent(flags *flag.FlagSet) (govtypesv1beta1.Content, error) {
+	title, err := flags.GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := flags.GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	balancerPoolId, err := flags.GetUint64(FlagBalancerPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationsPerBlock, err := flags.GetUint64(FlagMigrationsPerBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.MigrateBalancerPoolToConcentratedProposal{
+		Title:              title,
+		Description:        description,
+		BalancerPoolId:     balancerPoolId,
+		MigrationsPerBlock: migrationsPerBlock,
+	}
+	return content, nil
+}
+
 func NewAddToConcentratedLiquiditySuperfluidPositionCmd() (*osmocli.TxCliDesc, *types.MsgAddToConcentratedLiquiditySuperfluidPosition) {
 	return &osmocli.TxCliDesc{
 		Use:     "add-to-superfluid-cl-position",
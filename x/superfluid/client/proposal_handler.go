@@ -10,4 +10,6 @@ var (
 	SetSuperfluidAssetsProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitSetSuperfluidAssetsProposal)
 	RemoveSuperfluidAssetsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveSuperfluidAssetsProposal)
 	UpdateUnpoolWhitelistProposalHandler  = govclient.NewProposalHandler(cli.NewCmdUpdateUnpoolWhitelistProposal)
+
+	MigrateBalancerPoolToConcentratedProposalHandler = govclient.NewProposalHandler(cli.NewCmdMigrateBalancerPoolToConcentratedProposal)
 )
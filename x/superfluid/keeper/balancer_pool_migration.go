@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// StartBalancerPoolMigration starts migrating all the shares of the given balancer pool to full range positions in
+// its linked concentrated pool, at most migrationsPerBlock locks or accounts per block.
+// Errors if the balancer pool is not linked to a concentrated pool or if a balancer pool migration, of this pool
+// or of any other, is already in progress.
+func (k Keeper) StartBalancerPoolMigration(ctx sdk.Context, balancerPoolId, migrationsPerBlock uint64) (types.BalancerPoolMigration, error) {
+	if err := types.ValidateMigrationsPerBlock(migrationsPerBlock); err != nil {
+		return types.BalancerPoolMigration{}, err
+	}
+
+	if migrations := k.GetAllBalancerPoolMigrations(ctx); len(migrations) > 0 {
+		return types.BalancerPoolMigration{}, errorsmod.Wrapf(types.ErrBalancerPoolMigrationInProgress, "pool %d", migrations[0].BalancerPoolId)
+	}
+
+	clPoolId, err := k.gk.GetLinkedConcentratedPoolID(ctx, balancerPoolId)
+	if err != nil {
+		return types.BalancerPoolMigration{}, err
+	}
+
+	migration := types.NewBalancerPoolMigration(balancerPoolId, clPoolId, migrationsPerBlock)
+	k.SetBalancerPoolMigration(ctx, migration)
+	return migration, nil
+}
+
+// GetBalancerPoolMigration returns the in progress migration of the given balancer pool and whether it was found.
+func (k Keeper) GetBalancerPoolMigration(ctx sdk.Context, balancerPoolId uint64) (types.BalancerPoolMigration, bool) {
+	migration := types.BalancerPoolMigration{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyBalancerPoolMigration(balancerPoolId), &migration)
+	if err != nil {
+		panic(err)
+	}
+	return migration, found
+}
+
+// SetBalancerPoolMigration stores the given balancer pool migration.
+func (k Keeper) SetBalancerPoolMigration(ctx sdk.Context, migration types.BalancerPoolMigration) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.GetKeyBalancerPoolMigration(migration.BalancerPoolId), &migration)
+}
+
+func (k Keeper) deleteBalancerPoolMigration(ctx sdk.Context, balancerPoolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyBalancerPoolMigration(balancerPoolId))
+}
+
+// GetAllBalancerPoolMigrations returns all the in progress balancer pool migrations, ordered by balancer pool id.
+func (k Keeper) GetAllBalancerPoolMigrations(ctx sdk.Context) []types.BalancerPoolMigration {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBalancerPoolMigration)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	migrations := []types.BalancerPoolMigration{}
+	for ; iterator.Valid(); iterator.Next() {
+		migration := types.BalancerPoolMigration{}
+		if err := proto.Unmarshal(iterator.Value(), &migration); err != nil {
+			panic(err)
+		}
+		migrations = append(migrations, migration)
+	}
+	return migrations
+}
+
+// ProcessBalancerPoolMigrations migrates the next batch of shares of the in progress balancer pool migrations, in order
+// of balancer pool id. At most MaxMigrationsPerBlock locks or accounts are migrated across all migrations.
+// A migration is paused for the block if the spot price of its concentrated pool deviates too much from the spot price
+// of its balancer pool.
+func (k Keeper) ProcessBalancerPoolMigrations(ctx sdk.Context) {
+	remaining := uint64(types.MaxMigrationsPerBlock)
+	for _, migration := range k.GetAllBalancerPoolMigrations(ctx) {
+		if remaining == 0 {
+			return
+		}
+		if err := k.validateBalancerPoolMigrationSpotPrice(ctx, migration); err != nil {
+			events.EmitBalancerPoolMigrationPausedEvent(ctx, migration, err)
+			continue
+		}
+		remaining -= k.processBalancerPoolMigration(ctx, migration, min(migration.MigrationsPerBlock, remaining))
+	}
+}
+
+// validateBalancerPoolMigrationSpotPrice returns an error if the relative difference between the spot prices of the
+// concentrated pool and the balancer pool of the given migration is larger than the
+// MaxBalancerPoolMigrationSpotPriceDeviation param.
+// The shares are migrated without minimum amounts at a predictable time, so the spot price of the concentrated pool
+// could otherwise be moved right before a batch to sandwich the migrated positions.
+// A concentrated pool without positions has no spot price, and the first position migrated to it sets its price at
+// the ratio of the tokens exited from the balancer pool, so it is not checked.
+func (k Keeper) validateBalancerPoolMigrationSpotPrice(ctx sdk.Context, migration types.BalancerPoolMigration) error {
+	hasPositions, err := k.clk.HasAnyPositionForPool(ctx, migration.ClPoolId)
+	if err != nil {
+		return err
+	}
+	if !hasPositions {
+		return nil
+	}
+
+	clPool, err := k.clk.GetConcentratedPoolById(ctx, migration.ClPoolId)
+	if err != nil {
+		return err
+	}
+	quoteDenom, baseDenom := clPool.GetToken1(), clPool.GetToken0()
+
+	clSpotPrice, err := k.pmk.RouteCalculateSpotPrice(ctx, migration.ClPoolId, quoteDenom, baseDenom)
+	if err != nil {
+		return err
+	}
+	balancerSpotPrice, err := k.pmk.RouteCalculateSpotPrice(ctx, migration.BalancerPoolId, quoteDenom, baseDenom)
+	if err != nil {
+		return err
+	}
+
+	deviation := clSpotPrice.Sub(balancerSpotPrice).Abs().Quo(balancerSpotPrice)
+	maxDeviation := k.GetParams(ctx).MaxBalancerPoolMigrationSpotPriceDeviation
+	if deviation.GT(osmomath.BigDecFromDec(maxDeviation)) {
+		return errorsmod.Wrapf(types.ErrBalancerPoolMigrationSpotPriceDeviation, "concentrated pool %d spot price %s, balancer pool %d spot price %s, max deviation %s",
+			migration.ClPoolId, clSpotPrice, migration.BalancerPoolId, balancerSpotPrice, maxDeviation)
+	}
+	return nil
+}
+
+// processBalancerPoolMigration migrates up to limit locks or accounts of the current phase of the given balancer pool
+// migration, then records where the next block resumes from. Returns the number of locks or accounts visited.
+// Once a phase has no shares left to migrate, the migration moves on to the next phase, and once the last phase is
+// done, the migration is deleted.
+//
+// Shares that fail to migrate, e.g. the last shares of the pool that cannot be exited, are left as they are and
+// skipped. Shares that end up before the key a phase resumes from, e.g. those of locks created during the migration,
+// are not migrated either and are left for their owners to migrate.
+func (k Keeper) processBalancerPoolMigration(ctx sdk.Context, migration types.BalancerPoolMigration, limit uint64) uint64 {
+	shareDenom := gammtypes.GetPoolShareDenom(migration.BalancerPoolId)
+
+	var (
+		nextKey    []byte
+		numVisited uint64
+	)
+	switch migration.Phase {
+	case types.BalancerPoolMigrationPhaseLocked, types.BalancerPoolMigrationPhaseUnlocking:
+		isUnlocking := migration.Phase == types.BalancerPoolMigrationPhaseUnlocking
+		locks, lockNextKey := k.lk.GetLocksDenomPaginated(ctx, isUnlocking, shareDenom, migration.NextKey, limit)
+		for _, lock := range locks {
+			owner, err := sdk.AccAddressFromBech32(lock.Owner)
+			if err != nil {
+				panic(err)
+			}
+			if k.isBalancerPoolMigrationSkipped(ctx, owner) {
+				continue
+			}
+			k.migrateBalancerPoolShares(ctx, &migration, owner, int64(lock.ID), sdk.NewCoin(shareDenom, lock.Coins.AmountOf(shareDenom)))
+		}
+		nextKey = lockNextKey
+		numVisited = uint64(len(locks))
+	case types.BalancerPoolMigrationPhaseUnlocked:
+		res, err := k.bk.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      shareDenom,
+			Pagination: &query.PageRequest{Key: migration.NextKey, Limit: limit},
+		})
+		if err != nil {
+			panic(err)
+		}
+		for _, denomOwner := range res.DenomOwners {
+			owner, err := sdk.AccAddressFromBech32(denomOwner.Address)
+			if err != nil {
+				panic(err)
+			}
+			if k.isBalancerPoolMigrationSkipped(ctx, owner) {
+				continue
+			}
+			k.migrateBalancerPoolShares(ctx, &migration, owner, 0, denomOwner.Balance)
+		}
+		nextKey = res.Pagination.NextKey
+		numVisited = uint64(len(res.DenomOwners))
+	}
+
+	if len(nextKey) > 0 {
+		migration.NextKey = nextKey
+		k.SetBalancerPoolMigration(ctx, migration)
+		return numVisited
+	}
+
+	if migration.Phase == types.BalancerPoolMigrationPhaseUnlocked {
+		k.deleteBalancerPoolMigration(ctx, migration.BalancerPoolId)
+		events.EmitBalancerPoolMigrationCompletedEvent(ctx, migration)
+		return numVisited
+	}
+
+	migration.Phase++
+	migration.NextKey = nil
+	k.SetBalancerPoolMigration(ctx, migration)
+	return numVisited
+}
+
+// isBalancerPoolMigrationSkipped returns true if the shares of the given owner are not migrated by balancer pool
+// migrations. Module accounts, the lockup module account holding the locked shares among them, are skipped, and so are
+// CosmWasm contracts, whose code does not track the concentrated positions their shares would be migrated to.
+func (k Keeper) isBalancerPoolMigrationSkipped(ctx sdk.Context, owner sdk.AccAddress) bool {
+	if _, isModuleAccount := k.ak.GetAccount(ctx, owner).(sdk.ModuleAccountI); isModuleAccount {
+		return true
+	}
+	return k.wk.HasContractInfo(ctx, owner)
+}
+
+// migrateBalancerPoolShares migrates the given shares of the owner, locked in the given lock or unlocked if lockId is 0,
+// to a full range position in the concentrated pool of the migration, and counts the shares as migrated or failed.
+// The lock status and remaining lock duration of the shares carry over to the position, as for
+// RouteLockedBalancerToConcentratedMigration. State changes are discarded if the migration fails.
+func (k Keeper) migrateBalancerPoolShares(ctx sdk.Context, migration *types.BalancerPoolMigration, owner sdk.AccAddress, lockId int64, shares sdk.Coin) {
+	var (
+		positionData       cltypes.CreateFullRangePositionData
+		concentratedLockId uint64
+	)
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		var err error
+		positionData, _, concentratedLockId, err = k.RouteLockedBalancerToConcentratedMigration(cacheCtx, owner, lockId, shares, sdk.Coins{})
+		return err
+	})
+	if err != nil {
+		migration.NumFailed++
+		events.EmitBalancerPoolMigrationFailedEvent(ctx, *migration, owner, uint64(lockId), shares, err)
+		return
+	}
+
+	migration.NumMigrated++
+	events.EmitBalancerPoolMigrationMigratedEvent(ctx, *migration, owner, uint64(lockId), shares, concentratedLockId, positionData)
+}
@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"os"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
+	lockuptypes "github.com/osmosis-labs/osmosis/v26/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
+)
+
+// counterContractPath is the path of a test contract that holds no state about positions.
+const counterContractPath = "../../concentrated-liquidity/testcontracts/compiled-wasm/counter.wasm"
+
+// prepareLinkedBalancerAndConcentratedPools creates a balancer pool and a concentrated pool with the same spot price,
+// links them and registers the concentrated pool shares as a superfluid asset.
+func (s *KeeperTestSuite) prepareLinkedBalancerAndConcentratedPools() (balancerPoolId, clPoolId uint64) {
+	balancerPoolId = s.PrepareBalancerPoolWithCoins(DefaultCoins...)
+
+	clPool := s.PrepareConcentratedPoolWithCoins(DefaultCoin0.Denom, DefaultCoin1.Denom)
+	balancerPool, err := s.App.GAMMKeeper.GetCFMMPool(s.Ctx, balancerPoolId)
+	s.Require().NoError(err)
+	balancerSpotPrice, err := balancerPool.SpotPrice(s.Ctx, DefaultCoin1.Denom, DefaultCoin0.Denom)
+	s.Require().NoError(err)
+	s.CreateFullRangePosition(clPool, sdk.NewCoins(sdk.NewCoin(DefaultCoin0.Denom, osmomath.NewInt(100000000)), sdk.NewCoin(DefaultCoin1.Denom, osmomath.NewDec(100000000).Mul(balancerSpotPrice.Dec()).TruncateInt())))
+
+	err = s.App.GAMMKeeper.OverwriteMigrationRecords(s.Ctx, gammmigration.MigrationRecords{BalancerToConcentratedPoolLinks: []gammmigration.BalancerToConcentratedPoolLink{
+		{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()},
+	}})
+	s.Require().NoError(err)
+
+	err = s.App.SuperfluidKeeper.AddNewSuperfluidAsset(s.Ctx, types.SuperfluidAsset{
+		Denom:     cltypes.GetConcentratedLockupDenomFromPoolId(clPool.GetId()),
+		AssetType: types.SuperfluidAssetTypeConcentratedShare,
+	})
+	s.Require().NoError(err)
+
+	return balancerPoolId, clPool.GetId()
+}
+
+func (s *KeeperTestSuite) TestStartBalancerPoolMigration() {
+	s.Run("linked pool", func() {
+		s.SetupTest()
+		balancerPoolId, clPoolId := s.prepareLinkedBalancerAndConcentratedPools()
+
+		migration, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 10)
+		s.Require().NoError(err)
+		s.Require().Equal(types.NewBalancerPoolMigration(balancerPoolId, clPoolId, 10), migration)
+
+		storedMigration, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+		s.Require().True(found)
+		s.Require().Equal(migration, storedMigration)
+
+		// a pool cannot be migrated twice at the same time
+		_, err = s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 10)
+		s.Require().ErrorIs(err, types.ErrBalancerPoolMigrationInProgress)
+
+		// nor can another pool be migrated while a migration is in progress
+		otherBalancerPoolId, _ := s.prepareLinkedBalancerAndConcentratedPools()
+		_, err = s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, otherBalancerPoolId, 10)
+		s.Require().ErrorIs(err, types.ErrBalancerPoolMigrationInProgress)
+		_, found = s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, otherBalancerPoolId)
+		s.Require().False(found)
+	})
+
+	s.Run("pool not linked to a concentrated pool", func() {
+		s.SetupTest()
+		balancerPoolId := s.PrepareBalancerPoolWithCoins(DefaultCoins...)
+
+		_, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 10)
+		s.Require().ErrorIs(err, gammtypes.ConcentratedPoolMigrationLinkNotFoundError{PoolIdLeaving: balancerPoolId})
+	})
+
+	s.Run("invalid migrations per block", func() {
+		s.SetupTest()
+		balancerPoolId, _ := s.prepareLinkedBalancerAndConcentratedPools()
+
+		_, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 0)
+		s.Require().Error(err)
+		_, err = s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, types.MaxMigrationsPerBlock+1)
+		s.Require().Error(err)
+	})
+}
+
+// TestProcessBalancerPoolMigrations migrates a balancer pool with a superfluid delegated lock, a superfluid
+// undelegating lock, a vanilla lock, an unlocking vanilla lock and unlocked shares, one lock or account per block.
+func (s *KeeperTestSuite) TestProcessBalancerPoolMigrations() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(6)
+	balancerPoolId, clPoolId := s.prepareLinkedBalancerAndConcentratedPools()
+	balancerPoolShareDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	unbondingDuration := stakingParams.UnbondingTime
+
+	createPosition := func(acc sdk.AccAddress, duration time.Duration, superfluidDelegate bool) positionInfo {
+		s.FundAcc(acc, DefaultCoins)
+		return s.createBalancerPosition(acc, balancerPoolId, duration, balancerPoolShareDenom, DefaultCoins, superfluidDelegate)
+	}
+
+	createPosition(s.TestAccs[1], unbondingDuration, true)
+	superfluidUndelegating := createPosition(s.TestAccs[2], unbondingDuration, true)
+	err = s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, s.TestAccs[2].String(), superfluidUndelegating.lockId)
+	s.Require().NoError(err)
+	createPosition(s.TestAccs[3], unbondingDuration+time.Hour, false)
+	vanillaUnlocking := createPosition(s.TestAccs[4], unbondingDuration+time.Hour, false)
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, vanillaUnlocking.lockId, nil)
+	s.Require().NoError(err)
+	createPosition(s.TestAccs[5], 0, false)
+
+	// the unlocking lock gets closer to its end time before it is migrated
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	vanillaUnlockingLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, vanillaUnlocking.lockId)
+	s.Require().NoError(err)
+
+	_, err = s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 1)
+	s.Require().NoError(err)
+
+	// process the migration one block at a time until it is done
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	phases := []types.BalancerPoolMigrationPhase{}
+	numBlocks := 0
+	for ; numBlocks < 20; numBlocks++ {
+		migration, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+		if !found {
+			break
+		}
+		phases = append(phases, migration.Phase)
+		s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+	}
+
+	// 3 locked locks, 1 unlocking lock and 2 accounts
+	s.Require().Equal(6, numBlocks)
+	s.Require().Equal([]types.BalancerPoolMigrationPhase{
+		types.BalancerPoolMigrationPhaseLocked, types.BalancerPoolMigrationPhaseLocked, types.BalancerPoolMigrationPhaseLocked,
+		types.BalancerPoolMigrationPhaseUnlocking,
+		types.BalancerPoolMigrationPhaseUnlocked, types.BalancerPoolMigrationPhaseUnlocked,
+	}, phases)
+
+	// All the shares are migrated, except for the ones of the account migrated last, that cannot exit the pool
+	// as it holds all of its remaining shares.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationMigrated, 5)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationFailed, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationCompleted, 1)
+	s.Require().Empty(s.App.LockupKeeper.GetLocksDenom(s.Ctx, balancerPoolShareDenom))
+
+	numAccountsWithShares := 0
+	for _, acc := range []sdk.AccAddress{s.TestAccs[0], s.TestAccs[5]} {
+		if s.App.BankKeeper.GetBalance(s.Ctx, acc, balancerPoolShareDenom).IsPositive() {
+			numAccountsWithShares++
+		}
+	}
+	s.Require().Equal(1, numAccountsWithShares)
+
+	// the superfluid delegated lock is now a superfluid delegated concentrated lock
+	clLock := s.concentratedLockOf(s.TestAccs[1], clPoolId)
+	s.Require().False(clLock.IsUnlocking())
+	s.Require().Equal(unbondingDuration, clLock.Duration)
+	_, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, clLock.ID)
+	s.Require().True(found)
+
+	// the superfluid undelegating lock keeps undelegating
+	clLock = s.concentratedLockOf(s.TestAccs[2], clPoolId)
+	synthLock, _, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, clLock.ID)
+	s.Require().NoError(err)
+	s.Require().Contains(synthLock.SynthDenom, "superunbonding")
+
+	// vanilla locks start unlocking for the remaining duration of the balancer lock
+	clLock = s.concentratedLockOf(s.TestAccs[3], clPoolId)
+	s.Require().True(clLock.IsUnlocking())
+	s.Require().Equal(unbondingDuration+time.Hour, clLock.Duration)
+
+	clLock = s.concentratedLockOf(s.TestAccs[4], clPoolId)
+	s.Require().True(clLock.IsUnlocking())
+	s.Require().Equal(vanillaUnlockingLock.EndTime, clLock.EndTime)
+}
+
+// TestProcessBalancerPoolMigrations_BlockLimit checks that all the in progress migrations together migrate at most
+// MaxMigrationsPerBlock locks or accounts per block.
+func (s *KeeperTestSuite) TestProcessBalancerPoolMigrations_BlockLimit() {
+	s.SetupTest()
+	balancerPoolId, _ := s.prepareLinkedBalancerAndConcentratedPools()
+	balancerPoolShareDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+	// Locks of the same owner and duration are merged, so each lock has its own duration.
+	for i := 0; i < types.MaxMigrationsPerBlock+1; i++ {
+		s.LockTokens(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(balancerPoolShareDenom, osmomath.NewInt(1_000_000_000_000_000_000))), time.Hour+time.Duration(i)*time.Second)
+	}
+	_, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, types.MaxMigrationsPerBlock)
+	s.Require().NoError(err)
+
+	// A migration of a pool without shares that only needs one block per phase, as of a previous version that ran
+	// several migrations at once.
+	otherBalancerPoolId := s.PrepareBalancerPoolWithCoins(DefaultCoins...)
+	s.App.SuperfluidKeeper.SetBalancerPoolMigration(s.Ctx, types.NewBalancerPoolMigration(otherBalancerPoolId, 100, types.MaxMigrationsPerBlock))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+
+	// The first migration uses up the block limit, so the second one does not progress.
+	migration, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(types.MaxMigrationsPerBlock), migration.NumMigrated+migration.NumFailed)
+	s.Require().NotEmpty(migration.NextKey)
+	otherMigration, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, otherBalancerPoolId)
+	s.Require().True(found)
+	s.Require().Equal(types.BalancerPoolMigrationPhaseLocked, otherMigration.Phase)
+
+	// The next block migrates the last lock of the first migration and moves the second one to its next phase.
+	s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+	migration, found = s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(types.MaxMigrationsPerBlock+1), migration.NumMigrated+migration.NumFailed)
+	otherMigration, found = s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, otherBalancerPoolId)
+	s.Require().True(found)
+	s.Require().Equal(types.BalancerPoolMigrationPhaseUnlocking, otherMigration.Phase)
+}
+
+// TestProcessBalancerPoolMigrations_SkippedOwners checks that the locked and unlocked shares of module accounts and
+// CosmWasm contracts are not migrated.
+func (s *KeeperTestSuite) TestProcessBalancerPoolMigrations_SkippedOwners() {
+	s.SetupTest()
+	balancerPoolId, clPoolId := s.prepareLinkedBalancerAndConcentratedPools()
+	balancerPoolShareDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+	shares := sdk.NewCoins(sdk.NewCoin(balancerPoolShareDenom, osmomath.NewInt(1_000_000_000_000_000_000)))
+
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(s.App.WasmKeeper)
+	wasmCode, err := os.ReadFile(counterContractPath)
+	s.Require().NoError(err)
+	codeId, _, err := contractKeeper.Create(s.Ctx, s.TestAccs[0], wasmCode, nil)
+	s.Require().NoError(err)
+	contractAddr, _, err := contractKeeper.Instantiate(s.Ctx, codeId, s.TestAccs[0], s.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+	s.Require().NoError(err)
+
+	// The pool creator hands out some of its shares to a contract, to a module account and to an account that is migrated.
+	for _, acc := range []sdk.AccAddress{contractAddr, s.TestAccs[1]} {
+		s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[0], acc, shares.Add(shares...)))
+		s.LockTokensNoFund(acc, shares, time.Hour)
+	}
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, s.TestAccs[0], types.ModuleName, shares))
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	_, err = s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, types.MaxMigrationsPerBlock)
+	s.Require().NoError(err)
+	for i := 0; i < 10; i++ {
+		if _, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId); !found {
+			break
+		}
+		s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+	}
+	_, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+	s.Require().False(found)
+
+	s.Require().Len(s.userPositions(s.TestAccs[1], clPoolId), 2)
+	s.Require().Empty(s.userPositions(contractAddr, clPoolId))
+	s.Require().Equal(shares, s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, contractAddr))
+	s.Require().Equal(shares, s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddr))
+	s.Require().Equal(shares.AmountOf(balancerPoolShareDenom), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, balancerPoolShareDenom).Amount)
+}
+
+// userPositions returns the ids of the positions of acc in the given concentrated pool.
+func (s *KeeperTestSuite) userPositions(acc sdk.AccAddress, clPoolId uint64) []uint64 {
+	positions, err := s.App.ConcentratedLiquidityKeeper.GetUserPositions(s.Ctx, acc, clPoolId)
+	s.Require().NoError(err)
+	positionIds := []uint64{}
+	for _, position := range positions {
+		positionIds = append(positionIds, position.PositionId)
+	}
+	return positionIds
+}
+
+// concentratedLockOf returns the lock of the single position of acc in the given concentrated pool.
+func (s *KeeperTestSuite) concentratedLockOf(acc sdk.AccAddress, clPoolId uint64) lockuptypes.PeriodLock {
+	positionIds := s.userPositions(acc, clPoolId)
+	s.Require().Len(positionIds, 1)
+	lockId, err := s.App.ConcentratedLiquidityKeeper.GetLockIdFromPositionId(s.Ctx, positionIds[0])
+	s.Require().NoError(err)
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+	s.Require().NoError(err)
+	return *lock
+}
+
+// TestProcessBalancerPoolMigrations_SpotPriceDeviation checks that a migration is paused while the spot price of the
+// concentrated pool deviates from the spot price of the balancer pool by more than the max deviation param.
+func (s *KeeperTestSuite) TestProcessBalancerPoolMigrations_SpotPriceDeviation() {
+	s.SetupTest()
+	balancerPoolId, clPoolId := s.prepareLinkedBalancerAndConcentratedPools()
+	balancerPoolShareDenom := gammtypes.GetPoolShareDenom(balancerPoolId)
+	s.LockTokens(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(balancerPoolShareDenom, osmomath.NewInt(1_000_000_000_000_000_000))), time.Hour)
+	_, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 1)
+	s.Require().NoError(err)
+
+	// Move the price of the concentrated pool ahead of the next batch.
+	swapper := s.TestAccs[2]
+	tokenIn := sdk.NewCoin(DefaultCoin1.Denom, osmomath.NewInt(100_000_000_000))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	tokenOutAmount, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, swapper, clPoolId, tokenIn, DefaultCoin0.Denom, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+
+	// The migration does not progress.
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationPaused, 1)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationMigrated, 0)
+	migration, found := s.App.SuperfluidKeeper.GetBalancerPoolMigration(s.Ctx, balancerPoolId)
+	s.Require().True(found)
+	s.Require().Equal(types.NewBalancerPoolMigration(balancerPoolId, clPoolId, 1), migration)
+
+	// The migration progresses again once the max deviation is raised above the deviation.
+	params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
+	params.MaxBalancerPoolMigrationSpotPriceDeviation = osmomath.OneDec()
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, params)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	cacheCtx, _ := s.Ctx.CacheContext()
+	s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(cacheCtx)
+	s.AssertEventEmitted(cacheCtx, types.TypeEvtBalancerPoolMigrationPaused, 0)
+	s.AssertEventEmitted(cacheCtx, types.TypeEvtBalancerPoolMigrationMigrated, 1)
+
+	// Or once the price of the concentrated pool is moved back.
+	s.App.SuperfluidKeeper.SetParams(s.Ctx, types.DefaultParams())
+	_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, swapper, clPoolId, sdk.NewCoin(DefaultCoin0.Denom, tokenOutAmount), DefaultCoin1.Denom, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.SuperfluidKeeper.ProcessBalancerPoolMigrations(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationPaused, 0)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtBalancerPoolMigrationMigrated, 1)
+}
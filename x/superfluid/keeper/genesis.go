@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize in progress balancer pool migrations
+	for _, migration := range genState.BalancerPoolMigrations {
+		k.SetBalancerPoolMigration(ctx, migration)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +52,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		BalancerPoolMigrations:        k.GetAllBalancerPoolMigrations(ctx),
 	}
 }
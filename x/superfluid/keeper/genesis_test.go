@@ -21,7 +21,8 @@ var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: osmomath.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:                          osmomath.NewDecWithPrec(5, 1), // 50%
+		MaxBalancerPoolMigrationSpotPriceDeviation: types.DefaultMaxBalancerPoolMigrationSpotPriceDeviation,
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
@@ -49,6 +50,17 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	BalancerPoolMigrations: []types.BalancerPoolMigration{
+		{
+			BalancerPoolId:     1,
+			ClPoolId:           2,
+			MigrationsPerBlock: 10,
+			Phase:              types.BalancerPoolMigrationPhaseUnlocking,
+			NextKey:            []byte{0x01},
+			NumMigrated:        10,
+			NumFailed:          1,
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -99,6 +111,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	migrations := app.SuperfluidKeeper.GetAllBalancerPoolMigrations(ctx)
+	require.Equal(t, migrations, genesis.BalancerPoolMigrations)
 	os.RemoveAll(dirName)
 }
 
@@ -126,6 +141,7 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesis.BalancerPoolMigrations, genesisExported.BalancerPoolMigrations)
 
 	os.RemoveAll(dirName)
 }
@@ -87,3 +87,16 @@ func HandleUnpoolWhiteListChange(ctx sdk.Context, k keeper.Keeper, gammKeeper ty
 	k.SetUnpoolAllowedPools(ctx, duplicatesRemovedIds)
 	return nil
 }
+
+// HandleMigrateBalancerPoolToConcentratedProposal handles the migrate balancer pool to concentrated proposal.
+// It starts migrating all the shares of the balancer pool to its linked concentrated pool, the shares are then migrated
+// over the following blocks. Fails if the balancer pool is not linked to a concentrated pool or if a balancer pool
+// migration is already in progress.
+func HandleMigrateBalancerPoolToConcentratedProposal(ctx sdk.Context, k keeper.Keeper, p *types.MigrateBalancerPoolToConcentratedProposal) error {
+	migration, err := k.StartBalancerPoolMigration(ctx, p.BalancerPoolId, p.MigrationsPerBlock)
+	if err != nil {
+		return err
+	}
+	events.EmitBalancerPoolMigrationStartedEvent(ctx, migration)
+	return nil
+}
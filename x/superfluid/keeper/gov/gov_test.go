@@ -8,6 +8,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
+	gammmigration "github.com/osmosis-labs/osmosis/v26/x/gamm/types/migration"
 	minttypes "github.com/osmosis-labs/osmosis/v26/x/mint/types"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/keeper/gov"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
@@ -358,3 +360,77 @@ func (s *KeeperTestSuite) TestHandleUnpoolWhiteListChange() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestHandleMigrateBalancerPoolToConcentratedProposal() {
+	const (
+		testTitle       = "test title"
+		testDescription = "test description"
+	)
+
+	tests := map[string]struct {
+		isLinked           bool
+		isInProgress       bool
+		migrationsPerBlock uint64
+		expectedErr        error
+	}{
+		"success": {
+			isLinked:           true,
+			migrationsPerBlock: 10,
+		},
+		"error: balancer pool not linked to a concentrated pool": {
+			migrationsPerBlock: 10,
+			expectedErr:        gammtypes.ConcentratedPoolMigrationLinkNotFoundError{PoolIdLeaving: 1},
+		},
+		"error: migration already in progress": {
+			isLinked:           true,
+			isInProgress:       true,
+			migrationsPerBlock: 10,
+			expectedErr:        types.ErrBalancerPoolMigrationInProgress,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+
+			// Setup.
+			balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("foo", 1000000))
+			clPool := s.PrepareConcentratedPoolWithCoins("bar", "foo")
+			if tc.isLinked {
+				err := s.App.GAMMKeeper.OverwriteMigrationRecords(s.Ctx, gammmigration.MigrationRecords{BalancerToConcentratedPoolLinks: []gammmigration.BalancerToConcentratedPoolLink{
+					{BalancerPoolId: balancerPoolId, ClPoolId: clPool.GetId()},
+				}})
+				s.Require().NoError(err)
+			}
+			if tc.isInProgress {
+				_, err := s.App.SuperfluidKeeper.StartBalancerPoolMigration(s.Ctx, balancerPoolId, 1)
+				s.Require().NoError(err)
+			}
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			err := gov.HandleMigrateBalancerPoolToConcentratedProposal(ctx, *s.App.SuperfluidKeeper, &types.MigrateBalancerPoolToConcentratedProposal{
+				Title:              testTitle,
+				Description:        testDescription,
+				BalancerPoolId:     balancerPoolId,
+				MigrationsPerBlock: tc.migrationsPerBlock,
+			})
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.AssertEventEmitted(ctx, types.TypeEvtBalancerPoolMigrationStarted, 0)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, types.TypeEvtBalancerPoolMigrationStarted, 1)
+
+			res, err := s.querier.BalancerPoolMigrations(ctx, &types.QueryBalancerPoolMigrationsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal([]types.BalancerPoolMigration{
+				types.NewBalancerPoolMigration(balancerPoolId, clPool.GetId(), tc.migrationsPerBlock),
+			}, res.Migrations)
+		})
+	}
+}
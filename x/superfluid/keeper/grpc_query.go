@@ -653,6 +653,19 @@ func (q Querier) UnpoolWhitelist(goCtx context.Context, req *types.QueryUnpoolWh
 	}, nil
 }
 
+// BalancerPoolMigrations returns the balancer pool migrations approved by governance that are in progress.
+func (q Querier) BalancerPoolMigrations(goCtx context.Context, req *types.QueryBalancerPoolMigrationsRequest) (*types.QueryBalancerPoolMigrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	migrations := q.GetAllBalancerPoolMigrations(sdk.UnwrapSDKContext(goCtx))
+
+	return &types.QueryBalancerPoolMigrationsResponse{
+		Migrations: migrations,
+	}, nil
+}
+
 func (q Querier) filterConcentratedPositionLocks(ctx sdk.Context, positions []model.Position, isUnbonding bool) ([]types.ConcentratedPoolUserPositionRecord, error) {
 	// Query each position ID and determine if it has a lock ID associated with it.
 	// Construct a response with the position ID, lock ID, the amount of cl shares staked, and what those shares are worth in staked osmo tokens.
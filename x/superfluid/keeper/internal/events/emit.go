@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
 )
 
@@ -172,3 +173,109 @@ func newUnpoolIdEvent(sender string, lpShareDenom string, allExitedLockIDsSerial
 		sdk.NewAttribute(types.AttributeNewLockIds, string(allExitedLockIDsSerialized)),
 	)
 }
+
+func EmitBalancerPoolMigrationStartedEvent(ctx sdk.Context, migration types.BalancerPoolMigration) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBalancerPoolMigrationStartedEvent(migration),
+	})
+}
+
+func newBalancerPoolMigrationStartedEvent(migration types.BalancerPoolMigration) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtBalancerPoolMigrationStarted,
+		sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, osmoutils.Uint64ToString(migration.BalancerPoolId)),
+		sdk.NewAttribute(types.AttributeKeyPoolIdEntering, osmoutils.Uint64ToString(migration.ClPoolId)),
+		sdk.NewAttribute(types.AttributeMigrationsPerBlock, osmoutils.Uint64ToString(migration.MigrationsPerBlock)),
+	)
+}
+
+func EmitBalancerPoolMigrationMigratedEvent(ctx sdk.Context, migration types.BalancerPoolMigration, owner sdk.AccAddress, gammLockId uint64, shares sdk.Coin, concentratedLockId uint64, positionData cltypes.CreateFullRangePositionData) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBalancerPoolMigrationMigratedEvent(migration, owner, gammLockId, shares, concentratedLockId, positionData),
+	})
+}
+
+func newBalancerPoolMigrationMigratedEvent(migration types.BalancerPoolMigration, owner sdk.AccAddress, gammLockId uint64, shares sdk.Coin, concentratedLockId uint64, positionData cltypes.CreateFullRangePositionData) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtBalancerPoolMigrationMigrated,
+		sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, osmoutils.Uint64ToString(migration.BalancerPoolId)),
+		sdk.NewAttribute(types.AttributeKeyPoolIdEntering, osmoutils.Uint64ToString(migration.ClPoolId)),
+		sdk.NewAttribute(types.AttributeOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeGammLockId, osmoutils.Uint64ToString(gammLockId)),
+		sdk.NewAttribute(types.AttributeAmount, shares.String()),
+		sdk.NewAttribute(types.AttributeConcentratedLockId, osmoutils.Uint64ToString(concentratedLockId)),
+		sdk.NewAttribute(types.AttributePositionId, osmoutils.Uint64ToString(positionData.ID)),
+		sdk.NewAttribute(types.AttributeAmount0, positionData.Amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, positionData.Amount1.String()),
+		sdk.NewAttribute(types.AttributeLiquidity, positionData.Liquidity.String()),
+	)
+}
+
+func EmitBalancerPoolMigrationFailedEvent(ctx sdk.Context, migration types.BalancerPoolMigration, owner sdk.AccAddress, gammLockId uint64, shares sdk.Coin, err error) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBalancerPoolMigrationFailedEvent(migration, owner, gammLockId, shares, err),
+	})
+}
+
+func newBalancerPoolMigrationFailedEvent(migration types.BalancerPoolMigration, owner sdk.AccAddress, gammLockId uint64, shares sdk.Coin, err error) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtBalancerPoolMigrationFailed,
+		sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, osmoutils.Uint64ToString(migration.BalancerPoolId)),
+		sdk.NewAttribute(types.AttributeKeyPoolIdEntering, osmoutils.Uint64ToString(migration.ClPoolId)),
+		sdk.NewAttribute(types.AttributeOwner, owner.String()),
+		sdk.NewAttribute(types.AttributeGammLockId, osmoutils.Uint64ToString(gammLockId)),
+		sdk.NewAttribute(types.AttributeAmount, shares.String()),
+		sdk.NewAttribute(types.AttributeError, err.Error()),
+	)
+}
+
+func EmitBalancerPoolMigrationCompletedEvent(ctx sdk.Context, migration types.BalancerPoolMigration) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBalancerPoolMigrationCompletedEvent(migration),
+	})
+}
+
+func newBalancerPoolMigrationCompletedEvent(migration types.BalancerPoolMigration) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtBalancerPoolMigrationCompleted,
+		sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, osmoutils.Uint64ToString(migration.BalancerPoolId)),
+		sdk.NewAttribute(types.AttributeKeyPoolIdEntering, osmoutils.Uint64ToString(migration.ClPoolId)),
+		sdk.NewAttribute(types.AttributeNumMigrated, osmoutils.Uint64ToString(migration.NumMigrated)),
+		sdk.NewAttribute(types.AttributeNumFailed, osmoutils.Uint64ToString(migration.NumFailed)),
+	)
+}
+
+func EmitBalancerPoolMigrationPausedEvent(ctx sdk.Context, migration types.BalancerPoolMigration, err error) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBalancerPoolMigrationPausedEvent(migration, err),
+	})
+}
+
+func newBalancerPoolMigrationPausedEvent(migration types.BalancerPoolMigration, err error) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtBalancerPoolMigrationPaused,
+		sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, osmoutils.Uint64ToString(migration.BalancerPoolId)),
+		sdk.NewAttribute(types.AttributeKeyPoolIdEntering, osmoutils.Uint64ToString(migration.ClPoolId)),
+		sdk.NewAttribute(types.AttributeError, err.Error()),
+	)
+}
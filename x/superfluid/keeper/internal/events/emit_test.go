@@ -2,6 +2,7 @@ package events_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v26/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/keeper/internal/events"
 	"github.com/osmosis-labs/osmosis/v26/x/superfluid/types"
 )
@@ -431,3 +433,241 @@ func (suite *SuperfluidEventsTestSuite) TestEmitUnpoolIdEvent() {
 		})
 	}
 }
+
+func (suite *SuperfluidEventsTestSuite) TestEmitBalancerPoolMigrationStartedEvent() {
+	testcases := map[string]struct {
+		ctx sdk.Context
+	}{
+		"basic valid": {
+			ctx: suite.CreateTestContext(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	migration := types.BalancerPoolMigration{BalancerPoolId: 1, ClPoolId: 2, MigrationsPerBlock: 10, NumMigrated: 5, NumFailed: 1}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtBalancerPoolMigrationStarted,
+					sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, "1"),
+					sdk.NewAttribute(types.AttributeKeyPoolIdEntering, "2"),
+					sdk.NewAttribute(types.AttributeMigrationsPerBlock, "10"),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitBalancerPoolMigrationStartedEvent(tc.ctx, migration)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitBalancerPoolMigrationMigratedEvent() {
+	testcases := map[string]struct {
+		ctx sdk.Context
+	}{
+		"basic valid": {
+			ctx: suite.CreateTestContext(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	migration := types.BalancerPoolMigration{BalancerPoolId: 1, ClPoolId: 2, MigrationsPerBlock: 10, NumMigrated: 5, NumFailed: 1}
+	owner := sdk.AccAddress([]byte(addressString))
+	shares := sdk.NewInt64Coin("gamm/pool/1", 100)
+	positionData := cltypes.CreateFullRangePositionData{
+		ID:        3,
+		Amount0:   osmomath.NewInt(50),
+		Amount1:   osmomath.NewInt(60),
+		Liquidity: osmomath.NewDec(70),
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtBalancerPoolMigrationMigrated,
+					sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, "1"),
+					sdk.NewAttribute(types.AttributeKeyPoolIdEntering, "2"),
+					sdk.NewAttribute(types.AttributeOwner, owner.String()),
+					sdk.NewAttribute(types.AttributeGammLockId, "4"),
+					sdk.NewAttribute(types.AttributeAmount, shares.String()),
+					sdk.NewAttribute(types.AttributeConcentratedLockId, "5"),
+					sdk.NewAttribute(types.AttributePositionId, "3"),
+					sdk.NewAttribute(types.AttributeAmount0, "50"),
+					sdk.NewAttribute(types.AttributeAmount1, "60"),
+					sdk.NewAttribute(types.AttributeLiquidity, positionData.Liquidity.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitBalancerPoolMigrationMigratedEvent(tc.ctx, migration, owner, 4, shares, 5, positionData)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitBalancerPoolMigrationFailedEvent() {
+	testcases := map[string]struct {
+		ctx sdk.Context
+	}{
+		"basic valid": {
+			ctx: suite.CreateTestContext(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	migration := types.BalancerPoolMigration{BalancerPoolId: 1, ClPoolId: 2, MigrationsPerBlock: 10, NumMigrated: 5, NumFailed: 1}
+	owner := sdk.AccAddress([]byte(addressString))
+	shares := sdk.NewInt64Coin("gamm/pool/1", 100)
+	migrationErr := errors.New("migration failed")
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtBalancerPoolMigrationFailed,
+					sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, "1"),
+					sdk.NewAttribute(types.AttributeKeyPoolIdEntering, "2"),
+					sdk.NewAttribute(types.AttributeOwner, owner.String()),
+					sdk.NewAttribute(types.AttributeGammLockId, "4"),
+					sdk.NewAttribute(types.AttributeAmount, shares.String()),
+					sdk.NewAttribute(types.AttributeError, migrationErr.Error()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitBalancerPoolMigrationFailedEvent(tc.ctx, migration, owner, 4, shares, migrationErr)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitBalancerPoolMigrationCompletedEvent() {
+	testcases := map[string]struct {
+		ctx sdk.Context
+	}{
+		"basic valid": {
+			ctx: suite.CreateTestContext(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	migration := types.BalancerPoolMigration{BalancerPoolId: 1, ClPoolId: 2, MigrationsPerBlock: 10, NumMigrated: 5, NumFailed: 1}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtBalancerPoolMigrationCompleted,
+					sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, "1"),
+					sdk.NewAttribute(types.AttributeKeyPoolIdEntering, "2"),
+					sdk.NewAttribute(types.AttributeNumMigrated, "5"),
+					sdk.NewAttribute(types.AttributeNumFailed, "1"),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitBalancerPoolMigrationCompletedEvent(tc.ctx, migration)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitBalancerPoolMigrationPausedEvent() {
+	testcases := map[string]struct {
+		ctx sdk.Context
+	}{
+		"basic valid": {
+			ctx: suite.CreateTestContext(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	migration := types.BalancerPoolMigration{BalancerPoolId: 1, ClPoolId: 2, MigrationsPerBlock: 10}
+	err := types.ErrBalancerPoolMigrationSpotPriceDeviation
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtBalancerPoolMigrationPaused,
+					sdk.NewAttribute(types.AttributeKeyPoolIdLeaving, "1"),
+					sdk.NewAttribute(types.AttributeKeyPoolIdEntering, "2"),
+					sdk.NewAttribute(types.AttributeError, err.Error()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitBalancerPoolMigrationPausedEvent(tc.ctx, migration, err)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
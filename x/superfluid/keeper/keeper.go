@@ -30,6 +30,7 @@ type Keeper struct {
 	clk  types.ConcentratedKeeper
 	pmk  types.PoolManagerKeeper
 	vspk types.ValSetPreferenceKeeper
+	wk   types.WasmKeeper
 
 	lms types.LockupMsgServer
 }
@@ -62,6 +63,11 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak
 	}
 }
 
+// SetWasmKeeper sets the wasm keeper, which is created after the superfluid keeper.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wk = wasmKeeper
+}
+
 // Logger returns a logger instance.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
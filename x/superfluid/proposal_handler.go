@@ -21,6 +21,8 @@ func NewSuperfluidProposalHandler(k keeper.Keeper, ek types.EpochKeeper, gk type
 			return handleRemoveSuperfluidAssetsProposal(ctx, k, c)
 		case *types.UpdateUnpoolWhiteListProposal:
 			return handleUnpoolWhitelistChange(ctx, k, gk, c)
+		case *types.MigrateBalancerPoolToConcentratedProposal:
+			return handleMigrateBalancerPoolToConcentratedProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pool incentives proposal content type: %T", c)
@@ -39,3 +41,7 @@ func handleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, p *t
 func handleUnpoolWhitelistChange(ctx sdk.Context, k keeper.Keeper, gammKeeper types.GammKeeper, p *types.UpdateUnpoolWhiteListProposal) error {
 	return gov.HandleUnpoolWhiteListChange(ctx, k, gammKeeper, p)
 }
+
+func handleMigrateBalancerPoolToConcentratedProposal(ctx sdk.Context, k keeper.Keeper, p *types.MigrateBalancerPoolToConcentratedProposal) error {
+	return gov.HandleMigrateBalancerPoolToConcentratedProposal(ctx, k, p)
+}
@@ -27,6 +27,9 @@ var (
 	ErrPoolNotWhitelisted   = errorsmod.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = errorsmod.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = errorsmod.Register(ModuleName, 43, "lock has more than one asset")
+
+	ErrBalancerPoolMigrationInProgress = errorsmod.Register(ModuleName, 50, "balancer pool migration already in progress")
+	ErrBalancerPoolMigrationSpotPriceDeviation = errorsmod.Register(ModuleName, 51, "spot price of the concentrated pool deviates too much from the spot price of the balancer pool")
 )
 
 type PositionNotSuperfluidStakedError struct {
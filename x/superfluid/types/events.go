@@ -27,6 +27,17 @@ const (
 	AttributeAmount1                            = "amount1"
 	AttributeLiquidity                          = "liquidity"
 
+	TypeEvtBalancerPoolMigrationStarted   = "balancer_pool_migration_started"
+	TypeEvtBalancerPoolMigrationMigrated  = "balancer_pool_migration_migrated"
+	TypeEvtBalancerPoolMigrationFailed    = "balancer_pool_migration_failed"
+	TypeEvtBalancerPoolMigrationCompleted = "balancer_pool_migration_completed"
+	TypeEvtBalancerPoolMigrationPaused    = "balancer_pool_migration_paused"
+	AttributeOwner                        = "owner"
+	AttributeMigrationsPerBlock           = "migrations_per_block"
+	AttributeNumMigrated                  = "num_migrated"
+	AttributeNumFailed                    = "num_failed"
+	AttributeError                        = "error"
+
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
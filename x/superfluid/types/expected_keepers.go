@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	addresscodec "cosmossdk.io/core/address"
@@ -28,6 +29,7 @@ type LockupKeeper interface {
 	GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []lockuptypes.PeriodLock
 	GetPeriodLocks(ctx sdk.Context) ([]lockuptypes.PeriodLock, error)
 	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	GetLocksDenomPaginated(ctx sdk.Context, isUnlocking bool, denom string, startKey []byte, limit uint64) ([]lockuptypes.PeriodLock, []byte)
 	// Despite the name, BeginForceUnlock is really BeginUnlock
 	// TODO: Fix this in future code update
 	BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (uint64, error)
@@ -70,6 +72,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 // StakingKeeper expected staking keeper.
@@ -136,8 +139,14 @@ type PoolManagerKeeper interface {
 		tokenOutDenom string,
 		tokenOutMinAmount osmomath.Int,
 	) (osmomath.Int, sdk.Coin, error)
+	RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom, baseAssetDenom string) (osmomath.BigDec, error)
 }
 
 type ValSetPreferenceKeeper interface {
 	DelegateToValidatorSet(ctx sdk.Context, delegatorAddr string, coin sdk.Coin) error
 }
+
+// WasmKeeper defines the expected interface needed to tell CosmWasm contract accounts apart.
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
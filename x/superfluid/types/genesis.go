@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -33,5 +34,13 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if len(gs.BalancerPoolMigrations) > 1 {
+		return fmt.Errorf("at most one balancer pool migration can be in progress, got %d", len(gs.BalancerPoolMigrations))
+	}
+	for _, migration := range gs.BalancerPoolMigrations {
+		if err := migration.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -16,18 +16,22 @@ const (
 	ProposalTypeSetSuperfluidAssets    = "SetSuperfluidAssets"
 	ProposalTypeRemoveSuperfluidAssets = "RemoveSuperfluidAssets"
 	ProposalTypeUpdateUnpoolWhitelist  = "UpdateUnpoolWhitelist"
+
+	ProposalTypeMigrateBalancerPoolToConcentrated = "MigrateBalancerPoolToConcentrated"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeSetSuperfluidAssets)
 	govtypesv1.RegisterProposalType(ProposalTypeRemoveSuperfluidAssets)
 	govtypesv1.RegisterProposalType(ProposalTypeUpdateUnpoolWhitelist)
+	govtypesv1.RegisterProposalType(ProposalTypeMigrateBalancerPoolToConcentrated)
 }
 
 var (
 	_ govtypesv1.Content = &SetSuperfluidAssetsProposal{}
 	_ govtypesv1.Content = &RemoveSuperfluidAssetsProposal{}
 	_ govtypesv1.Content = &UpdateUnpoolWhiteListProposal{}
+	_ govtypesv1.Content = &MigrateBalancerPoolToConcentratedProposal{}
 )
 
 func NewSetSuperfluidAssetsProposal(title, description string, assets []SuperfluidAsset) govtypesv1.Content {
@@ -167,3 +171,44 @@ func (p UpdateUnpoolWhiteListProposal) String() string {
 	IsOverwrite:  %t
   `, p.Title, p.Description, p.Ids, p.IsOverwrite)
 }
+
+func NewMigrateBalancerPoolToConcentratedProposal(title, description string, balancerPoolId, migrationsPerBlock uint64) govtypesv1.Content {
+	return &MigrateBalancerPoolToConcentratedProposal{
+		Title:              title,
+		Description:        description,
+		BalancerPoolId:     balancerPoolId,
+		MigrationsPerBlock: migrationsPerBlock,
+	}
+}
+
+func (p *MigrateBalancerPoolToConcentratedProposal) GetTitle() string { return p.Title }
+
+func (p *MigrateBalancerPoolToConcentratedProposal) GetDescription() string { return p.Description }
+
+func (p *MigrateBalancerPoolToConcentratedProposal) ProposalRoute() string { return RouterKey }
+
+func (p *MigrateBalancerPoolToConcentratedProposal) ProposalType() string {
+	return ProposalTypeMigrateBalancerPoolToConcentrated
+}
+
+func (p *MigrateBalancerPoolToConcentratedProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.BalancerPoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+
+	return ValidateMigrationsPerBlock(p.MigrationsPerBlock)
+}
+
+func (p MigrateBalancerPoolToConcentratedProposal) String() string {
+	return fmt.Sprintf(`Migrate Balancer Pool To Concentrated Proposal:
+	Title:                %s
+	Description:          %s
+	Balancer Pool Id:     %d
+	Migrations Per Block: %d
+  `, p.Title, p.Description, p.BalancerPoolId, p.MigrationsPerBlock)
+}
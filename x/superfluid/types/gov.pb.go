@@ -146,10 +146,57 @@ func (m *UpdateUnpoolWhiteListProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateUnpoolWhiteListProposal proto.InternalMessageInfo
 
+// MigrateBalancerPoolToConcentratedProposal is a gov Content type to migrate
+// all the remaining shares of a balancer pool, locked, superfluid staked and
+// unlocked alike, to full range positions in its linked concentrated liquidity
+// pool. The shares are migrated over multiple blocks, at most
+// migrations_per_block locks or accounts per block.
+type MigrateBalancerPoolToConcentratedProposal struct {
+	Title              string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	BalancerPoolId     uint64 `protobuf:"varint,3,opt,name=balancer_pool_id,json=balancerPoolId,proto3" json:"balancer_pool_id,omitempty"`
+	MigrationsPerBlock uint64 `protobuf:"varint,4,opt,name=migrations_per_block,json=migrationsPerBlock,proto3" json:"migrations_per_block,omitempty"`
+}
+
+func (m *MigrateBalancerPoolToConcentratedProposal) Reset() {
+	*m = MigrateBalancerPoolToConcentratedProposal{}
+}
+func (*MigrateBalancerPoolToConcentratedProposal) ProtoMessage() {}
+func (*MigrateBalancerPoolToConcentratedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e37d6a8d0e42294, []int{3}
+}
+func (m *MigrateBalancerPoolToConcentratedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateBalancerPoolToConcentratedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateBalancerPoolToConcentratedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateBalancerPoolToConcentratedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateBalancerPoolToConcentratedProposal.Merge(m, src)
+}
+func (m *MigrateBalancerPoolToConcentratedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateBalancerPoolToConcentratedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateBalancerPoolToConcentratedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateBalancerPoolToConcentratedProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SetSuperfluidAssetsProposal)(nil), "osmosis.superfluid.v1beta1.SetSuperfluidAssetsProposal")
 	proto.RegisterType((*RemoveSuperfluidAssetsProposal)(nil), "osmosis.superfluid.v1beta1.RemoveSuperfluidAssetsProposal")
 	proto.RegisterType((*UpdateUnpoolWhiteListProposal)(nil), "osmosis.superfluid.v1beta1.UpdateUnpoolWhiteListProposal")
+	proto.RegisterType((*MigrateBalancerPoolToConcentratedProposal)(nil), "osmosis.superfluid.v1beta1.MigrateBalancerPoolToConcentratedProposal")
 }
 
 func init() {
@@ -157,36 +204,43 @@ func init() {
 }
 
 var fileDescriptor_2e37d6a8d0e42294 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x4c, 0x2c, 0x76, 0xe2, 0x41, 0x97, 0x8a, 0x31, 0xe2, 0x6e, 0x6c, 0x45, 0x82,
-	0xb0, 0xbb, 0xb4, 0x42, 0x0e, 0xbd, 0xb5, 0x7a, 0x14, 0x2d, 0x5b, 0x82, 0xe0, 0x25, 0x6c, 0xb2,
-	0xcf, 0x74, 0x60, 0x77, 0xdf, 0xb0, 0xf3, 0x76, 0xab, 0xdf, 0x40, 0x3c, 0x79, 0xf4, 0x98, 0x8f,
-	0xe0, 0xc1, 0x0f, 0x51, 0x3c, 0xf5, 0xe8, 0x41, 0x44, 0x92, 0x43, 0xbd, 0xf9, 0x15, 0x24, 0xb3,
-	0x93, 0x26, 0x4a, 0x10, 0xd4, 0x5e, 0x96, 0x79, 0xef, 0xfd, 0xe7, 0xff, 0xdf, 0xdf, 0x83, 0xe1,
-	0xf7, 0x50, 0xa5, 0xa8, 0x84, 0x0a, 0x54, 0x21, 0x21, 0x7f, 0x99, 0x14, 0x22, 0x0e, 0xca, 0xed,
-	0x01, 0x50, 0xb4, 0x1d, 0x8c, 0xb0, 0xf4, 0x65, 0x8e, 0x84, 0x76, 0xcb, 0xa8, 0xfc, 0x85, 0xca,
-	0x37, 0xaa, 0xd6, 0xc6, 0x08, 0x47, 0xa8, 0x65, 0xc1, 0xec, 0x54, 0xdd, 0x68, 0x5d, 0x8f, 0x52,
-	0x91, 0x61, 0xa0, 0xbf, 0xa6, 0x75, 0x6b, 0xa8, 0x5d, 0xfa, 0x95, 0xb6, 0x2a, 0xcc, 0x68, 0x6b,
-	0xc5, 0x5f, 0x2c, 0x45, 0x69, 0xd1, 0xe6, 0x0f, 0xc6, 0x6f, 0x1f, 0x02, 0x1d, 0x9e, 0xf7, 0xf7,
-	0x94, 0x02, 0x52, 0x07, 0x39, 0x4a, 0x54, 0x51, 0x62, 0x6f, 0xf0, 0xcb, 0x24, 0x28, 0x81, 0x26,
-	0x6b, 0xb3, 0xce, 0x7a, 0x58, 0x15, 0x76, 0x9b, 0x37, 0x62, 0x50, 0xc3, 0x5c, 0x48, 0x12, 0x98,
-	0x35, 0x2f, 0xe9, 0xd9, 0x72, 0xcb, 0xde, 0xe3, 0x6b, 0x91, 0x76, 0x6a, 0xd6, 0xda, 0xb5, 0x4e,
-	0x63, 0x67, 0xcb, 0x5f, 0x41, 0xfb, 0x5b, 0xea, 0x7e, 0xfd, 0xe4, 0xab, 0x6b, 0x85, 0xe6, 0xe2,
-	0x6e, 0xef, 0xcd, 0xd8, 0xb5, 0xde, 0x8f, 0x5d, 0xeb, 0xfb, 0xd8, 0x65, 0x9f, 0x3e, 0x7a, 0x2d,
-	0x43, 0x37, 0xdb, 0xa0, 0xd9, 0x93, 0xff, 0x08, 0x33, 0x82, 0x8c, 0xde, 0x9e, 0x7d, 0x78, 0x70,
-	0xff, 0x1c, 0x17, 0xc8, 0x5b, 0x84, 0x78, 0x95, 0x9b, 0x27, 0x0d, 0xd1, 0xe6, 0x19, 0xe3, 0x4e,
-	0x08, 0x29, 0x96, 0x70, 0xe1, 0xd0, 0x5d, 0x7e, 0x73, 0x11, 0xdc, 0xd7, 0xc1, 0xfd, 0x18, 0x32,
-	0x4c, 0xab, 0x2d, 0xac, 0x87, 0x37, 0xd4, 0xaf, 0x91, 0x8f, 0xf5, 0xf0, 0x9f, 0x49, 0x63, 0x48,
-	0xfe, 0x44, 0xfa, 0x85, 0xf1, 0x3b, 0x3d, 0x19, 0x47, 0x04, 0xbd, 0x4c, 0x22, 0x26, 0xcf, 0x8f,
-	0x04, 0xc1, 0x13, 0xa1, 0xe8, 0xbf, 0x41, 0xaf, 0xf1, 0x9a, 0x88, 0x2b, 0xa8, 0x7a, 0x38, 0x3b,
-	0xda, 0x77, 0xf9, 0x55, 0xa1, 0xfa, 0x58, 0x42, 0x7e, 0x9c, 0x0b, 0x82, 0x66, 0xbd, 0xcd, 0x3a,
-	0x57, 0xc2, 0x86, 0x50, 0xcf, 0xe6, 0xad, 0xdd, 0xa7, 0x7f, 0x47, 0xe9, 0xce, 0x29, 0x0b, 0x8d,
-	0xe0, 0x15, 0x9a, 0xc1, 0x3b, 0x9e, 0x41, 0x24, 0x42, 0xd1, 0xfe, 0xc1, 0xc9, 0xc4, 0x61, 0xa7,
-	0x13, 0x87, 0x7d, 0x9b, 0x38, 0xec, 0xdd, 0xd4, 0xb1, 0x4e, 0xa7, 0x8e, 0xf5, 0x79, 0xea, 0x58,
-	0x2f, 0xba, 0x23, 0x41, 0x47, 0xc5, 0xc0, 0x1f, 0x62, 0x1a, 0x18, 0x17, 0x2f, 0x89, 0x06, 0x6a,
-	0x5e, 0x04, 0xe5, 0x4e, 0x37, 0x78, 0xb5, 0xfc, 0x2e, 0xe8, 0xb5, 0x04, 0x35, 0x58, 0xd3, 0x6f,
-	0xe2, 0xe1, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x61, 0x1b, 0x41, 0xc0, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6b, 0x13, 0x5f,
+	0x14, 0xc6, 0xe7, 0x36, 0xf9, 0x97, 0x7f, 0x6f, 0x44, 0xea, 0x10, 0x31, 0x46, 0x9c, 0xc4, 0x54,
+	0x24, 0x0a, 0x77, 0xc6, 0x56, 0xc8, 0x22, 0xbb, 0xa6, 0x6e, 0x04, 0x5f, 0xc2, 0xd4, 0x20, 0x88,
+	0x30, 0xcc, 0xcb, 0x31, 0xbd, 0x38, 0x33, 0x67, 0x98, 0x7b, 0x93, 0xea, 0x37, 0x10, 0x57, 0x2e,
+	0xdd, 0x08, 0xf9, 0x08, 0x2e, 0xfc, 0x10, 0xc5, 0x55, 0x97, 0x2e, 0x44, 0x24, 0x59, 0xd4, 0x9d,
+	0x5f, 0x41, 0xe6, 0xce, 0xa4, 0x89, 0x52, 0x84, 0x5a, 0x37, 0xc3, 0xdc, 0x73, 0xce, 0x7d, 0x9e,
+	0xf3, 0x7b, 0x16, 0x97, 0x5e, 0x47, 0x11, 0xa1, 0xe0, 0xc2, 0x12, 0xa3, 0x04, 0xd2, 0xe7, 0xe1,
+	0x88, 0x07, 0xd6, 0x78, 0xd3, 0x03, 0xe9, 0x6e, 0x5a, 0x43, 0x1c, 0x9b, 0x49, 0x8a, 0x12, 0xf5,
+	0x7a, 0x31, 0x65, 0x2e, 0xa6, 0xcc, 0x62, 0xaa, 0x5e, 0x1d, 0xe2, 0x10, 0xd5, 0x98, 0x95, 0xfd,
+	0xe5, 0x37, 0xea, 0x17, 0xdc, 0x88, 0xc7, 0x68, 0xa9, 0x6f, 0x51, 0xba, 0xec, 0x2b, 0x15, 0x27,
+	0x9f, 0xcd, 0x0f, 0x45, 0x6b, 0xe3, 0x84, 0x2d, 0x96, 0xac, 0xd4, 0x50, 0xeb, 0x07, 0xa1, 0x57,
+	0x76, 0x41, 0xee, 0x1e, 0xd7, 0xb7, 0x85, 0x00, 0x29, 0xfa, 0x29, 0x26, 0x28, 0xdc, 0x50, 0xaf,
+	0xd2, 0xff, 0x24, 0x97, 0x21, 0xd4, 0x48, 0x93, 0xb4, 0xd7, 0xec, 0xfc, 0xa0, 0x37, 0x69, 0x25,
+	0x00, 0xe1, 0xa7, 0x3c, 0x91, 0x1c, 0xe3, 0xda, 0x8a, 0xea, 0x2d, 0x97, 0xf4, 0x6d, 0xba, 0xea,
+	0x2a, 0xa5, 0x5a, 0xa9, 0x59, 0x6a, 0x57, 0xb6, 0x36, 0xcc, 0x13, 0x68, 0x7f, 0x73, 0xed, 0x95,
+	0x0f, 0xbe, 0x36, 0x34, 0xbb, 0xb8, 0xd8, 0x1d, 0xbc, 0x9e, 0x34, 0xb4, 0x77, 0x93, 0x86, 0xf6,
+	0x7d, 0xd2, 0x20, 0x9f, 0x3e, 0xb2, 0x7a, 0x41, 0x97, 0x25, 0x58, 0xe4, 0x64, 0xee, 0x60, 0x2c,
+	0x21, 0x96, 0x6f, 0x8e, 0x3e, 0xdc, 0xba, 0x71, 0x8c, 0x0b, 0x92, 0x2d, 0x4c, 0x58, 0xae, 0xc6,
+	0x92, 0x82, 0xa8, 0x75, 0x44, 0xa8, 0x61, 0x43, 0x84, 0x63, 0xf8, 0xe7, 0xd0, 0x1d, 0x7a, 0x69,
+	0x61, 0xec, 0x28, 0x63, 0x27, 0x80, 0x18, 0xa3, 0x3c, 0x85, 0x35, 0xfb, 0xa2, 0xf8, 0xd5, 0xf2,
+	0xae, 0x6a, 0xfe, 0x35, 0x69, 0x00, 0xe1, 0x9f, 0x48, 0xbf, 0x10, 0x7a, 0x75, 0x90, 0x04, 0xae,
+	0x84, 0x41, 0x9c, 0x20, 0x86, 0x4f, 0xf6, 0xb8, 0x84, 0xfb, 0x5c, 0xc8, 0x33, 0x83, 0xae, 0xd3,
+	0x12, 0x0f, 0x72, 0xa8, 0xb2, 0x9d, 0xfd, 0xea, 0xd7, 0xe8, 0x39, 0x2e, 0x1c, 0x1c, 0x43, 0xba,
+	0x9f, 0x72, 0x09, 0xb5, 0x72, 0x93, 0xb4, 0xff, 0xb7, 0x2b, 0x5c, 0x3c, 0x9a, 0x97, 0xba, 0x0f,
+	0x4f, 0x47, 0xd9, 0x98, 0x53, 0x8e, 0x14, 0x02, 0x1b, 0x29, 0x06, 0xb6, 0x9f, 0x41, 0x84, 0x5c,
+	0xc8, 0xd6, 0xfb, 0x15, 0x7a, 0xf3, 0x01, 0x1f, 0xa6, 0xae, 0x84, 0x9e, 0x1b, 0xba, 0xb1, 0x0f,
+	0x69, 0x1f, 0x31, 0x7c, 0x8c, 0x3b, 0x18, 0xfb, 0x10, 0xcb, 0xac, 0x11, 0x9c, 0x19, 0xb5, 0x4d,
+	0xd7, 0xbd, 0x42, 0xdd, 0xc9, 0x16, 0x70, 0x78, 0x50, 0x2b, 0x35, 0x49, 0xbb, 0x6c, 0x9f, 0xf7,
+	0x96, 0x5c, 0xef, 0x05, 0xfa, 0x6d, 0x5a, 0x8d, 0xd4, 0x3a, 0x1c, 0x63, 0xe1, 0x24, 0x90, 0x3a,
+	0x5e, 0x88, 0xfe, 0x0b, 0x15, 0x45, 0xd9, 0xd6, 0x17, 0xbd, 0x3e, 0xa4, 0xbd, 0xac, 0xd3, 0x7d,
+	0x76, 0xba, 0x44, 0xd8, 0x3c, 0x91, 0x5c, 0x0a, 0xd8, 0x7c, 0x01, 0xa6, 0x92, 0x91, 0xc8, 0xfc,
+	0x25, 0xf2, 0x5e, 0xff, 0x60, 0x6a, 0x90, 0xc3, 0xa9, 0x41, 0xbe, 0x4d, 0x0d, 0xf2, 0x76, 0x66,
+	0x68, 0x87, 0x33, 0x43, 0xfb, 0x3c, 0x33, 0xb4, 0xa7, 0x9d, 0x21, 0x97, 0x7b, 0x23, 0xcf, 0xf4,
+	0x31, 0xb2, 0x0a, 0x4d, 0x16, 0xba, 0x9e, 0x98, 0x1f, 0xac, 0xf1, 0x56, 0xc7, 0x7a, 0xb9, 0xfc,
+	0x6e, 0xc8, 0x57, 0x09, 0x08, 0x6f, 0x55, 0xbd, 0x19, 0x77, 0x7e, 0x0e, 0x00, 0x85, 0x44, 0xe8,
+	0x33, 0xe0, 0x04, 0x00, 0x00,
 }
 
 func (this *SetSuperfluidAssetsProposal) Equal(that interface{}) bool {
@@ -297,6 +351,39 @@ func (this *UpdateUnpoolWhiteListProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MigrateBalancerPoolToConcentratedProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrateBalancerPoolToConcentratedProposal)
+	if !ok {
+		that2, ok := that.(MigrateBalancerPoolToConcentratedProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.BalancerPoolId != that1.BalancerPoolId {
+		return false
+	}
+	if this.MigrationsPerBlock != that1.MigrationsPerBlock {
+		return false
+	}
+	return true
+}
 func (m *SetSuperfluidAssetsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -459,6 +546,53 @@ func (m *UpdateUnpoolWhiteListProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MigrateBalancerPoolToConcentratedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateBalancerPoolToConcentratedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateBalancerPoolToConcentratedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrationsPerBlock != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MigrationsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BalancerPoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.BalancerPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -543,6 +677,29 @@ func (m *UpdateUnpoolWhiteListProposal) Size() (n int) {
 	return n
 }
 
+func (m *MigrateBalancerPoolToConcentratedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.BalancerPoolId != 0 {
+		n += 1 + sovGov(uint64(m.BalancerPoolId))
+	}
+	if m.MigrationsPerBlock != 0 {
+		n += 1 + sovGov(uint64(m.MigrationsPerBlock))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1053,6 +1210,158 @@ func (m *UpdateUnpoolWhiteListProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MigrateBalancerPoolToConcentratedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateBalancerPoolToConcentratedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateBalancerPoolToConcentratedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancerPoolId", wireType)
+			}
+			m.BalancerPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancerPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationsPerBlock", wireType)
+			}
+			m.MigrationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
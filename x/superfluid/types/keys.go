@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "superfluid"
@@ -27,4 +29,12 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixBalancerPoolMigration defines prefix to store in progress balancer pool migrations by balancer pool id.
+	KeyPrefixBalancerPoolMigration = []byte{0x07}
 )
+
+// GetKeyBalancerPoolMigration returns the key of the balancer pool migration of the given balancer pool.
+func GetKeyBalancerPoolMigration(balancerPoolId uint64) []byte {
+	return append(KeyPrefixBalancerPoolMigration, sdk.Uint64ToBigEndian(balancerPoolId)...)
+}
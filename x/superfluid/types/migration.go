@@ -1,6 +1,53 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxMigrationsPerBlock is the maximum number of locks or accounts a balancer pool migration may migrate per block,
+// and the maximum number of locks or accounts all balancer pool migrations migrate together per block.
+// Migrations run in the BeginBlocker with no gas limit and each one exits a pool, creates a position and
+// possibly a lock and a superfluid delegation, so the number of migrations per block is kept small.
+const MaxMigrationsPerBlock = 50
+
 type MigrationPoolIDs struct {
 	LeavingID  uint64
 	EnteringID uint64
 }
+
+// NewBalancerPoolMigration returns a balancer pool migration of the given balancer pool to the given concentrated pool
+// that has not started yet.
+func NewBalancerPoolMigration(balancerPoolId, clPoolId, migrationsPerBlock uint64) BalancerPoolMigration {
+	return BalancerPoolMigration{
+		BalancerPoolId:     balancerPoolId,
+		ClPoolId:           clPoolId,
+		MigrationsPerBlock: migrationsPerBlock,
+		Phase:              BalancerPoolMigrationPhaseLocked,
+	}
+}
+
+// ValidateMigrationsPerBlock returns an error if migrationsPerBlock is zero or greater than MaxMigrationsPerBlock.
+func ValidateMigrationsPerBlock(migrationsPerBlock uint64) error {
+	if migrationsPerBlock == 0 || migrationsPerBlock > MaxMigrationsPerBlock {
+		return fmt.Errorf("migrations per block must be between 1 and %d, got %d", MaxMigrationsPerBlock, migrationsPerBlock)
+	}
+	return nil
+}
+
+// Validate returns an error if the balancer pool migration is malformed.
+func (m BalancerPoolMigration) Validate() error {
+	if m.BalancerPoolId == 0 || m.ClPoolId == 0 {
+		return errors.New("pool id cannot be 0")
+	}
+
+	if err := ValidateMigrationsPerBlock(m.MigrationsPerBlock); err != nil {
+		return err
+	}
+
+	if _, ok := BalancerPoolMigrationPhase_name[int32(m.Phase)]; !ok {
+		return fmt.Errorf("invalid balancer pool migration phase %d", m.Phase)
+	}
+
+	return nil
+}
@@ -13,6 +13,9 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = osmomath.NewDecWithPrec(5, 1) // 50%
+
+	KeyMaxBalancerPoolMigrationSpotPriceDeviation     = []byte("MaxBalancerPoolMigrationSpotPriceDeviation")
+	DefaultMaxBalancerPoolMigrationSpotPriceDeviation = osmomath.NewDecWithPrec(1, 2) // 1%
 )
 
 // ParamTable for minting module.
@@ -20,16 +23,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor osmomath.Dec) Params {
+func NewParams(minimumRiskFactor, maxBalancerPoolMigrationSpotPriceDeviation osmomath.Dec) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:                          minimumRiskFactor,
+		MaxBalancerPoolMigrationSpotPriceDeviation: maxBalancerPoolMigrationSpotPriceDeviation,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:                          defaultMinimumRiskFactor, // 5%
+		MaxBalancerPoolMigrationSpotPriceDeviation: DefaultMaxBalancerPoolMigrationSpotPriceDeviation,
 	}
 }
 
@@ -42,6 +47,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyMaxBalancerPoolMigrationSpotPriceDeviation, &p.MaxBalancerPoolMigrationSpotPriceDeviation, ValidateMaxBalancerPoolMigrationSpotPriceDeviation),
 	}
 }
 
@@ -58,6 +64,23 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateMaxBalancerPoolMigrationSpotPriceDeviation(i interface{}) error {
+	v, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max balancer pool migration spot price deviation should not be nil")
+	}
+
+	if v.IsNegative() || v.GT(osmomath.OneDec()) {
+		return fmt.Errorf("max balancer pool migration spot price deviation should be between 0 - 1: %s", v)
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -32,6 +32,13 @@ type Params struct {
 	// to counter-balance the staked amount on chain's exposure to various asset
 	// volatilities, and have base staking be 'resistant' to volatility.
 	MinimumRiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// max_balancer_pool_migration_spot_price_deviation is the maximum relative
+	// difference between the spot prices of a balancer pool and of its linked
+	// concentrated pool for the next batch of the balancer pool migration to run.
+	// The migration is paused while the difference is larger, so that the price
+	// of the concentrated pool cannot be moved right before a batch to sandwich
+	// the migrated positions. default: 1%.
+	MaxBalancerPoolMigrationSpotPriceDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_balancer_pool_migration_spot_price_deviation,json=maxBalancerPoolMigrationSpotPriceDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_balancer_pool_migration_spot_price_deviation" yaml:"max_balancer_pool_migration_spot_price_deviation"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,24 +81,29 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x9a, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x50,
-	0x21, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76, 0x7c,
-	0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xe3, 0x89, 0x7b,
-	0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x27, 0x83, 0x6d, 0x2e, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0xaa, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x8b, 0x39, 0x4a, 0x41, 0x82, 0x50, 0xd1,
-	0xa0, 0xcc, 0xe2, 0x6c, 0x37, 0xb0, 0x98, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43,
-	0xfd, 0xaa, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x99, 0xe9, 0x57, 0x20, 0x87,
-	0x4f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x57, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x52, 0x46, 0xca, 0xa7, 0x42, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4b, 0x3b, 0x31,
+	0x18, 0xc6, 0xef, 0x3a, 0x14, 0xfe, 0xb7, 0xfd, 0x4f, 0x87, 0x52, 0xe1, 0x4e, 0x6e, 0x12, 0xc1,
+	0x8b, 0x28, 0x54, 0x70, 0xb3, 0x14, 0x27, 0x85, 0xa3, 0x6e, 0x0e, 0x86, 0xdc, 0x5d, 0x9a, 0x86,
+	0x26, 0x7d, 0x63, 0x92, 0x2b, 0xed, 0xb7, 0xf0, 0x33, 0x89, 0x43, 0xc7, 0x8e, 0xe2, 0x50, 0xa4,
+	0xfd, 0x06, 0x7e, 0x02, 0x69, 0x7a, 0x45, 0x07, 0x07, 0xdd, 0xf2, 0x3e, 0xef, 0x2f, 0xef, 0xf3,
+	0xc0, 0x13, 0xc4, 0x60, 0x24, 0x18, 0x6e, 0x90, 0xa9, 0x14, 0xd5, 0x03, 0x51, 0xf1, 0x12, 0x29,
+	0xa2, 0x89, 0x34, 0xa9, 0xd2, 0x60, 0x21, 0x0c, 0x6b, 0x20, 0xfd, 0x02, 0xda, 0xfb, 0x0c, 0x18,
+	0xb8, 0x35, 0xda, 0xbc, 0xb6, 0x64, 0x3b, 0x62, 0x00, 0x4c, 0x50, 0xe4, 0xa6, 0xbc, 0x1a, 0xa0,
+	0xb2, 0xd2, 0xc4, 0x72, 0x18, 0x6f, 0xf7, 0xc9, 0x73, 0x23, 0x68, 0x66, 0xee, 0x74, 0xf8, 0x18,
+	0xec, 0x49, 0x3e, 0xe6, 0xb2, 0x92, 0x58, 0x73, 0x33, 0xc2, 0x03, 0x52, 0x58, 0xd0, 0x2d, 0xff,
+	0xd0, 0x3f, 0xfa, 0xd7, 0xbd, 0x9a, 0x2f, 0x63, 0xef, 0x6d, 0x19, 0x1f, 0x14, 0xce, 0xda, 0x94,
+	0xa3, 0x94, 0x03, 0x92, 0xc4, 0x0e, 0xd3, 0x1b, 0xca, 0x48, 0x31, 0xeb, 0xd1, 0xe2, 0x63, 0x19,
+	0xb7, 0x67, 0x44, 0x8a, 0xcb, 0xe4, 0x87, 0x3b, 0x49, 0xff, 0x7f, 0xad, 0xf6, 0xb9, 0x19, 0x5d,
+	0x3b, 0x2d, 0x7c, 0xf1, 0x83, 0x53, 0x49, 0xa6, 0x38, 0x27, 0x82, 0x8c, 0x0b, 0xaa, 0xb1, 0x02,
+	0x10, 0x58, 0x72, 0xb6, 0xcd, 0x88, 0x8d, 0x02, 0x8b, 0x95, 0xe6, 0x05, 0xc5, 0x25, 0x9d, 0x70,
+	0x27, 0xb6, 0x1a, 0x2e, 0xd0, 0xc3, 0xef, 0x02, 0x5d, 0xd4, 0x81, 0xfe, 0x68, 0x92, 0xf4, 0x8f,
+	0x25, 0x99, 0x76, 0xeb, 0x1f, 0x19, 0x80, 0xb8, 0xdd, 0xf1, 0x77, 0x0a, 0x6c, 0xb6, 0xa1, 0x7b,
+	0x3b, 0xb8, 0x9b, 0xcd, 0x57, 0x91, 0xbf, 0x58, 0x45, 0xfe, 0xfb, 0x2a, 0xf2, 0x9f, 0xd6, 0x91,
+	0xb7, 0x58, 0x47, 0xde, 0xeb, 0x3a, 0xf2, 0xee, 0x3b, 0x8c, 0xdb, 0x61, 0x95, 0xa7, 0x05, 0x48,
+	0x54, 0x77, 0x76, 0x22, 0x48, 0x6e, 0x76, 0x03, 0x9a, 0x9c, 0x75, 0xd0, 0xf4, 0x7b, 0xcf, 0x76,
+	0xa6, 0xa8, 0xc9, 0x9b, 0xae, 0x9d, 0xf3, 0xcf, 0x01, 0x00, 0x66, 0x60, 0xe6, 0xea, 0x0a, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +126,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxBalancerPoolMigrationSpotPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxBalancerPoolMigrationSpotPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -146,6 +168,8 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBalancerPoolMigrationSpotPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -218,6 +242,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalancerPoolMigrationSpotPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBalancerPoolMigrationSpotPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1859,6 +1859,86 @@ func (m *QueryRestSupplyResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+type QueryBalancerPoolMigrationsRequest struct {
+}
+
+func (m *QueryBalancerPoolMigrationsRequest) Reset()         { *m = QueryBalancerPoolMigrationsRequest{} }
+func (m *QueryBalancerPoolMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancerPoolMigrationsRequest) ProtoMessage()    {}
+func (*QueryBalancerPoolMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{38}
+}
+func (m *QueryBalancerPoolMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancerPoolMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancerPoolMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancerPoolMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancerPoolMigrationsRequest.Merge(m, src)
+}
+func (m *QueryBalancerPoolMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancerPoolMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancerPoolMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancerPoolMigrationsRequest proto.InternalMessageInfo
+
+type QueryBalancerPoolMigrationsResponse struct {
+	Migrations []BalancerPoolMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations"`
+}
+
+func (m *QueryBalancerPoolMigrationsResponse) Reset()         { *m = QueryBalancerPoolMigrationsResponse{} }
+func (m *QueryBalancerPoolMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancerPoolMigrationsResponse) ProtoMessage()    {}
+func (*QueryBalancerPoolMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{39}
+}
+func (m *QueryBalancerPoolMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancerPoolMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancerPoolMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancerPoolMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancerPoolMigrationsResponse.Merge(m, src)
+}
+func (m *QueryBalancerPoolMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancerPoolMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancerPoolMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancerPoolMigrationsResponse proto.InternalMessageInfo
+
+func (m *QueryBalancerPoolMigrationsResponse) GetMigrations() []BalancerPoolMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
@@ -1898,144 +1978,150 @@ func init() {
 	proto.RegisterType((*UserConcentratedSuperfluidPositionsUndelegatingResponse)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsUndelegatingResponse")
 	proto.RegisterType((*QueryRestSupplyRequest)(nil), "osmosis.superfluid.QueryRestSupplyRequest")
 	proto.RegisterType((*QueryRestSupplyResponse)(nil), "osmosis.superfluid.QueryRestSupplyResponse")
+	proto.RegisterType((*QueryBalancerPoolMigrationsRequest)(nil), "osmosis.superfluid.QueryBalancerPoolMigrationsRequest")
+	proto.RegisterType((*QueryBalancerPoolMigrationsResponse)(nil), "osmosis.superfluid.QueryBalancerPoolMigrationsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 2099 bytes of a gzipped FileDescriptorProto
+	// 2170 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
-	0xf5, 0x17, 0x25, 0x45, 0xb2, 0x9f, 0x01, 0x5b, 0x1e, 0x3b, 0x96, 0x44, 0x5b, 0x2b, 0x87, 0x72,
-	0x2c, 0xfd, 0x15, 0x9b, 0x8c, 0xe5, 0x58, 0x52, 0x9c, 0xbf, 0x8d, 0x48, 0x96, 0xe5, 0xa8, 0xb5,
-	0x63, 0x65, 0x65, 0xc9, 0xe8, 0x17, 0x58, 0x6a, 0x39, 0x5a, 0x11, 0xe2, 0x92, 0x2b, 0x0e, 0xa9,
-	0x64, 0x11, 0xb8, 0x05, 0x52, 0x14, 0x68, 0xd0, 0x43, 0x5b, 0xe4, 0x50, 0xe4, 0xd6, 0x4b, 0x0f,
-	0xcd, 0xa1, 0xbd, 0xb5, 0x28, 0xd0, 0x4b, 0xd1, 0x4b, 0x80, 0xa2, 0x40, 0x80, 0x02, 0x45, 0xd1,
-	0x83, 0x13, 0xd8, 0x3d, 0xb6, 0x97, 0x1e, 0xdb, 0x4b, 0xc1, 0x99, 0xe1, 0xc7, 0xee, 0x0e, 0xc9,
-	0xdd, 0xb5, 0x6b, 0xe7, 0xe4, 0x25, 0xe7, 0x7d, 0xfd, 0xde, 0xd7, 0xf0, 0x3d, 0x19, 0x4a, 0x2e,
-	0xa9, 0xb9, 0xc4, 0x22, 0x1a, 0x09, 0xea, 0xd8, 0xdb, 0xb1, 0x03, 0xcb, 0xd4, 0xf6, 0x03, 0xec,
-	0x35, 0xd4, 0xba, 0xe7, 0xfa, 0x2e, 0x42, 0xfc, 0x5c, 0x4d, 0xce, 0xe5, 0x93, 0x55, 0xb7, 0xea,
-	0xd2, 0x63, 0x2d, 0xfc, 0xc5, 0x28, 0xe5, 0x52, 0x85, 0x92, 0x6a, 0xdb, 0x06, 0xc1, 0xda, 0xc1,
-	0xa5, 0x6d, 0xec, 0x1b, 0x97, 0xb4, 0x8a, 0x6b, 0x39, 0xfc, 0xfc, 0x4c, 0xd5, 0x75, 0xab, 0x36,
-	0xd6, 0x8c, 0xba, 0xa5, 0x19, 0x8e, 0xe3, 0xfa, 0x86, 0x6f, 0xb9, 0x0e, 0xe1, 0xa7, 0x93, 0xfc,
-	0x94, 0x3e, 0x6d, 0x07, 0x3b, 0x9a, 0x6f, 0xd5, 0x30, 0xf1, 0x8d, 0x5a, 0x3d, 0x12, 0xdf, 0x4a,
-	0x60, 0x06, 0x1e, 0x95, 0xc0, 0xcf, 0xa7, 0x04, 0x40, 0x92, 0x9f, 0x91, 0x16, 0x01, 0x51, 0xdd,
-	0xf0, 0x8c, 0x5a, 0x64, 0xc6, 0x78, 0x44, 0x60, 0xbb, 0x95, 0xbd, 0xa0, 0x4e, 0xff, 0xe1, 0x47,
-	0xb3, 0x69, 0x7c, 0xd4, 0x45, 0x31, 0xca, 0xba, 0x51, 0xb5, 0x9c, 0xb4, 0x31, 0xe7, 0x38, 0x2d,
-	0xf1, 0x8d, 0x3d, 0xcb, 0xa9, 0xc6, 0x84, 0xfc, 0x99, 0x51, 0x29, 0x27, 0x01, 0xbd, 0x13, 0xca,
-	0x59, 0xa7, 0x16, 0x94, 0xf1, 0x7e, 0x80, 0x89, 0xaf, 0xdc, 0x85, 0x13, 0x4d, 0x6f, 0x49, 0xdd,
-	0x75, 0x08, 0x46, 0x8b, 0x30, 0xc4, 0x2c, 0x1d, 0x93, 0xce, 0x4a, 0x33, 0x47, 0xe6, 0x64, 0xb5,
-	0x3d, 0x32, 0x2a, 0xe3, 0x59, 0x1e, 0xfc, 0xf4, 0xe1, 0x64, 0x5f, 0x99, 0xd3, 0x2b, 0x33, 0x30,
-	0xb2, 0x44, 0x08, 0xf6, 0xef, 0x35, 0xea, 0x98, 0x2b, 0x41, 0x27, 0xe1, 0x05, 0x13, 0x3b, 0x6e,
-	0x8d, 0x0a, 0x3b, 0x5c, 0x66, 0x0f, 0xca, 0x37, 0xe0, 0x78, 0x8a, 0x92, 0x2b, 0x5e, 0x05, 0x30,
-	0xc2, 0x97, 0xba, 0xdf, 0xa8, 0x63, 0x4a, 0x7f, 0x74, 0x6e, 0x5a, 0xa4, 0x7c, 0x23, 0xfe, 0x99,
-	0x08, 0x39, 0x6c, 0x44, 0x3f, 0x15, 0x04, 0x23, 0x4b, 0xb6, 0x4d, 0x8f, 0x62, 0xac, 0x5b, 0x70,
-	0x3c, 0xf5, 0x8e, 0x2b, 0x5c, 0x82, 0x21, 0xca, 0x15, 0x22, 0x1d, 0x98, 0x39, 0x32, 0x37, 0xd5,
-	0x81, 0xb2, 0x08, 0x32, 0x63, 0x54, 0x54, 0x38, 0x45, 0x5f, 0xdf, 0x09, 0x6c, 0xdf, 0xaa, 0xdb,
-	0x16, 0xf6, 0xf2, 0x81, 0xff, 0x50, 0x82, 0xd1, 0x36, 0x06, 0x6e, 0x4e, 0x1d, 0xe4, 0x50, 0xbf,
-	0x8e, 0xf7, 0x03, 0xeb, 0xc0, 0xb0, 0xb1, 0xe3, 0xeb, 0xb5, 0x98, 0x8a, 0x07, 0x63, 0x4e, 0x64,
-	0xe2, 0x5d, 0x52, 0x73, 0x6f, 0xc6, 0x4c, 0x69, 0xc9, 0x15, 0xd7, 0x33, 0xcb, 0x63, 0x6e, 0xc6,
-	0xb9, 0xf2, 0xa1, 0x04, 0x2f, 0x25, 0xf8, 0xd6, 0x1c, 0x1f, 0x7b, 0x35, 0x6c, 0x5a, 0x86, 0xd7,
-	0x58, 0xaa, 0x54, 0xdc, 0xc0, 0xf1, 0xd7, 0x9c, 0x1d, 0x57, 0x8c, 0x04, 0x8d, 0xc3, 0xa1, 0x03,
-	0xc3, 0xd6, 0x0d, 0xd3, 0xf4, 0xc6, 0xfa, 0xe9, 0xc1, 0xf0, 0x81, 0x61, 0x2f, 0x99, 0xa6, 0x17,
-	0x1e, 0x55, 0x8d, 0xa0, 0x8a, 0x75, 0xcb, 0x1c, 0x1b, 0x38, 0x2b, 0xcd, 0x0c, 0x96, 0x87, 0xe9,
-	0xf3, 0x9a, 0x89, 0xc6, 0x60, 0x38, 0xe4, 0xc0, 0x84, 0x8c, 0x0d, 0x32, 0x26, 0xfe, 0xa8, 0xec,
-	0x42, 0x69, 0xc9, 0xb6, 0x05, 0x36, 0x44, 0x31, 0x0c, 0xf3, 0x23, 0xc9, 0x7f, 0xee, 0x8f, 0xf3,
-	0x2a, 0x2b, 0x00, 0x35, 0x2c, 0x16, 0x95, 0xf5, 0x13, 0x5e, 0x03, 0xea, 0xba, 0x51, 0x8d, 0xd2,
-	0xb0, 0x9c, 0xe2, 0x54, 0xfe, 0x20, 0xc1, 0x64, 0xa6, 0x2a, 0x1e, 0x8b, 0xfb, 0x70, 0xc8, 0xe0,
-	0xef, 0x78, 0x72, 0x5c, 0xc9, 0x4f, 0x8e, 0x0c, 0xe7, 0xf1, 0x74, 0x89, 0x85, 0xa1, 0x5b, 0x4d,
-	0x20, 0xfa, 0x29, 0x88, 0xe9, 0x42, 0x10, 0xcc, 0xaa, 0x26, 0x14, 0xd7, 0x61, 0xea, 0x86, 0xeb,
-	0x38, 0xb8, 0xe2, 0x63, 0x91, 0xf2, 0xc8, 0x69, 0xa3, 0x30, 0x1c, 0xb6, 0x96, 0x30, 0x14, 0x12,
-	0x0d, 0xc5, 0x50, 0xf8, 0xb8, 0x66, 0x2a, 0xef, 0xc2, 0xb9, 0x7c, 0x7e, 0xee, 0x89, 0xbb, 0x30,
-	0xcc, 0x8d, 0xe7, 0x2e, 0xef, 0xcd, 0x11, 0xe5, 0x48, 0x8a, 0xb2, 0x0a, 0x2a, 0x6d, 0x3b, 0xf7,
-	0x5c, 0xdf, 0xb0, 0x57, 0xb0, 0x8d, 0xab, 0x14, 0xd0, 0x72, 0x63, 0xcb, 0xb0, 0x2d, 0xd3, 0xf0,
-	0x5d, 0x6f, 0xd5, 0xf5, 0x56, 0xc2, 0x1c, 0xcb, 0x2f, 0xa5, 0x3a, 0x68, 0x1d, 0xcb, 0xe1, 0x58,
-	0xae, 0xb5, 0x14, 0xfc, 0xa4, 0x08, 0x4a, 0x22, 0x8a, 0xb4, 0x14, 0xfb, 0x17, 0x12, 0x1c, 0x49,
-	0x9d, 0x36, 0x95, 0x80, 0xd4, 0x5c, 0x02, 0xf7, 0xe0, 0x88, 0x51, 0x0b, 0xe1, 0xea, 0x64, 0x87,
-	0x98, 0xac, 0x40, 0x96, 0x2f, 0x87, 0xd2, 0xfe, 0xf6, 0x70, 0xf2, 0x45, 0x16, 0x6e, 0x62, 0xee,
-	0xa9, 0x96, 0xab, 0xd5, 0x0c, 0x7f, 0x57, 0x5d, 0x73, 0xfc, 0x7f, 0x3d, 0x9c, 0x44, 0x0d, 0xa3,
-	0x66, 0x5f, 0x55, 0x52, 0x9c, 0x4a, 0x19, 0xd8, 0xd3, 0xc6, 0x0e, 0x31, 0xd1, 0xb7, 0xe1, 0x58,
-	0x4b, 0x87, 0xa0, 0xf5, 0x75, 0x78, 0x79, 0xa1, 0x48, 0xf2, 0x29, 0x26, 0xb9, 0x85, 0x5b, 0x29,
-	0x1f, 0x6d, 0xee, 0x0d, 0xca, 0x14, 0xbc, 0x44, 0xfd, 0x99, 0xc4, 0x33, 0x05, 0x38, 0x6a, 0xa6,
-	0x3f, 0x95, 0x40, 0xc9, 0xa3, 0xe2, 0xde, 0xde, 0x87, 0xe3, 0x7e, 0x48, 0xa5, 0x9b, 0xc9, 0x21,
-	0xf3, 0xd3, 0xf2, 0x4a, 0x91, 0xbd, 0x53, 0xcc, 0x5e, 0xc6, 0x9f, 0x04, 0x27, 0x2d, 0x4a, 0x29,
-	0x8f, 0xf8, 0xcd, 0xa1, 0x27, 0xca, 0x47, 0x4d, 0x0d, 0x2d, 0x39, 0x59, 0xaa, 0xa5, 0x6b, 0xe2,
-	0x15, 0x38, 0xce, 0xe5, 0xb8, 0x9e, 0x1e, 0xb5, 0x23, 0x16, 0xc0, 0x91, 0xf8, 0x60, 0x89, 0xbd,
-	0x0f, 0x89, 0x0f, 0xa2, 0x84, 0x8a, 0x89, 0x59, 0xc3, 0x1b, 0x89, 0x0f, 0x22, 0xe2, 0x38, 0x53,
-	0x07, 0xd2, 0x99, 0xfa, 0xa1, 0x04, 0x4a, 0x9e, 0x55, 0xdc, 0x5f, 0x15, 0x18, 0x62, 0xb1, 0xe6,
-	0xd9, 0x39, 0xde, 0xd4, 0x16, 0xa2, 0x86, 0x70, 0xc3, 0xb5, 0x9c, 0xe5, 0x57, 0x43, 0xff, 0x7d,
-	0xf2, 0xf9, 0xe4, 0x4c, 0xd5, 0xf2, 0x77, 0x83, 0x6d, 0xb5, 0xe2, 0xd6, 0x34, 0xfe, 0x25, 0xc0,
-	0xfe, 0xb9, 0x48, 0xcc, 0x3d, 0x2d, 0xbc, 0x47, 0x09, 0x65, 0x20, 0x65, 0x2e, 0x5a, 0xd9, 0x82,
-	0x69, 0x61, 0xd4, 0x96, 0x1b, 0x2b, 0x11, 0xf2, 0x5e, 0xdc, 0xa4, 0xfc, 0x66, 0x00, 0x66, 0x8a,
-	0x05, 0x73, 0xa4, 0xef, 0xc1, 0x84, 0x30, 0xa6, 0xba, 0x47, 0x6f, 0xac, 0xa8, 0x3c, 0xd5, 0xfc,
-	0x4e, 0x93, 0x28, 0x61, 0x17, 0x1d, 0xaf, 0xd6, 0xd3, 0x24, 0x93, 0x82, 0xa0, 0xef, 0xc2, 0x8b,
-	0x4d, 0x39, 0x89, 0x4d, 0x3d, 0xfc, 0x72, 0x0c, 0x23, 0xfa, 0xd4, 0x5d, 0x7e, 0x22, 0x9d, 0x9e,
-	0xd8, 0xa4, 0x2f, 0xd1, 0x8f, 0x24, 0x28, 0x31, 0x0b, 0x52, 0xd7, 0x7c, 0xf8, 0xb5, 0x86, 0x4d,
-	0x9d, 0x47, 0x7f, 0x80, 0xb6, 0xd9, 0x1c, 0x53, 0x34, 0x6e, 0xca, 0x74, 0x87, 0xa6, 0x94, 0x4f,
-	0x53, 0x8d, 0x49, 0x99, 0x6f, 0x50, 0x7d, 0x2c, 0xfd, 0x14, 0x07, 0xfe, 0x2f, 0xf1, 0xe9, 0xa6,
-	0x63, 0x3e, 0xb5, 0x9c, 0x48, 0xaa, 0xa1, 0x3f, 0x5d, 0x0d, 0xff, 0xee, 0x87, 0xd9, 0x4e, 0x14,
-	0x3e, 0xf7, 0x5c, 0xf9, 0x9e, 0x04, 0xa3, 0x2c, 0x54, 0x81, 0xf3, 0x0c, 0xd2, 0x85, 0x25, 0xe6,
-	0x66, 0xa2, 0x8a, 0x25, 0xcc, 0x6d, 0x38, 0x46, 0x1a, 0x8e, 0xbf, 0x8b, 0x7d, 0xab, 0xa2, 0x87,
-	0x77, 0x37, 0x19, 0x1b, 0xa0, 0xca, 0x27, 0x62, 0xc4, 0x6c, 0x84, 0x50, 0x37, 0x22, 0xb2, 0xdb,
-	0x6e, 0x65, 0x8f, 0x03, 0x3c, 0x4a, 0xd2, 0x2f, 0x89, 0xb2, 0x0f, 0x17, 0x32, 0xaa, 0x34, 0xbe,
-	0x35, 0x9b, 0xae, 0x5e, 0x61, 0xf7, 0x93, 0x8a, 0xba, 0x5f, 0x53, 0xbc, 0x7f, 0x21, 0xc1, 0xc5,
-	0x0e, 0x75, 0x3e, 0xef, 0x90, 0x2b, 0x0f, 0x60, 0xf1, 0x26, 0xf1, 0xad, 0x9a, 0xe1, 0xe3, 0x36,
-	0x41, 0x51, 0xc1, 0xfc, 0x0f, 0x5d, 0xf5, 0x3b, 0x09, 0x5e, 0xef, 0x41, 0x3f, 0x77, 0x5b, 0x66,
-	0x6f, 0x93, 0x9e, 0x4d, 0x6f, 0x53, 0x36, 0xe1, 0xbc, 0xf8, 0x8b, 0xec, 0xc9, 0xae, 0x96, 0x8f,
-	0x07, 0x61, 0xba, 0x50, 0xee, 0x73, 0xef, 0x16, 0x06, 0x9c, 0x68, 0x52, 0xc7, 0x0c, 0xe2, 0x8d,
-	0x62, 0x36, 0xf2, 0x7d, 0x34, 0x97, 0x47, 0xee, 0x4f, 0xcb, 0x61, 0x1c, 0x5c, 0x17, 0x32, 0xdb,
-	0x4e, 0xb2, 0x03, 0x3c, 0xf0, 0xe5, 0xb9, 0xbc, 0x06, 0x9f, 0xed, 0xe5, 0x35, 0x01, 0xa7, 0x69,
-	0x6a, 0x6c, 0x3a, 0x75, 0xd7, 0xb5, 0xef, 0xef, 0x5a, 0x3e, 0xb6, 0x2d, 0x12, 0x7d, 0xe9, 0x29,
-	0xaf, 0xc3, 0x19, 0xf1, 0x31, 0xf7, 0xe8, 0x38, 0x1c, 0x0a, 0x0f, 0x74, 0x8b, 0x67, 0xc6, 0x60,
-	0x79, 0x38, 0x7c, 0x5e, 0x33, 0x89, 0xb2, 0x0d, 0x97, 0x37, 0x09, 0xf6, 0x6e, 0xb8, 0x4e, 0x05,
-	0x3b, 0xbe, 0x17, 0x3a, 0x21, 0x49, 0x90, 0x75, 0x97, 0x58, 0xb4, 0x87, 0xc5, 0x0e, 0xea, 0x29,
-	0xb3, 0x7f, 0x2d, 0xc1, 0x6b, 0xdd, 0x29, 0xe1, 0x76, 0x7f, 0x07, 0x26, 0x2a, 0xb6, 0x4e, 0x4d,
-	0x0f, 0x08, 0xf6, 0xf4, 0x3a, 0x27, 0x6d, 0x49, 0xf3, 0x79, 0x51, 0x9a, 0xa7, 0x95, 0xad, 0xbb,
-	0xae, 0x1d, 0x1a, 0x10, 0xa9, 0x6a, 0x4a, 0xf7, 0xf1, 0x8a, 0x2d, 0x3e, 0x27, 0x0a, 0x86, 0xf9,
-	0x0e, 0xec, 0x4e, 0xee, 0x76, 0xa7, 0xda, 0x93, 0x7f, 0x7e, 0x2b, 0xc1, 0x42, 0xd7, 0x7a, 0xbe,
-	0x24, 0x2e, 0x52, 0xe1, 0x14, 0x4d, 0xbd, 0x32, 0x26, 0xfe, 0x46, 0x50, 0xaf, 0xdb, 0x8d, 0xfc,
-	0x71, 0xb6, 0x0c, 0xa3, 0x6d, 0xf4, 0x1c, 0xca, 0x42, 0x6a, 0x30, 0x28, 0xa8, 0xae, 0x68, 0x60,
-	0xa5, 0xe4, 0x73, 0x7f, 0x99, 0x80, 0x17, 0xa8, 0x50, 0xf4, 0x7d, 0x09, 0x86, 0xd8, 0xce, 0x0e,
-	0x9d, 0x17, 0x21, 0x6e, 0x5f, 0x0f, 0xca, 0xd3, 0x85, 0x74, 0xcc, 0x3c, 0x65, 0xf6, 0x83, 0x3f,
-	0xff, 0xfd, 0xa3, 0xfe, 0x73, 0x48, 0xd1, 0x04, 0x4b, 0xcf, 0x64, 0x73, 0x49, 0x95, 0xff, 0x40,
-	0x82, 0xc3, 0xf1, 0xd2, 0x0e, 0x9d, 0x13, 0xa9, 0x68, 0x5d, 0x21, 0xca, 0x2f, 0x17, 0x50, 0x71,
-	0x33, 0x54, 0x6a, 0xc6, 0x0c, 0x3a, 0x9f, 0x67, 0x46, 0xb2, 0x60, 0x64, 0xa6, 0x44, 0x3b, 0xc1,
-	0x0c, 0x53, 0x5a, 0xd6, 0x88, 0x19, 0xa6, 0xb4, 0x2e, 0x16, 0x3b, 0x34, 0xc5, 0xb6, 0x75, 0xb6,
-	0x58, 0x40, 0x3f, 0x93, 0xe0, 0x58, 0xcb, 0x56, 0x10, 0xcd, 0x66, 0xa2, 0x6e, 0xdb, 0x35, 0xca,
-	0xaf, 0x74, 0x44, 0xcb, 0x8d, 0x7b, 0x8d, 0x1a, 0xa7, 0xa2, 0x0b, 0xc5, 0x7e, 0x4a, 0xd6, 0x8f,
-	0xe8, 0xf7, 0x12, 0x8c, 0x66, 0x2c, 0xcd, 0xd0, 0x5c, 0x86, 0x57, 0x72, 0x96, 0x79, 0xf2, 0xe5,
-	0xae, 0x78, 0xb8, 0xe9, 0xd7, 0xa8, 0xe9, 0x0b, 0xe8, 0x4a, 0x91, 0x5f, 0xad, 0x94, 0x14, 0x3d,
-	0xde, 0xbd, 0x7d, 0x2e, 0xc1, 0x99, 0xbc, 0x9d, 0x17, 0x5a, 0xc8, 0x68, 0x06, 0x45, 0x5b, 0x36,
-	0x79, 0xb1, 0x7b, 0x46, 0x0e, 0xe9, 0x36, 0x85, 0xb4, 0x8a, 0x56, 0xf2, 0x20, 0x55, 0x22, 0x49,
-	0x42, 0x60, 0xda, 0xfb, 0x7c, 0xc3, 0xf7, 0x00, 0xfd, 0x2a, 0xda, 0xcc, 0xe4, 0xee, 0xc3, 0xd0,
-	0x72, 0x66, 0x69, 0x77, 0xbc, 0x94, 0x93, 0x6f, 0x3c, 0x91, 0x0c, 0x8e, 0xbe, 0x0f, 0xfd, 0x51,
-	0x02, 0x39, 0x7b, 0x97, 0x84, 0x84, 0xcb, 0xc6, 0xc2, 0x0d, 0x95, 0x3c, 0xdf, 0x2d, 0x1b, 0xb7,
-	0xe7, 0x3a, 0x8d, 0xc6, 0x22, 0x9a, 0x2f, 0x4a, 0x30, 0xf1, 0x4a, 0x0a, 0xfd, 0x49, 0x02, 0x39,
-	0x7b, 0xd3, 0x83, 0xae, 0x74, 0xfa, 0xd9, 0xd9, 0xb4, 0xaf, 0x12, 0xa3, 0x29, 0x5e, 0x28, 0x29,
-	0x6f, 0x52, 0x34, 0x57, 0xd1, 0x62, 0x1e, 0x1a, 0xf1, 0xe7, 0x32, 0xbb, 0x40, 0xd0, 0x3f, 0x25,
-	0x38, 0x5b, 0xb4, 0xd5, 0x41, 0x6f, 0x74, 0x6a, 0x9e, 0x60, 0xa1, 0x20, 0xff, 0x7f, 0x6f, 0xcc,
-	0x1c, 0xe1, 0xdb, 0x14, 0xe1, 0x5b, 0x68, 0xb5, 0x6b, 0x84, 0x44, 0x7b, 0xbf, 0xed, 0x3b, 0xe4,
-	0x01, 0xfa, 0xa0, 0x3f, 0xbd, 0xa9, 0xcb, 0xda, 0x4d, 0xa0, 0x6b, 0xf9, 0x46, 0x17, 0x2c, 0x51,
-	0xe4, 0xeb, 0xbd, 0xb2, 0x73, 0xd4, 0xdf, 0xa2, 0xa8, 0xef, 0xa3, 0xcd, 0x0e, 0x51, 0x07, 0x69,
-	0x81, 0xfa, 0x76, 0x43, 0x8f, 0x91, 0x0b, 0x9d, 0xf0, 0x1f, 0x09, 0x5e, 0xee, 0x68, 0x60, 0x47,
-	0x6f, 0x76, 0x11, 0x3c, 0xe1, 0xd0, 0x2c, 0x2f, 0x3d, 0x81, 0x04, 0xee, 0x8d, 0x3b, 0xd4, 0x1b,
-	0xb7, 0xd0, 0xcd, 0xee, 0x73, 0x20, 0xf4, 0x45, 0x32, 0xb3, 0xb3, 0xbf, 0x6b, 0xfd, 0xb2, 0x1f,
-	0x2e, 0x75, 0x3d, 0x83, 0xa3, 0xdb, 0x22, 0x1c, 0xbd, 0xae, 0x12, 0xe4, 0x3b, 0x4f, 0x49, 0x1a,
-	0xf7, 0xd0, 0x37, 0xa9, 0x87, 0xb6, 0xd0, 0xbd, 0x3c, 0x0f, 0x61, 0x2e, 0x5e, 0xcf, 0x6b, 0x08,
-	0x22, 0x87, 0xfd, 0x23, 0xea, 0xe0, 0xc2, 0xc9, 0x1c, 0x5d, 0xed, 0xfc, 0x9e, 0x68, 0x2b, 0x94,
-	0x37, 0x7a, 0xe2, 0xe5, 0xa8, 0x37, 0x29, 0xea, 0xbb, 0xe8, 0x4e, 0x1e, 0xea, 0xd6, 0x3f, 0x50,
-	0x14, 0x57, 0xc7, 0x27, 0x12, 0x1c, 0x6b, 0x19, 0x27, 0x91, 0x96, 0x69, 0xa7, 0x78, 0x2e, 0x95,
-	0x5f, 0xed, 0x9c, 0xa1, 0x9b, 0xaf, 0xb6, 0x80, 0x32, 0xeb, 0xef, 0xc6, 0x86, 0x7d, 0xdc, 0x0f,
-	0x17, 0xba, 0x19, 0x30, 0xd1, 0x2d, 0x91, 0x61, 0x3d, 0xcc, 0xc1, 0xf2, 0x5b, 0x4f, 0x2e, 0x88,
-	0x23, 0xdf, 0xa2, 0xc8, 0xd7, 0xd1, 0xdb, 0xb9, 0x77, 0x32, 0xfb, 0x14, 0x4a, 0x6f, 0x46, 0xec,
-	0x78, 0xe4, 0x13, 0xf7, 0xfa, 0x9f, 0xf7, 0x83, 0xd6, 0xe5, 0x70, 0x89, 0xbe, 0xd2, 0x23, 0x2a,
-	0xc1, 0x24, 0x2c, 0x7f, 0xf5, 0xa9, 0xc8, 0xe2, 0x4e, 0xfa, 0x1a, 0x75, 0xd2, 0x06, 0x7a, 0xa7,
-	0x13, 0x27, 0x05, 0x29, 0x09, 0xc5, 0x7e, 0xfa, 0x89, 0x04, 0x90, 0x0c, 0xa5, 0xe2, 0xb9, 0x44,
-	0x3c, 0xe9, 0x8a, 0xe7, 0x92, 0x8c, 0x29, 0xb7, 0xb3, 0x31, 0x92, 0x50, 0x9e, 0xe5, 0xf5, 0x4f,
-	0x1f, 0x95, 0xa4, 0xcf, 0x1e, 0x95, 0xa4, 0x2f, 0x1e, 0x95, 0xa4, 0x1f, 0x3f, 0x2e, 0xf5, 0x7d,
-	0xf6, 0xb8, 0xd4, 0xf7, 0xd7, 0xc7, 0xa5, 0xbe, 0xaf, 0xcf, 0xa7, 0xd6, 0x4a, 0x5c, 0xce, 0x45,
-	0xdb, 0xd8, 0x26, 0xb1, 0xd0, 0x83, 0xb9, 0x79, 0xed, 0xbd, 0xb4, 0x68, 0xba, 0x6a, 0xda, 0x1e,
-	0xa2, 0xff, 0x53, 0xe6, 0xf2, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x28, 0x70, 0xa2, 0xa7,
-	0x24, 0x00, 0x00,
+	0x15, 0x16, 0x25, 0x45, 0x8a, 0x9f, 0x01, 0x5b, 0x1e, 0x3b, 0xb6, 0x44, 0xdb, 0x2b, 0x87, 0x92,
+	0x2d, 0x45, 0xb6, 0x97, 0xb1, 0x6c, 0x4b, 0x8a, 0x13, 0x1b, 0xd1, 0x5a, 0x96, 0xa3, 0xd6, 0x8a,
+	0x95, 0x95, 0x25, 0xa3, 0x7f, 0x60, 0xa9, 0xe5, 0x68, 0x45, 0x88, 0x4b, 0xae, 0x38, 0xa4, 0x92,
+	0x45, 0xe0, 0x16, 0x48, 0x51, 0xa0, 0x41, 0x0f, 0x6d, 0x91, 0x43, 0x91, 0x5b, 0x2e, 0x3d, 0x34,
+	0x87, 0xf6, 0xd6, 0xa2, 0x40, 0x2f, 0x45, 0x0f, 0x0d, 0x50, 0x14, 0x08, 0xd0, 0x4b, 0xd1, 0x83,
+	0x13, 0xd8, 0x3d, 0xb6, 0x97, 0x1e, 0xdb, 0x4b, 0xc1, 0x99, 0xe1, 0xcf, 0xee, 0x0e, 0xc9, 0xdd,
+	0xb5, 0x6b, 0xe7, 0xe4, 0x25, 0xe7, 0xfd, 0x7c, 0xdf, 0x9b, 0xf7, 0xde, 0x70, 0x9e, 0x0c, 0x05,
+	0x87, 0xd4, 0x1c, 0x62, 0x12, 0x95, 0xf8, 0x75, 0xec, 0x6e, 0x5b, 0xbe, 0x69, 0xa8, 0x7b, 0x3e,
+	0x76, 0x1b, 0xc5, 0xba, 0xeb, 0x78, 0x0e, 0x42, 0x7c, 0xbd, 0x18, 0xaf, 0xcb, 0xc7, 0xaa, 0x4e,
+	0xd5, 0xa1, 0xcb, 0x6a, 0xf0, 0x8b, 0x49, 0xca, 0x85, 0x0a, 0x15, 0x55, 0xb7, 0x74, 0x82, 0xd5,
+	0xfd, 0x4b, 0x5b, 0xd8, 0xd3, 0x2f, 0xa9, 0x15, 0xc7, 0xb4, 0xf9, 0xfa, 0xa9, 0xaa, 0xe3, 0x54,
+	0x2d, 0xac, 0xea, 0x75, 0x53, 0xd5, 0x6d, 0xdb, 0xf1, 0x74, 0xcf, 0x74, 0x6c, 0xc2, 0x57, 0xc7,
+	0xf9, 0x2a, 0x7d, 0xda, 0xf2, 0xb7, 0x55, 0xcf, 0xac, 0x61, 0xe2, 0xe9, 0xb5, 0x7a, 0x68, 0xbe,
+	0x55, 0xc0, 0xf0, 0x5d, 0x6a, 0x81, 0xaf, 0x4f, 0x08, 0x88, 0xc4, 0x3f, 0x43, 0x2f, 0x02, 0xa1,
+	0xba, 0xee, 0xea, 0xb5, 0x10, 0xc6, 0x58, 0x28, 0x60, 0x39, 0x95, 0x5d, 0xbf, 0x4e, 0xff, 0xe1,
+	0x4b, 0x33, 0x49, 0x7e, 0x34, 0x44, 0x11, 0xcb, 0xba, 0x5e, 0x35, 0xed, 0x24, 0x98, 0x49, 0x2e,
+	0x4b, 0x3c, 0x7d, 0xd7, 0xb4, 0xab, 0x91, 0x20, 0x7f, 0x66, 0x52, 0xca, 0x31, 0x40, 0xef, 0x04,
+	0x76, 0xd6, 0x28, 0x82, 0x32, 0xde, 0xf3, 0x31, 0xf1, 0x94, 0xbb, 0x70, 0xb4, 0xe9, 0x2d, 0xa9,
+	0x3b, 0x36, 0xc1, 0x68, 0x01, 0x86, 0x18, 0xd2, 0x51, 0xe9, 0x8c, 0x34, 0x7d, 0x70, 0x56, 0x2e,
+	0xb6, 0xef, 0x4c, 0x91, 0xe9, 0x94, 0x06, 0x3f, 0x7b, 0x38, 0xde, 0x57, 0xe6, 0xf2, 0xca, 0x34,
+	0x8c, 0x2c, 0x12, 0x82, 0xbd, 0x7b, 0x8d, 0x3a, 0xe6, 0x4e, 0xd0, 0x31, 0x78, 0xc1, 0xc0, 0xb6,
+	0x53, 0xa3, 0xc6, 0x0e, 0x94, 0xd9, 0x83, 0xf2, 0x2d, 0x38, 0x92, 0x90, 0xe4, 0x8e, 0x97, 0x01,
+	0xf4, 0xe0, 0xa5, 0xe6, 0x35, 0xea, 0x98, 0xca, 0x1f, 0x9a, 0x9d, 0x12, 0x39, 0x5f, 0x8f, 0x7e,
+	0xc6, 0x46, 0x0e, 0xe8, 0xe1, 0x4f, 0x05, 0xc1, 0xc8, 0xa2, 0x65, 0xd1, 0xa5, 0x88, 0xeb, 0x26,
+	0x1c, 0x49, 0xbc, 0xe3, 0x0e, 0x17, 0x61, 0x88, 0x6a, 0x05, 0x4c, 0x07, 0xa6, 0x0f, 0xce, 0x4e,
+	0x74, 0xe0, 0x2c, 0xa4, 0xcc, 0x14, 0x95, 0x22, 0x1c, 0xa7, 0xaf, 0x57, 0x7d, 0xcb, 0x33, 0xeb,
+	0x96, 0x89, 0xdd, 0x6c, 0xe2, 0x3f, 0x96, 0xe0, 0x44, 0x9b, 0x02, 0x87, 0x53, 0x07, 0x39, 0xf0,
+	0xaf, 0xe1, 0x3d, 0xdf, 0xdc, 0xd7, 0x2d, 0x6c, 0x7b, 0x5a, 0x2d, 0x92, 0xe2, 0x9b, 0x31, 0x2b,
+	0x82, 0x78, 0x97, 0xd4, 0x9c, 0x5b, 0x91, 0x52, 0xd2, 0x72, 0xc5, 0x71, 0x8d, 0xf2, 0xa8, 0x93,
+	0xb2, 0xae, 0x7c, 0x28, 0xc1, 0xcb, 0x31, 0xbf, 0x15, 0xdb, 0xc3, 0x6e, 0x0d, 0x1b, 0xa6, 0xee,
+	0x36, 0x16, 0x2b, 0x15, 0xc7, 0xb7, 0xbd, 0x15, 0x7b, 0xdb, 0x11, 0x33, 0x41, 0x63, 0xf0, 0xe2,
+	0xbe, 0x6e, 0x69, 0xba, 0x61, 0xb8, 0xa3, 0xfd, 0x74, 0x61, 0x78, 0x5f, 0xb7, 0x16, 0x0d, 0xc3,
+	0x0d, 0x96, 0xaa, 0xba, 0x5f, 0xc5, 0x9a, 0x69, 0x8c, 0x0e, 0x9c, 0x91, 0xa6, 0x07, 0xcb, 0xc3,
+	0xf4, 0x79, 0xc5, 0x40, 0xa3, 0x30, 0x1c, 0x68, 0x60, 0x42, 0x46, 0x07, 0x99, 0x12, 0x7f, 0x54,
+	0x76, 0xa0, 0xb0, 0x68, 0x59, 0x02, 0x0c, 0xe1, 0x1e, 0x06, 0xf9, 0x11, 0xe7, 0x3f, 0x8f, 0xc7,
+	0xb9, 0x22, 0x2b, 0x80, 0x62, 0x50, 0x2c, 0x45, 0xd6, 0x4f, 0x78, 0x0d, 0x14, 0xd7, 0xf4, 0x6a,
+	0x98, 0x86, 0xe5, 0x84, 0xa6, 0xf2, 0x47, 0x09, 0xc6, 0x53, 0x5d, 0xf1, 0xbd, 0xb8, 0x0f, 0x2f,
+	0xea, 0xfc, 0x1d, 0x4f, 0x8e, 0xab, 0xd9, 0xc9, 0x91, 0x12, 0x3c, 0x9e, 0x2e, 0x91, 0x31, 0x74,
+	0xbb, 0x89, 0x44, 0x3f, 0x25, 0x31, 0x95, 0x4b, 0x82, 0xa1, 0x6a, 0x62, 0x71, 0x03, 0x26, 0x6e,
+	0x3a, 0xb6, 0x8d, 0x2b, 0x1e, 0x16, 0x39, 0x0f, 0x83, 0x76, 0x02, 0x86, 0x83, 0xd6, 0x12, 0x6c,
+	0x85, 0x44, 0xb7, 0x62, 0x28, 0x78, 0x5c, 0x31, 0x94, 0x77, 0x61, 0x32, 0x5b, 0x9f, 0x47, 0xe2,
+	0x2e, 0x0c, 0x73, 0xf0, 0x3c, 0xe4, 0xbd, 0x05, 0xa2, 0x1c, 0x5a, 0x51, 0x96, 0xa1, 0x48, 0xdb,
+	0xce, 0x3d, 0xc7, 0xd3, 0xad, 0x25, 0x6c, 0xe1, 0x2a, 0x25, 0x54, 0x6a, 0x6c, 0xea, 0x96, 0x69,
+	0xe8, 0x9e, 0xe3, 0x2e, 0x3b, 0xee, 0x52, 0x90, 0x63, 0xd9, 0xa5, 0x54, 0x07, 0xb5, 0x63, 0x3b,
+	0x9c, 0xcb, 0xf5, 0x96, 0x82, 0x1f, 0x17, 0x51, 0x89, 0x4d, 0x91, 0x96, 0x62, 0xff, 0x52, 0x82,
+	0x83, 0x89, 0xd5, 0xa6, 0x12, 0x90, 0x9a, 0x4b, 0xe0, 0x1e, 0x1c, 0xd4, 0x6b, 0x01, 0x5d, 0x8d,
+	0x6c, 0x13, 0x83, 0x15, 0x48, 0xe9, 0x72, 0x60, 0xed, 0xef, 0x0f, 0xc7, 0x5f, 0x62, 0xdb, 0x4d,
+	0x8c, 0xdd, 0xa2, 0xe9, 0xa8, 0x35, 0xdd, 0xdb, 0x29, 0xae, 0xd8, 0xde, 0xbf, 0x1f, 0x8e, 0xa3,
+	0x86, 0x5e, 0xb3, 0xae, 0x29, 0x09, 0x4d, 0xa5, 0x0c, 0xec, 0x69, 0x7d, 0x9b, 0x18, 0xe8, 0xbb,
+	0x70, 0xb8, 0xa5, 0x43, 0xd0, 0xfa, 0x3a, 0x50, 0x9a, 0xcf, 0xb3, 0x7c, 0x9c, 0x59, 0x6e, 0xd1,
+	0x56, 0xca, 0x87, 0x9a, 0x7b, 0x83, 0x32, 0x01, 0x2f, 0xd3, 0x78, 0xc6, 0xfb, 0x99, 0x20, 0x1c,
+	0x36, 0xd3, 0x9f, 0x4b, 0xa0, 0x64, 0x49, 0xf1, 0x68, 0xef, 0xc1, 0x11, 0x2f, 0x90, 0xd2, 0x8c,
+	0x78, 0x91, 0xc5, 0xa9, 0xb4, 0x94, 0x87, 0x77, 0x82, 0xe1, 0x65, 0xfa, 0xf1, 0xe6, 0x24, 0x4d,
+	0x29, 0xe5, 0x11, 0xaf, 0x79, 0xeb, 0x89, 0xf2, 0x51, 0x53, 0x43, 0x8b, 0x57, 0x16, 0x6b, 0xc9,
+	0x9a, 0x38, 0x0f, 0x47, 0xb8, 0x1d, 0xc7, 0xd5, 0xc2, 0x76, 0xc4, 0x36, 0x70, 0x24, 0x5a, 0x58,
+	0x64, 0xef, 0x03, 0xe1, 0xfd, 0x30, 0xa1, 0x22, 0x61, 0xd6, 0xf0, 0x46, 0xa2, 0x85, 0x50, 0x38,
+	0xca, 0xd4, 0x81, 0x64, 0xa6, 0x7e, 0x28, 0x81, 0x92, 0x85, 0x8a, 0xc7, 0xab, 0x02, 0x43, 0x6c,
+	0xaf, 0x79, 0x76, 0x8e, 0x35, 0xb5, 0x85, 0xb0, 0x21, 0xdc, 0x74, 0x4c, 0xbb, 0xf4, 0x6a, 0x10,
+	0xbf, 0x4f, 0xbf, 0x18, 0x9f, 0xae, 0x9a, 0xde, 0x8e, 0xbf, 0x55, 0xac, 0x38, 0x35, 0x95, 0x09,
+	0xf3, 0x7f, 0x2e, 0x12, 0x63, 0x57, 0x0d, 0xce, 0x51, 0x42, 0x15, 0x48, 0x99, 0x9b, 0x56, 0x36,
+	0x61, 0x4a, 0xb8, 0x6b, 0xa5, 0xc6, 0x52, 0xc8, 0xbc, 0x97, 0x30, 0x29, 0xbf, 0x1d, 0x80, 0xe9,
+	0x7c, 0xc3, 0x9c, 0xe9, 0x7b, 0x70, 0x5a, 0xb8, 0xa7, 0x9a, 0x4b, 0x4f, 0xac, 0xb0, 0x3c, 0x8b,
+	0xd9, 0x9d, 0x26, 0x76, 0xc2, 0x0e, 0x3a, 0x5e, 0xad, 0x27, 0x49, 0xaa, 0x04, 0x41, 0xdf, 0x87,
+	0x97, 0x9a, 0x72, 0x12, 0x1b, 0x5a, 0xf0, 0xe5, 0x18, 0xec, 0xe8, 0x53, 0x0f, 0xf9, 0xd1, 0x64,
+	0x7a, 0x62, 0x83, 0xbe, 0x44, 0x3f, 0x91, 0xa0, 0xc0, 0x10, 0x24, 0x8e, 0xf9, 0xe0, 0x6b, 0x0d,
+	0x1b, 0x1a, 0xdf, 0xfd, 0x81, 0x33, 0x52, 0x36, 0x14, 0x95, 0x43, 0x99, 0xea, 0x10, 0x4a, 0xf9,
+	0x24, 0xf5, 0x18, 0x97, 0xf9, 0x3a, 0xf5, 0xc7, 0xd2, 0x4f, 0xb1, 0xe1, 0x95, 0x38, 0xa6, 0x1b,
+	0xb6, 0xf1, 0xd4, 0x72, 0x22, 0xae, 0x86, 0xfe, 0x64, 0x35, 0xfc, 0xa7, 0x1f, 0x66, 0x3a, 0x71,
+	0xf8, 0xdc, 0x73, 0xe5, 0x07, 0x12, 0x9c, 0x60, 0x5b, 0xe5, 0xdb, 0xcf, 0x20, 0x5d, 0x58, 0x62,
+	0x6e, 0xc4, 0xae, 0x58, 0xc2, 0xdc, 0x81, 0xc3, 0xa4, 0x61, 0x7b, 0x3b, 0xd8, 0x33, 0x2b, 0x5a,
+	0x70, 0x76, 0x93, 0xd1, 0x01, 0xea, 0xfc, 0x74, 0xc4, 0x98, 0x5d, 0x21, 0x8a, 0xeb, 0xa1, 0xd8,
+	0x1d, 0xa7, 0xb2, 0xcb, 0x09, 0x1e, 0x22, 0xc9, 0x97, 0x44, 0xd9, 0x83, 0x0b, 0x29, 0x55, 0x1a,
+	0x9d, 0x9a, 0x4d, 0x47, 0xaf, 0xb0, 0xfb, 0x49, 0x79, 0xdd, 0xaf, 0x69, 0xbf, 0x7f, 0x29, 0xc1,
+	0xc5, 0x0e, 0x7d, 0x3e, 0xef, 0x2d, 0x57, 0x1e, 0xc0, 0xc2, 0x2d, 0xe2, 0x99, 0x35, 0xdd, 0xc3,
+	0x6d, 0x86, 0xc2, 0x82, 0xf9, 0x3f, 0x86, 0xea, 0xf7, 0x12, 0xbc, 0xd6, 0x83, 0x7f, 0x1e, 0xb6,
+	0xd4, 0xde, 0x26, 0x3d, 0x9b, 0xde, 0xa6, 0x6c, 0xc0, 0x39, 0xf1, 0x17, 0xd9, 0x93, 0x1d, 0x2d,
+	0x1f, 0x0f, 0xc2, 0x54, 0xae, 0xdd, 0xe7, 0xde, 0x2d, 0x74, 0x38, 0xda, 0xe4, 0x8e, 0x01, 0xe2,
+	0x8d, 0x62, 0x26, 0x8c, 0x7d, 0x78, 0x2f, 0x0f, 0xc3, 0x9f, 0xb4, 0xc3, 0x34, 0xb8, 0x2f, 0x64,
+	0xb4, 0xad, 0xa4, 0x6f, 0xf0, 0xc0, 0x57, 0xe7, 0xf0, 0x1a, 0x7c, 0xb6, 0x87, 0xd7, 0x69, 0x38,
+	0x49, 0x53, 0x63, 0xc3, 0xae, 0x3b, 0x8e, 0x75, 0x7f, 0xc7, 0xf4, 0xb0, 0x65, 0x92, 0xf0, 0x4b,
+	0x4f, 0x79, 0x0d, 0x4e, 0x89, 0x97, 0x79, 0x44, 0xc7, 0xe0, 0xc5, 0x60, 0x41, 0x33, 0x79, 0x66,
+	0x0c, 0x96, 0x87, 0x83, 0xe7, 0x15, 0x83, 0x28, 0x5b, 0x70, 0x79, 0x83, 0x60, 0xf7, 0xa6, 0x63,
+	0x57, 0xb0, 0xed, 0xb9, 0x41, 0x10, 0xe2, 0x04, 0x59, 0x73, 0x88, 0x49, 0x7b, 0x58, 0x14, 0xa0,
+	0x9e, 0x32, 0xfb, 0x37, 0x12, 0x5c, 0xe9, 0xce, 0x09, 0xc7, 0xfd, 0x3d, 0x38, 0x5d, 0xb1, 0x34,
+	0x0a, 0xdd, 0x27, 0xd8, 0xd5, 0xea, 0x5c, 0xb4, 0x25, 0xcd, 0xe7, 0x44, 0x69, 0x9e, 0x74, 0xb6,
+	0xe6, 0x38, 0x56, 0x00, 0x20, 0x74, 0xd5, 0x94, 0xee, 0x63, 0x15, 0x4b, 0xbc, 0x4e, 0x14, 0x0c,
+	0x73, 0x1d, 0xe0, 0x8e, 0xcf, 0x76, 0xbb, 0xda, 0x53, 0x7c, 0x7e, 0x27, 0xc1, 0x7c, 0xd7, 0x7e,
+	0xbe, 0x22, 0x21, 0x2a, 0xc2, 0x71, 0x9a, 0x7a, 0x65, 0x4c, 0xbc, 0x75, 0xbf, 0x5e, 0xb7, 0x1a,
+	0xd9, 0xd7, 0xd9, 0x32, 0x9c, 0x68, 0x93, 0xe7, 0x54, 0xe6, 0x13, 0x17, 0x83, 0x9c, 0xea, 0x0a,
+	0x2f, 0xac, 0xac, 0x3a, 0x26, 0x41, 0xa1, 0x36, 0x4b, 0xba, 0xa5, 0xdb, 0x95, 0x00, 0xa3, 0x63,
+	0xad, 0x9a, 0x55, 0xb7, 0xf9, 0x3a, 0xb7, 0x0f, 0x13, 0x99, 0x52, 0xd1, 0x20, 0x00, 0x6a, 0xd1,
+	0x5b, 0x1e, 0xbd, 0x57, 0x44, 0xd1, 0x13, 0xda, 0xe1, 0xc8, 0x12, 0x26, 0x66, 0x3f, 0x19, 0x87,
+	0x17, 0xa8, 0x63, 0xf4, 0x43, 0x09, 0x86, 0xd8, 0x44, 0x11, 0x9d, 0x13, 0x59, 0x6c, 0x1f, 0x5e,
+	0xca, 0x53, 0xb9, 0x72, 0x0c, 0xb6, 0x32, 0xf3, 0xc1, 0x5f, 0xff, 0xf1, 0x51, 0xff, 0x24, 0x52,
+	0x54, 0xc1, 0x48, 0x36, 0x9e, 0xab, 0x52, 0xe7, 0x3f, 0x92, 0xe0, 0x40, 0x34, 0x52, 0x44, 0x93,
+	0x22, 0x17, 0xad, 0x03, 0x4e, 0xf9, 0x6c, 0x8e, 0x14, 0x87, 0x51, 0xa4, 0x30, 0xa6, 0xd1, 0xb9,
+	0x2c, 0x18, 0xf1, 0xf8, 0x93, 0x41, 0x09, 0x27, 0x96, 0x29, 0x50, 0x5a, 0x86, 0x9c, 0xf2, 0xd9,
+	0x1c, 0xa9, 0xae, 0xa0, 0x58, 0x96, 0xa6, 0x33, 0xe7, 0x9f, 0x48, 0x70, 0xb8, 0x65, 0x66, 0x89,
+	0x66, 0x52, 0x59, 0xb7, 0x4d, 0x42, 0xe5, 0xf3, 0x1d, 0xc9, 0x72, 0x70, 0x57, 0x28, 0xb8, 0x22,
+	0xba, 0x90, 0x1f, 0xa7, 0x78, 0x38, 0x8a, 0xfe, 0x10, 0x8c, 0x55, 0xc5, 0x23, 0x3d, 0x34, 0x9b,
+	0x12, 0x95, 0x8c, 0x51, 0xa3, 0x7c, 0xb9, 0x2b, 0x1d, 0x0e, 0xfd, 0x3a, 0x85, 0x3e, 0x8f, 0xae,
+	0xe6, 0xc5, 0xd5, 0x4c, 0x58, 0xd1, 0xa2, 0xc9, 0xe0, 0x17, 0x12, 0x9c, 0xca, 0x9a, 0xc8, 0xa1,
+	0xf9, 0x94, 0x56, 0x95, 0x37, 0x03, 0x94, 0x17, 0xba, 0x57, 0xe4, 0x94, 0xee, 0x50, 0x4a, 0xcb,
+	0x68, 0x29, 0x8b, 0x52, 0x25, 0xb4, 0x24, 0x24, 0xa6, 0xbe, 0xcf, 0xe7, 0x8f, 0x0f, 0xd0, 0xaf,
+	0xc3, 0xb9, 0x51, 0xe6, 0xb4, 0x0e, 0x95, 0x52, 0x4b, 0xbb, 0xe3, 0x91, 0xa1, 0x7c, 0xf3, 0x89,
+	0x6c, 0x70, 0xf6, 0x7d, 0xe8, 0xcf, 0x12, 0xc8, 0xe9, 0x93, 0x2e, 0x24, 0x1c, 0x85, 0xe6, 0xce,
+	0xcf, 0xe4, 0xb9, 0x6e, 0xd5, 0x38, 0x9e, 0x1b, 0x74, 0x37, 0x16, 0xd0, 0x5c, 0x5e, 0x82, 0x89,
+	0x07, 0x66, 0xe8, 0x2f, 0x12, 0xc8, 0xe9, 0x73, 0x28, 0x74, 0xb5, 0xd3, 0x8f, 0xe2, 0xa6, 0x69,
+	0x9a, 0x3c, 0xd7, 0xad, 0x1a, 0x67, 0xf3, 0x26, 0x65, 0x73, 0x0d, 0x2d, 0x64, 0xb1, 0x11, 0x7f,
+	0xcc, 0xb3, 0xe3, 0x0d, 0xfd, 0x4b, 0x82, 0x33, 0x79, 0x33, 0x27, 0xf4, 0x7a, 0xa7, 0xf0, 0x04,
+	0xe3, 0x0e, 0xf9, 0x8d, 0xde, 0x94, 0x39, 0xc3, 0xb7, 0x29, 0xc3, 0xb7, 0xd0, 0x72, 0xd7, 0x0c,
+	0x89, 0xfa, 0x7e, 0xdb, 0x57, 0xd2, 0x03, 0xf4, 0x41, 0x7f, 0x72, 0x8e, 0x98, 0x36, 0x39, 0x41,
+	0xd7, 0xb3, 0x41, 0xe7, 0x8c, 0x78, 0xe4, 0x1b, 0xbd, 0xaa, 0x73, 0xd6, 0xdf, 0xa1, 0xac, 0xef,
+	0xa3, 0x8d, 0x0e, 0x59, 0xfb, 0x49, 0x83, 0xda, 0x56, 0x43, 0x8b, 0x98, 0x0b, 0x83, 0xf0, 0x5f,
+	0x09, 0xce, 0x76, 0x34, 0x4e, 0x40, 0x6f, 0x76, 0xb1, 0x79, 0xc2, 0x2b, 0xbd, 0xbc, 0xf8, 0x04,
+	0x16, 0x78, 0x34, 0x56, 0x69, 0x34, 0x6e, 0xa3, 0x5b, 0xdd, 0xe7, 0x40, 0x10, 0x8b, 0x78, 0xa2,
+	0xc0, 0xfe, 0xea, 0xf6, 0xab, 0x7e, 0xb8, 0xd4, 0xf5, 0x84, 0x00, 0xdd, 0x11, 0xf1, 0xe8, 0x75,
+	0xd0, 0x21, 0xaf, 0x3e, 0x25, 0x6b, 0x3c, 0x42, 0xdf, 0xa6, 0x11, 0xda, 0x44, 0xf7, 0xb2, 0x22,
+	0x84, 0xb9, 0x79, 0x2d, 0xab, 0x21, 0x88, 0x02, 0xf6, 0xcf, 0xb0, 0x83, 0x0b, 0xe7, 0x06, 0xe8,
+	0x5a, 0xe7, 0xe7, 0x44, 0x5b, 0xa1, 0xbc, 0xde, 0x93, 0x2e, 0x67, 0xbd, 0x41, 0x59, 0xdf, 0x45,
+	0xab, 0x59, 0xac, 0x5b, 0xff, 0x7c, 0x92, 0x5f, 0x1d, 0x9f, 0x4a, 0x70, 0xb8, 0xe5, 0xb2, 0x8b,
+	0xd4, 0x54, 0x9c, 0xe2, 0x5b, 0xb3, 0xfc, 0x6a, 0xe7, 0x0a, 0xdd, 0x7c, 0xb5, 0xf9, 0x54, 0x59,
+	0x7b, 0x37, 0x02, 0xf6, 0x71, 0x3f, 0x5c, 0xe8, 0xe6, 0xfa, 0x8b, 0x6e, 0x8b, 0x80, 0xf5, 0x70,
+	0x4b, 0x97, 0xdf, 0x7a, 0x72, 0x43, 0x9c, 0xf9, 0x26, 0x65, 0xbe, 0x86, 0xde, 0xce, 0x3c, 0x93,
+	0xd9, 0xa7, 0x50, 0x72, 0x6e, 0x63, 0x45, 0x17, 0x52, 0x71, 0xaf, 0xff, 0x45, 0x3f, 0xa8, 0x5d,
+	0x5e, 0x7d, 0xd1, 0xd7, 0x7a, 0x64, 0x25, 0xb8, 0xa7, 0xcb, 0x5f, 0x7f, 0x2a, 0xb6, 0x78, 0x90,
+	0xbe, 0x41, 0x83, 0xb4, 0x8e, 0xde, 0xe9, 0x24, 0x48, 0x7e, 0xc2, 0x42, 0x7e, 0x9c, 0x7e, 0x26,
+	0x01, 0xc4, 0x57, 0x66, 0x34, 0x93, 0x9a, 0xba, 0x6d, 0xf7, 0x70, 0xf9, 0x7c, 0x47, 0xb2, 0xdd,
+	0x5c, 0x23, 0x09, 0x03, 0xf1, 0x27, 0x09, 0x8e, 0x8b, 0x2f, 0xd3, 0x68, 0x2e, 0xd5, 0x67, 0xe6,
+	0x1d, 0x5d, 0x9e, 0xef, 0x5a, 0x8f, 0xe3, 0x7e, 0x83, 0xe2, 0x9e, 0x43, 0x57, 0xb2, 0x70, 0x6f,
+	0x71, 0x1b, 0x6c, 0x5c, 0x12, 0x5f, 0xd1, 0x4b, 0x6b, 0x9f, 0x3d, 0x2a, 0x48, 0x9f, 0x3f, 0x2a,
+	0x48, 0x5f, 0x3e, 0x2a, 0x48, 0x3f, 0x7d, 0x5c, 0xe8, 0xfb, 0xfc, 0x71, 0xa1, 0xef, 0x6f, 0x8f,
+	0x0b, 0x7d, 0xdf, 0x9c, 0x4b, 0x8c, 0xef, 0xb8, 0xe5, 0x8b, 0x96, 0xbe, 0x45, 0x22, 0x37, 0xfb,
+	0xb3, 0x73, 0xea, 0x7b, 0x49, 0x67, 0x74, 0xa4, 0xb7, 0x35, 0x44, 0xff, 0x47, 0xd2, 0xe5, 0xff,
+	0x0d, 0x00, 0x3e, 0x24, 0x9c, 0x27, 0x0f, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2090,6 +2176,9 @@ type QueryClient interface {
 	UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, in *UserConcentratedSuperfluidPositionsDelegatedRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
 	UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, in *UserConcentratedSuperfluidPositionsUndelegatingRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
 	RestSupply(ctx context.Context, in *QueryRestSupplyRequest, opts ...grpc.CallOption) (*QueryRestSupplyResponse, error)
+	// Returns the balancer pool migrations approved by governance that are in
+	// progress.
+	BalancerPoolMigrations(ctx context.Context, in *QueryBalancerPoolMigrationsRequest, opts ...grpc.CallOption) (*QueryBalancerPoolMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -2262,6 +2351,15 @@ func (c *queryClient) RestSupply(ctx context.Context, in *QueryRestSupplyRequest
 	return out, nil
 }
 
+func (c *queryClient) BalancerPoolMigrations(ctx context.Context, in *QueryBalancerPoolMigrationsRequest, opts ...grpc.CallOption) (*QueryBalancerPoolMigrationsResponse, error) {
+	out := new(QueryBalancerPoolMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/BalancerPoolMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of superfluid parameters.
@@ -2304,6 +2402,9 @@ type QueryServer interface {
 	UserConcentratedSuperfluidPositionsDelegated(context.Context, *UserConcentratedSuperfluidPositionsDelegatedRequest) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
 	UserConcentratedSuperfluidPositionsUndelegating(context.Context, *UserConcentratedSuperfluidPositionsUndelegatingRequest) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
 	RestSupply(context.Context, *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error)
+	// Returns the balancer pool migrations approved by governance that are in
+	// progress.
+	BalancerPoolMigrations(context.Context, *QueryBalancerPoolMigrationsRequest) (*QueryBalancerPoolMigrationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2364,6 +2465,9 @@ func (*UnimplementedQueryServer) UserConcentratedSuperfluidPositionsUndelegating
 func (*UnimplementedQueryServer) RestSupply(ctx context.Context, req *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestSupply not implemented")
 }
+func (*UnimplementedQueryServer) BalancerPoolMigrations(ctx context.Context, req *QueryBalancerPoolMigrationsRequest) (*QueryBalancerPoolMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancerPoolMigrations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2693,6 +2797,24 @@ func _Query_RestSupply_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalancerPoolMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancerPoolMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancerPoolMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/BalancerPoolMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancerPoolMigrations(ctx, req.(*QueryBalancerPoolMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2769,6 +2891,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestSupply",
 			Handler:    _Query_RestSupply_Handler,
 		},
+		{
+			MethodName: "BalancerPoolMigrations",
+			Handler:    _Query_BalancerPoolMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/query.proto",
@@ -4170,6 +4296,66 @@ func (m *QueryRestSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBalancerPoolMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancerPoolMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancerPoolMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancerPoolMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancerPoolMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancerPoolMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4749,6 +4935,30 @@ func (m *QueryRestSupplyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBalancerPoolMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBalancerPoolMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8382,6 +8592,140 @@ func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBalancerPoolMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancerPoolMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancerPoolMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancerPoolMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancerPoolMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancerPoolMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, BalancerPoolMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58,6 +58,41 @@ func (SuperfluidAssetType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_79d3c29d82dbb734, []int{0}
 }
 
+// BalancerPoolMigrationPhase indicates which shares of a balancer pool a
+// balancer pool migration is currently migrating.
+type BalancerPoolMigrationPhase int32
+
+const (
+	// BalancerPoolMigrationPhaseLocked migrates the shares of locks that have not
+	// started unlocking, superfluid delegated ones included.
+	BalancerPoolMigrationPhaseLocked BalancerPoolMigrationPhase = 0
+	// BalancerPoolMigrationPhaseUnlocking migrates the shares of unlocking locks,
+	// superfluid undelegating ones included.
+	BalancerPoolMigrationPhaseUnlocking BalancerPoolMigrationPhase = 1
+	// BalancerPoolMigrationPhaseUnlocked migrates the shares held by accounts.
+	BalancerPoolMigrationPhaseUnlocked BalancerPoolMigrationPhase = 2
+)
+
+var BalancerPoolMigrationPhase_name = map[int32]string{
+	0: "BalancerPoolMigrationPhaseLocked",
+	1: "BalancerPoolMigrationPhaseUnlocking",
+	2: "BalancerPoolMigrationPhaseUnlocked",
+}
+
+var BalancerPoolMigrationPhase_value = map[string]int32{
+	"BalancerPoolMigrationPhaseLocked":    0,
+	"BalancerPoolMigrationPhaseUnlocking": 1,
+	"BalancerPoolMigrationPhaseUnlocked":  2,
+}
+
+func (x BalancerPoolMigrationPhase) String() string {
+	return proto.EnumName(BalancerPoolMigrationPhase_name, int32(x))
+}
+
+func (BalancerPoolMigrationPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{1}
+}
+
 // SuperfluidAsset stores the pair of superfluid asset type and denom pair
 type SuperfluidAsset struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -478,8 +513,111 @@ func (m *ConcentratedPoolUserPositionRecord) GetEquivalentStakedAmount() *types.
 	return nil
 }
 
+// BalancerPoolMigration tracks the progress of a governance approved migration
+// of all the shares of a balancer pool to full range positions in its linked
+// concentrated liquidity pool.
+type BalancerPoolMigration struct {
+	BalancerPoolId uint64 `protobuf:"varint,1,opt,name=balancer_pool_id,json=balancerPoolId,proto3" json:"balancer_pool_id,omitempty"`
+	ClPoolId       uint64 `protobuf:"varint,2,opt,name=cl_pool_id,json=clPoolId,proto3" json:"cl_pool_id,omitempty"`
+	// migrations_per_block is the maximum number of locks or accounts migrated
+	// per block.
+	MigrationsPerBlock uint64                     `protobuf:"varint,3,opt,name=migrations_per_block,json=migrationsPerBlock,proto3" json:"migrations_per_block,omitempty"`
+	Phase              BalancerPoolMigrationPhase `protobuf:"varint,4,opt,name=phase,proto3,enum=osmosis.superfluid.BalancerPoolMigrationPhase" json:"phase,omitempty"`
+	// next_key is the key the current phase resumes from, empty if the phase has
+	// not started yet.
+	NextKey []byte `protobuf:"bytes,5,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	// num_migrated is the number of locks and accounts migrated so far.
+	NumMigrated uint64 `protobuf:"varint,6,opt,name=num_migrated,json=numMigrated,proto3" json:"num_migrated,omitempty"`
+	// num_failed is the number of locks and accounts that failed to migrate and
+	// were skipped.
+	NumFailed uint64 `protobuf:"varint,7,opt,name=num_failed,json=numFailed,proto3" json:"num_failed,omitempty"`
+}
+
+func (m *BalancerPoolMigration) Reset()         { *m = BalancerPoolMigration{} }
+func (m *BalancerPoolMigration) String() string { return proto.CompactTextString(m) }
+func (*BalancerPoolMigration) ProtoMessage()    {}
+func (*BalancerPoolMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
+}
+func (m *BalancerPoolMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalancerPoolMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalancerPoolMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalancerPoolMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalancerPoolMigration.Merge(m, src)
+}
+func (m *BalancerPoolMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalancerPoolMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalancerPoolMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalancerPoolMigration proto.InternalMessageInfo
+
+func (m *BalancerPoolMigration) GetBalancerPoolId() uint64 {
+	if m != nil {
+		return m.BalancerPoolId
+	}
+	return 0
+}
+
+func (m *BalancerPoolMigration) GetClPoolId() uint64 {
+	if m != nil {
+		return m.ClPoolId
+	}
+	return 0
+}
+
+func (m *BalancerPoolMigration) GetMigrationsPerBlock() uint64 {
+	if m != nil {
+		return m.MigrationsPerBlock
+	}
+	return 0
+}
+
+func (m *BalancerPoolMigration) GetPhase() BalancerPoolMigrationPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return BalancerPoolMigrationPhaseLocked
+}
+
+func (m *BalancerPoolMigration) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *BalancerPoolMigration) GetNumMigrated() uint64 {
+	if m != nil {
+		return m.NumMigrated
+	}
+	return 0
+}
+
+func (m *BalancerPoolMigration) GetNumFailed() uint64 {
+	if m != nil {
+		return m.NumFailed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
+	proto.RegisterEnum("osmosis.superfluid.BalancerPoolMigrationPhase", BalancerPoolMigrationPhase_name, BalancerPoolMigrationPhase_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
 	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
@@ -487,6 +625,7 @@ func init() {
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
+	proto.RegisterType((*BalancerPoolMigration)(nil), "osmosis.superfluid.BalancerPoolMigration")
 }
 
 func init() {
@@ -494,59 +633,71 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0xa9, 0x42, 0xbd, 0x39, 0x29, 0x48, 0x46, 0xa1, 0x58, 0x6b, 0x2a,
-	0x10, 0x97, 0xd5, 0x78, 0xe7, 0x75, 0x3d, 0xf2, 0xec, 0xce, 0x76, 0x67, 0xd6, 0xe0, 0x1b, 0x07,
-	0x0e, 0x3d, 0xf2, 0x27, 0x54, 0xe2, 0xc6, 0x95, 0x7f, 0xa2, 0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x80,
-	0x92, 0x0b, 0xe7, 0xfe, 0x05, 0x68, 0x66, 0x7f, 0x78, 0xd3, 0xb8, 0x42, 0x5c, 0xe8, 0xc9, 0x33,
-	0xef, 0x7b, 0xf3, 0xde, 0xf7, 0xcd, 0xfb, 0x3c, 0x8b, 0x6e, 0x71, 0x11, 0x73, 0x41, 0x85, 0x27,
-	0xf2, 0x14, 0xb2, 0x27, 0x2c, 0xa7, 0xa4, 0xb1, 0x74, 0xd3, 0x8c, 0x4b, 0x6e, 0x9a, 0x65, 0x92,
-	0xbb, 0x44, 0x76, 0xb7, 0x22, 0x1e, 0x71, 0x0d, 0x7b, 0x6a, 0x55, 0x64, 0xee, 0x5a, 0x11, 0xe7,
-	0x11, 0x03, 0x4f, 0xef, 0x26, 0xf9, 0x13, 0x8f, 0xe4, 0x19, 0x96, 0x94, 0x27, 0x25, 0x6e, 0xbf,
-	0x8e, 0x4b, 0x1a, 0x83, 0x90, 0x38, 0x4e, 0xab, 0x02, 0xa1, 0xee, 0xe5, 0x4d, 0xb0, 0x00, 0x6f,
-	0x7e, 0x30, 0x01, 0x89, 0x0f, 0xbc, 0x90, 0xd3, 0xaa, 0xc0, 0x4e, 0xc5, 0x97, 0xf1, 0x70, 0x96,
-	0xa7, 0xfa, 0xa7, 0x80, 0x9c, 0x05, 0x7a, 0x6f, 0x5c, 0xf3, 0x1b, 0x08, 0x01, 0xd2, 0xdc, 0x42,
-	0x57, 0x08, 0x24, 0x3c, 0xde, 0x36, 0xf6, 0x8d, 0xfe, 0x86, 0x5f, 0x6c, 0xcc, 0xcf, 0x10, 0xc2,
-	0x0a, 0x0e, 0xe4, 0x22, 0x85, 0xed, 0xf6, 0xbe, 0xd1, 0xbf, 0x76, 0x78, 0xdb, 0xbd, 0xac, 0xd1,
-	0x7d, 0xad, 0xdc, 0x57, 0x8b, 0x14, 0xfc, 0x0d, 0x5c, 0x2d, 0x1f, 0x5c, 0x7d, 0xf6, 0xdc, 0x6e,
-	0xfd, 0xfd, 0xdc, 0x36, 0x9c, 0x19, 0xda, 0x5b, 0xe6, 0x0e, 0x13, 0x09, 0x59, 0x0c, 0x84, 0xe2,
-	0x6c, 0x31, 0x08, 0x43, 0x9e, 0x27, 0x6f, 0x22, 0xb2, 0x83, 0xae, 0xce, 0x31, 0x0b, 0x30, 0x21,
-	0x99, 0xa6, 0xb1, 0xe1, 0xaf, 0xcf, 0x31, 0x1b, 0x10, 0x92, 0x29, 0x28, 0xc2, 0x79, 0x04, 0x01,
-	0x25, 0xdb, 0x9d, 0x7d, 0xa3, 0xdf, 0xf5, 0xd7, 0xf5, 0x7e, 0x48, 0x9c, 0x5f, 0x0d, 0x64, 0x7d,
-	0x29, 0x62, 0xfe, 0xe9, 0xd3, 0x9c, 0xce, 0x31, 0x83, 0x44, 0x7e, 0x91, 0x33, 0x49, 0x53, 0x46,
-	0x21, 0xf3, 0x21, 0xe4, 0x19, 0x31, 0x3f, 0x44, 0xef, 0x40, 0xca, 0xc3, 0x69, 0x90, 0xe4, 0xf1,
-	0x04, 0x32, 0xdd, 0xb5, 0xe3, 0x6f, 0xea, 0xd8, 0x23, 0x1d, 0x5a, 0x32, 0x6a, 0x37, 0x19, 0x7d,
-	0x83, 0x50, 0x5c, 0x17, 0xd3, 0x8d, 0x37, 0x8e, 0x3e, 0x79, 0x71, 0x6a, 0xb7, 0xfe, 0x38, 0xb5,
-	0x3f, 0x28, 0x46, 0x23, 0xc8, 0xcc, 0xa5, 0xdc, 0x8b, 0xb1, 0x9c, 0xba, 0x27, 0x10, 0xe1, 0x70,
-	0xf1, 0x10, 0xc2, 0x57, 0xa7, 0xf6, 0xf5, 0x05, 0x8e, 0xd9, 0x03, 0x67, 0x79, 0xdc, 0xf1, 0x1b,
-	0xb5, 0x9c, 0x57, 0x6d, 0xb4, 0xbb, 0xbc, 0xa3, 0x87, 0xc0, 0x20, 0xd2, 0xc6, 0x28, 0x19, 0xdf,
-	0x45, 0xd7, 0x49, 0x11, 0xe3, 0x99, 0xbe, 0x10, 0x10, 0xa2, 0xbc, 0xac, 0x5e, 0x0d, 0x0c, 0x8a,
-	0xb8, 0x4a, 0x9e, 0x63, 0x46, 0xc9, 0x85, 0xe4, 0x42, 0x47, 0xaf, 0x06, 0xaa, 0xe4, 0xef, 0xea,
-	0xca, 0x94, 0x27, 0x01, 0x8e, 0xd5, 0x3c, 0xb4, 0xb2, 0xcd, 0xc3, 0x1d, 0xb7, 0x90, 0xe4, 0x2a,
-	0xb7, 0xb9, 0xa5, 0xdb, 0xdc, 0x63, 0x4e, 0x93, 0x23, 0x4f, 0x89, 0xfe, 0xe5, 0x4f, 0xfb, 0x76,
-	0x44, 0xe5, 0x34, 0x9f, 0xb8, 0x21, 0x8f, 0xbd, 0xd2, 0x9a, 0xc5, 0xcf, 0x3d, 0x41, 0x66, 0x9e,
-	0x32, 0x90, 0xd0, 0x07, 0x6a, 0x96, 0x94, 0x27, 0x03, 0xdd, 0xc3, 0xfc, 0xc1, 0x40, 0xdb, 0x50,
-	0xcf, 0x28, 0x10, 0x12, 0xcf, 0x80, 0x54, 0x04, 0xba, 0xff, 0x46, 0xe0, 0xee, 0x7f, 0x69, 0x7e,
-	0x73, 0xd9, 0x67, 0xac, 0xdb, 0x14, 0x14, 0x9c, 0xa7, 0xe8, 0xd6, 0x09, 0x0f, 0x67, 0xc3, 0x55,
-	0x9e, 0x3c, 0xe6, 0x49, 0x02, 0xa1, 0xe2, 0x6b, 0xbe, 0x8f, 0xd6, 0xd5, 0xff, 0x48, 0x79, 0xcd,
-	0xd0, 0x5e, 0x5b, 0x63, 0xfa, 0x94, 0x79, 0x80, 0xb6, 0x68, 0xe3, 0x64, 0x80, 0x8b, 0xa3, 0xe5,
-	0x5d, 0xdf, 0xa0, 0x97, 0xab, 0x3a, 0x77, 0xd0, 0xcd, 0xc7, 0x49, 0xca, 0x39, 0xfb, 0x7a, 0x4a,
-	0x25, 0x30, 0x2a, 0x24, 0x90, 0x11, 0xe7, 0x4c, 0x98, 0x3d, 0xd4, 0xa1, 0x44, 0x0d, 0xb5, 0xd3,
-	0xef, 0xfa, 0x6a, 0xe9, 0xfc, 0xd6, 0x41, 0xce, 0x31, 0x4f, 0x42, 0x48, 0x64, 0x86, 0xcb, 0xbc,
-	0xc7, 0x02, 0xb2, 0x11, 0x17, 0xf4, 0xa2, 0x37, 0x2e, 0x8f, 0xdb, 0x78, 0xc3, 0xb8, 0x6d, 0xb4,
-	0x99, 0x96, 0xc7, 0x95, 0x9e, 0xb6, 0xd6, 0x83, 0xaa, 0xd0, 0x90, 0x34, 0xc5, 0x76, 0x2e, 0x88,
-	0xfd, 0x1c, 0x5d, 0x13, 0x8b, 0x44, 0x4e, 0x41, 0xd2, 0x30, 0x50, 0xb1, 0x72, 0x48, 0x7b, 0xf5,
-	0xd3, 0x50, 0xbc, 0x39, 0xee, 0xb8, 0xca, 0x52, 0x77, 0x7b, 0xd4, 0x55, 0x4e, 0xf1, 0xdf, 0x15,
-	0xcd, 0xe0, 0x6a, 0xd3, 0x5d, 0x79, 0xdb, 0xa6, 0x5b, 0xfb, 0x3f, 0x4c, 0x77, 0xe7, 0x47, 0x03,
-	0xdd, 0x58, 0xf1, 0x72, 0x9a, 0x7b, 0x68, 0x67, 0x45, 0xf8, 0x11, 0x96, 0x74, 0x0e, 0xbd, 0x96,
-	0x69, 0x35, 0xdf, 0x87, 0x1a, 0x3e, 0x19, 0x8d, 0xa7, 0x38, 0x83, 0x9e, 0x61, 0xf6, 0xd1, 0x47,
-	0x2b, 0xf0, 0xa6, 0x7d, 0x8a, 0xcc, 0xf6, 0x6e, 0xf7, 0xd9, 0xcf, 0x56, 0xeb, 0x68, 0xf4, 0xe2,
-	0xcc, 0x32, 0x5e, 0x9e, 0x59, 0xc6, 0x5f, 0x67, 0x96, 0xf1, 0xd3, 0xb9, 0xd5, 0x7a, 0x79, 0x6e,
-	0xb5, 0x7e, 0x3f, 0xb7, 0x5a, 0xdf, 0xde, 0x6f, 0x28, 0x2c, 0x47, 0x7b, 0x8f, 0xe1, 0x89, 0xa8,
-	0x36, 0xde, 0xfc, 0xf0, 0xbe, 0xf7, 0x7d, 0xf3, 0x8b, 0xa8, 0x55, 0x4f, 0xd6, 0xf4, 0x77, 0xe6,
-	0xe3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xbf, 0xf2, 0xd5, 0x34, 0x07, 0x00, 0x00,
+	// 1009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0x6e, 0x3e, 0x26, 0x25, 0xb8, 0xdb, 0x50, 0x12, 0x43, 0xec, 0xb0, 0xa9, 0x88,
+	0x95, 0xaa, 0xbb, 0x24, 0x48, 0x15, 0xea, 0x2d, 0x4e, 0xa8, 0x14, 0x48, 0x8a, 0xb5, 0x21, 0x02,
+	0x71, 0x59, 0x8d, 0x77, 0xde, 0xac, 0x47, 0x9e, 0x9d, 0xd9, 0xee, 0xec, 0x9a, 0xfa, 0xc6, 0x81,
+	0x43, 0x8f, 0x5c, 0xb9, 0x55, 0xe2, 0xc6, 0x15, 0x7e, 0x44, 0x8f, 0x95, 0xb8, 0x20, 0x0e, 0x01,
+	0x25, 0x17, 0xce, 0xfd, 0x05, 0x68, 0x66, 0x77, 0x6d, 0xa7, 0xb1, 0x55, 0x71, 0x81, 0xd3, 0xce,
+	0xbc, 0xcf, 0xfb, 0x39, 0xcf, 0xb3, 0xb3, 0x8b, 0x36, 0x85, 0x0c, 0x85, 0xa4, 0xd2, 0x91, 0x69,
+	0x04, 0xf1, 0x19, 0x4b, 0x29, 0x99, 0x58, 0xda, 0x51, 0x2c, 0x12, 0x61, 0x9a, 0xb9, 0x93, 0x3d,
+	0x46, 0xea, 0x2b, 0x81, 0x08, 0x84, 0x86, 0x1d, 0xb5, 0xca, 0x3c, 0xeb, 0x8d, 0x40, 0x88, 0x80,
+	0x81, 0xa3, 0x77, 0xdd, 0xf4, 0xcc, 0x21, 0x69, 0x8c, 0x13, 0x2a, 0x78, 0x8e, 0x37, 0x5f, 0xc7,
+	0x13, 0x1a, 0x82, 0x4c, 0x70, 0x18, 0x15, 0x09, 0x7c, 0x5d, 0xcb, 0xe9, 0x62, 0x09, 0xce, 0x60,
+	0xa7, 0x0b, 0x09, 0xde, 0x71, 0x7c, 0x41, 0x8b, 0x04, 0x6b, 0x45, 0xbf, 0x4c, 0xf8, 0xfd, 0x34,
+	0xd2, 0x8f, 0x0c, 0xb2, 0x86, 0xe8, 0xed, 0x93, 0x51, 0x7f, 0x7b, 0x52, 0x42, 0x62, 0xae, 0xa0,
+	0x1b, 0x04, 0xb8, 0x08, 0x57, 0x8d, 0x0d, 0xa3, 0xb5, 0xe8, 0x66, 0x1b, 0xf3, 0x11, 0x42, 0x58,
+	0xc1, 0x5e, 0x32, 0x8c, 0x60, 0xb5, 0xbc, 0x61, 0xb4, 0x96, 0x77, 0xb7, 0xec, 0xeb, 0x33, 0xda,
+	0xaf, 0xa5, 0xfb, 0x72, 0x18, 0x81, 0xbb, 0x88, 0x8b, 0xe5, 0xc3, 0x85, 0x67, 0xcf, 0x9b, 0xa5,
+	0xbf, 0x9f, 0x37, 0x0d, 0xab, 0x8f, 0xd6, 0xc7, 0xbe, 0x87, 0x3c, 0x81, 0x38, 0x04, 0x42, 0x71,
+	0x3c, 0xdc, 0xf3, 0x7d, 0x91, 0xf2, 0x59, 0x8d, 0xac, 0xa1, 0x85, 0x01, 0x66, 0x1e, 0x26, 0x24,
+	0xd6, 0x6d, 0x2c, 0xba, 0xf3, 0x03, 0xcc, 0xf6, 0x08, 0x89, 0x15, 0x14, 0xe0, 0x34, 0x00, 0x8f,
+	0x92, 0xd5, 0xca, 0x86, 0xd1, 0xaa, 0xba, 0xf3, 0x7a, 0x7f, 0x48, 0xac, 0x5f, 0x0c, 0xd4, 0xf8,
+	0x42, 0x86, 0xe2, 0xd3, 0x27, 0x29, 0x1d, 0x60, 0x06, 0x3c, 0x39, 0x4e, 0x59, 0x42, 0x23, 0x46,
+	0x21, 0x76, 0xc1, 0x17, 0x31, 0x31, 0x3f, 0x40, 0x37, 0x21, 0x12, 0x7e, 0xcf, 0xe3, 0x69, 0xd8,
+	0x85, 0x58, 0x57, 0xad, 0xb8, 0x4b, 0xda, 0xf6, 0x58, 0x9b, 0xc6, 0x1d, 0x95, 0x27, 0x3b, 0xfa,
+	0x1a, 0xa1, 0x70, 0x94, 0x4c, 0x17, 0x5e, 0x6c, 0x7f, 0xf2, 0xe2, 0xbc, 0x59, 0xfa, 0xe3, 0xbc,
+	0xf9, 0x5e, 0x46, 0x8d, 0x24, 0x7d, 0x9b, 0x0a, 0x27, 0xc4, 0x49, 0xcf, 0x3e, 0x82, 0x00, 0xfb,
+	0xc3, 0x03, 0xf0, 0x5f, 0x9d, 0x37, 0x6f, 0x0d, 0x71, 0xc8, 0x1e, 0x5a, 0xe3, 0x70, 0xcb, 0x9d,
+	0xc8, 0x65, 0xbd, 0x2a, 0xa3, 0xfa, 0xf8, 0x8c, 0x0e, 0x80, 0x41, 0xa0, 0x85, 0x91, 0x77, 0x7c,
+	0x0f, 0xdd, 0x22, 0x99, 0x4d, 0xc4, 0xfa, 0x40, 0x40, 0xca, 0xfc, 0xb0, 0x6a, 0x23, 0x60, 0x2f,
+	0xb3, 0x2b, 0xe7, 0x01, 0x66, 0x94, 0x5c, 0x71, 0xce, 0xe6, 0xa8, 0x8d, 0x80, 0xc2, 0xf9, 0xdb,
+	0x51, 0x66, 0x2a, 0xb8, 0x87, 0x43, 0xc5, 0x87, 0x9e, 0x6c, 0x69, 0x77, 0xcd, 0xce, 0x46, 0xb2,
+	0x95, 0xda, 0xec, 0x5c, 0x6d, 0xf6, 0xbe, 0xa0, 0xbc, 0xed, 0xa8, 0xa1, 0x7f, 0xfe, 0xb3, 0xb9,
+	0x15, 0xd0, 0xa4, 0x97, 0x76, 0x6d, 0x5f, 0x84, 0x4e, 0x2e, 0xcd, 0xec, 0x71, 0x5f, 0x92, 0xbe,
+	0xa3, 0x04, 0x24, 0x75, 0xc0, 0xa8, 0x4b, 0x2a, 0xf8, 0x9e, 0xae, 0x61, 0x7e, 0x67, 0xa0, 0x55,
+	0x18, 0x71, 0xe4, 0xc9, 0x04, 0xf7, 0x81, 0x14, 0x0d, 0x54, 0xdf, 0xd4, 0xc0, 0xbd, 0x7f, 0x53,
+	0xfc, 0xce, 0xb8, 0xce, 0x89, 0x2e, 0x93, 0xb5, 0x60, 0x3d, 0x41, 0x9b, 0x47, 0xc2, 0xef, 0x1f,
+	0x4e, 0xd3, 0xe4, 0xbe, 0xe0, 0x1c, 0x7c, 0xd5, 0xaf, 0xf9, 0x2e, 0x9a, 0x57, 0xef, 0x91, 0xd2,
+	0x9a, 0xa1, 0xb5, 0x36, 0xc7, 0x74, 0x94, 0xb9, 0x83, 0x56, 0xe8, 0x44, 0xa4, 0x87, 0xb3, 0xd0,
+	0xfc, 0xac, 0x6f, 0xd3, 0xeb, 0x59, 0xad, 0x6d, 0x74, 0xe7, 0x94, 0x47, 0x42, 0xb0, 0xaf, 0x7a,
+	0x34, 0x01, 0x46, 0x65, 0x02, 0xa4, 0x23, 0x04, 0x93, 0x66, 0x0d, 0x55, 0x28, 0x51, 0xa4, 0x56,
+	0x5a, 0x55, 0x57, 0x2d, 0xad, 0xdf, 0x2a, 0xc8, 0xda, 0x17, 0xdc, 0x07, 0x9e, 0xc4, 0x38, 0xf7,
+	0x3b, 0x95, 0x10, 0x77, 0x84, 0xa4, 0x57, 0xb5, 0x71, 0x9d, 0x6e, 0x63, 0x06, 0xdd, 0x4d, 0xb4,
+	0x14, 0xe5, 0xe1, 0x6a, 0x9e, 0xb2, 0x9e, 0x07, 0x15, 0xa6, 0x43, 0x32, 0x39, 0x6c, 0xe5, 0xca,
+	0xb0, 0x9f, 0xa1, 0x65, 0x39, 0xe4, 0x49, 0x0f, 0x12, 0xea, 0x7b, 0xca, 0x96, 0x93, 0xb4, 0x3e,
+	0xba, 0x1a, 0xb2, 0x3b, 0xc7, 0x3e, 0x29, 0xbc, 0xd4, 0xd9, 0xb6, 0xab, 0x4a, 0x29, 0xee, 0x5b,
+	0x72, 0xd2, 0x38, 0x5d, 0x74, 0x37, 0xfe, 0x6f, 0xd1, 0xcd, 0xfd, 0x27, 0xa2, 0xfb, 0xb5, 0x8c,
+	0xde, 0x69, 0x63, 0x86, 0xb9, 0xaf, 0x98, 0x14, 0xec, 0x98, 0x06, 0xd9, 0x37, 0xc0, 0x6c, 0xa1,
+	0x5a, 0x37, 0x07, 0x3c, 0x25, 0x91, 0xb1, 0xe0, 0x96, 0xbb, 0x13, 0x01, 0x87, 0xc4, 0x7c, 0x1f,
+	0x21, 0x9f, 0x8d, 0x7c, 0x32, 0x12, 0x17, 0x7c, 0x96, 0xa3, 0x1f, 0xa1, 0x95, 0xb0, 0x48, 0x2a,
+	0xbd, 0x08, 0x62, 0xaf, 0xab, 0xf9, 0xca, 0xf8, 0x34, 0xc7, 0x58, 0x07, 0xe2, 0xb6, 0x42, 0xcc,
+	0x03, 0x74, 0x23, 0xea, 0x61, 0x09, 0x9a, 0xd2, 0xe5, 0x5d, 0x7b, 0xda, 0x6d, 0x3f, 0xb5, 0xe7,
+	0x8e, 0x8a, 0x72, 0xb3, 0x60, 0x75, 0x29, 0x73, 0x78, 0x9a, 0x78, 0x7d, 0x18, 0x6a, 0x32, 0x6f,
+	0xba, 0xf3, 0x6a, 0xff, 0x39, 0x0c, 0xd5, 0x8d, 0xcb, 0xd3, 0xd0, 0xcb, 0x4a, 0x03, 0xd1, 0x47,
+	0x5d, 0x75, 0x97, 0x78, 0x1a, 0x1e, 0xe7, 0x26, 0x73, 0x1d, 0x21, 0xe5, 0x72, 0x86, 0x29, 0x03,
+	0xb2, 0x3a, 0xaf, 0x1d, 0x16, 0x79, 0x1a, 0x3e, 0xd2, 0x86, 0xed, 0xef, 0x0d, 0x74, 0x7b, 0xca,
+	0x07, 0xc7, 0x5c, 0x47, 0x6b, 0x53, 0xcc, 0x8f, 0x71, 0x42, 0x07, 0x50, 0x2b, 0x99, 0x0d, 0x54,
+	0x9f, 0x02, 0x1f, 0x75, 0x4e, 0x7a, 0x38, 0x86, 0x9a, 0x61, 0xb6, 0xd0, 0xdd, 0x29, 0xf8, 0xe4,
+	0x5b, 0x97, 0x79, 0x96, 0xeb, 0xd5, 0x67, 0x3f, 0x35, 0x4a, 0xdb, 0x3f, 0x1a, 0xa8, 0x3e, 0xfb,
+	0x24, 0xcc, 0xbb, 0x68, 0x63, 0x36, 0xaa, 0xa4, 0x0f, 0xa4, 0x56, 0x32, 0xb7, 0xd0, 0xe6, 0x6c,
+	0xaf, 0x53, 0xae, 0x28, 0xa1, 0x3c, 0xa8, 0x19, 0xe6, 0x87, 0xc8, 0x7a, 0x93, 0x23, 0x90, 0xa2,
+	0xb7, 0x76, 0xe7, 0xc5, 0x45, 0xc3, 0x78, 0x79, 0xd1, 0x30, 0xfe, 0xba, 0x68, 0x18, 0x3f, 0x5c,
+	0x36, 0x4a, 0x2f, 0x2f, 0x1b, 0xa5, 0xdf, 0x2f, 0x1b, 0xa5, 0x6f, 0x1e, 0x4c, 0x88, 0x36, 0xa7,
+	0xf6, 0x3e, 0xc3, 0x5d, 0x59, 0x6c, 0x9c, 0xc1, 0xee, 0x03, 0xe7, 0xe9, 0xe4, 0x4f, 0x8e, 0x16,
+	0x72, 0x77, 0x4e, 0xff, 0x3a, 0x7c, 0xfc, 0xcf, 0x00, 0xd7, 0x6d, 0x36, 0x1d, 0x07, 0x09, 0x00,
+	0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -905,6 +1056,66 @@ func (m *ConcentratedPoolUserPositionRecord) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *BalancerPoolMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalancerPoolMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalancerPoolMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumFailed != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.NumFailed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumMigrated != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.NumMigrated))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Phase != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MigrationsPerBlock != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.MigrationsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClPoolId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.ClPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BalancerPoolId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.BalancerPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -1052,6 +1263,37 @@ func (m *ConcentratedPoolUserPositionRecord) Size() (n int) {
 	return n
 }
 
+func (m *BalancerPoolMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BalancerPoolId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.BalancerPoolId))
+	}
+	if m.ClPoolId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.ClPoolId))
+	}
+	if m.MigrationsPerBlock != 0 {
+		n += 1 + sovSuperfluid(uint64(m.MigrationsPerBlock))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovSuperfluid(uint64(m.Phase))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.NumMigrated != 0 {
+		n += 1 + sovSuperfluid(uint64(m.NumMigrated))
+	}
+	if m.NumFailed != 0 {
+		n += 1 + sovSuperfluid(uint64(m.NumFailed))
+	}
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2059,6 +2301,204 @@ func (m *ConcentratedPoolUserPositionRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BalancerPoolMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalancerPoolMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalancerPoolMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancerPoolId", wireType)
+			}
+			m.BalancerPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancerPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClPoolId", wireType)
+			}
+			m.ClPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationsPerBlock", wireType)
+			}
+			m.MigrationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= BalancerPoolMigrationPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMigrated", wireType)
+			}
+			m.NumMigrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMigrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFailed", wireType)
+			}
+			m.NumFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -79,8 +79,224 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetVolumeWeightedTwap returns a volume weighted average price of the base asset,
+// in units of the quote asset, from (startTime, endTime) as determined by swaps in pool `poolId`.
+// It is the amount of quote asset swapped against the base asset divided by the amount of base asset
+// swapped against the quote asset in the time range. If there were no swaps in the pair within the
+// time range, the arithmetic twap is returned instead.
+//
+// The same time range restrictions and error cases as for GetArithmeticTwap apply.
+func (k Keeper) GetVolumeWeightedTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVolumeWeightedStrategy())
+}
+
+// GetVolumeWeightedTwapToNow returns volume weighted twap from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetVolumeWeightedTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetVolumeWeightedStrategy())
+}
+
+// GetMedianTwap returns the time weighted median of the spot price of the base asset,
+// in units of the quote asset, from (startTime, endTime) as determined by pool `poolId`.
+// Unlike the mean based twaps, a short lived price spike does not move the median unless
+// it lasts for at least half of the time range.
+//
+// The median is computed by iterating over all historical records within the time range,
+// so it is more expensive than the accumulator based twaps. It errors if the time range
+// holds more than types.MaxMedianTwapRecords records, which bounds the work done by a query.
+// The same time range restrictions and error cases as for GetArithmeticTwap apply.
+func (k Keeper) GetMedianTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.Equal(ctx.BlockTime()) {
+		return k.GetMedianTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	} else if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return k.getMedianTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endRecord)
+}
+
+// GetMedianTwapToNow returns the time weighted median of the spot price from start time until the
+// current block time for quote and base assets in a given pool.
+func (k Keeper) GetMedianTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
+	}
+	endRecord, err := k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return k.getMedianTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endRecord)
+}
+
+// GetTwapToNow returns the TWAP of the base asset, in units of the quote asset,
+// from startTime until the current block time, computed with the given strategy.
+// It has the same semantics as GetArithmeticTwapToNow, GetGeometricTwapToNow and
+// GetVolumeWeightedTwapToNow, and additionally errors if the strategy is not a known twap strategy.
+func (k Keeper) GetTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	strategyType types.TwapStrategy,
+) (osmomath.Dec, error) {
+	strategy, err := k.getTwapStrategy(strategyType)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, strategy)
+}
+
+// GetTwapSeries returns the TWAPs of the base asset, in units of the quote asset,
+// over consecutive buckets of bucketInterval within (startTime, endTime), as determined
+// by prices from AMM pool `poolId`, using the given strategy.
+// The last bucket ends at endTime, and may be shorter than bucketInterval.
+//
+// Only the buckets with an index in [offset, offset + limit) are returned,
+// along with the total number of buckets in the series.
+// Every returned bucket boundary is interpolated once, so the work done
+// is proportional to limit, which is capped at types.MaxTwapSeriesBuckets.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * bucketInterval is not positive
+// * limit > types.MaxTwapSeriesBuckets
+// * the strategy is not a known twap strategy
+// * the start of the first returned bucket is older than 48 hours OR pool creation
+// * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
+// * there were some computational errors during computing the twap of any returned bucket
+func (k Keeper) GetTwapSeries(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	bucketInterval time.Duration,
+	strategyType types.TwapStrategy,
+	offset uint64,
+	limit uint64,
+) ([]types.TwapSeriesBucket, uint64, error) {
+	if startTime.After(endTime) {
+		return nil, 0, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return nil, 0, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	if bucketInterval <= 0 {
+		return nil, 0, types.InvalidBucketIntervalError{BucketInterval: bucketInterval}
+	}
+	if limit > types.MaxTwapSeriesBuckets {
+		return nil, 0, types.TooManyBucketsError{Requested: limit, Max: types.MaxTwapSeriesBuckets}
+	}
+	strategy, err := k.getTwapStrategy(strategyType)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// round up, so that the last bucket ends at endTime.
+	seriesDuration := endTime.Sub(startTime)
+	totalBuckets := uint64(seriesDuration / bucketInterval)
+	if seriesDuration%bucketInterval != 0 {
+		totalBuckets++
+	}
+	if offset >= totalBuckets {
+		return []types.TwapSeriesBucket{}, totalBuckets, nil
+	}
+	lastBucket := offset + limit
+	if lastBucket > totalBuckets {
+		lastBucket = totalBuckets
+	}
+
+	buckets := make([]types.TwapSeriesBucket, 0, lastBucket-offset)
+	bucketStartTime := startTime.Add(time.Duration(offset) * bucketInterval)
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, bucketStartTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := offset; i < lastBucket; i++ {
+		bucketEndTime := bucketStartTime.Add(bucketInterval)
+		if bucketEndTime.After(endTime) {
+			bucketEndTime = endTime
+		}
+		endRecord, err := k.getInterpolatedRecord(ctx, poolId, bucketEndTime, baseAssetDenom, quoteAssetDenom)
+		if err != nil {
+			return nil, 0, err
+		}
+		twap, err := computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
+		if err != nil {
+			return nil, 0, err
+		}
+		buckets = append(buckets, types.TwapSeriesBucket{StartTime: bucketStartTime, EndTime: bucketEndTime, Twap: twap})
+
+		// the end of this bucket is the start of the next one.
+		startRecord, bucketStartTime = endRecord, bucketEndTime
+	}
+
+	return buckets, totalBuckets, nil
+}
+
+// getMedianTwap computes and returns the time weighted median of the spot price
+// from the start time until the time of the given end record.
+func (k Keeper) getMedianTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endRecord types.TwapRecord,
+) (osmomath.Dec, error) {
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	records, err := k.getRecordsAfterTimeUntil(ctx, poolId, startTime, endRecord.Time, baseAssetDenom, quoteAssetDenom, types.MaxMedianTwapRecords)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	// see if we need to return an error, due to spot price issues
+	err = spotPriceErrorInRange(startRecord, endRecord)
+	return computeMedianTwap(startRecord, records, startTime, endRecord.Time, quoteAssetDenom), err
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or volume weighted.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -111,7 +327,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be either arithmetic, geometric or volume weighted.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
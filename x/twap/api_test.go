@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	sdkrand "github.com/osmosis-labs/osmosis/v26/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v26/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v26/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v26/x/twap"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
@@ -909,3 +910,364 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+// TestGetMedianTwap tests if we get the expected median twap value from `GetMedianTwap`
+// and `GetMedianTwapToNow`, given records stored in state.
+func (s *TestSuite) TestGetMedianTwap() {
+	// recordsEverySecond returns the base record followed by n records one second apart.
+	recordsEverySecond := func(n int) []types.TwapRecord {
+		records := []types.TwapRecord{baseRecord}
+		for i := 1; i <= n; i++ {
+			record := baseRecord
+			record.Time = baseTime.Add(time.Duration(i) * time.Second)
+			records = append(records, record)
+		}
+		return records
+	}
+	maxRecordsDuration := time.Duration(types.MaxMedianTwapRecords) * time.Second
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		ctxTime       time.Time
+		input         getTwapInput
+		toNow         bool
+		expTwap       osmomath.Dec
+		expectedError error
+	}{
+		"(1 record) start time = record time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      osmomath.NewDec(10),
+		},
+		"(1 record) start time = record time, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      osmomath.NewDecWithPrec(1, 1),
+		},
+		"(2 record) end time before second record is mostly first spot price": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			// 10 for 10s, 5 for 5s
+			input:   makeSimpleTwapInput(baseTime, baseTime.Add(15*time.Second), baseQuoteBA),
+			expTwap: osmomath.NewDec(10),
+		},
+		"(2 record) to now: second spot price is held for most of the time": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			// 10 for 10s, 5 for 50s
+			input:   makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			toNow:   true,
+			expTwap: osmomath.NewDec(5),
+		},
+		"(2 record) end time = block time is computed to now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteAB),
+			expTwap:      osmomath.NewDecWithPrec(2, 1),
+		},
+
+		"(max records) range holding as many records as the cap": {
+			recordsToSet: recordsEverySecond(types.MaxMedianTwapRecords),
+			ctxTime:      baseTime.Add(2 * maxRecordsDuration),
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(maxRecordsDuration), baseQuoteBA),
+			expTwap:      osmomath.NewDec(10),
+		},
+
+		// error catching
+		"end time in future": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       baseTime,
+			input:         makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expectedError: types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"range holding more records than the cap": {
+			recordsToSet:  recordsEverySecond(types.MaxMedianTwapRecords + 1),
+			ctxTime:       baseTime.Add(2 * maxRecordsDuration),
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(maxRecordsDuration+time.Second), baseQuoteBA),
+			expectedError: types.TooManyRecordsError{Max: types.MaxMedianTwapRecords},
+		},
+		"start time after end time": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"start time too old": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(tMinOne, tPlusOne, baseQuoteBA),
+			expectedError: twap.TimeTooOldError{Time: tMinOne},
+		},
+		"spot price error in record at record time": {
+			recordsToSet:  []types.TwapRecord{withLastErrTime(baseRecord, baseTime)},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expectedError: errSpotPrice,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			var twap osmomath.Dec
+			var err error
+			if test.toNow {
+				twap, err = s.twapkeeper.GetMedianTwapToNow(s.Ctx, test.input.poolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom,
+					test.input.startTime)
+			} else {
+				twap, err = s.twapkeeper.GetMedianTwap(s.Ctx, test.input.poolId,
+					test.input.baseAssetDenom, test.input.quoteAssetDenom,
+					test.input.startTime, test.input.endTime)
+			}
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, twap)
+		})
+	}
+}
+
+// TestGetTwapToNow validates that GetTwapToNow returns the same twap as the
+// strategy specific functions, and errors on an unknown strategy.
+func (s *TestSuite) TestGetTwapToNow() {
+	s.SetupTest()
+	s.preSetRecords([]types.TwapRecord{baseRecord, tPlus10sp5Record})
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+	input := makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA)
+
+	expectedTwaps := map[types.TwapStrategy]func(sdk.Context, uint64, string, string, time.Time) (osmomath.Dec, error){
+		types.TWAP_STRATEGY_ARITHMETIC:      s.twapkeeper.GetArithmeticTwapToNow,
+		types.TWAP_STRATEGY_GEOMETRIC:       s.twapkeeper.GetGeometricTwapToNow,
+		types.TWAP_STRATEGY_VOLUME_WEIGHTED: s.twapkeeper.GetVolumeWeightedTwapToNow,
+	}
+	for strategy, getTwapToNow := range expectedTwaps {
+		expectedTwap, err := getTwapToNow(s.Ctx, input.poolId, input.baseAssetDenom, input.quoteAssetDenom, input.startTime)
+		s.Require().NoError(err)
+
+		twap, err := s.twapkeeper.GetTwapToNow(s.Ctx, input.poolId, input.baseAssetDenom, input.quoteAssetDenom, input.startTime, strategy)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedTwap, twap, strategy.String())
+	}
+
+	_, err := s.twapkeeper.GetTwapToNow(s.Ctx, input.poolId, input.baseAssetDenom, input.quoteAssetDenom, input.startTime, types.TwapStrategy(100))
+	s.Require().Equal(types.InvalidTwapStrategyError{Strategy: types.TwapStrategy(100)}, err)
+}
+
+func (s *TestSuite) TestGetTwapSeries() {
+	threeRecords := []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record}
+	bucket := func(start, end time.Time, twap osmomath.Dec) types.TwapSeriesBucket {
+		return types.TwapSeriesBucket{StartTime: start, EndTime: end, Twap: twap}
+	}
+
+	tests := map[string]struct {
+		recordsToSet   []types.TwapRecord
+		ctxTime        time.Time
+		input          getTwapInput
+		bucketInterval time.Duration
+		strategy       types.TwapStrategy
+		offset         uint64
+		limit          uint64
+		expBuckets     []types.TwapSeriesBucket
+		expTotal       uint64
+		expectedError  error
+	}{
+		"(3 record) one bucket per record": {
+			recordsToSet:   threeRecords,
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			bucketInterval: 10 * time.Second,
+			limit:          types.MaxTwapSeriesBuckets,
+			expBuckets: []types.TwapSeriesBucket{
+				bucket(baseTime, baseTime.Add(10*time.Second), osmomath.NewDec(10)),
+				bucket(baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), osmomath.NewDec(5)),
+				bucket(baseTime.Add(20*time.Second), baseTime.Add(30*time.Second), osmomath.NewDec(2)),
+			},
+			expTotal: 3,
+		},
+		"(3 record) last bucket is shorter and ends at end time": {
+			recordsToSet:   threeRecords,
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, baseTime.Add(25*time.Second), baseQuoteBA),
+			bucketInterval: 20 * time.Second,
+			limit:          types.MaxTwapSeriesBuckets,
+			expBuckets: []types.TwapSeriesBucket{
+				// 10 for 10s, 5 for 10s
+				bucket(baseTime, baseTime.Add(20*time.Second), osmomath.MustNewDecFromStr("7.5")),
+				bucket(baseTime.Add(20*time.Second), baseTime.Add(25*time.Second), osmomath.NewDec(2)),
+			},
+			expTotal: 2,
+		},
+		"(3 record) offset and limit select a page of buckets": {
+			recordsToSet:   threeRecords,
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			bucketInterval: 10 * time.Second,
+			offset:         1,
+			limit:          1,
+			expBuckets: []types.TwapSeriesBucket{
+				bucket(baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), osmomath.NewDec(5)),
+			},
+			expTotal: 3,
+		},
+		"(3 record) offset past the last bucket": {
+			recordsToSet:   threeRecords,
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			bucketInterval: 10 * time.Second,
+			offset:         3,
+			limit:          1,
+			expBuckets:     []types.TwapSeriesBucket{},
+			expTotal:       3,
+		},
+		"(1 record) end time = block time, volume weighted without volume": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteAB),
+			bucketInterval: time.Minute,
+			strategy:       types.TWAP_STRATEGY_VOLUME_WEIGHTED,
+			limit:          1,
+			expBuckets: []types.TwapSeriesBucket{
+				bucket(baseTime, tPlusOneMin, osmomath.NewDecWithPrec(1, 1)),
+			},
+			expTotal: 1,
+		},
+		"start time = end time has no buckets": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, baseTime, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          1,
+			expBuckets:     []types.TwapSeriesBucket{},
+			expTotal:       0,
+		},
+
+		// error catching
+		"end time in future": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        baseTime,
+			input:          makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          1,
+			expectedError:  types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"start time after end time": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          1,
+			expectedError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"zero bucket interval": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			limit:         1,
+			expectedError: types.InvalidBucketIntervalError{BucketInterval: 0},
+		},
+		"limit above the maximum number of buckets": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          types.MaxTwapSeriesBuckets + 1,
+			expectedError:  types.TooManyBucketsError{Requested: types.MaxTwapSeriesBuckets + 1, Max: types.MaxTwapSeriesBuckets},
+		},
+		"unknown strategy": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			bucketInterval: time.Second,
+			strategy:       types.TwapStrategy(100),
+			limit:          1,
+			expectedError:  types.InvalidTwapStrategyError{Strategy: types.TwapStrategy(100)},
+		},
+		"start time too old": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(tMinOne, tPlusOne, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          1,
+			expectedError:  twap.TimeTooOldError{Time: tMinOne},
+		},
+		"spot price error in record at record time": {
+			recordsToSet:   []types.TwapRecord{withLastErrTime(baseRecord, baseTime)},
+			ctxTime:        tPlusOneMin,
+			input:          makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			bucketInterval: time.Second,
+			limit:          1,
+			expectedError:  errSpotPrice,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			buckets, total, err := s.twapkeeper.GetTwapSeries(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime,
+				test.bucketInterval, test.strategy, test.offset, test.limit)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expBuckets, buckets)
+			s.Require().Equal(test.expTotal, total)
+		})
+	}
+}
+
+// TestGetVolumeWeightedTwapToNow_Swaps validates that the volume weighted twap
+// equals the average execution price of the swaps done in a pool.
+func (s *TestSuite) TestGetVolumeWeightedTwapToNow_Swaps() {
+	poolId, denomA, denomB := s.setupDefaultPool()
+	s.twapkeeper.EndBlock(s.Ctx)
+	startTime := s.Ctx.BlockTime()
+
+	volumes := map[string]osmomath.Int{denomA: osmomath.ZeroInt(), denomB: osmomath.ZeroInt()}
+	swapsInNextBlocks := []sdk.Coin{
+		sdk.NewCoin(denomA, osmomath.NewInt(1_000_000)),
+		sdk.NewCoin(denomB, osmomath.NewInt(500_000)),
+		sdk.NewCoin(denomA, osmomath.NewInt(10_000_000)),
+	}
+	for _, tokenIn := range swapsInNextBlocks {
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(10 * time.Second))
+		tokenOutDenom := denomA
+		if tokenIn.Denom == denomA {
+			tokenOutDenom = denomB
+		}
+
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+		tokenOutAmount, err := s.App.PoolManagerKeeper.SwapExactAmountInNoTakerFee(s.Ctx, s.TestAccs[0], poolId, tokenIn, tokenOutDenom, osmomath.OneInt())
+		s.Require().NoError(err)
+		s.twapkeeper.EndBlock(s.Ctx)
+
+		volumes[tokenIn.Denom] = volumes[tokenIn.Denom].Add(tokenIn.Amount)
+		volumes[tokenOutDenom] = volumes[tokenOutDenom].Add(tokenOutAmount)
+	}
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+
+	vwap, err := s.twapkeeper.GetVolumeWeightedTwapToNow(s.Ctx, poolId, denomA, denomB, startTime)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.SigFigRound(osmomath.NewDecFromInt(volumes[denomB]).QuoInt(volumes[denomA]), gammtypes.SpotPriceSigFigs), vwap)
+
+	// the volume weighted twap is independent of the time spent at each price,
+	// so the twap until the last swap is the same.
+	vwap, err = s.twapkeeper.GetVolumeWeightedTwap(s.Ctx, poolId, denomB, denomA, startTime, s.Ctx.BlockTime().Add(-time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.SigFigRound(osmomath.NewDecFromInt(volumes[denomA]).QuoInt(volumes[denomB]), gammtypes.SpotPriceSigFigs), vwap)
+}
@@ -16,6 +16,9 @@ import (
 	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
 
+// FlagStrategy is the flag for the strategy used to compute twaps in a twap series.
+const FlagStrategy = "strategy"
+
 // twapQueryParseArgs represents the outcome
 // of parsing the arguments for twap query command.
 type twapQueryArgs struct {
@@ -30,6 +33,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryVolumeWeightedCommand())
+	cmd.AddCommand(GetQueryMedianCommand())
+	cmd.AddCommand(GetQuerySeriesCommand())
+	cmd.AddCommand(GetQueryOraclePriceCommand())
+	cmd.AddCommand(GetQueryOracleRoutesCommand())
+	cmd.AddCommand(GetQueryRecordRetentionOverridesCommand())
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -138,6 +147,222 @@ Example:
 	return cmd
 }
 
+// GetQueryVolumeWeightedCommand returns a volume weighted twap query command.
+func GetQueryVolumeWeightedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volume-weighted [poolid] [base denom] [start time] [end time]",
+		Short: "Query volume weighted twap",
+		Long: osmocli.FormatLongDescDirect(`Query volume weighted twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volume-weighted 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.VolumeWeightedTwap(cmd.Context(), &queryproto.VolumeWeightedTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryMedianCommand returns a median twap query command.
+func GetQueryMedianCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median [poolid] [base denom] [start time] [end time]",
+		Short: "Query median twap",
+		Long: osmocli.FormatLongDescDirect(`Query median twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} median 1 uosmo 1667088000 24h
+{{.CommandPrefix}} median 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.MedianTwap(cmd.Context(), &queryproto.MedianTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQuerySeriesCommand returns a twap series query command.
+func GetQuerySeriesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "series [poolid] [base denom] [start time] [end time] [bucket interval]",
+		Short: "Query a series of twaps, one per bucket interval",
+		Long: osmocli.FormatLongDescDirect(`Query a series of twaps for pool, one per bucket interval. Start time must be unix time. End time can be unix time or duration.
+The strategy used to compute the twaps can be set with --strategy, one of arithmetic, geometric or volume-weighted.
+
+Example:
+{{.CommandPrefix}} series 1 uosmo 1667088000 24h 1h
+{{.CommandPrefix}} series 1 uosmo 1667088000 1667174400 15m --strategy=geometric --limit=24
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			bucketInterval, err := time.ParseDuration(args[4])
+			if err != nil {
+				return err
+			}
+			strategyArg, err := cmd.Flags().GetString(FlagStrategy)
+			if err != nil {
+				return err
+			}
+			strategy, err := parseTwapStrategy(strategyArg)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.TwapSeries(cmd.Context(), &queryproto.TwapSeriesRequest{
+				PoolId:         twapArgs.PoolId,
+				BaseAsset:      twapArgs.BaseDenom,
+				QuoteAsset:     quoteDenom,
+				StartTime:      twapArgs.StartTime,
+				EndTime:        &twapArgs.EndTime,
+				BucketInterval: bucketInterval,
+				Strategy:       strategy,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStrategy, "arithmetic", "twap strategy, one of arithmetic, geometric or volume-weighted")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "series")
+
+	return cmd
+}
+
+// GetQueryOraclePriceCommand returns an oracle price query command.
+func GetQueryOraclePriceCommand() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.OraclePriceRequest](
+		"oracle-price [base-denom] [quote-denom]",
+		"Query the price of the base denom in the quote denom, using its governance registered oracle route",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} oracle-price uatom uosmo
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
+// GetQueryOracleRoutesCommand returns an oracle routes query command.
+func GetQueryOracleRoutesCommand() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.OracleRoutesRequest](
+		"oracle-routes",
+		"Query all governance registered oracle routes",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} oracle-routes
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
+// GetQueryRecordRetentionOverridesCommand returns a record retention overrides query command.
+func GetQueryRecordRetentionOverridesCommand() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.RecordRetentionOverridesRequest](
+		"record-retention-overrides",
+		"Query all governance set per-pool record retention overrides",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} record-retention-overrides
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
+// parseTwapStrategy parses the twap strategy from its CLI name.
+func parseTwapStrategy(strategy string) (types.TwapStrategy, error) {
+	switch strategy {
+	case "arithmetic":
+		return types.TWAP_STRATEGY_ARITHMETIC, nil
+	case "geometric":
+		return types.TWAP_STRATEGY_GEOMETRIC, nil
+	case "volume-weighted":
+		return types.TWAP_STRATEGY_VOLUME_WEIGHTED, nil
+	default:
+		return 0, fmt.Errorf("unknown twap strategy %s, expected one of arithmetic, geometric or volume-weighted", strategy)
+	}
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
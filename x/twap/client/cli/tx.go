@@ -0,0 +1,181 @@
+package twapcli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// NewCmdSetOracleRoutesProposal implements a command handler for the set oracle routes proposal
+func NewCmdSetOracleRoutesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-oracle-routes-proposal [routes-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the routes used by the twap oracle",
+		Long: strings.TrimSpace(`Submit a proposal to set the routes used by the twap oracle.
+A route replaces the existing route of its (base_denom, quote_denom) pair, a route without hops removes it.
+
+Ex) set-oracle-routes-proposal routes.json ->
+- routes.json
+{
+	"routes": [
+		{
+			"base_denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			"quote_denom": "uusdc",
+			"hops": [
+				{ "pool_id": "1", "token_out_denom": "uosmo" },
+				{ "pool_id": "678", "token_out_denom": "uusdc" }
+			],
+			"twap_window": "3600s",
+			"strategy": "TWAP_STRATEGY_ARITHMETIC",
+			"max_staleness": "600s",
+			"max_spot_deviation": "0.05"
+		}
+	]
+}
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetOracleRoutesArgsToContent(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseSetOracleRoutesArgsToContent(cmd *cobra.Command, clientCtx client.Context, routesFile string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetOracleRoutesProposal{}
+	if err := clientCtx.Codec.UnmarshalJSON(contents, content); err != nil {
+		return nil, err
+	}
+	content.Title, content.Description = title, description
+
+	return content, nil
+}
+
+// NewCmdSetRecordRetentionOverridesProposal implements a command handler for the set record retention overrides proposal
+func NewCmdSetRecordRetentionOverridesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-record-retention-overrides-proposal [pool-ids] [keep-periods] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to override the twap record history keep period of pools",
+		Long: strings.TrimSpace(`Submit a proposal to override the twap record history keep period of pools.
+An override replaces the existing override of its pool, a zero keep period removes it.
+
+Ex) set-record-retention-overrides-proposal 1,1066 720h,0s ->
+keep the records of pool 1 for 30 days, and prune the records of pool 1066 by the record_history_keep_period param again.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetRecordRetentionOverridesArgsToContent(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseSetRecordRetentionOverridesArgsToContent(cmd *cobra.Command, poolIdsArg, keepPeriodsArg string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := strings.Split(poolIdsArg, ",")
+	keepPeriods := strings.Split(keepPeriodsArg, ",")
+	if len(poolIds) != len(keepPeriods) {
+		return nil, fmt.Errorf("number of pool ids (%d) and keep periods (%d) must match", len(poolIds), len(keepPeriods))
+	}
+
+	overrides := make([]types.RecordRetentionOverride, 0, len(poolIds))
+	for i := range poolIds {
+		poolId, err := strconv.ParseUint(strings.TrimSpace(poolIds[i]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		keepPeriod, err := time.ParseDuration(strings.TrimSpace(keepPeriods[i]))
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, types.RecordRetentionOverride{PoolId: poolId, KeepPeriod: keepPeriod})
+	}
+
+	return types.NewSetRecordRetentionOverridesProposal(title, description, overrides), nil
+}
@@ -21,6 +21,46 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) VolumeWeightedTwapToNow(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwapToNow(ctx, *req)
+}
+
+func (q Querier) VolumeWeightedTwap(grpcCtx context.Context,
+	req *queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolumeWeightedTwap(ctx, *req)
+}
+
+func (q Querier) TwapSeries(grpcCtx context.Context,
+	req *queryproto.TwapSeriesRequest,
+) (*queryproto.TwapSeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TwapSeries(ctx, *req)
+}
+
+func (q Querier) RecordRetentionOverrides(grpcCtx context.Context,
+	req *queryproto.RecordRetentionOverridesRequest,
+) (*queryproto.RecordRetentionOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RecordRetentionOverrides(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +71,46 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) OracleRoutes(grpcCtx context.Context,
+	req *queryproto.OracleRoutesRequest,
+) (*queryproto.OracleRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OracleRoutes(ctx, *req)
+}
+
+func (q Querier) OraclePrice(grpcCtx context.Context,
+	req *queryproto.OraclePriceRequest,
+) (*queryproto.OraclePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OraclePrice(ctx, *req)
+}
+
+func (q Querier) MedianTwapToNow(grpcCtx context.Context,
+	req *queryproto.MedianTwapToNowRequest,
+) (*queryproto.MedianTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MedianTwapToNow(ctx, *req)
+}
+
+func (q Querier) MedianTwap(grpcCtx context.Context,
+	req *queryproto.MedianTwapRequest,
+) (*queryproto.MedianTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MedianTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -51,6 +131,16 @@ func (q Querier) GeometricTwap(grpcCtx context.Context,
 	return q.Q.GeometricTwap(ctx, *req)
 }
 
+func (q Querier) BatchTwapToNow(grpcCtx context.Context,
+	req *queryproto.BatchTwapToNowRequest,
+) (*queryproto.BatchTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BatchTwapToNow(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
@@ -1,12 +1,16 @@
 package client
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/twap"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -61,6 +65,163 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) VolumeWeightedTwap(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapRequest,
+) (*queryproto.VolumeWeightedTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetVolumeWeightedTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolumeWeightedTwapResponse{VolumeWeightedTwap: twap}, err
+}
+
+func (q Querier) VolumeWeightedTwapToNow(ctx sdk.Context,
+	req queryproto.VolumeWeightedTwapToNowRequest,
+) (*queryproto.VolumeWeightedTwapToNowResponse, error) {
+	twap, err := q.K.GetVolumeWeightedTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.VolumeWeightedTwapToNowResponse{VolumeWeightedTwap: twap}, err
+}
+
+func (q Querier) MedianTwap(ctx sdk.Context,
+	req queryproto.MedianTwapRequest,
+) (*queryproto.MedianTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetMedianTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.MedianTwapResponse{MedianTwap: twap}, err
+}
+
+func (q Querier) MedianTwapToNow(ctx sdk.Context,
+	req queryproto.MedianTwapToNowRequest,
+) (*queryproto.MedianTwapToNowResponse, error) {
+	twap, err := q.K.GetMedianTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.MedianTwapToNowResponse{MedianTwap: twap}, err
+}
+
+func (q Querier) TwapSeries(ctx sdk.Context,
+	req queryproto.TwapSeriesRequest,
+) (*queryproto.TwapSeriesResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	offset, limit, err := parseTwapSeriesPagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, totalBuckets, err := q.K.GetTwapSeries(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime, req.BucketInterval, req.Strategy, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes := &query.PageResponse{Total: totalBuckets}
+	if nextOffset := offset + uint64(len(buckets)); nextOffset < totalBuckets {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(nextOffset)
+	}
+	return &queryproto.TwapSeriesResponse{Buckets: buckets, Pagination: pageRes}, nil
+}
+
+// parseTwapSeriesPagination returns the index of the first bucket and the number of buckets
+// to return for the given page request. The page key, if set, is the big endian encoded index
+// of the first bucket, as returned in the next key of the previous page.
+func parseTwapSeriesPagination(pageReq *query.PageRequest) (offset uint64, limit uint64, err error) {
+	if pageReq == nil {
+		return 0, types.DefaultTwapSeriesBuckets, nil
+	}
+	if pageReq.Reverse {
+		return 0, 0, fmt.Errorf("reverse pagination is not supported for twap series")
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return 0, 0, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset = pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, fmt.Errorf("invalid pagination key, expected 8 bytes, got %d", len(pageReq.Key))
+		}
+		offset = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	limit = pageReq.Limit
+	if limit == 0 {
+		limit = types.DefaultTwapSeriesBuckets
+	}
+	return offset, limit, nil
+}
+
+// BatchTwapToNow computes the twap of every entry independently, so that a failing entry,
+// e.g. due to a start time older than the kept records, does not fail the whole batch.
+func (q Querier) BatchTwapToNow(ctx sdk.Context,
+	req queryproto.BatchTwapToNowRequest,
+) (*queryproto.BatchTwapToNowResponse, error) {
+	if len(req.Entries) > types.MaxBatchTwapEntries {
+		return nil, types.TooManyBatchEntriesError{Requested: len(req.Entries), Max: types.MaxBatchTwapEntries}
+	}
+
+	results := make([]queryproto.TwapToNowResult, 0, len(req.Entries))
+	for _, entry := range req.Entries {
+		twap, err := q.K.GetTwapToNow(ctx, entry.PoolId, entry.BaseAsset, entry.QuoteAsset, entry.StartTime, entry.Strategy)
+		if err != nil {
+			results = append(results, queryproto.TwapToNowResult{Twap: osmomath.ZeroDec(), Error: err.Error()})
+			continue
+		}
+		results = append(results, queryproto.TwapToNowResult{Twap: twap})
+	}
+
+	return &queryproto.BatchTwapToNowResponse{Results: results}, nil
+}
+
+func (q Querier) OraclePrice(ctx sdk.Context,
+	req queryproto.OraclePriceRequest,
+) (*queryproto.OraclePriceResponse, error) {
+	price, err := q.K.GetOraclePrice(ctx, req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.OraclePriceResponse{Price: price}, nil
+}
+
+func (q Querier) OracleRoutes(ctx sdk.Context,
+	req queryproto.OracleRoutesRequest,
+) (*queryproto.OracleRoutesResponse, error) {
+	routes, err := q.K.GetAllOracleRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.OracleRoutesResponse{Routes: routes}, nil
+}
+
+func (q Querier) RecordRetentionOverrides(ctx sdk.Context,
+	req queryproto.RecordRetentionOverridesRequest,
+) (*queryproto.RecordRetentionOverridesResponse, error) {
+	overrides, err := q.K.GetAllRecordRetentionOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.RecordRetentionOverridesResponse{Overrides: overrides}, nil
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
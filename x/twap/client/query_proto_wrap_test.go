@@ -7,11 +7,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
 
 type QueryTestSuite struct {
@@ -234,3 +236,92 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 		})
 	}
 }
+
+func (suite *QueryTestSuite) TestQueryTwapSeries() {
+	suite.SetupTest()
+
+	var (
+		poolID    = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenA", 1000), sdk.NewInt64Coin("tokenB", 2000))
+		startTime = suite.Ctx.BlockTime()
+		ctx       = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+		client    = client.Querier{K: *suite.App.TwapKeeper}
+	)
+
+	req := queryproto.TwapSeriesRequest{
+		PoolId:         poolID,
+		BaseAsset:      "tokenA",
+		QuoteAsset:     "tokenB",
+		StartTime:      startTime,
+		BucketInterval: 25 * time.Minute,
+		Pagination:     &query.PageRequest{Limit: 2},
+	}
+
+	// 60 minutes in buckets of 25 minutes, the last bucket being 10 minutes.
+	res, err := client.TwapSeries(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Buckets, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Equal(startTime, res.Buckets[0].StartTime)
+	suite.Require().Equal(startTime.Add(50*time.Minute), res.Buckets[1].EndTime)
+	for _, bucket := range res.Buckets {
+		suite.Require().Equal(osmomath.NewDec(2), bucket.Twap)
+	}
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = client.TwapSeries(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Buckets, 1)
+	suite.Require().Equal(startTime.Add(50*time.Minute), res.Buckets[0].StartTime)
+	suite.Require().Equal(ctx.BlockTime(), res.Buckets[0].EndTime)
+	suite.Require().Equal(osmomath.NewDec(2), res.Buckets[0].Twap)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// both a key and an offset
+	req.Pagination = &query.PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 1}
+	_, err = client.TwapSeries(ctx, req)
+	suite.Require().Error(err)
+
+	// malformed key
+	req.Pagination = &query.PageRequest{Key: []byte{1}}
+	_, err = client.TwapSeries(ctx, req)
+	suite.Require().Error(err)
+}
+
+func (suite *QueryTestSuite) TestQueryBatchTwapToNow() {
+	suite.SetupTest()
+
+	var (
+		poolID          = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenA", 1000), sdk.NewInt64Coin("tokenB", 2000))
+		startTime       = suite.Ctx.BlockTime()
+		startTimeTooOld = startTime.Add(-time.Hour)
+		ctx             = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+		client          = client.Querier{K: *suite.App.TwapKeeper}
+	)
+
+	res, err := client.BatchTwapToNow(ctx, queryproto.BatchTwapToNowRequest{
+		Entries: []queryproto.TwapToNowEntry{
+			{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+			{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTimeTooOld},
+			{PoolId: poolID, BaseAsset: "tokenB", QuoteAsset: "tokenA", StartTime: startTime, Strategy: types.TWAP_STRATEGY_GEOMETRIC},
+			{PoolId: poolID + 1, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime},
+			{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime, Strategy: types.TWAP_STRATEGY_VOLUME_WEIGHTED},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 5)
+
+	// failing entries do not affect the others.
+	suite.Require().Equal(queryproto.TwapToNowResult{Twap: osmomath.NewDec(2)}, res.Results[0])
+	suite.Require().NotEmpty(res.Results[1].Error)
+	suite.Require().Equal(osmomath.ZeroDec(), res.Results[1].Twap)
+	suite.Require().Equal(queryproto.TwapToNowResult{Twap: osmomath.NewDecWithPrec(5, 1)}, res.Results[2])
+	suite.Require().NotEmpty(res.Results[3].Error)
+	suite.Require().Equal(queryproto.TwapToNowResult{Twap: osmomath.NewDec(2)}, res.Results[4])
+
+	// too many entries
+	_, err = client.BatchTwapToNow(ctx, queryproto.BatchTwapToNowRequest{
+		Entries: make([]queryproto.TwapToNowEntry, types.MaxBatchTwapEntries+1),
+	})
+	suite.Require().ErrorIs(err, types.TooManyBatchEntriesError{Requested: types.MaxBatchTwapEntries + 1, Max: types.MaxBatchTwapEntries})
+}
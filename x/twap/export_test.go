@@ -10,10 +10,11 @@ import (
 )
 
 type (
-	TimeTooOldError        = timeTooOldError
-	TwapStrategy           = twapStrategy
-	ArithmeticTwapStrategy = arithmetic
-	GeometricTwapStrategy  = geometric
+	TimeTooOldError            = timeTooOldError
+	TwapStrategy               = twapStrategy
+	ArithmeticTwapStrategy     = arithmetic
+	GeometricTwapStrategy      = geometric
+	VolumeWeightedTwapStrategy = volumeWeighted
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -32,6 +33,14 @@ func (k Keeper) GetChangedPools(ctx sdk.Context) []uint64 {
 	return k.getChangedPools(ctx)
 }
 
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+func (k Keeper) GetBlockSwapVolume(ctx sdk.Context, poolId uint64, denom0, denom1 string) (osmomath.Int, osmomath.Int) {
+	return k.getBlockSwapVolume(ctx, poolId, denom0, denom1)
+}
+
 func (k Keeper) UpdateRecord(ctx sdk.Context, record types.TwapRecord) (types.TwapRecord, error) {
 	return k.updateRecord(ctx, record)
 }
@@ -60,6 +69,14 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (s volumeWeighted) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func ComputeMedianTwap(startRecord types.TwapRecord, records []types.TwapRecord, startTime time.Time, endTime time.Time, quoteAsset string) osmomath.Dec {
+	return computeMedianTwap(startRecord, records, startTime, endTime, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -0,0 +1,55 @@
+package twap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// HandleSetOracleRoutesProposal sets every route of the proposal,
+// removing the routes that have no hops.
+func (k Keeper) HandleSetOracleRoutesProposal(ctx sdk.Context, p *types.SetOracleRoutesProposal) error {
+	for _, route := range p.Routes {
+		if route.IsRemoval() {
+			k.DeleteOracleRoute(ctx, route.BaseDenom, route.QuoteDenom)
+			continue
+		}
+		if err := k.SetOracleRoute(ctx, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleSetRecordRetentionOverridesProposal sets every record retention override of the proposal,
+// removing the overrides that have a zero keep period.
+func (k Keeper) HandleSetRecordRetentionOverridesProposal(ctx sdk.Context, p *types.SetRecordRetentionOverridesProposal) error {
+	for _, override := range p.Overrides {
+		if override.IsRemoval() {
+			k.DeleteRecordRetentionOverride(ctx, override.PoolId)
+			continue
+		}
+		if err := k.SetRecordRetentionOverride(ctx, override); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewTwapProposalHandler(k Keeper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.SetOracleRoutesProposal:
+			return k.HandleSetOracleRoutesProposal(ctx, c)
+		case *types.SetRecordRetentionOverridesProposal:
+			return k.HandleSetRecordRetentionOverridesProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized twap proposal content type: %T", c)
+		}
+	}
+}
@@ -75,6 +75,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
 	}
+
+	for _, route := range genState.OracleRoutes {
+		k.storeOracleRoute(ctx, route)
+	}
+
+	for _, override := range genState.RetentionOverrides {
+		k.storeRecordRetentionOverride(ctx, override)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -84,9 +92,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	oracleRoutes, err := k.GetAllOracleRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	retentionOverrides, err := k.GetAllRecordRetentionOverrides(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:             k.GetParams(ctx),
+		Twaps:              twapRecords,
+		OracleRoutes:       oracleRoutes,
+		RetentionOverrides: retentionOverrides,
 	}
 }
 
@@ -100,6 +120,25 @@ func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
 
+// GetVolumeWeightedStrategy gets volume weighted TWAP keeper.
+func (k Keeper) GetVolumeWeightedStrategy() *volumeWeighted {
+	return &volumeWeighted{k}
+}
+
+// getTwapStrategy returns the twapStrategy corresponding to the given strategy type.
+func (k Keeper) getTwapStrategy(strategyType types.TwapStrategy) (twapStrategy, error) {
+	switch strategyType {
+	case types.TWAP_STRATEGY_ARITHMETIC:
+		return k.GetArithmeticStrategy(), nil
+	case types.TWAP_STRATEGY_GEOMETRIC:
+		return k.GetGeometricStrategy(), nil
+	case types.TWAP_STRATEGY_VOLUME_WEIGHTED:
+		return k.GetVolumeWeightedStrategy(), nil
+	default:
+		return nil, types.InvalidTwapStrategyError{Strategy: strategyType}
+	}
+}
+
 // GetPruningState gets the current pruning state, which is used to determine
 // whether to prune historical records in the EndBlock. This allows us to spread
 // out the computational cost of pruning over time rather than all at once at epoch.
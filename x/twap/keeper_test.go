@@ -63,6 +63,8 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -85,6 +87,8 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+				Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -97,6 +101,8 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+				Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -112,6 +118,8 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -129,6 +137,8 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+				Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -141,6 +151,8 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+				Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+				Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 			},
 		})
 
@@ -227,6 +239,8 @@ func (s *TestSuite) TestTwapInitGenesis() {
 						P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 						P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 						GeometricTwapAccumulator:    osmomath.OneDec(),
+						Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+						Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 					},
 				}),
 
@@ -280,6 +294,31 @@ func (s *TestSuite) TestTWAPExportGenesis() {
 		"custom multi-record; decreasing": {
 			expectedGenesis: decreasingOrderByTimeRecordsPoolTwo,
 		},
+		"custom genesis with oracle routes": {
+			expectedGenesis: &types.GenesisState{
+				Params: basicParams,
+				Twaps:  []types.TwapRecord{mostRecentRecordPoolOne},
+				OracleRoutes: []types.OracleRoute{
+					{
+						BaseDenom:        denom0,
+						QuoteDenom:       denom1,
+						Hops:             []types.OracleRouteHop{{PoolId: basePoolId, TokenOutDenom: denom1}},
+						TwapWindow:       time.Hour,
+						MaxSpotDeviation: osmomath.NewDecWithPrec(5, 2),
+					},
+				},
+			},
+		},
+		"custom genesis with retention overrides": {
+			expectedGenesis: &types.GenesisState{
+				Params: basicParams,
+				Twaps:  []types.TwapRecord{mostRecentRecordPoolOne},
+				RetentionOverrides: []types.RecordRetentionOverride{
+					{PoolId: basePoolId, KeepPeriod: 720 * time.Hour},
+					{PoolId: basePoolId + 1, KeepPeriod: time.Hour},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -305,6 +344,11 @@ func (s *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			s.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+			s.Require().Len(actualGenesis.OracleRoutes, len(tc.expectedGenesis.OracleRoutes))
+			for i, route := range tc.expectedGenesis.OracleRoutes {
+				s.Require().True(route.Equal(actualGenesis.OracleRoutes[i]))
+			}
+			s.Require().ElementsMatch(tc.expectedGenesis.RetentionOverrides, actualGenesis.RetentionOverrides)
 		})
 	}
 }
@@ -427,6 +471,8 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 }
 
@@ -448,6 +494,8 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -475,6 +523,8 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 }
 
@@ -505,6 +555,8 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum osmoma
 		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 }
 
@@ -517,6 +569,8 @@ func newExpRecord(accum0, accum1, geomAccum osmomath.Dec) types.TwapRecord {
 		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 }
 
@@ -535,6 +589,8 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -559,6 +615,8 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
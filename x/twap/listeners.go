@@ -61,8 +61,10 @@ func (hook *gammhook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddres
 }
 
 // AfterCFMMSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
-func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+// The input includes the spread factor, which is taken out before tracking the swap volume.
+func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
 	hook.k.trackChangedPool(ctx, poolId)
+	hook.k.trackSwapVolume(ctx, poolId, subSpreadFactor(input, spreadFactor), output)
 }
 
 func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
@@ -93,6 +95,8 @@ func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Con
 	l.k.trackChangedPool(ctx, poolId)
 }
 
+// The input excludes the spread factor already, so it is tracked as is.
 func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.k.trackChangedPool(ctx, poolId)
+	l.k.trackSwapVolume(ctx, poolId, input, output)
 }
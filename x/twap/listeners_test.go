@@ -69,6 +69,8 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 					if poolType == poolmanagertypes.Concentrated {
 						expectedRecord.LastErrorTime = s.Ctx.BlockTime()
 					}
+					// the volume swapped in this block is folded into the volume accumulators in EndBlock.
+					expectedRecord.Asset0VolumeAccumulator, expectedRecord.Asset1VolumeAccumulator = s.twapkeeper.GetBlockSwapVolume(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1)
 					expectedRecords = append(expectedRecords, expectedRecord)
 				}
 
@@ -30,6 +30,8 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+		Asset0VolumeAccumulator:     osmomath.ZeroInt(),
+		Asset1VolumeAccumulator:     osmomath.ZeroInt(),
 	}, nil
 }
 
@@ -200,6 +202,13 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newRecord.P1LastSpotPrice = newSp1
 	newRecord.LastErrorTime = lastErrorTime
 
+	// fold the volume swapped within the pair during this block into the volume accumulators.
+	volume0, volume1 := k.consumeBlockSwapVolume(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+	if !volume0.IsZero() || !volume1.IsZero() {
+		newRecord.Asset0VolumeAccumulator = volumeAccumulatorOrZero(record.Asset0VolumeAccumulator).Add(volume0)
+		newRecord.Asset1VolumeAccumulator = volumeAccumulatorOrZero(record.Asset1VolumeAccumulator).Add(volume1)
+	}
+
 	return newRecord, nil
 }
 
@@ -287,12 +296,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := spotPriceErrorInRange(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
@@ -305,6 +309,28 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// spotPriceErrorInRange returns an error if a spot price error occurred
+// between the times of startRecord and endRecord, nil otherwise.
+// if (endRecord.LastErrorTime >= startRecord.Time) returns an error
+// if (startRecord.LastErrorTime == startRecord.Time) returns an error
+func spotPriceErrorInRange(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
+// volumeAccumulatorOrZero returns the given volume accumulator, or zero if it is unset.
+// Records stored before volume tracking was introduced have unset volume accumulators.
+func volumeAccumulatorOrZero(accumulator osmomath.Int) osmomath.Int {
+	if accumulator.IsNil() {
+		return osmomath.ZeroInt()
+	}
+	return accumulator
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
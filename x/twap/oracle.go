@@ -0,0 +1,152 @@
+package twap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// GetOraclePrice returns the price of baseDenom in units of quoteDenom, as determined by the
+// TWAPs of the pools of the governance registered oracle route for (baseDenom, quoteDenom).
+// The price of a multi-hop route is the product of the TWAPs of every hop, where every TWAP is
+// computed over the route's twap window, ending at the current block time.
+//
+// This function will error if:
+// * no oracle route is registered for (baseDenom, quoteDenom)
+// * the oracle price is unsafe to use, in which case a types.OracleUnsafeError is returned. Namely, if:
+//   - the TWAP of any hop cannot be computed, e.g. because the twap window is older than the kept records
+//   - the most recent record of any hop is older than the route's max staleness
+//   - the price deviates from the price of the route using current spot prices by more than the route's max spot deviation
+func (k Keeper) GetOraclePrice(ctx sdk.Context, baseDenom, quoteDenom string) (osmomath.Dec, error) {
+	route, err := k.GetOracleRoute(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	price, err := k.getOracleRoutePrice(ctx, route)
+	if err != nil {
+		return osmomath.Dec{}, types.OracleUnsafeError{BaseDenom: baseDenom, QuoteDenom: quoteDenom, Err: err}
+	}
+	return price, nil
+}
+
+// getOracleRoutePrice computes the price of the route, checking its staleness and deviation from spot price.
+func (k Keeper) getOracleRoutePrice(ctx sdk.Context, route types.OracleRoute) (osmomath.Dec, error) {
+	strategy, err := k.getTwapStrategy(route.Strategy)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	startTime := ctx.BlockTime().Add(-route.TwapWindow)
+
+	price := osmomath.OneDec()
+	spotPrice := osmomath.OneBigDec()
+	tokenInDenom := route.BaseDenom
+	for _, hop := range route.Hops {
+		if route.MaxStaleness > 0 {
+			record, err := k.getMostRecentRecordStoreRepresentation(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+			if err != nil {
+				return osmomath.Dec{}, err
+			}
+			if ctx.BlockTime().Sub(record.Time) > route.MaxStaleness {
+				return osmomath.Dec{}, types.OracleStalePriceError{PoolId: hop.PoolId, LastUpdateTime: record.Time, MaxStaleness: route.MaxStaleness}
+			}
+		}
+
+		twap, err := k.getTwapToNow(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom, startTime, strategy)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		price = price.MulMut(twap)
+
+		if route.MaxSpotDeviation.IsPositive() {
+			hopSpotPrice, err := k.poolmanagerKeeper.RouteCalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, tokenInDenom)
+			if err != nil {
+				return osmomath.Dec{}, err
+			}
+			spotPrice = spotPrice.MulMut(hopSpotPrice)
+		}
+
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	if !price.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("price must be positive, was (%s)", price)
+	}
+
+	if route.MaxSpotDeviation.IsPositive() {
+		spotPriceDec := spotPrice.Dec()
+		deviation := price.Sub(spotPriceDec).Abs().Quo(price)
+		if deviation.GT(route.MaxSpotDeviation) {
+			return osmomath.Dec{}, types.OracleSpotDeviationError{Price: price, SpotPrice: spotPriceDec, MaxSpotDeviation: route.MaxSpotDeviation}
+		}
+	}
+
+	return price, nil
+}
+
+// GetOracleRoute returns the oracle route pricing baseDenom in quoteDenom.
+// Returns types.OracleRouteNotFoundError if no such route is registered.
+func (k Keeper) GetOracleRoute(ctx sdk.Context, baseDenom, quoteDenom string) (types.OracleRoute, error) {
+	route := types.OracleRoute{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatOracleRouteKey(baseDenom, quoteDenom), &route)
+	if err != nil {
+		return types.OracleRoute{}, err
+	}
+	if !found {
+		return types.OracleRoute{}, types.OracleRouteNotFoundError{BaseDenom: baseDenom, QuoteDenom: quoteDenom}
+	}
+	return route, nil
+}
+
+// GetAllOracleRoutes returns all registered oracle routes.
+func (k Keeper) GetAllOracleRoutes(ctx sdk.Context) ([]types.OracleRoute, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.OracleRoutePrefix), parseOracleRouteFromBz)
+}
+
+// SetOracleRoute validates the given route against the current state of its pools and stores it,
+// replacing any existing route for its (base denom, quote denom) pair.
+// Every hop's pool must contain both the token in and token out denom of the hop.
+func (k Keeper) SetOracleRoute(ctx sdk.Context, route types.OracleRoute) error {
+	if err := route.Validate(); err != nil {
+		return err
+	}
+
+	tokenInDenom := route.BaseDenom
+	for _, hop := range route.Hops {
+		denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(denoms, tokenInDenom) || !slices.Contains(denoms, hop.TokenOutDenom) {
+			return fmt.Errorf("oracle route hop pool %d does not contain both %s and %s", hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		}
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	k.storeOracleRoute(ctx, route)
+	return nil
+}
+
+// DeleteOracleRoute removes the oracle route pricing baseDenom in quoteDenom, if any.
+func (k Keeper) DeleteOracleRoute(ctx sdk.Context, baseDenom, quoteDenom string) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatOracleRouteKey(baseDenom, quoteDenom))
+}
+
+func (k Keeper) storeOracleRoute(ctx sdk.Context, route types.OracleRoute) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatOracleRouteKey(route.BaseDenom, route.QuoteDenom), &route)
+}
+
+func parseOracleRouteFromBz(bz []byte) (types.OracleRoute, error) {
+	if len(bz) == 0 {
+		return types.OracleRoute{}, errors.New("oracle route not found")
+	}
+	route := types.OracleRoute{}
+	err := route.Unmarshal(bz)
+	return route, err
+}
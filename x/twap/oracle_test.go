@@ -0,0 +1,183 @@
+package twap_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v26/x/twap"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// setupOracleRoute creates two pools, pricing denom0 at 4 denom1 and denom1 at 2 denom2,
+// and returns a route pricing denom0 in denom2 through both of them.
+func (s *TestSuite) setupOracleRoute() types.OracleRoute {
+	poolIdA := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 4_000_000_000))
+	poolIdB := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom1, 1_000_000_000), sdk.NewInt64Coin(denom2, 2_000_000_000))
+	return types.OracleRoute{
+		BaseDenom:  denom0,
+		QuoteDenom: denom2,
+		Hops: []types.OracleRouteHop{
+			{PoolId: poolIdA, TokenOutDenom: denom1},
+			{PoolId: poolIdB, TokenOutDenom: denom2},
+		},
+		TwapWindow:       30 * time.Minute,
+		Strategy:         types.TWAP_STRATEGY_ARITHMETIC,
+		MaxSpotDeviation: osmomath.ZeroDec(),
+	}
+}
+
+func (s *TestSuite) TestGetOraclePrice() {
+	tests := map[string]struct {
+		updateRoute   func(route *types.OracleRoute)
+		timeElapsed   time.Duration
+		swapIn        sdk.Coin
+		expPrice      osmomath.Dec
+		expectedError error
+	}{
+		"multi-hop route": {
+			timeElapsed: time.Hour,
+			expPrice:    osmomath.NewDec(8),
+		},
+		"geometric strategy": {
+			updateRoute: func(route *types.OracleRoute) { route.Strategy = types.TWAP_STRATEGY_GEOMETRIC },
+			timeElapsed: time.Hour,
+			expPrice:    osmomath.NewDec(8),
+		},
+		"within max staleness": {
+			updateRoute: func(route *types.OracleRoute) { route.MaxStaleness = time.Hour },
+			timeElapsed: time.Hour,
+			expPrice:    osmomath.NewDec(8),
+		},
+		"within max spot deviation": {
+			updateRoute: func(route *types.OracleRoute) { route.MaxSpotDeviation = osmomath.NewDecWithPrec(5, 2) },
+			timeElapsed: time.Hour,
+			expPrice:    osmomath.NewDec(8),
+		},
+
+		// error catching
+		"twap window older than pool creation": {
+			timeElapsed: 10 * time.Minute,
+			expectedError: types.OracleUnsafeError{BaseDenom: denom0, QuoteDenom: denom2,
+				Err: twap.TimeTooOldError{Time: baseTime.Add(-20 * time.Minute)}},
+		},
+		"stale price": {
+			updateRoute: func(route *types.OracleRoute) { route.MaxStaleness = 10 * time.Minute },
+			timeElapsed: time.Hour,
+			expectedError: types.OracleUnsafeError{BaseDenom: denom0, QuoteDenom: denom2,
+				Err: types.OracleStalePriceError{PoolId: 1, LastUpdateTime: baseTime, MaxStaleness: 10 * time.Minute}},
+		},
+		"spot price moved away from twap in current block": {
+			updateRoute: func(route *types.OracleRoute) { route.MaxSpotDeviation = osmomath.NewDecWithPrec(5, 2) },
+			timeElapsed: time.Hour,
+			swapIn:      sdk.NewInt64Coin(denom0, 500_000_000),
+			expectedError: types.OracleUnsafeError{BaseDenom: denom0, QuoteDenom: denom2,
+				Err: types.OracleSpotDeviationError{}},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			route := s.setupOracleRoute()
+			if test.updateRoute != nil {
+				test.updateRoute(&route)
+			}
+			s.Require().NoError(s.twapkeeper.SetOracleRoute(s.Ctx, route))
+			s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(test.timeElapsed))
+
+			if !test.swapIn.IsNil() {
+				s.FundAcc(s.TestAccs[0], sdk.NewCoins(test.swapIn))
+				_, err := s.App.PoolManagerKeeper.SwapExactAmountInNoTakerFee(s.Ctx, s.TestAccs[0], route.Hops[0].PoolId, test.swapIn, denom1, osmomath.OneInt())
+				s.Require().NoError(err)
+			}
+
+			price, err := s.twapkeeper.GetOraclePrice(s.Ctx, denom0, denom2)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &types.OracleUnsafeError{})
+				if _, isDeviation := test.expectedError.(types.OracleUnsafeError).Err.(types.OracleSpotDeviationError); isDeviation {
+					s.Require().ErrorAs(err, &types.OracleSpotDeviationError{})
+					return
+				}
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expPrice, price)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetOraclePrice_RouteNotFound() {
+	s.SetupTest()
+	route := s.setupOracleRoute()
+	s.Require().NoError(s.twapkeeper.SetOracleRoute(s.Ctx, route))
+
+	// routes are directional
+	_, err := s.twapkeeper.GetOraclePrice(s.Ctx, denom2, denom0)
+	s.Require().Equal(types.OracleRouteNotFoundError{BaseDenom: denom2, QuoteDenom: denom0}, err)
+}
+
+func (s *TestSuite) TestSetOracleRoute() {
+	s.SetupTest()
+	route := s.setupOracleRoute()
+
+	// second hop swapped to a pool without denom1
+	invalidRoute := route
+	invalidRoute.Hops = []types.OracleRouteHop{route.Hops[0], {PoolId: s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)), TokenOutDenom: denom2}}
+	s.Require().Error(s.twapkeeper.SetOracleRoute(s.Ctx, invalidRoute))
+
+	// non-existent pool
+	invalidRoute.Hops = []types.OracleRouteHop{route.Hops[0], {PoolId: 100, TokenOutDenom: denom2}}
+	s.Require().Error(s.twapkeeper.SetOracleRoute(s.Ctx, invalidRoute))
+
+	// stateless validation
+	invalidRoute = route
+	invalidRoute.TwapWindow = 0
+	s.Require().Error(s.twapkeeper.SetOracleRoute(s.Ctx, invalidRoute))
+
+	routes, err := s.twapkeeper.GetAllOracleRoutes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(routes)
+
+	s.Require().NoError(s.twapkeeper.SetOracleRoute(s.Ctx, route))
+	storedRoute, err := s.twapkeeper.GetOracleRoute(s.Ctx, denom0, denom2)
+	s.Require().NoError(err)
+	s.Require().Equal(route, storedRoute)
+}
+
+func (s *TestSuite) TestHandleSetOracleRoutesProposal() {
+	s.SetupTest()
+	route := s.setupOracleRoute()
+	directRoute := types.OracleRoute{
+		BaseDenom:        denom1,
+		QuoteDenom:       denom2,
+		Hops:             route.Hops[1:],
+		TwapWindow:       time.Hour,
+		MaxSpotDeviation: osmomath.ZeroDec(),
+	}
+
+	handler := twap.NewTwapProposalHandler(*s.twapkeeper)
+	err := handler(s.Ctx, types.NewSetOracleRoutesProposal("title", "description", []types.OracleRoute{route, directRoute}))
+	s.Require().NoError(err)
+
+	routes, err := s.twapkeeper.GetAllOracleRoutes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.OracleRoute{route, directRoute}, routes)
+
+	// a route without hops removes the route of its pair
+	err = handler(s.Ctx, types.NewSetOracleRoutesProposal("title", "description", []types.OracleRoute{{BaseDenom: denom0, QuoteDenom: denom2}}))
+	s.Require().NoError(err)
+
+	routes, err = s.twapkeeper.GetAllOracleRoutes(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.OracleRoute{directRoute}, routes)
+
+	// the proposal fails if any of its routes is invalid
+	invalidRoute := directRoute
+	invalidRoute.Hops = []types.OracleRouteHop{{PoolId: route.Hops[0].PoolId, TokenOutDenom: denom2}}
+	err = handler(s.Ctx, types.NewSetOracleRoutesProposal("title", "description", []types.OracleRoute{invalidRoute}))
+	s.Require().Error(err)
+}
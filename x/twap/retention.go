@@ -0,0 +1,75 @@
+package twap
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+// GetRecordRetentionOverride returns the record retention override of pool `poolId`,
+// and whether the pool has one.
+func (k Keeper) GetRecordRetentionOverride(ctx sdk.Context, poolId uint64) (types.RecordRetentionOverride, bool, error) {
+	override := types.RecordRetentionOverride{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatRetentionOverrideKey(poolId), &override)
+	if err != nil {
+		return types.RecordRetentionOverride{}, false, err
+	}
+	return override, found, nil
+}
+
+// GetAllRecordRetentionOverrides returns all record retention overrides, sorted by pool id.
+func (k Keeper) GetAllRecordRetentionOverrides(ctx sdk.Context) ([]types.RecordRetentionOverride, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.RetentionOverridePrefix), parseRecordRetentionOverrideFromBz)
+}
+
+// SetRecordRetentionOverride validates the given override and stores it,
+// replacing any existing override of its pool. The pool must exist.
+func (k Keeper) SetRecordRetentionOverride(ctx sdk.Context, override types.RecordRetentionOverride) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, override.PoolId); err != nil {
+		return err
+	}
+
+	k.storeRecordRetentionOverride(ctx, override)
+	return nil
+}
+
+// DeleteRecordRetentionOverride removes the record retention override of pool `poolId`, if any.
+func (k Keeper) DeleteRecordRetentionOverride(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatRetentionOverrideKey(poolId))
+}
+
+// getPoolLastKeptTime returns the time before which the records of pool `poolId` are pruned.
+// The pruning state's last kept time is computed from the record history keep period param,
+// so for a pool with a retention override, it is shifted by the difference between the param
+// and the override's keep period.
+func (k Keeper) getPoolLastKeptTime(ctx sdk.Context, state types.PruningState, poolId uint64) (time.Time, error) {
+	override, found, err := k.GetRecordRetentionOverride(ctx, poolId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !found {
+		return state.LastKeptTime, nil
+	}
+	return state.LastKeptTime.Add(k.RecordHistoryKeepPeriod(ctx) - override.KeepPeriod), nil
+}
+
+func (k Keeper) storeRecordRetentionOverride(ctx sdk.Context, override types.RecordRetentionOverride) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatRetentionOverrideKey(override.PoolId), &override)
+}
+
+func parseRecordRetentionOverrideFromBz(bz []byte) (types.RecordRetentionOverride, error) {
+	if len(bz) == 0 {
+		return types.RecordRetentionOverride{}, errors.New("record retention override not found")
+	}
+	override := types.RecordRetentionOverride{}
+	err := override.Unmarshal(bz)
+	return override, err
+}
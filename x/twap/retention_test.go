@@ -0,0 +1,63 @@
+package twap_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v26/x/twap"
+	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
+)
+
+func (s *TestSuite) TestSetRecordRetentionOverride() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPool()
+	override := types.RecordRetentionOverride{PoolId: poolId, KeepPeriod: 720 * time.Hour}
+
+	// non-existent pool
+	s.Require().Error(s.twapkeeper.SetRecordRetentionOverride(s.Ctx, types.RecordRetentionOverride{PoolId: poolId + 1, KeepPeriod: time.Hour}))
+
+	// stateless validation
+	s.Require().Error(s.twapkeeper.SetRecordRetentionOverride(s.Ctx, types.RecordRetentionOverride{PoolId: poolId}))
+
+	_, found, err := s.twapkeeper.GetRecordRetentionOverride(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	s.Require().NoError(s.twapkeeper.SetRecordRetentionOverride(s.Ctx, override))
+	storedOverride, found, err := s.twapkeeper.GetRecordRetentionOverride(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(override, storedOverride)
+
+	s.twapkeeper.DeleteRecordRetentionOverride(s.Ctx, poolId)
+	_, found, err = s.twapkeeper.GetRecordRetentionOverride(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestHandleSetRecordRetentionOverridesProposal() {
+	s.SetupTest()
+	poolIdA := s.PrepareBalancerPool()
+	poolIdB := s.PrepareBalancerPool()
+	overrideA := types.RecordRetentionOverride{PoolId: poolIdA, KeepPeriod: 720 * time.Hour}
+	overrideB := types.RecordRetentionOverride{PoolId: poolIdB, KeepPeriod: time.Hour}
+
+	handler := twap.NewTwapProposalHandler(*s.twapkeeper)
+	err := handler(s.Ctx, types.NewSetRecordRetentionOverridesProposal("title", "description", []types.RecordRetentionOverride{overrideB, overrideA}))
+	s.Require().NoError(err)
+
+	overrides, err := s.twapkeeper.GetAllRecordRetentionOverrides(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.RecordRetentionOverride{overrideA, overrideB}, overrides)
+
+	// an override with a zero keep period removes the override of its pool
+	err = handler(s.Ctx, types.NewSetRecordRetentionOverridesProposal("title", "description", []types.RecordRetentionOverride{{PoolId: poolIdA}}))
+	s.Require().NoError(err)
+
+	overrides, err = s.twapkeeper.GetAllRecordRetentionOverrides(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.RecordRetentionOverride{overrideB}, overrides)
+
+	// the proposal fails if any of its overrides is for a non-existent pool
+	err = handler(s.Ctx, types.NewSetRecordRetentionOverridesProposal("title", "description", []types.RecordRetentionOverride{{PoolId: poolIdB + 1, KeepPeriod: time.Hour}}))
+	s.Require().Error(err)
+}
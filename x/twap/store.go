@@ -7,8 +7,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 )
@@ -44,7 +46,7 @@ var sentinelExistsValue = []byte{1}
 // to track that this pool changed this block.
 // This tracking is for use in EndBlock, to create new TWAP records.
 func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolsTransientPrefix)
 	poolIdBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(poolIdBz, poolId)
 
@@ -55,7 +57,7 @@ func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
 func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolsTransientPrefix)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -68,6 +70,76 @@ func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
 	return alteredPoolIds
 }
 
+// trackSwapVolume adds the amounts of a swap in pool `poolId` to the per-pair
+// swap volume of the current block, kept in the transient store.
+// The input must be net of the spread factor, so that the tracked amounts
+// are the ones exchanged at the pool's price.
+// The tracked volume is folded into the volume accumulators of the pair's record
+// in EndBlock, when the record is updated.
+// No-ops if the swap does not consist of exactly one input and one output coin.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	if len(input) != 1 || len(output) != 1 {
+		return
+	}
+	denom0, denom1, err := types.LexicographicalOrderDenoms(input[0].Denom, output[0].Denom)
+	if err != nil {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	for _, coin := range []sdk.Coin{input[0], output[0]} {
+		key := types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, coin.Denom)
+		volume := getTransientInt(store, key).Add(coin.Amount)
+		bz, err := volume.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
+
+// subSpreadFactor returns the input of a swap without the given spread factor.
+func subSpreadFactor(input sdk.Coins, spreadFactor osmomath.Dec) sdk.Coins {
+	netInput := make(sdk.Coins, len(input))
+	for i, coin := range input {
+		netInput[i] = sdk.NewCoin(coin.Denom, osmomath.OneDec().Sub(spreadFactor).MulInt(coin.Amount).TruncateInt())
+	}
+	return netInput
+}
+
+// getBlockSwapVolume returns the amounts of denom0 and denom1 swapped against each other
+// in pool `poolId` during the current block.
+func (k Keeper) getBlockSwapVolume(ctx sdk.Context, poolId uint64, denom0, denom1 string) (volume0 osmomath.Int, volume1 osmomath.Int) {
+	store := ctx.TransientStore(k.transientKey)
+	volume0 = getTransientInt(store, types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom0))
+	volume1 = getTransientInt(store, types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom1))
+	return volume0, volume1
+}
+
+// consumeBlockSwapVolume returns the amounts of denom0 and denom1 swapped against each other
+// in pool `poolId` during the current block, and resets them to zero.
+// This guarantees that the volume of a block is folded into the volume accumulators only once.
+func (k Keeper) consumeBlockSwapVolume(ctx sdk.Context, poolId uint64, denom0, denom1 string) (volume0 osmomath.Int, volume1 osmomath.Int) {
+	volume0, volume1 = k.getBlockSwapVolume(ctx, poolId, denom0, denom1)
+	store := ctx.TransientStore(k.transientKey)
+	store.Delete(types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom0))
+	store.Delete(types.FormatSwapVolumeTransientKey(poolId, denom0, denom1, denom1))
+	return volume0, volume1
+}
+
+// getTransientInt returns the integer stored at key, or zero if there is none.
+func getTransientInt(store storetypes.KVStore, key []byte) osmomath.Int {
+	bz := store.Get(key)
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+	value := osmomath.Int{}
+	if err := value.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return value
+}
+
 // storeHistoricalTWAP writes a twap to the store, indexed by pool id.
 func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
@@ -89,6 +161,9 @@ func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
 //
+// Pools with a record retention override are pruned by the override's keep period instead of the
+// RecordHistoryKeepPeriod param (see getPoolLastKeptTime).
+//
 // If we reach the per block pruning limit, we store the last key seen in the pruning state.
 // This is so that we can continue pruning from where we left off in the next block.
 // If we have pruned all records, we set the pruning state to not pruning.
@@ -104,6 +179,11 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.Pru
 			return err
 		}
 
+		lastKeptTime, err := k.getPoolLastKeptTime(ctx, state, poolId)
+		if err != nil {
+			return err
+		}
+
 		// Notice, if we hit the prune limit in the middle of a pool, we will re-iterate over the completed pruned pool records.
 		// This is acceptable overhead for the simplification this provides.
 		denomPairs := types.GetAllUniqueDenomPairs(denoms)
@@ -113,7 +193,7 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, state types.Pru
 			// lastKeptTime exclusively down to the oldest record.
 			iter := store.ReverseIterator(
 				types.FormatHistoricalPoolIndexDenomPairTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1),
-				types.FormatHistoricalPoolIndexTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1, state.LastKeptTime))
+				types.FormatHistoricalPoolIndexTWAPKey(poolId, denomPair.Denom0, denomPair.Denom1, lastKeptTime))
 			defer iter.Close()
 
 			firstIteration := true
@@ -269,6 +349,36 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 	return twap, nil
 }
 
+// getRecordsAfterTimeUntil returns all historical records for (id, asset0, asset1)
+// with a time t' such that startTime < t' <= endTime, sorted by time.
+// Returns an error without reading further if there are more than maxRecords such records.
+func (k Keeper) getRecordsAfterTimeUntil(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string, maxRecords int) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return []types.TwapRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	// time suffixed keys sort right after the key of the record at that exact time,
+	// which excludes a record at startTime and includes a record at endTime.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, endTime)
+	iter := store.Iterator(startKey, endKey)
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		if len(records) == maxRecords {
+			return []types.TwapRecord{}, types.TooManyRecordsError{Max: maxRecords}
+		}
+		record, err := types.ParseTwapFromBz(iter.Value())
+		if err != nil {
+			return []types.TwapRecord{}, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
 // DeleteHistoricalTimeIndexedTWAPs deletes every historical twap record indexed by time (now deprecated) up till the limit.
 // This is to be used in the upgrade handler, to clear out the now-obsolete historical twap records
 // that were indexed by time.
@@ -58,6 +58,97 @@ func (s *TestSuite) TestTrackChangedPool() {
 	}
 }
 
+// TestTrackSwapVolume tracks a list of swaps within one block, and checks that
+// the swapped amounts are accumulated per pool and denom pair.
+// Also checks that tracked swaps do not register as changed pools by themselves.
+func (s *TestSuite) TestTrackSwapVolume() {
+	type swap struct {
+		poolId uint64
+		input  sdk.Coins
+		output sdk.Coins
+	}
+	newSwap := func(poolId uint64, input, output sdk.Coin) swap {
+		return swap{poolId: poolId, input: sdk.NewCoins(input), output: sdk.NewCoins(output)}
+	}
+	tests := map[string]struct {
+		swaps     []swap
+		poolId    uint64
+		denom0    string
+		denom1    string
+		expVolume [2]int64
+	}{
+		"single swap": {
+			swaps:     []swap{newSwap(1, sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom1, 50))},
+			poolId:    1,
+			denom0:    denom0,
+			denom1:    denom1,
+			expVolume: [2]int64{100, 50},
+		},
+		"swaps in both directions are summed": {
+			swaps: []swap{
+				newSwap(1, sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom1, 50)),
+				newSwap(1, sdk.NewInt64Coin(denom1, 20), sdk.NewInt64Coin(denom0, 30)),
+			},
+			poolId:    1,
+			denom0:    denom0,
+			denom1:    denom1,
+			expVolume: [2]int64{130, 70},
+		},
+		"swaps in other pools and pairs are not accounted for": {
+			swaps: []swap{
+				newSwap(1, sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom1, 50)),
+				newSwap(2, sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom1, 50)),
+				newSwap(1, sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom2, 50)),
+			},
+			poolId:    1,
+			denom0:    denom0,
+			denom1:    denom1,
+			expVolume: [2]int64{100, 50},
+		},
+		"swaps with more than one coin in or out are ignored": {
+			swaps: []swap{
+				{poolId: 1, input: sdk.NewCoins(sdk.NewInt64Coin(denom0, 100), sdk.NewInt64Coin(denom2, 100)), output: sdk.NewCoins(sdk.NewInt64Coin(denom1, 50))},
+			},
+			poolId:    1,
+			denom0:    denom0,
+			denom1:    denom1,
+			expVolume: [2]int64{0, 0},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, swap := range test.swaps {
+				s.twapkeeper.TrackSwapVolume(s.Ctx, swap.poolId, swap.input, swap.output)
+			}
+
+			volume0, volume1 := s.twapkeeper.GetBlockSwapVolume(s.Ctx, test.poolId, test.denom0, test.denom1)
+			s.Require().Equal(osmomath.NewInt(test.expVolume[0]), volume0)
+			s.Require().Equal(osmomath.NewInt(test.expVolume[1]), volume1)
+			s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+		})
+	}
+}
+
+// TestSwapListenersTrackSwapVolume tests that the gamm hook tracks the amounts in
+// net of the spread factor it is given, and that the concentrated liquidity listener,
+// whose amounts in already exclude the spread factor, tracks them as they are.
+func (s *TestSuite) TestSwapListenersTrackSwapVolume() {
+	input := sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000))
+	output := sdk.NewCoins(sdk.NewInt64Coin(denom1, 500))
+
+	s.SetupTest()
+	s.twapkeeper.GammHooks().AfterCFMMSwap(s.Ctx, s.TestAccs[0], 1, input, output, osmomath.NewDecWithPrec(1, 2))
+	s.twapkeeper.ConcentratedLiquidityListener().AfterConcentratedPoolSwap(s.Ctx, s.TestAccs[0], 2, input, output)
+
+	volume0, volume1 := s.twapkeeper.GetBlockSwapVolume(s.Ctx, 1, denom0, denom1)
+	s.Require().Equal(osmomath.NewInt(990), volume0)
+	s.Require().Equal(osmomath.NewInt(500), volume1)
+	volume0, volume1 = s.twapkeeper.GetBlockSwapVolume(s.Ctx, 2, denom0, denom1)
+	s.Require().Equal(osmomath.NewInt(1000), volume0)
+	s.Require().Equal(osmomath.NewInt(500), volume1)
+}
+
 // TestGetAllMostRecentRecordsForPool takes a list of records as test cases,
 // and runs storeNewRecord for everything in sequence.
 // Then it runs GetAllMostRecentRecordsForPool, and sees if its equal to expected
@@ -368,6 +459,8 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 
 		lastKeptTime time.Time
 
+		retentionOverrides []types.RecordRetentionOverride
+
 		expectedKeptRecords []types.TwapRecord
 
 		overwriteLimit uint16
@@ -558,6 +651,38 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 
 			overwriteLimit: 9, // 5 total records in queue to be deleted due to limit
 		},
+		"base time; across pool 3; 4 records; retention override longer than keep period by 2ms; none pruned": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin1Ms, // base time - 1ms; kept since older than pool's lastKeptTime
+				pool3BaseSecBaseMs, // base time; kept since older than pool's lastKeptTime
+				pool3BaseSecMin3Ms, // base time - 3ms; kept since newest before pool's lastKeptTime
+				pool3BaseSecMin2Ms, // base time - 2ms; kept since at pool's lastKeptTime
+			},
+
+			lastKeptTime: baseTime,
+
+			retentionOverrides: []types.RecordRetentionOverride{{PoolId: pool3BaseSecBaseMs.PoolId, KeepPeriod: types.DefaultParams().RecordHistoryKeepPeriod + 2*time.Millisecond}},
+
+			expectedKeptRecords: []types.TwapRecord{pool3BaseSecMin3Ms, pool3BaseSecMin2Ms, pool3BaseSecMin1Ms, pool3BaseSecBaseMs},
+		},
+		"base time; across pools 3 and 4; 8 records; retention override of pool 3 shorter than keep period by 1s; pool 4 pruned by keep period": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin1Ms, // base time - 1ms; deleted since pool 3's lastKeptTime is base time + 1s
+				pool3BaseSecBaseMs, // base time; kept since newest before pool 3's lastKeptTime
+				pool3BaseSecMin3Ms, // base time - 3ms; deleted
+				pool3BaseSecMin2Ms, // base time - 2ms; deleted
+				pool4Plus1SMin3Ms,  // base time + 1s - 3ms; kept since older than lastKeptTime
+				pool4Plus1SMin2Ms,  // base time + 1s - 2ms; kept since older than lastKeptTime
+				pool4Plus1SMin1Ms,  // base time + 1s - 1ms; kept since older than lastKeptTime
+				pool4Plus1SBaseMs,  // base time + 1s; kept since older than lastKeptTime
+			},
+
+			lastKeptTime: baseTime,
+
+			retentionOverrides: []types.RecordRetentionOverride{{PoolId: pool3BaseSecBaseMs.PoolId, KeepPeriod: types.DefaultParams().RecordHistoryKeepPeriod - time.Second}},
+
+			expectedKeptRecords: []types.TwapRecord{pool3BaseSecBaseMs, pool4Plus1SMin3Ms, pool4Plus1SMin2Ms, pool4Plus1SMin1Ms, pool4Plus1SBaseMs},
+		},
 	}
 	for name, tc := range tests {
 		s.Run(name, func() {
@@ -567,6 +692,10 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 
 			s.preSetRecords(tc.recordsToPreSet)
 
+			for _, override := range tc.retentionOverrides {
+				s.Require().NoError(s.twapkeeper.SetRecordRetentionOverride(s.Ctx, override))
+			}
+
 			twapKeeper := s.twapkeeper
 
 			if tc.overwriteLimit != 0 {
@@ -1,6 +1,10 @@
 package twap
 
 import (
+	"slices"
+	"sort"
+	"time"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v26/x/twap/types"
 
@@ -8,7 +12,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and volume weighted.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -23,6 +27,10 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type volumeWeighted struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -70,3 +78,90 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a volume weighted TWAP between
+// two records given the quote asset.
+// The volume weighted price is the amount of quote asset swapped divided by the
+// amount of base asset swapped between the two records, i.e. the average execution
+// price of all swaps in the pair, excluding spread fees. Moving it requires trading the corresponding volume,
+// which makes it harder to manipulate than a TWAP in pools with thin liquidity.
+//
+// If nothing was swapped in the pair between the two records, the price was not moved by swaps,
+// so we fall back to the arithmetic TWAP.
+func (s *volumeWeighted) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	volume0Diff := volumeAccumulatorOrZero(endRecord.Asset0VolumeAccumulator).Sub(volumeAccumulatorOrZero(startRecord.Asset0VolumeAccumulator))
+	volume1Diff := volumeAccumulatorOrZero(endRecord.Asset1VolumeAccumulator).Sub(volumeAccumulatorOrZero(startRecord.Asset1VolumeAccumulator))
+
+	if !volume0Diff.IsPositive() || !volume1Diff.IsPositive() {
+		arithmeticStrategy := &arithmetic{s.TwapKeeper}
+		return arithmeticStrategy.computeTwap(startRecord, endRecord, quoteAsset)
+	}
+
+	var result osmomath.Dec
+	if quoteAsset == startRecord.Asset0Denom {
+		result = osmomath.NewDecFromInt(volume0Diff).QuoInt(volume1Diff)
+	} else {
+		result = osmomath.NewDecFromInt(volume1Diff).QuoInt(volume0Diff)
+	}
+
+	// N.B. we round to the same number of significant figures as the spot price,
+	// like the geometric twap.
+	return osmomath.SigFigRound(result, gammtypes.SpotPriceSigFigs)
+}
+
+// priceDuration is a spot price along with the duration it was the pool's spot price for.
+type priceDuration struct {
+	price    osmomath.Dec
+	duration time.Duration
+}
+
+// computeMedianTwap computes and returns the time weighted median of the spot price
+// over (startTime, endTime) given the quote asset.
+// startRecord must be the record at or before startTime and records must be all records
+// after startTime, up to and including endTime, sorted by time.
+// The last spot price of every record is treated as the pool's spot price from the
+// record's time until the time of the next record.
+// If startTime equals endTime, returns the last spot price of the start record.
+//
+// A median cannot be computed from the accumulators of two records,
+// which is why it is not implemented as a twapStrategy.
+func computeMedianTwap(startRecord types.TwapRecord, records []types.TwapRecord, startTime time.Time, endTime time.Time, quoteAsset string) osmomath.Dec {
+	lastSpotPrice := func(record types.TwapRecord) osmomath.Dec {
+		if quoteAsset == record.Asset0Denom {
+			return record.P0LastSpotPrice
+		}
+		return record.P1LastSpotPrice
+	}
+
+	priceDurations := make([]priceDuration, 0, len(records)+1)
+	totalDuration := time.Duration(0)
+	currentRecord, currentTime := startRecord, startTime
+	// records is cloned so that appending the end time does not write to the caller's slice.
+	for _, record := range append(slices.Clone(records), types.TwapRecord{Time: endTime}) {
+		duration := record.Time.Sub(currentTime)
+		if duration > 0 {
+			priceDurations = append(priceDurations, priceDuration{price: lastSpotPrice(currentRecord), duration: duration})
+			totalDuration += duration
+		}
+		currentRecord, currentTime = record, record.Time
+	}
+
+	if totalDuration == 0 {
+		return lastSpotPrice(startRecord)
+	}
+
+	sort.SliceStable(priceDurations, func(i, j int) bool {
+		return priceDurations[i].price.LT(priceDurations[j].price)
+	})
+
+	// the median is the first price at which the cumulative duration
+	// covers at least half of the total duration.
+	cumulativeDuration := time.Duration(0)
+	for _, priceDuration := range priceDurations {
+		cumulativeDuration += priceDuration.duration
+		if 2*cumulativeDuration >= totalDuration {
+			return priceDuration.price
+		}
+	}
+	return priceDurations[len(priceDurations)-1].price
+}
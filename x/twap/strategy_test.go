@@ -375,3 +375,162 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeVolumeWeightedStrategyTwap tests volume weighted strategy's computeTwap.
+// The twap is the ratio of the volume accumulator deltas, rounded to the significant
+// figures of the spot price, falling back to the arithmetic twap if nothing was swapped
+// between the two records.
+func (s *TestSuite) TestComputeVolumeWeightedStrategyTwap() {
+	withVolumes := func(record types.TwapRecord, volume0, volume1 int64) types.TwapRecord {
+		record.Asset0VolumeAccumulator = osmomath.NewInt(volume0)
+		record.Asset1VolumeAccumulator = osmomath.NewInt(volume1)
+		return record
+	}
+
+	tests := map[string]computeTwapTestCase{
+		"quote asset 0: 200 of asset 0 swapped against 100 of asset 1": {
+			startRecord: withVolumes(newOneSidedRecord(baseTime, osmomath.ZeroDec(), true), 0, 0),
+			endRecord:   withVolumes(newOneSidedRecord(tPlusOne, OneSec, true), 200, 100),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDec(2),
+		},
+		"quote asset 1: 200 of asset 0 swapped against 100 of asset 1": {
+			startRecord: withVolumes(newOneSidedRecord(baseTime, osmomath.ZeroDec(), true), 0, 0),
+			endRecord:   withVolumes(newOneSidedRecord(tPlusOne, OneSec, true), 200, 100),
+			quoteAsset:  denom1,
+			expTwap:     osmomath.NewDecWithPrec(5, 1),
+		},
+		"rounded to the significant figures of the spot price": {
+			startRecord: withVolumes(newOneSidedRecord(baseTime, osmomath.ZeroDec(), true), 0, 0),
+			endRecord:   withVolumes(newOneSidedRecord(tPlusOne, OneSec, true), 100, 300),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.MustNewDecFromStr("0.33333333"),
+		},
+		"non-zero start volume: only the delta is accounted for": {
+			startRecord: withVolumes(newOneSidedRecord(baseTime, osmomath.ZeroDec(), true), 1000, 10),
+			endRecord:   withVolumes(newOneSidedRecord(tPlusOne, OneSec, true), 1300, 110),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDec(3),
+		},
+		"unset volume accumulators: falls back to arithmetic twap": {
+			startRecord: newOneSidedRecord(baseTime, osmomath.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(tPlusOne, OneSec, true),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.OneDec(),
+		},
+		"no volume between records: falls back to arithmetic twap": testCaseFromDeltas(
+			s, osmomath.ZeroDec(), tenSecAccum, 5*time.Second, osmomath.NewDec(2)),
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			volumeWeightedStrategy := &twap.VolumeWeightedTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualTwap := volumeWeightedStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+			s.Require().Equal(test.expTwap, actualTwap)
+		})
+	}
+}
+
+// TestComputeMedianTwap tests computeMedianTwap on various inputs.
+// Records are set up with the spot prices they hold until the next record,
+// and the expected median is the price that the pool held for at least half the time.
+func (s *TestSuite) TestComputeMedianTwap() {
+	recordWithSp0 := func(t time.Time, sp0 int64) types.TwapRecord {
+		return newRecord(basePoolId, t, osmomath.NewDec(sp0), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	}
+
+	tests := map[string]struct {
+		startRecord types.TwapRecord
+		records     []types.TwapRecord
+		startTime   time.Time
+		endTime     time.Time
+		quoteAsset  string
+		expTwap     osmomath.Dec
+	}{
+		"single record: its spot price is the median": {
+			startRecord: recordWithSp0(baseTime, 10),
+			startTime:   baseTime,
+			endTime:     baseTime.Add(time.Minute),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDec(10),
+		},
+		"single record, quote asset 1": {
+			startRecord: recordWithSp0(baseTime, 10),
+			startTime:   baseTime,
+			endTime:     baseTime.Add(time.Minute),
+			quoteAsset:  denom1,
+			expTwap:     osmomath.NewDecWithPrec(1, 1),
+		},
+		"start time equals end time: spot price of start record": {
+			startRecord: recordWithSp0(baseTime, 10),
+			startTime:   baseTime.Add(time.Second),
+			endTime:     baseTime.Add(time.Second),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.NewDec(10),
+		},
+		"short lived spike does not move the median": {
+			startRecord: recordWithSp0(baseTime, 10),
+			records: []types.TwapRecord{
+				recordWithSp0(baseTime.Add(10*time.Second), 1000),
+				recordWithSp0(baseTime.Add(20*time.Second), 10),
+			},
+			startTime:  baseTime,
+			endTime:    baseTime.Add(time.Minute),
+			quoteAsset: denom0,
+			expTwap:    osmomath.NewDec(10),
+		},
+		"price held for the majority of the time is the median": {
+			startRecord: recordWithSp0(baseTime, 10),
+			records: []types.TwapRecord{
+				recordWithSp0(baseTime.Add(10*time.Second), 5),
+				recordWithSp0(baseTime.Add(20*time.Second), 20),
+			},
+			startTime:  baseTime,
+			endTime:    baseTime.Add(25 * time.Second),
+			quoteAsset: denom0,
+			// 5 for 10s, 10 for 10s, 20 for 5s
+			expTwap: osmomath.NewDec(10),
+		},
+		"start time after start record time: only the time after start counts": {
+			startRecord: recordWithSp0(baseTime, 10),
+			records: []types.TwapRecord{
+				recordWithSp0(baseTime.Add(10*time.Second), 5),
+			},
+			startTime:  baseTime.Add(9 * time.Second),
+			endTime:    baseTime.Add(12 * time.Second),
+			quoteAsset: denom0,
+			// 10 for 1s, 5 for 2s
+			expTwap: osmomath.NewDec(5),
+		},
+		"record at end time does not contribute": {
+			startRecord: recordWithSp0(baseTime, 10),
+			records: []types.TwapRecord{
+				recordWithSp0(baseTime.Add(10*time.Second), 5),
+			},
+			startTime:  baseTime,
+			endTime:    baseTime.Add(10 * time.Second),
+			quoteAsset: denom0,
+			expTwap:    osmomath.NewDec(10),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			actualTwap := twap.ComputeMedianTwap(test.startRecord, test.records, test.startTime, test.endTime, test.quoteAsset)
+			s.Require().Equal(test.expTwap, actualTwap)
+		})
+	}
+}
+
+// TestComputeMedianTwap_RecordsNotMutated tests that computeMedianTwap does not write
+// past the end of the given records when they have spare capacity.
+func (s *TestSuite) TestComputeMedianTwap_RecordsNotMutated() {
+	spare := newRecord(basePoolId, baseTime.Add(time.Hour), osmomath.NewDec(7), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	backing := []types.TwapRecord{
+		newRecord(basePoolId, baseTime.Add(10*time.Second), osmomath.NewDec(5), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()),
+		spare,
+	}
+	startRecord := newRecord(basePoolId, baseTime, osmomath.NewDec(10), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+
+	twap.ComputeMedianTwap(startRecord, backing[:1], baseTime, baseTime.Add(time.Minute), denom0)
+
+	s.Require().Equal(spare, backing[1])
+}
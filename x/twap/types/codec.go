@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&SetOracleRoutesProposal{}, "osmosis/SetOracleRoutesProposal", nil)
+	cdc.RegisterConcrete(&SetRecordRetentionOverridesProposal{}, "osmosis/SetRecordRetentionOverridesProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&SetOracleRoutesProposal{},
+		&SetRecordRetentionOverridesProposal{},
+	)
+}
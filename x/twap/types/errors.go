@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	time "time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 type EndTimeInFutureError struct {
@@ -73,3 +75,90 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type InvalidTwapStrategyError struct {
+	Strategy TwapStrategy
+}
+
+func (e InvalidTwapStrategyError) Error() string {
+	return fmt.Sprintf("invalid twap strategy %s", e.Strategy)
+}
+
+type InvalidBucketIntervalError struct {
+	BucketInterval time.Duration
+}
+
+func (e InvalidBucketIntervalError) Error() string {
+	return fmt.Sprintf("bucket interval must be positive, got %s", e.BucketInterval)
+}
+
+type TooManyBucketsError struct {
+	Requested uint64
+	Max       uint64
+}
+
+func (e TooManyBucketsError) Error() string {
+	return fmt.Sprintf("requested %d buckets, at most %d buckets can be queried at once", e.Requested, e.Max)
+}
+
+type TooManyBatchEntriesError struct {
+	Requested int
+	Max       int
+}
+
+func (e TooManyBatchEntriesError) Error() string {
+	return fmt.Sprintf("requested %d twaps, at most %d twaps can be queried in a batch", e.Requested, e.Max)
+}
+
+type TooManyRecordsError struct {
+	Max int
+}
+
+func (e TooManyRecordsError) Error() string {
+	return fmt.Sprintf("the time range holds more than %d records, query a shorter time range", e.Max)
+}
+
+type OracleRouteNotFoundError struct {
+	BaseDenom  string
+	QuoteDenom string
+}
+
+func (e OracleRouteNotFoundError) Error() string {
+	return fmt.Sprintf("no oracle route registered to price %s in %s", e.BaseDenom, e.QuoteDenom)
+}
+
+// OracleUnsafeError is returned when the oracle price of a route cannot be safely used,
+// Err is the reason why.
+type OracleUnsafeError struct {
+	BaseDenom  string
+	QuoteDenom string
+	Err        error
+}
+
+func (e OracleUnsafeError) Error() string {
+	return fmt.Sprintf("oracle price of %s in %s is unsafe: %v", e.BaseDenom, e.QuoteDenom, e.Err)
+}
+
+func (e OracleUnsafeError) Unwrap() error {
+	return e.Err
+}
+
+type OracleStalePriceError struct {
+	PoolId         uint64
+	LastUpdateTime time.Time
+	MaxStaleness   time.Duration
+}
+
+func (e OracleStalePriceError) Error() string {
+	return fmt.Sprintf("pool %d has not been updated since %s, more than the max staleness of %s ago", e.PoolId, e.LastUpdateTime, e.MaxStaleness)
+}
+
+type OracleSpotDeviationError struct {
+	Price            osmomath.Dec
+	SpotPrice        osmomath.Dec
+	MaxSpotDeviation osmomath.Dec
+}
+
+func (e OracleSpotDeviationError) Error() string {
+	return fmt.Sprintf("price %s deviates from spot price %s by more than the max spot deviation of %s", e.Price, e.SpotPrice, e.MaxSpotDeviation)
+}
@@ -29,6 +29,29 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	seenPairs := make(map[string]struct{}, len(g.OracleRoutes))
+	for _, route := range g.OracleRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		pair := string(FormatOracleRouteKey(route.BaseDenom, route.QuoteDenom))
+		if _, ok := seenPairs[pair]; ok {
+			return fmt.Errorf("duplicate oracle route for (%s, %s)", route.BaseDenom, route.QuoteDenom)
+		}
+		seenPairs[pair] = struct{}{}
+	}
+
+	seenPools := make(map[uint64]struct{}, len(g.RetentionOverrides))
+	for _, override := range g.RetentionOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenPools[override.PoolId]; ok {
+			return fmt.Errorf("duplicate record retention override for pool %d", override.PoolId)
+		}
+		seenPools[override.PoolId] = struct{}{}
+	}
 	return nil
 }
 
@@ -84,5 +107,14 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	// volume accumulators may be unset for records that predate volume tracking.
+	if !t.Asset0VolumeAccumulator.IsNil() && t.Asset0VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset0 volume accumulator cannot be negative, was (%s)", t.Asset0VolumeAccumulator)
+	}
+
+	if !t.Asset1VolumeAccumulator.IsNil() && t.Asset1VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset1 volume accumulator cannot be negative, was (%s)", t.Asset1VolumeAccumulator)
+	}
 	return nil
 }
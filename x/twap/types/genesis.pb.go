@@ -82,19 +82,79 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+// RecordRetentionOverride overrides the record history keep period of a
+// single pool. The records of the pool are pruned by its keep period, rather
+// than by the record_history_keep_period param.
+type RecordRetentionOverride struct {
+	PoolId     uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	KeepPeriod time.Duration `protobuf:"bytes,2,opt,name=keep_period,json=keepPeriod,proto3,stdduration" json:"keep_period" yaml:"keep_period"`
+}
+
+func (m *RecordRetentionOverride) Reset()         { *m = RecordRetentionOverride{} }
+func (m *RecordRetentionOverride) String() string { return proto.CompactTextString(m) }
+func (*RecordRetentionOverride) ProtoMessage()    {}
+func (*RecordRetentionOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *RecordRetentionOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordRetentionOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordRetentionOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordRetentionOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordRetentionOverride.Merge(m, src)
+}
+func (m *RecordRetentionOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordRetentionOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordRetentionOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordRetentionOverride proto.InternalMessageInfo
+
+func (m *RecordRetentionOverride) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RecordRetentionOverride) GetKeepPeriod() time.Duration {
+	if m != nil {
+		return m.KeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// oracle_routes is the collection of all oracle routes.
+	OracleRoutes []OracleRoute `protobuf:"bytes,3,rep,name=oracle_routes,json=oracleRoutes,proto3" json:"oracle_routes"`
+	// retention_overrides is the collection of all per-pool record retention
+	// overrides.
+	RetentionOverrides []RecordRetentionOverride `protobuf:"bytes,4,rep,name=retention_overrides,json=retentionOverrides,proto3" json:"retention_overrides"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +197,23 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetOracleRoutes() []OracleRoute {
+	if m != nil {
+		return m.OracleRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetentionOverrides() []RecordRetentionOverride {
+	if m != nil {
+		return m.RetentionOverrides
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*RecordRetentionOverride)(nil), "osmosis.twap.v1beta1.RecordRetentionOverride")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,34 +222,70 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0x5b, 0xa1, 0x34, 0x64, 0x40, 0x5d,
-	0x6a, 0xd3, 0x82, 0x18, 0x2a, 0xa6, 0x0a, 0xc4, 0xd7, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0xff, 0x96, 0xab, 0xdf, 0x39,
-	0x47, 0x27, 0xf7, 0xda, 0x0d, 0xb9, 0x2a, 0xb8, 0x62, 0x8a, 0xe8, 0x2d, 0x15, 0x64, 0x33, 0x49,
-	0x40, 0xd3, 0x09, 0xc9, 0xa1, 0x04, 0xc5, 0x14, 0x16, 0x92, 0x6b, 0xee, 0x75, 0xad, 0x06, 0x37,
-	0x1a, 0x6c, 0x35, 0x83, 0x6e, 0xce, 0x73, 0xde, 0x0a, 0x48, 0xf3, 0x65, 0xb4, 0x83, 0x47, 0x17,
-	0xf3, 0x9a, 0x21, 0x96, 0x90, 0x72, 0x99, 0x59, 0x5d, 0x3f, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0xf7, 0xd4, 0xbd, 0x27, 0x64, 0x55, 0x42, 0x0c, 0x82, 0xa7, 0xab, 0x98, 0x65, 0x50, 0x6a, 0xb6,
-	0x64, 0x20, 0x7b, 0x28, 0x40, 0xa3, 0x3b, 0x51, 0xb7, 0xa5, 0x2f, 0x1b, 0xf8, 0xe6, 0x1f, 0xf3,
-	0xbe, 0x20, 0x77, 0x60, 0x7a, 0xc6, 0x2b, 0xa6, 0x34, 0x97, 0x75, 0xfc, 0x09, 0x40, 0xc4, 0x02,
-	0x24, 0xe3, 0x59, 0xef, 0x46, 0x80, 0x46, 0x57, 0xd3, 0x3e, 0x36, 0x35, 0xf0, 0xb9, 0x06, 0x7e,
-	0x61, 0x6b, 0xcc, 0xc7, 0xbb, 0xc3, 0xd0, 0xf9, 0x73, 0x18, 0x3e, 0xac, 0x69, 0xb1, 0x9e, 0x85,
-	0xd7, 0x47, 0x85, 0xdf, 0x7f, 0x0d, 0x51, 0x74, 0xdf, 0x08, 0x5e, 0x1b, 0xfe, 0x0e, 0x40, 0x2c,
-	0x0c, 0xfd, 0x8a, 0xdc, 0xbb, 0xaf, 0xcc, 0x11, 0xde, 0x6b, 0xaa, 0xc1, 0x7b, 0xee, 0xde, 0x6e,
-	0x96, 0xa8, 0x7a, 0x28, 0xb8, 0x39, 0xba, 0x9a, 0x06, 0xf8, 0xd2, 0x4d, 0xf0, 0x87, 0x2d, 0x15,
-	0x51, 0x1b, 0x39, 0xbf, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xb9, 0x1d, 0xd1, 0xae, 0xc5, 0xfe,
-	0xc1, 0x83, 0xcb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xf9, 0xdb, 0xdd, 0xd1, 0x47, 0xfb, 0xa3,
-	0x8f, 0x7e, 0x1f, 0x7d, 0xf4, 0xed, 0xe4, 0x3b, 0xfb, 0x93, 0xef, 0xfc, 0x3c, 0xf9, 0xce, 0xc7,
-	0xc7, 0x39, 0xd3, 0xab, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x1b, 0xaf, 0x69, 0xa2, 0xce, 0x03,
-	0xd9, 0x4c, 0x9f, 0x91, 0xcf, 0xe6, 0x25, 0xe8, 0x5a, 0x80, 0x4a, 0x3a, 0xed, 0xc6, 0x9e, 0xfc,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x09, 0x74, 0xb5, 0x1f, 0x76, 0x02, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0x13, 0x31,
+	0x14, 0x8f, 0xdb, 0x10, 0x84, 0x5b, 0x18, 0x4c, 0x44, 0xd3, 0x08, 0x5d, 0x92, 0x1b, 0x50, 0x24,
+	0x94, 0x3b, 0x1a, 0x10, 0x43, 0xc4, 0x14, 0x81, 0xa0, 0x80, 0xd4, 0xea, 0x60, 0xea, 0x72, 0x72,
+	0x72, 0xee, 0xc5, 0x6a, 0x72, 0xcf, 0xb2, 0x9d, 0x94, 0x7c, 0x00, 0x76, 0x46, 0x46, 0x46, 0x3e,
+	0x04, 0x33, 0xea, 0xd8, 0x91, 0x29, 0xa0, 0x64, 0x61, 0xee, 0x27, 0x40, 0x67, 0x3b, 0x45, 0x2a,
+	0xd7, 0x81, 0xcd, 0xef, 0x7e, 0x7f, 0xde, 0xef, 0xf9, 0xf9, 0xb0, 0x0f, 0x6a, 0x02, 0x8a, 0xab,
+	0x50, 0x9f, 0x52, 0x11, 0xce, 0xf6, 0x06, 0x4c, 0xd3, 0xbd, 0x30, 0x65, 0x19, 0x53, 0x5c, 0x05,
+	0x42, 0x82, 0x06, 0x52, 0x75, 0x9c, 0x20, 0xe7, 0x04, 0x8e, 0x53, 0xaf, 0xa6, 0x90, 0x82, 0x21,
+	0x84, 0xf9, 0xc9, 0x72, 0xeb, 0x0f, 0x0a, 0xfd, 0xf2, 0x22, 0x96, 0x6c, 0x08, 0x32, 0x71, 0xbc,
+	0x56, 0x21, 0x0f, 0x24, 0x1d, 0x8e, 0x99, 0xa3, 0xec, 0xa6, 0x00, 0xe9, 0x98, 0x85, 0xa6, 0x1a,
+	0x4c, 0x8f, 0x43, 0x9a, 0xcd, 0xd7, 0xd0, 0xd0, 0xc8, 0x63, 0xdb, 0xde, 0x16, 0x0e, 0xf2, 0xae,
+	0xaa, 0x92, 0xa9, 0xa4, 0x9a, 0x43, 0x66, 0x71, 0xff, 0x3b, 0xc2, 0x95, 0x43, 0x2a, 0xe9, 0x44,
+	0x91, 0x27, 0xf8, 0x9e, 0x90, 0xd3, 0x8c, 0xc5, 0x4c, 0xc0, 0x70, 0x14, 0xf3, 0x84, 0x65, 0x9a,
+	0x1f, 0x73, 0x26, 0x6b, 0xa8, 0x89, 0xda, 0xb7, 0xa2, 0xaa, 0x41, 0x5f, 0xe4, 0xe0, 0xfe, 0x25,
+	0x46, 0x3e, 0x22, 0x5c, 0xb7, 0xa3, 0xc4, 0x23, 0xae, 0x34, 0xc8, 0x79, 0x7c, 0xc2, 0x98, 0x88,
+	0x05, 0x93, 0x1c, 0x92, 0xda, 0x46, 0x13, 0xb5, 0xb7, 0xba, 0xbb, 0x81, 0x8d, 0x11, 0xac, 0x63,
+	0x04, 0xcf, 0x5d, 0x8c, 0x7e, 0xe7, 0x6c, 0xd1, 0x28, 0x5d, 0x2c, 0x1a, 0xad, 0x39, 0x9d, 0x8c,
+	0x7b, 0xfe, 0xf5, 0x56, 0xfe, 0xe7, 0x9f, 0x0d, 0x14, 0xed, 0x58, 0xc2, 0x2b, 0x8b, 0xbf, 0x61,
+	0x4c, 0x1c, 0x5a, 0xf4, 0x2b, 0xc2, 0x3b, 0x91, 0xc1, 0x22, 0xa6, 0xf3, 0x74, 0x90, 0x1d, 0xcc,
+	0x98, 0x94, 0x3c, 0x61, 0xe4, 0x21, 0xbe, 0x29, 0x00, 0xc6, 0x31, 0x4f, 0xcc, 0x28, 0xe5, 0x3e,
+	0xb9, 0x58, 0x34, 0xee, 0xd8, 0x86, 0x0e, 0xf0, 0xa3, 0x4a, 0x7e, 0xda, 0x4f, 0xc8, 0x11, 0xde,
+	0xfa, 0xaf, 0x01, 0x3c, 0x37, 0x00, 0xb1, 0x7e, 0xff, 0x24, 0xc6, 0x27, 0x97, 0x21, 0x7b, 0xe5,
+	0xdf, 0x5f, 0x1a, 0xc8, 0xff, 0xb6, 0x81, 0xb7, 0x5f, 0xda, 0x27, 0xf5, 0x4e, 0x53, 0xcd, 0xc8,
+	0x33, 0x7c, 0x23, 0xdf, 0xbb, 0xaa, 0xa1, 0xe6, 0x66, 0x7b, 0xab, 0xdb, 0x0c, 0x8a, 0x5e, 0x58,
+	0xf0, 0xfe, 0x94, 0x0a, 0x3b, 0x61, 0xbf, 0x9c, 0xf7, 0x8c, 0xac, 0x88, 0xf4, 0x70, 0x45, 0x98,
+	0x0d, 0xba, 0xac, 0xf7, 0x8b, 0xe5, 0x76, 0xcb, 0x4e, 0xea, 0x14, 0xe4, 0x2d, 0xbe, 0x6d, 0x1f,
+	0x59, 0x2c, 0x61, 0xaa, 0x99, 0xaa, 0x6d, 0x9a, 0x04, 0xad, 0x62, 0x8b, 0x03, 0x43, 0x8d, 0x72,
+	0xa6, 0xf3, 0xd9, 0x86, 0xbf, 0x9f, 0x14, 0x49, 0xf0, 0x5d, 0xb9, 0xbe, 0xfc, 0x18, 0xdc, 0xed,
+	0xab, 0x5a, 0xd9, 0x78, 0x76, 0x8a, 0x3d, 0xaf, 0xd9, 0x99, 0xf3, 0x27, 0xf2, 0x2a, 0xa0, 0xfa,
+	0xaf, 0xcf, 0x96, 0x1e, 0x3a, 0x5f, 0x7a, 0xe8, 0xd7, 0xd2, 0x43, 0x9f, 0x56, 0x5e, 0xe9, 0x7c,
+	0xe5, 0x95, 0x7e, 0xac, 0xbc, 0xd2, 0xd1, 0xa3, 0x94, 0xeb, 0xd1, 0x74, 0x10, 0x0c, 0x61, 0x12,
+	0xba, 0x66, 0x9d, 0x31, 0x1d, 0xa8, 0x75, 0x11, 0xce, 0xba, 0x4f, 0xc3, 0x0f, 0xf6, 0x1f, 0xd3,
+	0x73, 0xc1, 0xd4, 0xa0, 0x62, 0xf6, 0xf9, 0xf8, 0xcf, 0x00, 0x33, 0x62, 0x26, 0x64, 0xf8, 0x03,
+	0x00, 0x00,
 }
 
+func (this *RecordRetentionOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordRetentionOverride)
+	if !ok {
+		that2, ok := that.(RecordRetentionOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.KeepPeriod != that1.KeepPeriod {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -213,6 +324,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordRetentionOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRetentionOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRetentionOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.KeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +380,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetentionOverrides) > 0 {
+		for iNdEx := len(m.RetentionOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetentionOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OracleRoutes) > 0 {
+		for iNdEx := len(m.OracleRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -286,6 +461,20 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *RecordRetentionOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -300,6 +489,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OracleRoutes) > 0 {
+		for _, e := range m.OracleRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetentionOverrides) > 0 {
+		for _, e := range m.RetentionOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -424,6 +625,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordRetentionOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordRetentionOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordRetentionOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.KeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -520,6 +823,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleRoutes = append(m.OracleRoutes, OracleRoute{})
+			if err := m.OracleRoutes[len(m.OracleRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetentionOverrides = append(m.RetentionOverrides, RecordRetentionOverride{})
+			if err := m.RetentionOverrides[len(m.RetentionOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -79,6 +79,12 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withVolumeAcc := func(record TwapRecord, volumeAcc0, volumeAcc1 osmomath.Int) TwapRecord {
+		record.Asset0VolumeAccumulator = volumeAcc0
+		record.Asset1VolumeAccumulator = volumeAcc1
+		return record
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -103,6 +109,43 @@ func TestGenesisState_Validate(t *testing.T) {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.Dec{})}),
 			expectedErr: true,
 		},
+		"valid volume accs are nil": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withVolumeAcc(baseRecord, osmomath.Int{}, osmomath.Int{})}),
+		},
+		"valid volume accs are positive": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withVolumeAcc(baseRecord, osmomath.NewInt(10), osmomath.NewInt(20))}),
+		},
+		"invalid volume acc 0 is negative": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withVolumeAcc(baseRecord, osmomath.NewInt(-1), osmomath.ZeroInt())}),
+			expectedErr: true,
+		},
+		"invalid volume acc 1 is negative": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withVolumeAcc(baseRecord, osmomath.ZeroInt(), osmomath.NewInt(-1))}),
+			expectedErr: true,
+		},
+		"valid oracle routes": {
+			twapGenesis: withOracleRoutes(NewGenesisState(basicParams, []TwapRecord{baseRecord}), baseOracleRoute),
+		},
+		"invalid oracle route": {
+			twapGenesis: withOracleRoutes(NewGenesisState(basicParams, []TwapRecord{baseRecord}), OracleRoute{BaseDenom: "uatom", QuoteDenom: "uosmo"}),
+			expectedErr: true,
+		},
+		"invalid duplicate oracle route": {
+			twapGenesis: withOracleRoutes(NewGenesisState(basicParams, []TwapRecord{baseRecord}), baseOracleRoute, baseOracleRoute),
+			expectedErr: true,
+		},
+		"valid retention overrides": {
+			twapGenesis: withRetentionOverrides(NewGenesisState(basicParams, []TwapRecord{baseRecord}), RecordRetentionOverride{PoolId: 1, KeepPeriod: 720 * time.Hour}),
+		},
+		"invalid retention override": {
+			twapGenesis: withRetentionOverrides(NewGenesisState(basicParams, []TwapRecord{baseRecord}), RecordRetentionOverride{PoolId: 1}),
+			expectedErr: true,
+		},
+		"invalid duplicate retention override": {
+			twapGenesis: withRetentionOverrides(NewGenesisState(basicParams, []TwapRecord{baseRecord}),
+				RecordRetentionOverride{PoolId: 1, KeepPeriod: 720 * time.Hour}, RecordRetentionOverride{PoolId: 1, KeepPeriod: time.Hour}),
+			expectedErr: true,
+		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
 				NewParams("week", 48*time.Hour),
@@ -387,3 +430,13 @@ func TestTWAPRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withOracleRoutes(genesis *GenesisState, routes ...OracleRoute) *GenesisState {
+	genesis.OracleRoutes = routes
+	return genesis
+}
+
+func withRetentionOverrides(genesis *GenesisState, overrides ...RecordRetentionOverride) *GenesisState {
+	genesis.RetentionOverrides = overrides
+	return genesis
+}
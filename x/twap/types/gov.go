@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeSetOracleRoutes             = "SetOracleRoutes"
+	ProposalTypeSetRecordRetentionOverrides = "SetRecordRetentionOverrides"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeSetOracleRoutes)
+	govtypesv1.RegisterProposalType(ProposalTypeSetRecordRetentionOverrides)
+}
+
+var (
+	_ govtypesv1.Content = &SetOracleRoutesProposal{}
+	_ govtypesv1.Content = &SetRecordRetentionOverridesProposal{}
+)
+
+// NewSetOracleRoutesProposal returns a new instance of a set oracle routes proposal struct.
+func NewSetOracleRoutesProposal(title, description string, routes []OracleRoute) govtypesv1.Content {
+	return &SetOracleRoutesProposal{
+		Title:       title,
+		Description: description,
+		Routes:      routes,
+	}
+}
+
+func (p *SetOracleRoutesProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetOracleRoutesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetOracleRoutesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetOracleRoutesProposal) ProposalType() string {
+	return ProposalTypeSetOracleRoutes
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetOracleRoutesProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Routes) == 0 {
+		return fmt.Errorf("proposal must set at least one oracle route")
+	}
+
+	seenPairs := make(map[string]struct{}, len(p.Routes))
+	for _, route := range p.Routes {
+		// a route without hops removes the route of its pair, so only its denoms must be valid.
+		if route.IsRemoval() {
+			err = route.validateDenoms()
+		} else {
+			err = route.Validate()
+		}
+		if err != nil {
+			return err
+		}
+
+		pair := string(FormatOracleRouteKey(route.BaseDenom, route.QuoteDenom))
+		if _, ok := seenPairs[pair]; ok {
+			return fmt.Errorf("duplicate oracle route for (%s, %s)", route.BaseDenom, route.QuoteDenom)
+		}
+		seenPairs[pair] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the set oracle routes proposal.
+func (p SetOracleRoutesProposal) String() string {
+	var b strings.Builder
+	for _, route := range p.Routes {
+		b.WriteString(fmt.Sprintf("(BaseDenom: %s, QuoteDenom: %s, Hops: %v, TwapWindow: %s, Strategy: %s, MaxStaleness: %s, MaxSpotDeviation: %s) ",
+			route.BaseDenom, route.QuoteDenom, route.Hops, route.TwapWindow, route.Strategy, route.MaxStaleness, route.MaxSpotDeviation))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Set Oracle Routes Proposal:
+  Title:       %s
+  Description: %s
+  Routes:      %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
+
+// NewSetRecordRetentionOverridesProposal returns a new instance of a set record retention overrides proposal struct.
+func NewSetRecordRetentionOverridesProposal(title, description string, overrides []RecordRetentionOverride) govtypesv1.Content {
+	return &SetRecordRetentionOverridesProposal{
+		Title:       title,
+		Description: description,
+		Overrides:   overrides,
+	}
+}
+
+func (p *SetRecordRetentionOverridesProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetRecordRetentionOverridesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetRecordRetentionOverridesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetRecordRetentionOverridesProposal) ProposalType() string {
+	return ProposalTypeSetRecordRetentionOverrides
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetRecordRetentionOverridesProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Overrides) == 0 {
+		return fmt.Errorf("proposal must set at least one record retention override")
+	}
+
+	seenPools := make(map[uint64]struct{}, len(p.Overrides))
+	for _, override := range p.Overrides {
+		// an override with a zero keep period removes the override of its pool.
+		if override.IsRemoval() {
+			if override.PoolId == 0 {
+				return fmt.Errorf("record retention override pool id cannot be 0")
+			}
+		} else if err := override.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenPools[override.PoolId]; ok {
+			return fmt.Errorf("duplicate record retention override for pool %d", override.PoolId)
+		}
+		seenPools[override.PoolId] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the set record retention overrides proposal.
+func (p SetRecordRetentionOverridesProposal) String() string {
+	var b strings.Builder
+	for _, override := range p.Overrides {
+		b.WriteString(fmt.Sprintf("(PoolId: %d, KeepPeriod: %s) ", override.PoolId, override.KeepPeriod))
+	}
+
+	recordsStr := b.String()
+	b.Reset()
+
+	b.WriteString(fmt.Sprintf(`Set Record Retention Overrides Proposal:
+  Title:       %s
+  Description: %s
+  Overrides:   %s
+`, p.Title, p.Description, recordsStr))
+
+	return b.String()
+}
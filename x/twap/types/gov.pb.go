@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twap/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetOracleRoutesProposal is a gov Content type for registering the routes
+// used by the TWAP oracle to price a base denom in a quote denom. It replaces
+// the existing route of every (base denom, quote denom) pair it contains. If
+// a route has no hops, it removes the route of its pair.
+type SetOracleRoutesProposal struct {
+	Title       string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Routes      []OracleRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *SetOracleRoutesProposal) Reset()      { *m = SetOracleRoutesProposal{} }
+func (*SetOracleRoutesProposal) ProtoMessage() {}
+func (*SetOracleRoutesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_637150237c176c55, []int{0}
+}
+func (m *SetOracleRoutesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetOracleRoutesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetOracleRoutesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetOracleRoutesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOracleRoutesProposal.Merge(m, src)
+}
+func (m *SetOracleRoutesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetOracleRoutesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOracleRoutesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetOracleRoutesProposal proto.InternalMessageInfo
+
+// SetRecordRetentionOverridesProposal is a gov Content type for overriding the
+// record history keep period of individual pools. It replaces the existing
+// override of every pool it contains. If an override has a zero keep period,
+// it removes the override of its pool, so that its records are pruned by the
+// record_history_keep_period param again.
+type SetRecordRetentionOverridesProposal struct {
+	Title       string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Overrides   []RecordRetentionOverride `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides" yaml:"overrides"`
+}
+
+func (m *SetRecordRetentionOverridesProposal) Reset()      { *m = SetRecordRetentionOverridesProposal{} }
+func (*SetRecordRetentionOverridesProposal) ProtoMessage() {}
+func (*SetRecordRetentionOverridesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_637150237c176c55, []int{1}
+}
+func (m *SetRecordRetentionOverridesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRecordRetentionOverridesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRecordRetentionOverridesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRecordRetentionOverridesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRecordRetentionOverridesProposal.Merge(m, src)
+}
+func (m *SetRecordRetentionOverridesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetRecordRetentionOverridesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRecordRetentionOverridesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRecordRetentionOverridesProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetOracleRoutesProposal)(nil), "osmosis.twap.v1beta1.SetOracleRoutesProposal")
+	proto.RegisterType((*SetRecordRetentionOverridesProposal)(nil), "osmosis.twap.v1beta1.SetRecordRetentionOverridesProposal")
+}
+
+func init() { proto.RegisterFile("osmosis/twap/v1beta1/gov.proto", fileDescriptor_637150237c176c55) }
+
+var fileDescriptor_637150237c176c55 = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x93, 0x2d, 0x16, 0x9a, 0x2a, 0xd4, 0x50, 0x35, 0xee, 0x21, 0xd3, 0x8e, 0x20, 0x45,
+	0xd9, 0x8c, 0xad, 0x20, 0xb2, 0xc7, 0x78, 0xf3, 0x60, 0x4b, 0x7a, 0xeb, 0x45, 0x92, 0xec, 0x10,
+	0x07, 0x92, 0xbc, 0x30, 0x33, 0x8d, 0xf6, 0x1b, 0x88, 0x27, 0x8f, 0x5e, 0x84, 0xfd, 0x08, 0x1e,
+	0xfc, 0x10, 0xa5, 0xa7, 0x1e, 0x3d, 0x05, 0xd9, 0x3d, 0xe8, 0x79, 0x3f, 0x81, 0x64, 0x66, 0xa2,
+	0x39, 0x64, 0x11, 0x2f, 0x5e, 0x42, 0x66, 0xfe, 0xff, 0xf7, 0x7f, 0x8f, 0xdf, 0x3c, 0xc7, 0x07,
+	0x51, 0x80, 0x60, 0x82, 0xc8, 0xb7, 0x71, 0x45, 0xea, 0xc3, 0x84, 0xca, 0xf8, 0x90, 0x64, 0x50,
+	0x07, 0x15, 0x07, 0x09, 0xee, 0xae, 0xd1, 0x83, 0x56, 0x0f, 0x8c, 0x3e, 0xde, 0xcd, 0x20, 0x03,
+	0x65, 0x20, 0xed, 0x9f, 0xf6, 0x8e, 0xef, 0xa7, 0xca, 0xfc, 0x5a, 0x0b, 0xfa, 0x60, 0xa4, 0xdb,
+	0x71, 0xc1, 0x4a, 0x20, 0xea, 0x6b, 0xae, 0xf6, 0x07, 0x3b, 0x03, 0x8f, 0xd3, 0x9c, 0x1a, 0x0b,
+	0x1e, 0x1e, 0x8e, 0x96, 0xb4, 0x9d, 0x48, 0x79, 0xf0, 0xe7, 0x91, 0x73, 0xef, 0x94, 0xca, 0x63,
+	0x55, 0x17, 0xc1, 0xb9, 0xa4, 0xe2, 0x84, 0x43, 0x05, 0x22, 0xce, 0xdd, 0x87, 0xce, 0x0d, 0xc9,
+	0x64, 0x4e, 0x3d, 0x7b, 0xcf, 0x3e, 0xd8, 0x0a, 0x77, 0x56, 0x0d, 0xba, 0x79, 0x11, 0x17, 0xf9,
+	0x14, 0xab, 0x6b, 0x1c, 0x69, 0xd9, 0x7d, 0xee, 0x6c, 0xcf, 0xa8, 0x48, 0x39, 0xab, 0x24, 0x83,
+	0xd2, 0x1b, 0x29, 0xf7, 0xdd, 0x55, 0x83, 0x5c, 0xed, 0xee, 0x89, 0x38, 0xea, 0x5b, 0xdd, 0x13,
+	0x67, 0x93, 0xab, 0x9e, 0xde, 0xc6, 0xde, 0xc6, 0xc1, 0xf6, 0xd1, 0x7e, 0x30, 0xc4, 0x2b, 0xe8,
+	0x4d, 0x17, 0xde, 0xb9, 0x6c, 0x90, 0xb5, 0x6a, 0xd0, 0x2d, 0x9d, 0xad, 0xcb, 0x71, 0x64, 0x72,
+	0xa6, 0xaf, 0xde, 0xcf, 0x91, 0xf5, 0x69, 0x8e, 0xac, 0x9f, 0x73, 0x64, 0x5f, 0x7d, 0x9d, 0x8c,
+	0x0d, 0xc7, 0xf6, 0x49, 0xba, 0xb8, 0x17, 0x50, 0x4a, 0x5a, 0xca, 0x0f, 0x3f, 0xbe, 0x3c, 0x42,
+	0x1d, 0xa2, 0x35, 0x0c, 0xf0, 0xd5, 0xc8, 0x79, 0x70, 0x4a, 0x65, 0x44, 0x53, 0xe0, 0xb3, 0x88,
+	0xb6, 0x75, 0x0c, 0xca, 0xe3, 0x9a, 0x72, 0xce, 0x66, 0xff, 0x95, 0x15, 0x75, 0xb6, 0xa0, 0x6b,
+	0x6b, 0x70, 0x4d, 0x86, 0x71, 0xad, 0x19, 0x36, 0xf4, 0x0c, 0xba, 0x1d, 0xdd, 0xea, 0x77, 0x1a,
+	0x8e, 0xfe, 0x24, 0x4f, 0xcf, 0xfe, 0x0d, 0xe0, 0xe3, 0x1e, 0xc0, 0xbf, 0x41, 0x0a, 0x5f, 0x5e,
+	0x2e, 0x7c, 0xfb, 0x7a, 0xe1, 0xdb, 0xdf, 0x17, 0xbe, 0xfd, 0x71, 0xe9, 0x5b, 0xd7, 0x4b, 0xdf,
+	0xfa, 0xb6, 0xf4, 0xad, 0xb3, 0x27, 0x19, 0x93, 0x6f, 0xce, 0x93, 0x20, 0x85, 0x82, 0x98, 0xc4,
+	0x49, 0x1e, 0x27, 0xa2, 0x3b, 0x90, 0xfa, 0xe8, 0x19, 0x79, 0xa7, 0x17, 0x59, 0x5e, 0x54, 0x54,
+	0x24, 0x9b, 0x6a, 0x7f, 0x9f, 0xfe, 0x1a, 0x00, 0x0e, 0x94, 0x72, 0x06, 0x82, 0x03, 0x00, 0x00,
+}
+
+func (this *SetOracleRoutesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetOracleRoutesProposal)
+	if !ok {
+		that2, ok := that.(SetOracleRoutesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(&that1.Routes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetRecordRetentionOverridesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetRecordRetentionOverridesProposal)
+	if !ok {
+		that2, ok := that.(SetRecordRetentionOverridesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Overrides) != len(that1.Overrides) {
+		return false
+	}
+	for i := range this.Overrides {
+		if !this.Overrides[i].Equal(&that1.Overrides[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *SetOracleRoutesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetOracleRoutesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetOracleRoutesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRecordRetentionOverridesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRecordRetentionOverridesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRecordRetentionOverridesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetOracleRoutesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetRecordRetentionOverridesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetOracleRoutesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetOracleRoutesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetOracleRoutesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, OracleRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRecordRetentionOverridesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRecordRetentionOverridesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRecordRetentionOverridesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, RecordRetentionOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
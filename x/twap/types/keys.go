@@ -24,14 +24,37 @@ const (
 	QuerierRoute = ModuleName
 	// Contract: Coin denoms cannot contain this character
 	KeySeparator = "|"
+
+	// DefaultTwapSeriesBuckets is the number of buckets returned by a TWAP series query
+	// if no pagination limit is given.
+	DefaultTwapSeriesBuckets = 100
+	// MaxTwapSeriesBuckets is the maximum number of buckets returned by a single TWAP series query.
+	// Every bucket boundary requires a record lookup, so this bounds the work done by a query.
+	MaxTwapSeriesBuckets = 1000
+
+	// MaxBatchTwapEntries is the maximum number of twaps that can be queried in a single batch query.
+	MaxBatchTwapEntries = 100
+
+	// MaxMedianTwapRecords is the maximum number of historical records a median twap is computed over.
+	// A pool changing in every block adds a record per block, so this bounds the work done by a query.
+	MaxMedianTwapRecords = 1000
 )
 
 var (
 	PruningStateKey = []byte{0x01}
 	// TODO: Delete in v26
 	DeprecatedHistoricalTWAPsIsPruningKey = []byte{0x02}
-	mostRecentTWAPsNoSeparator            = "recent_twap"
-	historicalTWAPPoolIndexNoSeparator    = "historical_pool_index"
+
+	// Transient store prefixes.
+	// ChangedPoolsTransientPrefix tracks the pools that changed in the current block.
+	ChangedPoolsTransientPrefix = []byte{0x01}
+	// SwapVolumeTransientPrefix tracks the per-pair swap volume of pools in the current block.
+	SwapVolumeTransientPrefix = []byte{0x02}
+
+	mostRecentTWAPsNoSeparator         = "recent_twap"
+	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	oracleRouteNoSeparator             = "oracle_route"
+	retentionOverrideNoSeparator       = "retention_override"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -44,6 +67,12 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is base denom | quote denom
+	// made for getting the oracle route of a (base denom, quote denom) pair
+	OracleRoutePrefix = oracleRouteNoSeparator + KeySeparator
+	// format is pool id
+	// made for getting the record retention override of a pool
+	RetentionOverridePrefix = retentionOverrideNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -84,6 +113,23 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+// FormatOracleRouteKey returns the key of the oracle route pricing baseDenom in quoteDenom.
+func FormatOracleRouteKey(baseDenom, quoteDenom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", OracleRoutePrefix, baseDenom, KeySeparator, quoteDenom))
+}
+
+// FormatRetentionOverrideKey returns the key of the record retention override of pool `poolId`.
+func FormatRetentionOverrideKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s", RetentionOverridePrefix, osmoutils.FormatFixedLengthU64(poolId)))
+}
+
+// FormatSwapVolumeTransientKey returns the transient store key tracking the amount of `denom`
+// swapped in pool `poolId` within the (denom0, denom1) pair during the current block.
+func FormatSwapVolumeTransientKey(poolId uint64, denom0, denom1, denom string) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s%s%s", SwapVolumeTransientPrefix, poolIdS, KeySeparator, denom0, KeySeparator, denom1, KeySeparator, denom))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store storetypes.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -108,5 +154,12 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = osmomath.ZeroDec()
 	}
+	// records written before volume tracking was introduced have no volume accumulators.
+	if twap.Asset0VolumeAccumulator.IsNil() {
+		twap.Asset0VolumeAccumulator = osmomath.ZeroInt()
+	}
+	if twap.Asset1VolumeAccumulator.IsNil() {
+		twap.Asset1VolumeAccumulator = osmomath.ZeroInt()
+	}
 	return twap, err
 }
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsRemoval returns true if the route has no hops, which removes
+// the route of its (base denom, quote denom) pair when set via governance.
+func (r OracleRoute) IsRemoval() bool {
+	return len(r.Hops) == 0
+}
+
+// Validate validates the oracle route. Returns nil on success, error otherwise.
+func (r OracleRoute) Validate() error {
+	if err := r.validateDenoms(); err != nil {
+		return err
+	}
+
+	if r.IsRemoval() {
+		return fmt.Errorf("oracle route (%s, %s) must have at least one hop", r.BaseDenom, r.QuoteDenom)
+	}
+
+	tokenInDenom := r.BaseDenom
+	for _, hop := range r.Hops {
+		if hop.PoolId == 0 {
+			return errors.New("oracle route hop pool id cannot be 0")
+		}
+		if err := sdk.ValidateDenom(hop.TokenOutDenom); err != nil {
+			return err
+		}
+		if hop.TokenOutDenom == tokenInDenom {
+			return fmt.Errorf("oracle route hop in pool %d cannot swap %s to itself", hop.PoolId, tokenInDenom)
+		}
+		tokenInDenom = hop.TokenOutDenom
+	}
+	if tokenInDenom != r.QuoteDenom {
+		return fmt.Errorf("oracle route must end in quote denom %s, ends in %s", r.QuoteDenom, tokenInDenom)
+	}
+
+	if r.TwapWindow <= 0 {
+		return fmt.Errorf("oracle route twap window must be positive, was (%s)", r.TwapWindow)
+	}
+
+	if _, ok := TwapStrategy_name[int32(r.Strategy)]; !ok {
+		return InvalidTwapStrategyError{Strategy: r.Strategy}
+	}
+
+	if r.MaxStaleness < 0 {
+		return fmt.Errorf("oracle route max staleness cannot be negative, was (%s)", r.MaxStaleness)
+	}
+
+	if r.MaxSpotDeviation.IsNil() || r.MaxSpotDeviation.IsNegative() {
+		return fmt.Errorf("oracle route max spot deviation cannot be negative, was (%s)", r.MaxSpotDeviation)
+	}
+
+	return nil
+}
+
+func (r OracleRoute) validateDenoms() error {
+	if err := sdk.ValidateDenom(r.BaseDenom); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.QuoteDenom); err != nil {
+		return err
+	}
+	if r.BaseDenom == r.QuoteDenom {
+		return fmt.Errorf("oracle route base and quote denom cannot be the same, was (%s)", r.BaseDenom)
+	}
+	return nil
+}
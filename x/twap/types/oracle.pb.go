@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twap/v1beta1/oracle.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OracleRoute is a governance registered route of pools used to price
+// base_denom in units of quote_denom. The price is the product of the TWAPs
+// of every hop of the route.
+type OracleRoute struct {
+	BaseDenom  string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// hops is the route of pools from base_denom to quote_denom. The token out
+	// denom of the last hop must be quote_denom.
+	Hops []OracleRouteHop `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops" yaml:"hops"`
+	// twap_window is the duration over which the TWAP of every hop is computed,
+	// ending at the current block time.
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	Strategy   TwapStrategy  `protobuf:"varint,5,opt,name=strategy,proto3,enum=osmosis.twap.v1beta1.TwapStrategy" json:"strategy,omitempty" yaml:"strategy"`
+	// max_staleness is the maximum time since the most recent TWAP record of
+	// every hop. A pool's record is only updated when its spot price may have
+	// changed, so an old record means that the pool is not actively used.
+	// Zero disables the check.
+	MaxStaleness time.Duration `protobuf:"bytes,6,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness" yaml:"max_staleness"`
+	// max_spot_deviation is the maximum relative difference between the price of
+	// the route and the price of the route using the current spot prices, e.g.
+	// 0.05 for 5%. Zero disables the check.
+	MaxSpotDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_spot_deviation,json=maxSpotDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_deviation" yaml:"max_spot_deviation"`
+}
+
+func (m *OracleRoute) Reset()         { *m = OracleRoute{} }
+func (m *OracleRoute) String() string { return proto.CompactTextString(m) }
+func (*OracleRoute) ProtoMessage()    {}
+func (*OracleRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4693be4e705de72a, []int{0}
+}
+func (m *OracleRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRoute.Merge(m, src)
+}
+func (m *OracleRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRoute proto.InternalMessageInfo
+
+func (m *OracleRoute) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *OracleRoute) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *OracleRoute) GetHops() []OracleRouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *OracleRoute) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *OracleRoute) GetStrategy() TwapStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return TWAP_STRATEGY_ARITHMETIC
+}
+
+func (m *OracleRoute) GetMaxStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+// OracleRouteHop is a single swap of an oracle route.
+type OracleRouteHop struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *OracleRouteHop) Reset()         { *m = OracleRouteHop{} }
+func (m *OracleRouteHop) String() string { return proto.CompactTextString(m) }
+func (*OracleRouteHop) ProtoMessage()    {}
+func (*OracleRouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4693be4e705de72a, []int{1}
+}
+func (m *OracleRouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRouteHop.Merge(m, src)
+}
+func (m *OracleRouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRouteHop proto.InternalMessageInfo
+
+func (m *OracleRouteHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *OracleRouteHop) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OracleRoute)(nil), "osmosis.twap.v1beta1.OracleRoute")
+	proto.RegisterType((*OracleRouteHop)(nil), "osmosis.twap.v1beta1.OracleRouteHop")
+}
+
+func init() { proto.RegisterFile("osmosis/twap/v1beta1/oracle.proto", fileDescriptor_4693be4e705de72a) }
+
+var fileDescriptor_4693be4e705de72a = []byte{
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6b, 0xd4, 0x4e,
+	0x14, 0xde, 0xfc, 0xba, 0xbf, 0xd6, 0xce, 0xda, 0x56, 0xa7, 0xb5, 0x6c, 0x2b, 0x24, 0xeb, 0x20,
+	0xb2, 0x20, 0x26, 0x76, 0x15, 0x85, 0x9e, 0x24, 0xec, 0x41, 0x45, 0x29, 0xa4, 0x82, 0xd0, 0x4b,
+	0x9c, 0x24, 0x63, 0x1a, 0x9a, 0xe4, 0xc5, 0xcc, 0xa4, 0xdb, 0xfd, 0x0b, 0xbc, 0x7a, 0xf4, 0xe8,
+	0x9f, 0xd3, 0x63, 0x0f, 0x1e, 0xc4, 0x43, 0x94, 0xf6, 0xe2, 0x39, 0x7f, 0x81, 0x64, 0x26, 0xd1,
+	0x5d, 0x58, 0xf0, 0x36, 0xef, 0xbd, 0xef, 0xfb, 0xde, 0x97, 0xf7, 0x5e, 0xd0, 0x1d, 0xe0, 0x09,
+	0xf0, 0x88, 0x5b, 0x62, 0x42, 0x33, 0xeb, 0x74, 0xcf, 0x63, 0x82, 0xee, 0x59, 0x90, 0x53, 0x3f,
+	0x66, 0x66, 0x96, 0x83, 0x00, 0xbc, 0xd5, 0x40, 0xcc, 0x1a, 0x62, 0x36, 0x90, 0xdd, 0xad, 0x10,
+	0x42, 0x90, 0x00, 0xab, 0x7e, 0x29, 0xec, 0xae, 0x1e, 0x02, 0x84, 0x31, 0xb3, 0x64, 0xe4, 0x15,
+	0xef, 0xad, 0xa0, 0xc8, 0xa9, 0x88, 0x20, 0x6d, 0xea, 0xf7, 0x16, 0xb6, 0xab, 0x03, 0x37, 0x67,
+	0x3e, 0xe4, 0x81, 0xc2, 0x91, 0xaf, 0x5d, 0xd4, 0x3b, 0x90, 0x26, 0x1c, 0x28, 0x04, 0xc3, 0x8f,
+	0x11, 0xf2, 0x28, 0x67, 0x6e, 0xc0, 0x52, 0x48, 0xfa, 0xda, 0x40, 0x1b, 0xae, 0xda, 0xb7, 0xaa,
+	0xd2, 0xb8, 0x39, 0xa5, 0x49, 0xbc, 0x4f, 0xfe, 0xd6, 0x88, 0xb3, 0x5a, 0x07, 0xe3, 0xfa, 0x8d,
+	0x9f, 0xa2, 0xde, 0x87, 0x02, 0x44, 0x4b, 0xfb, 0x4f, 0xd2, 0xb6, 0xab, 0xd2, 0xc0, 0x8a, 0x36,
+	0x53, 0x24, 0x0e, 0x92, 0x91, 0x22, 0xbe, 0x46, 0xdd, 0x63, 0xc8, 0x78, 0x7f, 0x69, 0xb0, 0x34,
+	0xec, 0x8d, 0xee, 0x9a, 0x8b, 0x26, 0x60, 0xce, 0xf8, 0x7b, 0x0e, 0x99, 0xbd, 0x79, 0x5e, 0x1a,
+	0x9d, 0xaa, 0x34, 0x7a, 0x4a, 0xbb, 0xe6, 0x13, 0x47, 0xca, 0xe0, 0x23, 0xd4, 0x93, 0x9f, 0x38,
+	0x89, 0xd2, 0x00, 0x26, 0xfd, 0xee, 0x40, 0x1b, 0xf6, 0x46, 0x3b, 0xa6, 0x9a, 0x95, 0xd9, 0xce,
+	0xca, 0x1c, 0x37, 0xb3, 0xb2, 0xf5, 0x46, 0xaa, 0xb1, 0x39, 0xc3, 0x25, 0x9f, 0x7f, 0x18, 0x9a,
+	0x83, 0xea, 0xcc, 0x5b, 0x99, 0xc0, 0x87, 0xe8, 0x1a, 0x17, 0x39, 0x15, 0x2c, 0x9c, 0xf6, 0xff,
+	0x1f, 0x68, 0xc3, 0xf5, 0x11, 0x59, 0x6c, 0xf7, 0xcd, 0x84, 0x66, 0x87, 0x0d, 0xd2, 0xde, 0xac,
+	0x4a, 0x63, 0x43, 0xa9, 0xb7, 0x6c, 0xe2, 0xfc, 0x11, 0xc2, 0xef, 0xd0, 0x5a, 0x42, 0xcf, 0x5c,
+	0x2e, 0x68, 0xcc, 0x52, 0xc6, 0x79, 0x7f, 0xf9, 0x5f, 0x96, 0x07, 0x8d, 0xe5, 0x2d, 0x25, 0x3a,
+	0xc7, 0x56, 0xa6, 0xaf, 0x27, 0xf4, 0xec, 0xb0, 0x4d, 0xe1, 0x14, 0x61, 0x89, 0xc9, 0x40, 0xb8,
+	0x01, 0x3b, 0x8d, 0xa4, 0x4a, 0x7f, 0x45, 0x6e, 0xe8, 0x59, 0xad, 0xf5, 0xbd, 0x34, 0x6e, 0xfb,
+	0xf2, 0x43, 0x78, 0x70, 0x62, 0x46, 0x60, 0x25, 0x54, 0x1c, 0x9b, 0xaf, 0x58, 0x48, 0xfd, 0xe9,
+	0x98, 0xf9, 0x55, 0x69, 0xec, 0xcc, 0xb4, 0x9a, 0x93, 0x21, 0xce, 0x8d, 0xba, 0x57, 0x06, 0x62,
+	0xdc, 0xa6, 0xf6, 0xbb, 0xbf, 0xbe, 0x18, 0x1a, 0xf9, 0xa8, 0xa1, 0xf5, 0xf9, 0xb5, 0xe1, 0xfb,
+	0x68, 0x25, 0x03, 0x88, 0xdd, 0x28, 0x90, 0x67, 0xd5, 0xb5, 0x71, 0x55, 0x1a, 0xeb, 0x4a, 0xba,
+	0x29, 0x10, 0x67, 0xb9, 0x7e, 0xbd, 0x08, 0xb0, 0x8d, 0x36, 0x04, 0x9c, 0xb0, 0xd4, 0x85, 0x42,
+	0xcc, 0x1d, 0xd5, 0x6e, 0x55, 0x1a, 0xdb, 0xcd, 0xb6, 0xe6, 0x01, 0xc4, 0x59, 0x93, 0x99, 0x83,
+	0x42, 0xc8, 0xdb, 0x52, 0x4e, 0xec, 0x97, 0xe7, 0x97, 0xba, 0x76, 0x71, 0xa9, 0x6b, 0x3f, 0x2f,
+	0x75, 0xed, 0xd3, 0x95, 0xde, 0xb9, 0xb8, 0xd2, 0x3b, 0xdf, 0xae, 0xf4, 0xce, 0xd1, 0xc3, 0x30,
+	0x12, 0xc7, 0x85, 0x67, 0xfa, 0x90, 0x58, 0xcd, 0x22, 0x1f, 0xc4, 0xd4, 0xe3, 0x6d, 0x60, 0x9d,
+	0x8e, 0x9e, 0x58, 0x67, 0xea, 0x07, 0x12, 0xd3, 0x8c, 0x71, 0x6f, 0x59, 0xae, 0xe3, 0xd1, 0xef,
+	0x01, 0x00, 0xde, 0xff, 0x9c, 0x76, 0xcc, 0x03, 0x00, 0x00,
+}
+
+func (this *OracleRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRoute)
+	if !ok {
+		that2, ok := that.(OracleRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return false
+	}
+	if len(this.Hops) != len(that1.Hops) {
+		return false
+	}
+	for i := range this.Hops {
+		if !this.Hops[i].Equal(&that1.Hops[i]) {
+			return false
+		}
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.Strategy != that1.Strategy {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
+	if !this.MaxSpotDeviation.Equal(that1.MaxSpotDeviation) {
+		return false
+	}
+	return true
+}
+func (this *OracleRouteHop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRouteHop)
+	if !ok {
+		that2, ok := that.(OracleRouteHop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	return true
+}
+func (m *OracleRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSpotDeviation.Size()
+		i -= size
+		if _, err := m.MaxSpotDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Strategy != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleRouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OracleRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Strategy != 0 {
+		n += 1 + sovOracle(uint64(m.Strategy))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxSpotDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *OracleRouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovOracle(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OracleRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, OracleRouteHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= TwapStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpotDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOracle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOracle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOracle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOracle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOracle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOracle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOracle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"testing"
+	time "time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+var baseOracleRoute = OracleRoute{
+	BaseDenom:  "uatom",
+	QuoteDenom: "uusdc",
+	Hops: []OracleRouteHop{
+		{PoolId: 1, TokenOutDenom: "uosmo"},
+		{PoolId: 2, TokenOutDenom: "uusdc"},
+	},
+	TwapWindow:       time.Hour,
+	Strategy:         TWAP_STRATEGY_GEOMETRIC,
+	MaxStaleness:     10 * time.Minute,
+	MaxSpotDeviation: osmomath.NewDecWithPrec(5, 2),
+}
+
+func withOracleRouteUpdate(route OracleRoute, update func(route *OracleRoute)) OracleRoute {
+	route.Hops = append([]OracleRouteHop{}, route.Hops...)
+	update(&route)
+	return route
+}
+
+func TestOracleRoute_Validate(t *testing.T) {
+	tests := map[string]struct {
+		route       OracleRoute
+		expectedErr bool
+	}{
+		"valid route": {
+			route: baseOracleRoute,
+		},
+		"valid route with checks disabled": {
+			route: withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) {
+				route.MaxStaleness = 0
+				route.MaxSpotDeviation = osmomath.ZeroDec()
+			}),
+		},
+		"invalid base denom": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.BaseDenom = "" }),
+			expectedErr: true,
+		},
+		"base denom equals quote denom": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.BaseDenom = "uusdc" }),
+			expectedErr: true,
+		},
+		"no hops": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.Hops = nil }),
+			expectedErr: true,
+		},
+		"hop pool id is zero": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.Hops[0].PoolId = 0 }),
+			expectedErr: true,
+		},
+		"hop swaps a denom to itself": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.Hops[0].TokenOutDenom = "uatom" }),
+			expectedErr: true,
+		},
+		"route does not end in quote denom": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.Hops = route.Hops[:1] }),
+			expectedErr: true,
+		},
+		"zero twap window": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.TwapWindow = 0 }),
+			expectedErr: true,
+		},
+		"unknown strategy": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.Strategy = TwapStrategy(100) }),
+			expectedErr: true,
+		},
+		"negative max staleness": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.MaxStaleness = -time.Second }),
+			expectedErr: true,
+		},
+		"negative max spot deviation": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.MaxSpotDeviation = osmomath.NewDec(-1) }),
+			expectedErr: true,
+		},
+		"nil max spot deviation": {
+			route:       withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.MaxSpotDeviation = osmomath.Dec{} }),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.route.Validate()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetOracleRoutesProposal_ValidateBasic(t *testing.T) {
+	removal := OracleRoute{BaseDenom: "uatom", QuoteDenom: "uosmo"}
+
+	tests := map[string]struct {
+		routes      []OracleRoute
+		expectedErr bool
+	}{
+		"valid routes": {
+			routes: []OracleRoute{baseOracleRoute, removal},
+		},
+		"no routes": {
+			routes:      []OracleRoute{},
+			expectedErr: true,
+		},
+		"removal with invalid denom": {
+			routes:      []OracleRoute{{BaseDenom: "uatom"}},
+			expectedErr: true,
+		},
+		"invalid route": {
+			routes:      []OracleRoute{withOracleRouteUpdate(baseOracleRoute, func(route *OracleRoute) { route.TwapWindow = 0 })},
+			expectedErr: true,
+		},
+		"duplicate pair": {
+			routes:      []OracleRoute{baseOracleRoute, {BaseDenom: baseOracleRoute.BaseDenom, QuoteDenom: baseOracleRoute.QuoteDenom}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewSetOracleRoutesProposal("title", "description", tc.routes).ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
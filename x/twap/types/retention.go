@@ -0,0 +1,24 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IsRemoval returns true if the override has a zero keep period, which removes
+// the override of its pool when set via governance.
+func (o RecordRetentionOverride) IsRemoval() bool {
+	return o.KeepPeriod == 0
+}
+
+// Validate validates the record retention override. Returns nil on success, error otherwise.
+func (o RecordRetentionOverride) Validate() error {
+	if o.PoolId == 0 {
+		return errors.New("record retention override pool id cannot be 0")
+	}
+
+	if o.KeepPeriod <= 0 {
+		return fmt.Errorf("record retention override keep period of pool %d must be positive, was (%s)", o.PoolId, o.KeepPeriod)
+	}
+	return nil
+}
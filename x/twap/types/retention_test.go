@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRetentionOverride_Validate(t *testing.T) {
+	tests := map[string]struct {
+		override    RecordRetentionOverride
+		expectedErr bool
+	}{
+		"valid override": {
+			override: RecordRetentionOverride{PoolId: 1, KeepPeriod: 720 * time.Hour},
+		},
+		"zero pool id": {
+			override:    RecordRetentionOverride{PoolId: 0, KeepPeriod: 720 * time.Hour},
+			expectedErr: true,
+		},
+		"zero keep period": {
+			override:    RecordRetentionOverride{PoolId: 1},
+			expectedErr: true,
+		},
+		"negative keep period": {
+			override:    RecordRetentionOverride{PoolId: 1, KeepPeriod: -time.Hour},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.override.Validate()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetRecordRetentionOverridesProposal_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		overrides   []RecordRetentionOverride
+		expectedErr bool
+	}{
+		"valid overrides": {
+			overrides: []RecordRetentionOverride{{PoolId: 1, KeepPeriod: 720 * time.Hour}, {PoolId: 2}},
+		},
+		"no overrides": {
+			overrides:   []RecordRetentionOverride{},
+			expectedErr: true,
+		},
+		"removal with zero pool id": {
+			overrides:   []RecordRetentionOverride{{PoolId: 0}},
+			expectedErr: true,
+		},
+		"negative keep period": {
+			overrides:   []RecordRetentionOverride{{PoolId: 1, KeepPeriod: -time.Hour}},
+			expectedErr: true,
+		},
+		"duplicate pool": {
+			overrides:   []RecordRetentionOverride{{PoolId: 1, KeepPeriod: 720 * time.Hour}, {PoolId: 1}},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewSetRecordRetentionOverridesProposal("title", "description", tc.overrides).ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -28,6 +28,35 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TwapStrategy is the strategy used to compute a TWAP.
+type TwapStrategy int32
+
+const (
+	TWAP_STRATEGY_ARITHMETIC      TwapStrategy = 0
+	TWAP_STRATEGY_GEOMETRIC       TwapStrategy = 1
+	TWAP_STRATEGY_VOLUME_WEIGHTED TwapStrategy = 2
+)
+
+var TwapStrategy_name = map[int32]string{
+	0: "TWAP_STRATEGY_ARITHMETIC",
+	1: "TWAP_STRATEGY_GEOMETRIC",
+	2: "TWAP_STRATEGY_VOLUME_WEIGHTED",
+}
+
+var TwapStrategy_value = map[string]int32{
+	"TWAP_STRATEGY_ARITHMETIC":      0,
+	"TWAP_STRATEGY_GEOMETRIC":       1,
+	"TWAP_STRATEGY_VOLUME_WEIGHTED": 2,
+}
+
+func (x TwapStrategy) String() string {
+	return proto.EnumName(TwapStrategy_name, int32(x))
+}
+
+func (TwapStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{0}
+}
+
 // A TWAP record should be indexed in state by pool_id, (asset pair), timestamp
 // The asset pair assets should be lexicographically sorted.
 // Technically (pool_id, asset_0_denom, asset_1_denom, height) do not need to
@@ -57,6 +86,13 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// Cumulative amount of asset0 swapped against asset1 in this pool, summed
+	// over both swap directions. Together with asset1_volume_accumulator, this
+	// is used to compute volume-weighted TWAPs.
+	Asset0VolumeAccumulator cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=asset0_volume_accumulator,json=asset0VolumeAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"asset0_volume_accumulator"`
+	// Cumulative amount of asset1 swapped against asset0 in this pool, summed
+	// over both swap directions.
+	Asset1VolumeAccumulator cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=asset1_volume_accumulator,json=asset1VolumeAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"asset1_volume_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -216,9 +252,65 @@ func (m *PruningState) GetLastSeenPoolId() uint64 {
 	return 0
 }
 
+// TwapSeriesBucket is the TWAP over (start_time, end_time).
+type TwapSeriesBucket struct {
+	StartTime time.Time                   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time                   `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Twap      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap" yaml:"twap"`
+}
+
+func (m *TwapSeriesBucket) Reset()         { *m = TwapSeriesBucket{} }
+func (m *TwapSeriesBucket) String() string { return proto.CompactTextString(m) }
+func (*TwapSeriesBucket) ProtoMessage()    {}
+func (*TwapSeriesBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{2}
+}
+func (m *TwapSeriesBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapSeriesBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapSeriesBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapSeriesBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapSeriesBucket.Merge(m, src)
+}
+func (m *TwapSeriesBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapSeriesBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapSeriesBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapSeriesBucket proto.InternalMessageInfo
+
+func (m *TwapSeriesBucket) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapSeriesBucket) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.twap.v1beta1.TwapStrategy", TwapStrategy_name, TwapStrategy_value)
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
 	proto.RegisterType((*PruningState)(nil), "osmosis.twap.v1beta1.PruningState")
+	proto.RegisterType((*TwapSeriesBucket)(nil), "osmosis.twap.v1beta1.TwapSeriesBucket")
 }
 
 func init() {
@@ -226,47 +318,61 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x21, 0xbf, 0x00, 0x9b, 0xf0, 0x43, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55,
-	0xe1, 0x50, 0x3b, 0xa6, 0x52, 0x0f, 0xf4, 0x44, 0x44, 0x0f, 0x6d, 0x51, 0x15, 0x19, 0x4e, 0xbd,
-	0x58, 0x1b, 0x7b, 0x70, 0x2c, 0xe2, 0xec, 0x6a, 0x77, 0x03, 0xcd, 0xb7, 0xe0, 0x2b, 0xf5, 0xc6,
-	0x91, 0x63, 0xd5, 0x43, 0x5a, 0xc1, 0xad, 0x47, 0x8e, 0x3d, 0x55, 0xbb, 0xeb, 0xa4, 0x84, 0xfe,
-	0x01, 0x6e, 0x9e, 0xd9, 0x37, 0xef, 0xbd, 0xf1, 0xce, 0x0e, 0x7a, 0x46, 0x78, 0x4e, 0x78, 0xc6,
-	0x7d, 0x71, 0x8a, 0xa9, 0x7f, 0x12, 0x74, 0x41, 0xe0, 0x40, 0x05, 0x11, 0x83, 0x98, 0xb0, 0xc4,
-	0xa3, 0x8c, 0x08, 0x62, 0xae, 0x15, 0x38, 0x4f, 0x1e, 0x79, 0x05, 0xae, 0xbe, 0x96, 0x92, 0x94,
-	0x28, 0x80, 0x2f, 0xbf, 0x34, 0xb6, 0xee, 0xa4, 0x84, 0xa4, 0x7d, 0xf0, 0x55, 0xd4, 0x1d, 0x1e,
-	0xf9, 0x22, 0xcb, 0x81, 0x0b, 0x9c, 0x53, 0x0d, 0x70, 0x3f, 0x55, 0x10, 0x3a, 0x3c, 0xc5, 0x34,
-	0x54, 0x0a, 0xe6, 0x3a, 0x5a, 0xa0, 0x84, 0xf4, 0xa3, 0x2c, 0xb1, 0x8c, 0x86, 0xd1, 0x2c, 0x87,
-	0x15, 0x19, 0xbe, 0x49, 0xcc, 0x4d, 0x54, 0xc3, 0x9c, 0x83, 0x68, 0x45, 0x09, 0x0c, 0x48, 0x6e,
-	0xcd, 0x35, 0x8c, 0xe6, 0x52, 0x58, 0xd5, 0xb9, 0x3d, 0x99, 0x9a, 0x42, 0x82, 0x02, 0x32, 0x7f,
-	0x03, 0x12, 0x68, 0xc8, 0x2e, 0xaa, 0xf4, 0x20, 0x4b, 0x7b, 0xc2, 0x2a, 0x37, 0x8c, 0xe6, 0x7c,
-	0x7b, 0xeb, 0xfb, 0xd8, 0x59, 0xd6, 0xcd, 0x45, 0xfa, 0xe0, 0x7a, 0xec, 0xac, 0x8d, 0x70, 0xde,
-	0xdf, 0x71, 0x67, 0xd2, 0x6e, 0x58, 0x14, 0x9a, 0xef, 0x51, 0x59, 0xf6, 0x60, 0xfd, 0xd7, 0x30,
-	0x9a, 0xd5, 0xed, 0xba, 0xa7, 0x1b, 0xf4, 0x26, 0x0d, 0x7a, 0x87, 0x93, 0x06, 0xdb, 0xf6, 0xf9,
-	0xd8, 0x29, 0x5d, 0x8f, 0x1d, 0x73, 0x86, 0x4f, 0x16, 0xbb, 0x67, 0x5f, 0x1d, 0x23, 0x54, 0x3c,
-	0x66, 0x07, 0x99, 0xb4, 0x15, 0xf5, 0x31, 0x17, 0x11, 0xa7, 0x44, 0x44, 0x94, 0x65, 0x31, 0x58,
-	0x15, 0xe9, 0xbd, 0xfd, 0x54, 0x32, 0x7c, 0x19, 0x3b, 0x1b, 0xb1, 0xfa, 0xe5, 0x3c, 0x39, 0xf6,
-	0x32, 0xe2, 0xe7, 0x58, 0xf4, 0xbc, 0x7d, 0x48, 0x71, 0x3c, 0xda, 0x83, 0x38, 0x5c, 0xa1, 0xad,
-	0x7d, 0xcc, 0xc5, 0x01, 0x25, 0xa2, 0x23, 0x6b, 0x15, 0x63, 0xf0, 0x1b, 0xe3, 0xc2, 0x43, 0x18,
-	0x83, 0x59, 0xc6, 0x1e, 0xb2, 0x69, 0x2b, 0xc2, 0x2c, 0x13, 0xbd, 0x1c, 0x44, 0x16, 0x47, 0x6a,
-	0x28, 0x70, 0x1c, 0x0f, 0xf3, 0x61, 0x1f, 0x0b, 0xc2, 0xac, 0xc5, 0xfb, 0xb3, 0x6f, 0xd0, 0xd6,
-	0xee, 0x94, 0x49, 0x5e, 0xfd, 0xee, 0x2f, 0x1e, 0xa5, 0x14, 0xfc, 0x53, 0x69, 0xe9, 0x21, 0x4a,
-	0xc1, 0xdf, 0x95, 0x30, 0xaa, 0xa7, 0x40, 0x72, 0x10, 0xec, 0x4f, 0x2a, 0xe8, 0xfe, 0x2a, 0xd6,
-	0x94, 0xe6, 0xb6, 0xc4, 0x11, 0x5a, 0x51, 0xb7, 0x00, 0x8c, 0x11, 0xa6, 0x2e, 0xde, 0xaa, 0xde,
-	0x39, 0x35, 0x6e, 0x31, 0x35, 0x8f, 0xf5, 0xd4, 0xdc, 0x22, 0xd0, 0x93, 0xb3, 0x2c, 0xb3, 0xaf,
-	0x65, 0x52, 0xd6, 0xb9, 0x3f, 0x0c, 0x54, 0xeb, 0xb0, 0xe1, 0x20, 0x1b, 0xa4, 0x07, 0x02, 0x0b,
-	0x30, 0x9f, 0x20, 0x94, 0xf1, 0x88, 0xea, 0x94, 0x7a, 0x48, 0x8b, 0xe1, 0x52, 0xc6, 0x0b, 0x8c,
-	0x19, 0xa3, 0xff, 0x15, 0xed, 0x31, 0x50, 0xa1, 0x6d, 0xcd, 0xdd, 0x69, 0x6b, 0xb3, 0xb0, 0xf5,
-	0xe8, 0x86, 0xad, 0x69, 0xbd, 0x76, 0x55, 0x93, 0xc9, 0x77, 0x40, 0x85, 0xac, 0x32, 0x5f, 0xa1,
-	0xe5, 0x02, 0x34, 0x8a, 0x38, 0xc0, 0x40, 0x3d, 0xc7, 0x5a, 0x7b, 0xfd, 0x7a, 0xec, 0xac, 0x26,
-	0x40, 0x19, 0xc4, 0x58, 0x40, 0xb2, 0xe3, 0x0a, 0x36, 0x04, 0xd7, 0x32, 0xc2, 0xaa, 0xae, 0x1e,
-	0x1d, 0x00, 0x0c, 0xcc, 0x2d, 0xb4, 0xaa, 0xe7, 0x17, 0x60, 0x10, 0x4d, 0x16, 0x42, 0x59, 0x2d,
-	0x04, 0x65, 0x5d, 0x82, 0x3a, 0x6a, 0x31, 0xb4, 0xdf, 0x9e, 0x5f, 0xda, 0xc6, 0xc5, 0xa5, 0x6d,
-	0x7c, 0xbb, 0xb4, 0x8d, 0xb3, 0x2b, 0xbb, 0x74, 0x71, 0x65, 0x97, 0x3e, 0x5f, 0xd9, 0xa5, 0x0f,
-	0xad, 0x34, 0x13, 0xbd, 0x61, 0xd7, 0x8b, 0x49, 0xee, 0x17, 0x2b, 0xeb, 0x79, 0x1f, 0x77, 0xf9,
-	0x24, 0xf0, 0x4f, 0xb6, 0x5f, 0xfa, 0x1f, 0xf5, 0xb6, 0x13, 0x23, 0x0a, 0xbc, 0x5b, 0x51, 0x8d,
-	0xbf, 0xf8, 0x19, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x9d, 0x58, 0x6f, 0x0a, 0x05, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xdb, 0x36,
+	0x18, 0xb5, 0x52, 0x2f, 0x3f, 0x68, 0x67, 0x49, 0x84, 0x74, 0xd1, 0x9c, 0x45, 0x4a, 0x34, 0x60,
+	0x48, 0x07, 0x4c, 0xb2, 0x32, 0x60, 0x87, 0xee, 0x64, 0x37, 0x5e, 0xea, 0x2d, 0x59, 0x0d, 0x59,
+	0x6b, 0xd7, 0x5d, 0x04, 0x5a, 0xfa, 0x2a, 0x0b, 0xb1, 0x4c, 0x81, 0xa4, 0xd3, 0xf9, 0x3f, 0xe8,
+	0xb1, 0xff, 0xc3, 0xfe, 0x99, 0x1e, 0x7b, 0x1c, 0x76, 0xf0, 0x86, 0xe4, 0xb6, 0x63, 0x2e, 0x03,
+	0x76, 0x1a, 0x48, 0xca, 0x69, 0xdc, 0x74, 0x8d, 0x7b, 0x33, 0x3f, 0xbe, 0xf7, 0x3e, 0xbf, 0xc7,
+	0x8f, 0x14, 0xfa, 0x82, 0xb0, 0x8c, 0xb0, 0x94, 0xb9, 0xfc, 0x39, 0xce, 0xdd, 0x33, 0xaf, 0x07,
+	0x1c, 0x7b, 0x72, 0x11, 0x52, 0x88, 0x08, 0x8d, 0x9d, 0x9c, 0x12, 0x4e, 0xf4, 0xcd, 0x02, 0xe7,
+	0x88, 0x2d, 0xa7, 0xc0, 0xd5, 0x36, 0x13, 0x92, 0x10, 0x09, 0x70, 0xc5, 0x2f, 0x85, 0xad, 0x59,
+	0x09, 0x21, 0xc9, 0x00, 0x5c, 0xb9, 0xea, 0x8d, 0x9e, 0xb9, 0x3c, 0xcd, 0x80, 0x71, 0x9c, 0xe5,
+	0x0a, 0x60, 0xff, 0xb3, 0x84, 0x50, 0xf0, 0x1c, 0xe7, 0xbe, 0xec, 0xa0, 0x6f, 0xa1, 0xa5, 0x9c,
+	0x90, 0x41, 0x98, 0xc6, 0x86, 0xb6, 0xab, 0xed, 0x97, 0xfd, 0x45, 0xb1, 0x6c, 0xc7, 0xfa, 0x1e,
+	0xaa, 0x62, 0xc6, 0x80, 0xd7, 0xc3, 0x18, 0x86, 0x24, 0x33, 0x16, 0x76, 0xb5, 0xfd, 0x15, 0xbf,
+	0xa2, 0x6a, 0x87, 0xa2, 0x74, 0x05, 0xf1, 0x0a, 0xc8, 0x9d, 0x6b, 0x10, 0x4f, 0x41, 0x1a, 0x68,
+	0xb1, 0x0f, 0x69, 0xd2, 0xe7, 0x46, 0x79, 0x57, 0xdb, 0xbf, 0xd3, 0xbc, 0xf7, 0xf7, 0xc4, 0x5a,
+	0x55, 0xe6, 0x42, 0xb5, 0x71, 0x39, 0xb1, 0x36, 0xc7, 0x38, 0x1b, 0xdc, 0xb7, 0x67, 0xca, 0xb6,
+	0x5f, 0x10, 0xf5, 0x1f, 0x51, 0x59, 0x78, 0x30, 0x3e, 0xda, 0xd5, 0xf6, 0x2b, 0x07, 0x35, 0x47,
+	0x19, 0x74, 0xa6, 0x06, 0x9d, 0x60, 0x6a, 0xb0, 0x69, 0xbe, 0x9a, 0x58, 0xa5, 0xcb, 0x89, 0xa5,
+	0xcf, 0xe8, 0x09, 0xb2, 0xfd, 0xf2, 0x4f, 0x4b, 0xf3, 0xa5, 0x8e, 0xde, 0x41, 0x7a, 0x5e, 0x0f,
+	0x07, 0x98, 0xf1, 0x90, 0xe5, 0x84, 0x87, 0x39, 0x4d, 0x23, 0x30, 0x16, 0xc5, 0x7f, 0x6f, 0x7e,
+	0x2e, 0x14, 0xfe, 0x98, 0x58, 0xdb, 0x91, 0x8c, 0x9c, 0xc5, 0xa7, 0x4e, 0x4a, 0xdc, 0x0c, 0xf3,
+	0xbe, 0x73, 0x0c, 0x09, 0x8e, 0xc6, 0x87, 0x10, 0xf9, 0x6b, 0x79, 0xfd, 0x18, 0x33, 0xde, 0xcd,
+	0x09, 0xef, 0x08, 0xae, 0x54, 0xf4, 0x6e, 0x28, 0x2e, 0x7d, 0x88, 0xa2, 0x37, 0xab, 0xd8, 0x47,
+	0x66, 0x5e, 0x0f, 0x31, 0x4d, 0x79, 0x3f, 0x03, 0x9e, 0x46, 0xa1, 0x1c, 0x0a, 0x1c, 0x45, 0xa3,
+	0x6c, 0x34, 0xc0, 0x9c, 0x50, 0x63, 0x79, 0x7e, 0xf5, 0xed, 0xbc, 0xde, 0xb8, 0x52, 0x12, 0x47,
+	0xdf, 0x78, 0xa3, 0x23, 0x3b, 0x79, 0xef, 0xed, 0xb4, 0xf2, 0x21, 0x9d, 0xbc, 0xff, 0xef, 0x84,
+	0x51, 0x2d, 0x01, 0x92, 0x01, 0xa7, 0xef, 0xea, 0x82, 0xe6, 0xef, 0x62, 0x5c, 0xc9, 0xbc, 0xdd,
+	0xe2, 0x19, 0x5a, 0x93, 0xa7, 0x00, 0x94, 0x12, 0x2a, 0x0f, 0xde, 0xa8, 0xdc, 0x3a, 0x35, 0x76,
+	0x31, 0x35, 0x9f, 0xa8, 0xa9, 0x79, 0x4b, 0x40, 0x4d, 0xce, 0xaa, 0xa8, 0xb6, 0x44, 0x51, 0xf0,
+	0xf4, 0xa7, 0xe8, 0xd3, 0xe2, 0x6e, 0x9c, 0x91, 0xc1, 0x28, 0x83, 0x19, 0x27, 0x55, 0xe9, 0x64,
+	0xa7, 0x70, 0x72, 0xf7, 0xa6, 0x93, 0xf6, 0x90, 0xfb, 0x5b, 0x8a, 0xff, 0x58, 0xd2, 0xaf, 0x5b,
+	0x98, 0x4a, 0x7b, 0xef, 0x92, 0x5e, 0x9d, 0x5f, 0xda, 0xbb, 0x21, 0x6d, 0xff, 0xab, 0xa1, 0x6a,
+	0x87, 0x8e, 0x86, 0xe9, 0x30, 0xe9, 0x72, 0xcc, 0x41, 0xdf, 0x41, 0x28, 0x65, 0x61, 0xae, 0x4a,
+	0xf2, 0xfa, 0x2f, 0xfb, 0x2b, 0x29, 0x2b, 0x30, 0x7a, 0x84, 0x3e, 0x96, 0x61, 0x9c, 0x42, 0xce,
+	0x55, 0x98, 0x0b, 0xb7, 0x86, 0xb9, 0x57, 0x84, 0x79, 0xf7, 0x5a, 0x98, 0x57, 0x7c, 0x95, 0x65,
+	0x55, 0x14, 0x7f, 0x80, 0x9c, 0xcb, 0x28, 0xbf, 0x45, 0xab, 0x05, 0x68, 0x1c, 0x32, 0x80, 0xa1,
+	0x7c, 0x44, 0xaa, 0xcd, 0xad, 0xcb, 0x89, 0xb5, 0x11, 0x43, 0x4e, 0x21, 0xc2, 0x1c, 0xe2, 0xfb,
+	0x36, 0xa7, 0x23, 0xb0, 0x0d, 0xcd, 0xaf, 0x28, 0xf6, 0xb8, 0x0b, 0x30, 0xd4, 0xef, 0xa1, 0x0d,
+	0x49, 0x16, 0xc4, 0x70, 0xfa, 0x8c, 0x95, 0xe5, 0x33, 0x26, 0xff, 0xba, 0x00, 0x75, 0xe4, 0x73,
+	0x66, 0xbf, 0x58, 0x40, 0xeb, 0x62, 0x5c, 0xba, 0x40, 0x53, 0x60, 0xcd, 0x51, 0x74, 0x0a, 0x5c,
+	0xff, 0x19, 0x21, 0xc6, 0x31, 0x2d, 0xdc, 0x69, 0xb7, 0xba, 0xdb, 0x29, 0xdc, 0x6d, 0x28, 0x77,
+	0x6f, 0xb8, 0xca, 0xd9, 0x8a, 0x2c, 0x48, 0x5b, 0x3e, 0x5a, 0x86, 0x61, 0x3c, 0x6f, 0x6a, 0xdb,
+	0x85, 0xee, 0x9a, 0xd2, 0x9d, 0x32, 0x95, 0xea, 0x12, 0x0c, 0x63, 0xa9, 0xf9, 0x1d, 0x2a, 0x8b,
+	0x6b, 0xa3, 0x9e, 0xd9, 0xe6, 0xc1, 0x1c, 0x57, 0xe5, 0x72, 0x62, 0x55, 0x94, 0xa4, 0x20, 0xda,
+	0xbe, 0xe4, 0x7f, 0x49, 0x51, 0x55, 0x26, 0xc1, 0x29, 0xe6, 0x90, 0x8c, 0xf5, 0xcf, 0x90, 0x11,
+	0x3c, 0x69, 0x74, 0xc2, 0x6e, 0xe0, 0x37, 0x82, 0xd6, 0xd1, 0xd3, 0xb0, 0xe1, 0xb7, 0x83, 0x87,
+	0x27, 0xad, 0xa0, 0xfd, 0x60, 0xbd, 0xa4, 0x6f, 0xa3, 0xad, 0xd9, 0xdd, 0xa3, 0xd6, 0xa3, 0x93,
+	0x56, 0xe0, 0xb7, 0x1f, 0xac, 0x6b, 0xfa, 0x1e, 0xda, 0x99, 0xdd, 0x7c, 0xfc, 0xe8, 0xf8, 0xa7,
+	0x93, 0x56, 0xf8, 0xa4, 0xd5, 0x3e, 0x7a, 0x18, 0xb4, 0x0e, 0xd7, 0x17, 0x6a, 0xe5, 0x17, 0xbf,
+	0x99, 0xa5, 0xe6, 0xf7, 0xaf, 0xce, 0x4d, 0xed, 0xf5, 0xb9, 0xa9, 0xfd, 0x75, 0x6e, 0x6a, 0x2f,
+	0x2f, 0xcc, 0xd2, 0xeb, 0x0b, 0xb3, 0xf4, 0xfb, 0x85, 0x59, 0xfa, 0xa5, 0x9e, 0xa4, 0xbc, 0x3f,
+	0xea, 0x39, 0x11, 0xc9, 0xdc, 0xe2, 0x3b, 0xf7, 0xd5, 0x00, 0xf7, 0xd8, 0x74, 0xe1, 0x9e, 0x1d,
+	0x7c, 0xe3, 0xfe, 0xaa, 0x3e, 0x91, 0x7c, 0x9c, 0x03, 0xeb, 0x2d, 0xca, 0x04, 0xbf, 0xfe, 0x6f,
+	0x00, 0xb2, 0x48, 0x5d, 0xaa, 0x3f, 0x07, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -289,6 +395,26 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Asset1VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset1VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.Asset0VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset0VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -435,6 +561,55 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapSeriesBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapSeriesBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapSeriesBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -480,6 +655,10 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Asset0VolumeAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Asset1VolumeAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -504,6 +683,21 @@ func (m *PruningState) Size() (n int) {
 	return n
 }
 
+func (m *TwapSeriesBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
 func sovTwapRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -877,6 +1071,74 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset0VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
@@ -1054,6 +1316,156 @@ func (m *PruningState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TwapSeriesBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapSeriesBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapSeriesBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTwapRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0